@@ -21,14 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Proposal_FullMethodName    = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName   = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName        = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName       = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName      = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName     = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName    = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Proposal_FullMethodName          = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName         = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName              = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName             = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName            = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName           = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName          = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName       = "/cosmos.gov.v1.Query/TallyResult"
+	Query_TallyPreview_FullMethodName      = "/cosmos.gov.v1.Query/TallyPreview"
+	Query_MinDeposit_FullMethodName        = "/cosmos.gov.v1.Query/MinDeposit"
+	Query_ProposerRateLimit_FullMethodName = "/cosmos.gov.v1.Query/ProposerRateLimit"
 )
 
 // QueryClient is the client API for Query service.
@@ -51,6 +54,16 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// TallyPreview queries the tally of a proposal in voting period as if its
+	// voting period ended now, with the breakdown of the voting power of each
+	// bonded validator.
+	TallyPreview(ctx context.Context, in *QueryTallyPreviewRequest, opts ...grpc.CallOption) (*QueryTallyPreviewResponse, error)
+	// MinDeposit queries the deposits currently required for a proposal to enter
+	// voting period and at its submission.
+	MinDeposit(ctx context.Context, in *QueryMinDepositRequest, opts ...grpc.CallOption) (*QueryMinDepositResponse, error)
+	// ProposerRateLimit queries the proposals submitted by a proposer within the
+	// proposer rate limit period.
+	ProposerRateLimit(ctx context.Context, in *QueryProposerRateLimitRequest, opts ...grpc.CallOption) (*QueryProposerRateLimitResponse, error)
 }
 
 type queryClient struct {
@@ -133,6 +146,33 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) TallyPreview(ctx context.Context, in *QueryTallyPreviewRequest, opts ...grpc.CallOption) (*QueryTallyPreviewResponse, error) {
+	out := new(QueryTallyPreviewResponse)
+	err := c.cc.Invoke(ctx, Query_TallyPreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinDeposit(ctx context.Context, in *QueryMinDepositRequest, opts ...grpc.CallOption) (*QueryMinDepositResponse, error) {
+	out := new(QueryMinDepositResponse)
+	err := c.cc.Invoke(ctx, Query_MinDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposerRateLimit(ctx context.Context, in *QueryProposerRateLimitRequest, opts ...grpc.CallOption) (*QueryProposerRateLimitResponse, error) {
+	out := new(QueryProposerRateLimitResponse)
+	err := c.cc.Invoke(ctx, Query_ProposerRateLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -153,6 +193,16 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// TallyPreview queries the tally of a proposal in voting period as if its
+	// voting period ended now, with the breakdown of the voting power of each
+	// bonded validator.
+	TallyPreview(context.Context, *QueryTallyPreviewRequest) (*QueryTallyPreviewResponse, error)
+	// MinDeposit queries the deposits currently required for a proposal to enter
+	// voting period and at its submission.
+	MinDeposit(context.Context, *QueryMinDepositRequest) (*QueryMinDepositResponse, error)
+	// ProposerRateLimit queries the proposals submitted by a proposer within the
+	// proposer rate limit period.
+	ProposerRateLimit(context.Context, *QueryProposerRateLimitRequest) (*QueryProposerRateLimitResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -184,6 +234,15 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) TallyPreview(context.Context, *QueryTallyPreviewRequest) (*QueryTallyPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyPreview not implemented")
+}
+func (UnimplementedQueryServer) MinDeposit(context.Context, *QueryMinDepositRequest) (*QueryMinDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinDeposit not implemented")
+}
+func (UnimplementedQueryServer) ProposerRateLimit(context.Context, *QueryProposerRateLimitRequest) (*QueryProposerRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposerRateLimit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -341,6 +400,60 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TallyPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallyPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyPreview(ctx, req.(*QueryTallyPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinDeposit(ctx, req.(*QueryMinDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposerRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposerRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposerRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposerRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposerRateLimit(ctx, req.(*QueryProposerRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +493,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "TallyPreview",
+			Handler:    _Query_TallyPreview_Handler,
+		},
+		{
+			MethodName: "MinDeposit",
+			Handler:    _Query_MinDeposit_Handler,
+		},
+		{
+			MethodName: "ProposerRateLimit",
+			Handler:    _Query_ProposerRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -21,20 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GroupInfo_FullMethodName              = "/cosmos.group.v1.Query/GroupInfo"
-	Query_GroupPolicyInfo_FullMethodName        = "/cosmos.group.v1.Query/GroupPolicyInfo"
-	Query_GroupMembers_FullMethodName           = "/cosmos.group.v1.Query/GroupMembers"
-	Query_GroupsByAdmin_FullMethodName          = "/cosmos.group.v1.Query/GroupsByAdmin"
-	Query_GroupPoliciesByGroup_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
-	Query_GroupPoliciesByAdmin_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
-	Query_Proposal_FullMethodName               = "/cosmos.group.v1.Query/Proposal"
-	Query_ProposalsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
-	Query_VoteByProposalVoter_FullMethodName    = "/cosmos.group.v1.Query/VoteByProposalVoter"
-	Query_VotesByProposal_FullMethodName        = "/cosmos.group.v1.Query/VotesByProposal"
-	Query_VotesByVoter_FullMethodName           = "/cosmos.group.v1.Query/VotesByVoter"
-	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
-	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
-	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_GroupInfo_FullMethodName                        = "/cosmos.group.v1.Query/GroupInfo"
+	Query_GroupPolicyInfo_FullMethodName                  = "/cosmos.group.v1.Query/GroupPolicyInfo"
+	Query_GroupMembers_FullMethodName                     = "/cosmos.group.v1.Query/GroupMembers"
+	Query_GroupsByAdmin_FullMethodName                    = "/cosmos.group.v1.Query/GroupsByAdmin"
+	Query_GroupPoliciesByGroup_FullMethodName             = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
+	Query_GroupPoliciesByAdmin_FullMethodName             = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
+	Query_Proposal_FullMethodName                         = "/cosmos.group.v1.Query/Proposal"
+	Query_ProposalsByGroupPolicy_FullMethodName           = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
+	Query_VoteByProposalVoter_FullMethodName              = "/cosmos.group.v1.Query/VoteByProposalVoter"
+	Query_VotesByProposal_FullMethodName                  = "/cosmos.group.v1.Query/VotesByProposal"
+	Query_VotesByVoter_FullMethodName                     = "/cosmos.group.v1.Query/VotesByVoter"
+	Query_GroupsByMember_FullMethodName                   = "/cosmos.group.v1.Query/GroupsByMember"
+	Query_TallyResult_FullMethodName                      = "/cosmos.group.v1.Query/TallyResult"
+	Query_Groups_FullMethodName                           = "/cosmos.group.v1.Query/Groups"
+	Query_ScheduledExecution_FullMethodName               = "/cosmos.group.v1.Query/ScheduledExecution"
+	Query_ScheduledExecutionsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/ScheduledExecutionsByGroupPolicy"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +77,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ScheduledExecution queries the scheduled execution of an accepted proposal.
+	ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error)
+	// ScheduledExecutionsByGroupPolicy queries the scheduled executions of a group policy.
+	ScheduledExecutionsByGroupPolicy(ctx context.Context, in *QueryScheduledExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsByGroupPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +217,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error) {
+	out := new(QueryScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecutionsByGroupPolicy(ctx context.Context, in *QueryScheduledExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsByGroupPolicyResponse, error) {
+	out := new(QueryScheduledExecutionsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledExecutionsByGroupPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +273,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ScheduledExecution queries the scheduled execution of an accepted proposal.
+	ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error)
+	// ScheduledExecutionsByGroupPolicy queries the scheduled executions of a group policy.
+	ScheduledExecutionsByGroupPolicy(context.Context, *QueryScheduledExecutionsByGroupPolicyRequest) (*QueryScheduledExecutionsByGroupPolicyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +326,12 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecution not implemented")
+}
+func (UnimplementedQueryServer) ScheduledExecutionsByGroupPolicy(context.Context, *QueryScheduledExecutionsByGroupPolicyRequest) (*QueryScheduledExecutionsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecutionsByGroupPolicy not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +597,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecution(ctx, req.(*QueryScheduledExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecutionsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecutionsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledExecutionsByGroupPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecutionsByGroupPolicy(ctx, req.(*QueryScheduledExecutionsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +696,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ScheduledExecution",
+			Handler:    _Query_ScheduledExecution_Handler,
+		},
+		{
+			MethodName: "ScheduledExecutionsByGroupPolicy",
+			Handler:    _Query_ScheduledExecutionsByGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -35,6 +35,7 @@ const (
 	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
 	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
 	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_CancelScheduledExecution_FullMethodName        = "/cosmos.group.v1.Msg/CancelScheduledExecution"
 )
 
 // MsgClient is the client API for Msg service.
@@ -69,6 +70,10 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// CancelScheduledExecution cancels the recurring execution of an accepted
+	// proposal. It must be signed by the group policy account, i.e. it is
+	// meant to be executed through a follow-up proposal.
+	CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -205,6 +210,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error) {
+	out := new(MsgCancelScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -237,6 +251,10 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// CancelScheduledExecution cancels the recurring execution of an accepted
+	// proposal. It must be signed by the group policy account, i.e. it is
+	// meant to be executed through a follow-up proposal.
+	CancelScheduledExecution(context.Context, *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -286,6 +304,9 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) CancelScheduledExecution(context.Context, *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledExecution not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -551,6 +572,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, req.(*MsgCancelScheduledExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -614,6 +653,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "CancelScheduledExecution",
+			Handler:    _Msg_CancelScheduledExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
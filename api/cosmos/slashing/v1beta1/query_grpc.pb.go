@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName         = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName    = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName   = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_PendingSlashes_FullMethodName = "/cosmos.slashing.v1beta1.Query/PendingSlashes"
+	Query_PendingSlash_FullMethodName   = "/cosmos.slashing.v1beta1.Query/PendingSlash"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// PendingSlashes queries the slashes awaiting their execution.
+	PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error)
+	// PendingSlash queries a slash awaiting its execution, along with the
+	// delegators whose stake is subject to it.
+	PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error) {
+	out := new(QueryPendingSlashesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingSlashes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error) {
+	out := new(QueryPendingSlashResponse)
+	err := c.cc.Invoke(ctx, Query_PendingSlash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// PendingSlashes queries the slashes awaiting their execution.
+	PendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error)
+	// PendingSlash queries a slash awaiting its execution, along with the
+	// delegators whose stake is subject to it.
+	PendingSlash(context.Context, *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) PendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlashes not implemented")
+}
+func (UnimplementedQueryServer) PendingSlash(context.Context, *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlash not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingSlashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlashes(ctx, req.(*QueryPendingSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlash(ctx, req.(*QueryPendingSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "PendingSlashes",
+			Handler:    _Query_PendingSlashes_Handler,
+		},
+		{
+			MethodName: "PendingSlash",
+			Handler:    _Query_PendingSlash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
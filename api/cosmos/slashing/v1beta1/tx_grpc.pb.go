@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName             = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_CancelPendingSlash_FullMethodName = "/cosmos.slashing.v1beta1.Msg/CancelPendingSlash"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +37,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CancelPendingSlash defines a governance operation for cancelling a slash
+	// awaiting its execution. The authority defaults to the x/gov module account.
+	CancelPendingSlash(ctx context.Context, in *MsgCancelPendingSlash, opts ...grpc.CallOption) (*MsgCancelPendingSlashResponse, error)
 }
 
 type msgClient struct {
@@ -64,6 +68,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelPendingSlash(ctx context.Context, in *MsgCancelPendingSlash, opts ...grpc.CallOption) (*MsgCancelPendingSlashResponse, error) {
+	out := new(MsgCancelPendingSlashResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPendingSlash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -77,6 +90,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CancelPendingSlash defines a governance operation for cancelling a slash
+	// awaiting its execution. The authority defaults to the x/gov module account.
+	CancelPendingSlash(context.Context, *MsgCancelPendingSlash) (*MsgCancelPendingSlashResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -90,6 +106,9 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) CancelPendingSlash(context.Context, *MsgCancelPendingSlash) (*MsgCancelPendingSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingSlash not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -139,6 +158,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelPendingSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPendingSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingSlash(ctx, req.(*MsgCancelPendingSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +191,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CancelPendingSlash",
+			Handler:    _Msg_CancelPendingSlash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
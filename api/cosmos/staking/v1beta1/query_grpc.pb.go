@@ -19,20 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                         = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName               = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName      = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                         = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName                = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName               = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName      = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName                = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName                 = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                     = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                               = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                             = "/cosmos.staking.v1beta1.Query/Params"
+	Query_ValidatorDenomStakes_FullMethodName               = "/cosmos.staking.v1beta1.Query/ValidatorDenomStakes"
+	Query_DelegatorDenomDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDenomDelegations"
+	Query_DelegatorDenomUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorDenomUnbondingDelegations"
+	Query_QueuedOperations_FullMethodName                   = "/cosmos.staking.v1beta1.Query/QueuedOperations"
+	Query_DelegatorQueuedOperations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorQueuedOperations"
+	Query_UnbondingQueue_FullMethodName                     = "/cosmos.staking.v1beta1.Query/UnbondingQueue"
+	Query_RedelegationQueue_FullMethodName                  = "/cosmos.staking.v1beta1.Query/RedelegationQueue"
+	Query_ValidatorSetSize_FullMethodName                   = "/cosmos.staking.v1beta1.Query/ValidatorSetSize"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +100,32 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorDenomStakes queries the additional bond denom stakes of a validator.
+	ValidatorDenomStakes(ctx context.Context, in *QueryValidatorDenomStakesRequest, opts ...grpc.CallOption) (*QueryValidatorDenomStakesResponse, error)
+	// DelegatorDenomDelegations queries the additional bond denom delegations of
+	// a delegator.
+	DelegatorDenomDelegations(ctx context.Context, in *QueryDelegatorDenomDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDenomDelegationsResponse, error)
+	// DelegatorDenomUnbondingDelegations queries the additional bond denom
+	// unbonding delegations of a delegator.
+	DelegatorDenomUnbondingDelegations(ctx context.Context, in *QueryDelegatorDenomUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDenomUnbondingDelegationsResponse, error)
+	// QueuedOperations queries the staking operations queued until the end of
+	// the epoch.
+	QueuedOperations(ctx context.Context, in *QueryQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryQueuedOperationsResponse, error)
+	// DelegatorQueuedOperations queries the staking operations of a delegator
+	// queued until the end of the epoch.
+	DelegatorQueuedOperations(ctx context.Context, in *QueryDelegatorQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryDelegatorQueuedOperationsResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries, of the bond denom
+	// and of the additional bond denoms, maturing in a time window across all
+	// delegators by page, along with their totals per validator and denom over
+	// the whole window.
+	UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries maturing in a time
+	// window across all delegators by page, along with their totals per source
+	// validator over the whole window.
+	RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error)
+	// ValidatorSetSize queries the effective size of the active validator set and
+	// the target size it ramps toward.
+	ValidatorSetSize(ctx context.Context, in *QueryValidatorSetSizeRequest, opts ...grpc.CallOption) (*QueryValidatorSetSizeResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +262,78 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorDenomStakes(ctx context.Context, in *QueryValidatorDenomStakesRequest, opts ...grpc.CallOption) (*QueryValidatorDenomStakesResponse, error) {
+	out := new(QueryValidatorDenomStakesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorDenomStakes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorDenomDelegations(ctx context.Context, in *QueryDelegatorDenomDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDenomDelegationsResponse, error) {
+	out := new(QueryDelegatorDenomDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorDenomDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorDenomUnbondingDelegations(ctx context.Context, in *QueryDelegatorDenomUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorDenomUnbondingDelegationsResponse, error) {
+	out := new(QueryDelegatorDenomUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorDenomUnbondingDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedOperations(ctx context.Context, in *QueryQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryQueuedOperationsResponse, error) {
+	out := new(QueryQueuedOperationsResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorQueuedOperations(ctx context.Context, in *QueryDelegatorQueuedOperationsRequest, opts ...grpc.CallOption) (*QueryDelegatorQueuedOperationsResponse, error) {
+	out := new(QueryDelegatorQueuedOperationsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorQueuedOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error) {
+	out := new(QueryUnbondingQueueResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error) {
+	out := new(QueryRedelegationQueueResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetSize(ctx context.Context, in *QueryValidatorSetSizeRequest, opts ...grpc.CallOption) (*QueryValidatorSetSizeResponse, error) {
+	out := new(QueryValidatorSetSizeResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSetSize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +391,32 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorDenomStakes queries the additional bond denom stakes of a validator.
+	ValidatorDenomStakes(context.Context, *QueryValidatorDenomStakesRequest) (*QueryValidatorDenomStakesResponse, error)
+	// DelegatorDenomDelegations queries the additional bond denom delegations of
+	// a delegator.
+	DelegatorDenomDelegations(context.Context, *QueryDelegatorDenomDelegationsRequest) (*QueryDelegatorDenomDelegationsResponse, error)
+	// DelegatorDenomUnbondingDelegations queries the additional bond denom
+	// unbonding delegations of a delegator.
+	DelegatorDenomUnbondingDelegations(context.Context, *QueryDelegatorDenomUnbondingDelegationsRequest) (*QueryDelegatorDenomUnbondingDelegationsResponse, error)
+	// QueuedOperations queries the staking operations queued until the end of
+	// the epoch.
+	QueuedOperations(context.Context, *QueryQueuedOperationsRequest) (*QueryQueuedOperationsResponse, error)
+	// DelegatorQueuedOperations queries the staking operations of a delegator
+	// queued until the end of the epoch.
+	DelegatorQueuedOperations(context.Context, *QueryDelegatorQueuedOperationsRequest) (*QueryDelegatorQueuedOperationsResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries, of the bond denom
+	// and of the additional bond denoms, maturing in a time window across all
+	// delegators by page, along with their totals per validator and denom over
+	// the whole window.
+	UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries maturing in a time
+	// window across all delegators by page, along with their totals per source
+	// validator over the whole window.
+	RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error)
+	// ValidatorSetSize queries the effective size of the active validator set and
+	// the target size it ramps toward.
+	ValidatorSetSize(context.Context, *QueryValidatorSetSizeRequest) (*QueryValidatorSetSizeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +466,30 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ValidatorDenomStakes(context.Context, *QueryValidatorDenomStakesRequest) (*QueryValidatorDenomStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDenomStakes not implemented")
+}
+func (UnimplementedQueryServer) DelegatorDenomDelegations(context.Context, *QueryDelegatorDenomDelegationsRequest) (*QueryDelegatorDenomDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDenomDelegations not implemented")
+}
+func (UnimplementedQueryServer) DelegatorDenomUnbondingDelegations(context.Context, *QueryDelegatorDenomUnbondingDelegationsRequest) (*QueryDelegatorDenomUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorDenomUnbondingDelegations not implemented")
+}
+func (UnimplementedQueryServer) QueuedOperations(context.Context, *QueryQueuedOperationsRequest) (*QueryQueuedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedOperations not implemented")
+}
+func (UnimplementedQueryServer) DelegatorQueuedOperations(context.Context, *QueryDelegatorQueuedOperationsRequest) (*QueryDelegatorQueuedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorQueuedOperations not implemented")
+}
+func (UnimplementedQueryServer) UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingQueue not implemented")
+}
+func (UnimplementedQueryServer) RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationQueue not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSetSize(context.Context, *QueryValidatorSetSizeRequest) (*QueryValidatorSetSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetSize not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +755,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorDenomStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDenomStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorDenomStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorDenomStakes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorDenomStakes(ctx, req.(*QueryValidatorDenomStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorDenomDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDenomDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDenomDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorDenomDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDenomDelegations(ctx, req.(*QueryDelegatorDenomDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorDenomUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorDenomUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorDenomUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorDenomUnbondingDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorDenomUnbondingDelegations(ctx, req.(*QueryDelegatorDenomUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedOperations(ctx, req.(*QueryQueuedOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorQueuedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorQueuedOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorQueuedOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorQueuedOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorQueuedOperations(ctx, req.(*QueryDelegatorQueuedOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingQueue(ctx, req.(*QueryUnbondingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationQueue(ctx, req.(*QueryRedelegationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSetSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetSize(ctx, req.(*QueryValidatorSetSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +962,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ValidatorDenomStakes",
+			Handler:    _Query_ValidatorDenomStakes_Handler,
+		},
+		{
+			MethodName: "DelegatorDenomDelegations",
+			Handler:    _Query_DelegatorDenomDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorDenomUnbondingDelegations",
+			Handler:    _Query_DelegatorDenomUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "QueuedOperations",
+			Handler:    _Query_QueuedOperations_Handler,
+		},
+		{
+			MethodName: "DelegatorQueuedOperations",
+			Handler:    _Query_DelegatorQueuedOperations_Handler,
+		},
+		{
+			MethodName: "UnbondingQueue",
+			Handler:    _Query_UnbondingQueue_Handler,
+		},
+		{
+			MethodName: "RedelegationQueue",
+			Handler:    _Query_RedelegationQueue_Handler,
+		},
+		{
+			MethodName: "ValidatorSetSize",
+			Handler:    _Query_ValidatorSetSize_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
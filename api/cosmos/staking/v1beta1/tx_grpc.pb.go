@@ -26,6 +26,8 @@ const (
 	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
 	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_DelegateDenom_FullMethodName             = "/cosmos.staking.v1beta1.Msg/DelegateDenom"
+	Msg_UndelegateDenom_FullMethodName           = "/cosmos.staking.v1beta1.Msg/UndelegateDenom"
 )
 
 // MsgClient is the client API for Msg service.
@@ -54,6 +56,12 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DelegateDenom defines a method for delegating an additional bond denom
+	// to a validator.
+	DelegateDenom(ctx context.Context, in *MsgDelegateDenom, opts ...grpc.CallOption) (*MsgDelegateDenomResponse, error)
+	// UndelegateDenom defines a method for undelegating an additional bond denom
+	// from a validator.
+	UndelegateDenom(ctx context.Context, in *MsgUndelegateDenom, opts ...grpc.CallOption) (*MsgUndelegateDenomResponse, error)
 }
 
 type msgClient struct {
@@ -127,6 +135,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) DelegateDenom(ctx context.Context, in *MsgDelegateDenom, opts ...grpc.CallOption) (*MsgDelegateDenomResponse, error) {
+	out := new(MsgDelegateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateDenom(ctx context.Context, in *MsgUndelegateDenom, opts ...grpc.CallOption) (*MsgUndelegateDenomResponse, error) {
+	out := new(MsgUndelegateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -153,6 +179,12 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DelegateDenom defines a method for delegating an additional bond denom
+	// to a validator.
+	DelegateDenom(context.Context, *MsgDelegateDenom) (*MsgDelegateDenomResponse, error)
+	// UndelegateDenom defines a method for undelegating an additional bond denom
+	// from a validator.
+	UndelegateDenom(context.Context, *MsgUndelegateDenom) (*MsgUndelegateDenomResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -181,6 +213,12 @@ func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCan
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) DelegateDenom(context.Context, *MsgDelegateDenom) (*MsgDelegateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateDenom not implemented")
+}
+func (UnimplementedMsgServer) UndelegateDenom(context.Context, *MsgUndelegateDenom) (*MsgUndelegateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateDenom not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -320,6 +358,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateDenom(ctx, req.(*MsgDelegateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateDenom(ctx, req.(*MsgUndelegateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -355,6 +429,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "DelegateDenom",
+			Handler:    _Msg_DelegateDenom_Handler,
+		},
+		{
+			MethodName: "UndelegateDenom",
+			Handler:    _Msg_UndelegateDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/vesting/v1beta1/query.proto
+
+package vestingv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_VestingPosition_FullMethodName  = "/cosmos.vesting.v1beta1.Query/VestingPosition"
+	Query_VestingPositions_FullMethodName = "/cosmos.vesting.v1beta1.Query/VestingPositions"
+	Query_ClawbackPreview_FullMethodName  = "/cosmos.vesting.v1beta1.Query/ClawbackPreview"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// VestingPosition returns a vesting position by its id.
+	VestingPosition(ctx context.Context, in *QueryVestingPositionRequest, opts ...grpc.CallOption) (*QueryVestingPositionResponse, error)
+	// VestingPositions returns the vesting positions of an account, with the
+	// total amount of coins they lock.
+	VestingPositions(ctx context.Context, in *QueryVestingPositionsRequest, opts ...grpc.CallOption) (*QueryVestingPositionsResponse, error)
+	// ClawbackPreview returns what a clawback of a ClawbackVestingAccount would
+	// return at the current block time, without performing it.
+	ClawbackPreview(ctx context.Context, in *QueryClawbackPreviewRequest, opts ...grpc.CallOption) (*QueryClawbackPreviewResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VestingPosition(ctx context.Context, in *QueryVestingPositionRequest, opts ...grpc.CallOption) (*QueryVestingPositionResponse, error) {
+	out := new(QueryVestingPositionResponse)
+	err := c.cc.Invoke(ctx, Query_VestingPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPositions(ctx context.Context, in *QueryVestingPositionsRequest, opts ...grpc.CallOption) (*QueryVestingPositionsResponse, error) {
+	out := new(QueryVestingPositionsResponse)
+	err := c.cc.Invoke(ctx, Query_VestingPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClawbackPreview(ctx context.Context, in *QueryClawbackPreviewRequest, opts ...grpc.CallOption) (*QueryClawbackPreviewResponse, error) {
+	out := new(QueryClawbackPreviewResponse)
+	err := c.cc.Invoke(ctx, Query_ClawbackPreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// VestingPosition returns a vesting position by its id.
+	VestingPosition(context.Context, *QueryVestingPositionRequest) (*QueryVestingPositionResponse, error)
+	// VestingPositions returns the vesting positions of an account, with the
+	// total amount of coins they lock.
+	VestingPositions(context.Context, *QueryVestingPositionsRequest) (*QueryVestingPositionsResponse, error)
+	// ClawbackPreview returns what a clawback of a ClawbackVestingAccount would
+	// return at the current block time, without performing it.
+	ClawbackPreview(context.Context, *QueryClawbackPreviewRequest) (*QueryClawbackPreviewResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) VestingPosition(context.Context, *QueryVestingPositionRequest) (*QueryVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPosition not implemented")
+}
+func (UnimplementedQueryServer) VestingPositions(context.Context, *QueryVestingPositionsRequest) (*QueryVestingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPositions not implemented")
+}
+func (UnimplementedQueryServer) ClawbackPreview(context.Context, *QueryClawbackPreviewRequest) (*QueryClawbackPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackPreview not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_VestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPosition(ctx, req.(*QueryVestingPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPositions(ctx, req.(*QueryVestingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClawbackPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClawbackPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClawbackPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClawbackPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClawbackPreview(ctx, req.(*QueryClawbackPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VestingPosition",
+			Handler:    _Query_VestingPosition_Handler,
+		},
+		{
+			MethodName: "VestingPositions",
+			Handler:    _Query_VestingPositions_Handler,
+		},
+		{
+			MethodName: "ClawbackPreview",
+			Handler:    _Query_ClawbackPreview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/vesting/v1beta1/query.proto",
+}
@@ -24,6 +24,9 @@ const (
 	Msg_CreatePeriodicVestingAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreatePeriodicVestingAccount"
 	Msg_CreateClawbackVestingAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount"
 	Msg_Clawback_FullMethodName                     = "/cosmos.vesting.v1beta1.Msg/Clawback"
+	Msg_CreateVestingPosition_FullMethodName        = "/cosmos.vesting.v1beta1.Msg/CreateVestingPosition"
+	Msg_TransferVestingPosition_FullMethodName      = "/cosmos.vesting.v1beta1.Msg/TransferVestingPosition"
+	Msg_AcceptVestingPosition_FullMethodName        = "/cosmos.vesting.v1beta1.Msg/AcceptVestingPosition"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +51,15 @@ type MsgClient interface {
 	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
 	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// CreateVestingPosition defines a method that enables adding a vesting
+	// position to an account, which may already exist.
+	CreateVestingPosition(ctx context.Context, in *MsgCreateVestingPosition, opts ...grpc.CallOption) (*MsgCreateVestingPositionResponse, error)
+	// TransferVestingPosition defines a method that enables transferring a
+	// vesting position, with its unvested coins, to another account.
+	TransferVestingPosition(ctx context.Context, in *MsgTransferVestingPosition, opts ...grpc.CallOption) (*MsgTransferVestingPositionResponse, error)
+	// AcceptVestingPosition defines a method that enables accepting a vesting
+	// position offered to an account.
+	AcceptVestingPosition(ctx context.Context, in *MsgAcceptVestingPosition, opts ...grpc.CallOption) (*MsgAcceptVestingPositionResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +115,33 @@ func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateVestingPosition(ctx context.Context, in *MsgCreateVestingPosition, opts ...grpc.CallOption) (*MsgCreateVestingPositionResponse, error) {
+	out := new(MsgCreateVestingPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateVestingPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferVestingPosition(ctx context.Context, in *MsgTransferVestingPosition, opts ...grpc.CallOption) (*MsgTransferVestingPositionResponse, error) {
+	out := new(MsgTransferVestingPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferVestingPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptVestingPosition(ctx context.Context, in *MsgAcceptVestingPosition, opts ...grpc.CallOption) (*MsgAcceptVestingPositionResponse, error) {
+	out := new(MsgAcceptVestingPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptVestingPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -125,6 +164,15 @@ type MsgServer interface {
 	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
 	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
 	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// CreateVestingPosition defines a method that enables adding a vesting
+	// position to an account, which may already exist.
+	CreateVestingPosition(context.Context, *MsgCreateVestingPosition) (*MsgCreateVestingPositionResponse, error)
+	// TransferVestingPosition defines a method that enables transferring a
+	// vesting position, with its unvested coins, to another account.
+	TransferVestingPosition(context.Context, *MsgTransferVestingPosition) (*MsgTransferVestingPositionResponse, error)
+	// AcceptVestingPosition defines a method that enables accepting a vesting
+	// position offered to an account.
+	AcceptVestingPosition(context.Context, *MsgAcceptVestingPosition) (*MsgAcceptVestingPositionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -147,6 +195,15 @@ func (UnimplementedMsgServer) CreateClawbackVestingAccount(context.Context, *Msg
 func (UnimplementedMsgServer) Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
 }
+func (UnimplementedMsgServer) CreateVestingPosition(context.Context, *MsgCreateVestingPosition) (*MsgCreateVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingPosition not implemented")
+}
+func (UnimplementedMsgServer) TransferVestingPosition(context.Context, *MsgTransferVestingPosition) (*MsgTransferVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVestingPosition not implemented")
+}
+func (UnimplementedMsgServer) AcceptVestingPosition(context.Context, *MsgAcceptVestingPosition) (*MsgAcceptVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptVestingPosition not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +307,60 @@ func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateVestingPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingPosition(ctx, req.(*MsgCreateVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferVestingPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVestingPosition(ctx, req.(*MsgTransferVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptVestingPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptVestingPosition(ctx, req.(*MsgAcceptVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +388,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Clawback",
 			Handler:    _Msg_Clawback_Handler,
 		},
+		{
+			MethodName: "CreateVestingPosition",
+			Handler:    _Msg_CreateVestingPosition_Handler,
+		},
+		{
+			MethodName: "TransferVestingPosition",
+			Handler:    _Msg_TransferVestingPosition_Handler,
+		},
+		{
+			MethodName: "AcceptVestingPosition",
+			Handler:    _Msg_AcceptVestingPosition_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -192,7 +192,7 @@ func TestClawback(t *testing.T) {
 			// set up simapp and validators
 			app := simapp.Setup(t, false)
 			ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime((now))
-			valAddr, val := createValidator(t, ctx, app, 100)
+			valAddr, _ := createValidator(t, ctx, app, 100)
 			require.Equal(t, "stake", app.StakingKeeper.BondDenom(ctx))
 
 			bacc, origCoins := initBaseAccount()
@@ -207,17 +207,13 @@ func TestClawback(t *testing.T) {
 			require.Equal(t, int64(1000), app.BankKeeper.GetBalance(ctx, addr, feeDenom).Amount.Int64())
 			require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, addr, stakeDenom).Amount.Int64())
 
-			// try delegating, clawback vesting account not allowed to delegate
-			_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(65), stakingtypes.Unbonded, val, true)
-			require.Error(t, err)
-
 			// undelegation should emit an error(delegator does not contain delegation)
 			_, err = app.StakingKeeper.Undelegate(ctx, addr, valAddr, sdk.NewDec(5))
 			require.Error(t, err)
 
 			ctx = ctx.WithBlockTime(tc.ctxTime)
 			va = app.AccountKeeper.GetAccount(ctx, addr).(*vesting.ClawbackVestingAccount)
-			clawbackAction := vesting.NewClawbackAction(funder, funder, app.AccountKeeper, app.BankKeeper)
+			clawbackAction := vesting.NewClawbackAction(funder, funder, app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 			err = va.Clawback(ctx, clawbackAction)
 			require.NoError(t, err)
 			app.AccountKeeper.SetAccount(ctx, va)
@@ -234,6 +230,68 @@ func TestClawback(t *testing.T) {
 	}
 }
 
+func TestClawbackDelegated(t *testing.T) {
+	c := sdk.NewCoins
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+
+	lockupPeriods := vesting.Periods{
+		{Length: int64(12 * 3600), Amount: c(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := vesting.Periods{
+		{Length: int64(8 * 3600), Amount: c(fee(1000))},  // 8am
+		{Length: int64(1 * 3600), Amount: c(stake(100))}, // 9am
+	}
+
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(now)
+	valAddr, val := createValidator(t, ctx, app, 100)
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := vesting.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	addr := va.GetAddress()
+	app.AccountKeeper.SetAccount(ctx, va)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, addr, origCoins))
+
+	// delegate most of the unvested stake, and start unbonding part of it
+	_, err := app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(65), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Undelegate(ctx, addr, valAddr, sdk.NewDec(5))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(7 * time.Hour))
+	va = app.AccountKeeper.GetAccount(ctx, addr).(*vesting.ClawbackVestingAccount)
+	require.Equal(t, c(stake(65)), va.DelegatedVesting)
+
+	// the preview doesn't alter the state
+	preview, err := va.PreviewClawback(ctx, funder, app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	require.NoError(t, err)
+	require.Equal(t, c(fee(1000), stake(35)), preview.Spendable)
+	require.Equal(t, c(stake(5)), preview.Unbonding)
+	require.Equal(t, c(stake(60)), preview.Delegated)
+	require.Equal(t, c(stake(65)), va.DelegatedVesting)
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, funder).IsZero())
+
+	err = va.Clawback(ctx, vesting.NewClawbackAction(funder, funder, app.AccountKeeper, app.BankKeeper, app.StakingKeeper))
+	require.NoError(t, err)
+
+	// the unvested tokens are transferred with their staking state
+	require.Equal(t, c(fee(1000), stake(35)), app.BankKeeper.GetAllBalances(ctx, funder))
+	require.Equal(t, sdk.NewInt(60), app.StakingKeeper.GetDelegatorBonded(ctx, funder))
+	require.Equal(t, sdk.NewInt(5), app.StakingKeeper.GetDelegatorUnbonding(ctx, funder))
+
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+	require.True(t, app.StakingKeeper.GetDelegatorBonded(ctx, addr).IsZero())
+	require.True(t, app.StakingKeeper.GetDelegatorUnbonding(ctx, addr).IsZero())
+	va = app.AccountKeeper.GetAccount(ctx, addr).(*vesting.ClawbackVestingAccount)
+	require.True(t, va.OriginalVesting.IsZero())
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.True(t, va.DelegatedFree.IsZero())
+}
+
 // createValidator creates a validator in the given SimApp.
 func createValidator(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers int64) (sdk.ValAddress, stakingtypes.Validator) {
 	valTokens := sdk.TokensFromConsensusPower(powers, sdk.DefaultPowerReduction)
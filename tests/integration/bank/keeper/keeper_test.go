@@ -29,9 +29,12 @@ import (
 	"cosmossdk.io/simapp"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingmodule "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingkeeper "github.com/cosmos/cosmos-sdk/x/auth/vesting/keeper"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -87,6 +90,7 @@ type IntegrationTestSuite struct {
 	bankKeeper    keeper.BaseKeeper
 	accountKeeper authkeeper.AccountKeeper
 	stakingKeeper *stakingkeeper.Keeper
+	vestingKeeper vestingkeeper.Keeper
 	ctx           sdk.Context
 	appCodec      codec.Codec
 	authConfig    *authmodulev1.Module
@@ -131,7 +135,7 @@ func (suite *IntegrationTestSuite) SetupTest() {
 			configurator.ParamsModule(),
 			configurator.ConsensusModule(),
 			configurator.VestingModule()),
-		&suite.accountKeeper, &suite.bankKeeper, &suite.stakingKeeper,
+		&suite.accountKeeper, &suite.bankKeeper, &suite.stakingKeeper, &suite.vestingKeeper,
 		&interfaceRegistry, &suite.appCodec, &suite.authConfig)
 	suite.NoError(err)
 
@@ -969,6 +973,40 @@ func (suite *IntegrationTestSuite) TestDelegateCoins_Invalid() {
 	suite.Require().Error(suite.bankKeeper.DelegateCoins(ctx, addr1, addrModule, origCoins.Add(origCoins...)))
 }
 
+func (suite *IntegrationTestSuite) TestDelegateCoins_VestingPosition() {
+	ctx := suite.ctx
+	now := ctx.BlockTime().Unix()
+
+	positionCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	unlockedCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addrModule))
+	suite.Require().NoError(testutil.FundAccount(suite.bankKeeper, ctx, addr1, positionCoins.Add(unlockedCoins...)))
+
+	// addr1 holds a vesting position offered to addr2
+	position, err := suite.vestingKeeper.CreateVestingPosition(ctx, addr1, funder, positionCoins, now, 0, now+100)
+	suite.Require().NoError(err)
+	position, err = suite.vestingKeeper.OfferVestingPosition(ctx, position, addr2)
+	suite.Require().NoError(err)
+
+	// only the coins not locked by the position can be delegated
+	suite.Require().ErrorIs(suite.bankKeeper.DelegateCoins(ctx, addr1, addrModule, positionCoins), sdkerrors.ErrInsufficientFunds)
+	suite.Require().NoError(suite.bankKeeper.DelegateCoins(ctx, addr1, addrModule, unlockedCoins))
+	suite.Require().ErrorIs(suite.bankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), sdkerrors.ErrInsufficientFunds)
+
+	// the position can still be transferred
+	msgServer := vestingmodule.NewMsgServerImpl(suite.accountKeeper, suite.bankKeeper, suite.stakingKeeper, suite.vestingKeeper)
+	_, err = msgServer.AcceptVestingPosition(ctx, vesting.NewMsgAcceptVestingPosition(addr2, position.Id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(positionCoins, suite.bankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(ctx, addr1).IsZero())
+}
+
 func (suite *IntegrationTestSuite) TestUndelegateCoins() {
 	ctx := suite.ctx
 	now := tmtime.Now()
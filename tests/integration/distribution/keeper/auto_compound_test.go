@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAutoCompoundDelegations(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+
+	// create validator with 50% commission and a delegation of the same amount
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk0, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	tstaking.Ctx = ctx
+
+	// process a single delegation per block
+	params := distrKeeper.GetParams(ctx)
+	params.AutoCompoundBatchSize = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0], true))
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addrs[1], valAddrs[0], true))
+	require.ErrorIs(t, distrKeeper.SetDelegationAutoCompound(ctx, addrs[1], valAddrs[1], true), disttypes.ErrNoDelegationExists)
+	require.Len(t, distrKeeper.GetAllAutoCompoundDelegations(ctx), 2)
+
+	// allocate rewards, half of which is the validator commission
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, disttypes.ModuleName, tokens))
+	val := stakingKeeper.Validator(ctx, valAddrs[0])
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(tokens...))
+
+	delegationTokens := func(delAddr sdk.AccAddress) math.LegacyDec {
+		validator, found := stakingKeeper.GetValidator(ctx, valAddrs[0])
+		require.True(t, found)
+		delegation, found := stakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+		require.True(t, found)
+		return validator.TokensFromShares(delegation.Shares)
+	}
+
+	// the delegations are compounded one per block, in key order
+	first, second := addrs[1], sdk.AccAddress(valAddrs[0])
+	if string(disttypes.GetAutoCompoundKey(second, valAddrs[0])) < string(disttypes.GetAutoCompoundKey(first, valAddrs[0])) {
+		first, second = second, first
+	}
+
+	distrKeeper.AutoCompoundDelegations(ctx)
+	require.Equal(t, math.LegacyNewDec(105), delegationTokens(first))
+	require.Equal(t, math.LegacyNewDec(100), delegationTokens(second))
+
+	distrKeeper.AutoCompoundDelegations(ctx)
+	require.Equal(t, math.LegacyNewDec(105), delegationTokens(first))
+	require.Equal(t, math.LegacyNewDec(105), delegationTokens(second))
+
+	// the commission is not compounded
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10))), distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission)
+
+	// the auto-compounding stops with the removal of the delegation
+	tstaking.Undelegate(addrs[1], valAddrs[0], delegationTokens(addrs[1]).TruncateInt(), true)
+	require.False(t, distrKeeper.IsAutoCompoundDelegation(ctx, addrs[1], valAddrs[0]))
+	require.True(t, distrKeeper.IsAutoCompoundDelegation(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0]))
+}
+
+func TestAutoCompoundGasLimit(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk0, sdk.NewInt(100), true)
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addrs[0], valAddrs[0], true))
+
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, disttypes.ModuleName, tokens))
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), sdk.NewDecCoinsFromCoins(tokens...))
+
+	// compounding out of gas leaves the delegation untouched
+	params := distrKeeper.GetParams(ctx)
+	params.AutoCompoundGasLimit = 1000
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	distrKeeper.AutoCompoundDelegations(ctx)
+	delegation, found := stakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(100), delegation.Shares)
+
+	params.AutoCompoundGasLimit = disttypes.DefaultAutoCompoundGasLimit
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	distrKeeper.AutoCompoundDelegations(ctx)
+	delegation, found = stakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDec(110), delegation.Shares)
+}
+
+func TestAutoCompoundDuringEpoch(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 1, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk0, 100, true)
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(2)
+	require.Equal(t, int64(100), stakingKeeper.GetLastValidatorPower(ctx, valAddrs[0]))
+
+	// the staking operations are applied at the end of the epoch
+	stakingParams := stakingKeeper.GetParams(ctx)
+	stakingParams.EpochLength = 10
+	require.NoError(t, stakingKeeper.SetParams(ctx, stakingParams))
+
+	require.NoError(t, distrKeeper.SetDelegationAutoCompound(ctx, addrs[0], valAddrs[0], true))
+
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, disttypes.ModuleName, tokens))
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), sdk.NewDecCoinsFromCoins(tokens...))
+
+	// the compounded rewards are queued and don't change the validator power
+	// before the end of the epoch
+	distrKeeper.AutoCompoundDelegations(ctx)
+	require.Len(t, stakingKeeper.GetAllQueuedOperations(ctx), 1)
+	validator, found := stakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction), validator.Tokens)
+
+	ctx = ctx.WithBlockHeight(9)
+	staking.EndBlocker(ctx, stakingKeeper)
+	require.Equal(t, int64(100), stakingKeeper.GetLastValidatorPower(ctx, valAddrs[0]))
+
+	ctx = ctx.WithBlockHeight(10)
+	staking.EndBlocker(ctx, stakingKeeper)
+	require.Empty(t, stakingKeeper.GetAllQueuedOperations(ctx))
+	require.Equal(t, int64(110), stakingKeeper.GetLastValidatorPower(ctx, valAddrs[0]))
+}
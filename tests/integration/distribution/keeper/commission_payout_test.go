@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawValidatorCommissionPayoutSplit(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk0, sdk.NewInt(100), true)
+
+	recipients := []disttypes.CommissionPayoutRecipient{
+		disttypes.NewCommissionPayoutRecipient(addrs[1], sdk.NewDecWithPrec(6, 1)),
+		disttypes.NewCommissionPayoutRecipient(addrs[2], sdk.NewDecWithPrec(3, 1)),
+	}
+
+	// the weights must sum to 1, the recipients are limited by the params and
+	// must be allowed to receive funds
+	require.ErrorIs(t, distrKeeper.SetCommissionPayoutSplit(ctx, valAddrs[0], recipients), disttypes.ErrInvalidCommissionPayoutSplit)
+	recipients[1].Weight = sdk.NewDecWithPrec(4, 1)
+
+	params := distrKeeper.GetParams(ctx)
+	params.MaxCommissionPayoutRecipients = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	require.ErrorIs(t, distrKeeper.SetCommissionPayoutSplit(ctx, valAddrs[0], recipients), disttypes.ErrInvalidCommissionPayoutSplit)
+
+	moduleAddr := authtypes.NewModuleAddress(disttypes.ModuleName)
+	require.Error(t, distrKeeper.SetCommissionPayoutSplit(ctx, valAddrs[0], []disttypes.CommissionPayoutRecipient{
+		disttypes.NewCommissionPayoutRecipient(moduleAddr, math.LegacyOneDec()),
+	}))
+	require.ErrorIs(t, distrKeeper.SetCommissionPayoutSplit(ctx, valAddrs[1], nil), disttypes.ErrNoValidatorExists)
+
+	// the truncated rest of the commission goes to the operator
+	params.MaxCommissionPayoutRecipients = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	require.NoError(t, distrKeeper.SetCommissionPayoutSplit(ctx, valAddrs[0], recipients))
+
+	tokens := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(11)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, disttypes.ModuleName, tokens))
+	distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], disttypes.ValidatorAccumulatedCommission{Commission: sdk.NewDecCoinsFromCoins(tokens...)})
+	distrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], disttypes.ValidatorOutstandingRewards{Rewards: sdk.NewDecCoinsFromCoins(tokens...)})
+
+	operatorBalance := bankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom).Amount
+	commission, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, tokens, commission)
+
+	require.Equal(t, sdk.NewInt(1006), bankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(1004), bankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, operatorBalance.AddRaw(1), bankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom).Amount)
+
+	// an empty list of recipients removes the split
+	require.NoError(t, distrKeeper.SetCommissionPayoutSplit(ctx, valAddrs[0], nil))
+	_, found := distrKeeper.GetCommissionPayoutSplit(ctx, valAddrs[0])
+	require.False(t, found)
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawDenomDelegationRewards(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk0, sdk.NewInt(100), true)
+
+	params := stakingKeeper.GetParams(ctx)
+	params.WeightedBondDenoms = []stakingtypes.WeightedBondDenom{{Denom: "ustable", Weight: sdk.NewDecWithPrec(5, 1)}}
+	require.NoError(t, stakingKeeper.SetParams(ctx, params))
+
+	stable := sdk.NewCoins(sdk.NewCoin("ustable", sdk.NewInt(200)))
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, addrs[1], stable))
+
+	val := stakingKeeper.Validator(ctx, valAddrs[0])
+	_, err = stakingKeeper.DelegateDenom(ctx, addrs[1], val.(stakingtypes.Validator), stable[0])
+	require.NoError(t, err)
+
+	// the stake weighs 100 tokens against the 100 tokens of the validator, so
+	// it earns half of the rewards left after the commission
+	val = stakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, disttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	expected := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(25))}
+	require.Equal(t, expected, distrKeeper.GetValidatorCurrentRewards(ctx, valAddrs[0]).Rewards)
+
+	delegation, found := stakingKeeper.GetDenomDelegation(ctx, addrs[1], valAddrs[0], "ustable")
+	require.True(t, found)
+	rewards, err := distrKeeper.CalculateDenomDelegationRewards(ctx, delegation)
+	require.NoError(t, err)
+	require.Equal(t, expected, rewards)
+
+	balance := bankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount
+	withdrawn, err := distrKeeper.WithdrawDenomDelegationRewards(ctx, addrs[1], valAddrs[0], "ustable")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(25))), withdrawn)
+	require.Equal(t, balance.AddRaw(25), bankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).Amount)
+
+	// nothing is left to withdraw, and the outstanding rewards were reduced
+	rewards, err = distrKeeper.CalculateDenomDelegationRewards(ctx, delegation)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(75))}, distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0]))
+
+	// undelegating the whole stake removes the reward index of the delegation
+	_, err = stakingKeeper.UndelegateDenom(ctx, addrs[1], valAddrs[0], stable[0])
+	require.NoError(t, err)
+	_, found = distrKeeper.GetDenomDelegatorRewardIndex(ctx, addrs[1], valAddrs[0], "ustable")
+	require.False(t, found)
+
+	_, err = distrKeeper.WithdrawDenomDelegationRewards(ctx, addrs[1], valAddrs[0], "ustable")
+	require.ErrorIs(t, err, disttypes.ErrEmptyDelegationDistInfo)
+}
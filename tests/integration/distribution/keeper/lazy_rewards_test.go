@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestLazyRewardAccountingEquivalence(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, stakingKeeper.TokensFromConsensusPower(ctx, 1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+
+	// create a validator with 50% commission, and one with 10% commission and a delegation
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk0, 100, true)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(addrs[2], valAddrs[1], 200)
+
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the eager reward accounting allocates to the validators of the votes,
+	// the lazy one to the validators by their last power, so that both are
+	// equivalent when all the bonded validators vote
+	eagerCtx, _ := ctx.CacheContext()
+	lazyCtx, _ := ctx.CacheContext()
+
+	params := distrKeeper.GetParams(lazyCtx)
+	params.LazyRewardAccounting = true
+	require.NoError(t, distrKeeper.SetParams(lazyCtx, params))
+	require.True(t, distrKeeper.UpdateRewardAccountingMode(lazyCtx))
+	require.False(t, distrKeeper.UpdateRewardAccountingMode(eagerCtx))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1003)))
+	allocate := func() {
+		var votes []abci.VoteInfo
+		var totalPower int64
+		stakingKeeper.IterateLastValidatorPowers(eagerCtx, func(valAddr sdk.ValAddress, power int64) bool {
+			consAddr, err := stakingKeeper.Validator(eagerCtx, valAddr).GetConsAddr()
+			require.NoError(t, err)
+			votes = append(votes, abci.VoteInfo{
+				Validator:       abci.Validator{Address: consAddr, Power: power},
+				SignedLastBlock: true,
+			})
+			totalPower += power
+			return false
+		})
+
+		require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, eagerCtx, authtypes.FeeCollectorName, fees))
+		distrKeeper.AllocateTokens(eagerCtx, totalPower, votes)
+
+		require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, lazyCtx, authtypes.FeeCollectorName, fees))
+		distrKeeper.AllocateTokensLazy(lazyCtx)
+	}
+
+	rewards := func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.DecCoins {
+		ctx, _ = ctx.CacheContext()
+		val := stakingKeeper.Validator(ctx, valAddr)
+		endingPeriod := distrKeeper.IncrementValidatorPeriod(ctx, val)
+		return distrKeeper.CalculateDelegationRewards(ctx, val, stakingKeeper.Delegation(ctx, delAddr, valAddr), endingPeriod)
+	}
+	requireEquivalent := func() {
+		for _, del := range []struct {
+			delAddr sdk.AccAddress
+			valAddr sdk.ValAddress
+		}{
+			{addrs[0], valAddrs[0]},
+			{addrs[1], valAddrs[1]},
+			{addrs[2], valAddrs[1]},
+			{addrs[2], valAddrs[0]},
+		} {
+			if stakingKeeper.Delegation(eagerCtx, del.delAddr, del.valAddr) == nil {
+				continue
+			}
+			requireDecCoinsApproxEqual(t, rewards(eagerCtx, del.delAddr, del.valAddr), rewards(lazyCtx, del.delAddr, del.valAddr))
+		}
+
+		for _, valAddr := range valAddrs[:2] {
+			lazyCommission, err := distrKeeper.WithdrawValidatorCommission(lazyCtx, valAddr)
+			require.NoError(t, err)
+			eagerCommission, err := distrKeeper.WithdrawValidatorCommission(eagerCtx, valAddr)
+			require.NoError(t, err)
+			requireDecCoinsApproxEqual(t, sdk.NewDecCoinsFromCoins(eagerCommission...), sdk.NewDecCoinsFromCoins(lazyCommission...))
+		}
+		requireDecCoinsApproxEqual(t, distrKeeper.GetFeePoolCommunityCoins(eagerCtx), distrKeeper.GetFeePoolCommunityCoins(lazyCtx))
+
+		msg, broken := keeper.AllInvariants(distrKeeper)(lazyCtx)
+		require.False(t, broken, msg)
+	}
+
+	allocate()
+	allocate()
+	requireEquivalent()
+
+	// the power of a validator changes after the end blocker
+	for _, c := range []sdk.Context{eagerCtx, lazyCtx} {
+		tstaking.Ctx = c
+		tstaking.DelegateWithPower(addrs[2], valAddrs[0], 100)
+		staking.EndBlocker(c, stakingKeeper)
+	}
+	allocate()
+	index, found := distrKeeper.GetValidatorRewardIndex(lazyCtx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(200), index.Power)
+	requireEquivalent()
+
+	// the commission of a validator changes between two allocations: the
+	// rewards accrued before the change are settled at the former commission
+	allocate()
+	newRate := sdk.NewDecWithPrec(2, 1)
+	msgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+	for _, c := range []*sdk.Context{&eagerCtx, &lazyCtx} {
+		*c = c.WithBlockTime(c.BlockTime().Add(stakingtypes.DefaultUnbondingTime))
+		msg := stakingtypes.NewMsgEditValidator(valAddrs[1], stakingtypes.Description{}, &newRate, nil)
+		_, err := msgServer.EditValidator(*c, msg)
+		require.NoError(t, err)
+	}
+	allocate()
+	requireEquivalent()
+
+	// the lazy reward accounting is settled when it is disabled
+	allocate()
+	params.LazyRewardAccounting = false
+	require.NoError(t, distrKeeper.SetParams(lazyCtx, params))
+	require.False(t, distrKeeper.UpdateRewardAccountingMode(lazyCtx))
+	_, found = distrKeeper.GetLazyRewardIndex(lazyCtx)
+	require.False(t, found)
+	requireEquivalent()
+}
+
+// requireDecCoinsApproxEqual asserts that the amounts only differ by the
+// truncation of the rewards, which is at most one token once truncated.
+func requireDecCoinsApproxEqual(t *testing.T, expected, actual sdk.DecCoins) {
+	t.Helper()
+	require.Equal(t, len(expected), len(actual), "expected %s, got %s", expected, actual)
+	for i := range expected {
+		require.Equal(t, expected[i].Denom, actual[i].Denom)
+		require.True(t, expected[i].Amount.Sub(actual[i].Amount).Abs().LTE(math.LegacyOneDec()), "expected %s, got %s", expected, actual)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"gotest.tools/v3/assert"
 
@@ -17,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/testutil"
@@ -193,6 +195,66 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	populateValidators(t, f)
+
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	for i := 0; i < 2; i++ {
+		tstaking.CreateValidatorWithValPower(valAddresses[i], pubkeys[i], power, true)
+	}
+	staking.EndBlocker(ctx, f.stakingKeeper)
+
+	// handle signatures to set signing infos
+	for i := 0; i < 2; i++ {
+		f.slashingKeeper.HandleValidatorSignature(ctx, pubkeys[i].Address(), power, true)
+	}
+
+	slashFraction := sdk.NewDecWithPrec(1, 1)
+	assert.NilError(t, f.evidenceKeeper.SetParams(ctx, types.NewParams(
+		types.NewEvidenceSlashFraction(types.TypeLightClientAttack, slashFraction),
+	)))
+	assert.Assert(t, f.evidenceKeeper.SlashFraction(ctx, types.TypeLightClientAttack).Equal(slashFraction))
+	assert.Assert(t, f.evidenceKeeper.SlashFraction(ctx, types.TypeEquivocation).Equal(f.slashingKeeper.SlashFractionDoubleSign(ctx)))
+
+	oldTokens := f.stakingKeeper.Validator(ctx, valAddresses[0]).GetTokens()
+
+	// both byzantine validators of the attack are reported at its common height
+	var misbehaviors []abci.Misbehavior
+	for i := 0; i < 2; i++ {
+		misbehaviors = append(misbehaviors, abci.Misbehavior{
+			Type:             abci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
+			Validator:        abci.Validator{Address: pubkeys[i].Address(), Power: power},
+			Height:           1,
+			Time:             time.Unix(0, 0),
+			TotalVotingPower: 2 * power,
+		})
+	}
+	evidence.BeginBlocker(ctx, abci.RequestBeginBlock{ByzantineValidators: misbehaviors}, f.evidenceKeeper)
+
+	for i := 0; i < 2; i++ {
+		validator := f.stakingKeeper.Validator(ctx, valAddresses[i])
+		assert.Assert(t, validator.IsJailed())
+		assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(pubkeys[i].Address())))
+
+		expTokens := oldTokens.Sub(sdk.NewDecFromInt(oldTokens).Mul(slashFraction).TruncateInt())
+		assert.Assert(t, validator.GetTokens().Equal(expTokens), "expected %s, got %s", expTokens, validator.GetTokens())
+	}
+
+	evidences := f.evidenceKeeper.GetAllEvidence(ctx)
+	assert.Equal(t, len(evidences), 2)
+	for _, e := range evidences {
+		lca, ok := e.(*types.LightClientAttack)
+		assert.Assert(t, ok)
+		assert.Equal(t, lca.Height, int64(1))
+		assert.Equal(t, lca.TotalPower, 2*power)
+	}
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
@@ -474,3 +474,61 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestPreviewTallyDelegatorOverride(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults, validators := app.GovKeeper.PreviewTally(ctx, proposal)
+
+	tokens := func(power int64) string { return app.StakingKeeper.TokensFromConsensusPower(ctx, power).String() }
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, tokens(13), tallyResults.YesCount)
+	require.Equal(t, tokens(30), tallyResults.NoCount)
+
+	// the first validator did not vote, its delegator overrode most of its power,
+	// the self-delegation of a voting validator is tallied as its operator's vote
+	require.Len(t, validators, 4)
+	require.Equal(t, valAddrs[0].String(), validators[0].ValidatorAddress)
+	require.Equal(t, tokens(5), validators[0].InheritedPower)
+	require.Equal(t, tokens(30), validators[0].OverriddenPower)
+	require.Empty(t, validators[0].Options)
+	require.Equal(t, valAddrs[2].String(), validators[1].ValidatorAddress)
+	require.Equal(t, "0", validators[1].InheritedPower)
+	require.Equal(t, tokens(7), validators[1].OverriddenPower)
+	require.Equal(t, v1.NewNonSplitVoteOption(v1.OptionYes), v1.WeightedVoteOptions(validators[1].Options))
+
+	// the preview does not delete the votes, the tally matches it
+	_, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[4])
+	require.True(t, found)
+
+	passes, burnDeposits, finalResults := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, tallyResults, finalResults)
+}
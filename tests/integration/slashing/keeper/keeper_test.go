@@ -232,6 +232,163 @@ func (s *KeeperTestSuite) TestHandleAlreadyJailed() {
 	s.Require().Equal(resultingTokens, validator.GetTokens())
 }
 
+// Test that a downtime slash is delayed by the slash delay, and can be cancelled
+func (s *KeeperTestSuite) TestHandleDelayedDowntimeSlash() {
+	// initial setup
+	ctx := s.ctx
+
+	params := s.slashingKeeper.GetParams(ctx)
+	params.SlashDelay = time.Hour
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	// 1000 first blocks OK
+	height := int64(0)
+	for ; height < s.slashingKeeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	// 501 blocks missed
+	for ; height < s.slashingKeeper.SignedBlocksWindow(ctx)+(s.slashingKeeper.SignedBlocksWindow(ctx)-s.slashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	// end block
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	// validator should have been jailed but not slashed yet
+	validator, _ := s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	s.Require().Equal(stakingtypes.Unbonding, validator.GetStatus())
+	s.Require().Equal(amt, validator.GetTokens())
+
+	res, err := s.queryClient.PendingSlashes(ctx, &slashingtypes.QueryPendingSlashesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingSlashes, 1)
+	pendingSlash := res.PendingSlashes[0]
+	s.Require().Equal(sdk.GetConsAddress(val).String(), pendingSlash.ValidatorAddress)
+	s.Require().Equal(stakingtypes.Infraction_INFRACTION_DOWNTIME, pendingSlash.Infraction)
+
+	slashRes, err := s.queryClient.PendingSlash(ctx, &slashingtypes.QueryPendingSlashRequest{Id: pendingSlash.Id})
+	s.Require().NoError(err)
+	s.Require().Len(slashRes.AffectedDelegators, 1)
+	s.Require().Equal(sdk.AccAddress(addr).String(), slashRes.AffectedDelegators[0].DelegatorAddress)
+
+	// the slash is executed once the delay has elapsed
+	ctx = ctx.WithBlockTime(pendingSlash.ExecutionTime)
+	s.slashingKeeper.ExecutePendingSlashes(ctx)
+
+	validator, _ = s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	resultingTokens := amt.Sub(s.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+	s.Require().Equal(resultingTokens, validator.GetTokens())
+	s.Require().Empty(s.slashingKeeper.GetPendingSlashes(ctx))
+
+	// a cancelled slash is never executed
+	s.slashingKeeper.SlashWithInfractionReason(ctx, sdk.GetConsAddress(val), params.SlashFractionDowntime, power, height, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	pendingSlashes := s.slashingKeeper.GetPendingSlashes(ctx)
+	s.Require().Len(pendingSlashes, 1)
+
+	_, err = s.msgServer.CancelPendingSlash(ctx, &slashingtypes.MsgCancelPendingSlash{
+		Authority: s.slashingKeeper.GetAuthority(),
+		Id:        pendingSlashes[0].Id,
+	})
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(pendingSlashes[0].ExecutionTime)
+	s.slashingKeeper.ExecutePendingSlashes(ctx)
+
+	validator, _ = s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	s.Require().Equal(resultingTokens, validator.GetTokens())
+}
+
+// Test that repeat downtime offences are penalized with escalating penalties
+func (s *KeeperTestSuite) TestHandleRepeatDowntime() {
+	// initial setup
+	ctx := s.ctx
+
+	params := s.slashingKeeper.GetParams(ctx)
+	params.DowntimeOffenceDecayWindow = 24 * time.Hour
+	params.RepeatDowntimePenalties = []slashingtypes.DowntimePenalty{
+		{JailDuration: 2 * params.DowntimeJailDuration, SlashFraction: params.SlashFractionDowntime.MulInt64(2)},
+	}
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.GetConsAddress(val)
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	height := int64(0)
+	// missDowntimeWindow signs a window of blocks then misses enough blocks to be jailed
+	missDowntimeWindow := func() {
+		signInfo, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		s.Require().True(found)
+		start := signInfo.StartHeight
+		for ; height < start+s.slashingKeeper.SignedBlocksWindow(ctx); height++ {
+			ctx = ctx.WithBlockHeight(height)
+			s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+		}
+		for ; height < start+s.slashingKeeper.SignedBlocksWindow(ctx)+(s.slashingKeeper.SignedBlocksWindow(ctx)-s.slashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, s.stakingKeeper)
+	}
+
+	// a first offence is penalized with the base penalty
+	missDowntimeWindow()
+	validator, _ := s.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	// the slashed amount is computed from the power at the infraction height
+	powerTokens := sdk.NewDecFromInt(s.stakingKeeper.TokensFromConsensusPower(ctx, power))
+	tokens := amt.Sub(powerTokens.Mul(params.SlashFractionDowntime).TruncateInt())
+	s.Require().Equal(tokens, validator.GetTokens())
+
+	signInfo, _ := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().Equal(ctx.BlockTime().Add(params.DowntimeJailDuration), signInfo.JailedUntil)
+	s.Require().Len(signInfo.DowntimeOffences, 1)
+
+	res, err := s.queryClient.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.PriorDowntimeOffences)
+	s.Require().Equal(params.RepeatDowntimePenalties[0], res.NextDowntimePenalty)
+
+	// unjail and commit a second offence within the decay window
+	ctx = ctx.WithBlockTime(signInfo.JailedUntil)
+	s.Require().NoError(s.slashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	missDowntimeWindow()
+	validator, _ = s.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	tokens = tokens.Sub(powerTokens.Mul(params.RepeatDowntimePenalties[0].SlashFraction).TruncateInt())
+	s.Require().Equal(tokens, validator.GetTokens())
+
+	signInfo, _ = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().Equal(ctx.BlockTime().Add(params.RepeatDowntimePenalties[0].JailDuration), signInfo.JailedUntil)
+	s.Require().Len(signInfo.DowntimeOffences, 2)
+
+	// the offences decay after the decay window
+	signInfo.PruneDowntimeOffences(ctx.BlockTime().Add(params.DowntimeOffenceDecayWindow), params.DowntimeOffenceDecayWindow)
+	s.Require().Empty(signInfo.DowntimeOffences)
+}
+
 // Test a validator dipping in and out of the validator set
 // Ensure that missed blocks are tracked correctly and that
 // the start height of the signing info is reset correctly
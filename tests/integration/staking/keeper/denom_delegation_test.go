@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDenomDelegation(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	keeper := app.StakingKeeper
+
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+
+	// only whitelisted denoms can be delegated
+	stable := sdk.NewCoin("ustable", keeper.TokensFromConsensusPower(ctx, 20))
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, addrDels[0], sdk.NewCoins(stable)))
+	_, err := keeper.DelegateDenom(ctx, addrDels[0], validator, stable)
+	require.ErrorIs(t, err, types.ErrBondDenomNotWhitelisted)
+
+	params := keeper.GetParams(ctx)
+	params.WeightedBondDenoms = []types.WeightedBondDenom{{Denom: "ustable", Weight: sdk.NewDecWithPrec(5, 1)}}
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	// the stake counts toward the voting power by the weight of its denom
+	_, err = keeper.DelegateDenom(ctx, addrDels[0], validator, stable)
+	require.NoError(t, err)
+
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, keeper.TokensFromConsensusPower(ctx, 10), validator.WeightedTokens)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, int64(20), keeper.GetLastValidatorPower(ctx, addrVals[0]))
+
+	bondedPool := keeper.GetBondedPool(ctx)
+	require.Equal(t, stable, app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), "ustable"))
+
+	// halving the weight halves the weighted tokens
+	params.WeightedBondDenoms[0].Weight = sdk.NewDecWithPrec(25, 2)
+	require.NoError(t, keeper.SetParams(ctx, params))
+	require.NoError(t, keeper.RefreshValidatorWeightedTokens(ctx))
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, keeper.TokensFromConsensusPower(ctx, 5), validator.WeightedTokens)
+
+	// the slash is shared between the tokens and the stake by their power
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 15, sdk.NewDecWithPrec(5, 1))
+
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(t, keeper.TokensFromConsensusPower(ctx, 5), validator.Tokens)
+	stake, found := keeper.GetValidatorDenomStake(ctx, addrVals[0], "ustable")
+	require.True(t, found)
+	require.Equal(t, keeper.TokensFromConsensusPower(ctx, 10), stake.Tokens)
+	require.Equal(t, keeper.TokensFromConsensusPower(ctx, 10).QuoRaw(4), validator.WeightedTokens)
+
+	// undelegating moves the stake to the not bonded pool until it matures
+	slashed := sdk.NewCoin("ustable", stake.Tokens)
+	completionTime, err := keeper.UndelegateDenom(ctx, addrDels[0], addrVals[0], slashed)
+	require.NoError(t, err)
+
+	_, found = keeper.GetDenomDelegation(ctx, addrDels[0], addrVals[0], "ustable")
+	require.False(t, found)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, validator.WeightedTokens.IsZero())
+
+	ubd, found := keeper.GetDenomUnbondingDelegation(ctx, addrDels[0], addrVals[0], "ustable")
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, slashed.Amount, ubd.Entries[0].Balance)
+
+	ctx = ctx.WithBlockTime(completionTime.Add(-time.Second))
+	staking.EndBlocker(ctx, keeper)
+	require.True(t, app.BankKeeper.GetBalance(ctx, addrDels[0], "ustable").IsZero())
+
+	ctx = ctx.WithBlockTime(completionTime)
+	staking.EndBlocker(ctx, keeper)
+	require.Equal(t, slashed, app.BankKeeper.GetBalance(ctx, addrDels[0], "ustable"))
+	_, found = keeper.GetDenomUnbondingDelegation(ctx, addrDels[0], addrVals[0], "ustable")
+	require.False(t, found)
+}
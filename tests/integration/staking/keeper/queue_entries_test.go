@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestUnbondingAndRedelegationQueueEntries(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	stakingKeeper := app.StakingKeeper
+	querier := keeper.Querier{Keeper: stakingKeeper}
+	bondDenom := stakingKeeper.BondDenom(ctx)
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(startTime)
+
+	params := stakingKeeper.GetParams(ctx)
+	params.WeightedBondDenoms = []types.WeightedBondDenom{{Denom: "ustable", Weight: sdk.NewDecWithPrec(5, 1)}}
+	require.NoError(t, stakingKeeper.SetParams(ctx, params))
+
+	validator, found := stakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	_, err := stakingKeeper.Delegate(ctx, addrDels[0], sdk.NewInt(3000), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	stable := sdk.NewInt64Coin("ustable", 500)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, addrDels[0], sdk.NewCoins(stable)))
+	validator, _ = stakingKeeper.GetValidator(ctx, addrVals[0])
+	_, err = stakingKeeper.DelegateDenom(ctx, addrDels[0], validator, stable)
+	require.NoError(t, err)
+
+	// two unbonding entries an hour apart, a denom unbonding and a redelegation
+	shares, err := stakingKeeper.ValidateUnbondAmount(ctx, addrDels[0], addrVals[0], sdk.NewInt(1000))
+	require.NoError(t, err)
+	firstCompletion, err := stakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], shares)
+	require.NoError(t, err)
+
+	_, err = stakingKeeper.UndelegateDenom(ctx, addrDels[0], addrVals[0], stable)
+	require.NoError(t, err)
+
+	_, err = stakingKeeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], shares)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	secondCompletion, err := stakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], shares)
+	require.NoError(t, err)
+
+	res, err := querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime: firstCompletion,
+		EndTime:   secondCompletion,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, firstCompletion, res.Entries[0].CompletionTime)
+	require.Equal(t, secondCompletion, res.Entries[2].CompletionTime)
+	require.Equal(t, []types.QueueTotal{
+		{ValidatorAddress: addrVals[0].String(), Amount: sdk.NewInt64Coin(bondDenom, 2000), Entries: 2},
+		{ValidatorAddress: addrVals[0].String(), Amount: stable, Entries: 1},
+	}, res.Totals)
+
+	// the entries are paginated by completion time, the totals are those of
+	// the whole window
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime:  firstCompletion,
+		EndTime:    secondCompletion,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, firstCompletion, res.Entries[1].CompletionTime)
+	require.Equal(t, []types.QueueTotal{
+		{ValidatorAddress: addrVals[0].String(), Amount: sdk.NewInt64Coin(bondDenom, 2000), Entries: 2},
+		{ValidatorAddress: addrVals[0].String(), Amount: stable, Entries: 1},
+	}, res.Totals)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime:  firstCompletion,
+		EndTime:    secondCompletion,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, secondCompletion, res.Entries[0].CompletionTime)
+	require.Len(t, res.Totals, 2)
+	require.Nil(t, res.Pagination.NextKey)
+
+	_, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime:  firstCompletion,
+		EndTime:    secondCompletion,
+		Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(1), Offset: 1},
+	})
+	require.Error(t, err)
+
+	_, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime:  firstCompletion,
+		EndTime:    secondCompletion,
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	require.Error(t, err)
+
+	// the window is inclusive and can be narrowed to a single completion time
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime:     secondCompletion,
+		EndTime:       secondCompletion,
+		ValidatorAddr: addrVals[0].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1000), res.Entries[0].Balance)
+
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime:     firstCompletion,
+		EndTime:       secondCompletion,
+		ValidatorAddr: addrVals[1].String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	redRes, err := querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{
+		StartTime: firstCompletion,
+		EndTime:   secondCompletion,
+	})
+	require.NoError(t, err)
+	require.Len(t, redRes.Entries, 1)
+	require.Equal(t, addrVals[1].String(), redRes.Entries[0].ValidatorDstAddress)
+	require.Equal(t, []types.QueueTotal{
+		{ValidatorAddress: addrVals[0].String(), Amount: sdk.NewInt64Coin(bondDenom, 1000), Entries: 1},
+	}, redRes.Totals)
+
+	redRes, err = querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{
+		StartTime:  firstCompletion,
+		EndTime:    secondCompletion,
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	require.NoError(t, err)
+	require.Empty(t, redRes.Entries)
+	require.Equal(t, []types.QueueTotal{
+		{ValidatorAddress: addrVals[0].String(), Amount: sdk.NewInt64Coin(bondDenom, 1000), Entries: 1},
+	}, redRes.Totals)
+
+	_, err = querier.RedelegationQueue(ctx, &types.QueryRedelegationQueueRequest{
+		StartTime: secondCompletion,
+		EndTime:   firstCompletion,
+	})
+	require.Error(t, err)
+
+	// an event is emitted for each entry maturing
+	ctx = ctx.WithBlockTime(firstCompletion).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	matured := make(map[string]int)
+	for _, event := range ctx.EventManager().Events() {
+		matured[event.Type]++
+	}
+	require.Equal(t, 2, matured[types.EventTypeUnbondingEntryMatured])
+	require.Equal(t, 1, matured[types.EventTypeRedelegationEntryMatured])
+
+	res, err = querier.UnbondingQueue(ctx, &types.QueryUnbondingQueueRequest{
+		StartTime: firstCompletion,
+		EndTime:   secondCompletion,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/simapp"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestQueuedOperations(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 5})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.EpochLength = 10
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddr, err := sdk.ValAddressFromBech32(app.StakingKeeper.GetValidators(ctx, 1)[0].OperatorAddress)
+	require.NoError(t, err)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	poolBalance := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
+
+	// the delegation is queued and its coins escrowed in the not bonded pool
+	amount := sdk.NewInt64Coin(bondDenom, 1000)
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(delAddrs[0], valAddr, amount))
+	require.NoError(t, err)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddr)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 9000), app.BankKeeper.GetBalance(ctx, delAddrs[0], bondDenom))
+	require.Equal(t, poolBalance.Add(amount), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom))
+
+	// a delegation to an unknown validator fails when applied and is refunded
+	unknownVal := sdk.ValAddress(delAddrs[0])
+	_, err = app.StakingKeeper.QueueOperation(ctx, types.NewMsgDelegate(delAddrs[0], unknownVal, amount))
+	require.NoError(t, err)
+
+	res, err := querier.DelegatorQueuedOperations(ctx, &types.QueryDelegatorQueuedOperationsRequest{DelegatorAddr: delAddrs[0].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Operations, 2)
+	require.Equal(t, types.OperationTypeDelegate, res.Operations[0].OperationType)
+	require.Equal(t, uint64(1), res.Operations[0].Id)
+	require.Equal(t, int64(10), res.EpochEndHeight)
+
+	_, stop := keeper.ModuleAccountInvariants(app.StakingKeeper)(ctx)
+	require.False(t, stop)
+
+	// nothing is applied before the end of the epoch
+	ctx = ctx.WithBlockHeight(9)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, app.StakingKeeper.GetAllQueuedOperations(ctx), 2)
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Empty(t, app.StakingKeeper.GetAllQueuedOperations(ctx))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.IsPositive())
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 9000), app.BankKeeper.GetBalance(ctx, delAddrs[0], bondDenom))
+	require.Equal(t, poolBalance, app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom))
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeQueuedOperationFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(t, failed, 1)
+
+	_, stop = keeper.ModuleAccountInvariants(app.StakingKeeper)(ctx)
+	require.False(t, stop)
+
+	// undelegations are queued as well and applied at the end of the next epoch
+	ctx = ctx.WithBlockHeight(11)
+	undelegateRes, err := msgServer.Undelegate(ctx, types.NewMsgUndelegate(delAddrs[0], valAddr, amount))
+	require.NoError(t, err)
+	require.True(t, undelegateRes.CompletionTime.IsZero())
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddr)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(20)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddr)
+	require.True(t, found)
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidatorSetSizeRamp(t *testing.T) {
+	app, ctx, _, _ := bootstrapSlashTest(t, 10)
+	stakingKeeper := app.StakingKeeper
+	querier := keeper.Querier{Keeper: stakingKeeper}
+
+	// without a ramp the size follows max validators immediately
+	params := stakingKeeper.GetParams(ctx)
+	params.MaxValidators = 3
+	require.NoError(t, stakingKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(10)
+	staking.EndBlocker(ctx, stakingKeeper)
+	require.Equal(t, uint32(3), stakingKeeper.EffectiveMaxValidators(ctx))
+	require.Len(t, stakingKeeper.GetLastValidators(ctx), 3)
+
+	// with a ramp the size moves by one validator every five blocks
+	params.MaxValidators = 1
+	params.MaxValidatorsRampStep = 1
+	params.MaxValidatorsRampInterval = 5
+	require.NoError(t, stakingKeeper.SetParams(ctx, params))
+
+	res, err := querier.ValidatorSetSize(ctx, &types.QueryValidatorSetSizeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryValidatorSetSizeResponse{EffectiveMaxValidators: 3, MaxValidators: 1, NextStepHeight: 15}, res)
+
+	ctx = ctx.WithBlockHeight(11)
+	staking.EndBlocker(ctx, stakingKeeper)
+	require.Len(t, stakingKeeper.GetLastValidators(ctx), 3)
+
+	ctx = ctx.WithBlockHeight(15)
+	updates := staking.EndBlocker(ctx, stakingKeeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Len(t, stakingKeeper.GetLastValidators(ctx), 2)
+
+	ctx = ctx.WithBlockHeight(20)
+	staking.EndBlocker(ctx, stakingKeeper)
+	require.Len(t, stakingKeeper.GetLastValidators(ctx), 1)
+
+	res, err = querier.ValidatorSetSize(ctx, &types.QueryValidatorSetSizeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryValidatorSetSizeResponse{EffectiveMaxValidators: 1, MaxValidators: 1}, res)
+
+	// the ramp moves up as well, and caps the step at the target
+	params.MaxValidators = 3
+	params.MaxValidatorsRampStep = 5
+	require.NoError(t, stakingKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(25)
+	staking.EndBlocker(ctx, stakingKeeper)
+	require.Equal(t, uint32(3), stakingKeeper.EffectiveMaxValidators(ctx))
+	require.Len(t, stakingKeeper.GetLastValidators(ctx), 3)
+}
@@ -0,0 +1,177 @@
+package msgservice
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	msgFilesOnce sync.Once
+	msgFiles     *protoregistry.Files
+	msgFilesErr  error
+)
+
+// msgFileRegistry returns a registry of the proto files registered with
+// gogoproto, completed with the protoregistry global files not registered with
+// gogoproto. Unlike in the gogoproto registry, whose files are built one at a
+// time as they are registered, all the references between files are resolved.
+// The well-known types are taken from the protoregistry global files, which
+// are the most recent ones.
+func msgFileRegistry() (*protoregistry.Files, error) {
+	msgFilesOnce.Do(func() {
+		isWellKnown := func(path string) bool {
+			_, err := protoregistry.GlobalFiles.FindFileByPath(path)
+			return err == nil && strings.HasPrefix(path, "google/protobuf/")
+		}
+
+		fds := &descriptorpb.FileDescriptorSet{}
+		proto.GogoResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+			if !isWellKnown(fd.Path()) {
+				fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+			}
+			return true
+		})
+		protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+			if _, err := proto.GogoResolver.FindFileByPath(fd.Path()); err != nil || isWellKnown(fd.Path()) {
+				fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+			}
+			return true
+		})
+		msgFiles, msgFilesErr = protodesc.NewFiles(fds)
+	})
+	return msgFiles, msgFilesErr
+}
+
+// MsgDescriptor returns the descriptor of the message of the given type URL.
+// The descriptors registered with gogoproto take precedence, since they are
+// the ones of the types the messages are actually decoded to.
+func MsgDescriptor(msgTypeURL string) (protoreflect.MessageDescriptor, error) {
+	files, err := msgFileRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msgTypeURL, "/")))
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("unknown msg type %s", msgTypeURL)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("%s is not a message type", msgTypeURL)
+	}
+	return md, nil
+}
+
+// MsgToProtoReflect converts a message to a dynamic protoreflect message, so
+// that its fields can be accessed by name.
+func MsgToProtoReflect(msg sdk.Msg) (protoreflect.Message, error) {
+	md, err := MsgDescriptor(sdk.MsgTypeURL(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := protov2.Unmarshal(bz, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ResolveFieldPath returns the descriptor of the field at the given path of a
+// message. The path is made of the proto names of the fields separated by
+// dots, e.g. "to_address" or "outputs.address". Map fields are not supported.
+func ResolveFieldPath(md protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	return resolveFieldPath(md, strings.Split(path, "."))
+}
+
+func resolveFieldPath(md protoreflect.MessageDescriptor, path []string) (protoreflect.FieldDescriptor, error) {
+	fd := md.Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unknown field %s of %s", path[0], md.FullName())
+	}
+	if fd.IsMap() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("map field %s of %s is not supported", path[0], md.FullName())
+	}
+	if len(path) == 1 {
+		return fd, nil
+	}
+
+	if fd.Kind() != protoreflect.MessageKind {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("field %s of %s is not a message", path[0], md.FullName())
+	}
+	return resolveFieldPath(fd.Message(), path[1:])
+}
+
+// RangeFieldPath calls f for each value of the field at the given path of a
+// message, flattening the repeated fields along the path. Unset singular
+// message fields are ranged over as empty messages.
+func RangeFieldPath(m protoreflect.Message, path string, f func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error) error {
+	return rangeFieldPath(m, strings.Split(path, "."), f)
+}
+
+func rangeFieldPath(m protoreflect.Message, path []string, f func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error) error {
+	fd, err := resolveFieldPath(m.Descriptor(), path[:1])
+	if err != nil {
+		return err
+	}
+
+	var values []protoreflect.Value
+	if fd.IsList() {
+		list := m.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, list.Get(i))
+		}
+	} else {
+		values = append(values, m.Get(fd))
+	}
+
+	for _, v := range values {
+		if len(path) == 1 {
+			err = f(fd, v)
+		} else {
+			if fd.Kind() != protoreflect.MessageKind {
+				return sdkerrors.ErrInvalidRequest.Wrapf("field %s of %s is not a message", path[0], m.Descriptor().FullName())
+			}
+			err = rangeFieldPath(v.Message(), path[1:], f)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FieldValues returns the values of the scalar field at the given path of a
+// message in their text format. Enum values are returned by name.
+func FieldValues(m protoreflect.Message, path string) ([]string, error) {
+	var values []string
+	err := RangeFieldPath(m, path, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() == protoreflect.EnumKind {
+			if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+				values = append(values, string(ev.Name()))
+				return nil
+			}
+		}
+		values = append(values, fmt.Sprint(v.Interface()))
+		return nil
+	})
+	return values, err
+}
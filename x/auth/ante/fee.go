@@ -78,22 +78,23 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 
 	feePayer := feeTx.FeePayer()
-	feeGranter := feeTx.FeeGranter()
+	feeGranters, err := getFeeGranters(sdkTx, feeTx.FeeGranter())
+	if err != nil {
+		return err
+	}
 	deductFeesFrom := feePayer
 
-	// if feegranter set deduct fee from feegranter account.
-	// this works with only when feegrant enabled.
-	if feeGranter != nil {
+	// if feegranters set deduct fee from the first feegranter account which
+	// allows to pay the fees. this works with only when feegrant enabled.
+	if len(feeGranters) > 0 {
 		if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
-		} else if !feeGranter.Equals(feePayer) {
-			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, sdkTx.GetMsgs())
-			if err != nil {
-				return sdkerrors.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
-			}
 		}
 
-		deductFeesFrom = feeGranter
+		deductFeesFrom, err = dfd.useGrantedFees(ctx, feeGranters, feePayer, fee, sdkTx.GetMsgs())
+		if err != nil {
+			return err
+		}
 	}
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
@@ -121,6 +122,59 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	return nil
 }
 
+// useGrantedFees tries the given fee granters in order, and returns the first
+// one which allows to pay the fees for the fee payer. The state changes made
+// while trying the fee granters which don't allow it are discarded.
+func (dfd DeductFeeDecorator) useGrantedFees(ctx sdk.Context, feeGranters []sdk.AccAddress, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, error) {
+	var err error
+	for _, feeGranter := range feeGranters {
+		if feeGranter.Equals(feePayer) {
+			return feeGranter, nil
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err = dfd.feegrantKeeper.UseGrantedFees(cacheCtx, feeGranter, feePayer, fee, msgs); err == nil {
+			write()
+			return feeGranter, nil
+		}
+	}
+
+	if len(feeGranters) == 1 {
+		return nil, sdkerrors.Wrapf(err, "%s does not allow to pay fees for %s", feeGranters[0], feePayer)
+	}
+	return nil, sdkerrors.Wrapf(err, "none of the fee granters %s allow to pay fees for %s", feeGranters, feePayer)
+}
+
+// getFeeGranters returns the fee granters of a tx: its fee granter, if set,
+// followed by the fallback fee granters of its ExtensionOptionFeeGranters
+// non-critical extension option, if any.
+func getFeeGranters(sdkTx sdk.Tx, feeGranter sdk.AccAddress) ([]sdk.AccAddress, error) {
+	var feeGranters []sdk.AccAddress
+	if feeGranter != nil {
+		feeGranters = append(feeGranters, feeGranter)
+	}
+
+	hasExtOptsTx, ok := sdkTx.(HasExtensionOptionsTx)
+	if !ok {
+		return feeGranters, nil
+	}
+
+	for _, opt := range hasExtOptsTx.GetNonCriticalExtensionOptions() {
+		ext, ok := opt.GetCachedValue().(*types.ExtensionOptionFeeGranters)
+		if !ok {
+			continue
+		}
+
+		fallbacks, err := ext.GetFeeGranterAddresses()
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid fallback fee granter: %s", err)
+		}
+		feeGranters = append(feeGranters, fallbacks...)
+	}
+
+	return feeGranters, nil
+}
+
 // DeductFees deducts fees from the given account.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 
@@ -182,6 +183,73 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 	}
 }
 
+func TestDeductFeesFallbackFeeGranters(t *testing.T) {
+	cases := map[string]struct {
+		valid    bool
+		err      error
+		errMsg   string
+		malleate func(*AnteTestSuite, []TestAccount) (feeGranter sdk.AccAddress, fallbacks []sdk.AccAddress)
+	}{
+		"fallback to the next fee granter": {
+			valid: true,
+			malleate: func(suite *AnteTestSuite, accs []TestAccount) (sdk.AccAddress, []sdk.AccAddress) {
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(feegrant.ErrFeeLimitExceeded.Wrap("basic allowance"))
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[2].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil)
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[2].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+				return accs[1].acc.GetAddress(), []sdk.AccAddress{accs[2].acc.GetAddress()}
+			},
+		},
+		"fallback fee granters only": {
+			valid: true,
+			malleate: func(suite *AnteTestSuite, accs []TestAccount) (sdk.AccAddress, []sdk.AccAddress) {
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(nil)
+				suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[1].acc.GetAddress(), authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+				return nil, []sdk.AccAddress{accs[1].acc.GetAddress(), accs[2].acc.GetAddress()}
+			},
+		},
+		"all fee granters exhausted": {
+			valid:  false,
+			err:    feegrant.ErrFeeLimitExceeded,
+			errMsg: "none of the fee granters",
+			malleate: func(suite *AnteTestSuite, accs []TestAccount) (sdk.AccAddress, []sdk.AccAddress) {
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[1].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(feegrant.ErrFeeLimitExceeded.Wrap("basic allowance"))
+				suite.feeGrantKeeper.EXPECT().UseGrantedFees(gomock.Any(), accs[2].acc.GetAddress(), accs[0].acc.GetAddress(), gomock.Any(), gomock.Any()).Return(feegrant.ErrFeeLimitExceeded.Wrap("basic allowance"))
+				return accs[1].acc.GetAddress(), []sdk.AccAddress{accs[2].acc.GetAddress()}
+			},
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			dfd := ante.NewDeductFeeDecorator(suite.accountKeeper, suite.bankKeeper, suite.feeGrantKeeper, nil)
+			feeAnteHandler := sdk.ChainAnteDecorators(dfd)
+
+			accs := suite.CreateTestAccounts(3)
+			feeGranter, fallbacks := tc.malleate(suite, accs)
+
+			ext, err := codectypes.NewAnyWithValue(authtypes.NewExtensionOptionFeeGranters(fallbacks...))
+			require.NoError(t, err)
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 50)))
+			txBuilder.SetGasLimit(10000000)
+			txBuilder.SetFeeGranter(feeGranter)
+			txBuilder.(tx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(ext)
+
+			_, err = feeAnteHandler(suite.ctx, txBuilder.GetTx(), false)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
 // don't consume any gas
 func SigGasNoConsumer(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params authtypes.Params) error {
 	return nil
@@ -244,62 +244,113 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+// ExtensionOptionFeeGranters is a non-critical tx extension option naming an
+// ordered list of fallback fee granters. If the fee granter of the tx, when set,
+// doesn't allow to pay the fees, the fee granters of the list are tried in
+// order, and the fees are paid by the first one whose allowance accepts them.
+type ExtensionOptionFeeGranters struct {
+	FeeGranters []string `protobuf:"bytes,1,rep,name=fee_granters,json=feeGranters,proto3" json:"fee_granters,omitempty"`
+}
+
+func (m *ExtensionOptionFeeGranters) Reset()         { *m = ExtensionOptionFeeGranters{} }
+func (m *ExtensionOptionFeeGranters) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeGranters) ProtoMessage()    {}
+func (*ExtensionOptionFeeGranters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *ExtensionOptionFeeGranters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeeGranters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeeGranters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeeGranters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeGranters.Merge(m, src)
+}
+func (m *ExtensionOptionFeeGranters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeeGranters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeGranters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeeGranters proto.InternalMessageInfo
+
+func (m *ExtensionOptionFeeGranters) GetFeeGranters() []string {
+	if m != nil {
+		return m.FeeGranters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*ExtensionOptionFeeGranters)(nil), "cosmos.auth.v1beta1.ExtensionOptionFeeGranters")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 707 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcf, 0x4e, 0xe3, 0x46,
-	0x1c, 0x8e, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0xbb, 0x91, 0x2a, 0x52,
-	0x54, 0xec, 0x26, 0x15, 0x95, 0xca, 0x8d, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x5a, 0x0e, 0xab,
-	0x95, 0xac, 0xb1, 0x33, 0x98, 0x11, 0x19, 0x8f, 0xd7, 0x33, 0x46, 0x31, 0x4f, 0x80, 0xf6, 0xb4,
-	0x8f, 0xc0, 0xee, 0x13, 0x70, 0xe0, 0x21, 0x56, 0x7b, 0x42, 0x7b, 0xd9, 0xdd, 0x4b, 0xb4, 0x0a,
-	0x07, 0xd0, 0x3e, 0xc5, 0xca, 0x33, 0x0e, 0x24, 0x6c, 0x2e, 0x91, 0x7f, 0xdf, 0xf7, 0xfd, 0xfe,
-	0x7d, 0xfe, 0xc5, 0xa0, 0xea, 0x51, 0x46, 0x28, 0xb3, 0x60, 0xcc, 0x8f, 0xad, 0xd3, 0x86, 0x8b,
-	0x38, 0x6c, 0x88, 0xc0, 0x0c, 0x23, 0xca, 0xa9, 0xba, 0x2c, 0x79, 0x53, 0x40, 0x19, 0x5f, 0x59,
-	0x82, 0x04, 0x07, 0xd4, 0x12, 0xbf, 0x52, 0x57, 0x59, 0x95, 0x3a, 0x47, 0x44, 0x56, 0x96, 0x24,
-	0xa9, 0xb2, 0x4f, 0x7d, 0x2a, 0xf1, 0xf4, 0x69, 0x94, 0xe0, 0x53, 0xea, 0xf7, 0x90, 0x25, 0x22,
-	0x37, 0x3e, 0xb2, 0x60, 0x90, 0x48, 0xaa, 0xf6, 0x2a, 0x0f, 0x4a, 0x2d, 0xc8, 0xd0, 0xb6, 0xe7,
-	0xd1, 0x38, 0xe0, 0x6a, 0x13, 0xcc, 0xc2, 0x6e, 0x37, 0x42, 0x8c, 0x69, 0x8a, 0xa1, 0xd4, 0xe7,
-	0x5a, 0xda, 0xbb, 0xab, 0x8d, 0x72, 0xd6, 0x63, 0x5b, 0x32, 0x1d, 0x1e, 0xe1, 0xc0, 0xb7, 0x47,
-	0x42, 0xf5, 0x10, 0xcc, 0x86, 0xb1, 0xeb, 0x9c, 0xa0, 0x44, 0xcb, 0x1b, 0x4a, 0xbd, 0xd4, 0x2c,
-	0x9b, 0xb2, 0xa1, 0x39, 0x6a, 0x68, 0x6e, 0x07, 0x49, 0x6b, 0xed, 0xf3, 0x40, 0x2f, 0x87, 0xb1,
-	0xdb, 0xc3, 0x5e, 0xaa, 0xfd, 0x8d, 0x12, 0xcc, 0x11, 0x09, 0x79, 0xf2, 0xfa, 0xf6, 0x72, 0x1d,
-	0x3c, 0x10, 0xf6, 0x4c, 0x18, 0xbb, 0xbb, 0x28, 0x51, 0x7f, 0x01, 0x8b, 0x50, 0x8e, 0xe5, 0x04,
-	0x31, 0x71, 0x51, 0xa4, 0x15, 0x0c, 0xa5, 0x5e, 0xb4, 0x17, 0x32, 0x74, 0x5f, 0x80, 0x6a, 0x05,
-	0x7c, 0xcb, 0xd0, 0xf3, 0x18, 0x05, 0x1e, 0xd2, 0x8a, 0x42, 0x70, 0x1f, 0x6f, 0xb5, 0xcf, 0x2f,
-	0xf4, 0xdc, 0xdd, 0x85, 0x9e, 0x7b, 0x7b, 0xb5, 0xf1, 0xd3, 0x14, 0x7b, 0xcd, 0x6c, 0xef, 0x9d,
-	0x17, 0xb7, 0x97, 0xeb, 0x2b, 0x52, 0xb0, 0xc1, 0xba, 0x27, 0xd6, 0x98, 0x27, 0xb5, 0x8f, 0x0a,
-	0x58, 0xd8, 0xa3, 0xdd, 0xb8, 0x77, 0xef, 0xd2, 0x0e, 0x98, 0x77, 0x21, 0x43, 0x4e, 0x36, 0x88,
-	0xb0, 0xaa, 0xd4, 0x34, 0xcc, 0x69, 0x1d, 0xc6, 0x2a, 0xb5, 0x8a, 0xd7, 0x03, 0x5d, 0xb1, 0x4b,
-	0xee, 0x98, 0xe1, 0x2a, 0x28, 0x06, 0x90, 0x20, 0xe1, 0xdc, 0x9c, 0x2d, 0x9e, 0x55, 0x03, 0x94,
-	0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0x3e, 0x67, 0x8f, 0x43, 0x5b,
-	0xff, 0x9e, 0xcb, 0x9d, 0x6a, 0xd3, 0x3a, 0x4e, 0xcc, 0x2a, 0x36, 0xd3, 0xc6, 0x36, 0x9b, 0x60,
-	0x6b, 0xcf, 0xc0, 0xf7, 0x12, 0x68, 0x47, 0xa8, 0x8b, 0x02, 0x8e, 0x61, 0x4f, 0xd5, 0x41, 0x89,
-	0x08, 0xcc, 0x11, 0x93, 0x89, 0x3b, 0xb0, 0x81, 0x84, 0xf6, 0xd3, 0xf9, 0xd6, 0xc0, 0x77, 0x5d,
-	0x14, 0xe1, 0x53, 0xc8, 0x31, 0x0d, 0xd2, 0x57, 0xc6, 0xb4, 0xbc, 0x51, 0xa8, 0xcf, 0xdb, 0x8b,
-	0x0f, 0xf0, 0x2e, 0x4a, 0x58, 0xed, 0x7d, 0x1e, 0xcc, 0x1c, 0xc0, 0x08, 0x12, 0xa6, 0x9a, 0x60,
-	0x99, 0xc0, 0xbe, 0x43, 0x10, 0xa1, 0x8e, 0x77, 0x0c, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x23, 0x2b,
-	0xda, 0x4b, 0x04, 0xf6, 0xf7, 0x10, 0xa1, 0xed, 0x7b, 0x42, 0x35, 0xc0, 0x3c, 0xef, 0x3b, 0x0c,
-	0xfb, 0x4e, 0x0f, 0x13, 0xcc, 0x85, 0x3f, 0x45, 0x1b, 0xf0, 0x7e, 0x07, 0xfb, 0xff, 0xa5, 0x88,
-	0xfa, 0x3b, 0xf8, 0x41, 0x28, 0xce, 0x90, 0xe3, 0x51, 0xc6, 0x9d, 0x10, 0x45, 0x8e, 0x9b, 0x70,
-	0x94, 0x5d, 0xc9, 0x52, 0x2a, 0x3d, 0x43, 0x6d, 0xca, 0xf8, 0x01, 0x8a, 0x5a, 0x09, 0x47, 0xea,
-	0xff, 0xe0, 0xc7, 0xb4, 0xe0, 0x29, 0x8a, 0xf0, 0x51, 0x22, 0x93, 0x50, 0xb7, 0xb9, 0xb9, 0xd9,
-	0xf8, 0x4b, 0x1e, 0x4e, 0x4b, 0x1b, 0x0e, 0xf4, 0x72, 0x07, 0xfb, 0x87, 0x42, 0x91, 0xa6, 0xfe,
-	0xf3, 0xb7, 0xe0, 0xed, 0x32, 0x9b, 0x40, 0x65, 0x96, 0xfa, 0x04, 0xac, 0x3e, 0x2e, 0xc8, 0x90,
-	0x17, 0x36, 0x37, 0xff, 0x3c, 0x69, 0x68, 0xdf, 0x88, 0x92, 0x95, 0xe1, 0x40, 0x5f, 0x99, 0x28,
-	0xd9, 0x19, 0x29, 0xec, 0x15, 0x36, 0x15, 0xdf, 0xfa, 0xf9, 0xee, 0x42, 0x57, 0x1e, 0xbf, 0xb7,
-	0xbe, 0xfc, 0x6e, 0x48, 0x3b, 0x5b, 0xed, 0x37, 0xc3, 0xaa, 0x72, 0x3d, 0xac, 0x2a, 0x9f, 0x86,
-	0x55, 0xe5, 0xe5, 0x4d, 0x35, 0x77, 0x7d, 0x53, 0xcd, 0x7d, 0xb8, 0xa9, 0xe6, 0x9e, 0xfe, 0xea,
-	0x63, 0x7e, 0x1c, 0xbb, 0xa6, 0x47, 0x49, 0xf6, 0x6d, 0xb0, 0xbe, 0xae, 0xc2, 0x93, 0x10, 0x31,
-	0x77, 0x46, 0xfc, 0x3f, 0xff, 0xf8, 0x12, 0x00, 0x00, 0xff, 0xff, 0x94, 0x45, 0xcd, 0x40, 0x99,
-	0x04, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xd0, 0xa5, 0x93, 0xec, 0x42, 0xbd, 0xa1, 0x78, 0x23, 0x14, 0x9b, 0x48, 0x68,
+	0xc3, 0x8a, 0xda, 0x24, 0xa8, 0x48, 0x94, 0xab, 0x26, 0x2c, 0xab, 0xd5, 0xb2, 0x3f, 0x72, 0xc4,
+	0x4a, 0x20, 0x24, 0x6b, 0xec, 0x9c, 0xb8, 0xa3, 0x66, 0x3c, 0xc6, 0x33, 0xae, 0xe2, 0x7d, 0x82,
+	0x15, 0x57, 0x3c, 0x42, 0xe1, 0x09, 0x7a, 0xd1, 0x87, 0x40, 0x5c, 0x55, 0xdc, 0x00, 0x37, 0x11,
+	0x4a, 0x2f, 0x5a, 0xf1, 0x14, 0xc8, 0x33, 0x4e, 0x9b, 0x94, 0x68, 0x6f, 0x2c, 0x9f, 0xef, 0xfb,
+	0xce, 0xcf, 0x7c, 0x3e, 0x1e, 0xd4, 0x0a, 0x18, 0xa7, 0x8c, 0x3b, 0x38, 0x15, 0x07, 0xce, 0x51,
+	0xd7, 0x07, 0x81, 0xbb, 0x32, 0xb0, 0xe3, 0x84, 0x09, 0xa6, 0xdf, 0x55, 0xbc, 0x2d, 0xa1, 0x82,
+	0x6f, 0x6e, 0x61, 0x4a, 0x22, 0xe6, 0xc8, 0xa7, 0xd2, 0x35, 0xef, 0x29, 0x9d, 0x27, 0x23, 0xa7,
+	0x48, 0x52, 0x54, 0x23, 0x64, 0x21, 0x53, 0x78, 0xfe, 0xb6, 0x48, 0x08, 0x19, 0x0b, 0x27, 0xe0,
+	0xc8, 0xc8, 0x4f, 0xc7, 0x0e, 0x8e, 0x32, 0x45, 0xb5, 0x7f, 0x29, 0xa3, 0x5a, 0x1f, 0x73, 0xd8,
+	0x0f, 0x02, 0x96, 0x46, 0x42, 0xef, 0xa1, 0x5b, 0x78, 0x34, 0x4a, 0x80, 0x73, 0x43, 0xb3, 0xb4,
+	0xce, 0x66, 0xdf, 0xf8, 0xe3, 0x74, 0xa7, 0x51, 0xf4, 0xd8, 0x57, 0xcc, 0x50, 0x24, 0x24, 0x0a,
+	0xdd, 0x85, 0x50, 0x7f, 0x89, 0x6e, 0xc5, 0xa9, 0xef, 0x1d, 0x42, 0x66, 0x94, 0x2d, 0xad, 0x53,
+	0xeb, 0x35, 0x6c, 0xd5, 0xd0, 0x5e, 0x34, 0xb4, 0xf7, 0xa3, 0xac, 0x7f, 0xff, 0xdf, 0x99, 0xd9,
+	0x88, 0x53, 0x7f, 0x42, 0x82, 0x5c, 0xfb, 0x09, 0xa3, 0x44, 0x00, 0x8d, 0x45, 0xf6, 0xeb, 0xc5,
+	0xc9, 0x03, 0x74, 0x4d, 0xb8, 0x1b, 0x71, 0xea, 0x3f, 0x81, 0x4c, 0xff, 0x08, 0xdd, 0xc1, 0x6a,
+	0x2c, 0x2f, 0x4a, 0xa9, 0x0f, 0x89, 0x51, 0xb1, 0xb4, 0x4e, 0xd5, 0xbd, 0x5d, 0xa0, 0xcf, 0x24,
+	0xa8, 0x37, 0xd1, 0xdb, 0x1c, 0x7e, 0x4c, 0x21, 0x0a, 0xc0, 0xa8, 0x4a, 0xc1, 0x55, 0xbc, 0x37,
+	0x78, 0x7d, 0x6c, 0x96, 0x2e, 0x8f, 0xcd, 0xd2, 0xef, 0xa7, 0x3b, 0x1f, 0xac, 0xb1, 0xd7, 0x2e,
+	0xce, 0xfd, 0xf8, 0xa7, 0x8b, 0x93, 0x07, 0xdb, 0x4a, 0xb0, 0xc3, 0x47, 0x87, 0xce, 0x92, 0x27,
+	0xed, 0xbf, 0x35, 0x74, 0xfb, 0x29, 0x1b, 0xa5, 0x93, 0x2b, 0x97, 0x1e, 0xa3, 0xba, 0x8f, 0x39,
+	0x78, 0xc5, 0x20, 0xd2, 0xaa, 0x5a, 0xcf, 0xb2, 0xd7, 0x75, 0x58, 0xaa, 0xd4, 0xaf, 0x9e, 0xcd,
+	0x4c, 0xcd, 0xad, 0xf9, 0x4b, 0x86, 0xeb, 0xa8, 0x1a, 0x61, 0x0a, 0xd2, 0xb9, 0x4d, 0x57, 0xbe,
+	0xeb, 0x16, 0xaa, 0xc5, 0x90, 0x50, 0xc2, 0x39, 0x61, 0x11, 0x37, 0x2a, 0x56, 0xa5, 0xb3, 0xe9,
+	0x2e, 0x43, 0x7b, 0x8f, 0x5e, 0xab, 0x33, 0xb5, 0xd7, 0x75, 0x5c, 0x99, 0x55, 0x9e, 0xcc, 0x58,
+	0x3a, 0xd9, 0x0a, 0xdb, 0xfe, 0x01, 0xbd, 0xab, 0x80, 0x41, 0x02, 0x23, 0x88, 0x04, 0xc1, 0x13,
+	0xdd, 0x44, 0x35, 0x2a, 0x31, 0x4f, 0x4e, 0x26, 0xf7, 0xc0, 0x45, 0x0a, 0x7a, 0x96, 0xcf, 0x77,
+	0x1f, 0xbd, 0x33, 0x82, 0x84, 0x1c, 0x61, 0x41, 0x58, 0x94, 0x7f, 0x32, 0x6e, 0x94, 0xad, 0x4a,
+	0xa7, 0xee, 0xde, 0xb9, 0x86, 0x9f, 0x40, 0xc6, 0xdb, 0x7f, 0x96, 0xd1, 0xc6, 0x0b, 0x9c, 0x60,
+	0xca, 0x75, 0x1b, 0xdd, 0xa5, 0x78, 0xea, 0x51, 0xa0, 0xcc, 0x0b, 0x0e, 0x70, 0x82, 0x03, 0x01,
+	0x89, 0x5a, 0xb2, 0xaa, 0xbb, 0x45, 0xf1, 0xf4, 0x29, 0x50, 0x36, 0xb8, 0x22, 0x74, 0x0b, 0xd5,
+	0xc5, 0xd4, 0xe3, 0x24, 0xf4, 0x26, 0x84, 0x12, 0x21, 0xfd, 0xa9, 0xba, 0x48, 0x4c, 0x87, 0x24,
+	0xfc, 0x26, 0x47, 0xf4, 0x4f, 0xd1, 0x7b, 0x52, 0xf1, 0x0a, 0xbc, 0x80, 0x71, 0xe1, 0xc5, 0x90,
+	0x78, 0x7e, 0x26, 0xa0, 0xd8, 0x92, 0xad, 0x5c, 0xfa, 0x0a, 0x06, 0x8c, 0x8b, 0x17, 0x90, 0xf4,
+	0x33, 0x01, 0xfa, 0x73, 0xf4, 0x7e, 0x5e, 0xf0, 0x08, 0x12, 0x32, 0xce, 0x54, 0x12, 0x8c, 0x7a,
+	0xbb, 0xbb, 0xdd, 0x2f, 0xd4, 0xe2, 0xf4, 0x8d, 0xf9, 0xcc, 0x6c, 0x0c, 0x49, 0xf8, 0x52, 0x2a,
+	0xf2, 0xd4, 0x87, 0x5f, 0x49, 0xde, 0x6d, 0xf0, 0x15, 0x54, 0x65, 0xe9, 0xdf, 0xa2, 0x7b, 0x37,
+	0x0b, 0x72, 0x08, 0xe2, 0xde, 0xee, 0xe7, 0x87, 0x5d, 0xe3, 0x2d, 0x59, 0xb2, 0x39, 0x9f, 0x99,
+	0xdb, 0x2b, 0x25, 0x87, 0x0b, 0x85, 0xbb, 0xcd, 0xd7, 0xe2, 0x7b, 0x1f, 0x5e, 0x1e, 0x9b, 0xda,
+	0xcd, 0xef, 0x36, 0x55, 0xf7, 0x86, 0xb2, 0xb3, 0xfd, 0x1d, 0x6a, 0x3e, 0x9c, 0x0a, 0x88, 0xf2,
+	0x75, 0x78, 0x1e, 0xe7, 0x86, 0x7f, 0x0d, 0xf0, 0x28, 0xc1, 0x91, 0x34, 0xef, 0x4b, 0x54, 0x1f,
+	0x03, 0x78, 0x61, 0x11, 0x1b, 0x9a, 0x55, 0x79, 0xe3, 0xaf, 0x5c, 0x1b, 0x5f, 0x27, 0xf7, 0x07,
+	0xbf, 0xcd, 0x5b, 0xda, 0xd9, 0xbc, 0xa5, 0xfd, 0x33, 0x6f, 0x69, 0x3f, 0x9f, 0xb7, 0x4a, 0x67,
+	0xe7, 0xad, 0xd2, 0x5f, 0xe7, 0xad, 0xd2, 0xf7, 0x1f, 0x87, 0x44, 0x1c, 0xa4, 0xbe, 0x1d, 0x30,
+	0x5a, 0x5c, 0x3b, 0xce, 0xff, 0x07, 0x14, 0x59, 0x0c, 0xdc, 0xdf, 0x90, 0xbf, 0xfe, 0x67, 0xff,
+	0x0d, 0x00, 0xd9, 0x74, 0x65, 0x37, 0xf4, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -528,6 +579,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtensionOptionFeeGranters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeeGranters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeGranters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGranters) > 0 {
+		for iNdEx := len(m.FeeGranters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeGranters[iNdEx])
+			copy(dAtA[i:], m.FeeGranters[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeGranters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -628,6 +711,21 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *ExtensionOptionFeeGranters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeGranters) > 0 {
+		for _, s := range m.FeeGranters {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1199,6 +1297,88 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtensionOptionFeeGranters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeGranters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeGranters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranters = append(m.FeeGranters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
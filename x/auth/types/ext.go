@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewExtensionOptionFeeGranters creates a new ExtensionOptionFeeGranters
+// naming the given fallback fee granters, in order.
+func NewExtensionOptionFeeGranters(feeGranters ...sdk.AccAddress) *ExtensionOptionFeeGranters {
+	granters := make([]string, len(feeGranters))
+	for i, granter := range feeGranters {
+		granters[i] = granter.String()
+	}
+
+	return &ExtensionOptionFeeGranters{FeeGranters: granters}
+}
+
+// GetFeeGranterAddresses returns the addresses of the fallback fee granters.
+func (e ExtensionOptionFeeGranters) GetFeeGranterAddresses() ([]sdk.AccAddress, error) {
+	granters := make([]sdk.AccAddress, len(e.FeeGranters))
+	for i, granter := range e.FeeGranters {
+		addr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			return nil, err
+		}
+		granters[i] = addr
+	}
+
+	return granters, nil
+}
@@ -0,0 +1,11 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/keeper"
+)
+
+// EndBlocker removes the vesting positions which have fully vested.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.RemoveVestedPositions(ctx, 200)
+}
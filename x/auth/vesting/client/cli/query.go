@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// GetQueryCmd returns the vesting module's query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryVestingPosition(),
+		GetCmdQueryVestingPositions(),
+		GetCmdQueryClawbackPreview(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryVestingPosition returns the command to query a vesting position.
+func GetCmdQueryVestingPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "position [id]",
+		Short:   "Query a vesting position and its locked tokens",
+		Example: fmt.Sprintf("%s query vesting position 1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingPosition(cmd.Context(), &types.QueryVestingPositionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVestingPositions returns the command to query the vesting
+// positions of an account.
+func GetCmdQueryVestingPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "positions [owner]",
+		Short:   "Query the vesting positions of an account and their locked tokens",
+		Example: fmt.Sprintf("%s query vesting positions cosmos1...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingPositions(cmd.Context(), &types.QueryVestingPositionsRequest{
+				Owner:      owner.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions")
+	return cmd
+}
+
+// GetCmdQueryClawbackPreview returns the command to query what a clawback of a
+// clawback vesting account would return.
+func GetCmdQueryClawbackPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "clawback-preview [address]",
+		Short:   "Query what a clawback of a clawback vesting account would return at the current block time",
+		Example: fmt.Sprintf("%s query vesting clawback-preview cosmos1...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ClawbackPreview(cmd.Context(), &types.QueryClawbackPreviewRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -14,16 +14,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // Transaction command flags
 const (
-	FlagDelayed = "delayed"
-	FlagDest    = "dest"
-	FlagLockup  = "lockup"
-	FlagMerge   = "merge"
-	FlagVesting = "vesting"
+	FlagDelayed   = "delayed"
+	FlagDest      = "dest"
+	FlagLockup    = "lockup"
+	FlagMerge     = "merge"
+	FlagVesting   = "vesting"
+	FlagCliffTime = "cliff-time"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -43,6 +45,9 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateClawbackVestingAccountCmd(),
 		NewMsgCreateCliffVestingAccountCmd(),
 		NewMsgClawbackCmd(),
+		NewMsgCreateVestingPositionCmd(),
+		NewMsgTransferVestingPositionCmd(),
+		NewMsgAcceptVestingPositionCmd(),
 	)
 
 	return txCmd
@@ -340,7 +345,8 @@ func NewMsgClawbackCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clawback [address]",
 		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
-		Long: `Must be requested by the original funder address (--from).
+		Long: `Must be requested by the original funder address (--from). The authority of the module, e.g. the gov
+		module account, claws back through a proposal setting the authority of the message instead.
 		May provide a destination address (--dest), otherwise the coins return to the funder.
 		Delegated or undelegating staking tokens will be transferred in the delegated (undelegating) state.
 		The recipient is vulnerable to slashing, and must act to unbond the tokens if desired.
@@ -426,3 +432,135 @@ set by the committed block's time. The cliff duration should be specified in hou
 
 	return cmd
 }
+
+// NewMsgCreateVestingPositionCmd returns a CLI command handler for creating a
+// MsgCreateVestingPosition transaction.
+func NewMsgCreateVestingPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-position [to_address] [amount] [start_time] [end_time]",
+		Short: "Offer a vesting position funded with an allocation of tokens to an account.",
+		Long: `Offer a vesting position funded with an allocation of tokens to an account,
+which may already exist and hold other vesting positions. The position and its
+tokens stay with the sender (--from) until the recipient accepts it, unless the
+sender is the recipient. The tokens vest continuously from start_time to
+end_time, but none of them vest before the '--cliff-time', if any. A cliff-time
+equal to end_time makes all the tokens vest at once. The times must be provided
+as UNIX epoch timestamps.`,
+		Example: fmt.Sprintf("%s tx vesting create-vesting-position cosmos1... 1000stake 1700000000 1800000000 --cliff-time 1730000000", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			cliffTime, _ := cmd.Flags().GetInt64(FlagCliffTime)
+
+			msg := types.NewMsgCreateVestingPosition(clientCtx.GetFromAddress(), toAddr, amount, startTime, cliffTime, endTime)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagCliffTime, 0, "Time before which no tokens vest, as a UNIX epoch timestamp")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgTransferVestingPositionCmd returns a CLI command handler for creating a
+// MsgTransferVestingPosition transaction.
+func NewMsgTransferVestingPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-vesting-position [id] [recipient]",
+		Short: "Offer a vesting position with its unvested tokens to another account.",
+		Long: `Offer a vesting position owned by the sender (--from) to another account,
+replacing any previous offer. The position stays with the sender until the
+recipient accepts it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferVestingPosition(clientCtx.GetFromAddress(), id, recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgAcceptVestingPositionCmd returns a CLI command handler for creating a
+// MsgAcceptVestingPosition transaction.
+func NewMsgAcceptVestingPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-vesting-position [id]",
+		Short: "Accept a vesting position offered to the sender.",
+		Long: `Accept a vesting position offered to the sender (--from), along with the
+tokens of the position which haven't vested yet. The vesting schedule of the
+position is kept.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptVestingPosition(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
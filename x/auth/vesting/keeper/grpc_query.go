@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// VestingPosition returns a vesting position by its id.
+func (k Keeper) VestingPosition(c context.Context, req *types.QueryVestingPositionRequest) (*types.QueryVestingPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	position, err := k.GetVestingPosition(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryVestingPositionResponse{
+		Position: position,
+		Locked:   position.LockedCoins(ctx.BlockTime()),
+	}, nil
+}
+
+// VestingPositions returns the vesting positions of an account.
+func (k Keeper) VestingPositions(c context.Context, req *types.QueryVestingPositionsRequest) (*types.QueryVestingPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var positions []types.VestingPosition
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingPositionsByOwnerPrefix(owner))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		position, err := k.GetVestingPosition(ctx, types.ParseVestingPositionByOwnerKey(key))
+		if err != nil {
+			return err
+		}
+		positions = append(positions, position)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingPositionsResponse{
+		Positions:  positions,
+		Locked:     k.LockedPositionCoins(ctx, owner),
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Keeper manages the vesting positions of the accounts. It doesn't hold the
+// vesting coins, which stay in the balances of the owners of the positions and
+// are locked by the bank keeper.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of clawing back any ClawbackVestingAccount, usually
+	// the gov module account. No such address if empty.
+	authority string
+}
+
+// NewKeeper creates a vesting Keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/vesting module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// CreateVestingPosition stores a new vesting position, assigning it the next
+// vesting position id, and returns it. It fails if the owner already holds the
+// maximum number of vesting positions.
+func (k Keeper) CreateVestingPosition(ctx sdk.Context, owner, funder sdk.AccAddress, amount sdk.Coins, startTime, cliffTime, endTime int64) (types.VestingPosition, error) {
+	if k.HasMaxVestingPositions(ctx, owner) {
+		return types.VestingPosition{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s already holds %d vesting positions", owner, types.MaxVestingPositions)
+	}
+
+	position := types.NewVestingPosition(k.nextVestingPositionID(ctx), owner, funder, amount, startTime, cliffTime, endTime)
+	if err := position.Validate(); err != nil {
+		return types.VestingPosition{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetVestingPosition(ctx, position)
+	return position, nil
+}
+
+// OfferVestingPosition offers a vesting position to a recipient, replacing any
+// previous offer. The position stays with its owner until the recipient
+// accepts it.
+func (k Keeper) OfferVestingPosition(ctx sdk.Context, position types.VestingPosition, recipient sdk.AccAddress) (types.VestingPosition, error) {
+	position.Recipient = recipient.String()
+	if err := position.Validate(); err != nil {
+		return types.VestingPosition{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetVestingPosition(ctx, position)
+	return position, nil
+}
+
+// HasMaxVestingPositions returns whether an account holds the maximum number of
+// vesting positions.
+func (k Keeper) HasMaxVestingPositions(ctx sdk.Context, owner sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingPositionsByOwnerPrefix(owner))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+		if count >= types.MaxVestingPositions {
+			return true
+		}
+	}
+	return false
+}
+
+// GetVestingPosition returns a vesting position by its id.
+func (k Keeper) GetVestingPosition(ctx sdk.Context, id uint64) (types.VestingPosition, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.VestingPositionKey(id))
+	if bz == nil {
+		return types.VestingPosition{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "vesting position %d", id)
+	}
+
+	var position types.VestingPosition
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, nil
+}
+
+// SetVestingPosition stores a vesting position and indexes it by owner and by
+// end time.
+func (k Keeper) SetVestingPosition(ctx sdk.Context, position types.VestingPosition) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VestingPositionKey(position.Id), k.cdc.MustMarshal(&position))
+	store.Set(types.VestingPositionByOwnerKey(position.GetOwnerAddress(), position.Id), []byte{})
+	store.Set(types.VestingPositionByEndTimeKey(position.EndTime, position.Id), []byte{})
+}
+
+// DeleteVestingPosition removes a vesting position and its indexes.
+func (k Keeper) DeleteVestingPosition(ctx sdk.Context, position types.VestingPosition) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VestingPositionKey(position.Id))
+	store.Delete(types.VestingPositionByOwnerKey(position.GetOwnerAddress(), position.Id))
+	store.Delete(types.VestingPositionByEndTimeKey(position.EndTime, position.Id))
+}
+
+// RemoveVestedPositions removes up to limit vesting positions which have fully
+// vested by the block time, along with any pending offer of them. Their coins
+// are no longer locked, so removing them doesn't change any balance.
+func (k Keeper) RemoveVestedPositions(ctx sdk.Context, limit int) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.VestingPositionByEndTimeKeyPrefix, types.VestingPositionsByEndTimePrefix(ctx.BlockTime().Unix()+1))
+	defer iter.Close()
+
+	var positions []types.VestingPosition
+	for ; iter.Valid() && len(positions) < limit; iter.Next() {
+		position, err := k.GetVestingPosition(ctx, types.ParseVestingPositionByEndTimeKey(iter.Key()))
+		if err != nil {
+			panic(err)
+		}
+		positions = append(positions, position)
+	}
+
+	for _, position := range positions {
+		k.DeleteVestingPosition(ctx, position)
+	}
+}
+
+// IterateVestingPositions iterates over the vesting positions of an owner,
+// ordered by id, until cb returns true.
+func (k Keeper) IterateVestingPositions(ctx sdk.Context, owner sdk.AccAddress, cb func(position types.VestingPosition) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingPositionsByOwnerPrefix(owner))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		position, err := k.GetVestingPosition(ctx, types.ParseVestingPositionByOwnerKey(iter.Key()))
+		if err != nil {
+			panic(err)
+		}
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// IterateAllVestingPositions iterates over all the vesting positions, ordered
+// by id, until cb returns true.
+func (k Keeper) IterateAllVestingPositions(ctx sdk.Context, cb func(position types.VestingPosition) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VestingPositionKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var position types.VestingPosition
+		k.cdc.MustUnmarshal(iter.Value(), &position)
+		if cb(position) {
+			break
+		}
+	}
+}
+
+// LockedPositionCoins returns the coins of an account locked by its vesting
+// positions at the block time. It is used by the bank keeper to compute the
+// locked coins of the account, on top of the coins locked by the vesting
+// account itself.
+func (k Keeper) LockedPositionCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+	k.IterateVestingPositions(ctx, addr, func(position types.VestingPosition) bool {
+		locked = locked.Add(position.LockedCoins(ctx.BlockTime())...)
+		return false
+	})
+	return locked
+}
+
+// nextVestingPositionID returns the id of the next vesting position and
+// increments it.
+func (k Keeper) nextVestingPositionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	id := uint64(1)
+	if bz := store.Get(types.NextVestingPositionIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextVestingPositionIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+// InitGenesis initializes the vesting positions from a genesis state. The next
+// vesting position id follows the highest id of the genesis positions.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	nextID := uint64(1)
+	for _, position := range data.Positions {
+		k.SetVestingPosition(ctx, position)
+		if position.Id >= nextID {
+			nextID = position.Id + 1
+		}
+	}
+	ctx.KVStore(k.storeKey).Set(types.NextVestingPositionIDKey, sdk.Uint64ToBigEndian(nextID))
+}
+
+// ExportGenesis returns the vesting positions as a genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	positions := []types.VestingPosition{}
+	k.IterateAllVestingPositions(ctx, func(position types.VestingPosition) bool {
+		positions = append(positions, position)
+		return false
+	})
+	return types.NewGenesisState(positions)
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	funder = sdk.AccAddress("funder______________")
+	owner1 = sdk.AccAddress("owner1______________")
+	owner2 = sdk.AccAddress("owner2______________")
+	coins  = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Unix(1_000_000, 0)})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, authtypes.NewModuleAddress("gov").String())
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, vesting.NewQueryServerImpl(authkeeper.AccountKeeper{}, nil, nil, s.keeper))
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (s *KeeperTestSuite) TestVestingPositions() {
+	now := s.ctx.BlockTime().Unix()
+
+	p1, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+100)
+	s.Require().NoError(err)
+	p2, err := s.keeper.CreateVestingPosition(s.ctx, owner2, funder, coins, now, now+100, now+100)
+	s.Require().NoError(err)
+	p3, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now+50, 0, now+150)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2, 3}, []uint64{p1.Id, p2.Id, p3.Id})
+
+	_, err = s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now)
+	s.Require().ErrorContains(err, "start-time must be before end-time")
+
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(60 * time.Second))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 130)), s.keeper.LockedPositionCoins(ctx, owner1))
+	s.Require().Equal(coins, s.keeper.LockedPositionCoins(ctx, owner2))
+
+	// the queries are served at the start of vesting
+	res, err := s.queryClient.VestingPosition(ctx, &types.QueryVestingPositionRequest{Id: p3.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(p3, res.Position)
+	s.Require().Equal(coins, res.Locked)
+
+	_, err = s.queryClient.VestingPosition(ctx, &types.QueryVestingPositionRequest{Id: 4})
+	s.Require().ErrorContains(err, "not found")
+
+	positions, err := s.queryClient.VestingPositions(ctx, &types.QueryVestingPositionsRequest{Owner: owner1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.VestingPosition{p1, p3}, positions.Positions)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), positions.Locked)
+
+	positions, err = s.queryClient.VestingPositions(ctx, &types.QueryVestingPositionsRequest{Owner: owner1.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.VestingPosition{p1}, positions.Positions)
+	s.Require().Equal(uint64(2), positions.Pagination.Total)
+
+	s.keeper.DeleteVestingPosition(s.ctx, p1)
+	_, err = s.keeper.GetVestingPosition(s.ctx, p1.Id)
+	s.Require().Error(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), s.keeper.LockedPositionCoins(ctx, owner1))
+}
+
+func (s *KeeperTestSuite) TestMaxVestingPositions() {
+	now := s.ctx.BlockTime().Unix()
+
+	for i := 0; i < types.MaxVestingPositions; i++ {
+		s.Require().False(s.keeper.HasMaxVestingPositions(s.ctx, owner1))
+		_, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+100)
+		s.Require().NoError(err)
+	}
+	s.Require().True(s.keeper.HasMaxVestingPositions(s.ctx, owner1))
+
+	_, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+100)
+	s.Require().ErrorContains(err, "already holds 16 vesting positions")
+	_, err = s.keeper.CreateVestingPosition(s.ctx, owner2, funder, coins, now, 0, now+100)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestOfferVestingPosition() {
+	now := s.ctx.BlockTime().Unix()
+	position, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+100)
+	s.Require().NoError(err)
+
+	_, err = s.keeper.OfferVestingPosition(s.ctx, position, owner1)
+	s.Require().ErrorContains(err, "cannot be offered to its owner")
+
+	offered, err := s.keeper.OfferVestingPosition(s.ctx, position, owner2)
+	s.Require().NoError(err)
+	s.Require().Equal(owner2.String(), offered.Recipient)
+
+	// the offered position stays with its owner
+	stored, err := s.keeper.GetVestingPosition(s.ctx, position.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(offered, stored)
+	s.Require().Equal(coins, s.keeper.LockedPositionCoins(s.ctx, owner1))
+	s.Require().True(s.keeper.LockedPositionCoins(s.ctx, owner2).IsZero())
+}
+
+func (s *KeeperTestSuite) TestRemoveVestedPositions() {
+	now := s.ctx.BlockTime().Unix()
+	p1, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+100)
+	s.Require().NoError(err)
+	p2, err := s.keeper.CreateVestingPosition(s.ctx, owner2, funder, coins, now, 0, now+100)
+	s.Require().NoError(err)
+	p3, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+150)
+	s.Require().NoError(err)
+
+	// nothing has fully vested yet
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(99 * time.Second))
+	s.keeper.RemoveVestedPositions(ctx, 10)
+	for _, p := range []types.VestingPosition{p1, p2, p3} {
+		_, err := s.keeper.GetVestingPosition(ctx, p.Id)
+		s.Require().NoError(err)
+	}
+
+	// the removals are limited
+	ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(100 * time.Second))
+	s.keeper.RemoveVestedPositions(ctx, 1)
+	_, err = s.keeper.GetVestingPosition(ctx, p1.Id)
+	s.Require().ErrorContains(err, "not found")
+	_, err = s.keeper.GetVestingPosition(ctx, p2.Id)
+	s.Require().NoError(err)
+
+	s.keeper.RemoveVestedPositions(ctx, 10)
+	_, err = s.keeper.GetVestingPosition(ctx, p2.Id)
+	s.Require().ErrorContains(err, "not found")
+	_, err = s.keeper.GetVestingPosition(ctx, p3.Id)
+	s.Require().NoError(err)
+	s.Require().False(s.keeper.HasMaxVestingPositions(ctx, owner2))
+
+	positions, err := s.queryClient.VestingPositions(ctx, &types.QueryVestingPositionsRequest{Owner: owner1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.VestingPosition{p3}, positions.Positions)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	now := s.ctx.BlockTime().Unix()
+	genesis := types.NewGenesisState([]types.VestingPosition{
+		types.NewVestingPosition(2, owner1, funder, coins, now, 0, now+100),
+		types.NewVestingPosition(5, owner2, funder, coins, now, 0, now+100),
+	})
+
+	s.keeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.keeper.ExportGenesis(s.ctx))
+
+	// new positions follow the highest genesis id
+	position, err := s.keeper.CreateVestingPosition(s.ctx, owner1, funder, coins, now, 0, now+100)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), position.Id)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
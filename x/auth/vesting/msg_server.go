@@ -10,18 +10,23 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingkeeper "github.com/cosmos/cosmos-sdk/x/auth/vesting/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+
+	stakingKeeper types.StakingKeeper
+	vestingKeeper vestingkeeper.Keeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper, StakingKeeper and
+// vesting Keeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, vk vestingkeeper.Keeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, stakingKeeper: sk, vestingKeeper: vk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -310,13 +315,22 @@ func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *type
 }
 
 // Clawback removes the unvested amount from a ClawbackVestingAccount.
-// The destination defaults to the funder address, but can be overridden.
+// It can be requested by the funder of the account or by the authority of the
+// module. The destination defaults to the funder address, but can be
+// overridden.
 func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	accountKeeper := s.AccountKeeper
 	bankKeeper := s.BankKeeper
 
-	funder, err := sdk.AccAddressFromBech32(msg.GetFunderAddress())
+	byAuthority := msg.GetAuthority() != ""
+	if byAuthority && s.vestingKeeper.GetAuthority() != msg.GetAuthority() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %q, got %s", s.vestingKeeper.GetAuthority(), msg.GetAuthority())
+	}
+	requestor, err := sdk.AccAddressFromBech32(msg.GetFunderAddress())
+	if byAuthority {
+		requestor, err = sdk.AccAddressFromBech32(msg.GetAuthority())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -326,20 +340,6 @@ func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 		return nil, err
 	}
 
-	dest := funder
-	if msg.GetDestAddress() != "" {
-		dest, err = sdk.AccAddressFromBech32(msg.GetDestAddress())
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if bankKeeper.BlockedAddr(dest) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
-			"%s is not allowed to receive funds", msg.DestAddress,
-		)
-	}
-
 	// Check if account exists
 	account := accountKeeper.GetAccount(ctx, addr)
 	if account == nil {
@@ -352,12 +352,33 @@ func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account not subject to clawback: %s", msg.Address)
 	}
 
-	// Check if account funder is same as in msg
-	if vestingAccount.FunderAddress != msg.FunderAddress {
+	// Check if account funder is same as in msg, unless requested by the
+	// module authority
+	if !byAuthority && vestingAccount.FunderAddress != msg.FunderAddress {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "clawback can only be requested by original funder %s", vestingAccount.FunderAddress)
 	}
 
-	clawbackAction := types.NewClawbackAction(funder, dest, accountKeeper, bankKeeper)
+	dest, err := sdk.AccAddressFromBech32(vestingAccount.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.GetDestAddress() != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.GetDestAddress())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bankKeeper.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
+			"%s is not allowed to receive funds", dest,
+		)
+	}
+
+	clawbackAction := types.NewClawbackAction(requestor, dest, accountKeeper, bankKeeper, s.stakingKeeper)
+	if byAuthority {
+		clawbackAction = types.NewAuthorityClawbackAction(requestor, dest, accountKeeper, bankKeeper, s.stakingKeeper)
+	}
 
 	// Perform clawback transfer,
 	// this updates state for both the vesting account and the destination account.
@@ -368,3 +389,127 @@ func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*typ
 
 	return &types.MsgClawbackResponse{}, nil
 }
+
+// CreateVestingPosition offers a new vesting position to an account, which
+// may already exist. The position and its coins stay with the sender until the
+// recipient accepts it, unless the sender is the recipient.
+func (s msgServer) CreateVestingPosition(goCtx context.Context, msg *types.MsgCreateVestingPosition) (*types.MsgCreateVestingPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bk := s.BankKeeper
+
+	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	// the coins locked by the position must not be locked already
+	if spendable := bk.SpendableCoins(ctx, from); !spendable.IsAllGTE(msg.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, msg.Amount)
+	}
+
+	position, err := s.vestingKeeper.CreateVestingPosition(ctx, from, from, msg.Amount.Sort(), msg.StartTime, msg.CliffTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if !from.Equals(to) {
+		if position, err = s.vestingKeeper.OfferVestingPosition(ctx, position, to); err != nil {
+			return nil, err
+		}
+	}
+
+	defer func() {
+		for _, a := range msg.Amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_vesting_position"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgCreateVestingPositionResponse{Id: position.Id}, nil
+}
+
+// TransferVestingPosition offers a vesting position to another account,
+// replacing any previous offer. The position stays with its owner until the
+// recipient accepts it.
+func (s msgServer) TransferVestingPosition(goCtx context.Context, msg *types.MsgTransferVestingPosition) (*types.MsgTransferVestingPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(recipient) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+
+	position, err := s.vestingKeeper.GetVestingPosition(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if position.Owner != msg.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "vesting position %d is not owned by %s", msg.Id, msg.Owner)
+	}
+
+	if _, err := s.vestingKeeper.OfferVestingPosition(ctx, position, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferVestingPositionResponse{}, nil
+}
+
+// AcceptVestingPosition transfers a vesting position to the account it is
+// offered to, along with the coins of the position which are still locked. The
+// vesting schedule of the position is kept.
+func (s msgServer) AcceptVestingPosition(goCtx context.Context, msg *types.MsgAcceptVestingPosition) (*types.MsgAcceptVestingPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := s.vestingKeeper.GetVestingPosition(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if position.Recipient != msg.Recipient {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "vesting position %d is not offered to %s", msg.Id, msg.Recipient)
+	}
+	if s.vestingKeeper.HasMaxVestingPositions(ctx, recipient) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s already holds %d vesting positions", msg.Recipient, types.MaxVestingPositions)
+	}
+
+	// the position is removed first so that its coins are no longer locked in
+	// the balance of the owner
+	s.vestingKeeper.DeleteVestingPosition(ctx, position)
+
+	locked := position.LockedCoins(ctx.BlockTime())
+	if !locked.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, position.GetOwnerAddress(), recipient, locked); err != nil {
+			return nil, err
+		}
+	}
+
+	position.Owner = msg.Recipient
+	position.Recipient = ""
+	s.vestingKeeper.SetVestingPosition(ctx, position)
+
+	return &types.MsgAcceptVestingPositionResponse{}, nil
+}
@@ -1,22 +1,28 @@
 package vesting_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtime "github.com/cometbft/cometbft/types/time"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
-	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingkeeper "github.com/cosmos/cosmos-sdk/x/auth/vesting/keeper"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -34,19 +40,27 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
+	vestingKeeper vestingkeeper.Keeper
 	msgServer     vestingtypes.MsgServer
 }
 
 func (s *VestingTestSuite) SetupTest() {
 	key := sdk.NewKVStoreKey(authtypes.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
-	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	vestingKey := sdk.NewKVStoreKey(vestingtypes.StoreKey)
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(vestingKey, storetypes.StoreTypeIAVL, db)
+	s.Require().NoError(cms.LoadLatestVersion())
+	s.ctx = sdk.NewContext(cms, tmproto.Header{Time: tmtime.Now()}, false, log.NewNopLogger())
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	maccPerms := map[string][]string{}
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		key,
@@ -58,7 +72,8 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.vestingKeeper = vestingkeeper.NewKeeper(encCfg.Codec, vestingKey, authtypes.NewModuleAddress("gov").String())
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.stakingKeeper, s.vestingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -253,6 +268,336 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateVestingPosition() {
+	now := s.ctx.BlockTime().Unix()
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *vestingtypes.MsgCreateVestingPosition
+		expErrMsg string
+	}{
+		"send disabled": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(fmt.Errorf("foo transfers are currently disabled"))
+			},
+			input:     vestingtypes.NewMsgCreateVestingPosition(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, 0, now+100),
+			expErrMsg: "foo transfers are currently disabled",
+		},
+		"blocked recipient": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreateVestingPosition(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, 0, now+100),
+			expErrMsg: "not allowed to receive funds",
+		},
+		"insufficient spendable coins": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), fromAddr).Return(sdk.NewCoins(sdk.NewInt64Coin("foo", 99)))
+			},
+			input:     vestingtypes.NewMsgCreateVestingPosition(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, 0, now+100),
+			expErrMsg: "insufficient funds",
+		},
+		"position offered to an existing account": {
+			preRun: func() {
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr))
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), fooCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), fromAddr).Return(sdk.NewCoins(fooCoin))
+			},
+			input: vestingtypes.NewMsgCreateVestingPosition(fromAddr, to1Addr, sdk.Coins{fooCoin}, now, now+50, now+100),
+		},
+		"position for the sender": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), fromAddr).Return(sdk.NewCoins(periodCoin))
+			},
+			input: vestingtypes.NewMsgCreateVestingPosition(fromAddr, fromAddr, sdk.Coins{periodCoin}, now, 0, now+100),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			tc.preRun()
+			res, err := s.msgServer.CreateVestingPosition(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			position, err := s.vestingKeeper.GetVestingPosition(s.ctx, res.Id)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.input.FromAddress, position.Owner)
+			s.Require().Equal(tc.input.FromAddress, position.Funder)
+			s.Require().Equal(tc.input.Amount, position.Amount)
+			if tc.input.ToAddress == tc.input.FromAddress {
+				s.Require().Empty(position.Recipient)
+			} else {
+				s.Require().Equal(tc.input.ToAddress, position.Recipient)
+			}
+		})
+	}
+
+	// both positions lock the coins of the sender at the start of vesting
+	s.Require().Equal(sdk.NewCoins(fooCoin.Add(periodCoin)), s.vestingKeeper.LockedPositionCoins(s.ctx, fromAddr))
+	s.Require().True(s.vestingKeeper.LockedPositionCoins(s.ctx, to1Addr).IsZero())
+	// only the position without cliff has partly vested before the cliff
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(25 * time.Second))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 115)), s.vestingKeeper.LockedPositionCoins(ctx, fromAddr))
+	// both positions have vested after the end of vesting
+	ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(100 * time.Second))
+	s.Require().True(s.vestingKeeper.LockedPositionCoins(ctx, fromAddr).IsZero())
+}
+
+func (s *VestingTestSuite) TestTransferVestingPosition() {
+	now := s.ctx.BlockTime().Unix()
+	position, err := s.vestingKeeper.CreateVestingPosition(s.ctx, to1Addr, fromAddr, sdk.Coins{fooCoin}, now, 0, now+100)
+	s.Require().NoError(err)
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(40 * time.Second))
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *vestingtypes.MsgTransferVestingPosition
+		expErrMsg string
+	}{
+		"blocked recipient": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgTransferVestingPosition(to1Addr, position.Id, to2Addr),
+			expErrMsg: "not allowed to receive funds",
+		},
+		"unknown position": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgTransferVestingPosition(to1Addr, position.Id+1, to2Addr),
+			expErrMsg: "not found",
+		},
+		"not the owner": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgTransferVestingPosition(to3Addr, position.Id, to2Addr),
+			expErrMsg: "is not owned by",
+		},
+		"offer to another account": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input: vestingtypes.NewMsgTransferVestingPosition(to1Addr, position.Id, to2Addr),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			tc.preRun()
+			_, err := s.msgServer.TransferVestingPosition(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	// the position stays with its owner until the recipient accepts it
+	offered, err := s.vestingKeeper.GetVestingPosition(ctx, position.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(to1Addr.String(), offered.Owner)
+	s.Require().Equal(to2Addr.String(), offered.Recipient)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 60)), s.vestingKeeper.LockedPositionCoins(ctx, to1Addr))
+	s.Require().True(s.vestingKeeper.LockedPositionCoins(ctx, to2Addr).IsZero())
+}
+
+func (s *VestingTestSuite) TestAcceptVestingPosition() {
+	now := s.ctx.BlockTime().Unix()
+	position, err := s.vestingKeeper.CreateVestingPosition(s.ctx, to1Addr, fromAddr, sdk.Coins{fooCoin}, now, 0, now+100)
+	s.Require().NoError(err)
+	position, err = s.vestingKeeper.OfferVestingPosition(s.ctx, position, to2Addr)
+	s.Require().NoError(err)
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(40 * time.Second))
+
+	// to3 holds the maximum number of positions
+	full, err := s.vestingKeeper.CreateVestingPosition(s.ctx, to1Addr, fromAddr, sdk.Coins{fooCoin}, now, 0, now+100)
+	s.Require().NoError(err)
+	_, err = s.vestingKeeper.OfferVestingPosition(s.ctx, full, to3Addr)
+	s.Require().NoError(err)
+	for i := 0; i < vestingtypes.MaxVestingPositions; i++ {
+		_, err := s.vestingKeeper.CreateVestingPosition(s.ctx, to3Addr, fromAddr, sdk.Coins{fooCoin}, now, 0, now+100)
+		s.Require().NoError(err)
+	}
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *vestingtypes.MsgAcceptVestingPosition
+		expErrMsg string
+	}{
+		"unknown position": {
+			input:     vestingtypes.NewMsgAcceptVestingPosition(to2Addr, position.Id+100),
+			expErrMsg: "not found",
+		},
+		"not the recipient": {
+			input:     vestingtypes.NewMsgAcceptVestingPosition(to3Addr, position.Id),
+			expErrMsg: "is not offered to",
+		},
+		"recipient holding the maximum number of positions": {
+			input:     vestingtypes.NewMsgAcceptVestingPosition(to3Addr, full.Id),
+			expErrMsg: "already holds 16 vesting positions",
+		},
+		"accept with the unvested coins": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, to2Addr, sdk.NewCoins(sdk.NewInt64Coin("foo", 60))).Return(nil)
+			},
+			input: vestingtypes.NewMsgAcceptVestingPosition(to2Addr, position.Id),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.AcceptVestingPosition(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	accepted, err := s.vestingKeeper.GetVestingPosition(ctx, position.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(to2Addr.String(), accepted.Owner)
+	s.Require().Empty(accepted.Recipient)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 60)), s.vestingKeeper.LockedPositionCoins(ctx, to1Addr))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 60)), s.vestingKeeper.LockedPositionCoins(ctx, to2Addr))
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+	valAddr := sdk.ValAddress([]byte("val__________________"))
+	authority := authtypes.NewModuleAddress("gov")
+
+	// setupAccount creates a clawback vesting account funded by fromAddr, with
+	// 100stake vesting in 100 seconds, of which 60stake are delegated and
+	// 10stake are unbonding.
+	setupAccount := func(addr sdk.AccAddress) {
+		baseAccount := authtypes.NewBaseAccountWithAddress(addr)
+		va := vestingtypes.NewClawbackVestingAccount(baseAccount, fromAddr, stake(100), s.ctx.BlockTime().Unix(),
+			vestingtypes.Periods{{Length: 0, Amount: stake(100)}}, vestingtypes.Periods{{Length: 100, Amount: stake(100)}})
+		va.DelegatedVesting = stake(70)
+		s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, va))
+	}
+	// expectClawback expects a clawback to dest of which unbondingAmt of the
+	// 10stake unbonding can be transferred.
+	expectClawback := func(addr, dest sdk.AccAddress, unbondingAmt int64) {
+		validator := stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: sdk.NewInt(100), DelegatorShares: sdk.NewDec(100)}
+
+		s.bankKeeper.EXPECT().BlockedAddr(dest).Return(false)
+		s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake")
+		s.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), addr).Return(sdk.NewInt(60))
+		s.stakingKeeper.EXPECT().GetDelegatorUnbonding(gomock.Any(), addr).Return(sdk.NewInt(10))
+		s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), addr).Return(stake(30))
+		s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), addr).Return(stake(30))
+		s.bankKeeper.EXPECT().SendCoins(gomock.Any(), addr, dest, stake(30)).Return(nil)
+		s.stakingKeeper.EXPECT().IterateDelegatorUnbondingDelegations(gomock.Any(), addr, gomock.Any()).
+			Do(func(_ sdk.Context, _ sdk.AccAddress, cb func(stakingtypes.UnbondingDelegation) bool) {
+				cb(stakingtypes.UnbondingDelegation{DelegatorAddress: addr.String(), ValidatorAddress: valAddr.String()})
+			})
+		s.stakingKeeper.EXPECT().IterateDelegatorDelegations(gomock.Any(), addr, gomock.Any()).
+			Do(func(_ sdk.Context, _ sdk.AccAddress, cb func(stakingtypes.Delegation) bool) {
+				cb(stakingtypes.NewDelegation(addr, valAddr, sdk.NewDec(60)))
+			})
+		s.stakingKeeper.EXPECT().TransferUnbonding(gomock.Any(), addr, dest, valAddr, sdk.NewInt(70)).Return(sdk.NewInt(unbondingAmt))
+		s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, true)
+		s.stakingKeeper.EXPECT().TransferDelegation(gomock.Any(), addr, dest, valAddr, sdk.NewDec(70-unbondingAmt)).Return(sdk.NewDec(60), nil)
+	}
+
+	to4Addr := sdk.AccAddress([]byte("to4__________________"))
+	setupAccount(to1Addr)
+	setupAccount(to2Addr)
+	setupAccount(to4Addr)
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to3Addr))
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErrMsg string
+	}{
+		"not a clawback vesting account": {
+			preRun:    func() {},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to3Addr, nil),
+			expErrMsg: "account not subject to clawback",
+		},
+		"not the funder nor the authority": {
+			preRun:    func() {},
+			input:     vestingtypes.NewMsgClawback(to2Addr, to1Addr, nil),
+			expErrMsg: "clawback can only be requested by original funder",
+		},
+		"authority as the funder": {
+			preRun:    func() {},
+			input:     vestingtypes.NewMsgClawback(authority, to1Addr, nil),
+			expErrMsg: "clawback can only be requested by original funder",
+		},
+		"not the authority": {
+			preRun:    func() {},
+			input:     vestingtypes.NewMsgAuthorityClawback(to2Addr, to1Addr, nil),
+			expErrMsg: "invalid authority",
+		},
+		"blocked destination": {
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to3Addr),
+			expErrMsg: "not allowed to receive funds",
+		},
+		"unbonding entries not transferable": {
+			preRun: func() {
+				expectClawback(to4Addr, to3Addr, 0)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to4Addr, to3Addr),
+			expErrMsg: "cannot claw back 10stake of the unvested tokens",
+		},
+		"clawback by the funder": {
+			preRun: func() {
+				expectClawback(to1Addr, to3Addr, 10)
+			},
+			input: vestingtypes.NewMsgClawback(fromAddr, to1Addr, to3Addr),
+		},
+		"clawback by the authority to the funder": {
+			preRun: func() {
+				expectClawback(to2Addr, fromAddr, 10)
+			},
+			input: vestingtypes.NewMsgAuthorityClawback(authority, to2Addr, nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			tc.preRun()
+			_, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			va := s.accountKeeper.GetAccount(s.ctx, sdk.MustAccAddressFromBech32(tc.input.Address)).(*vestingtypes.ClawbackVestingAccount)
+			s.Require().True(va.OriginalVesting.IsZero())
+			s.Require().True(va.DelegatedVesting.IsZero())
+			s.Require().True(va.DelegatedFree.IsZero())
+		})
+	}
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
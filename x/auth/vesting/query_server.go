@@ -0,0 +1,71 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	vestingkeeper "github.com/cosmos/cosmos-sdk/x/auth/vesting/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	vestingkeeper.Keeper
+
+	ak keeper.AccountKeeper
+	bk types.BankKeeper
+	sk types.StakingKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, serving the vesting positions from the vesting Keeper and the
+// clawback previews from the AccountKeeper, BankKeeper and StakingKeeper.
+func NewQueryServerImpl(ak keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, vk vestingkeeper.Keeper) types.QueryServer {
+	return &queryServer{Keeper: vk, ak: ak, bk: bk, sk: sk}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// ClawbackPreview returns what a clawback of a ClawbackVestingAccount to its
+// funder would transfer at the current block time.
+func (s queryServer) ClawbackPreview(c context.Context, req *types.QueryClawbackPreviewRequest) (*types.QueryClawbackPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account := s.ak.GetAccount(ctx, addr)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s does not exist", req.Address)
+	}
+
+	vestingAccount, ok := account.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account not subject to clawback: %s", req.Address)
+	}
+
+	funder, err := sdk.AccAddressFromBech32(vestingAccount.FunderAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res, err := vestingAccount.PreviewClawback(ctx, funder, s.ak, s.bk, s.sk)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClawbackPreviewResponse{
+		Spendable: res.Spendable,
+		Unbonding: res.Unbonding,
+		Delegated: res.Delegated,
+	}, nil
+}
@@ -27,6 +27,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCreatePermanentLockedAccount{}, "cosmos-sdk/MsgCreatePermLockedAccount")
 	legacy.RegisterAminoMsg(cdc, &MsgCreatePeriodicVestingAccount{}, "cosmos-sdk/MsgCreatePeriodVestAccount")
 	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgCreateVestingPosition{}, "cosmos-sdk/MsgCreateVestingPosition")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferVestingPosition{}, "cosmos-sdk/MsgTransferVestingPosition")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptVestingPosition{}, "cosmos-sdk/MsgAcceptVestingPosition")
 }
 
 // RegisterInterface associates protoName with AccountI and VestingAccount
@@ -67,6 +70,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateVestingAccount{},
 		&MsgCreatePermanentLockedAccount{},
 		&MsgCreateClawbackVestingAccount{},
+		&MsgCreateVestingPosition{},
+		&MsgTransferVestingPosition{},
+		&MsgAcceptVestingPosition{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
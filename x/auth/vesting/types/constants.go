@@ -4,6 +4,15 @@ const (
 	// ModuleName defines the module's name.
 	ModuleName = "vesting"
 
+	// StoreKey defines the store key of the module, which holds the vesting
+	// positions.
+	StoreKey = ModuleName
+
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// MaxVestingPositions is the maximum number of vesting positions an account
+	// can hold, including the positions it offers to other accounts, so that
+	// the cost of computing its locked coins stays bounded.
+	MaxVestingPositions = 16
 )
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(positions []VestingPosition) *GenesisState {
+	return &GenesisState{
+		Positions: positions,
+	}
+}
+
+// DefaultGenesisState returns a default vesting module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]VestingPosition{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	ids := make(map[uint64]bool, len(gs.Positions))
+	counts := make(map[string]int)
+	for _, p := range gs.Positions {
+		if ids[p.Id] {
+			return fmt.Errorf("duplicate vesting position id %d", p.Id)
+		}
+		ids[p.Id] = true
+
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid vesting position %d: %w", p.Id, err)
+		}
+
+		counts[p.Owner]++
+		if counts[p.Owner] > MaxVestingPositions {
+			return fmt.Errorf("%s holds more than %d vesting positions", p.Owner, MaxVestingPositions)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Keys for the vesting store
+// Items are stored with the following keys:
+//
+// - 0x01<id_Bytes>: VestingPosition
+//
+// - 0x02<owner_Bytes><id_Bytes>: []byte{}, index of the positions by owner
+//
+// - 0x03: next vesting position id
+//
+// - 0x04<end_time_Bytes><id_Bytes>: []byte{}, queue of the positions by end time
+var (
+	VestingPositionKeyPrefix          = []byte{0x01}
+	VestingPositionByOwnerKeyPrefix   = []byte{0x02}
+	NextVestingPositionIDKey          = []byte{0x03}
+	VestingPositionByEndTimeKeyPrefix = []byte{0x04}
+)
+
+// VestingPositionKey returns the key of a vesting position.
+func VestingPositionKey(id uint64) []byte {
+	return append(VestingPositionKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// VestingPositionsByOwnerPrefix returns the prefix of the index of the vesting
+// positions of an owner.
+func VestingPositionsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(VestingPositionByOwnerKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// VestingPositionByOwnerKey returns the key of the index of a vesting position
+// by owner.
+func VestingPositionByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(VestingPositionsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseVestingPositionByOwnerKey returns the id of a vesting position from its
+// key in the index by owner, stripped of the owner prefix.
+func ParseVestingPositionByOwnerKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
+
+// VestingPositionsByEndTimePrefix returns the prefix of the queue of the
+// vesting positions ending at endTime.
+func VestingPositionsByEndTimePrefix(endTime int64) []byte {
+	return append(VestingPositionByEndTimeKeyPrefix, sdk.Uint64ToBigEndian(uint64(endTime))...)
+}
+
+// VestingPositionByEndTimeKey returns the key of a vesting position in the
+// queue of the positions by end time.
+func VestingPositionByEndTimeKey(endTime int64, id uint64) []byte {
+	return append(VestingPositionsByEndTimePrefix(endTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ParseVestingPositionByEndTimeKey returns the id of a vesting position from
+// its key in the queue of the positions by end time.
+func ParseVestingPositionByEndTimeKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(VestingPositionByEndTimeKeyPrefix)+8:])
+}
@@ -14,9 +14,15 @@ const (
 	TypeMsgClawback                     = "msg_clawback"
 	TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account"
 	TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
+	TypeMsgCreateVestingPosition        = "msg_create_vesting_position"
+	TypeMsgTransferVestingPosition      = "msg_transfer_vesting_position"
+	TypeMsgAcceptVestingPosition        = "msg_accept_vesting_position"
 )
 
-var _, _, _, _, _ sdk.Msg = &MsgCreateVestingAccount{}, &MsgCreateVestingAccount{}, &MsgClawback{}, &MsgCreatePermanentLockedAccount{}, &MsgCreatePeriodicVestingAccount{}
+var (
+	_, _, _, _, _ sdk.Msg = &MsgCreateVestingAccount{}, &MsgCreateVestingAccount{}, &MsgClawback{}, &MsgCreatePermanentLockedAccount{}, &MsgCreatePeriodicVestingAccount{}
+	_, _, _       sdk.Msg = &MsgCreateVestingPosition{}, &MsgTransferVestingPosition{}, &MsgAcceptVestingPosition{}
+)
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //
@@ -180,6 +186,23 @@ func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
 	}
 }
 
+// NewMsgAuthorityClawback returns a reference to a new MsgClawback requested by
+// the authority of the module. The dest address may be nil - defaulting to the
+// funder.
+//
+//nolint:interfacer
+func NewMsgAuthorityClawback(authority, addr, dest sdk.AccAddress) *MsgClawback {
+	var destString string
+	if dest != nil {
+		destString = dest.String()
+	}
+	return &MsgClawback{
+		Authority:   authority.String(),
+		Address:     addr.String(),
+		DestAddress: destString,
+	}
+}
+
 // Route returns the message route for a MsgClawback.
 func (msg MsgClawback) Route() string { return RouterKey }
 
@@ -188,11 +211,15 @@ func (msg MsgClawback) Type() string { return TypeMsgClawback }
 
 // GetSigners returns the expected signers for a MsgClawback.
 func (msg MsgClawback) GetSigners() []sdk.AccAddress {
-	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	requestor := msg.FunderAddress
+	if msg.Authority != "" {
+		requestor = msg.Authority
+	}
+	addr, err := sdk.AccAddressFromBech32(requestor)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{funder}
+	return []sdk.AccAddress{addr}
 }
 
 // GetSignBytes returns the bytes all expected signers must sign over for a
@@ -203,12 +230,21 @@ func (msg MsgClawback) GetSignBytes() []byte {
 
 // ValidateBasic Implements Msg.
 func (msg MsgClawback) ValidateBasic() error {
-	funder, err := sdk.AccAddressFromBech32(msg.GetFunderAddress())
-	if err != nil {
-		return err
-	}
-	if err := sdk.VerifyAddressFormat(funder); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid funder address: %s", err)
+	switch {
+	case msg.GetFunderAddress() != "" && msg.GetAuthority() != "":
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "funder address and authority cannot both be set")
+	case msg.GetAuthority() != "":
+		if _, err := sdk.AccAddressFromBech32(msg.GetAuthority()); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+		}
+	default:
+		funder, err := sdk.AccAddressFromBech32(msg.GetFunderAddress())
+		if err != nil {
+			return err
+		}
+		if err := sdk.VerifyAddressFormat(funder); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid funder address: %s", err)
+		}
 	}
 
 	addr, err := sdk.AccAddressFromBech32(msg.GetAddress())
@@ -352,3 +388,145 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateVestingPosition returns a reference to a new MsgCreateVestingPosition.
+//
+//nolint:interfacer
+func NewMsgCreateVestingPosition(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, cliffTime, endTime int64) *MsgCreateVestingPosition {
+	return &MsgCreateVestingPosition{
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Amount:      amount,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+	}
+}
+
+// Route returns the message route for a MsgCreateVestingPosition.
+func (msg MsgCreateVestingPosition) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateVestingPosition.
+func (msg MsgCreateVestingPosition) Type() string { return TypeMsgCreateVestingPosition }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := ValidateVestingSchedule(msg.StartTime, msg.CliffTime, msg.EndTime); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateVestingPosition.
+func (msg MsgCreateVestingPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateVestingPosition.
+func (msg MsgCreateVestingPosition) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgTransferVestingPosition returns a reference to a new MsgTransferVestingPosition.
+//
+//nolint:interfacer
+func NewMsgTransferVestingPosition(owner sdk.AccAddress, id uint64, recipient sdk.AccAddress) *MsgTransferVestingPosition {
+	return &MsgTransferVestingPosition{
+		Owner:     owner.String(),
+		Id:        id,
+		Recipient: recipient.String(),
+	}
+}
+
+// Route returns the message route for a MsgTransferVestingPosition.
+func (msg MsgTransferVestingPosition) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgTransferVestingPosition.
+func (msg MsgTransferVestingPosition) Type() string { return TypeMsgTransferVestingPosition }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferVestingPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if msg.Owner == msg.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "owner and recipient cannot be the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgTransferVestingPosition.
+func (msg MsgTransferVestingPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgTransferVestingPosition.
+func (msg MsgTransferVestingPosition) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgAcceptVestingPosition returns a reference to a new MsgAcceptVestingPosition.
+//
+//nolint:interfacer
+func NewMsgAcceptVestingPosition(recipient sdk.AccAddress, id uint64) *MsgAcceptVestingPosition {
+	return &MsgAcceptVestingPosition{
+		Recipient: recipient.String(),
+		Id:        id,
+	}
+}
+
+// Route returns the message route for a MsgAcceptVestingPosition.
+func (msg MsgAcceptVestingPosition) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAcceptVestingPosition.
+func (msg MsgAcceptVestingPosition) Type() string { return TypeMsgAcceptVestingPosition }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptVestingPosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgAcceptVestingPosition.
+func (msg MsgAcceptVestingPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgAcceptVestingPosition.
+func (msg MsgAcceptVestingPosition) GetSigners() []sdk.AccAddress {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{recipient}
+}
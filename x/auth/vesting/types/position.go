@@ -0,0 +1,100 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVestingPosition returns a new VestingPosition. A zero cliffTime means that
+// the coins vest from startTime.
+func NewVestingPosition(id uint64, owner, funder sdk.AccAddress, amount sdk.Coins, startTime, cliffTime, endTime int64) VestingPosition {
+	if cliffTime == 0 {
+		cliffTime = startTime
+	}
+	return VestingPosition{
+		Id:        id,
+		Owner:     owner.String(),
+		Funder:    funder.String(),
+		Amount:    amount,
+		StartTime: startTime,
+		CliffTime: cliffTime,
+		EndTime:   endTime,
+	}
+}
+
+// GetVestedCoins returns the coins of the position which have vested by
+// blockTime. The coins vest linearly from the start time to the end time, but
+// none of them vest before the cliff time.
+func (p VestingPosition) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() < p.CliffTime || blockTime.Unix() <= p.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= p.EndTime {
+		return p.Amount
+	}
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - p.StartTime
+	y := p.EndTime - p.StartTime
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	for _, c := range p.Amount {
+		vestedAmt := sdk.NewDecFromInt(c.Amount).Mul(s).RoundInt()
+		vestedCoins = append(vestedCoins, sdk.NewCoin(c.Denom, vestedAmt))
+	}
+
+	return vestedCoins
+}
+
+// LockedCoins returns the coins of the position which have not vested yet by
+// blockTime.
+func (p VestingPosition) LockedCoins(blockTime time.Time) sdk.Coins {
+	return p.Amount.Sub(p.GetVestedCoins(blockTime)...)
+}
+
+// GetOwnerAddress returns the address of the owner of the position.
+func (p VestingPosition) GetOwnerAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(p.Owner)
+}
+
+// Validate checks for errors on the position fields
+func (p VestingPosition) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Owner); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Funder); err != nil {
+		return err
+	}
+	if p.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+			return err
+		}
+		if p.Recipient == p.Owner {
+			return errors.New("vesting position cannot be offered to its owner")
+		}
+	}
+	if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+		return errors.New("vesting position amount must be valid and positive")
+	}
+	return ValidateVestingSchedule(p.StartTime, p.CliffTime, p.EndTime)
+}
+
+// ValidateVestingSchedule checks that the cliff time, if any, is within the
+// vesting period, which must end after it starts.
+func ValidateVestingSchedule(startTime, cliffTime, endTime int64) error {
+	if startTime < 0 {
+		return errors.New("vesting start-time cannot be negative")
+	}
+	if startTime >= endTime {
+		return errors.New("vesting start-time must be before end-time")
+	}
+	if cliffTime != 0 && (cliffTime < startTime || cliffTime > endTime) {
+		return errors.New("vesting cliff-time must be between start-time and end-time")
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestVestingPositionGetVestedCoins(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	owner := sdk.AccAddress("owner")
+	funder := sdk.AccAddress("funder")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100))
+
+	continuous := types.NewVestingPosition(1, owner, funder, amount, now.Unix(), 0, now.Add(100*time.Second).Unix())
+	require.Equal(t, now.Unix(), continuous.CliffTime)
+	require.NoError(t, continuous.Validate())
+	require.Nil(t, continuous.GetVestedCoins(now))
+	require.Equal(t, amount, continuous.LockedCoins(now))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)), continuous.GetVestedCoins(now.Add(25*time.Second)))
+	require.Equal(t, amount, continuous.GetVestedCoins(now.Add(100*time.Second)))
+	require.True(t, continuous.LockedCoins(now.Add(100*time.Second)).IsZero())
+
+	withCliff := types.NewVestingPosition(2, owner, funder, amount, now.Unix(), now.Add(50*time.Second).Unix(), now.Add(100*time.Second).Unix())
+	require.NoError(t, withCliff.Validate())
+	require.Nil(t, withCliff.GetVestedCoins(now.Add(49*time.Second)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)), withCliff.GetVestedCoins(now.Add(50*time.Second)))
+
+	cliff := types.NewVestingPosition(3, owner, funder, amount, now.Unix(), now.Add(100*time.Second).Unix(), now.Add(100*time.Second).Unix())
+	require.NoError(t, cliff.Validate())
+	require.Equal(t, amount, cliff.LockedCoins(now.Add(99*time.Second)))
+	require.True(t, cliff.LockedCoins(now.Add(100*time.Second)).IsZero())
+}
+
+func TestVestingPositionValidate(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	funder := sdk.AccAddress("funder")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name     string
+		position types.VestingPosition
+		errMsg   string
+	}{
+		{"valid", types.NewVestingPosition(1, owner, funder, amount, 10, 15, 20), ""},
+		{"invalid owner", types.VestingPosition{Owner: "invalid", Funder: funder.String(), Amount: amount, StartTime: 10, EndTime: 20}, "decoding bech32 failed"},
+		{"empty amount", types.NewVestingPosition(1, owner, funder, sdk.Coins{}, 10, 0, 20), "must be valid and positive"},
+		{"end before start", types.NewVestingPosition(1, owner, funder, amount, 20, 0, 10), "start-time must be before end-time"},
+		{"cliff after end", types.NewVestingPosition(1, owner, funder, amount, 10, 30, 20), "cliff-time must be between"},
+		{"cliff before start", types.NewVestingPosition(1, owner, funder, amount, 10, 5, 20), "cliff-time must be between"},
+		{"offered", types.VestingPosition{Owner: owner.String(), Funder: funder.String(), Amount: amount, StartTime: 10, EndTime: 20, Recipient: funder.String()}, ""},
+		{"offered to the owner", types.VestingPosition{Owner: owner.String(), Funder: funder.String(), Amount: amount, StartTime: 10, EndTime: 20, Recipient: owner.String()}, "cannot be offered to its owner"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.position.Validate()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGenesisDuplicatePositions(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	position := types.NewVestingPosition(1, owner, owner, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100)), 10, 0, 20)
+
+	require.NoError(t, types.NewGenesisState([]types.VestingPosition{position}).Validate())
+	require.ErrorContains(t, types.NewGenesisState([]types.VestingPosition{position, position}).Validate(), "duplicate vesting position id 1")
+}
+
+func TestValidateGenesisMaxPositions(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	positions := make([]types.VestingPosition, types.MaxVestingPositions+1)
+	for i := range positions {
+		positions[i] = types.NewVestingPosition(uint64(i+1), owner, owner, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100)), 10, 0, 20)
+	}
+
+	require.NoError(t, types.NewGenesisState(positions[:types.MaxVestingPositions]).Validate())
+	require.ErrorContains(t, types.NewGenesisState(positions).Validate(), "holds more than 16 vesting positions")
+}
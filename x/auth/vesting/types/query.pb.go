@@ -0,0 +1,1775 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/vesting/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryVestingPositionRequest is the request type for the Query/VestingPosition
+// RPC method.
+type QueryVestingPositionRequest struct {
+	// id is the identifier of the vesting position.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryVestingPositionRequest) Reset()         { *m = QueryVestingPositionRequest{} }
+func (m *QueryVestingPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingPositionRequest) ProtoMessage()    {}
+func (*QueryVestingPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{0}
+}
+func (m *QueryVestingPositionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingPositionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingPositionRequest.Merge(m, src)
+}
+func (m *QueryVestingPositionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingPositionRequest proto.InternalMessageInfo
+
+func (m *QueryVestingPositionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryVestingPositionResponse is the response type for the
+// Query/VestingPosition RPC method.
+type QueryVestingPositionResponse struct {
+	// position is the vesting position.
+	Position VestingPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	// locked are the coins of the position which are still locked.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+}
+
+func (m *QueryVestingPositionResponse) Reset()         { *m = QueryVestingPositionResponse{} }
+func (m *QueryVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingPositionResponse) ProtoMessage()    {}
+func (*QueryVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{1}
+}
+func (m *QueryVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingPositionResponse.Merge(m, src)
+}
+func (m *QueryVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingPositionResponse proto.InternalMessageInfo
+
+func (m *QueryVestingPositionResponse) GetPosition() VestingPosition {
+	if m != nil {
+		return m.Position
+	}
+	return VestingPosition{}
+}
+
+func (m *QueryVestingPositionResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+// QueryVestingPositionsRequest is the request type for the
+// Query/VestingPositions RPC method.
+type QueryVestingPositionsRequest struct {
+	// owner is the address of the account holding the vesting positions.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingPositionsRequest) Reset()         { *m = QueryVestingPositionsRequest{} }
+func (m *QueryVestingPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingPositionsRequest) ProtoMessage()    {}
+func (*QueryVestingPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{2}
+}
+func (m *QueryVestingPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingPositionsRequest.Merge(m, src)
+}
+func (m *QueryVestingPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryVestingPositionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryVestingPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVestingPositionsResponse is the response type for the
+// Query/VestingPositions RPC method.
+type QueryVestingPositionsResponse struct {
+	// positions are the vesting positions of the account.
+	Positions []VestingPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	// locked are the coins locked by all the vesting positions of the account.
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVestingPositionsResponse) Reset()         { *m = QueryVestingPositionsResponse{} }
+func (m *QueryVestingPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingPositionsResponse) ProtoMessage()    {}
+func (*QueryVestingPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{3}
+}
+func (m *QueryVestingPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingPositionsResponse.Merge(m, src)
+}
+func (m *QueryVestingPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryVestingPositionsResponse) GetPositions() []VestingPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryVestingPositionsResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryVestingPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryClawbackPreviewRequest is the request type for the
+// Query/ClawbackPreview RPC method.
+type QueryClawbackPreviewRequest struct {
+	// address is the address of the ClawbackVestingAccount.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryClawbackPreviewRequest) Reset()         { *m = QueryClawbackPreviewRequest{} }
+func (m *QueryClawbackPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackPreviewRequest) ProtoMessage()    {}
+func (*QueryClawbackPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{4}
+}
+func (m *QueryClawbackPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackPreviewRequest.Merge(m, src)
+}
+func (m *QueryClawbackPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryClawbackPreviewRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryClawbackPreviewResponse is the response type for the
+// Query/ClawbackPreview RPC method.
+type QueryClawbackPreviewResponse struct {
+	// spendable are the unvested coins which would be sent from the balance of
+	// the account.
+	Spendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
+	// unbonding are the unvested tokens whose unbonding delegation entries would
+	// be transferred.
+	Unbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unbonding"`
+	// delegated are the unvested tokens whose delegations would be transferred.
+	Delegated github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated"`
+}
+
+func (m *QueryClawbackPreviewResponse) Reset()         { *m = QueryClawbackPreviewResponse{} }
+func (m *QueryClawbackPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackPreviewResponse) ProtoMessage()    {}
+func (*QueryClawbackPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94f6d251f3006c48, []int{5}
+}
+func (m *QueryClawbackPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackPreviewResponse.Merge(m, src)
+}
+func (m *QueryClawbackPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryClawbackPreviewResponse) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
+func (m *QueryClawbackPreviewResponse) GetUnbonding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
+func (m *QueryClawbackPreviewResponse) GetDelegated() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Delegated
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryVestingPositionRequest)(nil), "cosmos.vesting.v1beta1.QueryVestingPositionRequest")
+	proto.RegisterType((*QueryVestingPositionResponse)(nil), "cosmos.vesting.v1beta1.QueryVestingPositionResponse")
+	proto.RegisterType((*QueryVestingPositionsRequest)(nil), "cosmos.vesting.v1beta1.QueryVestingPositionsRequest")
+	proto.RegisterType((*QueryVestingPositionsResponse)(nil), "cosmos.vesting.v1beta1.QueryVestingPositionsResponse")
+	proto.RegisterType((*QueryClawbackPreviewRequest)(nil), "cosmos.vesting.v1beta1.QueryClawbackPreviewRequest")
+	proto.RegisterType((*QueryClawbackPreviewResponse)(nil), "cosmos.vesting.v1beta1.QueryClawbackPreviewResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/vesting/v1beta1/query.proto", fileDescriptor_94f6d251f3006c48)
+}
+
+var fileDescriptor_94f6d251f3006c48 = []byte{
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x6e, 0x01, 0x65, 0x48, 0x44, 0x27, 0xc4, 0x2c, 0x15, 0x17, 0xb2, 0x31, 0xd8, 0x90,
+	0xb0, 0x13, 0x0a, 0x5c, 0x34, 0x1e, 0x2c, 0x89, 0xc6, 0x70, 0x81, 0x9a, 0x78, 0xf0, 0x42, 0x66,
+	0x77, 0x27, 0xcb, 0x84, 0x32, 0xb3, 0x74, 0xa6, 0x20, 0x21, 0x5c, 0xfc, 0x05, 0x26, 0x26, 0x5e,
+	0xbd, 0x1a, 0xe3, 0xc1, 0x83, 0x07, 0x13, 0x4f, 0xde, 0x38, 0x12, 0xb9, 0x78, 0x52, 0x03, 0x26,
+	0xde, 0xfc, 0x0d, 0x66, 0x67, 0x66, 0x5b, 0xda, 0x74, 0x21, 0x3d, 0xd4, 0x4b, 0xdb, 0xec, 0xbe,
+	0xef, 0xbd, 0xf7, 0xbd, 0xef, 0x9b, 0x29, 0xf0, 0x42, 0x2e, 0xb6, 0xb9, 0x40, 0xbb, 0x44, 0x48,
+	0xca, 0x62, 0xb4, 0xbb, 0x10, 0x10, 0x89, 0x17, 0xd0, 0x4e, 0x93, 0x34, 0xf6, 0xfd, 0xa4, 0xc1,
+	0x25, 0x87, 0x37, 0x35, 0xc6, 0x37, 0x18, 0xdf, 0x60, 0x4a, 0x13, 0x31, 0x8f, 0xb9, 0x82, 0xa0,
+	0xf4, 0x97, 0x46, 0x97, 0xe6, 0x0c, 0x63, 0x80, 0x05, 0xd1, 0x34, 0x2d, 0xd2, 0x04, 0xc7, 0x94,
+	0x61, 0x49, 0x39, 0x33, 0x58, 0xf7, 0x3c, 0x36, 0x43, 0x85, 0x9c, 0x66, 0xef, 0xef, 0xe4, 0xb8,
+	0xcb, 0x9c, 0x68, 0xd4, 0x54, 0xcc, 0x79, 0x5c, 0x27, 0x08, 0x27, 0x14, 0x61, 0xc6, 0xb8, 0x54,
+	0x12, 0xc2, 0xbc, 0x9d, 0xd4, 0x1c, 0x1b, 0xda, 0xa8, 0x69, 0x45, 0xbf, 0xba, 0x81, 0xb7, 0x29,
+	0xe3, 0x48, 0x7d, 0xea, 0x47, 0xde, 0x3c, 0xb8, 0xb5, 0x9e, 0x7a, 0x7e, 0xa6, 0x15, 0xd6, 0xb8,
+	0xa0, 0x29, 0x59, 0x8d, 0xec, 0x34, 0x89, 0x90, 0xf0, 0x1a, 0xb0, 0x69, 0xe4, 0x58, 0x33, 0x56,
+	0x79, 0xa8, 0x66, 0xd3, 0xc8, 0x3b, 0xb1, 0xc0, 0x54, 0x6f, 0xbc, 0x48, 0x38, 0x13, 0x04, 0x3e,
+	0x01, 0x57, 0x13, 0xf3, 0x4c, 0x95, 0x8d, 0x55, 0xee, 0xfa, 0xbd, 0xe3, 0xf4, 0xbb, 0x28, 0xaa,
+	0x43, 0x47, 0x3f, 0xa6, 0x0b, 0xb5, 0x56, 0x39, 0xdc, 0x04, 0x23, 0x75, 0x1e, 0x6e, 0x91, 0xc8,
+	0xb1, 0x67, 0x8a, 0xe5, 0xb1, 0xca, 0x64, 0x46, 0x94, 0xa6, 0xd7, 0x62, 0x59, 0xe1, 0x94, 0x55,
+	0x97, 0xd3, 0xd2, 0xf7, 0x3f, 0xa7, 0xcb, 0x31, 0x95, 0x9b, 0xcd, 0xc0, 0x0f, 0xf9, 0xb6, 0xe9,
+	0xdc, 0x7c, 0xcd, 0x8b, 0x68, 0x0b, 0xc9, 0xfd, 0x84, 0x08, 0x55, 0x20, 0xde, 0xfd, 0xf9, 0x38,
+	0x67, 0xd5, 0x0c, 0xbf, 0xf7, 0x26, 0xa7, 0x2b, 0x91, 0xc5, 0xe0, 0x83, 0x61, 0xbe, 0xc7, 0x48,
+	0x43, 0xb5, 0x34, 0x5a, 0x75, 0xbe, 0x7d, 0x9a, 0x9f, 0x30, 0x66, 0x1e, 0x46, 0x51, 0x83, 0x08,
+	0xf1, 0x54, 0x36, 0x28, 0x8b, 0x6b, 0x1a, 0x06, 0x1f, 0x01, 0xd0, 0x9e, 0xbd, 0x63, 0xab, 0x1c,
+	0x66, 0x3b, 0xec, 0xeb, 0x7d, 0xcb, 0x9a, 0x58, 0xc3, 0x31, 0x31, 0x5a, 0xb5, 0x73, 0x95, 0xde,
+	0x5b, 0x1b, 0xdc, 0xce, 0x31, 0x66, 0xf2, 0x5e, 0x05, 0xa3, 0x59, 0x60, 0xc2, 0xb1, 0x66, 0x8a,
+	0xfd, 0x07, 0xde, 0xae, 0xff, 0x7f, 0x89, 0xc3, 0xc7, 0x1d, 0x01, 0x15, 0x3b, 0x17, 0x25, 0x37,
+	0x20, 0xdd, 0x73, 0x47, 0x42, 0xeb, 0x66, 0x7f, 0x57, 0xea, 0x78, 0x2f, 0xc0, 0xe1, 0xd6, 0x5a,
+	0x83, 0xec, 0x52, 0xb2, 0x97, 0x0d, 0xae, 0x02, 0xae, 0x60, 0x3d, 0xa0, 0x4b, 0x47, 0x97, 0x01,
+	0xbd, 0xbf, 0x36, 0x98, 0xea, 0xcd, 0x69, 0x32, 0x67, 0x60, 0x54, 0x24, 0x84, 0x45, 0x38, 0xa8,
+	0x13, 0xc7, 0x1a, 0x50, 0x52, 0x6d, 0x89, 0x54, 0xaf, 0xc9, 0x02, 0xce, 0x22, 0xca, 0xe2, 0x81,
+	0x4d, 0xa6, 0x2d, 0x91, 0xea, 0x45, 0xa4, 0x4e, 0x62, 0x2c, 0x49, 0xe4, 0x14, 0x07, 0xa5, 0xd7,
+	0x92, 0xa8, 0x7c, 0x1e, 0x02, 0xc3, 0x2a, 0x70, 0xf8, 0xc1, 0x02, 0xe3, 0x5d, 0x5b, 0x0a, 0x17,
+	0xf3, 0xd6, 0xf9, 0x82, 0x7b, 0xab, 0xb4, 0xd4, 0x5f, 0x91, 0x1e, 0xac, 0xe7, 0xbf, 0x3c, 0xf9,
+	0xfd, 0xda, 0x2e, 0xc3, 0x59, 0x94, 0x73, 0x0f, 0xb7, 0x8e, 0x0a, 0x3a, 0xa0, 0xd1, 0x21, 0xfc,
+	0x62, 0x81, 0xeb, 0x5d, 0x5c, 0x02, 0xf6, 0x25, 0x9d, 0xdd, 0x30, 0xa5, 0xe5, 0x3e, 0xab, 0x8c,
+	0xe3, 0x7b, 0xca, 0xf1, 0x12, 0xac, 0xe4, 0x39, 0xc6, 0x61, 0xc8, 0x9b, 0x4c, 0x0a, 0x74, 0xa0,
+	0x6e, 0xa6, 0xc3, 0x76, 0x0b, 0xf0, 0xab, 0x05, 0xc6, 0xbb, 0x56, 0xfc, 0x92, 0xb0, 0x7b, 0x1f,
+	0xb2, 0xd2, 0x52, 0x7f, 0x45, 0xc6, 0xfa, 0x8a, 0xb2, 0xfe, 0x00, 0xde, 0xbf, 0xdc, 0xba, 0x39,
+	0x99, 0x87, 0x28, 0x34, 0x5c, 0x1b, 0x89, 0x26, 0xab, 0xae, 0x1e, 0x9d, 0xba, 0xd6, 0xf1, 0xa9,
+	0x6b, 0xfd, 0x3a, 0x75, 0xad, 0x57, 0x67, 0x6e, 0xe1, 0xf8, 0xcc, 0x2d, 0x7c, 0x3f, 0x73, 0x0b,
+	0xcf, 0x17, 0x2e, 0x5c, 0xc7, 0x17, 0x08, 0x37, 0xe5, 0x66, 0x4b, 0x52, 0x6d, 0x67, 0x30, 0xa2,
+	0xfe, 0x12, 0x17, 0xff, 0x0d, 0x00, 0x46, 0x38, 0x37, 0xbd, 0x24, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VestingPosition returns a vesting position by its id.
+	VestingPosition(ctx context.Context, in *QueryVestingPositionRequest, opts ...grpc.CallOption) (*QueryVestingPositionResponse, error)
+	// VestingPositions returns the vesting positions of an account, with the
+	// total amount of coins they lock.
+	VestingPositions(ctx context.Context, in *QueryVestingPositionsRequest, opts ...grpc.CallOption) (*QueryVestingPositionsResponse, error)
+	// ClawbackPreview returns what a clawback of a ClawbackVestingAccount would
+	// return at the current block time, without performing it.
+	ClawbackPreview(ctx context.Context, in *QueryClawbackPreviewRequest, opts ...grpc.CallOption) (*QueryClawbackPreviewResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VestingPosition(ctx context.Context, in *QueryVestingPositionRequest, opts ...grpc.CallOption) (*QueryVestingPositionResponse, error) {
+	out := new(QueryVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Query/VestingPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingPositions(ctx context.Context, in *QueryVestingPositionsRequest, opts ...grpc.CallOption) (*QueryVestingPositionsResponse, error) {
+	out := new(QueryVestingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Query/VestingPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClawbackPreview(ctx context.Context, in *QueryClawbackPreviewRequest, opts ...grpc.CallOption) (*QueryClawbackPreviewResponse, error) {
+	out := new(QueryClawbackPreviewResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Query/ClawbackPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VestingPosition returns a vesting position by its id.
+	VestingPosition(context.Context, *QueryVestingPositionRequest) (*QueryVestingPositionResponse, error)
+	// VestingPositions returns the vesting positions of an account, with the
+	// total amount of coins they lock.
+	VestingPositions(context.Context, *QueryVestingPositionsRequest) (*QueryVestingPositionsResponse, error)
+	// ClawbackPreview returns what a clawback of a ClawbackVestingAccount would
+	// return at the current block time, without performing it.
+	ClawbackPreview(context.Context, *QueryClawbackPreviewRequest) (*QueryClawbackPreviewResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VestingPosition(ctx context.Context, req *QueryVestingPositionRequest) (*QueryVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPosition not implemented")
+}
+func (*UnimplementedQueryServer) VestingPositions(ctx context.Context, req *QueryVestingPositionsRequest) (*QueryVestingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingPositions not implemented")
+}
+func (*UnimplementedQueryServer) ClawbackPreview(ctx context.Context, req *QueryClawbackPreviewRequest) (*QueryClawbackPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackPreview not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Query/VestingPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPosition(ctx, req.(*QueryVestingPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Query/VestingPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingPositions(ctx, req.(*QueryVestingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClawbackPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClawbackPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClawbackPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Query/ClawbackPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClawbackPreview(ctx, req.(*QueryClawbackPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VestingPosition",
+			Handler:    _Query_VestingPosition_Handler,
+		},
+		{
+			MethodName: "VestingPositions",
+			Handler:    _Query_VestingPositions_Handler,
+		},
+		{
+			MethodName: "ClawbackPreview",
+			Handler:    _Query_ClawbackPreview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/vesting/v1beta1/query.proto",
+}
+
+func (m *QueryVestingPositionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingPositionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingPositionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegated) > 0 {
+		for iNdEx := len(m.Delegated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for iNdEx := len(m.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVestingPositionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClawbackPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClawbackPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for _, e := range m.Unbonding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Delegated) > 0 {
+		for _, e := range m.Delegated {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVestingPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, VestingPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClawbackPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClawbackPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClawbackPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClawbackPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClawbackPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClawbackPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spendable = append(m.Spendable, types.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbonding = append(m.Unbonding, types.Coin{})
+			if err := m.Unbonding[len(m.Unbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegated = append(m.Delegated, types.Coin{})
+			if err := m.Delegated[len(m.Delegated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -508,14 +508,19 @@ var xxx_messageInfo_MsgCreateClawbackVestingAccountResponse proto.InternalMessag
 // MsgClawback defines a message that removes unvested tokens from a
 // ClawbackVestingAccount.
 type MsgClawback struct {
-	// funder_address is the address which funded the account
+	// funder_address is the address which funded the account. Exactly one of
+	// funder_address and authority must be set.
 	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
 	// address is the address of the ClawbackVestingAccount to claw back from.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// dest_address specifies where the clawed-back tokens should be transferred
 	// to. If empty, the tokens will be transferred back to the original funder of
-	// the account.
+	// the account. Unvested tokens which are delegated or unbonding are
+	// transferred as delegations or unbonding delegation entries.
 	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// authority is the address of the authority of the module, which may claw
+	// back any ClawbackVestingAccount.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
 func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
@@ -572,6 +577,13 @@ func (m *MsgClawback) GetDestAddress() string {
 	return ""
 }
 
+func (m *MsgClawback) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 // MsgClawbackResponse defines the MsgClawback response type.
 type MsgClawbackResponse struct {
 }
@@ -609,6 +621,342 @@ func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
 
+// MsgCreateVestingPosition defines a message that enables offering a vesting
+// position to an account. The position is held by the sender until the
+// recipient accepts it, unless the sender is the recipient.
+type MsgCreateVestingPosition struct {
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// start of vesting as unix time (in seconds).
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff of vesting as unix time (in seconds). If zero, the coins vest from
+	// start_time.
+	CliffTime int64 `protobuf:"varint,5,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end of vesting as unix time (in seconds).
+	EndTime int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgCreateVestingPosition) Reset()         { *m = MsgCreateVestingPosition{} }
+func (m *MsgCreateVestingPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingPosition) ProtoMessage()    {}
+func (*MsgCreateVestingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{10}
+}
+func (m *MsgCreateVestingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingPosition.Merge(m, src)
+}
+func (m *MsgCreateVestingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingPosition proto.InternalMessageInfo
+
+func (m *MsgCreateVestingPosition) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateVestingPosition) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateVestingPosition) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgCreateVestingPosition) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateVestingPosition) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *MsgCreateVestingPosition) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// MsgCreateVestingPositionResponse defines the Msg/CreateVestingPosition
+// response type.
+type MsgCreateVestingPositionResponse struct {
+	// id is the identifier of the created vesting position.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateVestingPositionResponse) Reset()         { *m = MsgCreateVestingPositionResponse{} }
+func (m *MsgCreateVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingPositionResponse) ProtoMessage()    {}
+func (*MsgCreateVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{11}
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingPositionResponse.Merge(m, src)
+}
+func (m *MsgCreateVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingPositionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateVestingPositionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgTransferVestingPosition defines a message that enables offering a vesting
+// position to another account, which becomes its owner once it accepts it.
+type MsgTransferVestingPosition struct {
+	// owner is the address of the current owner of the vesting position.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id is the identifier of the vesting position.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address of the new owner of the vesting position.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgTransferVestingPosition) Reset()         { *m = MsgTransferVestingPosition{} }
+func (m *MsgTransferVestingPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVestingPosition) ProtoMessage()    {}
+func (*MsgTransferVestingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{12}
+}
+func (m *MsgTransferVestingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVestingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVestingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferVestingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVestingPosition.Merge(m, src)
+}
+func (m *MsgTransferVestingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVestingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVestingPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferVestingPosition proto.InternalMessageInfo
+
+func (m *MsgTransferVestingPosition) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgTransferVestingPosition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgTransferVestingPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgTransferVestingPositionResponse defines the Msg/TransferVestingPosition
+// response type.
+type MsgTransferVestingPositionResponse struct {
+}
+
+func (m *MsgTransferVestingPositionResponse) Reset()         { *m = MsgTransferVestingPositionResponse{} }
+func (m *MsgTransferVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVestingPositionResponse) ProtoMessage()    {}
+func (*MsgTransferVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{13}
+}
+func (m *MsgTransferVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVestingPositionResponse.Merge(m, src)
+}
+func (m *MsgTransferVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVestingPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferVestingPositionResponse proto.InternalMessageInfo
+
+// MsgAcceptVestingPosition defines a message that enables accepting a vesting
+// position offered to an account.
+type MsgAcceptVestingPosition struct {
+	// recipient is the address of the account the vesting position is offered
+	// to.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// id is the identifier of the vesting position.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAcceptVestingPosition) Reset()         { *m = MsgAcceptVestingPosition{} }
+func (m *MsgAcceptVestingPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptVestingPosition) ProtoMessage()    {}
+func (*MsgAcceptVestingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{14}
+}
+func (m *MsgAcceptVestingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptVestingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptVestingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptVestingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptVestingPosition.Merge(m, src)
+}
+func (m *MsgAcceptVestingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptVestingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptVestingPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptVestingPosition proto.InternalMessageInfo
+
+func (m *MsgAcceptVestingPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgAcceptVestingPosition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgAcceptVestingPositionResponse defines the Msg/AcceptVestingPosition
+// response type.
+type MsgAcceptVestingPositionResponse struct {
+}
+
+func (m *MsgAcceptVestingPositionResponse) Reset()         { *m = MsgAcceptVestingPositionResponse{} }
+func (m *MsgAcceptVestingPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptVestingPositionResponse) ProtoMessage()    {}
+func (*MsgAcceptVestingPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{15}
+}
+func (m *MsgAcceptVestingPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptVestingPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptVestingPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptVestingPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptVestingPositionResponse.Merge(m, src)
+}
+func (m *MsgAcceptVestingPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptVestingPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptVestingPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptVestingPositionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccountResponse")
@@ -620,64 +968,85 @@ func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccountResponse")
 	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.v1beta1.MsgClawback")
 	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.vesting.v1beta1.MsgClawbackResponse")
+	proto.RegisterType((*MsgCreateVestingPosition)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingPosition")
+	proto.RegisterType((*MsgCreateVestingPositionResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingPositionResponse")
+	proto.RegisterType((*MsgTransferVestingPosition)(nil), "cosmos.vesting.v1beta1.MsgTransferVestingPosition")
+	proto.RegisterType((*MsgTransferVestingPositionResponse)(nil), "cosmos.vesting.v1beta1.MsgTransferVestingPositionResponse")
+	proto.RegisterType((*MsgAcceptVestingPosition)(nil), "cosmos.vesting.v1beta1.MsgAcceptVestingPosition")
+	proto.RegisterType((*MsgAcceptVestingPositionResponse)(nil), "cosmos.vesting.v1beta1.MsgAcceptVestingPositionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x4f, 0xe3, 0x46,
-	0x14, 0xc7, 0x63, 0x92, 0x00, 0x19, 0x0a, 0x15, 0x26, 0x14, 0x13, 0x15, 0x3b, 0xb8, 0x45, 0x4d,
-	0x53, 0x61, 0x0b, 0x4a, 0x85, 0x94, 0x56, 0x42, 0x84, 0xde, 0x28, 0x52, 0x95, 0x56, 0x3d, 0x54,
-	0x95, 0x22, 0xc7, 0x1e, 0x8c, 0x95, 0xd8, 0x93, 0x7a, 0x26, 0x2c, 0xdc, 0xd0, 0x1e, 0xf7, 0xb4,
-	0xc7, 0x3d, 0xec, 0x81, 0xe3, 0x6a, 0x4f, 0x1c, 0xf6, 0xcc, 0x99, 0x23, 0xbb, 0xa7, 0x3d, 0xb1,
-	0x2b, 0x38, 0xc0, 0x61, 0x4f, 0xfc, 0x05, 0x2b, 0x7b, 0xc6, 0xc6, 0x09, 0x76, 0x12, 0x56, 0x2b,
-	0xed, 0x05, 0xe3, 0x79, 0xdf, 0xef, 0x9b, 0x97, 0xcf, 0x9b, 0x1f, 0x06, 0x92, 0x8e, 0xb0, 0x8d,
-	0xb0, 0xba, 0x0f, 0x31, 0xb1, 0x1c, 0x53, 0xdd, 0x5f, 0x69, 0x40, 0xa2, 0xad, 0xa8, 0xe4, 0x40,
-	0x69, 0xbb, 0x88, 0x20, 0xfe, 0x1b, 0x2a, 0x50, 0x98, 0x40, 0x61, 0x82, 0x42, 0xde, 0x44, 0x26,
-	0xf2, 0x25, 0xaa, 0xf7, 0x1f, 0x55, 0x17, 0x44, 0x96, 0xae, 0xa1, 0x61, 0x18, 0xe6, 0xd2, 0x91,
-	0xe5, 0xb0, 0xf8, 0x3c, 0x8d, 0xd7, 0xa9, 0x91, 0xa5, 0xa6, 0xa1, 0xef, 0x13, 0x2a, 0x09, 0x26,
-	0xa6, 0xaa, 0x39, 0xa6, 0xb2, 0xb1, 0xa7, 0xf0, 0x1e, 0x2c, 0x30, 0xad, 0xd9, 0x96, 0x83, 0x54,
-	0xff, 0x2f, 0x1d, 0x92, 0x3f, 0x8c, 0x80, 0xb9, 0x1d, 0x6c, 0x6e, 0xb9, 0x50, 0x23, 0xf0, 0x1f,
-	0x9a, 0x66, 0x53, 0xd7, 0x51, 0xc7, 0x21, 0xfc, 0xaf, 0xe0, 0xab, 0x5d, 0x17, 0xd9, 0x75, 0xcd,
-	0x30, 0x5c, 0x88, 0xb1, 0xc0, 0x15, 0xb9, 0x52, 0xae, 0x2a, 0xbc, 0x79, 0xb5, 0x9c, 0x67, 0x55,
-	0x6d, 0xd2, 0xc8, 0x5f, 0xc4, 0xb5, 0x1c, 0xb3, 0x36, 0xe1, 0xa9, 0xd9, 0x10, 0xbf, 0x0e, 0x00,
-	0x41, 0xa1, 0x75, 0x64, 0x80, 0x35, 0x47, 0x50, 0x60, 0xdc, 0x03, 0xa3, 0x9a, 0xed, 0xcd, 0x2f,
-	0xa4, 0x8b, 0xe9, 0xd2, 0xc4, 0xea, 0xbc, 0xc2, 0x1c, 0x1e, 0xaf, 0x00, 0xad, 0xb2, 0x85, 0x2c,
-	0xa7, 0xfa, 0xcb, 0xd9, 0x85, 0x94, 0x7a, 0xf9, 0x4e, 0x2a, 0x99, 0x16, 0xd9, 0xeb, 0x34, 0x14,
-	0x1d, 0xd9, 0x8c, 0x17, 0x7b, 0x2c, 0x63, 0xa3, 0xa9, 0x92, 0xc3, 0x36, 0xc4, 0xbe, 0x01, 0xbf,
-	0xb8, 0x3e, 0x29, 0x73, 0x35, 0x96, 0x9f, 0x9f, 0x07, 0xe3, 0xd0, 0x31, 0xea, 0xc4, 0xb2, 0xa1,
-	0x90, 0x29, 0x72, 0xa5, 0x74, 0x6d, 0x0c, 0x3a, 0xc6, 0xdf, 0x96, 0x0d, 0x79, 0x01, 0x8c, 0x19,
-	0xb0, 0xa5, 0x1d, 0x42, 0x43, 0xc8, 0x16, 0xb9, 0xd2, 0x78, 0x2d, 0x78, 0xad, 0xfc, 0x76, 0x73,
-	0x2c, 0x71, 0x8f, 0xaf, 0x4f, 0xca, 0x5d, 0x6c, 0x9e, 0x5c, 0x9f, 0x94, 0xe5, 0xc8, 0x9c, 0x09,
-	0x48, 0xe5, 0x45, 0x20, 0x25, 0x84, 0x6a, 0x10, 0xb7, 0x91, 0x83, 0xa1, 0x7c, 0x3a, 0x12, 0xd1,
-	0xfc, 0x09, 0x5d, 0x5b, 0x73, 0xa0, 0x43, 0xfe, 0x40, 0x7a, 0x13, 0x1a, 0x41, 0x67, 0x2a, 0xb1,
-	0x9d, 0x99, 0xbb, 0xbd, 0x90, 0x66, 0x0e, 0x35, 0xbb, 0x55, 0x91, 0xa3, 0x51, 0xb9, 0xbb, 0x31,
-	0x6b, 0x31, 0x8d, 0x99, 0xbd, 0xbd, 0x90, 0xa6, 0xa9, 0xf3, 0x2e, 0x26, 0x7f, 0x91, 0xae, 0x54,
-	0x36, 0x12, 0x01, 0x2f, 0xc5, 0x01, 0xf6, 0x08, 0x75, 0xc1, 0x91, 0x7f, 0x04, 0x3f, 0x0c, 0xe0,
-	0x17, 0xb2, 0x7e, 0xde, 0xc3, 0xda, 0x42, 0x86, 0xa5, 0xf7, 0xec, 0x82, 0xc5, 0x38, 0xd6, 0xdd,
-	0x48, 0x17, 0xee, 0x23, 0x8d, 0xb2, 0x5b, 0x00, 0x00, 0x13, 0xcd, 0x25, 0x74, 0xa5, 0xa5, 0xfd,
-	0x95, 0x96, 0xf3, 0x47, 0xfc, 0xb5, 0x56, 0x03, 0x5f, 0xb3, 0xfd, 0x5b, 0x6f, 0xfb, 0x25, 0x60,
-	0x21, 0xe3, 0x33, 0x16, 0x95, 0xf8, 0x73, 0x45, 0xa1, 0x95, 0x56, 0x73, 0x1e, 0x68, 0x0a, 0x6f,
-	0x8a, 0x49, 0x68, 0x04, 0x57, 0x7e, 0xbf, 0x39, 0x96, 0x52, 0xb1, 0x10, 0xcb, 0x09, 0x10, 0x63,
-	0x7e, 0x7a, 0x2f, 0xc9, 0x18, 0x49, 0x48, 0xf2, 0x75, 0x94, 0xe4, 0x56, 0x4b, 0x7b, 0xd4, 0xd0,
-	0xf4, 0xe6, 0x67, 0x27, 0xb9, 0x76, 0x9f, 0x64, 0x74, 0xed, 0xde, 0xc5, 0xe4, 0x28, 0xe0, 0x6d,
-	0x30, 0xd5, 0x42, 0x7a, 0xb3, 0xd3, 0x7e, 0x20, 0xdf, 0x8c, 0xc7, 0xb7, 0x36, 0x49, 0xbd, 0x8c,
-	0x2c, 0xbf, 0x73, 0xbf, 0x5b, 0xd9, 0x07, 0x64, 0xeb, 0x69, 0x14, 0x9f, 0x07, 0x59, 0x1b, 0xba,
-	0x26, 0x14, 0x46, 0xfd, 0x63, 0x86, 0xbe, 0x54, 0x32, 0x5e, 0xfb, 0xba, 0xf0, 0xc7, 0x23, 0x0d,
-	0xf1, 0xff, 0x0f, 0x26, 0x3c, 0x29, 0x13, 0xf1, 0x4b, 0x60, 0x6a, 0xb7, 0xe3, 0x18, 0xd0, 0xed,
-	0x61, 0x3d, 0x49, 0x47, 0x03, 0x9c, 0x02, 0x18, 0xeb, 0x46, 0x1d, 0xbc, 0x7a, 0xad, 0x32, 0x20,
-	0x26, 0xa1, 0x3d, 0x4d, 0x5b, 0xe5, 0x8d, 0x31, 0xb3, 0x3c, 0x0b, 0x66, 0x22, 0x53, 0x06, 0x95,
-	0xac, 0x9e, 0x66, 0x41, 0x7a, 0x07, 0x9b, 0xfc, 0x11, 0x07, 0xf2, 0xb1, 0xb7, 0x8a, 0x9a, 0xc4,
-	0x29, 0xe1, 0x60, 0x2c, 0xac, 0x3f, 0xd0, 0x10, 0x94, 0xc2, 0x3f, 0xe3, 0xc0, 0xb7, 0x7d, 0x8f,
-	0xd1, 0xc1, 0x99, 0xe3, 0x8d, 0x85, 0x8d, 0x4f, 0x34, 0xc6, 0x97, 0x16, 0x77, 0xea, 0x0c, 0x55,
-	0x5a, 0x8c, 0x71, 0xb8, 0xd2, 0xfa, 0xec, 0xe4, 0x48, 0x69, 0x09, 0xdb, 0x78, 0x70, 0x69, 0xf1,
-	0xc6, 0x21, 0x4a, 0xeb, 0xbf, 0xca, 0xf9, 0xff, 0xc0, 0x78, 0xb8, 0xc4, 0xbf, 0xeb, 0x97, 0x8c,
-	0x89, 0x0a, 0x3f, 0x0d, 0x21, 0x0a, 0xb2, 0x17, 0xb2, 0x47, 0xde, 0x51, 0x5a, 0xdd, 0x3e, 0xbb,
-	0x14, 0xb9, 0xf3, 0x4b, 0x91, 0x7b, 0x7f, 0x29, 0x72, 0x4f, 0xaf, 0xc4, 0xd4, 0xf9, 0x95, 0x98,
-	0x7a, 0x7b, 0x25, 0xa6, 0xfe, 0x5d, 0xe9, 0x7b, 0xa1, 0x1d, 0xa8, 0x5a, 0x87, 0xec, 0x85, 0x9f,
-	0x66, 0xfe, 0xfd, 0xd6, 0x18, 0xf5, 0xbf, 0xb2, 0x7e, 0xfe, 0x18, 0x00, 0x00, 0xff, 0xff, 0x48,
-	0x78, 0x66, 0x9b, 0x43, 0x0a, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0xfd, 0x91, 0x64, 0x27, 0x34, 0xa8, 0x6e, 0x42, 0x1c, 0x8b, 0xec, 0x6e, 0xdd,
+	0x56, 0x2c, 0x41, 0xdd, 0x25, 0x21, 0xb4, 0xc5, 0x20, 0x45, 0x49, 0xb8, 0x95, 0x48, 0xd5, 0x52,
+	0x71, 0x40, 0x48, 0x91, 0xd7, 0x9e, 0x75, 0x46, 0x59, 0x7b, 0x56, 0x9e, 0xd9, 0xb6, 0xb9, 0x55,
+	0x70, 0x82, 0x03, 0xe2, 0x88, 0x10, 0x87, 0x1e, 0x81, 0x53, 0x0e, 0x9c, 0x39, 0x57, 0x9c, 0x0a,
+	0x07, 0xc4, 0x29, 0xa0, 0xe4, 0x90, 0x1e, 0x38, 0xf5, 0x2f, 0x40, 0xe3, 0x19, 0x3b, 0xb6, 0x33,
+	0xde, 0xcd, 0x46, 0x48, 0x48, 0xbd, 0x64, 0xe3, 0x79, 0xef, 0xfb, 0xfc, 0xe6, 0xf3, 0x66, 0xdf,
+	0x9b, 0x05, 0x35, 0x1b, 0x13, 0x0f, 0x93, 0xd6, 0x03, 0x48, 0x28, 0xf2, 0xdd, 0xd6, 0x83, 0x95,
+	0x0e, 0xa4, 0xd6, 0x4a, 0x8b, 0x3e, 0x6a, 0xf6, 0x03, 0x4c, 0xb1, 0xfa, 0x1a, 0x77, 0x68, 0x0a,
+	0x87, 0xa6, 0x70, 0xd0, 0xe7, 0x5c, 0xec, 0xe2, 0xd0, 0xa5, 0xc5, 0xfe, 0xe3, 0xde, 0x7a, 0x55,
+	0x84, 0xeb, 0x58, 0x04, 0xc6, 0xb1, 0x6c, 0x8c, 0x7c, 0x61, 0x5f, 0xe4, 0xf6, 0x1d, 0x2e, 0x14,
+	0xa1, 0xb9, 0xe9, 0x7a, 0x4e, 0x26, 0xd1, 0x8b, 0xb9, 0xd7, 0x82, 0xf0, 0xf2, 0x08, 0xf3, 0x60,
+	0x1f, 0xc2, 0x70, 0xd9, 0xf2, 0x90, 0x8f, 0x5b, 0xe1, 0x5f, 0xbe, 0x64, 0xfc, 0x53, 0x00, 0x0b,
+	0xdb, 0xc4, 0xdd, 0x0a, 0xa0, 0x45, 0xe1, 0x27, 0x3c, 0xcc, 0x86, 0x6d, 0xe3, 0x81, 0x4f, 0xd5,
+	0xf7, 0xc1, 0x2b, 0xdd, 0x00, 0x7b, 0x3b, 0x96, 0xe3, 0x04, 0x90, 0x10, 0x4d, 0xa9, 0x2b, 0x8d,
+	0xca, 0xa6, 0xf6, 0xfb, 0xcf, 0x37, 0xe7, 0x44, 0x56, 0x1b, 0xdc, 0xf2, 0x31, 0x0d, 0x90, 0xef,
+	0xb6, 0x67, 0x98, 0xb7, 0x58, 0x52, 0x6f, 0x03, 0x40, 0x71, 0x2c, 0x2d, 0x8c, 0x90, 0x56, 0x28,
+	0x8e, 0x84, 0xbb, 0x60, 0xd2, 0xf2, 0xd8, 0xfb, 0xb5, 0x62, 0xbd, 0xd8, 0x98, 0x59, 0x5d, 0x6c,
+	0x0a, 0x05, 0xe3, 0x15, 0xa1, 0x6d, 0x6e, 0x61, 0xe4, 0x6f, 0xbe, 0xfb, 0xf4, 0xb0, 0x36, 0xf1,
+	0xd3, 0x5f, 0xb5, 0x86, 0x8b, 0xe8, 0xee, 0xa0, 0xd3, 0xb4, 0xb1, 0x27, 0x78, 0x89, 0x8f, 0x9b,
+	0xc4, 0xd9, 0x6b, 0xd1, 0xfd, 0x3e, 0x24, 0xa1, 0x80, 0xfc, 0x70, 0x72, 0xb0, 0xac, 0xb4, 0x45,
+	0x7c, 0x75, 0x11, 0x4c, 0x43, 0xdf, 0xd9, 0xa1, 0xc8, 0x83, 0x5a, 0xa9, 0xae, 0x34, 0x8a, 0xed,
+	0x29, 0xe8, 0x3b, 0xf7, 0x91, 0x07, 0x55, 0x0d, 0x4c, 0x39, 0xb0, 0x67, 0xed, 0x43, 0x47, 0x2b,
+	0xd7, 0x95, 0xc6, 0x74, 0x3b, 0x7a, 0x34, 0x3f, 0x78, 0xfe, 0xa4, 0xa6, 0x7c, 0x7e, 0x72, 0xb0,
+	0x9c, 0x62, 0xf3, 0xd5, 0xc9, 0xc1, 0xb2, 0x91, 0x78, 0x67, 0x0e, 0x52, 0xe3, 0x2a, 0xa8, 0xe5,
+	0x98, 0xda, 0x90, 0xf4, 0xb1, 0x4f, 0xa0, 0xf1, 0x4b, 0x21, 0xe1, 0x73, 0x0f, 0x06, 0x9e, 0xe5,
+	0x43, 0x9f, 0x7e, 0x84, 0xed, 0x3d, 0xe8, 0x44, 0x95, 0x31, 0xa5, 0x95, 0x59, 0x78, 0x71, 0x58,
+	0xbb, 0xb2, 0x6f, 0x79, 0x3d, 0xd3, 0x48, 0x5a, 0x8d, 0x74, 0x61, 0xd6, 0x24, 0x85, 0x99, 0x7f,
+	0x71, 0x58, 0xbb, 0xcc, 0x95, 0xa7, 0x36, 0xe3, 0x7f, 0xa9, 0x8a, 0xb9, 0x9e, 0x0b, 0xf8, 0x86,
+	0x0c, 0x30, 0x23, 0x94, 0x82, 0x63, 0xbc, 0x09, 0xde, 0x18, 0xc1, 0x2f, 0x66, 0xfd, 0x7d, 0x86,
+	0x35, 0xc2, 0x0e, 0xb2, 0x33, 0xdf, 0x82, 0xab, 0x32, 0xd6, 0x69, 0xa4, 0x4b, 0x67, 0x91, 0x26,
+	0xd9, 0x2d, 0x01, 0x40, 0xa8, 0x15, 0x50, 0x7e, 0xd2, 0x8a, 0xe1, 0x49, 0xab, 0x84, 0x2b, 0xe1,
+	0x59, 0x6b, 0x83, 0x57, 0xc5, 0xf7, 0x77, 0xa7, 0x1f, 0xa6, 0x40, 0xb4, 0x52, 0xc8, 0xb8, 0xda,
+	0x94, 0xf7, 0x95, 0x26, 0xcf, 0x74, 0xb3, 0xc2, 0x40, 0x73, 0x78, 0xb3, 0xc2, 0x85, 0x5b, 0x88,
+	0xf9, 0xe1, 0xf3, 0x27, 0xb5, 0x09, 0x29, 0xc4, 0xe5, 0x1c, 0x88, 0x92, 0xad, 0x67, 0x49, 0x4a,
+	0x5c, 0x62, 0x92, 0xbf, 0x25, 0x49, 0x6e, 0xf5, 0xac, 0x87, 0x1d, 0xcb, 0xde, 0xfb, 0xcf, 0x49,
+	0xae, 0x9d, 0x25, 0x99, 0x3c, 0xbb, 0xa7, 0x36, 0x23, 0x09, 0xf8, 0x2e, 0x98, 0xed, 0x61, 0x7b,
+	0x6f, 0xd0, 0x1f, 0x93, 0x6f, 0x89, 0xf1, 0x6d, 0x5f, 0xe2, 0x5a, 0xbe, 0x46, 0xd4, 0xed, 0xb3,
+	0xd5, 0x2a, 0x8f, 0x11, 0x2d, 0x53, 0x28, 0x75, 0x0e, 0x94, 0x3d, 0x18, 0xb8, 0x50, 0x9b, 0x0c,
+	0xdb, 0x0c, 0x7f, 0x30, 0x4b, 0xac, 0x7c, 0x29, 0xfc, 0x72, 0xa4, 0x31, 0xfe, 0x1f, 0x15, 0x30,
+	0xc3, 0x7c, 0x85, 0x97, 0x7a, 0x03, 0xcc, 0x76, 0x07, 0xbe, 0x03, 0x83, 0x0c, 0xec, 0x4b, 0x7c,
+	0x35, 0xe2, 0xa9, 0x81, 0xa9, 0x34, 0xeb, 0xe8, 0x91, 0xd5, 0xca, 0x81, 0x84, 0xc6, 0xf2, 0x22,
+	0xaf, 0x15, 0x5b, 0x8b, 0xc4, 0xb7, 0x40, 0xc5, 0x1a, 0xd0, 0x5d, 0x1c, 0x20, 0xba, 0xaf, 0x95,
+	0x46, 0x35, 0xf8, 0xd8, 0xd5, 0x98, 0x07, 0x57, 0x12, 0xa9, 0xc6, 0x5b, 0xf8, 0xba, 0x08, 0xb4,
+	0x6c, 0x6f, 0xbc, 0x87, 0x09, 0xa2, 0x08, 0xfb, 0x2f, 0xfd, 0x28, 0x4a, 0xb7, 0x88, 0x52, 0xb6,
+	0x45, 0x2c, 0x01, 0x60, 0xf7, 0x50, 0xb7, 0xcb, 0xcd, 0x65, 0x6e, 0x0e, 0x57, 0x42, 0x73, 0x72,
+	0x90, 0x4d, 0xa6, 0x06, 0x99, 0xf9, 0x9e, 0xb4, 0x09, 0x5c, 0x1b, 0x32, 0xaa, 0x22, 0xe6, 0xc6,
+	0x2a, 0xa8, 0xe7, 0xd9, 0xa2, 0xa2, 0xa9, 0xb3, 0xa0, 0x80, 0x9c, 0xb0, 0x1a, 0xa5, 0x76, 0x01,
+	0x39, 0xc6, 0xaf, 0x0a, 0xd0, 0xb7, 0x89, 0x7b, 0x3f, 0xb0, 0x7c, 0xd2, 0x85, 0x41, 0xb6, 0x8c,
+	0x4d, 0x50, 0xc6, 0x0f, 0x7d, 0x18, 0x8c, 0xac, 0x1f, 0x77, 0x13, 0xe1, 0x0b, 0x51, 0x78, 0x76,
+	0xe4, 0x02, 0x68, 0xa3, 0x3e, 0x82, 0x61, 0x4d, 0x46, 0x14, 0x32, 0x76, 0x35, 0xd7, 0x18, 0x05,
+	0x1e, 0x53, 0x32, 0x48, 0x72, 0xb2, 0x35, 0xae, 0x03, 0x23, 0xdf, 0x1a, 0x9f, 0xdb, 0xef, 0x94,
+	0xf0, 0xdc, 0x6e, 0xd8, 0x36, 0xec, 0xd3, 0xec, 0x86, 0x53, 0x09, 0x2b, 0xe7, 0x4e, 0x38, 0xbb,
+	0x71, 0xf3, 0x16, 0xdb, 0xc0, 0xa9, 0x5d, 0x52, 0x43, 0xe9, 0xfb, 0x0d, 0x03, 0xd4, 0xf3, 0x6c,
+	0xd1, 0x06, 0x56, 0xff, 0x98, 0x06, 0xc5, 0x6d, 0xe2, 0xaa, 0x8f, 0x15, 0x30, 0x27, 0xbd, 0x07,
+	0xb6, 0xf2, 0x3a, 0x5b, 0xce, 0x55, 0x46, 0xbf, 0x3d, 0xa6, 0x20, 0x3e, 0x4e, 0xdf, 0x2a, 0xe0,
+	0xf5, 0xa1, 0x17, 0x9f, 0xd1, 0x91, 0xe5, 0x42, 0x7d, 0xfd, 0x82, 0x42, 0x79, 0x6a, 0xb2, 0x7b,
+	0xc2, 0xb9, 0x52, 0x93, 0x08, 0xf5, 0xf5, 0x0b, 0x0a, 0x25, 0xa9, 0xe5, 0x0c, 0xde, 0xd1, 0xa9,
+	0xc9, 0x85, 0xfa, 0xfa, 0x05, 0x85, 0x71, 0x6a, 0x9f, 0x81, 0xe9, 0x78, 0x26, 0x5d, 0x1b, 0x16,
+	0x4c, 0x38, 0xe9, 0x6f, 0x9d, 0xc3, 0x29, 0x8e, 0xfe, 0x85, 0x02, 0xe6, 0xe5, 0xf3, 0xe2, 0xed,
+	0xf3, 0x9e, 0xc0, 0x48, 0xa1, 0xdf, 0x19, 0x57, 0x11, 0x67, 0xf1, 0xa5, 0x02, 0x16, 0xf2, 0x1a,
+	0xde, 0xea, 0x90, 0xa8, 0x39, 0x1a, 0xdd, 0x1c, 0x5f, 0x93, 0x22, 0x22, 0xef, 0x44, 0xc3, 0x88,
+	0x48, 0x15, 0xfa, 0x9d, 0x71, 0x15, 0x51, 0x16, 0x7a, 0xf9, 0x31, 0x1b, 0x6e, 0x9b, 0x77, 0x9f,
+	0x1e, 0x55, 0x95, 0x67, 0x47, 0x55, 0xe5, 0xef, 0xa3, 0xaa, 0xf2, 0xcd, 0x71, 0x75, 0xe2, 0xd9,
+	0x71, 0x75, 0xe2, 0xcf, 0xe3, 0xea, 0xc4, 0xa7, 0x2b, 0x43, 0xa7, 0xe4, 0xa3, 0x16, 0xbb, 0x2c,
+	0xc4, 0x3f, 0x72, 0xc3, 0xa1, 0xd9, 0x99, 0x0c, 0x7f, 0xaf, 0xbe, 0xf3, 0xef, 0x00, 0x1e, 0xf8,
+	0xc9, 0x8c, 0x8d, 0x0f, 0x00, 0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -787,6 +1156,15 @@ type MsgClient interface {
 	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
 	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// CreateVestingPosition defines a method that enables adding a vesting
+	// position to an account, which may already exist.
+	CreateVestingPosition(ctx context.Context, in *MsgCreateVestingPosition, opts ...grpc.CallOption) (*MsgCreateVestingPositionResponse, error)
+	// TransferVestingPosition defines a method that enables transferring a
+	// vesting position, with its unvested coins, to another account.
+	TransferVestingPosition(ctx context.Context, in *MsgTransferVestingPosition, opts ...grpc.CallOption) (*MsgTransferVestingPositionResponse, error)
+	// AcceptVestingPosition defines a method that enables accepting a vesting
+	// position offered to an account.
+	AcceptVestingPosition(ctx context.Context, in *MsgAcceptVestingPosition, opts ...grpc.CallOption) (*MsgAcceptVestingPositionResponse, error)
 }
 
 type msgClient struct {
@@ -842,6 +1220,33 @@ func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) CreateVestingPosition(ctx context.Context, in *MsgCreateVestingPosition, opts ...grpc.CallOption) (*MsgCreateVestingPositionResponse, error) {
+	out := new(MsgCreateVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateVestingPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferVestingPosition(ctx context.Context, in *MsgTransferVestingPosition, opts ...grpc.CallOption) (*MsgTransferVestingPositionResponse, error) {
+	out := new(MsgTransferVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/TransferVestingPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptVestingPosition(ctx context.Context, in *MsgAcceptVestingPosition, opts ...grpc.CallOption) (*MsgAcceptVestingPositionResponse, error) {
+	out := new(MsgAcceptVestingPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/AcceptVestingPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateVestingAccount defines a method that enables creating a vesting
@@ -862,6 +1267,15 @@ type MsgServer interface {
 	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
 	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
 	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// CreateVestingPosition defines a method that enables adding a vesting
+	// position to an account, which may already exist.
+	CreateVestingPosition(context.Context, *MsgCreateVestingPosition) (*MsgCreateVestingPositionResponse, error)
+	// TransferVestingPosition defines a method that enables transferring a
+	// vesting position, with its unvested coins, to another account.
+	TransferVestingPosition(context.Context, *MsgTransferVestingPosition) (*MsgTransferVestingPositionResponse, error)
+	// AcceptVestingPosition defines a method that enables accepting a vesting
+	// position offered to an account.
+	AcceptVestingPosition(context.Context, *MsgAcceptVestingPosition) (*MsgAcceptVestingPositionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -883,6 +1297,15 @@ func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context,
 func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
 }
+func (*UnimplementedMsgServer) CreateVestingPosition(ctx context.Context, req *MsgCreateVestingPosition) (*MsgCreateVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingPosition not implemented")
+}
+func (*UnimplementedMsgServer) TransferVestingPosition(ctx context.Context, req *MsgTransferVestingPosition) (*MsgTransferVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVestingPosition not implemented")
+}
+func (*UnimplementedMsgServer) AcceptVestingPosition(ctx context.Context, req *MsgAcceptVestingPosition) (*MsgAcceptVestingPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptVestingPosition not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -978,37 +1401,103 @@ func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.vesting.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVestingAccount",
-			Handler:    _Msg_CreateVestingAccount_Handler,
-		},
-		{
-			MethodName: "CreatePermanentLockedAccount",
-			Handler:    _Msg_CreatePermanentLockedAccount_Handler,
-		},
-		{
-			MethodName: "CreatePeriodicVestingAccount",
-			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
-		},
-		{
-			MethodName: "CreateClawbackVestingAccount",
-			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
-		},
-		{
-			MethodName: "Clawback",
-			Handler:    _Msg_Clawback_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/vesting/v1beta1/tx.proto",
-}
-
-func (m *MsgCreateVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+func _Msg_CreateVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateVestingPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingPosition(ctx, req.(*MsgCreateVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/TransferVestingPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVestingPosition(ctx, req.(*MsgTransferVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptVestingPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptVestingPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptVestingPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/AcceptVestingPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptVestingPosition(ctx, req.(*MsgAcceptVestingPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.vesting.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVestingAccount",
+			Handler:    _Msg_CreateVestingAccount_Handler,
+		},
+		{
+			MethodName: "CreatePermanentLockedAccount",
+			Handler:    _Msg_CreatePermanentLockedAccount_Handler,
+		},
+		{
+			MethodName: "CreatePeriodicVestingAccount",
+			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
+		},
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "CreateVestingPosition",
+			Handler:    _Msg_CreateVestingPosition_Handler,
+		},
+		{
+			MethodName: "TransferVestingPosition",
+			Handler:    _Msg_TransferVestingPosition_Handler,
+		},
+		{
+			MethodName: "AcceptVestingPosition",
+			Handler:    _Msg_AcceptVestingPosition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/vesting/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -1372,6 +1861,13 @@ func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.DestAddress) > 0 {
 		i -= len(m.DestAddress)
 		copy(dAtA[i:], m.DestAddress)
@@ -1419,6 +1915,223 @@ func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateVestingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CliffTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVestingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVestingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVestingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptVestingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptVestingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptVestingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptVestingPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptVestingPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptVestingPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1564,58 +2277,595 @@ func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	if m.Merge {
-		n += 2
-	}
-	return n
-}
-
-func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgClawback) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DestAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	if m.Merge {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateVestingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovTx(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgCreateVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgTransferVestingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptVestingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAcceptVestingPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delayed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgClawbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1638,10 +2888,10 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1709,10 +2959,10 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1722,31 +2972,16 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
-			m.EndTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1756,31 +2991,26 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Delayed = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1802,7 +3032,7 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,10 +3055,10 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1852,7 +3082,7 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1875,10 +3105,10 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1946,8 +3176,27 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1974,11 +3223,65 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2000,7 +3303,7 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2023,10 +3326,10 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2050,7 +3353,7 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2073,15 +3376,15 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2109,11 +3412,11 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2141,13 +3444,13 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			m.StartTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2157,16 +3460,29 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2176,25 +3492,23 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2217,7 +3531,7 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2240,10 +3554,10 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2267,7 +3581,7 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateVestingPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2290,10 +3604,10 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateVestingPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateVestingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2361,27 +3675,8 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2408,16 +3703,16 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2427,31 +3722,35 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
 			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var v int
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2461,12 +3760,11 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Merge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2488,7 +3786,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateVestingPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2511,12 +3809,31 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateVestingPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2538,7 +3855,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferVestingPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2561,15 +3878,15 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferVestingPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferVestingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2597,11 +3914,30 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2629,11 +3965,111 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferVestingPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferVestingPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptVestingPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptVestingPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptVestingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2661,8 +4097,27 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2684,7 +4139,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptVestingPositionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2707,10 +4162,10 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptVestingPositionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptVestingPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -330,6 +331,122 @@ func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
 
+// VestingPosition defines a vesting grant of coins to an existing account,
+// independent of the account type. An account can hold a bounded number of
+// vesting positions, whose locked coins add up to the coins locked by the
+// account itself. The coins vest linearly from start_time to end_time, but none
+// of them vest before cliff_time. A position offered to another account stays
+// with its owner until the recipient accepts it.
+type VestingPosition struct {
+	// id is the unique identifier of the vesting position.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account holding the vesting coins.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// funder is the address of the account which funded the vesting position.
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	// amount is the total amount of coins of the vesting position.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// start of vesting as unix time (in seconds).
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff of vesting as unix time (in seconds), before which no coins vest.
+	// When equal to end_time, all the coins vest at once.
+	CliffTime int64 `protobuf:"varint,6,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end of vesting as unix time (in seconds).
+	EndTime int64 `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// recipient is the address of the account the position is offered to, which
+	// becomes its owner once it accepts it. Empty if the position isn't offered.
+	Recipient string `protobuf:"bytes,8,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *VestingPosition) Reset()         { *m = VestingPosition{} }
+func (m *VestingPosition) String() string { return proto.CompactTextString(m) }
+func (*VestingPosition) ProtoMessage()    {}
+func (*VestingPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{7}
+}
+func (m *VestingPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPosition.Merge(m, src)
+}
+func (m *VestingPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPosition proto.InternalMessageInfo
+
+func (m *VestingPosition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VestingPosition) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *VestingPosition) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *VestingPosition) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *VestingPosition) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *VestingPosition) GetCliffTime() int64 {
+	if m != nil {
+		return m.CliffTime
+	}
+	return 0
+}
+
+func (m *VestingPosition) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *VestingPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -338,6 +455,7 @@ func init() {
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
+	proto.RegisterType((*VestingPosition)(nil), "cosmos.vesting.v1beta1.VestingPosition")
 }
 
 func init() {
@@ -345,53 +463,60 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xb1, 0x4f, 0xdb, 0x4a,
-	0x18, 0x8f, 0x49, 0xc8, 0x83, 0xe3, 0x11, 0xc0, 0x82, 0x28, 0x20, 0xe1, 0xe4, 0xf9, 0xbd, 0x21,
-	0x8a, 0x84, 0xf3, 0xa0, 0xed, 0x92, 0xa9, 0x84, 0xaa, 0x52, 0xd5, 0x0e, 0x55, 0x54, 0x75, 0xe8,
-	0x12, 0x9d, 0xed, 0xc3, 0xb1, 0x62, 0xdf, 0x45, 0xbe, 0x33, 0x14, 0x55, 0xdd, 0xab, 0x4e, 0x8c,
-	0x95, 0x3a, 0x94, 0xb1, 0x42, 0x1d, 0xf8, 0x33, 0x58, 0xaa, 0x32, 0x76, 0x4a, 0x2b, 0x18, 0xd8,
-	0xf9, 0x0b, 0x2a, 0xdf, 0x9d, 0x1d, 0x93, 0x04, 0xc4, 0x02, 0xed, 0x02, 0xbe, 0xef, 0xfb, 0xee,
-	0xfb, 0xfd, 0x7e, 0xdf, 0xfd, 0x2e, 0x3a, 0xf0, 0x9f, 0x45, 0xa8, 0x4f, 0x68, 0x7d, 0x07, 0x51,
-	0xe6, 0x62, 0xa7, 0xbe, 0xb3, 0x6e, 0x22, 0x06, 0xd7, 0xe3, 0xb5, 0xd1, 0x0b, 0x08, 0x23, 0x6a,
-	0x51, 0x54, 0x19, 0x71, 0x54, 0x56, 0xad, 0x2c, 0x40, 0xdf, 0xc5, 0xa4, 0xce, 0xff, 0x8a, 0xd2,
-	0x95, 0x45, 0x87, 0x38, 0x84, 0x7f, 0xd6, 0xa3, 0x2f, 0x19, 0xd5, 0x24, 0x8c, 0x09, 0x29, 0x4a,
-	0x30, 0x2c, 0xe2, 0xe2, 0xa1, 0x3c, 0x0c, 0x59, 0x27, 0xc9, 0x47, 0x0b, 0x91, 0xd7, 0x3f, 0xe6,
-	0x80, 0xda, 0x84, 0x14, 0xbd, 0x14, 0x04, 0x36, 0x2d, 0x8b, 0x84, 0x98, 0xa9, 0x4f, 0xc0, 0xdf,
-	0x51, 0xc7, 0x36, 0x14, 0xeb, 0x92, 0x52, 0x51, 0xaa, 0x33, 0x1b, 0x15, 0x43, 0xd2, 0xe5, 0x0d,
-	0x64, 0x37, 0x23, 0xda, 0x2e, 0xf7, 0x35, 0x73, 0x27, 0xfd, 0xb2, 0xd2, 0x9a, 0x31, 0x07, 0x21,
-	0xf5, 0x0d, 0x98, 0x27, 0x81, 0xeb, 0xb8, 0x18, 0x7a, 0x6d, 0x29, 0xb3, 0x34, 0x51, 0xc9, 0x56,
-	0x67, 0x36, 0x96, 0xe3, 0x76, 0x51, 0x79, 0xd2, 0x6e, 0x8b, 0xb8, 0xb8, 0xf9, 0xe0, 0xb8, 0x5f,
-	0xce, 0x1c, 0xfe, 0x28, 0x57, 0x1d, 0x97, 0x75, 0x42, 0xd3, 0xb0, 0x88, 0x5f, 0x97, 0x4a, 0xc4,
-	0xbf, 0x35, 0x6a, 0x77, 0xeb, 0x6c, 0xaf, 0x87, 0x28, 0xdf, 0x40, 0x3f, 0x9f, 0x1f, 0xd5, 0x94,
-	0xd6, 0x5c, 0x8c, 0x24, 0xe5, 0xa8, 0xbb, 0xa0, 0x60, 0x23, 0x0f, 0x39, 0x90, 0x21, 0xbb, 0xbd,
-	0x1d, 0x20, 0x54, 0xca, 0xde, 0x12, 0xf4, 0x6c, 0x82, 0xf3, 0x38, 0x40, 0x48, 0x7d, 0x0b, 0x16,
-	0x06, 0xc0, 0xb1, 0xec, 0xdc, 0x2d, 0x61, 0xcf, 0x27, 0x50, 0xb1, 0xee, 0x65, 0x30, 0x85, 0xb0,
-	0xdd, 0x66, 0xae, 0x8f, 0x4a, 0x93, 0x15, 0xa5, 0x9a, 0x6d, 0xfd, 0x85, 0xb0, 0xfd, 0xc2, 0xf5,
-	0x51, 0xa3, 0xf6, 0xee, 0xa0, 0x9c, 0xf9, 0x70, 0x50, 0xce, 0xbc, 0x3f, 0x3f, 0xaa, 0xad, 0xa6,
-	0xda, 0x8e, 0xda, 0x40, 0xff, 0xa6, 0x80, 0xd2, 0x16, 0xc1, 0xcc, 0xc5, 0x21, 0x09, 0xe9, 0x90,
-	0x47, 0x4c, 0xb0, 0xc8, 0x3d, 0x22, 0xd5, 0x0d, 0x79, 0xa5, 0x66, 0x8c, 0xb7, 0xb6, 0x31, 0x0a,
-	0x23, 0x5d, 0xa3, 0x9a, 0xa3, 0x3e, 0x5c, 0x05, 0x80, 0x32, 0x18, 0x30, 0xa1, 0x64, 0x82, 0x2b,
-	0x99, 0xe6, 0x11, 0xae, 0xe5, 0xff, 0xb4, 0x96, 0x7f, 0x53, 0x5a, 0xae, 0x22, 0xad, 0x1f, 0x2a,
-	0x60, 0xe9, 0x11, 0xf2, 0xe0, 0x5e, 0x32, 0xab, 0x3b, 0x94, 0xd3, 0x58, 0x4b, 0xf3, 0xad, 0xa4,
-	0xf8, 0x8e, 0xa5, 0xa4, 0xef, 0x2b, 0x20, 0xff, 0x1c, 0x05, 0x2e, 0xb1, 0xd5, 0x22, 0xc8, 0x7b,
-	0x08, 0x3b, 0xac, 0xc3, 0xf9, 0x64, 0x5b, 0x72, 0xa5, 0x76, 0x40, 0x1e, 0xfa, 0x9c, 0xe7, 0x6d,
-	0xdd, 0x29, 0xd9, 0xbf, 0x91, 0x8b, 0x78, 0xeb, 0x9f, 0x26, 0x40, 0x51, 0x50, 0x72, 0xad, 0x3f,
-	0xce, 0x0f, 0x6a, 0x0b, 0xcc, 0xc5, 0xe8, 0x3d, 0x4e, 0x92, 0xca, 0xfb, 0xae, 0x5d, 0x85, 0x2e,
-	0xb4, 0x34, 0xa7, 0xa3, 0xd9, 0x08, 0xbd, 0x05, 0x59, 0x22, 0x32, 0xb4, 0x61, 0xa4, 0xcf, 0xec,
-	0x9f, 0xd4, 0xa4, 0xc6, 0x8f, 0x41, 0xff, 0xa2, 0xf0, 0x09, 0xf9, 0x10, 0x23, 0xcc, 0x9e, 0x11,
-	0xab, 0x8b, 0xec, 0xbb, 0xb4, 0xd8, 0x75, 0x74, 0xc7, 0x70, 0xd2, 0xbf, 0x66, 0x41, 0x71, 0xcb,
-	0x83, 0xbb, 0x26, 0xb4, 0xba, 0xbf, 0xe1, 0x40, 0x1f, 0x82, 0xc2, 0x76, 0x88, 0x6d, 0x14, 0xb4,
-	0xa1, 0x6d, 0x07, 0x88, 0x52, 0x7e, 0xa8, 0xd3, 0xcd, 0xe5, 0x8b, 0x7e, 0x79, 0x69, 0x0f, 0xfa,
-	0x5e, 0x43, 0xbf, 0x9c, 0xd7, 0x5b, 0xb3, 0x22, 0xb0, 0x29, 0xd6, 0xea, 0xfd, 0x4b, 0x96, 0xc8,
-	0x46, 0x96, 0x68, 0x2e, 0x5d, 0xf4, 0xcb, 0x0b, 0x62, 0xf7, 0x20, 0xa7, 0xa7, 0x9d, 0x62, 0x83,
-	0x82, 0x47, 0xac, 0x6e, 0xd8, 0x4b, 0x8c, 0x92, 0xbb, 0x91, 0x51, 0x56, 0x23, 0xa3, 0x0c, 0xb8,
-	0x5d, 0xee, 0xa1, 0xb7, 0x66, 0x45, 0x40, 0x7a, 0x47, 0x75, 0x46, 0xfd, 0x38, 0x79, 0x23, 0x18,
-	0x4d, 0xc2, 0x14, 0x05, 0xcc, 0x50, 0x13, 0x7d, 0xc4, 0xa4, 0x53, 0xf1, 0xa9, 0x37, 0x9f, 0x1e,
-	0x9f, 0x6a, 0xca, 0xc9, 0xa9, 0xa6, 0xfc, 0x3c, 0xd5, 0x94, 0xfd, 0x33, 0x2d, 0x73, 0x72, 0xa6,
-	0x65, 0xbe, 0x9f, 0x69, 0x99, 0x57, 0xeb, 0xd7, 0xde, 0xfb, 0xd7, 0xf2, 0x89, 0x20, 0x9f, 0x2b,
-	0xfc, 0x67, 0xc0, 0xcc, 0xf3, 0x47, 0xc2, 0xbd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xda, 0x1f,
-	0xf0, 0xaa, 0xcd, 0x08, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x25, 0x59, 0xb1, 0xcf, 0xb5, 0x1c, 0x1f, 0x6c, 0x81, 0x0e, 0x20, 0x4a, 0x65, 0x3b,
+	0x08, 0x02, 0x4c, 0xc5, 0xe9, 0x8f, 0x41, 0x53, 0x23, 0x17, 0x05, 0x8a, 0x76, 0x08, 0xd8, 0xa2,
+	0x43, 0x17, 0xe1, 0x48, 0x9e, 0xa8, 0x83, 0xc8, 0x3b, 0x81, 0x77, 0x8a, 0x6b, 0x14, 0xdd, 0x8b,
+	0x4e, 0x19, 0x0b, 0x74, 0x68, 0xc6, 0x22, 0xe8, 0x90, 0xa1, 0x7f, 0x44, 0x96, 0xa2, 0x46, 0xa7,
+	0x4e, 0x6a, 0x61, 0x0f, 0xd9, 0xf3, 0x07, 0x14, 0x05, 0xef, 0x8e, 0x12, 0xf5, 0x23, 0x3f, 0x16,
+	0xa5, 0x59, 0x6c, 0xdd, 0x7b, 0xef, 0xde, 0xf7, 0x7d, 0xef, 0x3e, 0x1e, 0x09, 0xde, 0xf5, 0x19,
+	0x8f, 0x19, 0xef, 0xdc, 0xc7, 0x5c, 0x10, 0x1a, 0x76, 0xee, 0x9f, 0x7a, 0x58, 0xa0, 0xd3, 0x6c,
+	0xed, 0x8c, 0x13, 0x26, 0x18, 0xac, 0xa9, 0x2a, 0x27, 0x8b, 0xea, 0xaa, 0x5b, 0x07, 0x28, 0x26,
+	0x94, 0x75, 0xe4, 0x5f, 0x55, 0x7a, 0xeb, 0x30, 0x64, 0x21, 0x93, 0x3f, 0x3b, 0xe9, 0x2f, 0x1d,
+	0xb5, 0x34, 0x8c, 0x87, 0x38, 0x9e, 0x61, 0xf8, 0x8c, 0xd0, 0xa5, 0x3c, 0x9a, 0x88, 0xe1, 0x2c,
+	0x9f, 0x2e, 0x74, 0xfe, 0x58, 0xe5, 0xfb, 0xaa, 0xb1, 0x66, 0x23, 0x17, 0xf6, 0x4f, 0x65, 0x00,
+	0x7b, 0x88, 0xe3, 0xaf, 0x14, 0xb7, 0xbb, 0xbe, 0xcf, 0x26, 0x54, 0xc0, 0x4f, 0xc1, 0x5b, 0x29,
+	0x58, 0x1f, 0xa9, 0xb5, 0x69, 0x34, 0x8d, 0xd6, 0xee, 0x9d, 0xa6, 0xa3, 0xf7, 0xca, 0xde, 0x1a,
+	0xc8, 0x49, 0xb7, 0xeb, 0x7d, 0xbd, 0xf2, 0xe5, 0xb4, 0x61, 0xb8, 0xbb, 0xde, 0x3c, 0x04, 0xbf,
+	0x05, 0x37, 0x59, 0x42, 0x42, 0x42, 0x51, 0xd4, 0xd7, 0x13, 0x30, 0x8b, 0xcd, 0x52, 0x6b, 0xf7,
+	0xce, 0x71, 0xd6, 0x2e, 0x2d, 0x9f, 0xb5, 0x3b, 0x63, 0x84, 0xf6, 0x3e, 0x78, 0x32, 0x6d, 0x14,
+	0x1e, 0xfd, 0xdd, 0x68, 0x85, 0x44, 0x0c, 0x27, 0x9e, 0xe3, 0xb3, 0x58, 0xf3, 0xd6, 0xff, 0x4e,
+	0x78, 0x30, 0xea, 0x88, 0x8b, 0x31, 0xe6, 0x72, 0x03, 0xff, 0xe5, 0xe9, 0xe3, 0xb6, 0xe1, 0xee,
+	0x67, 0x48, 0x5a, 0x0e, 0x3c, 0x07, 0xd5, 0x00, 0x47, 0x38, 0x44, 0x02, 0x07, 0xfd, 0x41, 0x82,
+	0xb1, 0x59, 0xda, 0x10, 0xf4, 0xde, 0x0c, 0xe7, 0x93, 0x04, 0x63, 0xf8, 0x1d, 0x38, 0x98, 0x03,
+	0x67, 0xb2, 0xcb, 0x1b, 0xc2, 0xbe, 0x39, 0x83, 0xca, 0x74, 0x1f, 0x83, 0x6d, 0x4c, 0x83, 0xbe,
+	0x20, 0x31, 0x36, 0xb7, 0x9a, 0x46, 0xab, 0xe4, 0xde, 0xc0, 0x34, 0xf8, 0x92, 0xc4, 0xb8, 0xdb,
+	0xfe, 0xfe, 0x61, 0xa3, 0xf0, 0xe3, 0xc3, 0x46, 0xe1, 0x87, 0xa7, 0x8f, 0xdb, 0xf5, 0x5c, 0xdb,
+	0x55, 0x1b, 0xd8, 0x7f, 0x18, 0xc0, 0x3c, 0x63, 0x54, 0x10, 0x3a, 0x61, 0x13, 0xbe, 0xe4, 0x11,
+	0x0f, 0x1c, 0x4a, 0x8f, 0x68, 0x75, 0x4b, 0x5e, 0x69, 0x3b, 0xeb, 0x5d, 0xef, 0xac, 0xc2, 0x68,
+	0xd7, 0x40, 0x6f, 0xd5, 0x87, 0x75, 0x00, 0xb8, 0x40, 0x89, 0x50, 0x4a, 0x8a, 0x52, 0xc9, 0x8e,
+	0x8c, 0x48, 0x2d, 0xb7, 0xf3, 0x5a, 0xde, 0xc9, 0x69, 0x79, 0x1e, 0x69, 0xfb, 0x91, 0x01, 0x8e,
+	0x3e, 0xc6, 0x11, 0xba, 0xc0, 0xc1, 0x62, 0xe6, 0x75, 0xc8, 0xe9, 0x9e, 0xe4, 0xf9, 0x36, 0x73,
+	0x7c, 0xd7, 0x52, 0xb2, 0x1f, 0x18, 0xa0, 0x72, 0x0f, 0x27, 0x84, 0x05, 0xb0, 0x06, 0x2a, 0x11,
+	0xa6, 0xa1, 0x18, 0x4a, 0x3e, 0x25, 0x57, 0xaf, 0xe0, 0x10, 0x54, 0x50, 0x2c, 0x79, 0x6e, 0xea,
+	0x99, 0xd2, 0xfd, 0xbb, 0xe5, 0x94, 0xb7, 0xfd, 0x73, 0x11, 0xd4, 0x14, 0x25, 0xe2, 0xbf, 0x71,
+	0x7e, 0x80, 0x2e, 0xd8, 0xcf, 0xd0, 0xc7, 0x92, 0x24, 0xd7, 0xcf, 0xbb, 0xf5, 0x3c, 0x74, 0xa5,
+	0xa5, 0xb7, 0x93, 0xce, 0x46, 0xe9, 0xad, 0xea, 0x12, 0x95, 0xe1, 0x5d, 0x27, 0x7f, 0x66, 0x6f,
+	0xe7, 0x26, 0xb5, 0x7e, 0x0c, 0xf6, 0xaf, 0x86, 0x9c, 0x50, 0x8c, 0x28, 0xa6, 0xe2, 0x73, 0xe6,
+	0x8f, 0x70, 0xf0, 0x3a, 0x2d, 0xf6, 0x22, 0xba, 0x6b, 0x38, 0xd9, 0xbf, 0x97, 0x40, 0xed, 0x2c,
+	0x42, 0xe7, 0x1e, 0xf2, 0x47, 0xff, 0xc3, 0x81, 0x7e, 0x04, 0xaa, 0x83, 0x09, 0x0d, 0x70, 0xd2,
+	0x47, 0x41, 0x90, 0x60, 0xce, 0xe5, 0xa1, 0xee, 0xf4, 0x8e, 0x9f, 0x4d, 0x1b, 0x47, 0x17, 0x28,
+	0x8e, 0xba, 0xf6, 0x62, 0xde, 0x76, 0xf7, 0x54, 0xe0, 0xae, 0x5a, 0xc3, 0xf7, 0x17, 0x2c, 0x51,
+	0x4a, 0x2d, 0xd1, 0x3b, 0x7a, 0x36, 0x6d, 0x1c, 0xa8, 0xdd, 0xf3, 0x9c, 0x9d, 0x77, 0x4a, 0x00,
+	0xaa, 0x11, 0xf3, 0x47, 0x93, 0xf1, 0xcc, 0x28, 0xe5, 0x57, 0x32, 0x4a, 0x3d, 0x35, 0xca, 0x9c,
+	0xdb, 0x62, 0x0f, 0xdb, 0xdd, 0x53, 0x01, 0x55, 0xcc, 0x61, 0xb8, 0xea, 0xc7, 0xad, 0x57, 0x82,
+	0xb1, 0x34, 0x4c, 0x4d, 0xc1, 0x2c, 0x35, 0xb1, 0x57, 0x4c, 0xba, 0x9d, 0x9d, 0xba, 0xfd, 0x6f,
+	0x11, 0xec, 0xeb, 0x19, 0xdf, 0x63, 0x9c, 0x08, 0xc2, 0x28, 0xac, 0x82, 0x22, 0x09, 0xe4, 0xb1,
+	0x95, 0xdd, 0x22, 0x09, 0xa0, 0x03, 0xb6, 0xd8, 0x39, 0xc5, 0x89, 0x9e, 0xb5, 0xf9, 0xe7, 0x6f,
+	0x27, 0x87, 0x9a, 0x8f, 0x9e, 0xea, 0x17, 0x22, 0x21, 0x34, 0x74, 0x55, 0x19, 0xbc, 0x0d, 0x2a,
+	0x6a, 0xe6, 0x66, 0xe9, 0x25, 0x1b, 0x74, 0x5d, 0xee, 0x5a, 0x2a, 0x6f, 0xf6, 0x5a, 0x5a, 0xba,
+	0x11, 0xb6, 0x96, 0x6f, 0x84, 0x3a, 0x00, 0x7e, 0x44, 0x06, 0x03, 0x95, 0xae, 0xa8, 0xb4, 0x8c,
+	0xc8, 0x74, 0xfe, 0x3d, 0x79, 0x63, 0xe1, 0x3d, 0x09, 0x3f, 0x04, 0x3b, 0x09, 0xf6, 0xc9, 0x98,
+	0x60, 0x2a, 0xcc, 0xed, 0x97, 0xe8, 0x9e, 0x97, 0xf6, 0x3e, 0x7b, 0x72, 0x65, 0x19, 0x97, 0x57,
+	0x96, 0xf1, 0xcf, 0x95, 0x65, 0x3c, 0xb8, 0xb6, 0x0a, 0x97, 0xd7, 0x56, 0xe1, 0xaf, 0x6b, 0xab,
+	0xf0, 0xf5, 0xe9, 0x0b, 0x15, 0x7e, 0xa3, 0x3f, 0xdf, 0xf4, 0xa7, 0xa4, 0x14, 0xec, 0x55, 0xe4,
+	0x57, 0xda, 0x7b, 0xff, 0x0d, 0x00, 0xdd, 0x1f, 0x73, 0xe6, 0x69, 0x0a, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -757,6 +882,84 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *VestingPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CliffTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.CliffTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -916,6 +1119,45 @@ func (m *ClawbackVestingAccount) Size() (n int) {
 	return n
 }
 
+func (m *VestingPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if m.CliffTime != 0 {
+		n += 1 + sovVesting(uint64(m.CliffTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovVesting(uint64(m.EndTime))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1853,6 +2095,262 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VestingPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			m.CliffTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
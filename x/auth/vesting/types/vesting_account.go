@@ -13,6 +13,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Compile-time type assertions
@@ -858,18 +859,37 @@ func (va *ClawbackVestingAccount) computeClawback(clawbackTime int64) sdk.Coins
 }
 
 type clawbackAction struct {
-	requestor sdk.AccAddress
-	dest      sdk.AccAddress
-	ak        AccountKeeper
-	bk        BankKeeper
+	requestor   sdk.AccAddress
+	dest        sdk.AccAddress
+	byAuthority bool
+	ak          AccountKeeper
+	bk          BankKeeper
+	sk          StakingKeeper
 }
 
-func NewClawbackAction(requestor, dest sdk.AccAddress, ak AccountKeeper, bk BankKeeper) vestexported.ClawbackAction {
+// NewClawbackAction returns a ClawbackAction requested by the funder of the
+// account.
+func NewClawbackAction(requestor, dest sdk.AccAddress, ak AccountKeeper, bk BankKeeper, sk StakingKeeper) vestexported.ClawbackAction {
 	return clawbackAction{
 		requestor: requestor,
 		dest:      dest,
 		ak:        ak,
 		bk:        bk,
+		sk:        sk,
+	}
+}
+
+// NewAuthorityClawbackAction returns a ClawbackAction requested by the
+// authority of the vesting module, e.g. the gov module account, which may claw
+// back any account regardless of its funder.
+func NewAuthorityClawbackAction(authority, dest sdk.AccAddress, ak AccountKeeper, bk BankKeeper, sk StakingKeeper) vestexported.ClawbackAction {
+	return clawbackAction{
+		requestor:   authority,
+		dest:        dest,
+		byAuthority: true,
+		ak:          ak,
+		bk:          bk,
+		sk:          sk,
 	}
 }
 
@@ -878,37 +898,149 @@ func (ca clawbackAction) TakeFromAccount(ctx sdk.Context, rawAccount vestexporte
 	if !ok {
 		return fmt.Errorf("clawback expects *ClawbackVestingAccount, got %T", rawAccount)
 	}
-	if ca.requestor.String() != cva.FunderAddress {
+	if !ca.byAuthority && ca.requestor.String() != cva.FunderAddress {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "clawback can only be requested by original funder %s", cva.FunderAddress)
 	}
-	return cva.clawback(ctx, ca.dest, ca.ak, ca.bk)
+	_, err := cva.clawback(ctx, ca.dest, ca.ak, ca.bk, ca.sk)
+	return err
 }
 
 func (va *ClawbackVestingAccount) Clawback(ctx sdk.Context, action vestexported.ClawbackAction) error {
 	return action.TakeFromAccount(ctx, va)
 }
 
-// Clawback transfers unvested tokens in a ClawbackVestingAccount to dest.
+// ClawbackResult describes how the unvested tokens of a ClawbackVestingAccount
+// are transferred by a clawback.
+type ClawbackResult struct {
+	// Spendable are the coins sent from the balance of the account.
+	Spendable sdk.Coins
+	// Unbonding are the tokens of the unbonding delegation entries transferred.
+	Unbonding sdk.Coins
+	// Delegated are the tokens of the delegations transferred.
+	Delegated sdk.Coins
+}
+
+// PreviewClawback returns what a clawback to dest would transfer at the
+// current block time, without altering the account nor the state.
+func (va ClawbackVestingAccount) PreviewClawback(ctx sdk.Context, dest sdk.AccAddress, ak AccountKeeper, bk BankKeeper, sk StakingKeeper) (ClawbackResult, error) {
+	bva := *va.BaseVestingAccount
+	va.BaseVestingAccount = &bva
+
+	cacheCtx, _ := ctx.CacheContext()
+	return va.clawback(cacheCtx, dest, ak, bk, sk)
+}
+
+// clawback transfers unvested tokens in a ClawbackVestingAccount to dest.
 // Future vesting events are removed. Unstaked tokens are simply sent.
 // Unbonding and staked tokens are transferred with their staking state
-// intact.  Account state is updated to reflect the removals.
-func (va *ClawbackVestingAccount) clawback(ctx sdk.Context, dest sdk.AccAddress, ak AccountKeeper, bk BankKeeper) error {
+// intact, the unbonding entries first.  Account state is updated to reflect
+// the removals. It fails if any of the unvested tokens can't be transferred,
+// in which case the caller must discard the state changes.
+func (va *ClawbackVestingAccount) clawback(ctx sdk.Context, dest sdk.AccAddress, ak AccountKeeper, bk BankKeeper, sk StakingKeeper) (ClawbackResult, error) {
+	res := ClawbackResult{Spendable: sdk.NewCoins(), Unbonding: sdk.NewCoins(), Delegated: sdk.NewCoins()}
+
 	// Compute the clawback based on the account state only, and update account
 	toClawBack := va.computeClawback(ctx.BlockTime().Unix())
 	if toClawBack.IsZero() {
-		return nil
+		return res, nil
 	}
 	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+
+	// Adjust the clawback and the delegation tracking to the actual bank and
+	// staking state of the account, as delegations may have been slashed.
+	encumbered := va.GetVestingCoins(ctx.BlockTime())
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorBonded(ctx, addr)))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, sk.GetDelegatorUnbonding(ctx, addr)))
+	unbonded := bk.GetAllBalances(ctx, addr)
+	toClawBack = va.updateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
 
 	// update the account's vesting settings
 	ak.SetAccount(ctx, va)
 
 	// Now that future vesting events (and associated lockup) are removed,
 	// the balance of the account is unlocked and can be freely transferred.
-	err := bk.SendCoins(ctx, addr, dest, toClawBack)
-	if err != nil {
-		// shouldn't happen, we have a correctness issue in toClawBack in this case
-		return err
+	res.Spendable = coinsMin(toClawBack, bk.SpendableCoins(ctx, addr))
+	if err := bk.SendCoins(ctx, addr, dest, res.Spendable); err != nil {
+		return res, err
 	}
-	return nil
+	toClawBack = toClawBack.Sub(res.Spendable...)
+
+	// The rest can only be delegated or unbonding tokens.
+	// They are collected first, as they are modified by the transfers.
+	var (
+		ubds        []stakingtypes.UnbondingDelegation
+		delegations []stakingtypes.Delegation
+	)
+	sk.IterateDelegatorUnbondingDelegations(ctx, addr, func(ubd stakingtypes.UnbondingDelegation) bool {
+		ubds = append(ubds, ubd)
+		return false
+	})
+	sk.IterateDelegatorDelegations(ctx, addr, func(delegation stakingtypes.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+
+	want := toClawBack.AmountOf(bondDenom)
+	for _, ubd := range ubds {
+		if !want.IsPositive() {
+			break
+		}
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return res, err
+		}
+		transferred := sk.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		res.Unbonding = res.Unbonding.Add(sdk.NewCoin(bondDenom, transferred))
+		want = want.Sub(transferred)
+	}
+
+	for _, delegation := range delegations {
+		if !want.IsPositive() {
+			break
+		}
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			return res, sdkerrors.Wrapf(stakingtypes.ErrNoValidatorFound, "validator %s", valAddr)
+		}
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			return res, err
+		}
+		shares, err := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+		if err != nil {
+			return res, err
+		}
+		// round the transferred tokens up, not to claw back more than wanted
+		transferred := math.MinInt(validator.TokensFromSharesRoundUp(shares).Ceil().TruncateInt(), want)
+		res.Delegated = res.Delegated.Add(sdk.NewCoin(bondDenom, transferred))
+		want = want.Sub(transferred)
+	}
+
+	// The account state has already been updated, so the clawback must fail
+	// rather than leave unvested tokens behind, e.g. when dest has reached the
+	// maximum number of unbonding or redelegation entries.
+	remaining := toClawBack.Sub(res.Unbonding.Add(res.Delegated...)...)
+	if !remaining.IsZero() {
+		return res, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot claw back %s of the unvested tokens", remaining)
+	}
+
+	return res, nil
+}
+
+// updateDelegation adjusts the delegation tracking of the account for a
+// clawback, given the unvested coins still encumbered after the clawback and
+// the bonded, unbonding and unbonded coins of the account. It returns the
+// amount to claw back, capped to the coins the account actually holds.
+func (va *ClawbackVestingAccount) updateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(coinsMin(delegated, oldDelegated)...)
+	total := delegated.Add(unbonded...)
+	toClawBack = coinsMin(toClawBack, total)
+	newDelegated := coinsMin(delegated, total.Sub(toClawBack...)).Add(slashed...)
+	va.DelegatedVesting = coinsMin(encumbered, newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting...)
+	return toClawBack
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -105,8 +106,9 @@ func GetQueryGranterGrants() *cobra.Command {
 			fmt.Sprintf(`Query authorization grants granted by granter.
 Examples:
 $ %s q %s grants-by-granter cosmos1skj..
+$ %s q %s grants-by-granter cosmos1skj.. --msg-type=/cosmos.bank.v1beta1.MsgSend --expiration-before=1735689600
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -124,12 +126,25 @@ $ %s q %s grants-by-granter cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
+			expirationAfter, expirationBefore, err := getExpirationWindow(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := authz.NewQueryClient(clientCtx)
 			res, err := queryClient.GranterGrants(
 				cmd.Context(),
 				&authz.QueryGranterGrantsRequest{
-					Granter:    granter.String(),
-					Pagination: pageReq,
+					Granter:          granter.String(),
+					Pagination:       pageReq,
+					MsgTypeUrl:       msgType,
+					ExpirationAfter:  expirationAfter,
+					ExpirationBefore: expirationBefore,
 				},
 			)
 			if err != nil {
@@ -141,6 +156,7 @@ $ %s q %s grants-by-granter cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
+	addGrantsFilterFlags(cmd)
 	return cmd
 }
 
@@ -154,8 +170,9 @@ func GetQueryGranteeGrants() *cobra.Command {
 			fmt.Sprintf(`Query authorization grants granted to a grantee.
 Examples:
 $ %s q %s grants-by-grantee cosmos1skj..
+$ %s q %s grants-by-grantee cosmos1skj.. --msg-type=/cosmos.bank.v1beta1.MsgSend --expiration-before=1735689600
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -173,12 +190,25 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
+			expirationAfter, expirationBefore, err := getExpirationWindow(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := authz.NewQueryClient(clientCtx)
 			res, err := queryClient.GranteeGrants(
 				cmd.Context(),
 				&authz.QueryGranteeGrantsRequest{
-					Grantee:    grantee.String(),
-					Pagination: pageReq,
+					Grantee:          grantee.String(),
+					Pagination:       pageReq,
+					MsgTypeUrl:       msgType,
+					ExpirationAfter:  expirationAfter,
+					ExpirationBefore: expirationBefore,
 				},
 			)
 			if err != nil {
@@ -190,5 +220,34 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	addGrantsFilterFlags(cmd)
 	return cmd
 }
+
+func addGrantsFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMsgType, "", "Only return the grants for this Msg type URL")
+	cmd.Flags().Int64(FlagExpirationAfter, 0, "Only return the grants expiring after this Unix timestamp, grants without expiration included")
+	cmd.Flags().Int64(FlagExpirationBefore, 0, "Only return the grants expiring before this Unix timestamp")
+}
+
+func getExpirationWindow(cmd *cobra.Command) (after, before *time.Time, err error) {
+	afterUnix, err := cmd.Flags().GetInt64(FlagExpirationAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	if afterUnix != 0 {
+		t := time.Unix(afterUnix, 0)
+		after = &t
+	}
+
+	beforeUnix, err := cmd.Flags().GetInt64(FlagExpirationBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+	if beforeUnix != 0 {
+		t := time.Unix(beforeUnix, 0)
+		before = &t
+	}
+
+	return after, before, nil
+}
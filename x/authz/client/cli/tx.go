@@ -27,6 +27,17 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagPeriod            = "period"
+	FlagMaxExecutions     = "max-executions"
+	FlagPeriodSpendLimit  = "period-spend-limit"
+	FlagGrantee           = "grantee"
+	FlagMsgTypePrefix     = "msg-type-prefix"
+	FlagExpirationAfter   = "expiration-after"
+	FlagExpirationBefore  = "expiration-before"
+	FlagAllowedValues     = "allowed-values"
+	FlagMaxCoins          = "max-coins"
+	rateLimited           = "rate-limited"
+	fieldConstraint       = "field-constraint"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -46,6 +57,7 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -55,7 +67,7 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"rate-limited\"|\"field-constraint\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
@@ -63,7 +75,9 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. rate-limited --msg-type=/cosmos.bank.v1beta1.MsgSend --period=24h --max-executions=10 --period-spend-limit=1000stake --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. field-constraint --msg-type=/cosmos.bank.v1beta1.MsgSend --allowed-values=to_address=cosmos1sk..,cosmos1hq.. --max-coins=amount=1000stake --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -113,6 +127,53 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case rateLimited:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				period, err := cmd.Flags().GetDuration(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+				if err != nil {
+					return err
+				}
+
+				limit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				periodSpendLimit, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				rateLimitedAuthorization := authz.NewRateLimitedAuthorization(msgType, period, maxExecutions, periodSpendLimit)
+				if err := rateLimitedAuthorization.ValidateBasic(); err != nil {
+					return err
+				}
+				authorization = rateLimitedAuthorization
+			case fieldConstraint:
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				constraints, err := getFieldConstraints(cmd)
+				if err != nil {
+					return err
+				}
+
+				fieldConstraintAuthorization := authz.NewFieldConstraintAuthorization(msgType, constraints...)
+				if err := fieldConstraintAuthorization.ValidateBasic(); err != nil {
+					return err
+				}
+				authorization = fieldConstraintAuthorization
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -192,7 +253,12 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization, RateLimitedAuthorization or FieldConstraintAuthorization")
+	cmd.Flags().Duration(FlagPeriod, 0, "Period after which the executions count and spend limit of a RateLimitedAuthorization are reset")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Max number of executions per period for RateLimitedAuthorization, zero (0) for no limit")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "Coins allowed to be spent per period for RateLimitedAuthorization, empty for no limit")
+	cmd.Flags().StringArray(FlagAllowedValues, []string{}, "Allowed values of a Msg field for FieldConstraintAuthorization, as <field>=<value1>,<value2>,... (can be repeated)")
+	cmd.Flags().StringArray(FlagMaxCoins, []string{}, "Max coins of a Msg coin field for FieldConstraintAuthorization, as <field>=<coins> (can be repeated)")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
@@ -201,6 +267,43 @@ Examples:
 	return cmd
 }
 
+// getFieldConstraints parses the field constraints of a FieldConstraintAuthorization
+// from the allowed values and max coins flags.
+func getFieldConstraints(cmd *cobra.Command) ([]authz.FieldConstraint, error) {
+	allowedValues, err := cmd.Flags().GetStringArray(FlagAllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	maxCoins, err := cmd.Flags().GetStringArray(FlagMaxCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	var constraints []authz.FieldConstraint
+	for _, c := range allowedValues {
+		field, values, ok := strings.Cut(c, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid allowed values %s, expected <field>=<value1>,<value2>,...", c)
+		}
+		constraints = append(constraints, authz.NewAllowedValuesConstraint(field, strings.Split(values, ",")...))
+	}
+
+	for _, c := range maxCoins {
+		field, coins, ok := strings.Cut(c, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid max coins %s, expected <field>=<coins>", c)
+		}
+		limit, err := sdk.ParseCoinsNormalized(coins)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, authz.NewMaxCoinsConstraint(field, limit))
+	}
+
+	return constraints, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -247,6 +350,55 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all --from=[granter]",
+		Short: "revoke all authorizations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all authorizations given by a granter, optionally only the ones given to a grantee,
+or whose msg type URL starts with a prefix:
+Example:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+ $ %s tx %s revoke-all --grantee=cosmos1skj.. --msg-type-prefix=/cosmos.bank. --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granteeStr, err := cmd.Flags().GetString(FlagGrantee)
+			if err != nil {
+				return err
+			}
+
+			var grantee sdk.AccAddress
+			if granteeStr != "" {
+				grantee, err = sdk.AccAddressFromBech32(granteeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msgTypePrefix, err := cmd.Flags().GetString(FlagMsgTypePrefix)
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress(), grantee, msgTypePrefix)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagGrantee, "", "Only revoke the authorizations given to this grantee")
+	cmd.Flags().String(FlagMsgTypePrefix, "", "Only revoke the authorizations whose Msg type URL starts with this prefix")
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -390,6 +390,73 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Valid tx rate limited authorization",
+			[]string{
+				grantee.String(),
+				"rate-limited",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=%s", cli.FlagPeriod, "24h"),
+				fmt.Sprintf("--%s=%d", cli.FlagMaxExecutions, 10),
+				fmt.Sprintf("--%s=%s", cli.FlagPeriodSpendLimit, "100stake"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"rate limited authorization without limit",
+			[]string{
+				grantee.String(),
+				"rate-limited",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=%s", cli.FlagPeriod, "24h"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true,
+			"max executions per period or period spend limit must be set",
+		},
+		{
+			"Valid tx field constraint authorization",
+			[]string{
+				grantee.String(),
+				"field-constraint",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=to_address=%s", cli.FlagAllowedValues, val[0].Address.String()),
+				fmt.Sprintf("--%s=amount=%s", cli.FlagMaxCoins, "100stake"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"field constraint authorization with unknown field",
+			[]string{
+				grantee.String(),
+				"field-constraint",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=recipient=%s", cli.FlagAllowedValues, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true,
+			"unknown field recipient",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
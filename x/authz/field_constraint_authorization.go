@@ -0,0 +1,188 @@
+package authz
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// gasCostPerFieldValue is the gas consumed for each checked value of a
+// constrained field.
+const gasCostPerFieldValue = uint64(10)
+
+// coinFullName is the proto full name of the message type of the fields
+// which can be constrained with max coins.
+const coinFullName = protoreflect.FullName("cosmos.base.v1beta1.Coin")
+
+var _ Authorization = &FieldConstraintAuthorization{}
+
+// NewFieldConstraintAuthorization creates a new FieldConstraintAuthorization object.
+func NewFieldConstraintAuthorization(msgTypeURL string, constraints ...FieldConstraint) *FieldConstraintAuthorization {
+	return &FieldConstraintAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// NewAllowedValuesConstraint creates a new FieldConstraint which only allows
+// the given values for a field.
+func NewAllowedValuesConstraint(field string, allowedValues ...string) FieldConstraint {
+	return FieldConstraint{
+		Field:         field,
+		AllowedValues: allowedValues,
+	}
+}
+
+// NewMaxCoinsConstraint creates a new FieldConstraint which limits the sum of
+// the coins of a field.
+func NewMaxCoinsConstraint(field string, maxCoins sdk.Coins) FieldConstraint {
+	return FieldConstraint{
+		Field:    field,
+		MaxCoins: maxCoins,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FieldConstraintAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a FieldConstraintAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	m, err := msgservice.MsgToProtoReflect(msg)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.check(ctx, m); err != nil {
+			return AcceptResponse{}, err
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FieldConstraintAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type cannot be empty")
+	}
+	if len(a.Constraints) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("constraints cannot be empty")
+	}
+
+	md, err := msgservice.MsgDescriptor(a.Msg)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.validate(md); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks that the constraint is well formed and applies to a field of
+// the given message.
+func (c FieldConstraint) validate(md protoreflect.MessageDescriptor) error {
+	if c.Field == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("constraint field cannot be empty")
+	}
+
+	fd, err := msgservice.ResolveFieldPath(md, c.Field)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(c.AllowedValues) > 0 && !c.MaxCoins.Empty():
+		return sdkerrors.ErrInvalidRequest.Wrapf("constraint of field %s cannot have both allowed values and max coins", c.Field)
+	case len(c.AllowedValues) > 0:
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowed values are not supported for field %s of kind %s", c.Field, fd.Kind())
+		}
+	case !c.MaxCoins.Empty():
+		if fd.Kind() != protoreflect.MessageKind || fd.Message().FullName() != coinFullName {
+			return sdkerrors.ErrInvalidRequest.Wrapf("max coins are only supported for fields of type %s, got field %s", coinFullName, c.Field)
+		}
+		if err := c.MaxCoins.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("max coins of field %s: %s", c.Field, err)
+		}
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("constraint of field %s must have allowed values or max coins", c.Field)
+	}
+
+	return nil
+}
+
+// check returns an error if the given message doesn't satisfy the constraint.
+func (c FieldConstraint) check(ctx sdk.Context, m protoreflect.Message) error {
+	if len(c.AllowedValues) > 0 {
+		values, err := msgservice.FieldValues(m, c.Field)
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			isAllowed := false
+			for _, allowed := range c.AllowedValues {
+				ctx.GasMeter().ConsumeGas(gasCostPerFieldValue, "field constraint")
+				if v == allowed {
+					isAllowed = true
+					break
+				}
+			}
+			if !isAllowed {
+				return sdkerrors.ErrUnauthorized.Wrapf("%s is not an allowed value of field %s", v, c.Field)
+			}
+		}
+	}
+
+	if !c.MaxCoins.Empty() {
+		total, err := fieldCoins(m, c.Field)
+		if err != nil {
+			return err
+		}
+		if !total.IsAllLTE(c.MaxCoins) {
+			return sdkerrors.ErrUnauthorized.Wrapf("coins %s of field %s exceed max coins %s", total, c.Field, c.MaxCoins)
+		}
+	}
+
+	return nil
+}
+
+// fieldCoins returns the sum of the coins of the cosmos.base.v1beta1.Coin
+// field at the given path of a message.
+func fieldCoins(m protoreflect.Message, path string) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	err := msgservice.RangeFieldPath(m, path, func(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.MessageKind || fd.Message().FullName() != coinFullName {
+			return sdkerrors.ErrInvalidRequest.Wrapf("field %s is not of type %s", fd.FullName(), coinFullName)
+		}
+
+		cm := v.Message()
+		if !cm.IsValid() {
+			// unset singular field
+			return nil
+		}
+		fields := cm.Descriptor().Fields()
+		amount, ok := sdkmath.NewIntFromString(cm.Get(fields.ByName("amount")).String())
+		if !ok {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid amount of field %s", fd.FullName())
+		}
+		coin := sdk.Coin{Denom: cm.Get(fields.ByName("denom")).String(), Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("field %s: %s", fd.FullName(), err)
+		}
+
+		total = total.Add(coin)
+		return nil
+	})
+	return total, err
+}
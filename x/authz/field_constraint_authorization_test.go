@@ -0,0 +1,141 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestFieldConstraintAuthorizationValidateBasic(t *testing.T) {
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	maxCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name   string
+		auth   *authz.FieldConstraintAuthorization
+		errMsg string
+	}{
+		{"empty msg type", authz.NewFieldConstraintAuthorization("", authz.NewAllowedValuesConstraint("to_address", "addr")), "msg type cannot be empty"},
+		{"no constraints", authz.NewFieldConstraintAuthorization(sendMsgType), "constraints cannot be empty"},
+		{"unknown msg type", authz.NewFieldConstraintAuthorization("/cosmos.bank.v1beta1.MsgUnknown", authz.NewAllowedValuesConstraint("to_address", "addr")), "unknown msg type"},
+		{"empty field", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewAllowedValuesConstraint("", "addr")), "constraint field cannot be empty"},
+		{"unknown field", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewAllowedValuesConstraint("recipient", "addr")), "unknown field recipient"},
+		{"path through scalar field", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewAllowedValuesConstraint("to_address.denom", "addr")), "is not a message"},
+		{"no allowed values nor max coins", authz.NewFieldConstraintAuthorization(sendMsgType, authz.FieldConstraint{Field: "to_address"}), "must have allowed values or max coins"},
+		{"both allowed values and max coins", authz.NewFieldConstraintAuthorization(sendMsgType, authz.FieldConstraint{Field: "amount", AllowedValues: []string{"1stake"}, MaxCoins: maxCoins}), "cannot have both"},
+		{"allowed values of message field", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewAllowedValuesConstraint("amount", "1stake")), "allowed values are not supported"},
+		{"max coins of non coin field", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewMaxCoinsConstraint("to_address", maxCoins)), "max coins are only supported"},
+		{"invalid max coins", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewMaxCoinsConstraint("amount", sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}})), "max coins of field amount"},
+		{"allowed values", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewAllowedValuesConstraint("to_address", "addr")), ""},
+		{"nested allowed values", authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), authz.NewAllowedValuesConstraint("outputs.address", "addr")), ""},
+		{"max coins", authz.NewFieldConstraintAuthorization(sendMsgType, authz.NewMaxCoinsConstraint("amount", maxCoins)), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFieldConstraintAuthorizationAccept(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(authz.ModuleName), sdk.NewTransientStoreKey("transient_test")).Ctx
+
+	granter := sdk.AccAddress("granter")
+	allowed := sdk.AccAddress("allowed")
+	other := sdk.AccAddress("other")
+	validator := sdk.ValAddress("validator")
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+	}
+
+	sendAuth := authz.NewFieldConstraintAuthorization(
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		authz.NewAllowedValuesConstraint("to_address", allowed.String()),
+		authz.NewMaxCoinsConstraint("amount", coins(10)),
+	)
+	require.NoError(t, sendAuth.ValidateBasic())
+
+	testCases := []struct {
+		name   string
+		auth   authz.Authorization
+		msg    sdk.Msg
+		errMsg string
+	}{
+		{
+			"allowed recipient and amount",
+			sendAuth,
+			banktypes.NewMsgSend(granter, allowed, coins(10)),
+			"",
+		},
+		{
+			"recipient not allowed",
+			sendAuth,
+			banktypes.NewMsgSend(granter, other, coins(1)),
+			"is not an allowed value of field to_address",
+		},
+		{
+			"amount above max coins",
+			sendAuth,
+			banktypes.NewMsgSend(granter, allowed, coins(11)),
+			"exceed max coins",
+		},
+		{
+			"denom not in max coins",
+			sendAuth,
+			banktypes.NewMsgSend(granter, allowed, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))),
+			"exceed max coins",
+		},
+		{
+			"repeated nested field",
+			authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), authz.NewAllowedValuesConstraint("outputs.address", allowed.String())),
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(granter, coins(2))},
+				[]banktypes.Output{banktypes.NewOutput(allowed, coins(1)), banktypes.NewOutput(other, coins(1))},
+			),
+			"is not an allowed value of field outputs.address",
+		},
+		{
+			"singular coin field",
+			authz.NewFieldConstraintAuthorization(
+				sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+				authz.NewAllowedValuesConstraint("validator_address", validator.String()),
+				authz.NewMaxCoinsConstraint("amount", coins(5)),
+			),
+			stakingtypes.NewMsgDelegate(granter, validator, sdk.NewInt64Coin("stake", 5)),
+			"",
+		},
+		{
+			"enum field",
+			authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&govv1.MsgVote{}), authz.NewAllowedValuesConstraint("option", govv1.OptionYes.String())),
+			govv1.NewMsgVote(granter, 1, govv1.OptionNo, ""),
+			"VOTE_OPTION_NO is not an allowed value of field option",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := tc.auth.Accept(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+			require.Nil(t, resp.Updated)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -109,6 +110,10 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 			return nil, err
 		}
 
+		if !grantMatchesFilters(auth1.MsgTypeURL(), auth.Expiration, req.MsgTypeUrl, req.ExpirationAfter, req.ExpirationBefore) {
+			return nil, nil
+		}
+
 		any, err := codectypes.NewAnyWithValue(auth1)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -159,6 +164,10 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 			return nil, nil
 		}
 
+		if !grantMatchesFilters(auth1.MsgTypeURL(), auth.Expiration, req.MsgTypeUrl, req.ExpirationAfter, req.ExpirationBefore) {
+			return nil, nil
+		}
+
 		authorizationAny, err := codectypes.NewAnyWithValue(auth1)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -182,3 +191,19 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		Pagination: pageRes,
 	}, nil
 }
+
+// grantMatchesFilters returns true if a grant with the given msg type URL and
+// expiration matches the msg type URL and expiration window filters of a grants
+// query. Grants without expiration are considered to never expire.
+func grantMatchesFilters(msgTypeURL string, expiration *time.Time, filterMsgTypeURL string, expirationAfter, expirationBefore *time.Time) bool {
+	if filterMsgTypeURL != "" && msgTypeURL != filterMsgTypeURL {
+		return false
+	}
+	if expirationAfter != nil && expiration != nil && !expiration.After(*expirationAfter) {
+		return false
+	}
+	if expirationBefore != nil && (expiration == nil || !expiration.Before(*expirationBefore)) {
+		return false
+	}
+	return true
+}
@@ -209,6 +209,39 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			1,
 		},
+		{
+			"valid case, msg type filter",
+			func() {
+				err := suite.authzKeeper.SaveGrant(suite.ctx, addrs[3], addrs[0], authz.NewGenericAuthorization(sdk.MsgTypeURL(&authz.MsgExec{})), nil)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, expiration before filter",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:          addrs[0].String(),
+				ExpirationBefore: timePtr(suite.ctx.BlockTime().Add(2 * time.Hour)),
+			},
+			2,
+		},
+		{
+			"valid case, expiration after filter",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:         addrs[0].String(),
+				ExpirationAfter: timePtr(suite.ctx.BlockTime().Add(2 * time.Hour)),
+			},
+			1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -310,3 +343,7 @@ func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress)
 	suite.Require().NoError(err)
 	return authorization
 }
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -227,6 +229,33 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// DeleteAllGrants revokes all the grants given by the granter, only to the
+// grantee if it is not nil, and only for the msg types starting with
+// msgTypePrefix if it is not empty. It returns the number of revoked grants.
+func (k Keeper) DeleteAllGrants(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, msgTypePrefix string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(grantee, granter, ""))
+
+	// The keys are copied, since the deletions can't happen while iterating.
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		_, _, msgType := parseGrantStoreKey(iter.Key())
+		if strings.HasPrefix(msgType, msgTypePrefix) {
+			keys = append(keys, bytes.Clone(iter.Key()))
+		}
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		_, granteeAddr, msgType := parseGrantStoreKey(key)
+		if err := k.DeleteGrant(ctx, granteeAddr, granter, msgType); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(keys)), nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -217,6 +217,93 @@ func (suite *TestSuite) TestRevoke() {
 	}
 }
 
+func (suite *TestSuite) TestRevokeAll() {
+	addrs := suite.createAccounts(2)
+
+	grantee, granter := addrs[0], addrs[1]
+	otherGrantee := suite.addrs[2]
+	genericMsgType := sdk.MsgTypeURL(&authz.MsgExec{})
+
+	setupGrants := func() {
+		suite.createSendAuthorization(grantee, granter)
+		suite.createSendAuthorization(otherGrantee, granter)
+		err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(genericMsgType), nil)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name       string
+		msg        authz.MsgRevokeAll
+		expErr     bool
+		errMsg     string
+		expRevoked uint64
+		expLeft    []sdk.AccAddress
+	}{
+		{
+			name:   "invalid granter",
+			msg:    authz.MsgRevokeAll{Granter: "invalid"},
+			expErr: true,
+			errMsg: "invalid bech32 string",
+		},
+		{
+			name:   "invalid grantee",
+			msg:    authz.MsgRevokeAll{Granter: granter.String(), Grantee: "invalid"},
+			expErr: true,
+			errMsg: "invalid bech32 string",
+		},
+		{
+			name:       "all grants of the granter",
+			msg:        authz.NewMsgRevokeAll(granter, nil, ""),
+			expRevoked: 3,
+		},
+		{
+			name:       "grants to a grantee",
+			msg:        authz.NewMsgRevokeAll(granter, grantee, ""),
+			expRevoked: 2,
+			expLeft:    []sdk.AccAddress{otherGrantee},
+		},
+		{
+			name:       "grants matching a msg type prefix",
+			msg:        authz.NewMsgRevokeAll(granter, nil, "/cosmos.bank."),
+			expRevoked: 2,
+			expLeft:    []sdk.AccAddress{grantee},
+		},
+		{
+			name:       "grants to a grantee matching a msg type prefix",
+			msg:        authz.NewMsgRevokeAll(granter, grantee, "/cosmos.bank."),
+			expRevoked: 1,
+			expLeft:    []sdk.AccAddress{grantee, otherGrantee},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx
+			suite.ctx, _ = ctx.CacheContext()
+			defer func() { suite.ctx = ctx }()
+
+			setupGrants()
+			resp, err := suite.msgSrvr.RevokeAll(suite.ctx, &tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRevoked, resp.Revoked)
+
+			var left []sdk.AccAddress
+			suite.authzKeeper.IterateGrants(suite.ctx, func(granterAddr, granteeAddr sdk.AccAddress, _ authz.Grant) bool {
+				suite.Require().Equal(granter, granterAddr)
+				left = append(left, granteeAddr)
+				return false
+			})
+			suite.Require().ElementsMatch(tc.expLeft, left)
+		})
+	}
+}
+
 func (suite *TestSuite) TestExec() {
 	addrs := suite.createAccounts(2)
 
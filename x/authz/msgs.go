@@ -16,11 +16,13 @@ import (
 var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgRevokeAll{}
 	_ sdk.Msg = &MsgExec{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
+	_ legacytx.LegacyMsg = &MsgRevokeAll{}
 	_ legacytx.LegacyMsg = &MsgExec{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
@@ -172,6 +174,63 @@ func (msg MsgRevoke) GetSignBytes() []byte {
 	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll. The grantee can be nil to revoke
+// the authorizations of all grantees.
+//
+//nolint:interfacer
+func NewMsgRevokeAll(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURLPrefix string) MsgRevokeAll {
+	msg := MsgRevokeAll{
+		Granter:          granter.String(),
+		MsgTypeUrlPrefix: msgTypeURLPrefix,
+	}
+	if grantee != nil {
+		msg.Grantee = grantee.String()
+	}
+	return msg
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeAll) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeAll) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if msg.Grantee != "" {
+		grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+		}
+
+		if granter.Equals(grantee) {
+			return ErrGranteeIsGranter
+		}
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeAll) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeAll) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 //
 //nolint:interfacer
@@ -77,6 +77,27 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRevokeAll(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		expectPass       bool
+	}{
+		{"nil Granter address", nil, grantee, false},
+		{"granter and grantee are the same", granter, granter, false},
+		{"valid test case without grantee", granter, nil, true},
+		{"valid test case", granter, grantee, true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRevokeAll(tc.granter, tc.grantee, "/cosmos.bank.")
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // add time interval to a time object and returns a pointer
 func addDatePtr(t *time.Time, months, days int) *time.Time {
 	t2 := t.AddDate(0, months, days)
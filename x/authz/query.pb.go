@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -161,6 +166,14 @@ type QueryGranterGrantsRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// msg_type_url, if set, only returns the grants for this sdk.Msg type URL.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// expiration_after, if set, only returns the grants expiring after this time.
+	// Grants without expiration are always considered to expire after it.
+	ExpirationAfter *time.Time `protobuf:"bytes,4,opt,name=expiration_after,json=expirationAfter,proto3,stdtime" json:"expiration_after,omitempty"`
+	// expiration_before, if set, only returns the grants expiring before this
+	// time. Grants without expiration are never considered to expire before it.
+	ExpirationBefore *time.Time `protobuf:"bytes,5,opt,name=expiration_before,json=expirationBefore,proto3,stdtime" json:"expiration_before,omitempty"`
 }
 
 func (m *QueryGranterGrantsRequest) Reset()         { *m = QueryGranterGrantsRequest{} }
@@ -210,6 +223,27 @@ func (m *QueryGranterGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranterGrantsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryGranterGrantsRequest) GetExpirationAfter() *time.Time {
+	if m != nil {
+		return m.ExpirationAfter
+	}
+	return nil
+}
+
+func (m *QueryGranterGrantsRequest) GetExpirationBefore() *time.Time {
+	if m != nil {
+		return m.ExpirationBefore
+	}
+	return nil
+}
+
 // QueryGranterGrantsResponse is the response type for the Query/GranterGrants RPC method.
 type QueryGranterGrantsResponse struct {
 	// grants is a list of grants granted by the granter.
@@ -270,6 +304,14 @@ type QueryGranteeGrantsRequest struct {
 	Grantee string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// msg_type_url, if set, only returns the grants for this sdk.Msg type URL.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// expiration_after, if set, only returns the grants expiring after this time.
+	// Grants without expiration are always considered to expire after it.
+	ExpirationAfter *time.Time `protobuf:"bytes,4,opt,name=expiration_after,json=expirationAfter,proto3,stdtime" json:"expiration_after,omitempty"`
+	// expiration_before, if set, only returns the grants expiring before this
+	// time. Grants without expiration are never considered to expire before it.
+	ExpirationBefore *time.Time `protobuf:"bytes,5,opt,name=expiration_before,json=expirationBefore,proto3,stdtime" json:"expiration_before,omitempty"`
 }
 
 func (m *QueryGranteeGrantsRequest) Reset()         { *m = QueryGranteeGrantsRequest{} }
@@ -319,6 +361,27 @@ func (m *QueryGranteeGrantsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryGranteeGrantsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryGranteeGrantsRequest) GetExpirationAfter() *time.Time {
+	if m != nil {
+		return m.ExpirationAfter
+	}
+	return nil
+}
+
+func (m *QueryGranteeGrantsRequest) GetExpirationBefore() *time.Time {
+	if m != nil {
+		return m.ExpirationBefore
+	}
+	return nil
+}
+
 // QueryGranteeGrantsResponse is the response type for the Query/GranteeGrants RPC method.
 type QueryGranteeGrantsResponse struct {
 	// grants is a list of grants granted to the grantee.
@@ -386,41 +449,46 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xf6, 0xff, 0x2f, 0xc2, 0x83, 0x8b, 0xe1, 0x90, 0x85, 0x29, 0x8a, 0xaa, 0x09,
-	0x0a, 0xd2, 0xec, 0xad, 0x93, 0x38, 0x22, 0xb6, 0xc3, 0x76, 0x85, 0x00, 0x17, 0x2e, 0x55, 0xba,
-	0xbe, 0x72, 0x23, 0xda, 0x38, 0xb3, 0x1d, 0x44, 0x87, 0x76, 0x81, 0x2f, 0x80, 0xb4, 0x03, 0x1f,
-	0x01, 0x89, 0x33, 0x1f, 0x82, 0xe3, 0x04, 0x17, 0x8e, 0xa8, 0x45, 0xf0, 0x35, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0x02, 0xd3, 0x24, 0x4e, 0xad, 0xa3, 0xe7, 0x7d, 0xde, 0xdf, 0xfb, 0x38, 0x76,
-	0x70, 0xb8, 0x23, 0xd4, 0x40, 0x28, 0x16, 0xe7, 0xba, 0xb7, 0xc7, 0x9e, 0xaf, 0x75, 0x40, 0xc7,
-	0x6b, 0x6c, 0x37, 0x07, 0x39, 0xa4, 0x99, 0x14, 0x5a, 0x90, 0xeb, 0x56, 0x41, 0x8d, 0x82, 0x3a,
-	0x85, 0xbf, 0xc4, 0x85, 0xe0, 0x7d, 0x60, 0x71, 0x96, 0xb0, 0x38, 0x4d, 0x85, 0x8e, 0x75, 0x22,
-	0x52, 0x65, 0x6b, 0xfc, 0x3b, 0xce, 0xb5, 0x13, 0x2b, 0xb0, 0x66, 0xbf, 0xac, 0xb3, 0x98, 0x27,
-	0xa9, 0x11, 0x3b, 0x6d, 0x31, 0x81, 0xed, 0x66, 0x15, 0x8b, 0x56, 0xd1, 0x36, 0x2b, 0xe6, 0x70,
-	0xcc, 0xa2, 0xf1, 0x1d, 0x61, 0xf2, 0x70, 0xe2, 0xbf, 0x2d, 0xe3, 0x54, 0xab, 0x08, 0x76, 0x73,
-	0x50, 0x9a, 0xb4, 0xf0, 0x25, 0x3e, 0x79, 0x00, 0xd2, 0x43, 0x21, 0x6a, 0x5e, 0xde, 0xf4, 0x3e,
-	0x7d, 0x58, 0x99, 0x0e, 0xb2, 0xd1, 0xed, 0x4a, 0x50, 0xea, 0x91, 0x96, 0x49, 0xca, 0xa3, 0xa9,
-	0x70, 0x56, 0x03, 0x5e, 0xb5, 0x5c, 0x0d, 0x90, 0x10, 0x5f, 0x19, 0x28, 0xde, 0xd6, 0xc3, 0x0c,
-	0xda, 0xb9, 0xec, 0x7b, 0xb5, 0x49, 0x61, 0x84, 0x07, 0x8a, 0x3f, 0x1e, 0x66, 0xf0, 0x44, 0xf6,
-	0xc9, 0x16, 0xc6, 0xb3, 0x89, 0xbd, 0xff, 0x42, 0xd4, 0x5c, 0x68, 0xdd, 0xa4, 0xce, 0x75, 0x12,
-	0x0f, 0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x98, 0x83, 0x9b, 0x22, 0x3a, 0x52, 0xd9, 0x38, 0x40,
-	0xf8, 0xda, 0xdc, 0xa0, 0x2a, 0x13, 0xa9, 0x02, 0xb2, 0x8e, 0xeb, 0x06, 0x46, 0x79, 0x28, 0xac,
-	0x35, 0x17, 0x5a, 0x37, 0x68, 0xd1, 0x76, 0x51, 0x53, 0x15, 0x39, 0x29, 0xd9, 0x9e, 0x83, 0xaa,
-	0x1a, 0xa8, 0x5b, 0x67, 0x42, 0xd9, 0x8e, 0x73, 0x54, 0x6f, 0x11, 0x5e, 0x9c, 0x51, 0x81, 0x3c,
-	0xff, 0x2e, 0x6c, 0x15, 0xa0, 0xfd, 0x4d, 0x5e, 0xef, 0x10, 0xf6, 0x8b, 0xc8, 0x5c, 0x6c, 0xf7,
-	0x8f, 0xc5, 0xd6, 0x3c, 0x25, 0xb6, 0x8d, 0x5c, 0xf7, 0x84, 0x4c, 0xf6, 0x8c, 0xf1, 0x85, 0x67,
-	0x08, 0x27, 0x64, 0x08, 0x65, 0x33, 0x84, 0x8b, 0xca, 0x10, 0xfe, 0xd9, 0x0c, 0x5b, 0x3f, 0x6a,
-	0xf8, 0x7f, 0x43, 0x4a, 0x5e, 0x23, 0x5c, 0xb7, 0x9c, 0xe4, 0x04, 0x9e, 0xdf, 0xaf, 0x0b, 0xff,
-	0x76, 0x09, 0xa5, 0xed, 0xda, 0x58, 0x7e, 0xf5, 0xf9, 0xdb, 0x41, 0x35, 0x20, 0x4b, 0xac, 0xf0,
-	0xda, 0x72, 0x83, 0xbd, 0x47, 0xf8, 0xea, 0xdc, 0x8b, 0x47, 0xd8, 0x59, 0x2d, 0x8e, 0x1d, 0x1e,
-	0x7f, 0xb5, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x56, 0x09, 0x3d, 0x0d, 0x8d, 0xb9, 0x83, 0xc6,
-	0x5e, 0xba, 0x3f, 0xfb, 0x47, 0x60, 0xa1, 0x34, 0x2c, 0xfc, 0x29, 0x2c, 0x9c, 0x03, 0x16, 0xa6,
-	0xb0, 0xb0, 0xbf, 0x79, 0xef, 0xe3, 0x28, 0x40, 0x87, 0xa3, 0x00, 0x7d, 0x1d, 0x05, 0xe8, 0xcd,
-	0x38, 0xa8, 0x1c, 0x8e, 0x83, 0xca, 0x97, 0x71, 0x50, 0x79, 0xba, 0xcc, 0x13, 0xdd, 0xcb, 0x3b,
-	0x74, 0x47, 0x0c, 0xa6, 0x9e, 0xf6, 0x67, 0x45, 0x75, 0x9f, 0xb1, 0x17, 0xb6, 0x41, 0xa7, 0x6e,
-	0xbe, 0x1b, 0xeb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8b, 0x47, 0x69, 0xf8, 0x06, 0x00,
-	0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0x99, 0xe5, 0xcf, 0x2f, 0xbf, 0x41, 0xa3, 0x8e, 0x1c, 0x4a, 0x25, 0x65, 0xb3, 0x21,
+	0xba, 0x9a, 0x30, 0x85, 0x25, 0xf1, 0x68, 0x64, 0x0f, 0x70, 0x30, 0x26, 0x5a, 0xf1, 0xe2, 0x65,
+	0xd3, 0xc2, 0x43, 0x69, 0xa4, 0x9d, 0x32, 0x33, 0x35, 0x80, 0xe1, 0xa2, 0x6f, 0x80, 0x84, 0x17,
+	0x61, 0xe2, 0xd9, 0x17, 0xe1, 0x91, 0x68, 0x4c, 0xbc, 0x69, 0x58, 0xa3, 0x6f, 0xc3, 0x74, 0x66,
+	0xea, 0xee, 0x62, 0x81, 0x2a, 0x31, 0xf1, 0xe0, 0xa9, 0x7f, 0xf8, 0x7e, 0x9f, 0xe7, 0xf3, 0xcc,
+	0xf3, 0xa5, 0x8b, 0xeb, 0xab, 0x4c, 0xc4, 0x4c, 0xb8, 0x7e, 0x26, 0x37, 0x76, 0xdd, 0x67, 0xf3,
+	0x01, 0x48, 0x7f, 0xde, 0xdd, 0xca, 0x80, 0xef, 0xd0, 0x94, 0x33, 0xc9, 0xc8, 0x84, 0x56, 0x50,
+	0xa5, 0xa0, 0x46, 0x61, 0x4f, 0x85, 0x8c, 0x85, 0x9b, 0xe0, 0xfa, 0x69, 0xe4, 0xfa, 0x49, 0xc2,
+	0xa4, 0x2f, 0x23, 0x96, 0x08, 0xed, 0xb1, 0xa7, 0xcd, 0x5f, 0xd5, 0x53, 0x90, 0xad, 0xbb, 0x32,
+	0x8a, 0x41, 0x48, 0x3f, 0x4e, 0x8d, 0x60, 0x22, 0x64, 0x21, 0x53, 0xb7, 0x6e, 0x7e, 0x67, 0xde,
+	0xde, 0x32, 0x30, 0x81, 0x2f, 0x40, 0x33, 0xfc, 0x20, 0x4a, 0xfd, 0x30, 0x4a, 0x54, 0x0f, 0xa3,
+	0x2d, 0x07, 0xd7, 0x90, 0x5a, 0x31, 0xa9, 0x15, 0x1d, 0xdd, 0xc6, 0x4c, 0xa1, 0x1e, 0x1a, 0x5f,
+	0x11, 0x26, 0x0f, 0xf3, 0xfa, 0xcb, 0xdc, 0x4f, 0xa4, 0xf0, 0x60, 0x2b, 0x03, 0x21, 0x49, 0x0b,
+	0xff, 0x17, 0xe6, 0x2f, 0x80, 0x5b, 0xa8, 0x8e, 0x9a, 0xff, 0xb7, 0xad, 0x77, 0x6f, 0x66, 0x8b,
+	0xf9, 0x17, 0xd7, 0xd6, 0x38, 0x08, 0xf1, 0x48, 0xf2, 0x28, 0x09, 0xbd, 0x42, 0xd8, 0xf3, 0x80,
+	0x55, 0xab, 0xe6, 0x01, 0x52, 0xc7, 0x17, 0x62, 0x11, 0x76, 0xe4, 0x4e, 0x0a, 0x9d, 0x8c, 0x6f,
+	0x5a, 0xc3, 0xb9, 0xd1, 0xc3, 0xb1, 0x08, 0x57, 0x76, 0x52, 0x78, 0xcc, 0x37, 0xc9, 0x12, 0xc6,
+	0xbd, 0x89, 0xad, 0x91, 0x3a, 0x6a, 0x8e, 0xb7, 0xae, 0x53, 0x53, 0x35, 0x3f, 0x1e, 0xaa, 0x57,
+	0x64, 0xe6, 0xa6, 0x0f, 0xfc, 0x10, 0xcc, 0x14, 0x5e, 0x9f, 0xb3, 0x71, 0x80, 0xf0, 0xd5, 0x81,
+	0x41, 0x45, 0xca, 0x12, 0x01, 0x64, 0x01, 0x8f, 0x29, 0x18, 0x61, 0xa1, 0xfa, 0x70, 0x73, 0xbc,
+	0x75, 0x8d, 0x96, 0x6d, 0x99, 0x2a, 0x97, 0x67, 0xa4, 0x64, 0x79, 0x00, 0xaa, 0xa6, 0xa0, 0x6e,
+	0x9c, 0x09, 0xa5, 0x3b, 0x0e, 0x50, 0x7d, 0xa8, 0xe1, 0xc9, 0x1e, 0x15, 0xf0, 0xf3, 0x6f, 0x61,
+	0xa9, 0x04, 0xed, 0x37, 0xce, 0xab, 0xc2, 0x66, 0xee, 0xe1, 0xcb, 0xb0, 0x9d, 0x46, 0x5c, 0xe9,
+	0x3b, 0xfe, 0x7a, 0x8e, 0xa9, 0xf7, 0x63, 0x53, 0x9d, 0x7a, 0x5a, 0xa4, 0x9e, 0xae, 0x14, 0xa9,
+	0x6f, 0x8f, 0xec, 0x7f, 0x9a, 0x46, 0xde, 0xa5, 0x9e, 0x73, 0x31, 0x37, 0x92, 0xfb, 0xf8, 0x4a,
+	0x5f, 0xb1, 0x00, 0xd6, 0x19, 0x07, 0x6b, 0xb4, 0x62, 0xb5, 0x3e, 0x8e, 0xb6, 0x72, 0x36, 0x5e,
+	0x21, 0x6c, 0x97, 0x9d, 0xab, 0x59, 0xfa, 0xdd, 0x63, 0x4b, 0x6f, 0x9e, 0xb2, 0xf4, 0xc5, 0x4c,
+	0x6e, 0x30, 0x1e, 0xed, 0xaa, 0xf2, 0x7f, 0x3c, 0x01, 0x70, 0x42, 0x02, 0xa0, 0x6a, 0x02, 0xe0,
+	0x5f, 0x02, 0xca, 0x12, 0x00, 0x7f, 0x6d, 0x02, 0x5a, 0xdf, 0x86, 0xf1, 0xa8, 0x22, 0x25, 0x2f,
+	0x11, 0x1e, 0xd3, 0x9c, 0xe4, 0x04, 0x9e, 0x9f, 0x3f, 0xd5, 0xf6, 0xcd, 0x0a, 0x4a, 0xdd, 0xb5,
+	0x31, 0xf3, 0xe2, 0xfd, 0x97, 0x83, 0x9a, 0x43, 0xa6, 0xdc, 0xd2, 0x9f, 0x0c, 0x33, 0xd8, 0x6b,
+	0x84, 0x2f, 0x0e, 0xfc, 0xdb, 0x10, 0xf7, 0xac, 0x16, 0xc7, 0x3e, 0x5c, 0xf6, 0x5c, 0x75, 0x83,
+	0x41, 0xbb, 0xad, 0xd0, 0xe6, 0x08, 0x3d, 0x0d, 0x4d, 0x5f, 0x80, 0xbb, 0xcf, 0xcd, 0xcd, 0x5e,
+	0x1f, 0x2c, 0x54, 0x86, 0x85, 0x5f, 0x85, 0x85, 0x73, 0xc0, 0x42, 0x01, 0x0b, 0x7b, 0xed, 0x3b,
+	0x6f, 0x8f, 0x1c, 0x74, 0x78, 0xe4, 0xa0, 0xcf, 0x47, 0x0e, 0xda, 0xef, 0x3a, 0x43, 0x87, 0x5d,
+	0x67, 0xe8, 0x63, 0xd7, 0x19, 0x7a, 0x32, 0x13, 0x46, 0x72, 0x23, 0x0b, 0xe8, 0x2a, 0x8b, 0x8b,
+	0x9a, 0xfa, 0x32, 0x2b, 0xd6, 0x9e, 0xba, 0xdb, 0xba, 0x41, 0x30, 0xa6, 0xf2, 0xbf, 0xf0, 0x7d,
+	0x00, 0xf2, 0xde, 0xc7, 0x21, 0xab, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -714,6 +782,33 @@ func (m *QueryGranterGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationBefore != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationBefore):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpirationAfter != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationAfter):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintQuery(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -805,6 +900,33 @@ func (m *QueryGranteeGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationBefore != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationBefore):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpirationAfter != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationAfter):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -945,6 +1067,18 @@ func (m *QueryGranterGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpirationAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpirationBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -981,6 +1115,18 @@ func (m *QueryGranteeGrantsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpirationAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpirationBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1408,6 +1554,110 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationAfter == nil {
+				m.ExpirationAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationBefore == nil {
+				m.ExpirationBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1646,6 +1896,110 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationAfter == nil {
+				m.ExpirationAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationBefore == nil {
+				m.ExpirationBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
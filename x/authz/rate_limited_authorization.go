@@ -0,0 +1,160 @@
+package authz
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// anyFullName is the proto full name of google.protobuf.Any, whose values
+// may hide coins from the spend of a message.
+const anyFullName = protoreflect.FullName("google.protobuf.Any")
+
+var _ Authorization = &RateLimitedAuthorization{}
+
+// NewRateLimitedAuthorization creates a new RateLimitedAuthorization object.
+// The first period starts at the first execution.
+func NewRateLimitedAuthorization(msgTypeURL string, period time.Duration, maxExecutionsPerPeriod uint64, periodSpendLimit sdk.Coins) *RateLimitedAuthorization {
+	return &RateLimitedAuthorization{
+		Msg:                    msgTypeURL,
+		Period:                 period,
+		MaxExecutionsPerPeriod: maxExecutionsPerPeriod,
+		PeriodSpendLimit:       periodSpendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RateLimitedAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a RateLimitedAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	a.tryResetPeriod(ctx.BlockTime())
+
+	if a.MaxExecutionsPerPeriod > 0 {
+		if a.PeriodExecutions >= a.MaxExecutionsPerPeriod {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("max executions per period reached, next period starts at %s", a.PeriodReset)
+		}
+		a.PeriodExecutions++
+	}
+
+	if !a.PeriodSpendLimit.Empty() {
+		amount, err := msgSpend(msg)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+		var isNeg bool
+		a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(amount...)
+		if isNeg {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit, next period starts at %s", a.PeriodReset)
+		}
+	}
+
+	return AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will reset the PeriodExecutions count and top up
+// the PeriodCanSpend amount to PeriodSpendLimit, and update the PeriodReset in
+// the same way as feegrant's PeriodicAllowance: if we are within one Period, it
+// will update from the last PeriodReset, and if we are more than one period out,
+// reset is one Period from the execution of this method.
+func (a *RateLimitedAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodExecutions = 0
+	a.PeriodCanSpend = a.PeriodSpendLimit
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RateLimitedAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type cannot be empty")
+	}
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+	if a.MaxExecutionsPerPeriod == 0 && a.PeriodSpendLimit.Empty() {
+		return sdkerrors.ErrInvalidRequest.Wrap("max executions per period or period spend limit must be set")
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+	return nil
+}
+
+// msgSpend returns the coins spent by a message: the coins of its only
+// top-level cosmos.base.v1beta1.Coin field, such as the amount of bank's
+// MsgSend, the value of staking's MsgCreateValidator or the token of IBC's
+// MsgTransfer. A message which can't carry any coin, even nested, spends
+// nothing. An error is returned if the spend can't be determined: the message
+// has several top-level coin fields, or it carries coins or
+// google.protobuf.Any values in other fields only, such as bank's
+// MsgMultiSend or authz's MsgExec.
+func msgSpend(msg sdk.Msg) (sdk.Coins, error) {
+	m, err := msgservice.MsgToProtoReflect(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var coinFields []protoreflect.FieldDescriptor
+	nested := false
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind {
+			continue
+		}
+		if fd.Message().FullName() == coinFullName {
+			coinFields = append(coinFields, fd)
+		} else if mayCarryCoins(fd.Message(), map[protoreflect.FullName]bool{}) {
+			nested = true
+		}
+	}
+
+	switch {
+	case len(coinFields) == 1:
+		return fieldCoins(m, string(coinFields[0].Name()))
+	case len(coinFields) == 0 && !nested:
+		return sdk.NewCoins(), nil
+	default:
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("cannot determine the coins spent by %s", sdk.MsgTypeURL(msg))
+	}
+}
+
+// mayCarryCoins returns true if a message of the given type may contain
+// cosmos.base.v1beta1.Coin or google.protobuf.Any values, at any depth.
+func mayCarryCoins(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	switch md.FullName() {
+	case coinFullName, anyFullName:
+		return true
+	}
+	if seen[md.FullName()] {
+		return false
+	}
+	seen[md.FullName()] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && mayCarryCoins(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestRateLimitedAuthorizationValidateBasic(t *testing.T) {
+	msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name   string
+		auth   *authz.RateLimitedAuthorization
+		errMsg string
+	}{
+		{"empty msg type", authz.NewRateLimitedAuthorization("", time.Hour, 1, nil), "msg type cannot be empty"},
+		{"zero period", authz.NewRateLimitedAuthorization(msgType, 0, 1, nil), "period must be positive"},
+		{"no limit", authz.NewRateLimitedAuthorization(msgType, time.Hour, 0, nil), "max executions per period or period spend limit must be set"},
+		{"invalid spend limit", authz.NewRateLimitedAuthorization(msgType, time.Hour, 0, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}), "period spend limit is invalid"},
+		{"max executions only", authz.NewRateLimitedAuthorization(msgType, time.Hour, 1, nil), ""},
+		{"spend limit only", authz.NewRateLimitedAuthorization(msgType, time.Hour, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))), ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitedAuthorizationAccept(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(authz.ModuleName), sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	granter := sdk.AccAddress("granter")
+	recipient := sdk.AccAddress("recipient")
+	msgSend := func(amount int64) *banktypes.MsgSend {
+		return banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+
+	t.Log("max executions per period")
+	var auth authz.Authorization = authz.NewRateLimitedAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), time.Hour, 2, nil)
+	for i := 0; i < 2; i++ {
+		resp, err := auth.Accept(ctx, msgSend(1))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		auth = resp.Updated
+	}
+	_, err := auth.Accept(ctx, msgSend(1))
+	require.ErrorContains(t, err, "max executions per period reached")
+
+	// The executions count is reset in the next period.
+	resp, err := auth.Accept(ctx.WithBlockTime(now.Add(time.Hour)), msgSend(1))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, uint64(1), resp.Updated.(*authz.RateLimitedAuthorization).PeriodExecutions)
+
+	t.Log("period spend limit")
+	auth = authz.NewRateLimitedAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), time.Hour, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	resp, err = auth.Accept(ctx, msgSend(6))
+	require.NoError(t, err)
+	auth = resp.Updated
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), auth.(*authz.RateLimitedAuthorization).PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), auth.(*authz.RateLimitedAuthorization).PeriodReset)
+
+	_, err = auth.Accept(ctx, msgSend(5))
+	require.ErrorContains(t, err, "requested amount is more than period spend limit")
+
+	// The spend limit is topped up in the next period.
+	resp, err = auth.Accept(ctx.WithBlockTime(now.Add(time.Hour)), msgSend(10))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Updated.(*authz.RateLimitedAuthorization).PeriodCanSpend.IsZero())
+
+	t.Log("spend of other msgs")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 6))
+	multiSend := banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(granter, coins)}, []banktypes.Output{banktypes.NewOutput(recipient, coins)})
+	auth = authz.NewRateLimitedAuthorization(sdk.MsgTypeURL(multiSend), time.Hour, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	_, err = auth.Accept(ctx, multiSend)
+	require.ErrorContains(t, err, "cannot determine the coins spent")
+
+	// Msgs which can't carry coins spend nothing.
+	setSendEnabled := &banktypes.MsgSetSendEnabled{Authority: granter.String()}
+	auth = authz.NewRateLimitedAuthorization(sdk.MsgTypeURL(setSendEnabled), time.Hour, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	resp, err = auth.Accept(ctx, setSendEnabled)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), resp.Updated.(*authz.RateLimitedAuthorization).PeriodCanSpend)
+}
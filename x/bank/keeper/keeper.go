@@ -12,7 +12,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -147,19 +146,14 @@ func (k BaseKeeper) WithMintCoinsRestriction(check MintingRestrictionFn) BaseKee
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
 // address to a ModuleAccount address. If any of the delegation amounts are negative,
-// an error is returned.
+// an error is returned. The coins locked by the vesting positions of the account
+// cannot be delegated.
 func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleAccAddr)
 	}
 
-	// do not allow delegation if clawback vesting account
-	acc := k.ak.GetAccount(ctx, delegatorAddr)
-	if _, ok := acc.(vestexported.ClawbackVestingAccountI); ok {
-		return fmt.Errorf("clawback vesting account (%s) is restricted for delegation", delegatorAddr)
-	}
-
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -173,6 +167,11 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 
 	balances := sdk.NewCoins()
 
+	positionLocked := sdk.NewCoins()
+	if k.vpk != nil {
+		positionLocked = k.vpk.LockedPositionCoins(ctx, delegatorAddr)
+	}
+
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
 		if balance.IsLT(coin) {
@@ -181,6 +180,13 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 			)
 		}
 
+		// the coins of the vesting positions must stay in the balance to be transferable
+		if locked := positionLocked.AmountOf(coin.Denom); balance.Amount.Sub(locked).LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s%s of %s is locked by vesting positions", locked, coin.Denom, balance,
+			)
+		}
+
 		balances = balances.Add(balance)
 		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
 		if err != nil {
@@ -181,7 +181,7 @@ func (suite *KeeperTestSuite) mockDelegateCoins(ctx sdk.Context, acc authtypes.A
 	if ok {
 		suite.authKeeper.EXPECT().SetAccount(ctx, vacc)
 	}
-	suite.authKeeper.EXPECT().GetAccount(ctx, acc.GetAddress()).Return(acc).Times(2)
+	suite.authKeeper.EXPECT().GetAccount(ctx, acc.GetAddress()).Return(acc)
 	suite.authKeeper.EXPECT().GetAccount(ctx, mAcc.GetAddress()).Return(mAcc)
 }
 
@@ -839,6 +839,47 @@ func (suite *KeeperTestSuite) TestSpendableCoins() {
 	require.Equal(origCoins.Sub(lockedCoins...)[0], suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], "stake"))
 }
 
+func (suite *KeeperTestSuite) TestSpendableCoinsWithVestingPositions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	positionCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 80))
+
+	vestingPositionsKeeper := banktestutil.NewMockVestingPositionsKeeper(gomock.NewController(suite.T()))
+	vestingPositionsKeeper.EXPECT().LockedPositionCoins(gomock.Any(), accAddrs[0]).Return(positionCoins).AnyTimes()
+	vestingPositionsKeeper.EXPECT().LockedPositionCoins(gomock.Any(), accAddrs[1]).Return(positionCoins).AnyTimes()
+	vestingPositionsKeeper.EXPECT().LockedPositionCoins(gomock.Any(), gomock.Any()).Return(sdk.NewCoins()).AnyTimes()
+
+	bankKeeper := suite.bankKeeper
+	bankKeeper.SetVestingPositionsKeeper(vestingPositionsKeeper)
+	require.Panics(func() { bankKeeper.SetVestingPositionsKeeper(vestingPositionsKeeper) })
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	vacc := vesting.NewContinuousVestingAccount(acc1, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(bankKeeper, ctx, accAddrs[0], origCoins))
+
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(bankKeeper, ctx, accAddrs[1], origCoins.Add(positionCoins...)))
+
+	// the coins of the positions are locked on top of the vesting account ones
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(origCoins.Sub(positionCoins...), bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	suite.mockSpendableCoins(ctx, vacc)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), bankKeeper.SpendableCoins(ctx, accAddrs[1]))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
+	require.Error(bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sendCoins))
+}
+
 func (suite *KeeperTestSuite) TestVestingAccountSend() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1014,22 +1055,17 @@ func (suite *KeeperTestSuite) TestDelegateCoins_Invalid() {
 	origCoins := sdk.NewCoins(newFooCoin(100))
 	delCoins := sdk.NewCoins(newFooCoin(50))
 
-	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
-
 	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(nil)
 	require.Error(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), delCoins))
 
 	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
-	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
 	invalidCoins := sdk.Coins{sdk.Coin{Denom: "fooDenom", Amount: sdk.NewInt(-50)}}
 	require.Error(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), invalidCoins))
 
 	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
-	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
 	require.Error(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), delCoins))
 
 	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
-	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0)
 	require.Error(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), origCoins.Add(origCoins...)))
 }
 
@@ -38,6 +38,7 @@ type BaseViewKeeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	ak       types.AccountKeeper
+	vpk      types.VestingPositionsKeeper
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -49,6 +50,17 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak t
 	}
 }
 
+// SetVestingPositionsKeeper sets the keeper of the vesting positions, whose
+// locked coins are added to the locked coins of the accounts. It must be set
+// before the bank keeper is passed to other keepers.
+func (k *BaseViewKeeper) SetVestingPositionsKeeper(vpk types.VestingPositionsKeeper) {
+	if k.vpk != nil {
+		panic("cannot set vesting positions keeper twice")
+	}
+
+	k.vpk = vpk
+}
+
 // Logger returns a module-specific logger.
 func (k BaseViewKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -164,17 +176,24 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// type. The coins locked by the vesting positions of the account, if any, are
+// added to them.
 func (k BaseViewKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(ctx.BlockTime())
+			locked = vacc.LockedCoins(ctx.BlockTime())
 		}
 	}
 
-	return sdk.NewCoins()
+	if k.vpk != nil {
+		locked = locked.Add(k.vpk.LockedPositionCoins(ctx, addr)...)
+	}
+
+	return locked
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
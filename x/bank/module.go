@@ -216,6 +216,9 @@ type BankInputs struct {
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace `optional:"true"`
+
+	// VestingPositionsKeeper is used to lock the coins of the vesting positions
+	VestingPositionsKeeper types.VestingPositionsKeeper `optional:"true"`
 }
 
 type BankOutputs struct {
@@ -254,6 +257,9 @@ func ProvideModule(in BankInputs) BankOutputs {
 		blockedAddresses,
 		authority.String(),
 	)
+	if in.VestingPositionsKeeper != nil {
+		bankKeeper.SetVestingPositionsKeeper(in.VestingPositionsKeeper)
+	}
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper, in.LegacySubspace)
 
 	return BankOutputs{BankKeeper: bankKeeper, Module: m}
@@ -227,6 +227,43 @@ func (mr *MockAccountKeeperMockRecorder) ValidatePermissions(macc interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePermissions", reflect.TypeOf((*MockAccountKeeper)(nil).ValidatePermissions), macc)
 }
 
+// MockVestingPositionsKeeper is a mock of VestingPositionsKeeper interface.
+type MockVestingPositionsKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockVestingPositionsKeeperMockRecorder
+}
+
+// MockVestingPositionsKeeperMockRecorder is the mock recorder for MockVestingPositionsKeeper.
+type MockVestingPositionsKeeperMockRecorder struct {
+	mock *MockVestingPositionsKeeper
+}
+
+// NewMockVestingPositionsKeeper creates a new mock instance.
+func NewMockVestingPositionsKeeper(ctrl *gomock.Controller) *MockVestingPositionsKeeper {
+	mock := &MockVestingPositionsKeeper{ctrl: ctrl}
+	mock.recorder = &MockVestingPositionsKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVestingPositionsKeeper) EXPECT() *MockVestingPositionsKeeperMockRecorder {
+	return m.recorder
+}
+
+// LockedPositionCoins mocks base method.
+func (m *MockVestingPositionsKeeper) LockedPositionCoins(ctx types.Context, addr types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockedPositionCoins", ctx, addr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// LockedPositionCoins indicates an expected call of LockedPositionCoins.
+func (mr *MockVestingPositionsKeeperMockRecorder) LockedPositionCoins(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedPositionCoins", reflect.TypeOf((*MockVestingPositionsKeeper)(nil).LockedPositionCoins), ctx, addr)
+}
+
 // MockBankHooks is a mock of BankHooks interface.
 type MockBankHooks struct {
 	ctrl     *gomock.Controller
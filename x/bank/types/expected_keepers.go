@@ -28,6 +28,13 @@ type AccountKeeper interface {
 	GetModulePermissions() map[string]types.PermissionsForAddress
 }
 
+// VestingPositionsKeeper defines the contract of the keeper of the vesting
+// positions of the accounts, whose locked coins add up to the coins locked by
+// the vesting accounts.
+type VestingPositionsKeeper interface {
+	LockedPositionCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
 // Event Hooks
 // These can be utilized to communicate between a bank keeper and another
 // keeper which must take particular actions when sends happen.
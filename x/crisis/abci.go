@@ -9,13 +9,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// check all registered invariants
+// check the registered invariants, starting a new round of checks every
+// invariant check period and resuming the round in progress in between
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
-	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
+	if k.InvCheckPeriod() == 0 {
 		// skip running the invariant check
 		return
 	}
-	k.AssertInvariants(ctx)
+	if !k.InvariantsRoundInProgress() && ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
+		// skip running the invariant check
+		return
+	}
+	k.CheckInvariants(ctx)
 }
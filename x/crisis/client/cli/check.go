@@ -0,0 +1,256 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+const (
+	FlagHeight       = "height"
+	FlagInvariants   = "invariants"
+	FlagConcurrency  = "concurrency"
+	FlagAppDBBackend = "app-db-backend"
+)
+
+// InvariantsApp defines an application whose registered invariants can be
+// checked offline by the check-invariants command.
+type InvariantsApp interface {
+	servertypes.Application
+
+	// InvariantRoutes returns the invariants registered with the crisis keeper.
+	InvariantRoutes() []types.InvarRoute
+}
+
+// InvariantCheckResult is the result of a single invariant check.
+type InvariantCheckResult struct {
+	Route    string `json:"route"`
+	Broken   bool   `json:"broken"`
+	Message  string `json:"message,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// InvariantCheckReport is the report printed by the check-invariants command.
+type InvariantCheckReport struct {
+	Height  int64                  `json:"height"`
+	Total   int                    `json:"total"`
+	Broken  int                    `json:"broken"`
+	Results []InvariantCheckResult `json:"results"`
+}
+
+// CheckInvariantsCmd returns a command checking the registered invariants
+// against the application state at a given height, without a running node.
+func CheckInvariantsCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check the registered invariants against the application state",
+		Long: `Check the registered crisis invariants against the application state stored in
+the home directory, at the latest or at the given height, and print a report.
+
+The application database is opened read-only, and each invariant runs in parallel
+on its own cache-wrapped branch of the state, which is discarded afterwards.
+The command fails if any invariant is broken.
+
+Invariants may be filtered with the --invariants flag, taking module names or
+full invariant routes (module-name/invariant-route).
+
+Note: only the 'goleveldb' backend can be opened read-only, other backends are
+opened as usual but are never written to.`,
+		Example: "check-invariants --height 100 --invariants bank,staking/module-accounts",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			filters, _ := cmd.Flags().GetStringSlice(FlagInvariants)
+			concurrency, _ := cmd.Flags().GetInt(FlagConcurrency)
+			if height < 0 {
+				return fmt.Errorf("height must not be negative, got %d", height)
+			}
+			if concurrency <= 0 {
+				return fmt.Errorf("concurrency must be positive, got %d", concurrency)
+			}
+
+			db, err := openReadOnlyDB(config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app, ok := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper).(InvariantsApp)
+			if !ok {
+				return fmt.Errorf("the application does not expose its invariant routes")
+			}
+
+			routes, err := FilterInvariantRoutes(app.InvariantRoutes(), filters)
+			if err != nil {
+				return err
+			}
+
+			cms := app.CommitMultiStore()
+			if height == 0 {
+				height = cms.LastCommitID().Version
+			}
+			if height == 0 {
+				return fmt.Errorf("the application database has no committed state")
+			}
+
+			newCtx := func() (sdk.Context, error) {
+				ms, err := cms.CacheMultiStoreWithVersion(height)
+				if err != nil {
+					return sdk.Context{}, err
+				}
+
+				return sdk.NewContext(ms, tmproto.Header{Height: height}, false, serverCtx.Logger), nil
+			}
+			if _, err := newCtx(); err != nil {
+				return fmt.Errorf("failed to load the state at height %d: %w", height, err)
+			}
+
+			report := CheckInvariants(newCtx, routes, concurrency)
+			report.Height = height
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+
+			if report.Broken > 0 {
+				return fmt.Errorf("%d of %d invariants broken at height %d", report.Broken, report.Total, height)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for the application database")
+	cmd.Flags().Int64(FlagHeight, 0, "Height of the state to check, defaults to the latest height")
+	cmd.Flags().StringSlice(FlagInvariants, nil, "Modules or invariant routes to check, defaults to all the registered invariants")
+	cmd.Flags().Int(FlagConcurrency, runtime.NumCPU(), "Number of invariants checked in parallel")
+
+	return cmd
+}
+
+// FilterInvariantRoutes returns the routes matching any of the given module
+// names or full invariant routes, or all of them if no filter is given.
+func FilterInvariantRoutes(routes []types.InvarRoute, filters []string) ([]types.InvarRoute, error) {
+	if len(filters) == 0 {
+		return routes, nil
+	}
+
+	var filtered []types.InvarRoute
+	for _, filter := range filters {
+		found := false
+		for _, ir := range routes {
+			if ir.ModuleName == filter || ir.FullRoute() == filter {
+				filtered = append(filtered, ir)
+				found = true
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("no registered invariant matches %q", filter)
+		}
+	}
+
+	return filtered, nil
+}
+
+// CheckInvariants runs the given invariants with at most concurrency of them
+// in parallel. Each invariant runs on its own context returned by newCtx, and
+// its results are reported in the order of the routes.
+func CheckInvariants(newCtx func() (sdk.Context, error), routes []types.InvarRoute, concurrency int) InvariantCheckReport {
+	results := make([]InvariantCheckResult, len(routes))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, ir := range routes {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, ir types.InvarRoute) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			results[i] = checkInvariant(newCtx, ir)
+		}(i, ir)
+	}
+	wg.Wait()
+
+	report := InvariantCheckReport{
+		Total:   len(results),
+		Results: results,
+	}
+	for _, res := range results {
+		if res.Broken {
+			report.Broken++
+		}
+	}
+
+	return report
+}
+
+// checkInvariant runs a single invariant, reporting a panic or a failure to
+// create its context as a broken invariant.
+func checkInvariant(newCtx func() (sdk.Context, error), ir types.InvarRoute) (res InvariantCheckResult) {
+	res.Route = ir.FullRoute()
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			res.Broken = true
+			res.Error = fmt.Sprintf("invariant panicked: %v", r)
+		}
+		res.Duration = time.Since(start).String()
+	}()
+
+	ctx, err := newCtx()
+	if err != nil {
+		res.Broken = true
+		res.Error = err.Error()
+		return res
+	}
+
+	msg, broken := ir.Invar(ctx)
+	res.Broken = broken
+	if broken {
+		res.Message = strings.TrimSpace(msg)
+	}
+
+	return res
+}
+
+// openReadOnlyDB opens the application database, read-only if its backend
+// supports it.
+func openReadOnlyDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	if backendType == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+
+	return dbm.NewDB("application", backendType, dataDir)
+}
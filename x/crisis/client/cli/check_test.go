@@ -0,0 +1,72 @@
+package cli_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func testInvariantRoutes() []types.InvarRoute {
+	return []types.InvarRoute{
+		types.NewInvarRoute("bank", "total-supply", func(sdk.Context) (string, bool) {
+			return "", false
+		}),
+		types.NewInvarRoute("bank", "nonnegative-outstanding", func(sdk.Context) (string, bool) {
+			return "negative balance\n", true
+		}),
+		types.NewInvarRoute("staking", "module-accounts", func(sdk.Context) (string, bool) {
+			panic("corrupted state")
+		}),
+	}
+}
+
+func TestFilterInvariantRoutes(t *testing.T) {
+	routes := testInvariantRoutes()
+
+	filtered, err := cli.FilterInvariantRoutes(routes, nil)
+	require.NoError(t, err)
+	require.Len(t, filtered, 3)
+
+	filtered, err = cli.FilterInvariantRoutes(routes, []string{"bank"})
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+
+	filtered, err = cli.FilterInvariantRoutes(routes, []string{"bank/total-supply", "staking"})
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+	require.Equal(t, "bank/total-supply", filtered[0].FullRoute())
+	require.Equal(t, "staking/module-accounts", filtered[1].FullRoute())
+
+	_, err = cli.FilterInvariantRoutes(routes, []string{"bank/unknown"})
+	require.Error(t, err)
+}
+
+func TestCheckInvariants(t *testing.T) {
+	newCtx := func() (sdk.Context, error) { return sdk.Context{}, nil }
+
+	report := cli.CheckInvariants(newCtx, testInvariantRoutes(), 2)
+	require.Equal(t, 3, report.Total)
+	require.Equal(t, 2, report.Broken)
+	require.Len(t, report.Results, 3)
+
+	require.Equal(t, "bank/total-supply", report.Results[0].Route)
+	require.False(t, report.Results[0].Broken)
+
+	require.Equal(t, "bank/nonnegative-outstanding", report.Results[1].Route)
+	require.True(t, report.Results[1].Broken)
+	require.Equal(t, "negative balance", report.Results[1].Message)
+
+	require.Equal(t, "staking/module-accounts", report.Results[2].Route)
+	require.True(t, report.Results[2].Broken)
+	require.Contains(t, report.Results[2].Error, "corrupted state")
+
+	failingCtx := func() (sdk.Context, error) { return sdk.Context{}, errors.New("version does not exist") }
+	report = cli.CheckInvariants(failingCtx, testInvariantRoutes(), 1)
+	require.Equal(t, 3, report.Broken)
+	require.Equal(t, "version does not exist", report.Results[0].Error)
+}
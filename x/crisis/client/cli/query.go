@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns the parent command for all x/crisis CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(GetCmdQueryInvariantRuns())
+
+	return queryCmd
+}
+
+// GetCmdQueryInvariantRuns implements the query invariant runs command.
+func GetCmdQueryInvariantRuns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariant-runs [module-name/invariant-route]",
+		Short: "Query the last run of each invariant by the queried node",
+		Long: `Query the last run of each registered invariant, or of the given one, by the queried node.
+The runs are kept in memory by each node and depend on its invariant check settings.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInvariantRunsRequest{}
+			if len(args) == 1 {
+				req.Route = args[0]
+			}
+
+			res, err := queryClient.InvariantRuns(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
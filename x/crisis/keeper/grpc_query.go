@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+// InvariantRuns implements the Query/InvariantRuns gRPC method
+func (k *Keeper) InvariantRuns(_ context.Context, req *types.QueryInvariantRunsRequest) (*types.QueryInvariantRunsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	found := false
+	runs := []types.InvariantRun{}
+	for _, ir := range k.Routes() {
+		if req.Route != "" && req.Route != ir.FullRoute() {
+			continue
+		}
+		found = true
+
+		if run, ok := k.LastInvariantRun(ir.FullRoute()); ok {
+			runs = append(runs, run)
+		}
+	}
+
+	if req.Route != "" && !found {
+		return nil, status.Errorf(codes.NotFound, "invariant %s is not registered", req.Route)
+	}
+
+	return &types.QueryInvariantRunsResponse{Runs: runs, RoundInProgress: k.InvariantsRoundInProgress()}, nil
+}
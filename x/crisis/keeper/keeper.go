@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
@@ -26,6 +29,20 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	invariantsPerBlock uint           // maximum number of invariants checked per block, 0 to check all at once
+	reportOnly         bool           // report broken invariants instead of halting the chain
+	runs               *invariantRuns // in-memory state of the invariant checks, shared by the Keeper copies
+}
+
+// invariantRuns holds the in-memory state of the invariant checks of the node.
+// It is not part of the chain state, as the invariant check settings are local
+// to each node.
+type invariantRuns struct {
+	mtx     sync.RWMutex
+	inRound bool                          // whether an incremental round of checks is in progress
+	next    int                           // index of the next route to check in the round in progress
+	last    map[string]types.InvariantRun // last run of each invariant by full route
 }
 
 // NewKeeper creates a new Keeper object
@@ -41,9 +58,25 @@ func NewKeeper(
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
+		runs:             &invariantRuns{last: make(map[string]types.InvariantRun)},
 	}
 }
 
+// SetInvariantsPerBlock sets the maximum number of invariants checked per
+// block. A round of checks then spans several blocks, each one resuming after
+// the last invariant checked in the previous one. Zero checks all the
+// invariants in a single block.
+func (k *Keeper) SetInvariantsPerBlock(n uint) {
+	k.invariantsPerBlock = n
+}
+
+// SetReportOnly sets whether the invariants broken during the periodic checks
+// are only reported, through logs, events and telemetry, instead of halting
+// the chain.
+func (k *Keeper) SetReportOnly(reportOnly bool) {
+	k.reportOnly = reportOnly
+}
+
 // GetAuthority returns the x/crisis module's authority.
 func (k *Keeper) GetAuthority() string {
 	return k.authority
@@ -84,12 +117,8 @@ func (k *Keeper) AssertInvariants(ctx sdk.Context) {
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i+1, "/", n), "name", ir.FullRoute())
-		if res, stop := ir.Invar(ctx); stop {
-			// TODO: Include app name as part of context to allow for this to be
-			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
-				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+		if res, stop := k.runInvariant(ctx, ir); stop {
+			panic(brokenInvariantError(ir, res))
 		}
 	}
 
@@ -97,6 +126,121 @@ func (k *Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// CheckInvariants checks the registered invariants in the round of checks in
+// progress, starting a new round if there is none. All the invariants are
+// checked if no per-block budget is set, and otherwise up to the budget,
+// resuming after the last invariant checked in the previous block. A broken
+// invariant halts the chain by panicking, unless in report-only mode. It
+// returns true if the round of checks is complete.
+func (k *Keeper) CheckInvariants(ctx sdk.Context) bool {
+	logger := k.Logger(ctx)
+	invarRoutes := k.Routes()
+	n := len(invarRoutes)
+
+	k.runs.mtx.Lock()
+	first := 0
+	if k.runs.inRound {
+		first = k.runs.next
+	}
+	k.runs.mtx.Unlock()
+
+	last := n
+	if k.invariantsPerBlock > 0 && first+int(k.invariantsPerBlock) < n {
+		last = first + int(k.invariantsPerBlock)
+	}
+
+	start := time.Now()
+	for i := first; i < last; i++ {
+		ir := invarRoutes[i]
+		logger.Info("checking crisis invariants", "inv", fmt.Sprint(i+1, "/", n), "name", ir.FullRoute())
+		res, stop := k.runInvariant(ctx, ir)
+		if !stop {
+			continue
+		}
+
+		if !k.reportOnly {
+			panic(brokenInvariantError(ir, res))
+		}
+
+		logger.Error("invariant broken", "name", ir.FullRoute(), "res", res)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInvariantBroken,
+				sdk.NewAttribute(types.AttributeKeyRoute, ir.FullRoute()),
+				sdk.NewAttribute(types.AttributeKeyMessage, res),
+			),
+		)
+	}
+
+	k.runs.mtx.Lock()
+	defer k.runs.mtx.Unlock()
+	k.runs.inRound = last < n
+	k.runs.next = last
+
+	if k.runs.inRound {
+		logger.Info("checked invariants", "checked", last-first, "remaining", n-last, "duration", time.Since(start), "height", ctx.BlockHeight())
+		return false
+	}
+
+	logger.Info("checked all invariants", "duration", time.Since(start), "height", ctx.BlockHeight())
+	return true
+}
+
+// InvariantsRoundInProgress returns true if an incremental round of invariant
+// checks is in progress.
+func (k *Keeper) InvariantsRoundInProgress() bool {
+	k.runs.mtx.RLock()
+	defer k.runs.mtx.RUnlock()
+	return k.runs.inRound
+}
+
+// LastInvariantRun returns the last run of the invariant with the given full
+// route by this node, if any.
+func (k *Keeper) LastInvariantRun(fullRoute string) (types.InvariantRun, bool) {
+	k.runs.mtx.RLock()
+	defer k.runs.mtx.RUnlock()
+	run, found := k.runs.last[fullRoute]
+	return run, found
+}
+
+// runInvariant runs the given invariant, recording the run and its metrics.
+func (k *Keeper) runInvariant(ctx sdk.Context, ir types.InvarRoute) (string, bool) {
+	start := time.Now()
+	res, stop := ir.Invar(ctx)
+	duration := time.Since(start)
+
+	telemetry.ModuleMeasureSince(types.ModuleName, start, types.ModuleName, "invariant", ir.FullRoute())
+	if stop {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "invariant", "broken"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+		)
+	}
+
+	k.runs.mtx.Lock()
+	defer k.runs.mtx.Unlock()
+	k.runs.last[ir.FullRoute()] = types.InvariantRun{
+		Route:    ir.FullRoute(),
+		Height:   ctx.BlockHeight(),
+		Broken:   stop,
+		Message:  res,
+		Duration: duration,
+	}
+
+	return res, stop
+}
+
+// brokenInvariantError returns the error with which the chain halts when the
+// given invariant is broken.
+func brokenInvariantError(ir types.InvarRoute, res string) error {
+	// TODO: Include app name as part of context to allow for this to be
+	// variable.
+	return fmt.Errorf("invariant broken: %s\n"+
+		"\tCRITICAL please submit the following transaction:\n"+
+		"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route)
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k *Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
@@ -59,3 +59,61 @@ func TestAssertInvariants(t *testing.T) {
 	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
 }
+
+func TestCheckInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	keeper := keeper.NewKeeper(encCfg.Codec, key, 5, supplyKeeper, "", "")
+
+	var checked []string
+	for _, route := range []string{"testRoute1", "testRoute2", "testRoute3"} {
+		route := route
+		keeper.RegisterRoute("testModule", route, func(sdk.Context) (string, bool) {
+			checked = append(checked, route)
+			return "", false
+		})
+	}
+
+	// the round of checks spans several blocks
+	keeper.SetInvariantsPerBlock(2)
+	require.False(t, keeper.CheckInvariants(testCtx.Ctx.WithBlockHeight(5)))
+	require.True(t, keeper.InvariantsRoundInProgress())
+	require.Equal(t, []string{"testRoute1", "testRoute2"}, checked)
+
+	require.True(t, keeper.CheckInvariants(testCtx.Ctx.WithBlockHeight(6)))
+	require.False(t, keeper.InvariantsRoundInProgress())
+	require.Equal(t, []string{"testRoute1", "testRoute2", "testRoute3"}, checked)
+
+	res, err := keeper.InvariantRuns(testCtx.Ctx, &types.QueryInvariantRunsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Runs, 3)
+	require.Equal(t, "testModule/testRoute1", res.Runs[0].Route)
+	require.Equal(t, int64(5), res.Runs[0].Height)
+	require.Equal(t, int64(6), res.Runs[2].Height)
+
+	// broken invariants are reported in report-only mode
+	keeper.RegisterRoute("testModule", "testRoute4", func(sdk.Context) (string, bool) { return "broken", true })
+	keeper.SetInvariantsPerBlock(0)
+	keeper.SetReportOnly(true)
+	ctx := testCtx.Ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { require.True(t, keeper.CheckInvariants(ctx)) })
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeInvariantBroken, ctx.EventManager().Events()[0].Type)
+
+	res, err = keeper.InvariantRuns(testCtx.Ctx, &types.QueryInvariantRunsRequest{Route: "testModule/testRoute4"})
+	require.NoError(t, err)
+	require.Len(t, res.Runs, 1)
+	require.True(t, res.Runs[0].Broken)
+	require.Equal(t, "broken", res.Runs[0].Message)
+
+	_, err = keeper.InvariantRuns(testCtx.Ctx, &types.QueryInvariantRunsRequest{Route: "testModule/unknown"})
+	require.Error(t, err)
+
+	// broken invariants halt the chain otherwise
+	keeper.SetReportOnly(false)
+	require.Panics(t, func() { keeper.CheckInvariants(ctx) })
+}
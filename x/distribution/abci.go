@@ -21,17 +21,37 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// TODO this is Tendermint-dependent
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
 	blockHeight := ctx.BlockHeight()
+
+	// switch the reward accounting when the lazy_reward_accounting param changed
+	lazy := k.UpdateRewardAccountingMode(ctx)
+
+	communityPoolBefore := k.GetFeePoolCommunityCoins(ctx)
 	// only allocate rewards if the block height is greater than 1
 	// and for every multiple of 50 blocks for performance reasons.
 	if blockHeight > 1 && blockHeight%BlockMultipleToDistributeRewards == 0 {
-		// determine the total power signing the block
-		var previousTotalPower int64
-		for _, voteInfo := range req.LastCommitInfo.GetVotes() {
-			previousTotalPower += voteInfo.Validator.Power
+		if lazy {
+			k.AllocateTokensLazy(ctx)
+		} else {
+			// determine the total power signing the block
+			var previousTotalPower int64
+			for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+				previousTotalPower += voteInfo.Validator.Power
+			}
+
+			k.AllocateTokens(ctx, previousTotalPower, req.LastCommitInfo.GetVotes())
 		}
+	}
 
-		k.AllocateTokens(ctx, previousTotalPower, req.LastCommitInfo.GetVotes())
+	// pay the continuous funds from the community pool, given its inflow from
+	// the allocation of this block
+	inflow, negative := k.GetFeePoolCommunityCoins(ctx).SafeSub(communityPoolBefore)
+	if negative {
+		inflow = sdk.DecCoins{}
 	}
+	k.DistributeContinuousFunds(ctx, inflow)
+
+	// compound the rewards of the next batch of auto-compounding delegations
+	k.AutoCompoundDelegations(ctx)
 
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
@@ -32,6 +32,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryContinuousFunds(),
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryBudget(),
+		GetCmdQueryDelegatorAutoCompound(),
+		GetCmdQueryCommissionPayoutSplit(),
+		GetCmdQueryDenomDelegationRewards(),
 	)
 
 	return distQueryCmd
@@ -364,3 +370,272 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryContinuousFunds implements the query continuous funds command.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query the continuous funds from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the continuous funds paid from the community pool every block.
+
+Example:
+$ %s query distribution continuous-funds
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &types.QueryContinuousFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
+
+// GetCmdQueryContinuousFund implements the query continuous fund command.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [recipient]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the continuous fund of a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the continuous fund paid from the community pool to a recipient.
+
+Example:
+$ %s query distribution continuous-fund %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFund(cmd.Context(), &types.QueryContinuousFundRequest{Recipient: recipient.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBudget implements the query budget command.
+func GetCmdQueryBudget() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "budget [recipient]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the budget of a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the budget from the community pool of a recipient, and its claimable amount.
+
+Example:
+$ %s query distribution budget %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Budget(cmd.Context(), &types.QueryBudgetRequest{Recipient: recipient.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelegatorAutoCompound implements the query delegator auto-compound command.
+func GetCmdQueryDelegatorAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the auto-compounding status of the delegations of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the rewards of each delegation of a delegator are auto-compounded.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoCompound(
+				cmd.Context(),
+				&types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCommissionPayoutSplit implements the query commission payout split command.
+func GetCmdQueryCommissionPayoutSplit() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-payout-split [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the commission payout split of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recipients the commission of a validator is paid out to.
+
+Example:
+$ %s query distribution commission-payout-split %s1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommissionPayoutSplit(
+				cmd.Context(),
+				&types.QueryCommissionPayoutSplitRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Split)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomDelegationRewards implements the query denom delegation rewards command.
+func GetCmdQueryDenomDelegationRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "denom-rewards [delegator-addr] [validator-addr] [denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the rewards of an additional bond denom delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by a delegation of an additional bond denom to a validator.
+
+Example:
+$ %s query distribution denom-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomDelegationRewards(
+				cmd.Context(),
+				&types.QueryDenomDelegationRewardsRequest{
+					DelegatorAddress: delegatorAddr.String(),
+					ValidatorAddress: validatorAddr.String(),
+					Denom:            args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -90,14 +90,18 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false}`,
+			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false,"auto_compound_batch_size":"0","auto_compound_gas_limit":"0","max_commission_payout_recipients":0,"lazy_reward_accounting":false}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
-			`base_proposer_reward: "0"
+			`auto_compound_batch_size: "0"
+auto_compound_gas_limit: "0"
+base_proposer_reward: "0"
 bonus_proposer_reward: "0"
 community_tax: "0"
+lazy_reward_accounting: false
+max_commission_payout_recipients: 0
 withdraw_addr_enabled: false`,
 		},
 	}
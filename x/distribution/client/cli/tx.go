@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -40,6 +41,10 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewClaimBudgetCmd(),
+		NewSetAutoCompoundCmd(),
+		NewSetCommissionPayoutSplitCmd(),
+		NewWithdrawDenomRewardsCmd(),
 	)
 
 	return distTxCmd
@@ -254,3 +259,172 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 
 	return cmd
 }
+
+// NewClaimBudgetCmd returns a CLI command handler for creating a MsgClaimBudget transaction.
+func NewClaimBudgetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-budget",
+		Args:  cobra.NoArgs,
+		Short: "Claim the unlocked tranches of a budget from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the unlocked and unclaimed tranches of the budget of the sender.
+
+Example:
+$ %s tx distribution claim-budget --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimBudget(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable or disable the auto-compounding of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto-compounding of the rewards of a delegation. The rewards
+in the bond denom are periodically withdrawn and re-delegated to the validator.
+
+Example:
+$ %s tx distribution set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("enabled %s not a valid bool: %w", args[1], err)
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetCommissionPayoutSplitCmd returns a CLI command handler for creating a MsgSetCommissionPayoutSplit transaction.
+func NewSetCommissionPayoutSplitCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-commission-payout-split [recipient-addr:weight]...",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Set the recipients the commission of a validator is paid out to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the recipients the commission of a validator is paid out to, with their weights.
+The weights must sum to 1. Without recipients, the split is removed and the commission
+is paid out to the withdraw address of the operator.
+
+Example:
+$ %s tx distribution set-commission-payout-split %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.7 %s1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:0.3 --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			recipients := make([]types.CommissionPayoutRecipient, 0, len(args))
+			for _, arg := range args {
+				addr, weight, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("recipient %s must be in the format address:weight", arg)
+				}
+				recipientAddr, err := sdk.AccAddressFromBech32(addr)
+				if err != nil {
+					return err
+				}
+				recipientWeight, err := sdk.NewDecFromStr(weight)
+				if err != nil {
+					return fmt.Errorf("weight %s not a valid decimal: %w", weight, err)
+				}
+				recipients = append(recipients, types.NewCommissionPayoutRecipient(recipientAddr, recipientWeight))
+			}
+
+			msg := types.NewMsgSetCommissionPayoutSplit(valAddr, recipients)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawDenomRewardsCmd returns a CLI command handler for creating a MsgWithdrawDenomReward transaction.
+func NewWithdrawDenomRewardsCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-denom-rewards [validator-addr] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw the rewards of an additional bond denom delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of a delegation of an additional bond denom to a validator.
+
+Example:
+$ %s tx distribution withdraw-denom-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawDenomReward(delAddr, valAddr, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
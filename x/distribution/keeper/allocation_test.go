@@ -30,6 +30,7 @@ func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -75,6 +76,7 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
@@ -173,6 +175,7 @@ func TestAllocateTokensTruncation(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetDelegationAutoCompound enables or disables the auto-compounding of the rewards of a
+// delegation. Only existing delegations can be auto-compounded.
+func (k Keeper) SetDelegationAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+		k.setAutoCompoundDelegation(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteAutoCompoundDelegation(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// IsAutoCompoundDelegation returns true if the rewards of a delegation are
+// auto-compounded.
+func (k Keeper) IsAutoCompoundDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// DeleteAutoCompoundDelegation disables the auto-compounding of the rewards of a
+// delegation.
+func (k Keeper) DeleteAutoCompoundDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundKey(delAddr, valAddr))
+}
+
+// IterateAutoCompoundDelegations iterates over the auto-compounding delegations
+// by delegator.
+func (k Keeper) IterateAutoCompoundDelegations(ctx sdk.Context, handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr := types.GetAutoCompoundAddresses(iter.Key())
+		if handler(delAddr, valAddr) {
+			break
+		}
+	}
+}
+
+// GetAllAutoCompoundDelegations returns all the auto-compounding delegations.
+func (k Keeper) GetAllAutoCompoundDelegations(ctx sdk.Context) []types.AutoCompoundDelegation {
+	delegations := []types.AutoCompoundDelegation{}
+	k.IterateAutoCompoundDelegations(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+		delegations = append(delegations, types.AutoCompoundDelegation{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		return false
+	})
+
+	return delegations
+}
+
+// AutoCompoundDelegations compounds the rewards of the next batch of
+// auto-compounding delegations, within the gas limit of the params. The
+// delegations are processed in a round robin over the blocks.
+func (k Keeper) AutoCompoundDelegations(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundBatchSize == 0 {
+		return
+	}
+
+	// collect the keys of the batch, and the key of the next delegation to process
+	keys := k.nextAutoCompoundKeys(ctx, params.AutoCompoundBatchSize+1)
+	var next []byte
+	if uint64(len(keys)) > params.AutoCompoundBatchSize {
+		next = keys[params.AutoCompoundBatchSize]
+		keys = keys[:params.AutoCompoundBatchSize]
+	}
+
+	gasLeft := params.AutoCompoundGasLimit
+	for i, key := range keys {
+		delAddr, valAddr := types.GetAutoCompoundAddresses(key)
+
+		gasUsed, err := k.compoundWithGasLimit(ctx, delAddr, valAddr, gasLeft)
+		if errorsmod.IsOf(err, sdkerrors.ErrOutOfGas) && i > 0 {
+			// the delegation is retried in the next block, a delegation
+			// exceeding the whole gas limit is skipped instead
+			next = key
+			break
+		}
+		gasLeft -= gasUsed
+
+		switch {
+		case errorsmod.IsOf(err, types.ErrNoDelegationExists, types.ErrNoValidatorExists):
+			k.DeleteAutoCompoundDelegation(ctx, delAddr, valAddr)
+		case err != nil:
+			k.Logger(ctx).Info(
+				"failed to auto-compound delegation rewards",
+				"delegator", delAddr.String(),
+				"validator", valAddr.String(),
+				"err", err,
+			)
+		}
+	}
+
+	k.setAutoCompoundCursor(ctx, next)
+}
+
+// CompoundDelegationRewards withdraws the rewards of a delegation and
+// re-delegates the rewards in the bond denom to its validator. The rewards in
+// other denoms are sent to the delegator withdraw address. In the staking epoch
+// mode, the re-delegation is queued until the end of the epoch like any other
+// delegation.
+func (k Keeper) CompoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorExists
+	}
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		return sdk.Coin{}, types.ErrNoDelegationExists
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	rewards, err := k.withdrawDelegationRewardsCompounding(ctx, validator, del, bondDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// reinitialize the delegation
+	k.initializeDelegation(ctx, valAddr, delAddr)
+
+	compounded := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if compounded.IsZero() {
+		return compounded, nil
+	}
+
+	if k.stakingKeeper.EpochLength(ctx) > 0 {
+		msg := stakingtypes.NewMsgDelegate(delAddr, valAddr, compounded)
+		if _, err := k.stakingKeeper.QueueOperation(ctx, msg); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if _, err := k.stakingKeeper.Delegate(ctx, delAddr, compounded.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return compounded, nil
+}
+
+// compoundWithGasLimit compounds the rewards of a delegation in a cached
+// context whose gas meter is limited by the given gas limit. State changes are
+// only written if the compounding succeeds.
+func (k Keeper) compoundWithGasLimit(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, gasLimit uint64) (gasUsed uint64, err error) {
+	cacheCtx, flush := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	// Rewards are compounded in the BeginBlocker, so out of gas panics are
+	// recorded as failed compounding instead of halting the chain.
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			gasUsed = gasLimit
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gas limit: %d", oog.Descriptor, gasLimit)
+		}
+	}()
+
+	if _, err := k.CompoundDelegationRewards(cacheCtx, delAddr, valAddr); err != nil {
+		return gasMeter.GasConsumed(), err
+	}
+
+	flush()
+	return gasMeter.GasConsumed(), nil
+}
+
+// nextAutoCompoundKeys returns the keys of the next auto-compounding
+// delegations to process from the cursor, wrapping around to the first
+// delegation at the end.
+func (k Keeper) nextAutoCompoundKeys(ctx sdk.Context, limit uint64) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.AutoCompoundPrefix
+	if cursor := store.Get(types.AutoCompoundCursorKey); cursor != nil {
+		start = cursor
+	}
+
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundPrefix))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid() && uint64(len(keys)) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	return keys
+}
+
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, next []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if next == nil {
+		store.Delete(types.AutoCompoundCursorKey)
+		return
+	}
+
+	store.Set(types.AutoCompoundCursorKey, next)
+}
+
+func (k Keeper) setAutoCompoundDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundKey(delAddr, valAddr), []byte{})
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetCommissionPayoutSplit gets the commission payout split of a validator.
+func (k Keeper) GetCommissionPayoutSplit(ctx sdk.Context, valAddr sdk.ValAddress) (split types.CommissionPayoutSplit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetCommissionPayoutSplitKey(valAddr))
+	if b == nil {
+		return split, false
+	}
+
+	k.cdc.MustUnmarshal(b, &split)
+	return split, true
+}
+
+// setCommissionPayoutSplit sets the commission payout split of a validator.
+func (k Keeper) setCommissionPayoutSplit(ctx sdk.Context, valAddr sdk.ValAddress, split types.CommissionPayoutSplit) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&split)
+	store.Set(types.GetCommissionPayoutSplitKey(valAddr), b)
+}
+
+// DeleteCommissionPayoutSplit deletes the commission payout split of a validator.
+func (k Keeper) DeleteCommissionPayoutSplit(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCommissionPayoutSplitKey(valAddr))
+}
+
+// IterateCommissionPayoutSplits iterates over the commission payout splits.
+func (k Keeper) IterateCommissionPayoutSplits(ctx sdk.Context, handler func(split types.CommissionPayoutSplit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CommissionPayoutSplitPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var split types.CommissionPayoutSplit
+		k.cdc.MustUnmarshal(iter.Value(), &split)
+		if handler(split) {
+			break
+		}
+	}
+}
+
+// GetAllCommissionPayoutSplits returns all the commission payout splits.
+func (k Keeper) GetAllCommissionPayoutSplits(ctx sdk.Context) []types.CommissionPayoutSplit {
+	splits := []types.CommissionPayoutSplit{}
+	k.IterateCommissionPayoutSplits(ctx, func(split types.CommissionPayoutSplit) bool {
+		splits = append(splits, split)
+		return false
+	})
+
+	return splits
+}
+
+// SetCommissionPayoutSplit sets the recipients the commission of a validator is
+// paid out to. An empty list of recipients removes the split, so that the
+// commission is paid out to the withdraw address of the operator again.
+func (k Keeper) SetCommissionPayoutSplit(ctx sdk.Context, valAddr sdk.ValAddress, recipients []types.CommissionPayoutRecipient) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	if len(recipients) == 0 {
+		k.DeleteCommissionPayoutSplit(ctx, valAddr)
+	} else {
+		split := types.NewCommissionPayoutSplit(valAddr, recipients)
+		if err := split.Validate(); err != nil {
+			return err
+		}
+
+		maxRecipients := k.GetParams(ctx).MaxCommissionPayoutRecipients
+		if len(recipients) > int(maxRecipients) {
+			return types.ErrInvalidCommissionPayoutSplit.Wrapf("number of recipients %d exceeds the max %d", len(recipients), maxRecipients)
+		}
+
+		for _, recipient := range recipients {
+			addr := sdk.MustAccAddressFromBech32(recipient.Address)
+			if k.bankKeeper.BlockedAddr(addr) {
+				return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive external funds", recipient.Address)
+			}
+		}
+
+		k.setCommissionPayoutSplit(ctx, valAddr, split)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionPayoutSplit,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// payoutCommission sends the withdrawn commission of a validator to the
+// recipients of its commission payout split, if any. The share of each
+// recipient is truncated and the rest is sent to the withdraw address of the
+// operator.
+func (k Keeper) payoutCommission(ctx sdk.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	remainder := commission
+	if split, found := k.GetCommissionPayoutSplit(ctx, valAddr); found {
+		var payouts []sdk.Coins
+		payouts, remainder = split.Split(commission)
+		for i, recipient := range split.Recipients {
+			if payouts[i].IsZero() {
+				continue
+			}
+
+			addr := sdk.MustAccAddressFromBech32(recipient.Address)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, payouts[i]); err != nil {
+				return err
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCommissionPayout,
+					sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, payouts[i].String()),
+				),
+			)
+		}
+	}
+
+	if remainder.IsZero() {
+		return nil
+	}
+
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, remainder)
+}
@@ -139,6 +139,13 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.Val
 }
 
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
+	return k.withdrawDelegationRewardsCompounding(ctx, val, del, "")
+}
+
+// withdrawDelegationRewardsCompounding withdraws the rewards of a delegation,
+// sending the rewards in the compound denom, if any, to the delegator to be
+// re-delegated, and the others to the delegator withdraw address.
+func (k Keeper) withdrawDelegationRewardsCompounding(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, compoundDenom string) (sdk.Coins, error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrEmptyDelegationDistInfo
@@ -168,10 +175,25 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
-		if err != nil {
-			return nil, err
+		withdrawn := finalRewards
+		if compoundDenom != "" {
+			compounded := sdk.NewCoins(sdk.NewCoin(compoundDenom, finalRewards.AmountOf(compoundDenom)))
+			withdrawn = finalRewards.Sub(compounded...)
+
+			if !compounded.IsZero() {
+				err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, del.GetDelegatorAddr(), compounded)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if !withdrawn.IsZero() {
+			withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, withdrawn)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -29,6 +29,7 @@ func TestCalculateRewardsBasic(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -107,6 +108,7 @@ func TestCalculateRewardsAfterSlash(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -202,6 +204,7 @@ func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -317,6 +320,7 @@ func TestCalculateRewardsMultiDelegator(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -407,6 +411,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -479,6 +484,7 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -586,6 +592,7 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -713,6 +720,7 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
@@ -896,6 +904,7 @@ func Test100PercentCommissionReward(t *testing.T) {
 
 	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	stakingKeeper.EXPECT().GetValidatorDenomWeightedTokens(gomock.Any(), gomock.Any()).Return(sdk.Coins{}).AnyTimes()
 	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
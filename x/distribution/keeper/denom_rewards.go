@@ -0,0 +1,245 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetDenomRewardIndex gets the reward index of a validator's additional bond
+// denom stake, that is the rewards accrued per share of the stake.
+func (k Keeper) GetDenomRewardIndex(ctx sdk.Context, valAddr sdk.ValAddress, denom string) (index types.DenomRewardIndex, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDenomRewardIndexKey(valAddr, denom))
+	if b == nil {
+		return index, false
+	}
+
+	k.cdc.MustUnmarshal(b, &index)
+	return index, true
+}
+
+// SetDenomRewardIndex sets the reward index of a validator's additional bond
+// denom stake.
+func (k Keeper) SetDenomRewardIndex(ctx sdk.Context, index types.DenomRewardIndex) {
+	valAddr, err := sdk.ValAddressFromBech32(index.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&index)
+	store.Set(types.GetDenomRewardIndexKey(valAddr, index.Denom), b)
+}
+
+// IterateDenomRewardIndexes iterates over the reward indexes of the additional
+// bond denom stakes.
+func (k Keeper) IterateDenomRewardIndexes(ctx sdk.Context, handler func(index types.DenomRewardIndex) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomRewardIndexPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var index types.DenomRewardIndex
+		k.cdc.MustUnmarshal(iter.Value(), &index)
+		if handler(index) {
+			break
+		}
+	}
+}
+
+// GetDenomDelegatorRewardIndex gets the reward index an additional bond denom
+// delegation last withdrew its rewards at.
+func (k Keeper) GetDenomDelegatorRewardIndex(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (index types.DenomDelegatorRewardIndex, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDenomDelegatorRewardIndexKey(valAddr, delAddr, denom))
+	if b == nil {
+		return index, false
+	}
+
+	k.cdc.MustUnmarshal(b, &index)
+	return index, true
+}
+
+// SetDenomDelegatorRewardIndex sets the reward index of an additional bond
+// denom delegation.
+func (k Keeper) SetDenomDelegatorRewardIndex(ctx sdk.Context, index types.DenomDelegatorRewardIndex) {
+	valAddr, err := sdk.ValAddressFromBech32(index.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	delAddr := sdk.MustAccAddressFromBech32(index.DelegatorAddress)
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&index)
+	store.Set(types.GetDenomDelegatorRewardIndexKey(valAddr, delAddr, index.Denom), b)
+}
+
+// DeleteDenomDelegatorRewardIndex deletes the reward index of an additional
+// bond denom delegation.
+func (k Keeper) DeleteDenomDelegatorRewardIndex(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomDelegatorRewardIndexKey(valAddr, delAddr, denom))
+}
+
+// IterateDenomDelegatorRewardIndexes iterates over the reward indexes of the
+// additional bond denom delegations.
+func (k Keeper) IterateDenomDelegatorRewardIndexes(ctx sdk.Context, handler func(index types.DenomDelegatorRewardIndex) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomDelegatorRewardIndexPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var index types.DenomDelegatorRewardIndex
+		k.cdc.MustUnmarshal(iter.Value(), &index)
+		if handler(index) {
+			break
+		}
+	}
+}
+
+// deleteValidatorDenomRewardIndexes deletes all the additional bond denom
+// reward indexes of a validator.
+func (k Keeper) deleteValidatorDenomRewardIndexes(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.GetDenomRewardIndexesKey(valAddr), types.GetDenomDelegatorRewardIndexesKey(valAddr)} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// allocateTokensToDenomStakes credits the additional bond denom stakes of a
+// validator with their share of the rewards of its delegators, proportional to
+// their weighted tokens, and returns the rewards left to the delegators of the
+// bond denom.
+func (k Keeper) allocateTokensToDenomStakes(ctx sdk.Context, val stakingtypes.ValidatorI, shared sdk.DecCoins) sdk.DecCoins {
+	weighted := k.stakingKeeper.GetValidatorDenomWeightedTokens(ctx, val.GetOperator())
+	if weighted.IsZero() || shared.IsZero() {
+		return shared
+	}
+
+	totalTokens := val.GetTokens()
+	for _, coin := range weighted {
+		totalTokens = totalTokens.Add(coin.Amount)
+	}
+	total := math.LegacyNewDecFromInt(totalTokens)
+
+	remaining := shared
+	for _, coin := range weighted {
+		stake, found := k.stakingKeeper.GetValidatorDenomStake(ctx, val.GetOperator(), coin.Denom)
+		if !found || !stake.Shares.IsPositive() {
+			continue
+		}
+
+		// the truncated dust is left to the delegators of the bond denom
+		portion := shared.MulDecTruncate(math.LegacyNewDecFromInt(coin.Amount).Quo(total))
+		increment := portion.QuoDecTruncate(stake.Shares)
+		credited := increment.MulDecTruncate(stake.Shares)
+		if credited.IsZero() {
+			continue
+		}
+
+		index, found := k.GetDenomRewardIndex(ctx, val.GetOperator(), coin.Denom)
+		if !found {
+			index = types.DenomRewardIndex{ValidatorAddress: val.GetOperator().String(), Denom: coin.Denom}
+		}
+		index.Index = index.Index.Add(increment...)
+		k.SetDenomRewardIndex(ctx, index)
+
+		remaining = remaining.Sub(credited)
+	}
+
+	return remaining
+}
+
+// CalculateDenomDelegationRewards calculates the rewards an additional bond
+// denom delegation accrued since it last withdrew its rewards.
+func (k Keeper) CalculateDenomDelegationRewards(ctx sdk.Context, delegation stakingtypes.DenomDelegation) (sdk.DecCoins, error) {
+	valAddr := delegation.GetValidatorAddr()
+	delIndex, found := k.GetDenomDelegatorRewardIndex(ctx, delegation.GetDelegatorAddr(), valAddr, delegation.Denom)
+	if !found {
+		return nil, types.ErrEmptyDenomDelegationInfo
+	}
+
+	index, _ := k.GetDenomRewardIndex(ctx, valAddr, delegation.Denom)
+	return index.Index.Sub(delIndex.Index).MulDecTruncate(delegation.Shares), nil
+}
+
+// withdrawDenomDelegationRewards pays out the rewards of an additional bond
+// denom delegation to the withdraw address of the delegator.
+func (k Keeper) withdrawDenomDelegationRewards(ctx sdk.Context, delegation stakingtypes.DenomDelegation) (sdk.Coins, error) {
+	rewardsRaw, err := k.CalculateDenomDelegationRewards(ctx, delegation)
+	if err != nil {
+		return nil, err
+	}
+
+	// defensive edge case may happen on the very final digits
+	// of the decCoins due to operation order of the distribution mechanism.
+	valAddr := delegation.GetValidatorAddr()
+	outstanding := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	rewards := rewardsRaw.Intersect(outstanding)
+
+	// truncate reward dec coins, return remainder to community pool
+	finalRewards, remainder := rewards.TruncateDecimal()
+	if !finalRewards.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delegation.GetDelegatorAddr())
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	k.SetFeePool(ctx, feePool)
+
+	// move the delegation to the current index
+	index, _ := k.GetDenomRewardIndex(ctx, valAddr, delegation.Denom)
+	k.SetDenomDelegatorRewardIndex(ctx, types.DenomDelegatorRewardIndex{
+		DelegatorAddress: delegation.DelegatorAddress,
+		ValidatorAddress: delegation.ValidatorAddress,
+		Denom:            delegation.Denom,
+		Index:            index.Index,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawDenomRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, finalRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, delegation.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDenom, delegation.Denom),
+		),
+	)
+
+	return finalRewards, nil
+}
+
+// WithdrawDenomDelegationRewards withdraws the rewards of an additional bond
+// denom delegation.
+func (k Keeper) WithdrawDenomDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (sdk.Coins, error) {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, types.ErrNoValidatorExists
+	}
+
+	delegation, found := k.stakingKeeper.GetDenomDelegation(ctx, delAddr, valAddr, denom)
+	if !found {
+		return nil, types.ErrEmptyDelegationDistInfo
+	}
+
+	// allocate the rewards the validator accrued with the lazy reward accounting
+	k.settleValidatorRewards(ctx, valAddr)
+
+	return k.withdrawDenomDelegationRewards(ctx, delegation)
+}
@@ -79,6 +79,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+	for _, budget := range data.Budgets {
+		k.SetBudget(ctx, budget)
+	}
+	for _, delegation := range data.AutoCompoundDelegations {
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setAutoCompoundDelegation(ctx, sdk.MustAccAddressFromBech32(delegation.DelegatorAddress), valAddr)
+	}
+	for _, split := range data.CommissionPayoutSplits {
+		valAddr, err := sdk.ValAddressFromBech32(split.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setCommissionPayoutSplit(ctx, valAddr, split)
+	}
+	for _, index := range data.DenomRewardIndexes {
+		k.SetDenomRewardIndex(ctx, index)
+	}
+	for _, index := range data.DenomDelegatorRewardIndexes {
+		k.SetDenomDelegatorRewardIndex(ctx, index)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -100,6 +126,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	// export the settled state of the lazy reward accounting, which is started
+	// again from the params in the first block
+	if _, found := k.GetLazyRewardIndex(ctx); found {
+		ctx, _ = ctx.CacheContext()
+		k.MigrateFromLazyRewardAccounting(ctx)
+	}
+
 	feePool := k.GetFeePool(ctx)
 	params := k.GetParams(ctx)
 
@@ -184,5 +217,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.ContinuousFunds = k.GetAllContinuousFunds(ctx)
+	genState.Budgets = k.GetAllBudgets(ctx)
+	genState.AutoCompoundDelegations = k.GetAllAutoCompoundDelegations(ctx)
+	genState.CommissionPayoutSplits = k.GetAllCommissionPayoutSplits(ctx)
+
+	genState.DenomRewardIndexes = []types.DenomRewardIndex{}
+	k.IterateDenomRewardIndexes(ctx, func(index types.DenomRewardIndex) (stop bool) {
+		genState.DenomRewardIndexes = append(genState.DenomRewardIndexes, index)
+		return false
+	})
+
+	genState.DenomDelegatorRewardIndexes = []types.DenomDelegatorRewardIndex{}
+	k.IterateDenomDelegatorRewardIndexes(ctx, func(index types.DenomDelegatorRewardIndex) (stop bool) {
+		genState.DenomDelegatorRewardIndexes = append(genState.DenomDelegatorRewardIndexes, index)
+		return false
+	})
+
+	return genState
 }
@@ -91,6 +91,7 @@ func (k Querier) ValidatorOutstandingRewards(c context.Context, req *types.Query
 	if err != nil {
 		return nil, err
 	}
+	k.settleValidatorRewards(ctx, valAdr)
 	rewards := k.GetValidatorOutstandingRewards(ctx, valAdr)
 
 	return &types.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
@@ -112,6 +113,7 @@ func (k Querier) ValidatorCommission(c context.Context, req *types.QueryValidato
 	if err != nil {
 		return nil, err
 	}
+	k.settleValidatorRewards(ctx, valAdr)
 	commission := k.GetValidatorAccumulatedCommission(ctx, valAdr)
 
 	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
@@ -201,6 +203,52 @@ func (k Querier) DelegationRewards(c context.Context, req *types.QueryDelegation
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
 }
 
+// DenomDelegationRewards the rewards accrued by an additional bond denom delegation
+func (k Querier) DenomDelegationRewards(c context.Context, req *types.QueryDenomDelegationRewardsRequest) (*types.QueryDenomDelegationRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAdr) == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegation, found := k.stakingKeeper.GetDenomDelegation(ctx, delAdr, valAdr, req.Denom)
+	if !found {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	rewards, err := k.CalculateDenomDelegationRewards(ctx, delegation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomDelegationRewardsResponse{Rewards: rewards}, nil
+}
+
 // DelegationTotalRewards the total rewards accrued by a each validator
 func (k Querier) DelegationTotalRewards(c context.Context, req *types.QueryDelegationTotalRewardsRequest) (*types.QueryDelegationTotalRewardsResponse, error) {
 	if req == nil {
@@ -293,3 +341,131 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// ContinuousFunds queries the continuous funds from the community pool
+func (k Querier) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fundsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContinuousFundPrefix)
+
+	funds := []types.ContinuousFund{}
+	pageRes, err := query.Paginate(fundsStore, req.Pagination, func(key, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
+
+// ContinuousFund queries the continuous fund of a recipient
+func (k Querier) ContinuousFund(c context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Recipient == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty recipient address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	fund, found := k.GetContinuousFund(ctx, recipient)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund of %s not found", req.Recipient)
+	}
+
+	return &types.QueryContinuousFundResponse{ContinuousFund: fund}, nil
+}
+
+// Budget queries the budget of a recipient
+func (k Querier) Budget(c context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Recipient == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty recipient address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	budget, found := k.GetBudget(ctx, recipient)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "budget of %s not found", req.Recipient)
+	}
+
+	return &types.QueryBudgetResponse{Budget: budget, Claimable: budget.ClaimableAmount(ctx.BlockTime())}, nil
+}
+
+// DelegatorAutoCompound queries the auto-compounding status of each delegation of a delegator
+func (k Querier) DelegatorAutoCompound(c context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations := []types.DelegationAutoCompoundStatus{}
+	k.stakingKeeper.IterateDelegations(
+		ctx, delAdr,
+		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			delegations = append(delegations, types.DelegationAutoCompoundStatus{
+				ValidatorAddress: del.GetValidatorAddr().String(),
+				Enabled:          k.IsAutoCompoundDelegation(ctx, delAdr, del.GetValidatorAddr()),
+			})
+			return false
+		},
+	)
+
+	return &types.QueryDelegatorAutoCompoundResponse{Delegations: delegations}, nil
+}
+
+// CommissionPayoutSplit queries the commission payout split of a validator
+func (k Querier) CommissionPayoutSplit(c context.Context, req *types.QueryCommissionPayoutSplitRequest) (*types.QueryCommissionPayoutSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	split, found := k.GetCommissionPayoutSplit(ctx, valAdr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "commission payout split for validator %s not found", req.ValidatorAddress)
+	}
+
+	return &types.QueryCommissionPayoutSplitResponse{Split: split}, nil
+}
@@ -27,6 +27,12 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) er
 
 // AfterValidatorRemoved performs clean up after a validator is removed
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	// remove from the lazy reward accounting
+	h.k.removeValidatorRewardIndex(ctx, valAddr)
+
+	// remove the reward indexes of the additional bond denom stakes
+	h.k.deleteValidatorDenomRewardIndexes(ctx, valAddr)
+
 	// fetch outstanding
 	outstanding := h.k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
 
@@ -44,12 +50,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 		h.k.SetFeePool(ctx, feePool)
 
-		// add to validator account
+		// add to validator account, or to the recipients of its payout split
 		if !coins.IsZero() {
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
-
-			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+			if err := h.k.payoutCommission(ctx, valAddr, coins); err != nil {
 				return err
 			}
 		}
@@ -68,6 +71,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 	// remove commission record
 	h.k.DeleteValidatorAccumulatedCommission(ctx, valAddr)
 
+	// remove commission payout split
+	h.k.DeleteCommissionPayoutSplit(ctx, valAddr)
+
 	// clear slashes
 	h.k.DeleteValidatorSlashEvents(ctx, valAddr)
 
@@ -82,6 +88,7 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 // increment period
 func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
 	_ = h.k.IncrementValidatorPeriod(ctx, val)
 	return nil
@@ -89,6 +96,7 @@ func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress,
 
 // withdraw delegation rewards (which also increments period)
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
 	del := h.k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
 
@@ -107,26 +115,71 @@ func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 
 // record the slash event
 func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
 	h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
 	return nil
 }
 
-func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+// settle the validator at its current commission before it changes, and
+// refresh its power in the lazy reward accounting
+func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
+	h.k.settleValidatorRewards(ctx, valAddr)
 	return nil
 }
 
-func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// refresh the power of the validator in the lazy reward accounting
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
 	return nil
 }
 
-func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// refresh the power of the validator in the lazy reward accounting
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// stop the auto-compounding of a removed delegation
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.DeleteAutoCompoundDelegation(ctx, delAddr, valAddr)
 	return nil
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// withdraw the rewards of an additional bond denom delegation
+func (h Hooks) BeforeDenomDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error {
+	h.k.markValidatorRewardIndexStale(ctx, valAddr)
+	h.k.settleValidatorRewards(ctx, valAddr)
+
+	delegation, found := h.k.stakingKeeper.GetDenomDelegation(ctx, delAddr, valAddr, denom)
+	if !found {
+		return nil
+	}
+
+	if _, err := h.k.withdrawDenomDelegationRewards(ctx, delegation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// move the additional bond denom delegation to the current reward index
+func (h Hooks) AfterDenomDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error {
+	if _, found := h.k.stakingKeeper.GetDenomDelegation(ctx, delAddr, valAddr, denom); !found {
+		h.k.DeleteDenomDelegatorRewardIndex(ctx, delAddr, valAddr, denom)
+		return nil
+	}
+
+	index, _ := h.k.GetDenomRewardIndex(ctx, valAddr, denom)
+	h.k.SetDenomDelegatorRewardIndex(ctx, types.DenomDelegatorRewardIndex{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Denom:            denom,
+		Index:            index.Index,
+	})
+	return nil
+}
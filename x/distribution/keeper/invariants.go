@@ -141,6 +141,11 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 			return false
 		})
 
+		// the rewards not yet settled with the lazy reward accounting
+		if global, found := k.GetLazyRewardIndex(ctx); found {
+			expectedCoins = expectedCoins.Add(global.Unsettled...)
+		}
+
 		communityPool := k.GetFeePoolCommunityCoins(ctx)
 		expectedInt, _ := expectedCoins.Add(communityPool...).TruncateDecimal()
 
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetLazyRewardIndex gets the global reward index of the lazy reward
+// accounting. It is only found while the lazy reward accounting is active.
+func (k Keeper) GetLazyRewardIndex(ctx sdk.Context) (index types.LazyRewardIndex, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LazyRewardIndexKey)
+	if b == nil {
+		return index, false
+	}
+
+	k.cdc.MustUnmarshal(b, &index)
+	return index, true
+}
+
+// setLazyRewardIndex sets the global reward index of the lazy reward accounting.
+func (k Keeper) setLazyRewardIndex(ctx sdk.Context, index types.LazyRewardIndex) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&index)
+	store.Set(types.LazyRewardIndexKey, b)
+}
+
+// GetValidatorRewardIndex gets the reward index of a validator.
+func (k Keeper) GetValidatorRewardIndex(ctx sdk.Context, valAddr sdk.ValAddress) (index types.ValidatorRewardIndex, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorRewardIndexKey(valAddr))
+	if b == nil {
+		return index, false
+	}
+
+	k.cdc.MustUnmarshal(b, &index)
+	return index, true
+}
+
+// setValidatorRewardIndex sets the reward index of a validator.
+func (k Keeper) setValidatorRewardIndex(ctx sdk.Context, valAddr sdk.ValAddress, index types.ValidatorRewardIndex) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&index)
+	store.Set(types.GetValidatorRewardIndexKey(valAddr), b)
+}
+
+// IterateValidatorRewardIndexes iterates over the reward indexes of the validators.
+func (k Keeper) IterateValidatorRewardIndexes(ctx sdk.Context, handler func(valAddr sdk.ValAddress, index types.ValidatorRewardIndex) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorRewardIndexPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var index types.ValidatorRewardIndex
+		k.cdc.MustUnmarshal(iter.Value(), &index)
+		if handler(types.GetValidatorRewardIndexAddress(iter.Key()), index) {
+			break
+		}
+	}
+}
+
+// markValidatorRewardIndexStale records that the power of a validator may
+// change, so that its reward index power is refreshed before the next
+// allocation.
+func (k Keeper) markValidatorRewardIndexStale(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.LazyRewardIndexKey) {
+		return
+	}
+
+	store.Set(types.GetValidatorRewardIndexStaleKey(valAddr), []byte{})
+}
+
+// staleValidatorRewardIndexes returns the validators whose reward index power
+// must be refreshed.
+func (k Keeper) staleValidatorRewardIndexes(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorRewardIndexStalePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valAddrs = append(valAddrs, types.GetValidatorRewardIndexAddress(iter.Key()))
+	}
+
+	return valAddrs
+}
+
+// UpdateRewardAccountingMode migrates the reward accounting state when the
+// lazy_reward_accounting param changed, and returns whether the lazy reward
+// accounting is active.
+func (k Keeper) UpdateRewardAccountingMode(ctx sdk.Context) (lazy bool) {
+	enabled := k.GetParams(ctx).LazyRewardAccounting
+	_, active := k.GetLazyRewardIndex(ctx)
+
+	switch {
+	case enabled && !active:
+		k.MigrateToLazyRewardAccounting(ctx)
+	case !enabled && active:
+		k.MigrateFromLazyRewardAccounting(ctx)
+	}
+
+	return enabled
+}
+
+// MigrateToLazyRewardAccounting starts the lazy reward accounting: every
+// bonded validator starts accruing rewards from a zero global reward index
+// with its last power.
+func (k Keeper) MigrateToLazyRewardAccounting(ctx sdk.Context) {
+	global := types.LazyRewardIndex{Index: sdk.DecCoins{}, Unsettled: sdk.DecCoins{}}
+	k.stakingKeeper.IterateLastValidatorPowers(ctx, func(valAddr sdk.ValAddress, power int64) (stop bool) {
+		k.setValidatorRewardIndex(ctx, valAddr, types.ValidatorRewardIndex{Index: sdk.DecCoins{}, Power: power})
+		global.TotalPower += power
+		return false
+	})
+
+	k.setLazyRewardIndex(ctx, global)
+}
+
+// MigrateFromLazyRewardAccounting stops the lazy reward accounting: every
+// validator is settled, the unsettled dust is sent to the community pool and
+// the lazy reward accounting state is deleted.
+func (k Keeper) MigrateFromLazyRewardAccounting(ctx sdk.Context) {
+	var valAddrs []sdk.ValAddress
+	k.IterateValidatorRewardIndexes(ctx, func(valAddr sdk.ValAddress, _ types.ValidatorRewardIndex) (stop bool) {
+		valAddrs = append(valAddrs, valAddr)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, valAddr := range valAddrs {
+		k.settleValidatorRewards(ctx, valAddr)
+		store.Delete(types.GetValidatorRewardIndexKey(valAddr))
+	}
+	for _, valAddr := range k.staleValidatorRewardIndexes(ctx) {
+		store.Delete(types.GetValidatorRewardIndexStaleKey(valAddr))
+	}
+
+	global, _ := k.GetLazyRewardIndex(ctx)
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(global.Unsettled...)
+	k.SetFeePool(ctx, feePool)
+
+	store.Delete(types.LazyRewardIndexKey)
+}
+
+// settleValidatorRewards allocates to a validator the rewards it accrued
+// since it was last settled, that is the growth of the global reward index
+// times its power. It is a no-op when the lazy reward accounting is inactive.
+func (k Keeper) settleValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress) {
+	global, found := k.GetLazyRewardIndex(ctx)
+	if !found {
+		return
+	}
+	index, found := k.GetValidatorRewardIndex(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	rewards := global.Index.Sub(index.Index).MulDec(math.LegacyNewDec(index.Power))
+	if !rewards.IsZero() {
+		global.Unsettled = global.Unsettled.Sub(rewards)
+		k.setLazyRewardIndex(ctx, global)
+
+		// the rewards of a removed validator go to the community pool
+		if val := k.stakingKeeper.Validator(ctx, valAddr); val != nil {
+			k.AllocateTokensToValidator(ctx, val, rewards)
+		} else {
+			feePool := k.GetFeePool(ctx)
+			feePool.CommunityPool = feePool.CommunityPool.Add(rewards...)
+			k.SetFeePool(ctx, feePool)
+		}
+	}
+
+	index.Index = global.Index
+	k.setValidatorRewardIndex(ctx, valAddr, index)
+}
+
+// removeValidatorRewardIndex settles a validator and removes it from the lazy
+// reward accounting.
+func (k Keeper) removeValidatorRewardIndex(ctx sdk.Context, valAddr sdk.ValAddress) {
+	k.settleValidatorRewards(ctx, valAddr)
+
+	index, found := k.GetValidatorRewardIndex(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	global, _ := k.GetLazyRewardIndex(ctx)
+	global.TotalPower -= index.Power
+	k.setLazyRewardIndex(ctx, global)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorRewardIndexKey(valAddr))
+	store.Delete(types.GetValidatorRewardIndexStaleKey(valAddr))
+}
+
+// refreshValidatorRewardIndexes settles the validators whose power may have
+// changed, and updates their power to their last power.
+func (k Keeper) refreshValidatorRewardIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, valAddr := range k.staleValidatorRewardIndexes(ctx) {
+		store.Delete(types.GetValidatorRewardIndexStaleKey(valAddr))
+
+		power := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if power == 0 {
+			k.removeValidatorRewardIndex(ctx, valAddr)
+			continue
+		}
+
+		k.settleValidatorRewards(ctx, valAddr)
+		global, _ := k.GetLazyRewardIndex(ctx)
+		index, found := k.GetValidatorRewardIndex(ctx, valAddr)
+		if !found {
+			index = types.ValidatorRewardIndex{Index: global.Index}
+		}
+
+		global.TotalPower += power - index.Power
+		k.setLazyRewardIndex(ctx, global)
+
+		index.Power = power
+		k.setValidatorRewardIndex(ctx, valAddr, index)
+	}
+}
+
+// AllocateTokensLazy distributes the collected fees with the lazy reward
+// accounting: instead of allocating the rewards to every validator, the
+// rewards per unit of power are added to the global reward index, and each
+// validator is allocated its rewards when it is settled.
+func (k Keeper) AllocateTokensLazy(ctx sdk.Context) {
+	feesCollected := k.collectFees(ctx)
+
+	k.refreshValidatorRewardIndexes(ctx)
+	global, _ := k.GetLazyRewardIndex(ctx)
+
+	feePool := k.GetFeePool(ctx)
+	if global.TotalPower == 0 {
+		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
+		k.SetFeePool(ctx, feePool)
+		return
+	}
+
+	// calculate the rewards per unit of power, the truncated dust goes to the
+	// community pool along with the community tax
+	totalPower := math.LegacyNewDec(global.TotalPower)
+	feeMultiplier := feesCollected.MulDecTruncate(math.LegacyOneDec().Sub(k.GetCommunityTax(ctx)))
+	increment := feeMultiplier.QuoDecTruncate(totalPower)
+	allocated := increment.MulDec(totalPower)
+
+	global.Index = global.Index.Add(increment...)
+	global.Unsettled = global.Unsettled.Add(allocated...)
+	k.setLazyRewardIndex(ctx, global)
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected.Sub(allocated)...)
+	k.SetFeePool(ctx, feePool)
+}
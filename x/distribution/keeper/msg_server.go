@@ -74,6 +74,37 @@ func (k msgServer) WithdrawDelegatorReward(goCtx context.Context, msg *types.Msg
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawDenomReward(goCtx context.Context, msg *types.MsgWithdrawDenomReward) (*types.MsgWithdrawDenomRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.WithdrawDenomDelegationRewards(ctx, delegatorAddress, valAddr, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_denom_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgWithdrawDenomRewardResponse{Amount: amount}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(goCtx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -158,3 +189,106 @@ func (k msgServer) CommunityPoolSpend(goCtx context.Context, req *types.MsgCommu
 
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
+
+func (k msgServer) CreateContinuousFund(goCtx context.Context, req *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CreateContinuousFund(ctx, req.ContinuousFund()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateContinuousFundResponse{}, nil
+}
+
+func (k msgServer) CancelContinuousFund(goCtx context.Context, req *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CancelContinuousFund(ctx, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContinuousFundResponse{}, nil
+}
+
+func (k msgServer) SubmitBudgetProposal(goCtx context.Context, req *types.MsgSubmitBudgetProposal) (*types.MsgSubmitBudgetProposalResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := ctx.BlockTime()
+	if req.StartTime != nil {
+		startTime = *req.StartTime
+	}
+
+	budget := types.NewBudget(recipient, req.TotalBudget, startTime, req.Tranches, req.Period)
+	if err := k.SubmitBudget(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitBudgetProposalResponse{}, nil
+}
+
+func (k msgServer) ClaimBudget(goCtx context.Context, msg *types.MsgClaimBudget) (*types.MsgClaimBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.ClaimBudget(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBudgetResponse{Amount: amount}, nil
+}
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SetDelegationAutoCompound(ctx, delegatorAddress, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) SetCommissionPayoutSplit(goCtx context.Context, msg *types.MsgSetCommissionPayoutSplit) (*types.MsgSetCommissionPayoutSplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.SetCommissionPayoutSplit(ctx, valAddr, msg.Recipients); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCommissionPayoutSplitResponse{}, nil
+}
@@ -0,0 +1,291 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetContinuousFund returns the continuous fund of a recipient.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetContinuousFundKey(recipient))
+	if b == nil {
+		return fund, false
+	}
+
+	k.cdc.MustUnmarshal(b, &fund)
+	return fund, true
+}
+
+// SetContinuousFund stores the continuous fund of a recipient.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+	store.Set(types.GetContinuousFundKey(recipient), k.cdc.MustMarshal(&fund))
+}
+
+// DeleteContinuousFund removes the continuous fund of a recipient.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(recipient))
+}
+
+// IterateContinuousFunds iterates over the continuous funds by recipient.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// GetAllContinuousFunds returns all the continuous funds.
+func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) []types.ContinuousFund {
+	funds := []types.ContinuousFund{}
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) bool {
+		funds = append(funds, fund)
+		return false
+	})
+
+	return funds
+}
+
+// CreateContinuousFund creates the continuous fund of a recipient, replacing
+// its existing fund if any.
+func (k Keeper) CreateContinuousFund(ctx sdk.Context, fund types.ContinuousFund) error {
+	if err := fund.Validate(); err != nil {
+		return err
+	}
+	if fund.IsExpired(ctx.BlockTime()) {
+		return types.ErrInvalidContinuousFund.Wrapf("expiry %s must be after the block time", fund.Expiry)
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errors.Wrapf(errors.ErrUnauthorized, "%s is not allowed to receive external funds", fund.Recipient)
+	}
+
+	if fund.IsPercentage() {
+		totalPercentage := fund.Percentage
+		k.IterateContinuousFunds(ctx, func(other types.ContinuousFund) bool {
+			if other.Recipient != fund.Recipient && other.IsPercentage() {
+				totalPercentage = totalPercentage.Add(other.Percentage)
+			}
+			return false
+		})
+		if totalPercentage.GT(math.LegacyOneDec()) {
+			return types.ErrInvalidContinuousFund.Wrapf("total percentage of the continuous funds exceeds 1: %s", totalPercentage)
+		}
+	}
+
+	k.SetContinuousFund(ctx, fund)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+		),
+	)
+
+	return nil
+}
+
+// CancelContinuousFund removes the continuous fund of a recipient.
+func (k Keeper) CancelContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) error {
+	if _, found := k.GetContinuousFund(ctx, recipient); !found {
+		return types.ErrNoContinuousFund.Wrapf("recipient %s", recipient)
+	}
+
+	k.DeleteContinuousFund(ctx, recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+	)
+
+	return nil
+}
+
+// DistributeContinuousFunds pays the continuous funds from the community pool,
+// given the inflow of the community pool in the current block. The expired
+// funds are removed, and the fixed amounts the community pool cannot cover are
+// skipped.
+func (k Keeper) DistributeContinuousFunds(ctx sdk.Context, inflow sdk.DecCoins) {
+	var (
+		funds   []types.ContinuousFund
+		expired []sdk.AccAddress
+	)
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) bool {
+		if fund.IsExpired(ctx.BlockTime()) {
+			expired = append(expired, sdk.MustAccAddressFromBech32(fund.Recipient))
+		} else {
+			funds = append(funds, fund)
+		}
+		return false
+	})
+
+	for _, recipient := range expired {
+		k.DeleteContinuousFund(ctx, recipient)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundExpired,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			),
+		)
+	}
+
+	for _, fund := range funds {
+		amount := fund.AmountPerBlock
+		if fund.IsPercentage() {
+			amount, _ = inflow.MulDecTruncate(fund.Percentage).TruncateDecimal()
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+		if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+			k.Logger(ctx).Info(
+				"skipped continuous fund payout",
+				"recipient", fund.Recipient,
+				"amount", amount.String(),
+				"err", err,
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFundPayout,
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+}
+
+// GetBudget returns the budget of a recipient.
+func (k Keeper) GetBudget(ctx sdk.Context, recipient sdk.AccAddress) (budget types.Budget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetBudgetKey(recipient))
+	if b == nil {
+		return budget, false
+	}
+
+	k.cdc.MustUnmarshal(b, &budget)
+	return budget, true
+}
+
+// SetBudget stores the budget of a recipient.
+func (k Keeper) SetBudget(ctx sdk.Context, budget types.Budget) {
+	store := ctx.KVStore(k.storeKey)
+	recipient := sdk.MustAccAddressFromBech32(budget.Recipient)
+	store.Set(types.GetBudgetKey(recipient), k.cdc.MustMarshal(&budget))
+}
+
+// DeleteBudget removes the budget of a recipient.
+func (k Keeper) DeleteBudget(ctx sdk.Context, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBudgetKey(recipient))
+}
+
+// IterateBudgets iterates over the budgets by recipient.
+func (k Keeper) IterateBudgets(ctx sdk.Context, handler func(budget types.Budget) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BudgetPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var budget types.Budget
+		k.cdc.MustUnmarshal(iter.Value(), &budget)
+		if handler(budget) {
+			break
+		}
+	}
+}
+
+// GetAllBudgets returns all the budgets.
+func (k Keeper) GetAllBudgets(ctx sdk.Context) []types.Budget {
+	budgets := []types.Budget{}
+	k.IterateBudgets(ctx, func(budget types.Budget) bool {
+		budgets = append(budgets, budget)
+		return false
+	})
+
+	return budgets
+}
+
+// SubmitBudget creates the budget of a recipient, which can hold a single
+// budget at a time.
+func (k Keeper) SubmitBudget(ctx sdk.Context, budget types.Budget) error {
+	if err := budget.Validate(); err != nil {
+		return err
+	}
+
+	recipient := sdk.MustAccAddressFromBech32(budget.Recipient)
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errors.Wrapf(errors.ErrUnauthorized, "%s is not allowed to receive external funds", budget.Recipient)
+	}
+	if _, found := k.GetBudget(ctx, recipient); found {
+		return types.ErrBudgetExists.Wrapf("recipient %s", budget.Recipient)
+	}
+
+	k.SetBudget(ctx, budget)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitBudget,
+			sdk.NewAttribute(types.AttributeKeyRecipient, budget.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, budget.TotalBudget.String()),
+		),
+	)
+
+	return nil
+}
+
+// ClaimBudget pays the unlocked and unclaimed tranches of the budget of a
+// recipient from the community pool. The budget is removed once fully claimed.
+func (k Keeper) ClaimBudget(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Coins, error) {
+	budget, found := k.GetBudget(ctx, recipient)
+	if !found {
+		return nil, types.ErrNoBudget.Wrapf("recipient %s", recipient)
+	}
+
+	amount := budget.ClaimableAmount(ctx.BlockTime())
+	if amount.IsZero() {
+		return nil, types.ErrNothingToClaim.Wrapf("next tranche of %s is not unlocked yet", recipient)
+	}
+
+	if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return nil, err
+	}
+
+	budget.Claimed = budget.Claimed.Add(amount...)
+	if budget.IsFullyClaimed() {
+		k.DeleteBudget(ctx, recipient)
+	} else {
+		k.SetBudget(ctx, budget)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimBudget,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return amount, nil
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func setupPoolKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *distrtestutil.MockBankKeeper) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Unix(1_000_000, 0).UTC()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	bankKeeper.EXPECT().BlockedAddr(distrAcc.GetAddress()).Return(true).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	feePool := types.InitialFeePool()
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	distrKeeper.SetFeePool(ctx, feePool)
+
+	return ctx, distrKeeper, bankKeeper
+}
+
+func TestCreateContinuousFund(t *testing.T) {
+	ctx, distrKeeper, _ := setupPoolKeeper(t)
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+
+	require.NoError(t, distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[0], math.LegacyNewDecWithPrec(6, 1), nil, nil)))
+
+	// the total percentage of the funds cannot exceed 1
+	err := distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[1], math.LegacyNewDecWithPrec(5, 1), nil, nil))
+	require.ErrorIs(t, err, types.ErrInvalidContinuousFund)
+
+	// replacing the fund of a recipient does not count its previous percentage
+	require.NoError(t, distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[0], math.LegacyNewDecWithPrec(5, 1), nil, nil)))
+	require.NoError(t, distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[1], math.LegacyNewDecWithPrec(5, 1), nil, nil)))
+
+	// the expiry must be after the block time
+	expiry := ctx.BlockTime()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	err = distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[2], math.LegacyZeroDec(), amount, &expiry))
+	require.ErrorIs(t, err, types.ErrInvalidContinuousFund)
+
+	require.Len(t, distrKeeper.GetAllContinuousFunds(ctx), 2)
+
+	require.NoError(t, distrKeeper.CancelContinuousFund(ctx, addrs[1]))
+	require.ErrorIs(t, distrKeeper.CancelContinuousFund(ctx, addrs[1]), types.ErrNoContinuousFund)
+	require.Len(t, distrKeeper.GetAllContinuousFunds(ctx), 1)
+}
+
+func TestDistributeContinuousFunds(t *testing.T) {
+	ctx, distrKeeper, bankKeeper := setupPoolKeeper(t)
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	fixedAmount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[0], math.LegacyNewDecWithPrec(25, 2), nil, nil)))
+	require.NoError(t, distrKeeper.CreateContinuousFund(ctx, types.NewContinuousFund(addrs[1], math.LegacyZeroDec(), fixedAmount, &expiry)))
+
+	// a quarter of the inflow, truncated, and the fixed amount are paid
+	inflow := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(202)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[1], fixedAmount)
+	distrKeeper.DistributeContinuousFunds(ctx, inflow)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(850))), distrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// the fixed amount is skipped when the community pool cannot cover it
+	feePool := distrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(50)))
+	distrKeeper.SetFeePool(ctx, feePool)
+	distrKeeper.DistributeContinuousFunds(ctx, sdk.DecCoins{})
+	require.Equal(t, feePool.CommunityPool, distrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// expired funds are removed
+	ctx = ctx.WithBlockTime(expiry)
+	distrKeeper.DistributeContinuousFunds(ctx, sdk.DecCoins{})
+	_, found := distrKeeper.GetContinuousFund(ctx, addrs[1])
+	require.False(t, found)
+	_, found = distrKeeper.GetContinuousFund(ctx, addrs[0])
+	require.True(t, found)
+}
+
+func TestClaimBudget(t *testing.T) {
+	ctx, distrKeeper, bankKeeper := setupPoolKeeper(t)
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	startTime := ctx.BlockTime().Add(time.Hour)
+	totalBudget := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+	require.NoError(t, distrKeeper.SubmitBudget(ctx, types.NewBudget(addrs[0], totalBudget, startTime, 3, time.Hour)))
+	require.ErrorIs(t, distrKeeper.SubmitBudget(ctx, types.NewBudget(addrs[0], totalBudget, startTime, 3, time.Hour)), types.ErrBudgetExists)
+
+	_, err := distrKeeper.ClaimBudget(ctx, addrs[1])
+	require.ErrorIs(t, err, types.ErrNoBudget)
+
+	// nothing is unlocked before the start time
+	_, err = distrKeeper.ClaimBudget(ctx, addrs[0])
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	// the first tranche is unlocked at the start time
+	ctx = ctx.WithBlockTime(startTime)
+	tranche := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[0], tranche)
+	amount, err := distrKeeper.ClaimBudget(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, tranche, amount)
+
+	_, err = distrKeeper.ClaimBudget(ctx, addrs[0])
+	require.ErrorIs(t, err, types.ErrNothingToClaim)
+
+	// the remaining tranches are claimed at once and the budget is removed
+	ctx = ctx.WithBlockTime(startTime.Add(5 * time.Hour))
+	remaining := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[0], remaining)
+	amount, err = distrKeeper.ClaimBudget(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, remaining, amount)
+
+	_, found := distrKeeper.GetBudget(ctx, addrs[0])
+	require.False(t, found)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(700))), distrKeeper.GetFeePoolCommunityCoins(ctx))
+}
@@ -41,16 +41,26 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_delegations": [],
+	"budgets": [],
+	"commission_payout_splits": [],
+	"continuous_funds": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
+	"denom_delegator_reward_indexes": [],
+	"denom_reward_indexes": [],
 	"fee_pool": {
 		"community_pool": []
 	},
 	"outstanding_rewards": [],
 	"params": {
+		"auto_compound_batch_size": "100",
+		"auto_compound_gas_limit": "20000000",
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
+		"lazy_reward_accounting": false,
+		"max_commission_payout_recipients": 10,
 		"withdraw_addr_enabled": true
 	},
 	"previous_proposer": "",
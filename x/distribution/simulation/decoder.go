@@ -63,6 +63,60 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.ContinuousFundPrefix):
+			var fundA, fundB types.ContinuousFund
+			cdc.MustUnmarshal(kvA.Value, &fundA)
+			cdc.MustUnmarshal(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+
+		case bytes.Equal(kvA.Key[:1], types.BudgetPrefix):
+			var budgetA, budgetB types.Budget
+			cdc.MustUnmarshal(kvA.Value, &budgetA)
+			cdc.MustUnmarshal(kvB.Value, &budgetB)
+			return fmt.Sprintf("%v\n%v", budgetA, budgetB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundPrefix):
+			delAddr, valAddr := types.GetAutoCompoundAddresses(kvA.Key)
+			return fmt.Sprintf("%v\n%v", delAddr, valAddr)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			delAddrA, valAddrA := types.GetAutoCompoundAddresses(kvA.Value)
+			delAddrB, valAddrB := types.GetAutoCompoundAddresses(kvB.Value)
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommissionPayoutSplitPrefix):
+			var splitA, splitB types.CommissionPayoutSplit
+			cdc.MustUnmarshal(kvA.Value, &splitA)
+			cdc.MustUnmarshal(kvB.Value, &splitB)
+			return fmt.Sprintf("%v\n%v", splitA, splitB)
+
+		case bytes.Equal(kvA.Key[:1], types.LazyRewardIndexKey):
+			var indexA, indexB types.LazyRewardIndex
+			cdc.MustUnmarshal(kvA.Value, &indexA)
+			cdc.MustUnmarshal(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorRewardIndexPrefix):
+			var indexA, indexB types.ValidatorRewardIndex
+			cdc.MustUnmarshal(kvA.Value, &indexA)
+			cdc.MustUnmarshal(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorRewardIndexStalePrefix):
+			return fmt.Sprintf("%v\n%v", types.GetValidatorRewardIndexAddress(kvA.Key), types.GetValidatorRewardIndexAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.DenomRewardIndexPrefix):
+			var indexA, indexB types.DenomRewardIndex
+			cdc.MustUnmarshal(kvA.Value, &indexA)
+			cdc.MustUnmarshal(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+
+		case bytes.Equal(kvA.Key[:1], types.DenomDelegatorRewardIndexPrefix):
+			var indexA, indexB types.DenomDelegatorRewardIndex
+			cdc.MustUnmarshal(kvA.Value, &indexA)
+			cdc.MustUnmarshal(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
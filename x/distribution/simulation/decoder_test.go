@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -40,6 +41,13 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, math.LegacyOneDec())
+	fund := types.NewContinuousFund(delAddr1, math.LegacyNewDecWithPrec(1, 1), nil, nil)
+	split := types.NewCommissionPayoutSplit(valAddr1, []types.CommissionPayoutRecipient{types.NewCommissionPayoutRecipient(delAddr1, math.LegacyOneDec())})
+	lazyRewardIndex := types.LazyRewardIndex{Index: decCoins, TotalPower: 10, Unsettled: decCoins}
+	validatorRewardIndex := types.ValidatorRewardIndex{Index: decCoins, Power: 10}
+	denomRewardIndex := types.DenomRewardIndex{ValidatorAddress: valAddr1.String(), Denom: "stake2", Index: decCoins}
+	denomDelegatorRewardIndex := types.DenomDelegatorRewardIndex{DelegatorAddress: delAddr1.String(), ValidatorAddress: valAddr1.String(), Denom: "stake2", Index: decCoins}
+	budget := types.NewBudget(delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), time.Unix(0, 0).UTC(), 4, time.Hour)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -52,6 +60,15 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetContinuousFundKey(delAddr1), Value: cdc.MustMarshal(&fund)},
+			{Key: types.GetBudgetKey(delAddr1), Value: cdc.MustMarshal(&budget)},
+			{Key: types.GetAutoCompoundKey(delAddr1, valAddr1), Value: []byte{}},
+			{Key: types.GetCommissionPayoutSplitKey(valAddr1), Value: cdc.MustMarshal(&split)},
+			{Key: types.LazyRewardIndexKey, Value: cdc.MustMarshal(&lazyRewardIndex)},
+			{Key: types.GetValidatorRewardIndexKey(valAddr1), Value: cdc.MustMarshal(&validatorRewardIndex)},
+			{Key: types.GetValidatorRewardIndexStaleKey(valAddr1), Value: []byte{}},
+			{Key: types.GetDenomRewardIndexKey(valAddr1, "stake2"), Value: cdc.MustMarshal(&denomRewardIndex)},
+			{Key: types.GetDenomDelegatorRewardIndexKey(valAddr1, delAddr1, "stake2"), Value: cdc.MustMarshal(&denomDelegatorRewardIndex)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -69,6 +86,15 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"ContinuousFund", fmt.Sprintf("%v\n%v", fund, fund)},
+		{"Budget", fmt.Sprintf("%v\n%v", budget, budget)},
+		{"AutoCompound", fmt.Sprintf("%v\n%v", delAddr1, valAddr1)},
+		{"CommissionPayoutSplit", fmt.Sprintf("%v\n%v", split, split)},
+		{"LazyRewardIndex", fmt.Sprintf("%v\n%v", lazyRewardIndex, lazyRewardIndex)},
+		{"ValidatorRewardIndex", fmt.Sprintf("%v\n%v", validatorRewardIndex, validatorRewardIndex)},
+		{"ValidatorRewardIndexStale", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
+		{"DenomRewardIndex", fmt.Sprintf("%v\n%v", denomRewardIndex, denomRewardIndex)},
+		{"DenomDelegatorRewardIndex", fmt.Sprintf("%v\n%v", denomDelegatorRewardIndex, denomDelegatorRewardIndex)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -7,6 +7,7 @@ package testutil
 import (
 	reflect "reflect"
 
+	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -220,6 +221,35 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// BondDenom mocks base method.
+func (m *MockStakingKeeper) BondDenom(ctx types.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BondDenom", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BondDenom indicates an expected call of BondDenom.
+func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
+}
+
+// Delegate mocks base method.
+func (m *MockStakingKeeper) Delegate(ctx types.Context, delAddr types.AccAddress, bondAmt math.Int, tokenSrc types1.BondStatus, validator types1.Validator, subtractAccount bool) (types.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegate", ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+	ret0, _ := ret[0].(types.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delegate indicates an expected call of Delegate.
+func (mr *MockStakingKeeperMockRecorder) Delegate(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegate", reflect.TypeOf((*MockStakingKeeper)(nil).Delegate), ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+}
+
 // Delegation mocks base method.
 func (m *MockStakingKeeper) Delegation(arg0 types.Context, arg1 types.AccAddress, arg2 types.ValAddress) types1.DelegationI {
 	m.ctrl.T.Helper()
@@ -234,6 +264,20 @@ func (mr *MockStakingKeeperMockRecorder) Delegation(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), arg0, arg1, arg2)
 }
 
+// EpochLength mocks base method.
+func (m *MockStakingKeeper) EpochLength(ctx types.Context) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpochLength", ctx)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// EpochLength indicates an expected call of EpochLength.
+func (mr *MockStakingKeeperMockRecorder) EpochLength(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpochLength", reflect.TypeOf((*MockStakingKeeper)(nil).EpochLength), ctx)
+}
+
 // GetAllDelegatorDelegations mocks base method.
 func (m *MockStakingKeeper) GetAllDelegatorDelegations(ctx types.Context, delegator types.AccAddress) []types1.Delegation {
 	m.ctrl.T.Helper()
@@ -276,6 +320,79 @@ func (mr *MockStakingKeeperMockRecorder) GetAllValidators(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidators", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllValidators), ctx)
 }
 
+// GetDenomDelegation mocks base method.
+func (m *MockStakingKeeper) GetDenomDelegation(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress, denom string) (types1.DenomDelegation, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomDelegation", ctx, delAddr, valAddr, denom)
+	ret0, _ := ret[0].(types1.DenomDelegation)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomDelegation indicates an expected call of GetDenomDelegation.
+func (mr *MockStakingKeeperMockRecorder) GetDenomDelegation(ctx, delAddr, valAddr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomDelegation", reflect.TypeOf((*MockStakingKeeper)(nil).GetDenomDelegation), ctx, delAddr, valAddr, denom)
+}
+
+// GetLastValidatorPower mocks base method.
+func (m *MockStakingKeeper) GetLastValidatorPower(ctx types.Context, operator types.ValAddress) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastValidatorPower", ctx, operator)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetLastValidatorPower indicates an expected call of GetLastValidatorPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastValidatorPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastValidatorPower), ctx, operator)
+}
+
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx types.Context, addr types.ValAddress) (types1.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// GetValidatorDenomStake mocks base method.
+func (m *MockStakingKeeper) GetValidatorDenomStake(ctx types.Context, valAddr types.ValAddress, denom string) (types1.ValidatorDenomStake, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorDenomStake", ctx, valAddr, denom)
+	ret0, _ := ret[0].(types1.ValidatorDenomStake)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidatorDenomStake indicates an expected call of GetValidatorDenomStake.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorDenomStake(ctx, valAddr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorDenomStake", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorDenomStake), ctx, valAddr, denom)
+}
+
+// GetValidatorDenomWeightedTokens mocks base method.
+func (m *MockStakingKeeper) GetValidatorDenomWeightedTokens(ctx types.Context, valAddr types.ValAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorDenomWeightedTokens", ctx, valAddr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetValidatorDenomWeightedTokens indicates an expected call of GetValidatorDenomWeightedTokens.
+func (mr *MockStakingKeeperMockRecorder) GetValidatorDenomWeightedTokens(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorDenomWeightedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidatorDenomWeightedTokens), ctx, valAddr)
+}
+
 // IterateDelegations mocks base method.
 func (m *MockStakingKeeper) IterateDelegations(ctx types.Context, delegator types.AccAddress, fn func(int64, types1.DelegationI) bool) {
 	m.ctrl.T.Helper()
@@ -288,6 +405,18 @@ func (mr *MockStakingKeeperMockRecorder) IterateDelegations(ctx, delegator, fn i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).IterateDelegations), ctx, delegator, fn)
 }
 
+// IterateLastValidatorPowers mocks base method.
+func (m *MockStakingKeeper) IterateLastValidatorPowers(ctx types.Context, handler func(types.ValAddress, int64) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateLastValidatorPowers", ctx, handler)
+}
+
+// IterateLastValidatorPowers indicates an expected call of IterateLastValidatorPowers.
+func (mr *MockStakingKeeperMockRecorder) IterateLastValidatorPowers(ctx, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateLastValidatorPowers", reflect.TypeOf((*MockStakingKeeper)(nil).IterateLastValidatorPowers), ctx, handler)
+}
+
 // IterateValidators mocks base method.
 func (m *MockStakingKeeper) IterateValidators(arg0 types.Context, arg1 func(int64, types1.ValidatorI) bool) {
 	m.ctrl.T.Helper()
@@ -300,6 +429,21 @@ func (mr *MockStakingKeeperMockRecorder) IterateValidators(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateValidators", reflect.TypeOf((*MockStakingKeeper)(nil).IterateValidators), arg0, arg1)
 }
 
+// QueueOperation mocks base method.
+func (m *MockStakingKeeper) QueueOperation(ctx types.Context, msg types.Msg) (types1.QueuedStakingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueOperation", ctx, msg)
+	ret0, _ := ret[0].(types1.QueuedStakingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueOperation indicates an expected call of QueueOperation.
+func (mr *MockStakingKeeperMockRecorder) QueueOperation(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueOperation", reflect.TypeOf((*MockStakingKeeper)(nil).QueueOperation), ctx, msg)
+}
+
 // Validator mocks base method.
 func (m *MockStakingKeeper) Validator(arg0 types.Context, arg1 types.ValAddress) types1.ValidatorI {
 	m.ctrl.T.Helper()
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBudget creates a new Budget instance with nothing claimed.
+//
+//nolint:interfacer
+func NewBudget(recipient sdk.AccAddress, totalBudget sdk.Coins, startTime time.Time, tranches uint64, period time.Duration) Budget {
+	return Budget{
+		Recipient:   recipient.String(),
+		TotalBudget: totalBudget,
+		Claimed:     sdk.NewCoins(),
+		StartTime:   startTime,
+		Tranches:    tranches,
+		Period:      period,
+	}
+}
+
+// UnlockedTranches returns the number of tranches of the budget unlocked at
+// the given time. The first tranche is unlocked at the start time, and the
+// following ones after each period.
+func (b Budget) UnlockedTranches(t time.Time) uint64 {
+	if t.Before(b.StartTime) {
+		return 0
+	}
+	if b.Period <= 0 {
+		return b.Tranches
+	}
+
+	unlocked := uint64(t.Sub(b.StartTime)/b.Period) + 1
+	if unlocked > b.Tranches {
+		return b.Tranches
+	}
+
+	return unlocked
+}
+
+// UnlockedAmount returns the amount of the budget unlocked at the given time.
+func (b Budget) UnlockedAmount(t time.Time) sdk.Coins {
+	unlocked := b.UnlockedTranches(t)
+	if unlocked == b.Tranches {
+		return b.TotalBudget
+	}
+
+	amount := sdk.NewCoins()
+	for _, coin := range b.TotalBudget {
+		tranchesAmount := coin.Amount.Mul(math.NewIntFromUint64(unlocked)).Quo(math.NewIntFromUint64(b.Tranches))
+		amount = amount.Add(sdk.NewCoin(coin.Denom, tranchesAmount))
+	}
+
+	return amount
+}
+
+// ClaimableAmount returns the unlocked amount of the budget which is not
+// claimed yet at the given time.
+func (b Budget) ClaimableAmount(t time.Time) sdk.Coins {
+	claimable, hasNeg := b.UnlockedAmount(t).SafeSub(b.Claimed...)
+	if hasNeg {
+		return sdk.NewCoins()
+	}
+
+	return claimable
+}
+
+// IsFullyClaimed returns true if the whole budget has been claimed.
+func (b Budget) IsFullyClaimed() bool {
+	return b.Claimed.IsAllGTE(b.TotalBudget)
+}
+
+// Validate performs a stateless validation of the budget.
+func (b Budget) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Recipient); err != nil {
+		return ErrInvalidBudget.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := validateBudgetSchedule(b.TotalBudget, b.Tranches, b.Period); err != nil {
+		return err
+	}
+	if err := b.Claimed.Validate(); err != nil {
+		return ErrInvalidBudget.Wrapf("invalid claimed amount: %s", err)
+	}
+	if !b.Claimed.Empty() && !b.Claimed.IsAllLTE(b.TotalBudget) {
+		return ErrInvalidBudget.Wrapf("claimed amount %s exceeds the total budget %s", b.Claimed, b.TotalBudget)
+	}
+
+	return nil
+}
+
+func validateBudgetSchedule(totalBudget sdk.Coins, tranches uint64, period time.Duration) error {
+	if totalBudget.Empty() {
+		return ErrInvalidBudget.Wrap("total budget cannot be empty")
+	}
+	if err := totalBudget.Validate(); err != nil {
+		return ErrInvalidBudget.Wrapf("invalid total budget: %s", err)
+	}
+	if tranches == 0 {
+		return ErrInvalidBudget.Wrap("tranches must be positive")
+	}
+	if tranches > 1 && period <= 0 {
+		return ErrInvalidBudget.Wrapf("period must be positive: %s", period)
+	}
+	if period < 0 {
+		return ErrInvalidBudget.Wrapf("period cannot be negative: %s", period)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBudgetClaimableAmount(t *testing.T) {
+	startTime := time.Unix(1_000_000, 0).UTC()
+	budget := NewBudget(delAddr1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), startTime, 3, time.Hour)
+	require.NoError(t, budget.Validate())
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount))
+	}
+
+	require.Equal(t, uint64(0), budget.UnlockedTranches(startTime.Add(-time.Second)))
+	require.True(t, budget.ClaimableAmount(startTime.Add(-time.Second)).IsZero())
+	require.Equal(t, coins(33), budget.ClaimableAmount(startTime))
+	require.Equal(t, coins(66), budget.ClaimableAmount(startTime.Add(time.Hour)))
+	require.Equal(t, coins(100), budget.ClaimableAmount(startTime.Add(2*time.Hour)))
+	require.Equal(t, coins(100), budget.ClaimableAmount(startTime.Add(100*time.Hour)))
+
+	budget.Claimed = coins(33)
+	require.True(t, budget.ClaimableAmount(startTime.Add(time.Minute)).IsZero())
+	require.Equal(t, coins(67), budget.ClaimableAmount(startTime.Add(2*time.Hour)))
+	require.False(t, budget.IsFullyClaimed())
+
+	budget.Claimed = coins(101)
+	require.Error(t, budget.Validate())
+}
@@ -23,6 +23,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "cosmos-sdk/MsgCreateContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "cosmos-sdk/MsgCancelContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitBudgetProposal{}, "cosmos-sdk/MsgSubmitBudgetProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimBudget{}, "cosmos-sdk/MsgClaimBudget")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCommissionPayoutSplit{}, "cosmos-sdk/MsgSetCommissionPayoutSplit")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawDenomReward{}, "cosmos-sdk/MsgWithdrawDenomReward")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -36,6 +43,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgFundCommunityPool{},
 		&MsgUpdateParams{},
 		&MsgCommunityPoolSpend{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
+		&MsgSubmitBudgetProposal{},
+		&MsgClaimBudget{},
+		&MsgSetAutoCompound{},
+		&MsgSetCommissionPayoutSplit{},
+		&MsgWithdrawDenomReward{},
 	)
 
 	registry.RegisterImplementations(
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCommissionPayoutSplit creates a new CommissionPayoutSplit instance
+//
+//nolint:interfacer
+func NewCommissionPayoutSplit(valAddr sdk.ValAddress, recipients []CommissionPayoutRecipient) CommissionPayoutSplit {
+	return CommissionPayoutSplit{
+		ValidatorAddress: valAddr.String(),
+		Recipients:       recipients,
+	}
+}
+
+// NewCommissionPayoutRecipient creates a new CommissionPayoutRecipient instance
+//
+//nolint:interfacer
+func NewCommissionPayoutRecipient(addr sdk.AccAddress, weight sdk.Dec) CommissionPayoutRecipient {
+	return CommissionPayoutRecipient{
+		Address: addr.String(),
+		Weight:  weight,
+	}
+}
+
+// Validate performs a stateless validation of the commission payout split.
+func (s CommissionPayoutSplit) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(s.ValidatorAddress); err != nil {
+		return ErrInvalidCommissionPayoutSplit.Wrapf("invalid validator address: %s", err)
+	}
+
+	return validateCommissionPayoutRecipients(s.Recipients)
+}
+
+// Split splits an amount of commission between the recipients by weight,
+// truncating the share of each recipient. The remainder of the truncation is
+// returned separately.
+func (s CommissionPayoutSplit) Split(amount sdk.Coins) (payouts []sdk.Coins, remainder sdk.Coins) {
+	remainder = amount
+	payouts = make([]sdk.Coins, len(s.Recipients))
+	for i, recipient := range s.Recipients {
+		payout := sdk.NewCoins()
+		for _, coin := range amount {
+			share := math.LegacyNewDecFromInt(coin.Amount).Mul(recipient.Weight).TruncateInt()
+			payout = payout.Add(sdk.NewCoin(coin.Denom, share))
+		}
+		payouts[i] = payout
+		remainder = remainder.Sub(payout...)
+	}
+
+	return payouts, remainder
+}
+
+func validateCommissionPayoutRecipients(recipients []CommissionPayoutRecipient) error {
+	if len(recipients) == 0 {
+		return ErrInvalidCommissionPayoutSplit.Wrap("recipients cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	totalWeight := math.LegacyZeroDec()
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return ErrInvalidCommissionPayoutSplit.Wrapf("invalid recipient address: %s", err)
+		}
+		if seen[recipient.Address] {
+			return ErrInvalidCommissionPayoutSplit.Wrapf("duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return ErrInvalidCommissionPayoutSplit.Wrapf("weight of recipient %s must be positive", recipient.Address)
+		}
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(math.LegacyOneDec()) {
+		return ErrInvalidCommissionPayoutSplit.Wrapf("weights of the recipients must sum to 1: %s", totalWeight)
+	}
+
+	return nil
+}
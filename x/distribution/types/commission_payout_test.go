@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCommissionPayoutSplit(t *testing.T) {
+	split := NewCommissionPayoutSplit(valAddr1, []CommissionPayoutRecipient{
+		NewCommissionPayoutRecipient(delAddr1, sdk.NewDecWithPrec(7, 1)),
+		NewCommissionPayoutRecipient(delAddr2, sdk.NewDecWithPrec(3, 1)),
+	})
+	require.NoError(t, split.Validate())
+
+	commission := sdk.NewCoins(sdk.NewInt64Coin("uatom", 101), sdk.NewInt64Coin("uosmo", 9))
+	payouts, remainder := split.Split(commission)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 70), sdk.NewInt64Coin("uosmo", 6)),
+		sdk.NewCoins(sdk.NewInt64Coin("uatom", 30), sdk.NewInt64Coin("uosmo", 2)),
+	}, payouts)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("uosmo", 1)), remainder)
+
+	split.Recipients[1].Weight = sdk.NewDecWithPrec(2, 1)
+	require.Error(t, split.Validate())
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewContinuousFund creates a new ContinuousFund instance. Exactly one of the
+// percentage of the community pool inflow or the amount per block must be set.
+//
+//nolint:interfacer
+func NewContinuousFund(recipient sdk.AccAddress, percentage sdk.Dec, amountPerBlock sdk.Coins, expiry *time.Time) ContinuousFund {
+	return ContinuousFund{
+		Recipient:      recipient.String(),
+		Percentage:     percentage,
+		AmountPerBlock: amountPerBlock,
+		Expiry:         expiry,
+	}
+}
+
+// IsPercentage returns true if the fund is a percentage of the community pool
+// inflow, rather than a fixed amount per block.
+func (cf ContinuousFund) IsPercentage() bool {
+	return !cf.Percentage.IsNil() && !cf.Percentage.IsZero()
+}
+
+// IsExpired returns true if the fund has expired at the given time.
+func (cf ContinuousFund) IsExpired(t time.Time) bool {
+	return cf.Expiry != nil && !cf.Expiry.After(t)
+}
+
+// Validate performs a stateless validation of the continuous fund.
+func (cf ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cf.Recipient); err != nil {
+		return ErrInvalidContinuousFund.Wrapf("invalid recipient address: %s", err)
+	}
+
+	hasAmount := !cf.AmountPerBlock.Empty()
+	if cf.IsPercentage() == hasAmount {
+		return ErrInvalidContinuousFund.Wrap("exactly one of percentage or amount per block must be set")
+	}
+
+	if cf.IsPercentage() {
+		if cf.Percentage.IsNegative() || cf.Percentage.GT(math.LegacyOneDec()) {
+			return ErrInvalidContinuousFund.Wrapf("percentage must be between 0 and 1: %s", cf.Percentage)
+		}
+		return nil
+	}
+
+	if err := cf.AmountPerBlock.Validate(); err != nil {
+		return ErrInvalidContinuousFund.Wrapf("invalid amount per block: %s", err)
+	}
+
+	return nil
+}
@@ -9,7 +9,25 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeCreateContinuousFund  = "create_continuous_fund"
+	EventTypeCancelContinuousFund  = "cancel_continuous_fund"
+	EventTypeContinuousFundPayout  = "continuous_fund_payout"
+	EventTypeContinuousFundExpired = "continuous_fund_expired"
+	EventTypeSubmitBudget          = "submit_budget"
+	EventTypeClaimBudget           = "claim_budget"
+
+	EventTypeSetAutoCompound = "set_auto_compound"
+	EventTypeAutoCompound    = "auto_compound"
+
+	EventTypeSetCommissionPayoutSplit = "set_commission_payout_split"
+	EventTypeCommissionPayout         = "commission_payout"
+
+	EventTypeWithdrawDenomRewards = "withdraw_denom_rewards"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyDenom           = "denom"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -49,6 +51,22 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
 	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
 	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) (power int64)
+	IterateLastValidatorPowers(ctx sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool))
+
+	GetValidatorDenomStake(ctx sdk.Context, valAddr sdk.ValAddress, denom string) (stake stakingtypes.ValidatorDenomStake, found bool)
+	GetValidatorDenomWeightedTokens(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Coins
+	GetDenomDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (delegation stakingtypes.DenomDelegation, found bool)
+
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	Delegate(
+		ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool,
+	) (newShares sdk.Dec, err error)
+	EpochLength(ctx sdk.Context) uint64
+	QueueOperation(ctx sdk.Context, msg sdk.Msg) (stakingtypes.QueuedStakingOperation, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +41,12 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		ContinuousFunds:                 []ContinuousFund{},
+		Budgets:                         []Budget{},
+		AutoCompoundDelegations:         []AutoCompoundDelegation{},
+		CommissionPayoutSplits:          []CommissionPayoutSplit{},
+		DenomRewardIndexes:              []DenomRewardIndex{},
+		DenomDelegatorRewardIndexes:     []DenomDelegatorRewardIndex{},
 	}
 }
 
@@ -45,5 +55,124 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := validateContinuousFunds(gs.ContinuousFunds); err != nil {
+		return err
+	}
+	if err := validateBudgets(gs.Budgets); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundDelegations(gs.AutoCompoundDelegations); err != nil {
+		return err
+	}
+	if err := validateCommissionPayoutSplits(gs.CommissionPayoutSplits, gs.Params.MaxCommissionPayoutRecipients); err != nil {
+		return err
+	}
+	if err := validateDenomRewardIndexes(gs.DenomRewardIndexes, gs.DenomDelegatorRewardIndexes); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+func validateContinuousFunds(funds []ContinuousFund) error {
+	recipients := make(map[string]bool, len(funds))
+	totalPercentage := math.LegacyZeroDec()
+	for _, fund := range funds {
+		if recipients[fund.Recipient] {
+			return fmt.Errorf("duplicate continuous fund for recipient %s", fund.Recipient)
+		}
+		recipients[fund.Recipient] = true
+
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+		if fund.IsPercentage() {
+			totalPercentage = totalPercentage.Add(fund.Percentage)
+		}
+	}
+
+	if totalPercentage.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("total percentage of the continuous funds exceeds 1: %s", totalPercentage)
+	}
+
+	return nil
+}
+
+func validateBudgets(budgets []Budget) error {
+	recipients := make(map[string]bool, len(budgets))
+	for _, budget := range budgets {
+		if recipients[budget.Recipient] {
+			return fmt.Errorf("duplicate budget for recipient %s", budget.Recipient)
+		}
+		recipients[budget.Recipient] = true
+
+		if err := budget.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateAutoCompoundDelegations(delegations []AutoCompoundDelegation) error {
+	seen := make(map[AutoCompoundDelegation]bool, len(delegations))
+	for _, delegation := range delegations {
+		if seen[delegation] {
+			return fmt.Errorf("duplicate auto-compounding delegation of %s to %s", delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+		seen[delegation] = true
+
+		if _, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-compounding delegator address: %w", err)
+		}
+		if _, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid auto-compounding validator address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateCommissionPayoutSplits(splits []CommissionPayoutSplit, maxRecipients uint32) error {
+	validators := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if validators[split.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission payout split for validator %s", split.ValidatorAddress)
+		}
+		validators[split.ValidatorAddress] = true
+
+		if err := split.Validate(); err != nil {
+			return err
+		}
+		if len(split.Recipients) > int(maxRecipients) {
+			return fmt.Errorf("commission payout split of validator %s exceeds the max number of recipients %d", split.ValidatorAddress, maxRecipients)
+		}
+	}
+
+	return nil
+}
+
+func validateDenomRewardIndexes(indexes []DenomRewardIndex, delegatorIndexes []DenomDelegatorRewardIndex) error {
+	stakes := make(map[string]bool, len(indexes))
+	for _, index := range indexes {
+		key := index.ValidatorAddress + "/" + index.Denom
+		if stakes[key] {
+			return fmt.Errorf("duplicate %s reward index for validator %s", index.Denom, index.ValidatorAddress)
+		}
+		stakes[key] = true
+
+		if err := index.Index.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, index := range delegatorIndexes {
+		if !stakes[index.ValidatorAddress+"/"+index.Denom] {
+			return fmt.Errorf("%s reward index of delegator %s refers to a missing validator reward index", index.Denom, index.DelegatorAddress)
+		}
+		if err := index.Index.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -348,6 +348,18 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// continuous_funds defines the continuous funds from the community pool at genesis.
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,11,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// budgets defines the budgets from the community pool at genesis.
+	Budgets []Budget `protobuf:"bytes,12,rep,name=budgets,proto3" json:"budgets"`
+	// auto_compound_delegations defines the auto-compounding delegations at genesis.
+	AutoCompoundDelegations []AutoCompoundDelegation `protobuf:"bytes,13,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
+	// commission_payout_splits defines the commission payout splits of the validators at genesis.
+	CommissionPayoutSplits []CommissionPayoutSplit `protobuf:"bytes,14,rep,name=commission_payout_splits,json=commissionPayoutSplits,proto3" json:"commission_payout_splits"`
+	// denom_reward_indexes defines the reward indexes of the additional bond denom stakes at genesis.
+	DenomRewardIndexes []DenomRewardIndex `protobuf:"bytes,15,rep,name=denom_reward_indexes,json=denomRewardIndexes,proto3" json:"denom_reward_indexes"`
+	// denom_delegator_reward_indexes defines the reward indexes of the additional bond denom delegations at genesis.
+	DenomDelegatorRewardIndexes []DenomDelegatorRewardIndex `protobuf:"bytes,16,rep,name=denom_delegator_reward_indexes,json=denomDelegatorRewardIndexes,proto3" json:"denom_delegator_reward_indexes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,65 +411,77 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 921 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x25, 0x4d, 0xc6, 0x45, 0xb4, 0xdb, 0x34, 0x6c, 0xd2, 0xb2, 0x4e, 0x4b, 0x0f,
-	0x05, 0xd4, 0x35, 0x31, 0x08, 0xaa, 0x22, 0x90, 0x62, 0xb7, 0xe5, 0xe3, 0xd2, 0xc8, 0x96, 0x40,
-	0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x5e, 0x8f, 0xb0, 0x77, 0xac, 0x99, 0xf1, 0x1a, 0x90, 0x38, 0x70,
-	0x02, 0x21, 0x90, 0x38, 0xc2, 0xad, 0xc7, 0x0a, 0x09, 0x89, 0x03, 0x7f, 0x44, 0x25, 0x2e, 0x15,
-	0x27, 0x4e, 0x7c, 0x38, 0x07, 0xe0, 0x9f, 0x40, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xda, 0xed, 0xd6,
-	0x69, 0x93, 0x4b, 0xe2, 0x9d, 0x79, 0x1f, 0xbf, 0xdf, 0x7b, 0x3f, 0xbd, 0x37, 0xf0, 0x39, 0x8f,
-	0xf2, 0x31, 0xe5, 0x0d, 0x9f, 0x70, 0xc1, 0x48, 0x7f, 0x2a, 0x08, 0x0d, 0x1b, 0xd1, 0x6e, 0x1f,
-	0x0b, 0xb4, 0xdb, 0x08, 0x70, 0x88, 0x39, 0xe1, 0xee, 0x84, 0x51, 0x41, 0xad, 0xf3, 0xca, 0xd4,
-	0x35, 0x4d, 0x5d, 0x6d, 0xba, 0xbd, 0x11, 0xd0, 0x80, 0x4a, 0xbb, 0x46, 0xfc, 0x4b, 0xb9, 0x6c,
-	0x3b, 0x3a, 0x7a, 0x1f, 0x71, 0x9c, 0x46, 0xf5, 0x28, 0x09, 0xf5, 0xbd, 0x5b, 0x96, 0x7d, 0x21,
-	0x8f, 0xb2, 0xdf, 0x52, 0xf6, 0x3d, 0x95, 0x48, 0xe3, 0x51, 0x57, 0x67, 0xd0, 0x98, 0x84, 0xb4,
-	0x21, 0xff, 0xaa, 0xa3, 0x4b, 0x3f, 0x02, 0x78, 0xee, 0x06, 0x1e, 0xe1, 0x00, 0x09, 0xca, 0xde,
-	0x23, 0x62, 0xe8, 0x33, 0x34, 0x7b, 0x3b, 0x1c, 0x50, 0xeb, 0x26, 0x3c, 0xe3, 0x27, 0x17, 0x3d,
-	0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x83, 0x1d, 0x70, 0x65, 0xbd, 0x65, 0xff, 0xfa, 0xf3, 0xd5, 0x0d,
-	0x1d, 0x79, 0x4f, 0xdd, 0x74, 0x05, 0x23, 0x61, 0xd0, 0x39, 0x9d, 0xba, 0xe8, 0x73, 0xab, 0x0d,
-	0x4f, 0xcf, 0x74, 0xd8, 0x34, 0x4a, 0xf5, 0x21, 0x51, 0x9e, 0x4a, 0x3c, 0xf4, 0xf1, 0xf5, 0xb5,
-	0x2f, 0xef, 0xd4, 0x2b, 0xff, 0xdc, 0xa9, 0x57, 0x2e, 0xfd, 0x07, 0xe0, 0xc5, 0x77, 0xd1, 0x88,
-	0xf8, 0x71, 0x8e, 0xdb, 0x53, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0xcf, 0x10, 0xf3, 0x79, 0x07,
-	0x7b, 0x94, 0xf9, 0x31, 0xf6, 0x28, 0x31, 0x5a, 0x1e, 0x7b, 0xea, 0x92, 0x60, 0xff, 0x02, 0xc0,
-	0xb3, 0x34, 0xcb, 0xd1, 0x63, 0x2a, 0x89, 0x5d, 0xdd, 0x59, 0xb9, 0x52, 0x6b, 0x5e, 0xd0, 0x9d,
-	0x71, 0xe3, 0xce, 0x25, 0x4d, 0x76, 0x6f, 0x60, 0xaf, 0x4d, 0x49, 0xd8, 0xba, 0x76, 0xef, 0xf7,
-	0x7a, 0xe5, 0x87, 0x3f, 0xea, 0x2f, 0x04, 0x44, 0x0c, 0xa7, 0x7d, 0xd7, 0xa3, 0x63, 0xdd, 0x0c,
-	0xfd, 0xef, 0x2a, 0xf7, 0x3f, 0x6a, 0x88, 0x4f, 0x26, 0x98, 0x27, 0x3e, 0xfc, 0xee, 0xdf, 0x3f,
-	0x3d, 0x0f, 0x3a, 0x16, 0xcd, 0xd1, 0x32, 0x0a, 0xf0, 0x17, 0x80, 0x97, 0xd3, 0x02, 0xec, 0x79,
-	0xde, 0x74, 0x3c, 0x1d, 0x21, 0x81, 0xfd, 0x36, 0x1d, 0x8f, 0x09, 0xe7, 0x84, 0x86, 0x47, 0x5b,
-	0x83, 0x21, 0xac, 0xa1, 0x2c, 0x8b, 0x6c, 0x5d, 0xad, 0xf9, 0x9a, 0x5b, 0xa2, 0x73, 0xb7, 0x1c,
-	0x5e, 0x6b, 0x3d, 0xae, 0x8c, 0xa2, 0x6a, 0x86, 0x36, 0x38, 0xfe, 0x0b, 0xe0, 0x4e, 0x1a, 0xe4,
-	0x2d, 0xc2, 0x05, 0x65, 0xc4, 0x43, 0xa3, 0x63, 0xe9, 0xf1, 0x26, 0x5c, 0x9d, 0x60, 0x46, 0xa8,
-	0xa2, 0x76, 0xa2, 0xa3, 0xbf, 0xac, 0x0f, 0xe1, 0xc9, 0xa4, 0xdd, 0x2b, 0x92, 0xf3, 0xab, 0xcb,
-	0x71, 0xce, 0xc1, 0x35, 0xf9, 0x26, 0x21, 0x0d, 0xae, 0xbf, 0x00, 0xf8, 0x4c, 0xea, 0xdc, 0x9e,
-	0x32, 0x86, 0x43, 0x71, 0x2c, 0x44, 0xdf, 0xcf, 0x08, 0xa9, 0x26, 0xbe, 0xbc, 0x1c, 0xa1, 0x45,
-	0x4c, 0x0f, 0x61, 0xf3, 0x7d, 0x15, 0x9e, 0x4f, 0xc7, 0x49, 0x57, 0x20, 0x26, 0x48, 0x18, 0xc4,
-	0xe3, 0x24, 0xe3, 0x72, 0x14, 0x43, 0xa5, 0xb0, 0x24, 0xd5, 0x43, 0x97, 0xa4, 0x0f, 0x9f, 0xe4,
-	0x1a, 0x63, 0x8f, 0x84, 0x03, 0xaa, 0x3b, 0xdd, 0x2c, 0x2d, 0x4c, 0x21, 0x3d, 0xb3, 0x2c, 0xa7,
-	0xb8, 0x71, 0x61, 0xd4, 0xe6, 0x9b, 0x2a, 0xdc, 0x4a, 0xab, 0xda, 0x1d, 0x21, 0x3e, 0xbc, 0x19,
-	0xc9, 0xc2, 0x1e, 0xb1, 0x9c, 0x87, 0x98, 0x04, 0x43, 0x91, 0xc8, 0x59, 0x7d, 0x19, 0x32, 0x5f,
-	0x59, 0x90, 0x39, 0x85, 0xe7, 0xb2, 0xb4, 0x3c, 0x06, 0xd5, 0xc3, 0x31, 0x2a, 0xfb, 0x84, 0x2c,
-	0xc5, 0x8b, 0xcb, 0x69, 0x24, 0x63, 0x63, 0x16, 0xe2, 0x6c, 0x94, 0xbf, 0x37, 0xea, 0xf1, 0xf5,
-	0x3a, 0x3c, 0xf5, 0xa6, 0xda, 0x9e, 0x5d, 0x81, 0x04, 0xb6, 0x6e, 0xc1, 0xd5, 0x09, 0x62, 0x68,
-	0xac, 0x78, 0xd7, 0x9a, 0xcf, 0x96, 0x26, 0xdf, 0x97, 0xa6, 0x66, 0x3e, 0xed, 0x6d, 0xbd, 0x03,
-	0xd7, 0x06, 0x18, 0xf7, 0x26, 0x94, 0x8e, 0xb4, 0xd4, 0x2f, 0x97, 0x46, 0xba, 0x85, 0xf1, 0x3e,
-	0xa5, 0xa3, 0x05, 0x69, 0x0f, 0xd4, 0x99, 0x35, 0x83, 0x76, 0x26, 0xd8, 0x74, 0x91, 0xc5, 0x62,
-	0x89, 0xe7, 0xc2, 0xca, 0xf2, 0x6a, 0x31, 0x77, 0xab, 0x99, 0x69, 0xd3, 0x2f, 0xb2, 0x90, 0x12,
-	0x9f, 0x30, 0x1c, 0x11, 0x3a, 0x95, 0xab, 0x7c, 0x42, 0x39, 0x66, 0xb2, 0x29, 0xa5, 0x7a, 0x48,
-	0x5c, 0xf6, 0xb5, 0x87, 0xf5, 0x69, 0xf1, 0x06, 0x7b, 0x42, 0x42, 0x7f, 0x63, 0xb9, 0xee, 0x3e,
-	0x68, 0xcd, 0x9a, 0x34, 0x0a, 0x96, 0x96, 0xf5, 0x1d, 0x80, 0x17, 0x0d, 0x4d, 0x67, 0xa3, 0xbe,
-	0xe7, 0xa5, 0xdb, 0x80, 0xdb, 0xab, 0x12, 0xca, 0xde, 0x63, 0x6c, 0x94, 0x3c, 0x9a, 0x7a, 0x54,
-	0xea, 0xc0, 0xad, 0xaf, 0x00, 0xbc, 0x90, 0x41, 0x1b, 0xa6, 0x33, 0x3b, 0x2d, 0xd0, 0x49, 0x89,
-	0xea, 0xf5, 0x47, 0x9c, 0xf9, 0x79, 0x44, 0xdb, 0xd1, 0x03, 0x8d, 0xad, 0xcf, 0x01, 0xdc, 0xca,
-	0xc0, 0x78, 0x6a, 0xde, 0xa6, 0x48, 0xd6, 0x24, 0x92, 0xeb, 0x8f, 0x32, 0xac, 0xf3, 0x30, 0x9e,
-	0x8e, 0x8a, 0x2d, 0xad, 0xcf, 0x4c, 0x9d, 0x2f, 0x0c, 0x45, 0x6e, 0xaf, 0x4b, 0x04, 0xd7, 0x0e,
-	0x3f, 0x15, 0xf3, 0xf9, 0x33, 0xb5, 0x9b, 0x76, 0xdc, 0x9a, 0xc1, 0xcd, 0xc2, 0x31, 0xc4, 0x6d,
-	0x28, 0x93, 0xbf, 0x72, 0xd8, 0x39, 0x94, 0x4f, 0xbd, 0x51, 0x30, 0x8d, 0x8c, 0xd5, 0xd5, 0xba,
-	0x7d, 0x77, 0xee, 0x80, 0x7b, 0x73, 0x07, 0xdc, 0x9f, 0x3b, 0xe0, 0xcf, 0xb9, 0x03, 0xbe, 0x3d,
-	0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x83, 0xdd, 0xd2, 0x67, 0xdc, 0xc7,
-	0x8b, 0xaf, 0x73, 0xf9, 0xaa, 0xeb, 0xaf, 0xca, 0x17, 0xf6, 0x4b, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x88, 0x91, 0xf2, 0x3f, 0x0c, 0x00, 0x00,
+	// 1109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x25, 0x3f, 0xc6, 0x69, 0x93, 0x6e, 0xd3, 0xb0, 0x49, 0x8a, 0x9d, 0x96, 0x1e,
+	0x0a, 0x55, 0x6c, 0x92, 0x22, 0xa8, 0x8a, 0x40, 0x8a, 0x9d, 0x86, 0x96, 0x4b, 0xa3, 0x58, 0x02,
+	0x81, 0x90, 0x56, 0xe3, 0x9d, 0xb1, 0x3d, 0x60, 0xcf, 0xac, 0x76, 0x66, 0xed, 0xb6, 0x12, 0x07,
+	0x2e, 0x80, 0x90, 0x90, 0x38, 0xc2, 0xad, 0x12, 0x97, 0x0a, 0x09, 0x89, 0x03, 0x7f, 0x44, 0x25,
+	0x2e, 0x15, 0x27, 0x4e, 0xfc, 0x48, 0x0e, 0xc0, 0x3f, 0x81, 0xd0, 0xce, 0xce, 0xee, 0xce, 0xd6,
+	0x9b, 0xb5, 0x53, 0xd2, 0x4b, 0x92, 0xdd, 0x79, 0xef, 0x7d, 0xdf, 0x7b, 0xef, 0x9b, 0xf7, 0xb2,
+	0xe0, 0x25, 0x87, 0xf1, 0x3e, 0xe3, 0x35, 0x44, 0xb8, 0xf0, 0x48, 0xcb, 0x17, 0x84, 0xd1, 0xda,
+	0x60, 0xb3, 0x85, 0x05, 0xdc, 0xac, 0x75, 0x30, 0xc5, 0x9c, 0xf0, 0xaa, 0xeb, 0x31, 0xc1, 0xcc,
+	0xb5, 0xd0, 0xb4, 0xaa, 0x9b, 0x56, 0x95, 0xe9, 0xea, 0x52, 0x87, 0x75, 0x98, 0xb4, 0xab, 0x05,
+	0x7f, 0x85, 0x2e, 0xab, 0x65, 0x15, 0xbd, 0x05, 0x39, 0x8e, 0xa3, 0x3a, 0x8c, 0x50, 0x75, 0x5e,
+	0xcd, 0x43, 0x4f, 0xe1, 0x84, 0xf6, 0x2b, 0xa1, 0xbd, 0x1d, 0x02, 0x29, 0x3e, 0xe1, 0xd1, 0x59,
+	0xd8, 0x27, 0x94, 0xd5, 0xe4, 0xcf, 0xf0, 0xd5, 0xa5, 0x1f, 0x0c, 0x70, 0x7e, 0x07, 0xf7, 0x70,
+	0x07, 0x0a, 0xe6, 0xbd, 0x47, 0x44, 0x17, 0x79, 0x70, 0x78, 0x9b, 0xb6, 0x99, 0x79, 0x13, 0x9c,
+	0x45, 0xd1, 0x81, 0x0d, 0x11, 0xf2, 0x30, 0xe7, 0x96, 0xb1, 0x6e, 0x5c, 0x99, 0xab, 0x5b, 0xbf,
+	0xfc, 0xb4, 0xb1, 0xa4, 0x22, 0x6f, 0x87, 0x27, 0x4d, 0xe1, 0x11, 0xda, 0xd9, 0x5f, 0x8c, 0x5d,
+	0xd4, 0x7b, 0xb3, 0x01, 0x16, 0x87, 0x2a, 0x6c, 0x1c, 0x65, 0x6a, 0x4c, 0x94, 0x85, 0xc8, 0x43,
+	0xbd, 0xbe, 0x31, 0xfb, 0xc5, 0x83, 0x4a, 0xe1, 0xef, 0x07, 0x95, 0xc2, 0xa5, 0x7f, 0x0d, 0x70,
+	0xf1, 0x5d, 0xd8, 0x23, 0x28, 0xc0, 0xb8, 0xe3, 0x0b, 0x2e, 0x20, 0x45, 0x81, 0x0f, 0x1e, 0x42,
+	0x0f, 0xf1, 0x7d, 0xec, 0x30, 0x0f, 0x05, 0xdc, 0x07, 0x91, 0xd1, 0xe4, 0xdc, 0x63, 0x97, 0x88,
+	0xfb, 0xe7, 0x06, 0x38, 0xc7, 0x12, 0x0c, 0xdb, 0x0b, 0x41, 0xac, 0xa9, 0xf5, 0xe2, 0x95, 0xd2,
+	0xd6, 0x05, 0xd5, 0x99, 0x6a, 0xd0, 0xb9, 0xa8, 0xc9, 0xd5, 0x1d, 0xec, 0x34, 0x18, 0xa1, 0xf5,
+	0xeb, 0x8f, 0x7e, 0xab, 0x14, 0xbe, 0xff, 0xbd, 0x72, 0xb5, 0x43, 0x44, 0xd7, 0x6f, 0x55, 0x1d,
+	0xd6, 0x57, 0xcd, 0x50, 0xbf, 0x36, 0x38, 0xfa, 0xb8, 0x26, 0xee, 0xb9, 0x98, 0x47, 0x3e, 0xfc,
+	0xe1, 0x5f, 0x3f, 0xbe, 0x6c, 0xec, 0x9b, 0x6c, 0x24, 0x2d, 0xad, 0x00, 0x7f, 0x1a, 0xe0, 0x72,
+	0x5c, 0x80, 0x6d, 0xc7, 0xf1, 0xfb, 0x7e, 0x0f, 0x0a, 0x8c, 0x1a, 0xac, 0xdf, 0x27, 0x9c, 0x13,
+	0x46, 0x4f, 0xb6, 0x06, 0x5d, 0x50, 0x82, 0x09, 0x8a, 0x6c, 0x5d, 0x69, 0xeb, 0x8d, 0x6a, 0x8e,
+	0xce, 0xab, 0xf9, 0xf4, 0xea, 0x73, 0x41, 0x65, 0xc2, 0x54, 0xf5, 0xd0, 0x5a, 0x8e, 0xff, 0x18,
+	0x60, 0x3d, 0x0e, 0x72, 0x8b, 0x70, 0xc1, 0x3c, 0xe2, 0xc0, 0xde, 0x33, 0xe9, 0xf1, 0x32, 0x98,
+	0x76, 0xb1, 0x47, 0x58, 0x98, 0xda, 0xa9, 0x7d, 0xf5, 0x64, 0x7e, 0x08, 0x66, 0xa2, 0x76, 0x17,
+	0x65, 0xce, 0xaf, 0x4f, 0x96, 0xf3, 0x08, 0x5d, 0x3d, 0xdf, 0x28, 0xa4, 0x96, 0xeb, 0xcf, 0x06,
+	0x78, 0x21, 0x76, 0x6e, 0xf8, 0x9e, 0x87, 0xa9, 0x78, 0x26, 0x89, 0xbe, 0x9f, 0x24, 0x14, 0x36,
+	0xf1, 0xd5, 0xc9, 0x12, 0x4a, 0x73, 0x1a, 0x93, 0xcd, 0xb7, 0x53, 0x60, 0x2d, 0x1e, 0x27, 0x4d,
+	0x01, 0x3d, 0x41, 0x68, 0x27, 0x18, 0x27, 0x49, 0x2e, 0x27, 0x31, 0x54, 0x32, 0x4b, 0x32, 0x75,
+	0xec, 0x92, 0xb4, 0xc0, 0x69, 0xae, 0x38, 0xda, 0x84, 0xb6, 0x99, 0xea, 0xf4, 0x56, 0x6e, 0x61,
+	0x32, 0xd3, 0xd3, 0xcb, 0x32, 0xcf, 0xb5, 0x03, 0xad, 0x36, 0x5f, 0x4d, 0x81, 0x95, 0xb8, 0xaa,
+	0xcd, 0x1e, 0xe4, 0xdd, 0x9b, 0x03, 0x59, 0xd8, 0x13, 0x96, 0x73, 0x17, 0x93, 0x4e, 0x57, 0x44,
+	0x72, 0x0e, 0x9f, 0x34, 0x99, 0x17, 0x53, 0x32, 0x67, 0xe0, 0x7c, 0x02, 0xcb, 0x03, 0x52, 0x36,
+	0x0e, 0x58, 0x59, 0xa7, 0x64, 0x29, 0x5e, 0x99, 0x4c, 0x23, 0x49, 0x36, 0x7a, 0x21, 0xce, 0x0d,
+	0x46, 0xcf, 0xb5, 0x7a, 0x7c, 0x77, 0x06, 0xcc, 0xbf, 0x1d, 0x6e, 0xcf, 0xa6, 0x80, 0x02, 0x9b,
+	0xbb, 0x60, 0xda, 0x85, 0x1e, 0xec, 0x87, 0x79, 0x97, 0xb6, 0x5e, 0xcc, 0x05, 0xdf, 0x93, 0xa6,
+	0x3a, 0x9e, 0xf2, 0x36, 0xdf, 0x01, 0xb3, 0x6d, 0x8c, 0x6d, 0x97, 0xb1, 0x9e, 0x92, 0xfa, 0xe5,
+	0xdc, 0x48, 0xbb, 0x18, 0xef, 0x31, 0xd6, 0x4b, 0x49, 0xbb, 0x1d, 0xbe, 0x33, 0x87, 0xc0, 0x4a,
+	0x04, 0x1b, 0x2f, 0xb2, 0x40, 0x2c, 0xc1, 0x5c, 0x28, 0x4e, 0xae, 0x16, 0x7d, 0xb7, 0xea, 0x48,
+	0xcb, 0x28, 0xcb, 0x42, 0x4a, 0xdc, 0xf5, 0xf0, 0x80, 0x30, 0x5f, 0xae, 0x72, 0x97, 0x71, 0xec,
+	0x59, 0xa7, 0xc6, 0xe9, 0x21, 0x72, 0xd9, 0x53, 0x1e, 0xe6, 0xfd, 0xec, 0x0d, 0xf6, 0x9c, 0xa4,
+	0xfe, 0xd6, 0x64, 0xdd, 0x3d, 0x6a, 0xcd, 0xea, 0x69, 0x64, 0x2c, 0x2d, 0xf3, 0x1b, 0x03, 0x5c,
+	0xd4, 0x34, 0x9d, 0x8c, 0x7a, 0xdb, 0x89, 0xb7, 0x01, 0xb7, 0xa6, 0x25, 0x95, 0xed, 0xff, 0xb1,
+	0x51, 0x46, 0xd9, 0x54, 0x06, 0xb9, 0x0e, 0xdc, 0xfc, 0xd2, 0x00, 0x17, 0x12, 0x6a, 0xdd, 0x78,
+	0x66, 0xc7, 0x05, 0x9a, 0x91, 0xac, 0xde, 0x7c, 0xca, 0x99, 0x3f, 0xca, 0x68, 0x75, 0x70, 0xa4,
+	0xb1, 0xf9, 0xa9, 0x01, 0x56, 0x12, 0x32, 0x4e, 0x38, 0x6f, 0x63, 0x26, 0xb3, 0x92, 0xc9, 0x8d,
+	0xa7, 0x19, 0xd6, 0xa3, 0x34, 0x9e, 0x1f, 0x64, 0x5b, 0x9a, 0x9f, 0xe8, 0x3a, 0x4f, 0x0d, 0x45,
+	0x6e, 0xcd, 0x49, 0x06, 0xd7, 0x8f, 0x3f, 0x15, 0x47, 0xf1, 0x97, 0x51, 0x96, 0x1d, 0x37, 0x87,
+	0x60, 0x39, 0x73, 0x0c, 0x71, 0x0b, 0x48, 0xf0, 0xd7, 0x8e, 0x3b, 0x87, 0x46, 0xa1, 0x97, 0x32,
+	0xa6, 0x11, 0x37, 0x21, 0x58, 0x74, 0x18, 0x15, 0x84, 0xfa, 0xc1, 0x45, 0x6b, 0xfb, 0x14, 0x71,
+	0xab, 0x24, 0x21, 0xaf, 0xe6, 0x42, 0x36, 0x62, 0xa7, 0x5d, 0x9f, 0xa6, 0x70, 0x16, 0x9c, 0xd4,
+	0x11, 0x37, 0x6f, 0x81, 0x99, 0x96, 0x8f, 0x3a, 0x58, 0x70, 0x6b, 0x7e, 0xbd, 0x38, 0x76, 0xae,
+	0xd5, 0xa5, 0x6d, 0x6a, 0x18, 0x29, 0x77, 0xf3, 0x3e, 0x58, 0x81, 0xbe, 0x60, 0xc1, 0xf5, 0x71,
+	0x99, 0x4f, 0x91, 0xad, 0xaa, 0x29, 0xef, 0xd1, 0x69, 0x19, 0xfb, 0x5a, 0x6e, 0xec, 0x6d, 0x5f,
+	0xb0, 0x86, 0x72, 0xde, 0x89, 0x7d, 0x53, 0x02, 0x81, 0x99, 0x26, 0x41, 0x87, 0xac, 0xe4, 0xd6,
+	0xda, 0x2e, 0xbc, 0xc7, 0x7c, 0x61, 0x73, 0xb7, 0x47, 0x04, 0xb7, 0xce, 0x4c, 0x30, 0x08, 0x93,
+	0xdb, 0xb7, 0x27, 0x7d, 0x9b, 0x81, 0x6b, 0x4a, 0x1a, 0x4e, 0x96, 0x05, 0x37, 0x3f, 0x02, 0x4b,
+	0x08, 0x53, 0xd6, 0x57, 0x17, 0xc2, 0x26, 0x14, 0xe1, 0xbb, 0x98, 0x5b, 0x0b, 0x12, 0x74, 0x63,
+	0x8c, 0x2a, 0x29, 0xeb, 0x87, 0x12, 0xbf, 0x1d, 0xb8, 0xa5, 0x26, 0x16, 0x7a, 0xe2, 0x10, 0x73,
+	0xf3, 0x33, 0x03, 0x94, 0x43, 0xb0, 0xe4, 0x32, 0x3c, 0x01, 0xbb, 0x38, 0x81, 0x1e, 0x25, 0x6c,
+	0x7c, 0x23, 0x8e, 0xc0, 0x5f, 0x43, 0x47, 0x59, 0x61, 0xed, 0x3f, 0xaa, 0xfa, 0x9d, 0x87, 0x07,
+	0x65, 0xe3, 0xd1, 0x41, 0xd9, 0x78, 0x7c, 0x50, 0x36, 0xfe, 0x38, 0x28, 0x1b, 0x5f, 0x1f, 0x96,
+	0x0b, 0x8f, 0x0f, 0xcb, 0x85, 0x5f, 0x0f, 0xcb, 0x85, 0x0f, 0x36, 0x73, 0xbf, 0x2e, 0xee, 0xa6,
+	0x3f, 0x1a, 0xe5, 0xc7, 0x46, 0x6b, 0x5a, 0x7e, 0xf8, 0x5d, 0xfb, 0x6f, 0x00, 0xba, 0x6a, 0x3b,
+	0xc0, 0xd6, 0x0e, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -783,6 +807,92 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomDelegatorRewardIndexes) > 0 {
+		for iNdEx := len(m.DenomDelegatorRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomDelegatorRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.DenomRewardIndexes) > 0 {
+		for iNdEx := len(m.DenomRewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.CommissionPayoutSplits) > 0 {
+		for iNdEx := len(m.CommissionPayoutSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionPayoutSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1102,6 +1212,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionPayoutSplits) > 0 {
+		for _, e := range m.CommissionPayoutSplits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomRewardIndexes) > 0 {
+		for _, e := range m.DenomRewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomDelegatorRewardIndexes) > 0 {
+		for _, e := range m.DenomDelegatorRewardIndexes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2370,6 +2516,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, Budget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegation{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionPayoutSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionPayoutSplits = append(m.CommissionPayoutSplits, CommissionPayoutSplit{})
+			if err := m.CommissionPayoutSplits[len(m.CommissionPayoutSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRewardIndexes = append(m.DenomRewardIndexes, DenomRewardIndex{})
+			if err := m.DenomRewardIndexes[len(m.DenomRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDelegatorRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomDelegatorRewardIndexes = append(m.DenomDelegatorRewardIndexes, DenomDelegatorRewardIndex{})
+			if err := m.DenomDelegatorRewardIndexes[len(m.DenomDelegatorRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,26 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>: ContinuousFund
+//
+// - 0x0B<accAddrLen (1 Byte)><accAddr_Bytes>: Budget
+//
+// - 0x0C<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
+//
+// - 0x0D: auto-compounding cursor
+//
+// - 0x0E<valAddrLen (1 Byte)><valAddr_Bytes>: CommissionPayoutSplit
+//
+// - 0x0F: LazyRewardIndex
+//
+// - 0x10<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorRewardIndex
+//
+// - 0x11<valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
+//
+// - 0x12<valAddrLen (1 Byte)><valAddr_Bytes><denom_Bytes>: DenomRewardIndex
+//
+// - 0x13<valAddrLen (1 Byte)><valAddr_Bytes><accAddrLen (1 Byte)><accAddr_Bytes><denom_Bytes>: DenomDelegatorRewardIndex
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +74,21 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	ContinuousFundPrefix = []byte{0x0A} // key for continuous funds from the community pool
+	BudgetPrefix         = []byte{0x0B} // key for budgets from the community pool
+
+	AutoCompoundPrefix    = []byte{0x0C} // key for auto-compounding delegations
+	AutoCompoundCursorKey = []byte{0x0D} // key for the next auto-compounding delegation to process
+
+	CommissionPayoutSplitPrefix = []byte{0x0E} // key for the commission payout splits of validators
+
+	LazyRewardIndexKey              = []byte{0x0F} // key for the global reward index of the lazy reward accounting
+	ValidatorRewardIndexPrefix      = []byte{0x10} // key for the reward indexes of validators
+	ValidatorRewardIndexStalePrefix = []byte{0x11} // key for validators whose power may have changed since their index was updated
+
+	DenomRewardIndexPrefix          = []byte{0x12} // key for the reward indexes of the additional bond denom stakes of validators
+	DenomDelegatorRewardIndexPrefix = []byte{0x13} // key for the reward indexes of the additional bond denom delegations
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -214,3 +249,107 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetContinuousFundKey creates the key for the continuous fund of a recipient.
+func GetContinuousFundKey(recipient sdk.AccAddress) []byte {
+	return append(ContinuousFundPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
+
+// GetBudgetKey creates the key for the budget of a recipient.
+func GetBudgetKey(recipient sdk.AccAddress) []byte {
+	return append(BudgetPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
+
+// GetRecipientAddress creates an address from a continuous fund or budget key.
+func GetRecipientAddress(key []byte) (recipient sdk.AccAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes> or 0x0B<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
+// GetAutoCompoundKey creates the key for an auto-compounding delegation.
+func GetAutoCompoundKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetAutoCompoundDelegatorPrefix(delAddr), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetAutoCompoundDelegatorPrefix creates the prefix key for the auto-compounding
+// delegations of a delegator.
+func GetAutoCompoundDelegatorPrefix(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoCompoundAddresses creates the addresses from an auto-compounding delegation key.
+func GetAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0C<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetCommissionPayoutSplitKey creates the key for the commission payout split of a validator.
+func GetCommissionPayoutSplitKey(v sdk.ValAddress) []byte {
+	return append(CommissionPayoutSplitPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorRewardIndexKey creates the key for the reward index of a validator.
+func GetValidatorRewardIndexKey(v sdk.ValAddress) []byte {
+	return append(ValidatorRewardIndexPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorRewardIndexStaleKey creates the key marking the reward index power of a validator as stale.
+func GetValidatorRewardIndexStaleKey(v sdk.ValAddress) []byte {
+	return append(ValidatorRewardIndexStalePrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorRewardIndexAddress creates an address from a validator reward index key,
+// or from a stale validator reward index key.
+func GetValidatorRewardIndexAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x10<valAddrLen (1 Byte)><valAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
+
+// GetDenomRewardIndexesKey creates the prefix for the reward indexes of the
+// additional bond denom stakes of a validator.
+func GetDenomRewardIndexesKey(v sdk.ValAddress) []byte {
+	return append(DenomRewardIndexPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetDenomRewardIndexKey creates the key for the reward index of a validator's
+// additional bond denom stake.
+func GetDenomRewardIndexKey(v sdk.ValAddress, denom string) []byte {
+	return append(GetDenomRewardIndexesKey(v), []byte(denom)...)
+}
+
+// GetDenomDelegatorRewardIndexesKey creates the prefix for the reward indexes
+// of the additional bond denom delegations to a validator.
+func GetDenomDelegatorRewardIndexesKey(v sdk.ValAddress) []byte {
+	return append(DenomDelegatorRewardIndexPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetDenomDelegatorRewardIndexKey creates the key for the reward index of an
+// additional bond denom delegation.
+func GetDenomDelegatorRewardIndexKey(v sdk.ValAddress, d sdk.AccAddress, denom string) []byte {
+	key := append(GetDenomDelegatorRewardIndexesKey(v), address.MustLengthPrefix(d.Bytes())...)
+	return append(key, []byte(denom)...)
+}
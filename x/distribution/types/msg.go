@@ -15,6 +15,13 @@ const (
 	TypeMsgFundCommunityPool           = "fund_community_pool"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgCommunityPoolSpend          = "community_pool_spend"
+	TypeMsgCreateContinuousFund        = "create_continuous_fund"
+	TypeMsgCancelContinuousFund        = "cancel_continuous_fund"
+	TypeMsgSubmitBudgetProposal        = "submit_budget_proposal"
+	TypeMsgClaimBudget                 = "claim_budget"
+	TypeMsgSetAutoCompound             = "set_auto_compound"
+	TypeMsgSetCommissionPayoutSplit    = "set_commission_payout_split"
+	TypeMsgWithdrawDenomReward         = "withdraw_denom_reward"
 )
 
 // Verify interface at compile time
@@ -24,6 +31,13 @@ var (
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgCreateContinuousFund)(nil)
+	_ sdk.Msg = (*MsgCancelContinuousFund)(nil)
+	_ sdk.Msg = (*MsgSubmitBudgetProposal)(nil)
+	_ sdk.Msg = (*MsgClaimBudget)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgSetCommissionPayoutSplit)(nil)
+	_ sdk.Msg = (*MsgWithdrawDenomReward)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -224,3 +238,271 @@ func (msg MsgCommunityPoolSpend) ValidateBasic() error {
 
 	return msg.Amount.Validate()
 }
+
+// Route returns the MsgCreateContinuousFund message route.
+func (msg MsgCreateContinuousFund) Route() string { return ModuleName }
+
+// Type returns the MsgCreateContinuousFund message type.
+func (msg MsgCreateContinuousFund) Type() string { return TypeMsgCreateContinuousFund }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateContinuousFund message that
+// the expected signer needs to sign.
+func (msg MsgCreateContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCreateContinuousFund message validation.
+func (msg MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.ContinuousFund().Validate()
+}
+
+// ContinuousFund returns the continuous fund created by the message.
+func (msg MsgCreateContinuousFund) ContinuousFund() ContinuousFund {
+	return ContinuousFund{
+		Recipient:      msg.Recipient,
+		Percentage:     msg.Percentage,
+		AmountPerBlock: msg.AmountPerBlock,
+		Expiry:         msg.Expiry,
+	}
+}
+
+// Route returns the MsgCancelContinuousFund message route.
+func (msg MsgCancelContinuousFund) Route() string { return ModuleName }
+
+// Type returns the MsgCancelContinuousFund message type.
+func (msg MsgCancelContinuousFund) Type() string { return TypeMsgCancelContinuousFund }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCancelContinuousFund message that
+// the expected signer needs to sign.
+func (msg MsgCancelContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCancelContinuousFund message validation.
+func (msg MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// Route returns the MsgSubmitBudgetProposal message route.
+func (msg MsgSubmitBudgetProposal) Route() string { return ModuleName }
+
+// Type returns the MsgSubmitBudgetProposal message type.
+func (msg MsgSubmitBudgetProposal) Type() string { return TypeMsgSubmitBudgetProposal }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgSubmitBudgetProposal) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSubmitBudgetProposal message that
+// the expected signer needs to sign.
+func (msg MsgSubmitBudgetProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSubmitBudgetProposal message validation.
+func (msg MsgSubmitBudgetProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return validateBudgetSchedule(msg.TotalBudget, msg.Tranches, msg.Period)
+}
+
+// NewMsgClaimBudget creates a new MsgClaimBudget instance
+//
+//nolint:interfacer
+func NewMsgClaimBudget(recipient sdk.AccAddress) *MsgClaimBudget {
+	return &MsgClaimBudget{
+		Recipient: recipient.String(),
+	}
+}
+
+// Route returns the MsgClaimBudget message route.
+func (msg MsgClaimBudget) Route() string { return ModuleName }
+
+// Type returns the MsgClaimBudget message type.
+func (msg MsgClaimBudget) Type() string { return TypeMsgClaimBudget }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the recipient.
+func (msg MsgClaimBudget) GetSigners() []sdk.AccAddress {
+	recipient, _ := sdk.AccAddressFromBech32(msg.Recipient)
+	return []sdk.AccAddress{recipient}
+}
+
+// GetSignBytes returns the raw bytes for a MsgClaimBudget message that
+// the expected signer needs to sign.
+func (msg MsgClaimBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgClaimBudget message validation.
+func (msg MsgClaimBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound instance
+//
+//nolint:interfacer
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetCommissionPayoutSplit creates a new MsgSetCommissionPayoutSplit instance
+//
+//nolint:interfacer
+func NewMsgSetCommissionPayoutSplit(valAddr sdk.ValAddress, recipients []CommissionPayoutRecipient) *MsgSetCommissionPayoutSplit {
+	return &MsgSetCommissionPayoutSplit{
+		ValidatorAddress: valAddr.String(),
+		Recipients:       recipients,
+	}
+}
+
+// Route returns the MsgSetCommissionPayoutSplit message route.
+func (msg MsgSetCommissionPayoutSplit) Route() string { return ModuleName }
+
+// Type returns the MsgSetCommissionPayoutSplit message type.
+func (msg MsgSetCommissionPayoutSplit) Type() string { return TypeMsgSetCommissionPayoutSplit }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the validator operator.
+func (msg MsgSetCommissionPayoutSplit) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetCommissionPayoutSplit message that
+// the expected signer needs to sign.
+func (msg MsgSetCommissionPayoutSplit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetCommissionPayoutSplit message validation.
+func (msg MsgSetCommissionPayoutSplit) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if len(msg.Recipients) == 0 {
+		return nil
+	}
+
+	return validateCommissionPayoutRecipients(msg.Recipients)
+}
+
+// NewMsgWithdrawDenomReward creates a new MsgWithdrawDenomReward instance
+//
+//nolint:interfacer
+func NewMsgWithdrawDenomReward(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) *MsgWithdrawDenomReward {
+	return &MsgWithdrawDenomReward{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Denom:            denom,
+	}
+}
+
+// Route returns the MsgWithdrawDenomReward message route.
+func (msg MsgWithdrawDenomReward) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawDenomReward message type.
+func (msg MsgWithdrawDenomReward) Type() string { return TypeMsgWithdrawDenomReward }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawDenomReward) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawDenomReward message that
+// the expected signer needs to sign.
+func (msg MsgWithdrawDenomReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawDenomReward message validation.
+func (msg MsgWithdrawDenomReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -93,3 +94,115 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCreateContinuousFund
+func TestMsgCreateContinuousFund(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+	tests := []struct {
+		recipient      sdk.AccAddress
+		percentage     sdk.Dec
+		amountPerBlock sdk.Coins
+		expectPass     bool
+	}{
+		{delAddr1, sdk.NewDecWithPrec(5, 1), nil, true},
+		{delAddr1, sdk.ZeroDec(), amount, true},
+		{delAddr1, sdk.Dec{}, amount, true},
+		{emptyDelAddr, sdk.NewDecWithPrec(5, 1), nil, false},
+		{delAddr1, sdk.NewDecWithPrec(5, 1), amount, false},
+		{delAddr1, sdk.ZeroDec(), nil, false},
+		{delAddr1, sdk.NewDecWithPrec(11, 1), nil, false},
+		{delAddr1, sdk.NewDecWithPrec(-1, 1), nil, false},
+	}
+	for i, tc := range tests {
+		msg := MsgCreateContinuousFund{
+			Authority:      delAddr2.String(),
+			Recipient:      tc.recipient.String(),
+			Percentage:     tc.percentage,
+			AmountPerBlock: tc.amountPerBlock,
+		}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSubmitBudgetProposal
+func TestMsgSubmitBudgetProposal(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	tests := []struct {
+		recipient  sdk.AccAddress
+		budget     sdk.Coins
+		tranches   uint64
+		period     time.Duration
+		expectPass bool
+	}{
+		{delAddr1, amount, 10, time.Hour, true},
+		{delAddr1, amount, 1, 0, true},
+		{emptyDelAddr, amount, 10, time.Hour, false},
+		{delAddr1, sdk.Coins{}, 10, time.Hour, false},
+		{delAddr1, amount, 0, time.Hour, false},
+		{delAddr1, amount, 10, 0, false},
+	}
+	for i, tc := range tests {
+		msg := MsgSubmitBudgetProposal{
+			Authority:   delAddr2.String(),
+			Recipient:   tc.recipient.String(),
+			TotalBudget: tc.budget,
+			Tranches:    tc.tranches,
+			Period:      tc.period,
+		}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true},
+		{emptyDelAddr, valAddr1, false},
+		{delAddr1, emptyValAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, true)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetCommissionPayoutSplit(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		recipients    []CommissionPayoutRecipient
+		expectPass    bool
+	}{
+		{valAddr1, []CommissionPayoutRecipient{NewCommissionPayoutRecipient(delAddr1, half), NewCommissionPayoutRecipient(delAddr2, half)}, true},
+		{valAddr1, nil, true},
+		{emptyValAddr, nil, false},
+		{valAddr1, []CommissionPayoutRecipient{NewCommissionPayoutRecipient(delAddr1, half)}, false},
+		{valAddr1, []CommissionPayoutRecipient{NewCommissionPayoutRecipient(delAddr1, half), NewCommissionPayoutRecipient(delAddr1, half)}, false},
+		{valAddr1, []CommissionPayoutRecipient{NewCommissionPayoutRecipient(delAddr1, sdk.OneDec()), NewCommissionPayoutRecipient(delAddr2, sdk.ZeroDec())}, false},
+		{valAddr1, []CommissionPayoutRecipient{NewCommissionPayoutRecipient(emptyDelAddr, sdk.OneDec())}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionPayoutSplit(tc.validatorAddr, tc.recipients)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -9,13 +9,25 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Default auto-compounding parameters
+const (
+	DefaultAutoCompoundBatchSize = uint64(100)
+	DefaultAutoCompoundGasLimit  = uint64(20_000_000)
+
+	DefaultMaxCommissionPayoutRecipients = uint32(10)
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.ZeroDec(),            // deprecated
-		BonusProposerReward: sdk.ZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:          sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:    sdk.ZeroDec(),            // deprecated
+		BonusProposerReward:   sdk.ZeroDec(),            // deprecated
+		WithdrawAddrEnabled:   true,
+		AutoCompoundBatchSize: DefaultAutoCompoundBatchSize,
+		AutoCompoundGasLimit:  DefaultAutoCompoundGasLimit,
+
+		MaxCommissionPayoutRecipients: DefaultMaxCommissionPayoutRecipients,
 	}
 }
 
@@ -31,6 +43,9 @@ func (p Params) ValidateBasic() error {
 			"community tax should be non-negative and less than one: %s", p.CommunityTax,
 		)
 	}
+	if p.AutoCompoundBatchSize > 0 && p.AutoCompoundGasLimit == 0 {
+		return fmt.Errorf("auto compound gas limit must be positive when auto-compounding is enabled")
+	}
 
 	return nil
 }
@@ -13,18 +13,23 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by Tendermint. Currently, only equivocation is handled.
+// misbehavior submitted by Tendermint, i.e. equivocations and light client
+// attacks.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
 	for _, tmEvidence := range req.ByzantineValidators {
 		switch tmEvidence.Type {
-		// It's still ongoing discussion how should we treat and slash attacks with
-		// premeditation. So for now we agree to treat them in the same way.
-		case abci.MisbehaviorType_DUPLICATE_VOTE, abci.MisbehaviorType_LIGHT_CLIENT_ATTACK:
+		case abci.MisbehaviorType_DUPLICATE_VOTE:
 			evidence := types.FromABCIEvidence(tmEvidence)
 			k.HandleEquivocationEvidence(ctx, evidence.(*types.Equivocation))
 
+		// A light client attack is reported once per byzantine validator, at
+		// the common height of the attack.
+		case abci.MisbehaviorType_LIGHT_CLIENT_ATTACK:
+			evidence := types.FromABCIEvidence(tmEvidence)
+			k.HandleLightClientAttackEvidence(ctx, evidence.(*types.LightClientAttack))
+
 		default:
 			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", tmEvidence.Type))
 		}
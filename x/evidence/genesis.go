@@ -19,6 +19,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
 	}
 
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
 	for _, e := range gs.Evidence {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
@@ -49,5 +53,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	return &types.GenesisState{
 		Evidence: evidence,
+		Params:   k.GetParams(ctx),
 	}
 }
@@ -61,7 +61,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			true,
 			func() {
@@ -82,7 +82,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			false,
 			func() {
@@ -2,13 +2,24 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// infractionEvidence defines the evidence of a validator infraction reported
+// by CometBFT.
+type infractionEvidence interface {
+	exported.ValidatorEvidence
+
+	// GetTime returns the time of the infraction.
+	GetTime() time.Time
+}
+
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
 // jailed and tombstoned. Once tombstoned, the validator will not be able to
@@ -21,10 +32,31 @@ import (
 // - the signing info does not exist (will panic)
 // - is already tombstoned
 //
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
+// The validator is slashed by the slash fraction set in the params for the
+// equivocation evidence type, or by the x/slashing double sign slash fraction.
 func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
+	k.handleInfractionEvidence(ctx, evidence)
+}
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. CometBFT reports one evidence per byzantine validator of an attack,
+// all at the common height of the attack, which is the infraction height. Each
+// of these validators is slashed, jailed and tombstoned as for an equivocation,
+// the evidence being invalid under the same conditions.
+//
+// The validator is slashed by the slash fraction set in the params for the
+// light client attack evidence type, or by the x/slashing double sign slash
+// fraction.
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) {
+	k.handleInfractionEvidence(ctx, evidence)
+}
+
+// handleInfractionEvidence slashes, jails and tombstones the validator which
+// committed the infraction, assuming the evidence is valid, and stores the
+// evidence.
+func (k Keeper) handleInfractionEvidence(ctx sdk.Context, evidence infractionEvidence) {
 	logger := k.Logger(ctx)
+	evidenceType := evidence.Type()
 	consAddr := evidence.GetConsensusAddress()
 
 	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
@@ -63,7 +95,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	if cp != nil && cp.Evidence != nil {
 		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
 			logger.Info(
-				"ignored equivocation; evidence too old",
+				"ignored evidence; evidence too old",
+				"type", evidenceType,
 				"validator", consAddr,
 				"infraction_height", infractionHeight,
 				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
@@ -81,7 +114,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// ignore if the validator is already tombstoned
 	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
 		logger.Info(
-			"ignored equivocation; validator already tombstoned",
+			"ignored evidence; validator already tombstoned",
+			"type", evidenceType,
 			"validator", consAddr,
 			"infraction_height", infractionHeight,
 			"infraction_time", infractionTime,
@@ -90,7 +124,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	}
 
 	logger.Info(
-		"confirmed equivocation",
+		"confirmed infraction evidence",
+		"type", evidenceType,
 		"validator", consAddr,
 		"infraction_height", infractionHeight,
 		"infraction_time", infractionTime,
@@ -108,10 +143,13 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
+	//
+	// Light client attacks are signatures of conflicting headers, and are thus
+	// reported as double signing infractions.
 	k.slashingKeeper.SlashWithInfractionReason(
 		ctx,
 		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		k.SlashFraction(ctx, evidenceType),
 		evidence.GetValidatorPower(), distributionHeight,
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	)
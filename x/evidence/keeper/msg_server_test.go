@@ -63,3 +63,59 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(
+		types.NewEvidenceSlashFraction(types.TypeLightClientAttack, sdk.NewDecWithPrec(1, 1)),
+	)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUpdateParams{
+				Authority: sdk.AccAddress(valAddresses[0]).String(),
+				Params:    params,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid slash fraction",
+			req: &types.MsgUpdateParams{
+				Authority: s.evidenceKeeper.GetAuthority(),
+				Params: types.NewParams(
+					types.NewEvidenceSlashFraction(types.TypeLightClientAttack, sdk.NewDec(2)),
+				),
+			},
+			expErr:    true,
+			expErrMsg: "must be between 0 and 1",
+		},
+		{
+			name: "valid params",
+			req: &types.MsgUpdateParams{
+				Authority: s.evidenceKeeper.GetAuthority(),
+				Params:    params,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(params, s.evidenceKeeper.GetParams(s.ctx))
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// GetParams returns the current x/evidence module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the x/evidence module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// SlashFraction returns the fraction of stake slashed for evidence of the given
+// type, which defaults to the x/slashing double sign slash fraction. Evidence
+// handlers registered on custom routes may use it to slash validators.
+func (k Keeper) SlashFraction(ctx sdk.Context, evidenceType string) sdk.Dec {
+	if fraction, ok := k.GetParams(ctx).GetSlashFraction(evidenceType); ok {
+		return fraction
+	}
+
+	return k.slashingKeeper.SlashFractionDoubleSign(ctx)
+}
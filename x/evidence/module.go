@@ -20,11 +20,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	eviclient "github.com/cosmos/cosmos-sdk/x/evidence/client"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/simulation"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -213,8 +215,11 @@ type EvidenceOutputs struct {
 	Module         appmodule.AppModule
 }
 
+// ProvideModule provides the evidence keeper and module. The gov module account
+// is the authority capable of updating the module parameters.
 func ProvideModule(in EvidenceInputs) EvidenceOutputs {
-	k := keeper.NewKeeper(in.Cdc, in.Key, in.StakingKeeper, in.SlashingKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k := keeper.NewKeeper(in.Cdc, in.Key, in.StakingKeeper, in.SlashingKeeper, authority.String())
 	m := NewAppModule(*k)
 
 	return EvidenceOutputs{EvidenceKeeper: *k, Module: m}
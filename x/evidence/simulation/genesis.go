@@ -30,7 +30,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) },
 	)
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	evidenceGenesis := types.NewGenesisState(types.DefaultParams(), ev)
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
@@ -15,11 +15,16 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "light_client_attack"
+	TypeLightClientAttack  = "light_client_attack"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.ValidatorEvidence = &Equivocation{}
+	_ exported.ValidatorEvidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -85,8 +90,77 @@ func (e Equivocation) GetValidatorPower() int64 {
 // GetTotalPower is a no-op for the Equivocation type.
 func (e Equivocation) GetTotalPower() int64 { return 0 }
 
-// FromABCIEvidence converts a Tendermint concrete Evidence type to
-// SDK Evidence using Equivocation as the concrete type.
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the byzantine validator's consensus address.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the common height of the LightClientAttack, which is its
+// infraction height.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time of the LightClientAttack.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the byzantine validator's power at the common
+// height.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total power of the validator set at the common
+// height.
+func (e LightClientAttack) GetTotalPower() int64 { return e.TotalPower }
+
+// FromABCIEvidence converts a Tendermint concrete Evidence type to SDK
+// Evidence, using LightClientAttack as the concrete type for light client
+// attacks and Equivocation for any other misbehavior.
 func FromABCIEvidence(e abci.Misbehavior) exported.Evidence {
 	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
 	consAddr, err := sdk.Bech32ifyAddressBytes(bech32PrefixConsAddr, e.Validator.Address)
@@ -94,6 +168,16 @@ func FromABCIEvidence(e abci.Misbehavior) exported.Evidence {
 		panic(err)
 	}
 
+	if e.Type == abci.MisbehaviorType_LIGHT_CLIENT_ATTACK {
+		return &LightClientAttack{
+			Height:           e.Height,
+			Power:            e.Validator.Power,
+			ConsensusAddress: consAddr,
+			Time:             e.Time,
+			TotalPower:       e.TotalVotingPower,
+		}
+	}
+
 	return &Equivocation{
 		Height:           e.Height,
 		Power:            e.Validator.Power,
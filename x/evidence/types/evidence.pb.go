@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -74,8 +75,159 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of
+// a validator taking part in a light client attack, by signing a conflicting
+// header. CometBFT reports one such evidence per byzantine validator.
+type LightClientAttack struct {
+	// height is the common height of the attack, i.e. the last height at which
+	// the conflicting and trusted chains agree, which is the infraction height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the attack.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// power is the byzantine validator power at the common height.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// consensus_address is the byzantine validator consensus address.
+	ConsensusAddress string `protobuf:"bytes,4,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// total_power is the total voting power of the validator set at the common
+	// height.
+	TotalPower int64 `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()      { *m = LightClientAttack{} }
+func (*LightClientAttack) ProtoMessage() {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// Params defines the parameters for the x/evidence module.
+type Params struct {
+	// slash_fractions overrides the fraction of stake slashed for the given
+	// evidence types. Evidence of any other type is slashed by the x/slashing
+	// double sign slash fraction.
+	SlashFractions []EvidenceSlashFraction `protobuf:"bytes,1,rep,name=slash_fractions,json=slashFractions,proto3" json:"slash_fractions"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSlashFractions() []EvidenceSlashFraction {
+	if m != nil {
+		return m.SlashFractions
+	}
+	return nil
+}
+
+// EvidenceSlashFraction defines the fraction of stake slashed for evidence of
+// a given type.
+type EvidenceSlashFraction struct {
+	// evidence_type is the type of the evidence, e.g. equivocation or
+	// light_client_attack.
+	EvidenceType string `protobuf:"bytes,1,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty"`
+	// slash_fraction is the fraction of stake slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *EvidenceSlashFraction) Reset()         { *m = EvidenceSlashFraction{} }
+func (m *EvidenceSlashFraction) String() string { return proto.CompactTextString(m) }
+func (*EvidenceSlashFraction) ProtoMessage()    {}
+func (*EvidenceSlashFraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{3}
+}
+func (m *EvidenceSlashFraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceSlashFraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceSlashFraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvidenceSlashFraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceSlashFraction.Merge(m, src)
+}
+func (m *EvidenceSlashFraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceSlashFraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceSlashFraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceSlashFraction proto.InternalMessageInfo
+
+func (m *EvidenceSlashFraction) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
+	proto.RegisterType((*EvidenceSlashFraction)(nil), "cosmos.evidence.v1beta1.EvidenceSlashFraction")
 }
 
 func init() {
@@ -83,32 +235,99 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0x0b, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x43, 0xd4, 0xe9,
-	0xc1, 0x85, 0xa1, 0xea, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0xd3, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92, 0xcc, 0xdc, 0xd4, 0xe2, 0x92,
-	0xc4, 0xdc, 0x02, 0xa8, 0x02, 0x49, 0x88, 0x15, 0xf1, 0x10, 0x9d, 0x50, 0xfb, 0xc0, 0x1c, 0xa5,
-	0x37, 0x8c, 0x5c, 0x3c, 0xae, 0x85, 0xa5, 0x99, 0x65, 0xf9, 0xc9, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc,
-	0x41, 0x50, 0x9e, 0x90, 0x2d, 0x17, 0x0b, 0xc8, 0x58, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x29, 0x3d, 0x88, 0x9d, 0x7a, 0x30, 0x3b, 0xf5, 0x42, 0x60, 0x76, 0x3a, 0xf1, 0x9e, 0xb8, 0x27,
-	0xcf, 0x30, 0xe1, 0xbe, 0x3c, 0xe3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xc0, 0xda, 0x84, 0x44,
-	0xb8, 0x58, 0x0b, 0xf2, 0xcb, 0x53, 0x8b, 0x24, 0x98, 0xc1, 0xa6, 0x42, 0x38, 0x42, 0xae, 0x5c,
-	0x82, 0xc9, 0xf9, 0x79, 0xc5, 0xa9, 0x79, 0xc5, 0xa5, 0xc5, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9,
-	0xc5, 0xc5, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x9d,
-	0xea, 0x08, 0x91, 0x09, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x12, 0x80, 0x6b, 0x81, 0x8a, 0x5b,
-	0x69, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x86, 0xae, 0xe7,
-	0x1b, 0xb4, 0xa0, 0x61, 0xaa, 0x5b, 0x9c, 0x92, 0xad, 0x8f, 0xec, 0x3b, 0x27, 0xef, 0x15, 0x8f,
-	0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x1a, 0x4a, 0xfa, 0x48, 0x06, 0x55, 0x20, 0xa2,
-	0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0x61, 0xa8, 0x30, 0xea, 0x01, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0x6d, 0xa4, 0x5c, 0x9a, 0x42, 0xac, 0x00, 0x26, 0x42, 0x76, 0x08, 0x52, 0x15,
+	0x55, 0xca, 0x59, 0x2d, 0x5b, 0x24, 0x86, 0x84, 0x86, 0x05, 0x86, 0x2a, 0xed, 0xc4, 0x12, 0x9d,
+	0x9d, 0xab, 0x6d, 0x35, 0xf6, 0x19, 0xdf, 0x25, 0xd0, 0xff, 0x00, 0xb1, 0xd0, 0x0d, 0xc6, 0x4c,
+	0xa8, 0x63, 0x87, 0xfe, 0x11, 0x1d, 0xab, 0x4e, 0x88, 0xa1, 0xa0, 0x64, 0x28, 0x03, 0x7f, 0x04,
+	0xf2, 0xdd, 0x25, 0xb8, 0xe5, 0xc7, 0xce, 0x72, 0xba, 0xf7, 0xdd, 0x7b, 0xef, 0x7b, 0xdf, 0x77,
+	0x77, 0x70, 0xdd, 0xa5, 0x2c, 0xa4, 0xcc, 0x26, 0xe3, 0x60, 0x40, 0x22, 0x97, 0xd8, 0xe3, 0x4d,
+	0x87, 0x70, 0xbc, 0xb9, 0x00, 0x50, 0x9c, 0x50, 0x4e, 0xf5, 0x7b, 0x32, 0x0f, 0x2d, 0x60, 0x95,
+	0x57, 0x2d, 0xe3, 0x30, 0x88, 0xa8, 0x2d, 0x56, 0x99, 0x5b, 0xad, 0x78, 0xd4, 0xa3, 0x62, 0x6b,
+	0xa7, 0x3b, 0x85, 0x5a, 0x1e, 0xa5, 0xde, 0x90, 0xd8, 0x22, 0x72, 0x46, 0xfb, 0x36, 0x0f, 0x42,
+	0xc2, 0x38, 0x0e, 0x63, 0x95, 0x70, 0x5f, 0x52, 0xf4, 0x65, 0xa5, 0xe2, 0x13, 0x41, 0xfd, 0x07,
+	0x80, 0xab, 0xdd, 0x57, 0xa3, 0x60, 0x4c, 0x5d, 0xcc, 0x03, 0x1a, 0xe9, 0x77, 0x61, 0xde, 0x27,
+	0x81, 0xe7, 0x73, 0x03, 0xd4, 0x40, 0x23, 0xd7, 0x53, 0x91, 0xfe, 0x04, 0x2e, 0xa7, 0x6d, 0x8d,
+	0xa5, 0x1a, 0x68, 0x14, 0xb7, 0xaa, 0x48, 0x72, 0xa2, 0x39, 0x27, 0xda, 0x9b, 0x73, 0x76, 0x4a,
+	0x67, 0x97, 0x96, 0x76, 0xf4, 0xd5, 0x02, 0xc7, 0x57, 0x27, 0x1b, 0xa0, 0x27, 0xca, 0xf4, 0x0a,
+	0x5c, 0x89, 0xe9, 0x6b, 0x92, 0x18, 0x39, 0xd1, 0x55, 0x06, 0x7a, 0x17, 0x96, 0x5d, 0x1a, 0x31,
+	0x12, 0xb1, 0x11, 0xeb, 0xe3, 0xc1, 0x20, 0x21, 0x8c, 0x19, 0xcb, 0x35, 0xd0, 0x28, 0x74, 0x8c,
+	0x8b, 0xd3, 0x66, 0x45, 0x8d, 0xda, 0x96, 0x27, 0xbb, 0x3c, 0x09, 0x22, 0xaf, 0x77, 0x7b, 0x51,
+	0xa2, 0xf0, 0x56, 0xe3, 0xed, 0xc4, 0xd2, 0x3e, 0x4e, 0x2c, 0xed, 0xfb, 0xc4, 0xd2, 0xde, 0x5d,
+	0x9d, 0x6c, 0x28, 0x4f, 0x9b, 0x6c, 0x70, 0x60, 0x67, 0xd5, 0xd5, 0x3f, 0x2c, 0xc1, 0xf2, 0x8b,
+	0x54, 0xcf, 0xd3, 0x61, 0x40, 0x22, 0xde, 0xe6, 0x1c, 0xbb, 0x07, 0xff, 0xa3, 0x66, 0xdd, 0x82,
+	0x45, 0x4e, 0x39, 0x1e, 0xf6, 0x25, 0xc5, 0x8a, 0xa0, 0x80, 0x02, 0xda, 0x49, 0x91, 0x56, 0xf3,
+	0xa6, 0x29, 0x0f, 0x32, 0xa6, 0xfc, 0xe6, 0x41, 0xfd, 0x3d, 0x80, 0xf9, 0x1d, 0x9c, 0xe0, 0x90,
+	0xe9, 0x0e, 0xbc, 0xc5, 0x86, 0x98, 0xf9, 0xfd, 0xfd, 0x04, 0xbb, 0xa9, 0x6d, 0xcc, 0x00, 0xb5,
+	0x5c, 0xa3, 0xb8, 0x85, 0xd0, 0x5f, 0xde, 0x2a, 0xea, 0x2a, 0x60, 0x37, 0xad, 0x7b, 0xa6, 0xca,
+	0x3a, 0x85, 0xd4, 0x15, 0xe9, 0xc8, 0x1a, 0xcb, 0x9e, 0xb0, 0xd6, 0xc3, 0x9b, 0x13, 0xbd, 0xf9,
+	0xf5, 0x51, 0xe4, 0x18, 0xf5, 0x4f, 0x00, 0xde, 0xf9, 0x63, 0x5f, 0xfd, 0x11, 0x2c, 0xcd, 0x93,
+	0xfb, 0xfc, 0x30, 0x26, 0xe2, 0xda, 0x0a, 0xbd, 0xd5, 0x39, 0xb8, 0x77, 0x18, 0x13, 0xdd, 0x87,
+	0x6b, 0xd7, 0x55, 0x88, 0x6b, 0x2c, 0x74, 0xda, 0xe9, 0x50, 0x5f, 0x2e, 0xad, 0x75, 0x2f, 0xe0,
+	0xfe, 0xc8, 0x41, 0x2e, 0x0d, 0xd5, 0x97, 0xb0, 0x33, 0xe3, 0xa4, 0x6d, 0x19, 0xda, 0x26, 0xee,
+	0xc5, 0x69, 0x13, 0x2a, 0xd5, 0xdb, 0xc4, 0x95, 0x62, 0x4a, 0xd7, 0xc4, 0x74, 0x9e, 0x1f, 0x4f,
+	0x4d, 0x70, 0x36, 0x35, 0xc1, 0xf9, 0xd4, 0x04, 0xdf, 0xa6, 0x26, 0x38, 0x9a, 0x99, 0xda, 0xf9,
+	0xcc, 0xd4, 0x3e, 0xcf, 0x4c, 0xed, 0x65, 0xf3, 0x9f, 0x3c, 0x19, 0xd9, 0x82, 0xd2, 0xc9, 0x8b,
+	0xd7, 0xf5, 0xf8, 0xe7, 0x00, 0x48, 0x6d, 0x33, 0xca, 0x3f, 0x04, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SlashFractions) != len(that1.SlashFractions) {
+		return false
+	}
+	for i := range this.SlashFractions {
+		if !this.SlashFractions[i].Equal(&that1.SlashFractions[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *EvidenceSlashFraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*EvidenceSlashFraction)
+	if !ok {
+		that2, ok := that.(EvidenceSlashFraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EvidenceType != that1.EvidenceType {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +376,136 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashFractions) > 0 {
+		for iNdEx := len(m.SlashFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashFractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceSlashFraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceSlashFraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceSlashFraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +538,60 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashFractions) > 0 {
+		for _, e := range m.SlashFractions {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EvidenceSlashFraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +751,378 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashFractions = append(m.SlashFractions, EvidenceSlashFraction{})
+			if err := m.SlashFractions[len(m.SlashFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceSlashFraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceSlashFraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceSlashFraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
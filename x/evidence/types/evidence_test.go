@@ -70,6 +70,91 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	var zeroTime time.Time
+	addr := sdk.ConsAddress("foo_________________")
+
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.LightClientAttack{100, n, 1000000, addr.String(), 3000000}, false},
+		{"invalid time", types.LightClientAttack{100, zeroTime, 1000000, addr.String(), 3000000}, true},
+		{"invalid height", types.LightClientAttack{0, n, 1000000, addr.String(), 3000000}, true},
+		{"invalid power", types.LightClientAttack{100, n, 0, addr.String(), 3000000}, true},
+		{"invalid total power", types.LightClientAttack{100, n, 1000000, addr.String(), 100}, true},
+		{"invalid address", types.LightClientAttack{100, n, 1000000, "", 3000000}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestFromABCIEvidence(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	misbehavior := abci.Misbehavior{
+		Type: abci.MisbehaviorType_DUPLICATE_VOTE,
+		Validator: abci.Validator{
+			Address: []byte("foo_________________"),
+			Power:   100,
+		},
+		Height:           10,
+		Time:             n,
+		TotalVotingPower: 300,
+	}
+
+	equivocation, ok := types.FromABCIEvidence(misbehavior).(*types.Equivocation)
+	require.True(t, ok)
+	require.Equal(t, int64(10), equivocation.GetHeight())
+	require.Equal(t, int64(0), equivocation.GetTotalPower())
+
+	misbehavior.Type = abci.MisbehaviorType_LIGHT_CLIENT_ATTACK
+	lca, ok := types.FromABCIEvidence(misbehavior).(*types.LightClientAttack)
+	require.True(t, ok)
+	require.Equal(t, int64(10), lca.GetHeight())
+	require.Equal(t, int64(100), lca.GetValidatorPower())
+	require.Equal(t, int64(300), lca.GetTotalPower())
+	require.Equal(t, n, lca.GetTime())
+	require.Equal(t, sdk.ConsAddress("foo_________________"), lca.GetConsensusAddress())
+	require.Equal(t, types.TypeLightClientAttack, lca.Type())
+	require.NoError(t, lca.ValidateBasic())
+}
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"valid", types.NewParams(
+			types.NewEvidenceSlashFraction(types.TypeEquivocation, sdk.NewDecWithPrec(5, 2)),
+			types.NewEvidenceSlashFraction(types.TypeLightClientAttack, sdk.OneDec()),
+		), false},
+		{"empty type", types.NewParams(types.NewEvidenceSlashFraction("", sdk.OneDec())), true},
+		{"duplicate type", types.NewParams(
+			types.NewEvidenceSlashFraction(types.TypeEquivocation, sdk.NewDecWithPrec(5, 2)),
+			types.NewEvidenceSlashFraction(types.TypeEquivocation, sdk.NewDecWithPrec(1, 2)),
+		), true},
+		{"nil fraction", types.NewParams(types.NewEvidenceSlashFraction(types.TypeEquivocation, sdk.Dec{})), true},
+		{"negative fraction", types.NewParams(types.NewEvidenceSlashFraction(types.TypeEquivocation, sdk.NewDec(-1))), true},
+		{"fraction above one", types.NewParams(types.NewEvidenceSlashFraction(types.TypeEquivocation, sdk.NewDec(2))), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.params.Validate() != nil)
+		})
+	}
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := abci.Misbehavior{
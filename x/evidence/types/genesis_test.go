@@ -44,11 +44,11 @@ func TestNewGenesisState(t *testing.T) {
 
 			if tc.expPass {
 				require.NotPanics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence)
 				})
 			} else {
 				require.Panics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence)
 				})
 			}
 		})
@@ -79,7 +79,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			true,
 		},
@@ -95,7 +95,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			false,
 		},
@@ -1,11 +1,68 @@
 package types
 
 import (
+	"fmt"
 	"time"
-)
 
-// DONTCOVER
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// NewParams returns Params instance with the given values.
+func NewParams(slashFractions ...EvidenceSlashFraction) Params {
+	return Params{
+		SlashFractions: slashFractions,
+	}
+}
+
+// NewEvidenceSlashFraction returns the fraction of stake slashed for evidence
+// of the given type.
+func NewEvidenceSlashFraction(evidenceType string, slashFraction sdk.Dec) EvidenceSlashFraction {
+	return EvidenceSlashFraction{
+		EvidenceType:  evidenceType,
+		SlashFraction: slashFraction,
+	}
+}
+
+// DefaultParams returns default x/evidence module parameters, with which all
+// evidence is slashed by the x/slashing double sign slash fraction.
+func DefaultParams() Params {
+	return Params{
+		SlashFractions: []EvidenceSlashFraction{},
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	seen := make(map[string]bool, len(p.SlashFractions))
+	for _, sf := range p.SlashFractions {
+		if sf.EvidenceType == "" {
+			return fmt.Errorf("evidence type of slash fraction cannot be empty")
+		}
+		if seen[sf.EvidenceType] {
+			return fmt.Errorf("duplicate slash fraction for evidence type %s", sf.EvidenceType)
+		}
+		seen[sf.EvidenceType] = true
+
+		if sf.SlashFraction.IsNil() || sf.SlashFraction.IsNegative() || sf.SlashFraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("slash fraction of evidence type %s must be between 0 and 1: %s", sf.EvidenceType, sf.SlashFraction)
+		}
+	}
+
+	return nil
+}
+
+// GetSlashFraction returns the slash fraction set for the given evidence type,
+// and false if none is set.
+func (p Params) GetSlashFraction(evidenceType string) (sdk.Dec, bool) {
+	for _, sf := range p.SlashFractions {
+		if sf.EvidenceType == evidenceType {
+			return sf.SlashFraction, true
+		}
+	}
+
+	return sdk.Dec{}, false
+}
@@ -9,6 +9,7 @@ import (
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -240,11 +241,96 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evidence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evidence.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -252,37 +338,43 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0xf2, 0x43, 0xc3, 0x1b, 0x17, 0xab, 0x68, 0x25, 0x42, 0x61, 0x64, 0x12, 0x94,
-	0x49, 0xb5, 0xd3, 0x21, 0x71, 0x5f, 0x25, 0xd8, 0xb8, 0x41, 0x8e, 0x70, 0x40, 0x76, 0xe6, 0x25,
-	0x11, 0xa9, 0x9d, 0xcd, 0xce, 0xb4, 0x08, 0x71, 0xe1, 0xc2, 0x15, 0x09, 0x71, 0x42, 0xfc, 0x39,
-	0x48, 0x1c, 0x27, 0x71, 0xe1, 0x84, 0x50, 0xcb, 0x5f, 0xc1, 0x09, 0xc5, 0x76, 0xda, 0xee, 0x17,
-	0x85, 0x53, 0x5f, 0xed, 0xf7, 0xfd, 0xbe, 0xcf, 0x7b, 0xcf, 0x81, 0xeb, 0xb1, 0x54, 0x23, 0xa9,
-	0x08, 0x3f, 0xcc, 0x76, 0xb9, 0x88, 0x39, 0x39, 0x1c, 0x30, 0xae, 0xe9, 0x80, 0xec, 0x97, 0xfc,
-	0xa0, 0xc2, 0xc5, 0x81, 0xd4, 0x12, 0xad, 0xda, 0x24, 0xdc, 0x24, 0x61, 0x97, 0xe4, 0x6d, 0x38,
-	0x35, 0xa3, 0x8a, 0x5b, 0xc5, 0x54, 0x5f, 0xd0, 0x24, 0x13, 0x54, 0x67, 0x52, 0x58, 0x13, 0xaf,
-	0x93, 0xc8, 0x44, 0x9a, 0x90, 0xd4, 0x91, 0x3b, 0xbd, 0x99, 0x48, 0x99, 0xe4, 0x9c, 0x98, 0x7f,
-	0xac, 0xdc, 0x23, 0x54, 0xb8, 0xaa, 0xde, 0x2d, 0x77, 0x45, 0x8b, 0x8c, 0x50, 0x21, 0xa4, 0x36,
-	0x6e, 0xca, 0xde, 0x06, 0xef, 0x00, 0xec, 0x3c, 0xab, 0x2b, 0x3e, 0x72, 0x50, 0x11, 0xdf, 0x2f,
-	0xb9, 0xd2, 0xe8, 0x05, 0xbc, 0xde, 0x70, 0xbe, 0x4c, 0xa9, 0x4a, 0xbb, 0x60, 0x0d, 0xf4, 0x56,
-	0x86, 0x0f, 0x7f, 0xff, 0xb8, 0x1d, 0x26, 0x99, 0x4e, 0x4b, 0x86, 0x63, 0x39, 0x22, 0xb1, 0x1c,
-	0x71, 0xcd, 0xf6, 0xf4, 0x2c, 0xc8, 0x33, 0xa6, 0x08, 0xab, 0x34, 0x57, 0x78, 0x87, 0x1f, 0x0d,
-	0xeb, 0xa0, 0x0b, 0xa2, 0x95, 0xc6, 0x6c, 0x87, 0xaa, 0x14, 0x21, 0x78, 0xd9, 0x78, 0xb6, 0xd7,
-	0x40, 0xef, 0x5a, 0x64, 0xe2, 0xe0, 0x09, 0xbc, 0x71, 0x0a, 0x44, 0x15, 0x52, 0x28, 0x8e, 0x42,
-	0xb8, 0xd4, 0x88, 0x0d, 0xc4, 0xf2, 0x66, 0x07, 0xdb, 0x9e, 0x70, 0xd3, 0x2e, 0xde, 0x12, 0x55,
-	0x34, 0xcd, 0x0a, 0x28, 0x5c, 0x35, 0x56, 0x5b, 0x79, 0x7e, 0xba, 0xad, 0xc7, 0x10, 0xce, 0x46,
-	0xea, 0xec, 0xee, 0x62, 0xb7, 0x98, 0x7a, 0xfe, 0xd8, 0x6e, 0xcc, 0xcd, 0x1f, 0x3f, 0xa5, 0x49,
-	0xa3, 0x8d, 0xe6, 0x94, 0xc1, 0x47, 0x00, 0xbb, 0x67, 0x6b, 0x9c, 0x4b, 0x7c, 0x69, 0x31, 0x31,
-	0xda, 0x3e, 0x81, 0xd5, 0x36, 0x58, 0xf7, 0x16, 0x62, 0xd9, 0x72, 0xf3, 0x5c, 0x9b, 0x5f, 0xda,
-	0xf0, 0x8a, 0xe1, 0x42, 0x9f, 0x00, 0x5c, 0x6a, 0xc8, 0x50, 0x1f, 0x5f, 0xf0, 0xf6, 0xf0, 0x79,
-	0xcb, 0xf7, 0xf0, 0xbf, 0xa6, 0x5b, 0x82, 0x20, 0x7c, 0xfb, 0xed, 0xd7, 0x87, 0xf6, 0x06, 0xea,
-	0x91, 0x8b, 0xbe, 0x83, 0xe9, 0xc1, 0xeb, 0x7a, 0xd9, 0x6f, 0xd0, 0x67, 0x00, 0x97, 0xe7, 0x46,
-	0x87, 0xc2, 0xbf, 0x57, 0x3c, 0xbb, 0x49, 0x6f, 0xf0, 0x1f, 0x0a, 0x87, 0x79, 0xdf, 0x60, 0xae,
-	0xa3, 0x3b, 0x0b, 0x31, 0x87, 0xdb, 0x5f, 0xc7, 0x3e, 0x38, 0x1e, 0xfb, 0xe0, 0xe7, 0xd8, 0x07,
-	0xef, 0x27, 0x7e, 0xeb, 0x78, 0xe2, 0xb7, 0xbe, 0x4f, 0xfc, 0xd6, 0xf3, 0xfe, 0x89, 0xd7, 0x6f,
-	0x6c, 0xec, 0x4f, 0x5f, 0xed, 0xbe, 0x22, 0x47, 0x33, 0x4f, 0x5d, 0x15, 0x5c, 0xb1, 0xab, 0x66,
-	0xe3, 0x0f, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x76, 0x1c, 0x26, 0x0a, 0x22, 0x04, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x29, 0x44, 0xed, 0xb5, 0x0c, 0x1c, 0x41, 0x0d, 0x16, 0x72, 0x5a, 0x57, 0x6a,
+	0x43, 0x20, 0x77, 0x49, 0x91, 0xd8, 0x1b, 0x09, 0x5a, 0xb6, 0xe2, 0x0d, 0x18, 0xd0, 0x39, 0xbd,
+	0x3a, 0x16, 0xb1, 0xcf, 0xcd, 0x5d, 0xaa, 0x46, 0x88, 0x85, 0x85, 0x85, 0x01, 0x09, 0x31, 0x21,
+	0x76, 0x46, 0x3e, 0x46, 0xc7, 0x4a, 0x30, 0x30, 0x55, 0x28, 0x41, 0xe2, 0x3b, 0x30, 0x21, 0xdf,
+	0x9d, 0x93, 0xba, 0x6d, 0x9a, 0x76, 0x49, 0x5e, 0x9e, 0xdf, 0xff, 0xff, 0x7e, 0x7e, 0xef, 0x05,
+	0xae, 0xb4, 0xb8, 0x08, 0xb9, 0x20, 0x6c, 0x3f, 0xd8, 0x61, 0x51, 0x8b, 0x91, 0xfd, 0x86, 0xc7,
+	0x24, 0x6d, 0x90, 0xbd, 0x1e, 0xeb, 0xf6, 0x71, 0xdc, 0xe5, 0x92, 0xa3, 0x45, 0x5d, 0x84, 0xd3,
+	0x22, 0x6c, 0x8a, 0xac, 0xaa, 0x51, 0x7b, 0x54, 0x30, 0xad, 0x18, 0xe9, 0x63, 0xea, 0x07, 0x11,
+	0x95, 0x01, 0x8f, 0xb4, 0x89, 0x55, 0xf4, 0xb9, 0xcf, 0x55, 0x48, 0x92, 0xc8, 0x64, 0xef, 0xf8,
+	0x9c, 0xfb, 0x1d, 0x46, 0xd4, 0x2f, 0xaf, 0xb7, 0x4b, 0x68, 0x64, 0xba, 0x5a, 0x77, 0xcd, 0x23,
+	0x1a, 0x07, 0x84, 0x46, 0x11, 0x97, 0xca, 0x4d, 0x98, 0xa7, 0x37, 0x69, 0x18, 0x44, 0x9c, 0xa8,
+	0x4f, 0x93, 0x5a, 0x9d, 0xf4, 0x2e, 0x23, 0x6e, 0x55, 0xe7, 0xbc, 0x07, 0xb0, 0xf8, 0x2c, 0x81,
+	0x7d, 0x6c, 0xf2, 0x2e, 0xdb, 0xeb, 0x31, 0x21, 0xd1, 0x4b, 0x78, 0x23, 0x2d, 0x7d, 0xd5, 0xa6,
+	0xa2, 0x5d, 0x02, 0x4b, 0xa0, 0xb2, 0xd0, 0x7c, 0xf4, 0xef, 0xb8, 0x5c, 0xf7, 0x03, 0xd9, 0xee,
+	0x79, 0xb8, 0xc5, 0x43, 0xd2, 0xe2, 0x21, 0x93, 0xde, 0xae, 0x1c, 0x07, 0x9d, 0xc0, 0x13, 0xc4,
+	0xeb, 0x4b, 0x26, 0xf0, 0x16, 0x3b, 0x68, 0x26, 0x41, 0x09, 0xb8, 0x0b, 0xa9, 0xd9, 0x16, 0x15,
+	0x6d, 0x84, 0xe0, 0x35, 0xe5, 0x99, 0x5f, 0x02, 0x95, 0x39, 0x57, 0xc5, 0xce, 0x53, 0x78, 0xfb,
+	0x14, 0x88, 0x88, 0x79, 0x24, 0x18, 0xaa, 0xc3, 0xd9, 0x54, 0xac, 0x20, 0xe6, 0xd7, 0x8b, 0x58,
+	0x8f, 0x03, 0xa7, 0x93, 0xc2, 0x1b, 0x51, 0xdf, 0x1d, 0x55, 0x39, 0x14, 0x2e, 0x2a, 0xab, 0x8d,
+	0x4e, 0xe7, 0xf4, 0x6b, 0x3d, 0x81, 0x70, 0xbc, 0x0d, 0x63, 0xb7, 0x8a, 0xcd, 0x4e, 0x93, 0xd5,
+	0x61, 0xbd, 0x6c, 0x33, 0x2e, 0xbc, 0x4d, 0xfd, 0x54, 0xeb, 0x9e, 0x50, 0x3a, 0x9f, 0x01, 0x2c,
+	0x9d, 0xed, 0x71, 0x2e, 0xf1, 0xcc, 0x74, 0x62, 0xb4, 0x99, 0xc1, 0xca, 0x2b, 0xac, 0xb5, 0xa9,
+	0x58, 0xba, 0x5d, 0x86, 0xab, 0x08, 0x91, 0xc2, 0xda, 0xa6, 0x5d, 0x1a, 0x0a, 0x43, 0xee, 0x3c,
+	0x87, 0xb7, 0x32, 0x59, 0xc3, 0xd9, 0x84, 0x85, 0x58, 0x65, 0xcc, 0x20, 0xca, 0x78, 0xc2, 0x71,
+	0x63, 0x2d, 0x6c, 0xce, 0x1d, 0x1e, 0x97, 0x73, 0xdf, 0xfe, 0x7e, 0xaf, 0x02, 0xd7, 0x28, 0xd7,
+	0x7f, 0xce, 0xc0, 0xeb, 0xca, 0x1b, 0x7d, 0x01, 0x70, 0x36, 0x1d, 0x05, 0xaa, 0x4d, 0xb4, 0x3a,
+	0xef, 0xda, 0x2c, 0x7c, 0xd9, 0x72, 0x4d, 0xee, 0xd4, 0xdf, 0xfd, 0xf8, 0xf3, 0x29, 0x5f, 0x45,
+	0x15, 0x32, 0xed, 0xce, 0xc9, 0x9b, 0xe4, 0xba, 0xde, 0xa2, 0xaf, 0x00, 0xce, 0x9f, 0xd8, 0x15,
+	0xaa, 0x5f, 0xdc, 0xf1, 0xec, 0xe9, 0x58, 0x8d, 0x2b, 0x28, 0x0c, 0xe6, 0x3d, 0x85, 0xb9, 0x82,
+	0x96, 0xa7, 0x62, 0xa2, 0x0f, 0x00, 0x16, 0xf4, 0x94, 0xd1, 0xfd, 0x8b, 0x1b, 0x65, 0x56, 0x6b,
+	0x3d, 0xb8, 0x5c, 0xb1, 0x01, 0x5a, 0x53, 0x40, 0xcb, 0xa8, 0x3c, 0x11, 0x48, 0xaf, 0xb5, 0xb9,
+	0x79, 0x38, 0xb0, 0xc1, 0xd1, 0xc0, 0x06, 0xbf, 0x07, 0x36, 0xf8, 0x38, 0xb4, 0x73, 0x47, 0x43,
+	0x3b, 0xf7, 0x6b, 0x68, 0xe7, 0x5e, 0xd4, 0x32, 0xff, 0x7e, 0x65, 0xa2, 0xbf, 0x6a, 0x62, 0xe7,
+	0x35, 0x39, 0x18, 0x3b, 0xca, 0x7e, 0xcc, 0x84, 0x57, 0x50, 0x17, 0xff, 0xf0, 0xff, 0x00, 0xeb,
+	0x6e, 0xa1, 0xa7, 0x5d, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -301,6 +393,8 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the x/evidence module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -329,12 +423,23 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the x/evidence module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -347,6 +452,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -388,6 +496,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -400,6 +526,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -561,6 +691,62 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -634,6 +820,26 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1048,6 +1254,139 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,9 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	// FlagAllowedFieldValues is repeated, with values formatted as <msg-type-url>:<field>=<value1>,<value2>,...
+	FlagAllowedFieldValues = "allowed-field-values"
+	FlagMaxGasPrices       = "max-gas-prices"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,8 +60,10 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
-				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --max-gas-prices 0.025stake
+	--allowed-field-values "/cosmos.bank.v1beta1.MsgSend:to_address=cosmos1skjw...,cosmos1hq..."
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -160,6 +165,40 @@ Examples:
 				}
 			}
 
+			allowedFieldValues, err := cmd.Flags().GetStringArray(FlagAllowedFieldValues)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedFieldValues) > 0 {
+				restrictions, err := parseMsgFieldRestrictions(allowedFieldValues)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewAllowedMsgFieldsAllowance(grant, restrictions)
+				if err != nil {
+					return err
+				}
+			}
+
+			maxGasPricesVal, err := cmd.Flags().GetString(FlagMaxGasPrices)
+			if err != nil {
+				return err
+			}
+
+			if maxGasPricesVal != "" {
+				maxGasPrices, err := sdk.ParseDecCoins(maxGasPricesVal)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewGasPriceCapAllowance(grant, maxGasPrices)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
 			if err != nil {
 				return err
@@ -171,6 +210,8 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().StringArray(FlagAllowedFieldValues, []string{}, "Allowed values of a message field for fee allowance, as <msg-type-url>:<field>=<value1>,<value2>,... (can be repeated)")
+	cmd.Flags().String(FlagMaxGasPrices, "", "Max gas prices of the txs the fee allowance pays for, e.g. 0.025stake")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
@@ -179,6 +220,30 @@ Examples:
 	return cmd
 }
 
+// parseMsgFieldRestrictions parses message field restrictions formatted as
+// <msg-type-url>:<field>=<value1>,<value2>,...
+func parseMsgFieldRestrictions(values []string) ([]feegrant.MsgFieldRestriction, error) {
+	restrictions := make([]feegrant.MsgFieldRestriction, len(values))
+	for i, value := range values {
+		msgTypeURL, rest, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid allowed field values %s, expected <msg-type-url>:<field>=<value1>,<value2>,...", value)
+		}
+		field, allowedValues, ok := strings.Cut(rest, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid allowed field values %s, expected <msg-type-url>:<field>=<value1>,<value2>,...", value)
+		}
+
+		restrictions[i] = feegrant.MsgFieldRestriction{
+			MsgTypeUrl:    msgTypeURL,
+			Field:         field,
+			AllowedValues: strings.Split(allowedValues, ","),
+		}
+	}
+
+	return restrictions, nil
+}
+
 // NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
 func NewCmdRevokeFeegrant() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,6 +21,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
@@ -699,6 +700,35 @@ func (s *CLITestSuite) TestFilteredFeeAllowance() {
 			),
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"invalid allowed field values",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedFieldValues, "to_address"),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, spendLimit.String()),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"valid allowed field values and max gas prices fee grant",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s:to_address=%s", cli.FlagAllowedFieldValues, sdk.MsgTypeURL(&banktypes.MsgSend{}), granter),
+					fmt.Sprintf("--%s=%s", cli.FlagMaxGasPrices, "0.025stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, spendLimit.String()),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, &sdk.TxResponse{}, 0,
+		},
 	}
 
 	for _, tc := range testCases {
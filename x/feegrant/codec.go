@@ -22,6 +22,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance", nil)
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance", nil)
+	cdc.RegisterConcrete(&AllowedMsgFieldsAllowance{}, "cosmos-sdk/AllowedMsgFieldsAllowance", nil)
+	cdc.RegisterConcrete(&GasPriceCapAllowance{}, "cosmos-sdk/GasPriceCapAllowance", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -37,6 +39,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&AllowedMsgFieldsAllowance{},
+		&GasPriceCapAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -219,6 +219,161 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// AllowedMsgFieldsAllowance creates allowance only for messages whose fields
+// have allowed values, e.g. to restrict the recipients, contracts or validators
+// that may appear in the messages it pays fees for.
+type AllowedMsgFieldsAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// restrictions are the restrictions that the fields of the messages must
+	// all satisfy. Messages of types without restrictions are not allowed. The
+	// messages executed by authz's MsgExec are checked instead of the MsgExec.
+	Restrictions []MsgFieldRestriction `protobuf:"bytes,2,rep,name=restrictions,proto3" json:"restrictions"`
+}
+
+func (m *AllowedMsgFieldsAllowance) Reset()         { *m = AllowedMsgFieldsAllowance{} }
+func (m *AllowedMsgFieldsAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsgFieldsAllowance) ProtoMessage()    {}
+func (*AllowedMsgFieldsAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *AllowedMsgFieldsAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsgFieldsAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsgFieldsAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsgFieldsAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsgFieldsAllowance.Merge(m, src)
+}
+func (m *AllowedMsgFieldsAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsgFieldsAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsgFieldsAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsgFieldsAllowance proto.InternalMessageInfo
+
+// MsgFieldRestriction restricts the values of a field of the messages of a type.
+type MsgFieldRestriction struct {
+	// msg_type_url is the type URL of the restricted messages.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// field is the path of the restricted field, as the proto names of the
+	// fields separated by dots, e.g. "to_address" or "outputs.address".
+	// The restriction applies to each element of repeated fields.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// allowed_values is the list of values allowed for the field, in their text
+	// format, e.g. an address.
+	AllowedValues []string `protobuf:"bytes,3,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+}
+
+func (m *MsgFieldRestriction) Reset()         { *m = MsgFieldRestriction{} }
+func (m *MsgFieldRestriction) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldRestriction) ProtoMessage()    {}
+func (*MsgFieldRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *MsgFieldRestriction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldRestriction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldRestriction.Merge(m, src)
+}
+func (m *MsgFieldRestriction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldRestriction proto.InternalMessageInfo
+
+func (m *MsgFieldRestriction) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFieldRestriction) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MsgFieldRestriction) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+// GasPriceCapAllowance creates allowance only for transactions whose gas
+// prices, i.e. the fees divided by the gas limit, are at most the given caps.
+type GasPriceCapAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_prices are the maximum gas prices allowed, one per fee denom.
+	// Fees in other denoms are not allowed.
+	MaxGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=max_gas_prices,json=maxGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_gas_prices"`
+}
+
+func (m *GasPriceCapAllowance) Reset()         { *m = GasPriceCapAllowance{} }
+func (m *GasPriceCapAllowance) String() string { return proto.CompactTextString(m) }
+func (*GasPriceCapAllowance) ProtoMessage()    {}
+func (*GasPriceCapAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *GasPriceCapAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceCapAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceCapAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceCapAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceCapAllowance.Merge(m, src)
+}
+func (m *GasPriceCapAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceCapAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceCapAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceCapAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +388,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{6}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +442,9 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*AllowedMsgFieldsAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgFieldsAllowance")
+	proto.RegisterType((*MsgFieldRestriction)(nil), "cosmos.feegrant.v1beta1.MsgFieldRestriction")
+	proto.RegisterType((*GasPriceCapAllowance)(nil), "cosmos.feegrant.v1beta1.GasPriceCapAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,47 +453,59 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xb6, 0x28, 0x17, 0x28, 0xad, 0xa9, 0x84, 0x53, 0x21, 0xbb, 0x8a, 0x04, 0x4d,
-	0x2b, 0xd5, 0x56, 0x8b, 0x58, 0x3a, 0x35, 0x2e, 0xa2, 0x80, 0x5a, 0xa9, 0x72, 0x99, 0x90, 0x50,
-	0x74, 0xb6, 0xaf, 0xe6, 0x44, 0xec, 0x33, 0x3e, 0x17, 0x1a, 0x06, 0x66, 0xc4, 0x80, 0x32, 0x32,
-	0x32, 0x22, 0xa6, 0x0e, 0xe5, 0x3b, 0x54, 0x0c, 0xa8, 0x62, 0x62, 0x22, 0x28, 0x19, 0x3a, 0xf3,
-	0x0d, 0x90, 0xef, 0xce, 0x8e, 0x9b, 0x50, 0x68, 0x25, 0xba, 0x24, 0x77, 0xef, 0xde, 0xfb, 0xfd,
-	0x79, 0xef, 0x45, 0x01, 0xb7, 0x1c, 0x42, 0x7d, 0x42, 0x8d, 0x1d, 0x84, 0xbc, 0x08, 0x06, 0xb1,
-	0xf1, 0x62, 0xc9, 0x46, 0x31, 0x5c, 0xca, 0x02, 0x7a, 0x18, 0x91, 0x98, 0xc8, 0xd7, 0x79, 0x9e,
-	0x9e, 0x85, 0x45, 0xde, 0xcc, 0xb4, 0x47, 0x3c, 0xc2, 0x72, 0x8c, 0xe4, 0xc4, 0xd3, 0x67, 0x2a,
-	0x1e, 0x21, 0x5e, 0x13, 0x19, 0xec, 0x66, 0xef, 0xee, 0x18, 0x30, 0x68, 0xa5, 0x4f, 0x1c, 0xa9,
-	0xc1, 0x6b, 0x04, 0x2c, 0x7f, 0x52, 0x85, 0x18, 0x1b, 0x52, 0x94, 0x09, 0x71, 0x08, 0x0e, 0xc4,
-	0xfb, 0x14, 0xf4, 0x71, 0x40, 0x0c, 0xf6, 0x29, 0x42, 0xda, 0x20, 0x51, 0x8c, 0x7d, 0x44, 0x63,
-	0xe8, 0x87, 0x29, 0xe6, 0x60, 0x82, 0xbb, 0x1b, 0xc1, 0x18, 0x13, 0x81, 0x59, 0x7d, 0x37, 0x02,
-	0x26, 0x4c, 0x48, 0xb1, 0x53, 0x6f, 0x36, 0xc9, 0x4b, 0x18, 0x38, 0x48, 0x7e, 0x0e, 0xca, 0x34,
-	0x44, 0x81, 0xdb, 0x68, 0x62, 0x1f, 0xc7, 0x8a, 0x34, 0x5b, 0xac, 0x95, 0x97, 0x2b, 0xba, 0x90,
-	0x9a, 0x88, 0x4b, 0xdd, 0xeb, 0x6b, 0x04, 0x07, 0xe6, 0x9d, 0xc3, 0x1f, 0x5a, 0xe1, 0x53, 0x47,
-	0xab, 0x79, 0x38, 0x7e, 0xba, 0x6b, 0xeb, 0x0e, 0xf1, 0x85, 0x2f, 0xf1, 0xb5, 0x48, 0xdd, 0x67,
-	0x46, 0xdc, 0x0a, 0x11, 0x65, 0x05, 0xf4, 0xe3, 0xf1, 0xfe, 0x82, 0x64, 0x01, 0x46, 0xb2, 0x91,
-	0x70, 0xc8, 0xab, 0x00, 0xa0, 0xbd, 0x10, 0x73, 0x65, 0xca, 0xc8, 0xac, 0x54, 0x2b, 0x2f, 0xcf,
-	0xe8, 0x5c, 0xba, 0x9e, 0x4a, 0xd7, 0x1f, 0xa5, 0xde, 0xcc, 0xd1, 0x76, 0x47, 0x93, 0xac, 0x5c,
-	0xcd, 0xca, 0xfa, 0x97, 0x83, 0xc5, 0x9b, 0xa7, 0x0c, 0x49, 0xbf, 0x87, 0x50, 0x66, 0xef, 0xc1,
-	0xdb, 0xe3, 0xfd, 0x85, 0x4a, 0x4e, 0xd8, 0x49, 0xf7, 0xd5, 0xcf, 0xa3, 0x60, 0x6a, 0x0b, 0x45,
-	0x98, 0xb8, 0xf9, 0x9e, 0xdc, 0x07, 0x63, 0x76, 0x92, 0xa7, 0x48, 0x4c, 0xdb, 0x9c, 0x7e, 0x1a,
-	0xd5, 0x49, 0x34, 0xb3, 0x94, 0xf4, 0x86, 0xfb, 0xe5, 0x00, 0xf2, 0x2a, 0x18, 0x0f, 0x19, 0xbc,
-	0xb0, 0x59, 0x19, 0xb2, 0x79, 0x57, 0x4c, 0xc8, 0xbc, 0x92, 0x14, 0xbf, 0xef, 0x68, 0x12, 0x07,
-	0x10, 0x75, 0xf2, 0x6b, 0x20, 0xf3, 0x53, 0x23, 0x3f, 0xa6, 0xe2, 0x05, 0x8d, 0x69, 0x92, 0x73,
-	0x6d, 0xf7, 0x87, 0xf5, 0x0a, 0x88, 0x58, 0xc3, 0x81, 0x01, 0xd7, 0xa0, 0x8c, 0x5e, 0x10, 0xfb,
-	0x04, 0x67, 0x5a, 0x83, 0x01, 0x13, 0x20, 0x6f, 0x80, 0xcb, 0x82, 0x3b, 0x42, 0x14, 0xc5, 0xca,
-	0xd8, 0x3f, 0x57, 0x85, 0x35, 0xb1, 0x9d, 0x35, 0xb1, 0xcc, 0xcb, 0xad, 0xa4, 0x7a, 0xe5, 0xe1,
-	0xb9, 0x96, 0xe6, 0x46, 0x4e, 0xe8, 0xd0, 0x86, 0x54, 0x7f, 0x49, 0xe0, 0x1a, 0xbb, 0x21, 0x77,
-	0x93, 0x7a, 0xfd, 0xcd, 0x79, 0x02, 0x4a, 0x30, 0xbd, 0x88, 0xed, 0x99, 0x1e, 0x92, 0x5b, 0x0f,
-	0x5a, 0xe6, 0xfc, 0x99, 0xc5, 0x58, 0x7d, 0x44, 0x79, 0x1e, 0x4c, 0x42, 0xce, 0xda, 0xf0, 0x11,
-	0xa5, 0xd0, 0x43, 0x54, 0x19, 0x99, 0x2d, 0xd6, 0x4a, 0xd6, 0x55, 0x11, 0xdf, 0x14, 0xe1, 0x95,
-	0xad, 0x37, 0x1f, 0xb4, 0xc2, 0xb9, 0x1c, 0xab, 0x39, 0xc7, 0x7f, 0xf0, 0x56, 0xfd, 0x2a, 0x81,
-	0xb1, 0xf5, 0x04, 0x42, 0x5e, 0x06, 0x97, 0x18, 0x16, 0x8a, 0x98, 0xc7, 0x92, 0xa9, 0x7c, 0x3b,
-	0x58, 0x9c, 0x16, 0x44, 0x75, 0xd7, 0x8d, 0x10, 0xa5, 0xdb, 0x71, 0x84, 0x03, 0xcf, 0x4a, 0x13,
-	0xfb, 0x35, 0x88, 0xfd, 0x14, 0xce, 0x50, 0x33, 0xd0, 0xcd, 0xe2, 0xff, 0xee, 0xa6, 0x59, 0x3f,
-	0xec, 0xaa, 0xd2, 0x51, 0x57, 0x95, 0x7e, 0x76, 0x55, 0xa9, 0xdd, 0x53, 0x0b, 0x47, 0x3d, 0xb5,
-	0xf0, 0xbd, 0xa7, 0x16, 0x1e, 0xcf, 0xfd, 0x75, 0x6f, 0xf7, 0xb2, 0xff, 0x0b, 0x7b, 0x9c, 0xc9,
-	0xb8, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x3d, 0x09, 0x1d, 0x5a, 0x06, 0x00, 0x00,
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x3b, 0x45,
+	0x14, 0xef, 0xb6, 0x14, 0xd3, 0x69, 0xa9, 0xb0, 0x34, 0x71, 0x4b, 0xc8, 0xb6, 0x69, 0x44, 0x0a,
+	0xca, 0x36, 0xd4, 0x98, 0x98, 0x9e, 0xe8, 0x42, 0x40, 0x0d, 0x24, 0xb8, 0x20, 0x07, 0x8d, 0xd9,
+	0x4c, 0x77, 0x87, 0x75, 0xe2, 0xfe, 0x72, 0x67, 0x8b, 0xad, 0x31, 0x9e, 0x8d, 0x07, 0xc3, 0xd1,
+	0xa3, 0x47, 0xe3, 0x45, 0x0e, 0xf8, 0x2f, 0x18, 0xe2, 0xc1, 0x10, 0x4f, 0x9e, 0xc4, 0xc0, 0x81,
+	0xb3, 0xff, 0x81, 0xd9, 0x99, 0xd9, 0x76, 0x29, 0xc5, 0x2f, 0x4d, 0xbe, 0x70, 0x69, 0x77, 0xde,
+	0xbe, 0xf7, 0x79, 0x9f, 0xcf, 0x7b, 0x6f, 0x5e, 0x0b, 0xde, 0x30, 0x3c, 0xe2, 0x78, 0xa4, 0x71,
+	0x8c, 0x90, 0x15, 0x40, 0x37, 0x6c, 0x9c, 0xac, 0x77, 0x50, 0x08, 0xd7, 0x07, 0x06, 0xc5, 0x0f,
+	0xbc, 0xd0, 0x13, 0x5f, 0x63, 0x7e, 0xca, 0xc0, 0xcc, 0xfd, 0x16, 0x4a, 0x96, 0x67, 0x79, 0xd4,
+	0xa7, 0x11, 0x3d, 0x31, 0xf7, 0x85, 0xb2, 0xe5, 0x79, 0x96, 0x8d, 0x1a, 0xf4, 0xd4, 0xe9, 0x1e,
+	0x37, 0xa0, 0xdb, 0x8f, 0x5f, 0x31, 0x24, 0x9d, 0xc5, 0x70, 0x58, 0xf6, 0x4a, 0xe6, 0x64, 0x3a,
+	0x90, 0xa0, 0x01, 0x11, 0xc3, 0xc3, 0x2e, 0x7f, 0x3f, 0x07, 0x1d, 0xec, 0x7a, 0x0d, 0xfa, 0xc9,
+	0x4d, 0x95, 0xd1, 0x44, 0x21, 0x76, 0x10, 0x09, 0xa1, 0xe3, 0xc7, 0x98, 0xa3, 0x0e, 0x66, 0x37,
+	0x80, 0x21, 0xf6, 0x38, 0x66, 0xed, 0xfb, 0x34, 0x28, 0xaa, 0x90, 0x60, 0xa3, 0x6d, 0xdb, 0xde,
+	0x97, 0xd0, 0x35, 0x90, 0xf8, 0x05, 0xc8, 0x13, 0x1f, 0xb9, 0xa6, 0x6e, 0x63, 0x07, 0x87, 0x92,
+	0x50, 0xcd, 0xd4, 0xf3, 0xcd, 0xb2, 0xc2, 0xa9, 0x46, 0xe4, 0x62, 0xf5, 0xca, 0xa6, 0x87, 0x5d,
+	0xf5, 0x9d, 0x8b, 0xbf, 0x2b, 0xa9, 0x9f, 0xaf, 0x2a, 0x75, 0x0b, 0x87, 0x9f, 0x75, 0x3b, 0x8a,
+	0xe1, 0x39, 0x5c, 0x17, 0xff, 0x5a, 0x23, 0xe6, 0xe7, 0x8d, 0xb0, 0xef, 0x23, 0x42, 0x03, 0xc8,
+	0x4f, 0xb7, 0x67, 0xab, 0x82, 0x06, 0x68, 0x92, 0xdd, 0x28, 0x87, 0xb8, 0x01, 0x00, 0xea, 0xf9,
+	0x98, 0x31, 0x93, 0xd2, 0x55, 0xa1, 0x9e, 0x6f, 0x2e, 0x28, 0x8c, 0xba, 0x12, 0x53, 0x57, 0x0e,
+	0x63, 0x6d, 0xea, 0xd4, 0xe9, 0x55, 0x45, 0xd0, 0x12, 0x31, 0xad, 0x9d, 0xdf, 0xcf, 0xd7, 0x96,
+	0x1e, 0x68, 0x92, 0xb2, 0x8d, 0xd0, 0x40, 0xde, 0xfb, 0xdf, 0xdd, 0x9e, 0xad, 0x96, 0x13, 0xc4,
+	0xee, 0xaa, 0xaf, 0xfd, 0x3a, 0x05, 0xe6, 0xf6, 0x51, 0x80, 0x3d, 0x33, 0x59, 0x93, 0xf7, 0x40,
+	0xb6, 0x13, 0xf9, 0x49, 0x02, 0xe5, 0xb6, 0xac, 0x3c, 0x94, 0xea, 0x2e, 0x9a, 0x9a, 0x8b, 0x6a,
+	0xc3, 0xf4, 0x32, 0x00, 0x71, 0x03, 0x4c, 0xfb, 0x14, 0x9e, 0xcb, 0x2c, 0xdf, 0x93, 0xb9, 0xc5,
+	0x3b, 0xa4, 0xce, 0x44, 0xc1, 0x3f, 0x5c, 0x55, 0x04, 0x06, 0xc0, 0xe3, 0xc4, 0x6f, 0x80, 0xc8,
+	0x9e, 0xf4, 0x64, 0x9b, 0x32, 0x4f, 0xd4, 0xa6, 0x59, 0x96, 0xeb, 0x60, 0xd8, 0xac, 0xaf, 0x00,
+	0xb7, 0xe9, 0x06, 0x74, 0x19, 0x07, 0x69, 0xea, 0x89, 0xb2, 0x17, 0x59, 0xa6, 0x4d, 0xe8, 0x52,
+	0x02, 0xe2, 0x2e, 0x28, 0xf0, 0xdc, 0x01, 0x22, 0x28, 0x94, 0xb2, 0x2f, 0x1c, 0x15, 0x5a, 0xc4,
+	0xd3, 0x41, 0x11, 0xf3, 0x2c, 0x5c, 0x8b, 0xa2, 0x5b, 0x1f, 0x4c, 0x34, 0x34, 0x8b, 0x09, 0xa2,
+	0xf7, 0x26, 0xa4, 0xf6, 0xaf, 0x00, 0xe6, 0xe9, 0x09, 0x99, 0x7b, 0xc4, 0x1a, 0x4e, 0xce, 0xa7,
+	0x20, 0x07, 0xe3, 0x03, 0x9f, 0x9e, 0xd2, 0x3d, 0xba, 0x6d, 0xb7, 0xaf, 0xae, 0x3c, 0x9a, 0x8c,
+	0x36, 0x44, 0x14, 0x57, 0xc0, 0x2c, 0x64, 0x59, 0x75, 0x07, 0x11, 0x02, 0x2d, 0x44, 0xa4, 0x74,
+	0x35, 0x53, 0xcf, 0x69, 0xaf, 0x72, 0xfb, 0x1e, 0x37, 0xb7, 0xf6, 0xbf, 0xfd, 0xb1, 0x92, 0x9a,
+	0x48, 0xb1, 0x9c, 0x50, 0x3c, 0x46, 0x5b, 0xed, 0x97, 0x34, 0x28, 0x0f, 0xed, 0xdb, 0x18, 0xd9,
+	0x26, 0x79, 0x36, 0xe5, 0x9f, 0x80, 0x42, 0x80, 0x48, 0x18, 0x60, 0x23, 0xba, 0x2c, 0x4c, 0x75,
+	0xbe, 0xf9, 0xd6, 0x83, 0x37, 0x33, 0x66, 0xa8, 0x0d, 0x83, 0x92, 0xd7, 0xf3, 0x0e, 0x58, 0xeb,
+	0x68, 0xe2, 0x5a, 0xbd, 0x3e, 0xb6, 0x56, 0x23, 0x35, 0xa9, 0x85, 0x60, 0x7e, 0x0c, 0x0f, 0xb1,
+	0x0a, 0x0a, 0x0e, 0xb1, 0xf4, 0x68, 0xfc, 0xf5, 0x6e, 0x60, 0xd3, 0x6a, 0xe5, 0x34, 0xe0, 0x10,
+	0xeb, 0xb0, 0xef, 0xa3, 0x8f, 0x02, 0x5b, 0x2c, 0x81, 0xec, 0x71, 0x14, 0x45, 0xb7, 0x46, 0x4e,
+	0x63, 0x07, 0x71, 0x09, 0x14, 0xe3, 0xee, 0x9f, 0x40, 0xbb, 0x8b, 0x08, 0x5d, 0x03, 0x39, 0x6d,
+	0x86, 0x5b, 0x8f, 0xa8, 0xb1, 0xf6, 0x5b, 0x1a, 0x94, 0x76, 0x20, 0xd9, 0x0f, 0xb0, 0x81, 0x36,
+	0xa1, 0xff, 0x6c, 0x2d, 0xfa, 0x1a, 0x14, 0x1d, 0xd8, 0xd3, 0x2d, 0x18, 0xfd, 0xdc, 0x61, 0x03,
+	0xc5, 0x4d, 0x5a, 0x1c, 0xbb, 0x27, 0xb6, 0x90, 0x41, 0x57, 0xc5, 0xbb, 0x7c, 0x55, 0xbc, 0xf9,
+	0x88, 0x55, 0xc1, 0x63, 0xf8, 0xb6, 0x28, 0x38, 0xb0, 0x17, 0xeb, 0x24, 0xad, 0x0f, 0x27, 0xee,
+	0x61, 0x25, 0x81, 0x3f, 0xae, 0x5e, 0xb5, 0x3f, 0x04, 0x90, 0xdd, 0x89, 0x30, 0xc4, 0x26, 0x78,
+	0x85, 0x82, 0xa1, 0x80, 0x35, 0x4b, 0x95, 0xfe, 0x3c, 0x5f, 0x2b, 0xf1, 0x4c, 0x6d, 0xd3, 0x0c,
+	0x10, 0x21, 0x07, 0x61, 0x80, 0x5d, 0x4b, 0x8b, 0x1d, 0x87, 0x31, 0x48, 0x4a, 0x3f, 0x2e, 0x66,
+	0xa4, 0x43, 0x99, 0x97, 0xdd, 0x21, 0xb5, 0x7d, 0x71, 0x2d, 0x0b, 0x97, 0xd7, 0xb2, 0xf0, 0xcf,
+	0xb5, 0x2c, 0x9c, 0xde, 0xc8, 0xa9, 0xcb, 0x1b, 0x39, 0xf5, 0xd7, 0x8d, 0x9c, 0xfa, 0x78, 0xf9,
+	0x7f, 0xab, 0xdf, 0x1b, 0xfc, 0x41, 0xea, 0x4c, 0x53, 0x1a, 0x6f, 0xff, 0x37, 0x00, 0xc5, 0xf7,
+	0x39, 0xb3, 0x4b, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -506,6 +676,150 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowedMsgFieldsAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsgFieldsAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsgFieldsAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for iNdEx := len(m.Restrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFieldRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceCapAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceCapAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceCapAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxGasPrices) > 0 {
+		for iNdEx := len(m.MaxGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -631,6 +945,67 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *AllowedMsgFieldsAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.Restrictions) > 0 {
+		for _, e := range m.Restrictions {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFieldRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPriceCapAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.MaxGasPrices) > 0 {
+		for _, e := range m.MaxGasPrices {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1113,6 +1488,392 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AllowedMsgFieldsAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgFieldsAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgFieldsAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = append(m.Restrictions, MsgFieldRestriction{})
+			if err := m.Restrictions[len(m.Restrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceCapAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceCapAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceCapAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrices = append(m.MaxGasPrices, types.DecCoin{})
+			if err := m.MaxGasPrices[len(m.MaxGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,114 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*GasPriceCapAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*GasPriceCapAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *GasPriceCapAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewGasPriceCapAllowance creates new fee allowance capped by gas prices.
+func NewGasPriceCapAllowance(allowance FeeAllowanceI, maxGasPrices sdk.DecCoins) (*GasPriceCapAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasPriceCapAllowance{
+		Allowance:    any,
+		MaxGasPrices: maxGasPrices,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *GasPriceCapAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *GasPriceCapAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept method checks that the gas prices of the tx, computed from the fee
+// and the gas limit of the gas meter, are within the caps.
+func (a *GasPriceCapAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	gasLimit := sdk.NewDecFromInt(sdk.NewIntFromUint64(ctx.GasMeter().Limit()))
+	for _, coin := range fee {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check gas price")
+		maxFee := a.MaxGasPrices.AmountOf(coin.Denom).Mul(gasLimit)
+		if sdk.NewDecFromInt(coin.Amount).GT(maxFee) {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "gas price of %s exceeds max gas prices %s", coin, a.MaxGasPrices)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *GasPriceCapAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if a.MaxGasPrices.Empty() {
+		return sdkerrors.Wrap(ErrNoAllowance, "max gas prices shouldn't be empty")
+	}
+	if err := a.MaxGasPrices.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max gas prices: %s", err)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+func (a *GasPriceCapAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
@@ -0,0 +1,90 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestGasPriceCapAllowanceValidateBasic(t *testing.T) {
+	testCases := map[string]struct {
+		maxGasPrices sdk.DecCoins
+		errMsg       string
+	}{
+		"no max gas prices": {
+			errMsg: "max gas prices shouldn't be empty",
+		},
+		"invalid max gas prices": {
+			maxGasPrices: sdk.DecCoins{sdk.DecCoin{Denom: "atom", Amount: sdk.NewDec(-1)}},
+			errMsg:       "max gas prices",
+		},
+		"valid": {
+			maxGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2))),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewGasPriceCapAllowance(&feegrant.BasicAllowance{}, tc.maxGasPrices)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGasPriceCapAllowanceAccept(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(feegrant.StoreKey), sdk.NewTransientStoreKey("transient_test")).Ctx.
+		WithGasMeter(sdk.NewGasMeter(100000))
+
+	// 0.01atom per gas, i.e. at most 1000atom of fees for 100000 gas.
+	maxGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)))
+
+	cases := map[string]struct {
+		fee    sdk.Coins
+		accept bool
+	}{
+		"gas price below cap": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+			accept: true,
+		},
+		"gas price at cap": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			accept: true,
+		},
+		"gas price above cap": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("atom", 1001)),
+			accept: false,
+		},
+		"denom without cap": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("eth", 1)),
+			accept: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewGasPriceCapAllowance(&feegrant.BasicAllowance{}, maxGasPrices)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, tc.fee, nil)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package feegrant
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	_ FeeAllowanceI                 = (*AllowedMsgFieldsAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*AllowedMsgFieldsAllowance)(nil)
+)
+
+// nestedMsgs is implemented by the messages executing other messages on
+// behalf of other accounts, like authz's MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedMsgFieldsAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewAllowedMsgFieldsAllowance creates new fee allowance restricted by the
+// values of message fields.
+func NewAllowedMsgFieldsAllowance(allowance FeeAllowanceI, restrictions []MsgFieldRestriction) (*AllowedMsgFieldsAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedMsgFieldsAllowance{
+		Allowance:    any,
+		Restrictions: restrictions,
+	}, nil
+}
+
+// GetAllowance returns allowed fee allowance.
+func (a *AllowedMsgFieldsAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets allowed fee allowance.
+func (a *AllowedMsgFieldsAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept method checks that the fields of the messages have allowed values
+func (a *AllowedMsgFieldsAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if err := a.allMsgFieldsAllowed(ctx, msgs); err != nil {
+		return false, err
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// allMsgFieldsAllowed returns an error if a message has no restriction or
+// doesn't satisfy its restrictions. The messages executed on behalf of other
+// accounts, like the ones of authz's MsgExec, are checked instead of the
+// message wrapping them.
+func (a *AllowedMsgFieldsAllowance) allMsgFieldsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if wrapper, ok := msg.(nestedMsgs); ok {
+			nested, err := wrapper.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := a.allMsgFieldsAllowed(ctx, nested); err != nil {
+				return err
+			}
+			continue
+		}
+
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		var m protoreflect.Message
+		for _, r := range a.Restrictions {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg field")
+			if r.MsgTypeUrl != msgTypeURL {
+				continue
+			}
+
+			if m == nil {
+				var err error
+				if m, err = msgservice.MsgToProtoReflect(msg); err != nil {
+					return err
+				}
+			}
+
+			values, err := msgservice.FieldValues(m, r.Field)
+			if err != nil {
+				return err
+			}
+			for _, v := range values {
+				if !r.isAllowed(ctx, v) {
+					return sdkerrors.Wrapf(ErrMessageNotAllowed, "%s is not an allowed value of field %s of %s", v, r.Field, msgTypeURL)
+				}
+			}
+		}
+
+		if m == nil {
+			return sdkerrors.Wrapf(ErrMessageNotAllowed, "%s has no field restriction", msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+func (r MsgFieldRestriction) isAllowed(ctx sdk.Context, value string) bool {
+	for _, allowed := range r.AllowedValues {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg field value")
+		if value == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedMsgFieldsAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.Restrictions) == 0 {
+		return sdkerrors.Wrap(ErrNoMessages, "restrictions shouldn't be empty")
+	}
+
+	for _, r := range a.Restrictions {
+		if err := r.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ValidateBasic checks that the restriction applies to a scalar field of a
+// known message type.
+func (r MsgFieldRestriction) ValidateBasic() error {
+	if len(r.AllowedValues) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("allowed values of field %s of %s shouldn't be empty", r.Field, r.MsgTypeUrl)
+	}
+
+	md, err := msgservice.MsgDescriptor(r.MsgTypeUrl)
+	if err != nil {
+		return err
+	}
+
+	fd, err := msgservice.ResolveFieldPath(md, r.Field)
+	if err != nil {
+		return err
+	}
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+		return sdkerrors.ErrInvalidRequest.Wrapf("field %s of %s of kind %s cannot be restricted", r.Field, r.MsgTypeUrl, fd.Kind())
+	}
+
+	return nil
+}
+
+func (a *AllowedMsgFieldsAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
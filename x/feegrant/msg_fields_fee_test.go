@@ -0,0 +1,138 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAllowedMsgFieldsAllowanceValidateBasic(t *testing.T) {
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := map[string]struct {
+		restrictions []feegrant.MsgFieldRestriction
+		errMsg       string
+	}{
+		"no restrictions": {
+			errMsg: "restrictions shouldn't be empty",
+		},
+		"no allowed values": {
+			restrictions: []feegrant.MsgFieldRestriction{{MsgTypeUrl: sendMsgType, Field: "to_address"}},
+			errMsg:       "allowed values of field to_address",
+		},
+		"unknown msg type": {
+			restrictions: []feegrant.MsgFieldRestriction{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgUnknown", Field: "to_address", AllowedValues: []string{"addr"}}},
+			errMsg:       "unknown msg type",
+		},
+		"unknown field": {
+			restrictions: []feegrant.MsgFieldRestriction{{MsgTypeUrl: sendMsgType, Field: "recipient", AllowedValues: []string{"addr"}}},
+			errMsg:       "unknown field recipient",
+		},
+		"message field": {
+			restrictions: []feegrant.MsgFieldRestriction{{MsgTypeUrl: sendMsgType, Field: "amount", AllowedValues: []string{"1stake"}}},
+			errMsg:       "cannot be restricted",
+		},
+		"valid": {
+			restrictions: []feegrant.MsgFieldRestriction{{MsgTypeUrl: sendMsgType, Field: "to_address", AllowedValues: []string{"addr"}}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedMsgFieldsAllowance(&feegrant.BasicAllowance{}, tc.restrictions)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAllowedMsgFieldsAllowanceAccept(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(feegrant.StoreKey), sdk.NewTransientStoreKey("transient_test")).Ctx
+
+	granter := sdk.AccAddress("granter")
+	allowed := sdk.AccAddress("allowed")
+	other := sdk.AccAddress("other")
+	validator := sdk.ValAddress("validator")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	execAllowed := authz.NewMsgExec(other, []sdk.Msg{banktypes.NewMsgSend(granter, allowed, coins)})
+	execOther := authz.NewMsgExec(other, []sdk.Msg{banktypes.NewMsgSend(granter, other, coins)})
+
+	restrictions := []feegrant.MsgFieldRestriction{
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Field: "to_address", AllowedValues: []string{allowed.String()}},
+		{MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), Field: "outputs.address", AllowedValues: []string{allowed.String()}},
+	}
+
+	cases := map[string]struct {
+		msgs    []sdk.Msg
+		fee     sdk.Coins
+		accept  bool
+		remains sdk.Coins
+	}{
+		"allowed recipient": {
+			msgs:    []sdk.Msg{banktypes.NewMsgSend(granter, allowed, coins)},
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 4)),
+			accept:  true,
+			remains: sdk.NewCoins(sdk.NewInt64Coin("atom", 6)),
+		},
+		"recipient not allowed": {
+			msgs:   []sdk.Msg{banktypes.NewMsgSend(granter, allowed, coins), banktypes.NewMsgSend(granter, other, coins)},
+			accept: false,
+		},
+		"nested recipient not allowed": {
+			msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(granter, coins.Add(coins...))},
+				[]banktypes.Output{banktypes.NewOutput(allowed, coins), banktypes.NewOutput(other, coins)},
+			)},
+			accept: false,
+		},
+		"msg type without restrictions": {
+			msgs:   []sdk.Msg{stakingtypes.NewMsgDelegate(granter, validator, sdk.NewInt64Coin("atom", 1))},
+			accept: false,
+		},
+		"allowed recipient executed by authz": {
+			msgs:    []sdk.Msg{&execAllowed},
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 4)),
+			accept:  true,
+			remains: sdk.NewCoins(sdk.NewInt64Coin("atom", 6)),
+		},
+		"recipient not allowed executed by authz": {
+			msgs:   []sdk.Msg{&execOther},
+			accept: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := feegrant.NewAllowedMsgFieldsAllowance(&feegrant.BasicAllowance{SpendLimit: coins}, restrictions)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tc.remains, inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
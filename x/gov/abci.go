@@ -17,6 +17,9 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 	logger := keeper.Logger(ctx)
 
+	// delete the proposer submissions which left the proposer rate limit period
+	keeper.PruneProposerSubmissions(ctx)
+
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
@@ -58,6 +61,18 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		return false
 	})
 
+	// move into voting period the proposals whose deposit meets the decayed minimum deposit
+	for _, proposalID := range keeper.ActivateProposalsMeetingMinDeposit(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalDeposit,
+				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposalID)),
+			),
+		)
+
+		logger.Info("proposal met the decayed minimum deposit; voting period started", "proposal", proposalID)
+	}
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
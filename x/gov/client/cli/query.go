@@ -38,6 +38,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryTallyPreview(),
+		GetCmdQueryMinDeposit(),
+		GetCmdQueryProposerRateLimit(),
 	)
 
 	return govQueryCmd
@@ -508,6 +511,144 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryTallyPreview implements the command to query for the tally preview of a proposal.
+func GetCmdQueryTallyPreview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-preview [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get a preview of the tally of a proposal in voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of a proposal in voting period as if its voting period
+ended now, with the voting power of each bonded validator inherited from its
+delegators which did not vote and overridden by its delegators which voted.
+
+Example:
+$ %s query gov tally-preview 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.TallyPreview(
+				cmd.Context(),
+				&v1.QueryTallyPreviewRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMinDeposit implements the command to query for the deposits currently required for a proposal.
+func GetCmdQueryMinDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-deposit",
+		Args:  cobra.NoArgs,
+		Short: "Query the deposits currently required for a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposit currently required for a proposal to enter voting period
+and the deposit currently required at its submission. They rise with the number
+of proposals in voting period.
+
+Example:
+$ %s query gov min-deposit
+$ %s query gov min-deposit --expedited
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			expedited, _ := cmd.Flags().GetBool(flagExpedited)
+
+			res, err := queryClient.MinDeposit(
+				cmd.Context(),
+				&v1.QueryMinDepositRequest{Expedited: expedited},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagExpedited, false, "(optional) query the deposits required for an expedited proposal")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposerRateLimit implements the command to query for the proposals submitted by a
+// proposer within the proposer rate limit period.
+func GetCmdQueryProposerRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-rate-limit [proposer-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the proposals submitted by a proposer within the rate limit period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposals submitted by a proposer within the proposer rate limit
+period, and the time from which it can submit a proposal again if it reached
+the rate limit.
+
+Example:
+$ %s query gov proposer-rate-limit cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposerRateLimit(
+				cmd.Context(),
+				&v1.QueryProposerRateLimitRequest{Proposer: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -29,6 +29,7 @@ const (
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
+	flagExpedited    = "expedited"
 	FlagMetadata     = "metadata"
 	FlagSummary      = "summary"
 	// Deprecated: only used for v1beta1 legacy proposals.
@@ -281,6 +281,89 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// TallyPreview queries the tally of a proposal in voting period as if its voting period ended now
+func (q Keeper) TallyPreview(c context.Context, req *v1.QueryTallyPreviewRequest) (*v1.QueryTallyPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	passes, burnDeposits, tallyResult, validators := q.PreviewTally(ctx, proposal)
+
+	return &v1.QueryTallyPreviewResponse{
+		Tally:        &tallyResult,
+		Passes:       passes,
+		BurnDeposits: burnDeposits,
+		Validators:   validators,
+	}, nil
+}
+
+// MinDeposit queries the deposits currently required for a proposal to enter voting period and at its submission
+func (q Keeper) MinDeposit(c context.Context, req *v1.QueryMinDepositRequest) (*v1.QueryMinDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	minDeposit := q.GetMinDeposit(ctx, req.Expedited)
+	minInitialDeposit := sdk.NewCoins()
+	for _, coin := range minDeposit {
+		minInitialDeposit = minInitialDeposit.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(minInitialDepositRatio).RoundInt()))
+	}
+
+	return &v1.QueryMinDepositResponse{MinDeposit: minDeposit, MinInitialDeposit: minInitialDeposit}, nil
+}
+
+// ProposerRateLimit queries the proposals submitted by a proposer within the proposer rate limit period
+func (q Keeper) ProposerRateLimit(c context.Context, req *v1.QueryProposerRateLimitRequest) (*v1.QueryProposerRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Proposer == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty proposer address")
+	}
+
+	proposer, err := sdk.AccAddressFromBech32(req.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var maxProposals uint64
+	if params := q.GetParams(ctx); params.ProposerRateLimitEnabled() {
+		maxProposals = params.MaxProposalsPerProposer
+	}
+
+	return &v1.QueryProposerRateLimitResponse{
+		Submissions:        q.GetProposerSubmissionsInWindow(ctx, proposer),
+		MaxProposals:       maxProposals,
+		NextSubmissionTime: q.NextProposerSubmissionTime(ctx, proposer),
+	}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
@@ -1515,6 +1515,141 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryTallyPreview() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	var req *v1.QueryTallyPreviewRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QueryTallyPreviewRequest{}
+			},
+			false,
+		},
+		{
+			"non existing proposal request",
+			func() {
+				req = &v1.QueryTallyPreviewRequest{ProposalId: 2}
+			},
+			false,
+		},
+		{
+			"proposal status passed",
+			func() {
+				propTime := time.Now()
+				proposal := v1.Proposal{
+					Id:              1,
+					Status:          v1.StatusPassed,
+					SubmitTime:      &propTime,
+					VotingStartTime: &propTime,
+					VotingEndTime:   &propTime,
+					Metadata:        "proposal metadata",
+				}
+				suite.govKeeper.SetProposal(ctx, proposal)
+
+				req = &v1.QueryTallyPreviewRequest{ProposalId: proposal.Id}
+			},
+			false,
+		},
+		{
+			"proposal is in voting period",
+			func() {
+				propTime := time.Now()
+				proposal := v1.Proposal{
+					Id:              1,
+					Status:          v1.StatusVotingPeriod,
+					SubmitTime:      &propTime,
+					VotingStartTime: &propTime,
+					VotingEndTime:   &propTime,
+					Metadata:        "proposal metadata",
+				}
+				suite.govKeeper.SetProposal(ctx, proposal)
+
+				req = &v1.QueryTallyPreviewRequest{ProposalId: proposal.Id}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			previewRes, err := queryClient.TallyPreview(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				expTally := v1.EmptyTallyResult()
+				suite.Require().Equal(expTally.String(), previewRes.Tally.String())
+				suite.Require().False(previewRes.Passes)
+				suite.Require().Empty(previewRes.Validators)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(previewRes)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMinDeposit() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params := v1.DefaultParams()
+	params.MinInitialDepositRatio = sdk.NewDecWithPrec(5, 1).String()
+	params.MinDepositIncreaseRatio = sdk.NewDecWithPrec(1, 1).String()
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+	suite.govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	res, err := queryClient.MinDeposit(gocontext.Background(), &v1.QueryMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(11000000))), sdk.Coins(res.MinDeposit))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5500000))), sdk.Coins(res.MinInitialDeposit))
+
+	res, err = queryClient.MinDeposit(gocontext.Background(), &v1.QueryMinDepositRequest{Expedited: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(55000000))), sdk.Coins(res.MinDeposit))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposerRateLimit() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.ProposerRateLimit(gocontext.Background(), &v1.QueryProposerRateLimitRequest{})
+	suite.Require().Error(err)
+
+	period := time.Hour
+	params := v1.DefaultParams()
+	params.MaxProposalsPerProposer = 1
+	params.ProposerRateLimitPeriod = &period
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+
+	res, err := queryClient.ProposerRateLimit(gocontext.Background(), &v1.QueryProposerRateLimitRequest{Proposer: suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Submissions, 1)
+	suite.Require().Equal(proposal.Id, res.Submissions[0].ProposalId)
+	suite.Require().Equal(uint64(1), res.MaxProposals)
+	suite.Require().Equal(proposal.SubmitTime.Add(period), *res.NextSubmissionTime)
+
+	res, err = queryClient.ProposerRateLimit(gocontext.Background(), &v1.QueryProposerRateLimitRequest{Proposer: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Submissions)
+	suite.Require().Nil(res.NextSubmissionTime)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MaxMinDepositActivationChecks is the maximum number of proposals in deposit period, by deposit
+// end time, whose total deposit is checked against the decayed minimum deposit in each block.
+const MaxMinDepositActivationChecks = 100
+
+// GetMinDeposit returns the deposit currently required for a proposal to enter voting period. It is
+// the minimum deposit of the params, risen by MinDepositIncreaseRatio of it for each proposal in
+// voting period, each rise decaying linearly over MinDepositDecayPeriod since the submission of
+// its proposal.
+func (keeper Keeper) GetMinDeposit(ctx sdk.Context, expedited bool) sdk.Coins {
+	return keeper.getMinDeposit(ctx, keeper.GetParams(ctx), expedited)
+}
+
+// getMinDeposit returns the deposit currently required for a proposal to enter voting period.
+func (keeper Keeper) getMinDeposit(ctx sdk.Context, params v1.Params, expedited bool) sdk.Coins {
+	increase, err := params.MinDepositIncrease()
+	if err != nil {
+		panic(err)
+	}
+	if increase.IsZero() {
+		return risenMinDeposit(params, expedited, increase, math.LegacyZeroDec())
+	}
+
+	return risenMinDeposit(params, expedited, increase, keeper.minDepositRises(ctx, params))
+}
+
+// minDepositRises returns the sum of the current rises of the proposals in voting period.
+func (keeper Keeper) minDepositRises(ctx sdk.Context, params v1.Params) sdk.Dec {
+	var decayPeriod time.Duration
+	if params.MinDepositDecayPeriod != nil {
+		decayPeriod = *params.MinDepositDecayPeriod
+	}
+
+	rises := math.LegacyZeroDec()
+	keeper.iterateProposalQueue(ctx, types.ActiveProposalQueuePrefix, func(proposal v1.Proposal) bool {
+		rises = rises.Add(minDepositRise(ctx.BlockTime(), *proposal.SubmitTime, decayPeriod))
+		return false
+	})
+
+	return rises
+}
+
+// risenMinDeposit returns the minimum deposit of the params risen by increase times the sum of
+// the rises of the proposals in voting period.
+func risenMinDeposit(params v1.Params, expedited bool, increase, rises sdk.Dec) sdk.Coins {
+	minDeposit := sdk.NewCoins(params.MinDeposit...)
+	if expedited {
+		minDeposit = sdk.NewCoins(params.ExpeditedMinDeposit...)
+	}
+
+	factor := math.LegacyOneDec().Add(increase.Mul(rises))
+	for i := range minDeposit {
+		minDeposit[i].Amount = sdk.NewDecFromInt(minDeposit[i].Amount).Mul(factor).Ceil().TruncateInt()
+	}
+
+	return minDeposit
+}
+
+// ActivateProposalsMeetingMinDeposit moves into voting period the proposals in deposit period
+// whose total deposit meets the minimum deposit, which may have decayed since their last deposit.
+// Only the first MaxMinDepositActivationChecks proposals by deposit end time are checked. It
+// returns the IDs of the activated proposals.
+func (keeper Keeper) ActivateProposalsMeetingMinDeposit(ctx sdk.Context) (proposalIDs []uint64) {
+	params := keeper.GetParams(ctx)
+	increase, err := params.MinDepositIncrease()
+	if err != nil {
+		panic(err)
+	}
+	// the minimum deposit does not change without the rise
+	if increase.IsZero() {
+		return nil
+	}
+
+	var proposals []v1.Proposal
+	keeper.iterateProposalQueue(ctx, types.InactiveProposalQueuePrefix, func(proposal v1.Proposal) bool {
+		proposals = append(proposals, proposal)
+		return len(proposals) == MaxMinDepositActivationChecks
+	})
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	// the minimum deposits are only computed again after an activation
+	minDeposits := func() map[bool]sdk.Coins {
+		rises := keeper.minDepositRises(ctx, params)
+		return map[bool]sdk.Coins{
+			false: risenMinDeposit(params, false, increase, rises),
+			true:  risenMinDeposit(params, true, increase, rises),
+		}
+	}
+	current := minDeposits()
+
+	// each activated proposal raises the minimum deposit of the next ones
+	for _, proposal := range proposals {
+		if !sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(current[proposal.Expedited]) {
+			continue
+		}
+
+		keeper.ActivateVotingPeriod(ctx, proposal)
+		proposalIDs = append(proposalIDs, proposal.Id)
+		current = minDeposits()
+	}
+
+	return proposalIDs
+}
+
+// iterateProposalQueue iterates over the proposals of the inactive or active proposal queue, by
+// end time, and performs a callback function until it returns true.
+func (keeper Keeper) iterateProposalQueue(ctx sdk.Context, prefix []byte, cb func(proposal v1.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitInactiveProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// minDepositRise returns the rise of the minimum deposit for a proposal submitted at submitTime, as
+// a proportion of MinDepositIncreaseRatio: one, decaying linearly to zero over decayPeriod.
+func minDepositRise(blockTime, submitTime time.Time, decayPeriod time.Duration) sdk.Dec {
+	if decayPeriod <= 0 {
+		return math.LegacyOneDec()
+	}
+
+	age := blockTime.Sub(submitTime)
+	if age >= decayPeriod {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDec(int64(decayPeriod - age)).QuoInt64(int64(decayPeriod))
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestGetMinDeposit(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdk.NewInt(1000))
+	minDeposit := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	decayPeriod := 10 * time.Hour
+	params := v1.DefaultParams()
+	params.MinDeposit = minDeposit(100)
+	params.MinInitialDepositRatio = sdk.NewDecWithPrec(5, 1).String()
+	params.MinDepositIncreaseRatio = sdk.NewDecWithPrec(5, 1).String()
+	params.MinDepositDecayPeriod = &decayPeriod
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	require.Equal(t, minDeposit(100), govKeeper.GetMinDeposit(ctx, false))
+
+	// a proposal in deposit period does not raise the minimum deposit
+	first, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	second, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	require.Equal(t, minDeposit(100), govKeeper.GetMinDeposit(ctx, false))
+
+	// each proposal in voting period raises it by half of it
+	activated, err := govKeeper.AddDeposit(ctx, first.Id, addrs[0], minDeposit(100))
+	require.NoError(t, err)
+	require.True(t, activated)
+	require.Equal(t, minDeposit(150), govKeeper.GetMinDeposit(ctx, false))
+	require.ErrorIs(t, govKeeper.ValidateInitialDeposit(ctx, minDeposit(74), false), types.ErrMinDepositTooSmall)
+	require.NoError(t, govKeeper.ValidateInitialDeposit(ctx, minDeposit(75), false))
+
+	activated, err = govKeeper.AddDeposit(ctx, second.Id, addrs[0], minDeposit(149))
+	require.NoError(t, err)
+	require.False(t, activated)
+	require.Empty(t, govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+
+	// the rises decay over time, and a proposal meeting the decayed minimum deposit enters voting
+	// period without a further deposit
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(decayPeriod / 2))
+	require.Equal(t, minDeposit(125), govKeeper.GetMinDeposit(ctx, false))
+	require.Equal(t, []uint64{second.Id}, govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+	proposal, found := govKeeper.GetProposal(ctx, second.Id)
+	require.True(t, found)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, minDeposit(150), govKeeper.GetMinDeposit(ctx, false))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(decayPeriod / 2))
+	require.Equal(t, minDeposit(100), govKeeper.GetMinDeposit(ctx, false))
+}
+
+func TestActivateProposalsMeetingMinDeposit(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdk.NewInt(100000))
+	minDeposit := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	decayPeriod := 10 * time.Hour
+	params := v1.DefaultParams()
+	params.MinDeposit = minDeposit(100)
+	params.MinDepositIncreaseRatio = sdk.NewDecWithPrec(5, 1).String()
+	params.MinDepositDecayPeriod = &decayPeriod
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	active, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	_, err = govKeeper.AddDeposit(ctx, active.Id, addrs[0], minDeposit(100))
+	require.NoError(t, err)
+
+	// queue more proposals than checked in a block, all below the minimum deposit
+	count := keeper.MaxMinDepositActivationChecks + 10
+	proposalIDs := make([]uint64, count)
+	for i := range proposalIDs {
+		proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+		require.NoError(t, err)
+		activated, err := govKeeper.AddDeposit(ctx, proposal.Id, addrs[0], minDeposit(130))
+		require.NoError(t, err)
+		require.False(t, activated)
+		proposalIDs[i] = proposal.Id
+	}
+	require.Empty(t, govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+
+	// the first activated proposal raises the minimum deposit above the deposit of the next ones
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(6 * time.Hour))
+	require.Equal(t, minDeposit(120), govKeeper.GetMinDeposit(ctx, false))
+	require.Equal(t, proposalIDs[:1], govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+	require.Equal(t, minDeposit(140), govKeeper.GetMinDeposit(ctx, false))
+
+	// once the rises have decayed, the proposals are activated in batches by deposit end time
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(decayPeriod))
+	require.Equal(t, proposalIDs[1:keeper.MaxMinDepositActivationChecks+1], govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+	require.Equal(t, proposalIDs[keeper.MaxMinDepositActivationChecks+1:], govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+	require.Empty(t, govKeeper.ActivateProposalsMeetingMinDeposit(ctx))
+}
@@ -77,13 +77,18 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 
 	}
 
+	if err := keeper.checkProposerRateLimit(ctx, proposer); err != nil {
+		return v1.Proposal{}, err
+	}
+
 	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+	params := keeper.GetParams(ctx)
+	depositPeriod := params.MaxDepositPeriod
 
 	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer, expedited)
 	if err != nil {
@@ -94,6 +99,14 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
 
+	if params.ProposerRateLimitEnabled() {
+		keeper.SetProposerSubmission(ctx, v1.ProposerSubmission{
+			Proposer:   proposer.String(),
+			ProposalId: proposalID,
+			SubmitTime: &submitTime,
+		})
+	}
+
 	// called right after a proposal is submitted
 	err = keeper.Hooks().AfterProposalSubmission(ctx, proposalID)
 	if err != nil {
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// SetProposerSubmission sets the submission of a proposal to store.
+func (keeper Keeper) SetProposerSubmission(ctx sdk.Context, submission v1.ProposerSubmission) {
+	store := ctx.KVStore(keeper.storeKey)
+	proposer := sdk.MustAccAddressFromBech32(submission.Proposer)
+	store.Set(types.ProposerSubmissionKey(submission.ProposalId, *submission.SubmitTime), proposer.Bytes())
+}
+
+// IterateProposerSubmissions iterates over the submissions of proposals, ordered by submit time,
+// and performs a callback function
+func (keeper Keeper) IterateProposerSubmissions(ctx sdk.Context, cb func(submission v1.ProposerSubmission) (stop bool)) {
+	keeper.iterateProposerSubmissions(ctx, types.ProposerSubmissionsKeyPrefix, cb)
+}
+
+// iterateProposerSubmissions iterates over the submissions of proposals from the start key,
+// ordered by submit time, and performs a callback function
+func (keeper Keeper) iterateProposerSubmissions(ctx sdk.Context, start []byte, cb func(submission v1.ProposerSubmission) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.ProposerSubmissionsKeyPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, submitTime := types.SplitProposerSubmissionKey(iterator.Key())
+		submission := v1.ProposerSubmission{
+			Proposer:   sdk.AccAddress(iterator.Value()).String(),
+			ProposalId: proposalID,
+			SubmitTime: &submitTime,
+		}
+
+		if cb(submission) {
+			break
+		}
+	}
+}
+
+// GetProposerSubmissions returns the submissions of all the proposals tracked for the proposer
+// rate limit.
+func (keeper Keeper) GetProposerSubmissions(ctx sdk.Context) (submissions []*v1.ProposerSubmission) {
+	keeper.IterateProposerSubmissions(ctx, func(submission v1.ProposerSubmission) bool {
+		submissions = append(submissions, &submission)
+		return false
+	})
+
+	return
+}
+
+// GetProposerSubmissionsInWindow returns the submissions of the proposals submitted by a proposer
+// within the proposer rate limit period, ordered by submit time.
+func (keeper Keeper) GetProposerSubmissionsInWindow(ctx sdk.Context, proposer sdk.AccAddress) (submissions []*v1.ProposerSubmission) {
+	params := keeper.GetParams(ctx)
+	if !params.ProposerRateLimitEnabled() {
+		return nil
+	}
+
+	proposerStr := proposer.String()
+	keeper.iterateProposerSubmissions(ctx, proposerRateLimitWindowStart(ctx, params), func(submission v1.ProposerSubmission) bool {
+		if submission.Proposer == proposerStr {
+			submissions = append(submissions, &submission)
+		}
+		return false
+	})
+
+	return
+}
+
+// NextProposerSubmissionTime returns the time from which a proposer can submit a proposal again,
+// nil if it can submit one now.
+func (keeper Keeper) NextProposerSubmissionTime(ctx sdk.Context, proposer sdk.AccAddress) *time.Time {
+	params := keeper.GetParams(ctx)
+	submissions := keeper.GetProposerSubmissionsInWindow(ctx, proposer)
+	if !params.ProposerRateLimitEnabled() || uint64(len(submissions)) < params.MaxProposalsPerProposer {
+		return nil
+	}
+
+	// the proposer can submit again once all but MaxProposalsPerProposer-1 submissions leave the window
+	oldest := submissions[uint64(len(submissions))-params.MaxProposalsPerProposer]
+	next := oldest.SubmitTime.Add(*params.ProposerRateLimitPeriod)
+	return &next
+}
+
+// checkProposerRateLimit returns an error if the proposer submitted the maximum number of proposals
+// within the proposer rate limit period.
+func (keeper Keeper) checkProposerRateLimit(ctx sdk.Context, proposer sdk.AccAddress) error {
+	next := keeper.NextProposerSubmissionTime(ctx, proposer)
+	if next == nil {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrProposerRateLimited, "%s can submit a proposal again at %s", proposer, next.Format(time.RFC3339))
+}
+
+// PruneProposerSubmissions deletes the submissions of proposals which left the proposer rate limit
+// period, or all of them if the rate limit is disabled.
+func (keeper Keeper) PruneProposerSubmissions(ctx sdk.Context) {
+	params := keeper.GetParams(ctx)
+	store := ctx.KVStore(keeper.storeKey)
+
+	end := sdk.PrefixEndBytes(types.ProposerSubmissionsKeyPrefix)
+	if params.ProposerRateLimitEnabled() {
+		end = proposerRateLimitWindowStart(ctx, params)
+	}
+
+	iterator := store.Iterator(types.ProposerSubmissionsKeyPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// proposerRateLimitWindowStart returns the first key of the submissions within the proposer rate
+// limit period, which excludes the submissions made exactly one period ago.
+func proposerRateLimitWindowStart(ctx sdk.Context, params v1.Params) []byte {
+	return sdk.PrefixEndBytes(types.ProposerSubmissionsByTimeKey(ctx.BlockTime().Add(-*params.ProposerRateLimitPeriod)))
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestProposerRateLimit(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(1000))
+	startTime := ctx.BlockTime()
+
+	period := time.Hour
+	params := v1.DefaultParams()
+	params.MaxProposalsPerProposer = 2
+	params.ProposerRateLimitPeriod = &period
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	_, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute))
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+
+	// the proposer reached the rate limit, other proposers did not
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.ErrorIs(t, err, types.ErrProposerRateLimited)
+	require.Len(t, govKeeper.GetProposerSubmissionsInWindow(ctx, addrs[0]), 2)
+	require.Equal(t, startTime.Add(period), *govKeeper.NextProposerSubmissionTime(ctx, addrs[0]))
+
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[1], false)
+	require.NoError(t, err)
+	require.Nil(t, govKeeper.NextProposerSubmissionTime(ctx, addrs[1]))
+
+	// the first submission leaves the period, and is pruned
+	ctx = ctx.WithBlockTime(startTime.Add(period))
+	require.Len(t, govKeeper.GetProposerSubmissionsInWindow(ctx, addrs[0]), 1)
+	govKeeper.PruneProposerSubmissions(ctx)
+	require.Len(t, govKeeper.GetProposerSubmissions(ctx), 2)
+
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	require.NoError(t, err)
+
+	// disabling the rate limit prunes all the submissions
+	params.MaxProposalsPerProposer = 0
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	govKeeper.PruneProposerSubmissions(ctx)
+	require.Empty(t, govKeeper.GetProposerSubmissions(ctx))
+}
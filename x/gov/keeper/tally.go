@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"sort"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -12,6 +15,41 @@ import (
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
 // voters
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
+	passes, burnDeposits, tallyResults, _ = keeper.tally(ctx, proposal)
+	return passes, burnDeposits, tallyResults
+}
+
+// PreviewTally tallies a proposal as if its voting period ended now, and returns the breakdown of
+// the voting power of each bonded validator, sorted by descending bonded tokens. It tallies on a
+// cache-wrapped context, so that the votes of the proposal are not deleted.
+func (keeper Keeper) PreviewTally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult, validators []*v1.ValidatorTallyPreview) {
+	cacheCtx, _ := ctx.CacheContext()
+	passes, burnDeposits, tallyResults, currValidators := keeper.tally(cacheCtx, proposal)
+
+	vals := make([]v1.ValidatorGovInfo, 0, len(currValidators))
+	for _, val := range currValidators {
+		vals = append(vals, val)
+	}
+
+	sort.Slice(vals, func(i, j int) bool {
+		if !vals[i].BondedTokens.Equal(vals[j].BondedTokens) {
+			return vals[i].BondedTokens.GT(vals[j].BondedTokens)
+		}
+		return bytes.Compare(vals[i].Address, vals[j].Address) < 0
+	})
+
+	validators = make([]*v1.ValidatorTallyPreview, len(vals))
+	for i, val := range vals {
+		preview := v1.NewValidatorTallyPreview(val)
+		validators[i] = &preview
+	}
+
+	return passes, burnDeposits, tallyResults, validators
+}
+
+// tally tallies a proposal and returns, along with the tally, the bonded validators with the
+// deductions of their delegators voting independently.
+func (keeper Keeper) tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult, currValidators map[string]v1.ValidatorGovInfo) {
 	results := make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
@@ -19,7 +57,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
 	totalVotingPower := math.LegacyZeroDec()
-	currValidators := make(map[string]v1.ValidatorGovInfo)
+	currValidators = make(map[string]v1.ValidatorGovInfo)
 
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
@@ -95,25 +133,25 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
 	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+		return false, false, tallyResults, currValidators
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
 	quorum, _ := sdk.NewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults
+		return false, params.BurnVoteQuorum, tallyResults, currValidators
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults
+		return false, false, tallyResults, currValidators
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := sdk.NewDecFromStr(params.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, params.BurnVoteVeto, tallyResults
+		return false, params.BurnVoteVeto, tallyResults, currValidators
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
@@ -127,9 +165,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 
 	threshold, _ := sdk.NewDecFromStr(thresholdStr)
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+		return true, false, tallyResults, currValidators
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, false, tallyResults, currValidators
 }
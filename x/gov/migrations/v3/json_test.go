@@ -122,6 +122,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
 	],
+	"proposer_submissions": [],
 	"starting_proposal_id": "1",
 	"tally_params": {
 		"quorum": "0.334000000000000000",
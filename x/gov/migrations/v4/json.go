@@ -27,6 +27,10 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.MinDepositIncreaseRatio,
+		*defaultParams.MinDepositDecayPeriod,
+		defaultParams.MaxProposalsPerProposer,
+		*defaultParams.ProposerRateLimitPeriod,
 	)
 
 	return &v1.GenesisState{
@@ -71,19 +71,24 @@ func TestMigrateJSON(t *testing.T) {
 		"expedited_threshold": "0.667000000000000000",
 		"expedited_voting_period": "86400s",
 		"max_deposit_period": "172800s",
+		"max_proposals_per_proposer": "0",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
 		],
+		"min_deposit_decay_period": "0s",
+		"min_deposit_increase_ratio": "0.000000000000000000",
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"proposer_rate_limit_period": "172800s",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
 		"voting_period": "172800s"
 	},
 	"proposals": [],
+	"proposer_submissions": [],
 	"starting_proposal_id": "1",
 	"tally_params": null,
 	"votes": [],
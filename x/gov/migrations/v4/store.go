@@ -39,6 +39,10 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.MinDepositIncreaseRatio,
+		*defaultParams.MinDepositDecayPeriod,
+		defaultParams.MaxProposalsPerProposer,
+		*defaultParams.ProposerRateLimitPeriod,
 	)
 
 	bz, err := cdc.Marshal(&params)
@@ -82,6 +82,12 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"proposer submissions",
+			kv.Pair{Key: types.ProposerSubmissionKey(1, endTime), Value: delAddr1.Bytes()},
+			kv.Pair{Key: types.ProposerSubmissionKey(1, endTime), Value: delAddr1.Bytes()},
+			fmt.Sprintf("%v\n%v", delAddr1, delAddr1), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
@@ -33,6 +33,8 @@ const (
 //
 // - 0x04<proposalID_Bytes>: []byte{0x01} if proposalID is in the voting period
 //
+// - 0x05<submitTime_Bytes><proposalID_Bytes>: proposerAddr_Bytes
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
@@ -44,6 +46,7 @@ var (
 	InactiveProposalQueuePrefix   = []byte{0x02}
 	ProposalIDKey                 = []byte{0x03}
 	VotingPeriodProposalKeyPrefix = []byte{0x04}
+	ProposerSubmissionsKeyPrefix  = []byte{0x05}
 
 	DepositsKeyPrefix = []byte{0x10}
 
@@ -97,6 +100,16 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// ProposerSubmissionsByTimeKey gets the proposer submissions key by submitTime
+func ProposerSubmissionsByTimeKey(submitTime time.Time) []byte {
+	return append(ProposerSubmissionsKeyPrefix, sdk.FormatTimeBytes(submitTime)...)
+}
+
+// ProposerSubmissionKey returns the key for the submission of a proposalID
+func ProposerSubmissionKey(proposalID uint64, submitTime time.Time) []byte {
+	return append(ProposerSubmissionsByTimeKey(submitTime), GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -136,6 +149,11 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitProposerSubmissionKey split the proposer submission key and returns the proposal id and submitTime
+func SplitProposerSubmissionKey(key []byte) (proposalID uint64, submitTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
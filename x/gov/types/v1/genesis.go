@@ -2,8 +2,10 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -33,6 +35,15 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	for _, submission := range data.ProposerSubmissions {
+		if _, err := sdk.AccAddressFromBech32(submission.Proposer); err != nil {
+			return fmt.Errorf("invalid proposer submission of proposal %d: %w", submission.ProposalId, err)
+		}
+		if submission.SubmitTime == nil {
+			return fmt.Errorf("proposer submission of proposal %d must have a submit time", submission.ProposalId)
+		}
+	}
+
 	return data.Params.ValidateBasic()
 }
 
@@ -90,6 +90,37 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "invalid min deposit increase ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MinDepositIncreaseRatio = "-0.1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "max proposals per proposer without rate limit period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MaxProposalsPerProposer = 3
+				params1.ProposerRateLimitPeriod = nil
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid proposer submission",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposerSubmissions = []*v1.ProposerSubmission{{Proposer: "invalid", ProposalId: 1}}
+
+				return state
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -487,6 +487,84 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+// ValidatorTallyPreview defines the voting power of a bonded validator in a
+// tally preview of a proposal.
+type ValidatorTallyPreview struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// inherited_power is the voting power of the validator's delegators which
+	// did not vote, cast by the validator's vote.
+	InheritedPower string `protobuf:"bytes,2,opt,name=inherited_power,json=inheritedPower,proto3" json:"inherited_power,omitempty"`
+	// overridden_power is the voting power of the validator's delegators which
+	// voted, deducted from the validator's vote. It includes the self-delegation
+	// of the validator's operator if it voted, tallied as the operator's vote.
+	OverriddenPower string `protobuf:"bytes,3,opt,name=overridden_power,json=overriddenPower,proto3" json:"overridden_power,omitempty"`
+	// options is the vote of the validator, empty if it did not vote, in which
+	// case the inherited power is not tallied.
+	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *ValidatorTallyPreview) Reset()         { *m = ValidatorTallyPreview{} }
+func (m *ValidatorTallyPreview) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTallyPreview) ProtoMessage()    {}
+func (*ValidatorTallyPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
+}
+func (m *ValidatorTallyPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTallyPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTallyPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTallyPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTallyPreview.Merge(m, src)
+}
+func (m *ValidatorTallyPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTallyPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTallyPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTallyPreview proto.InternalMessageInfo
+
+func (m *ValidatorTallyPreview) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorTallyPreview) GetInheritedPower() string {
+	if m != nil {
+		return m.InheritedPower
+	}
+	return ""
+}
+
+func (m *ValidatorTallyPreview) GetOverriddenPower() string {
+	if m != nil {
+		return m.OverriddenPower
+	}
+	return ""
+}
+
+func (m *ValidatorTallyPreview) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -504,7 +582,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +654,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +709,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +763,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,15 +824,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// Duration of the voting period of an expedited proposal.
 	//
@@ -764,7 +842,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.48
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -772,13 +850,25 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// The proportion of the minimum deposit by which the minimum deposit rises
+	// for each proposal in voting period. Zero disables the rise.
+	MinDepositIncreaseRatio string `protobuf:"bytes,16,opt,name=min_deposit_increase_ratio,json=minDepositIncreaseRatio,proto3" json:"min_deposit_increase_ratio,omitempty"`
+	// The period over which the rise of the minimum deposit for a proposal
+	// decays linearly to zero since its submission. Zero disables the decay.
+	MinDepositDecayPeriod *time.Duration `protobuf:"bytes,17,opt,name=min_deposit_decay_period,json=minDepositDecayPeriod,proto3,stdduration" json:"min_deposit_decay_period,omitempty"`
+	// The maximum number of proposals a proposer can submit within the proposer
+	// rate limit period. Zero disables the rate limit.
+	MaxProposalsPerProposer uint64 `protobuf:"varint,18,opt,name=max_proposals_per_proposer,json=maxProposalsPerProposer,proto3" json:"max_proposals_per_proposer,omitempty"`
+	// The period over which the proposals submitted by a proposer are rate
+	// limited.
+	ProposerRateLimitPeriod *time.Duration `protobuf:"bytes,19,opt,name=proposer_rate_limit_period,json=proposerRateLimitPeriod,proto3,stdduration" json:"proposer_rate_limit_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,6 +988,99 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetMinDepositIncreaseRatio() string {
+	if m != nil {
+		return m.MinDepositIncreaseRatio
+	}
+	return ""
+}
+
+func (m *Params) GetMinDepositDecayPeriod() *time.Duration {
+	if m != nil {
+		return m.MinDepositDecayPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetMaxProposalsPerProposer() uint64 {
+	if m != nil {
+		return m.MaxProposalsPerProposer
+	}
+	return 0
+}
+
+func (m *Params) GetProposerRateLimitPeriod() *time.Duration {
+	if m != nil {
+		return m.ProposerRateLimitPeriod
+	}
+	return nil
+}
+
+// ProposerSubmission defines the submission of a proposal, tracked for the
+// proposer rate limit.
+type ProposerSubmission struct {
+	// proposer is the address of the proposal proposer.
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// submit_time is the time of proposal submission.
+	SubmitTime *time.Time `protobuf:"bytes,3,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+}
+
+func (m *ProposerSubmission) Reset()         { *m = ProposerSubmission{} }
+func (m *ProposerSubmission) String() string { return proto.CompactTextString(m) }
+func (*ProposerSubmission) ProtoMessage()    {}
+func (*ProposerSubmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *ProposerSubmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSubmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSubmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSubmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSubmission.Merge(m, src)
+}
+func (m *ProposerSubmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSubmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSubmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSubmission proto.InternalMessageInfo
+
+func (m *ProposerSubmission) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ProposerSubmission) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposerSubmission) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -905,102 +1088,118 @@ func init() {
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
+	proto.RegisterType((*ValidatorTallyPreview)(nil), "cosmos.gov.v1.ValidatorTallyPreview")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ProposerSubmission)(nil), "cosmos.gov.v1.ProposerSubmission")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x73, 0xd3, 0x46,
-	0x14, 0x8f, 0x6c, 0xc7, 0x71, 0x9e, 0x63, 0xc7, 0x2c, 0x81, 0x28, 0x81, 0xd8, 0xc1, 0xc3, 0x30,
-	0x29, 0x7f, 0xec, 0x06, 0x4a, 0x0f, 0xa5, 0x33, 0x1d, 0x27, 0x16, 0x45, 0x0c, 0xc4, 0xae, 0x6c,
-	0x1c, 0xe8, 0x45, 0x23, 0x47, 0x8b, 0xb3, 0x53, 0x4b, 0xeb, 0x4a, 0x6b, 0x13, 0x7f, 0x84, 0xde,
-	0x38, 0xf6, 0xd4, 0xe9, 0xb1, 0xc7, 0x1e, 0x98, 0x7e, 0x81, 0x5e, 0x38, 0x75, 0x18, 0x2e, 0xed,
-	0xa5, 0xd0, 0x81, 0x43, 0x67, 0xf8, 0x14, 0x9d, 0x5d, 0xad, 0x2c, 0xc7, 0x71, 0x27, 0x09, 0x97,
-	0xc4, 0x7a, 0xef, 0xf7, 0x7b, 0xef, 0xed, 0xfb, 0xb7, 0x12, 0x2c, 0xef, 0x51, 0xdf, 0xa1, 0x7e,
-	0xb9, 0x43, 0x07, 0xe5, 0xc1, 0x26, 0xff, 0x57, 0xea, 0x79, 0x94, 0x51, 0x94, 0x09, 0x14, 0x25,
-	0x2e, 0x19, 0x6c, 0xae, 0xe6, 0x25, 0xae, 0x6d, 0xf9, 0xb8, 0x3c, 0xd8, 0x6c, 0x63, 0x66, 0x6d,
-	0x96, 0xf7, 0x28, 0x71, 0x03, 0xf8, 0xea, 0x52, 0x87, 0x76, 0xa8, 0xf8, 0x59, 0xe6, 0xbf, 0xa4,
-	0xb4, 0xd0, 0xa1, 0xb4, 0xd3, 0xc5, 0x65, 0xf1, 0xd4, 0xee, 0x3f, 0x2d, 0x33, 0xe2, 0x60, 0x9f,
-	0x59, 0x4e, 0x4f, 0x02, 0x56, 0x26, 0x01, 0x96, 0x3b, 0x94, 0xaa, 0xfc, 0xa4, 0xca, 0xee, 0x7b,
-	0x16, 0x23, 0x34, 0xf4, 0xb8, 0x12, 0x44, 0x64, 0x06, 0x4e, 0x65, 0xb4, 0x81, 0xea, 0x8c, 0xe5,
-	0x10, 0x97, 0x96, 0xc5, 0xdf, 0x40, 0x54, 0xa4, 0x80, 0x76, 0x31, 0xe9, 0xec, 0x33, 0x6c, 0xb7,
-	0x28, 0xc3, 0xb5, 0x1e, 0xb7, 0x84, 0x36, 0x21, 0x49, 0xc5, 0x2f, 0x55, 0x59, 0x57, 0x36, 0xb2,
-	0x37, 0x57, 0x4a, 0x87, 0x4e, 0x5d, 0x8a, 0xa0, 0x86, 0x04, 0xa2, 0x2b, 0x90, 0x7c, 0x26, 0x0c,
-	0xa9, 0xb1, 0x75, 0x65, 0x63, 0x7e, 0x2b, 0xfb, 0xfa, 0xc5, 0x0d, 0x90, 0xac, 0x2a, 0xde, 0x33,
-	0xa4, 0xb6, 0xf8, 0xb3, 0x02, 0x73, 0x55, 0xdc, 0xa3, 0x3e, 0x61, 0xa8, 0x00, 0xe9, 0x9e, 0x47,
-	0x7b, 0xd4, 0xb7, 0xba, 0x26, 0xb1, 0x85, 0xaf, 0x84, 0x01, 0xa1, 0x48, 0xb7, 0xd1, 0xe7, 0x30,
-	0x6f, 0x07, 0x58, 0xea, 0x49, 0xbb, 0xea, 0xeb, 0x17, 0x37, 0x96, 0xa4, 0xdd, 0x8a, 0x6d, 0x7b,
-	0xd8, 0xf7, 0x1b, 0xcc, 0x23, 0x6e, 0xc7, 0x88, 0xa0, 0xe8, 0x4b, 0x48, 0x5a, 0x0e, 0xed, 0xbb,
-	0x4c, 0x8d, 0xaf, 0xc7, 0x37, 0xd2, 0x51, 0xfc, 0xbc, 0x4c, 0x25, 0x59, 0xa6, 0xd2, 0x36, 0x25,
-	0xee, 0xd6, 0xfc, 0xcb, 0x37, 0x85, 0x99, 0x5f, 0xfe, 0xfd, 0xf5, 0xaa, 0x62, 0x48, 0x4e, 0xf1,
-	0xed, 0x2c, 0xa4, 0xea, 0x32, 0x08, 0x94, 0x85, 0xd8, 0x28, 0xb4, 0x18, 0xb1, 0xd1, 0xa7, 0x90,
-	0x72, 0xb0, 0xef, 0x5b, 0x1d, 0xec, 0xab, 0x31, 0x61, 0x7c, 0xa9, 0x14, 0x54, 0xa4, 0x14, 0x56,
-	0xa4, 0x54, 0x71, 0x87, 0xc6, 0x08, 0x85, 0x6e, 0x43, 0xd2, 0x67, 0x16, 0xeb, 0xfb, 0x6a, 0x5c,
-	0x24, 0x73, 0x6d, 0x22, 0x99, 0xa1, 0xab, 0x86, 0x00, 0x19, 0x12, 0x8c, 0xee, 0x01, 0x7a, 0x4a,
-	0x5c, 0xab, 0x6b, 0x32, 0xab, 0xdb, 0x1d, 0x9a, 0x1e, 0xf6, 0xfb, 0x5d, 0xa6, 0x26, 0xd6, 0x95,
-	0x8d, 0xf4, 0xcd, 0xd5, 0x09, 0x13, 0x4d, 0x0e, 0x31, 0x04, 0xc2, 0xc8, 0x09, 0xd6, 0x98, 0x04,
-	0x55, 0x20, 0xed, 0xf7, 0xdb, 0x0e, 0x61, 0x26, 0x6f, 0x33, 0x75, 0x56, 0x9a, 0x98, 0x8c, 0xba,
-	0x19, 0xf6, 0xe0, 0x56, 0xe2, 0xf9, 0xdb, 0x82, 0x62, 0x40, 0x40, 0xe2, 0x62, 0x74, 0x1f, 0x72,
-	0x32, 0xbb, 0x26, 0x76, 0xed, 0xc0, 0x4e, 0xf2, 0x84, 0x76, 0xb2, 0x92, 0xa9, 0xb9, 0xb6, 0xb0,
-	0xa5, 0x43, 0x86, 0x51, 0x66, 0x75, 0x4d, 0x29, 0x57, 0xe7, 0x4e, 0x51, 0xa3, 0x05, 0x41, 0x0d,
-	0x1b, 0xe8, 0x01, 0x9c, 0x19, 0x50, 0x46, 0xdc, 0x8e, 0xe9, 0x33, 0xcb, 0x93, 0xe7, 0x4b, 0x9d,
-	0x30, 0xae, 0xc5, 0x80, 0xda, 0xe0, 0x4c, 0x11, 0xd8, 0x3d, 0x90, 0xa2, 0xe8, 0x8c, 0xf3, 0x27,
-	0xb4, 0x95, 0x09, 0x88, 0xe1, 0x11, 0x57, 0x79, 0x93, 0x30, 0xcb, 0xb6, 0x98, 0xa5, 0x02, 0x6f,
-	0x5b, 0x63, 0xf4, 0x8c, 0x96, 0x60, 0x96, 0x11, 0xd6, 0xc5, 0x6a, 0x5a, 0x28, 0x82, 0x07, 0xa4,
-	0xc2, 0x9c, 0xdf, 0x77, 0x1c, 0xcb, 0x1b, 0xaa, 0x0b, 0x42, 0x1e, 0x3e, 0xa2, 0xcf, 0x20, 0x15,
-	0x4c, 0x04, 0xf6, 0xd4, 0xcc, 0x31, 0x23, 0x30, 0x42, 0xa2, 0x8b, 0x30, 0x8f, 0x0f, 0x7a, 0xd8,
-	0x26, 0x0c, 0xdb, 0x6a, 0x76, 0x5d, 0xd9, 0x48, 0x19, 0x91, 0xa0, 0xf8, 0xa7, 0x02, 0xe9, 0xf1,
-	0x0e, 0xb9, 0x06, 0xf3, 0x43, 0xec, 0x9b, 0x7b, 0x62, 0x64, 0x94, 0x23, 0xf3, 0xab, 0xbb, 0xcc,
-	0x48, 0x0d, 0xb1, 0xbf, 0xcd, 0xf5, 0xe8, 0x16, 0x64, 0xac, 0xb6, 0xcf, 0x2c, 0xe2, 0x4a, 0x42,
-	0x6c, 0x2a, 0x61, 0x41, 0x82, 0x02, 0xd2, 0x27, 0x90, 0x72, 0xa9, 0xc4, 0xc7, 0xa7, 0xe2, 0xe7,
-	0x5c, 0x1a, 0x40, 0xef, 0x00, 0x72, 0xa9, 0xf9, 0x8c, 0xb0, 0x7d, 0x73, 0x80, 0x59, 0x48, 0x4a,
-	0x4c, 0x25, 0x2d, 0xba, 0x74, 0x97, 0xb0, 0xfd, 0x16, 0x66, 0x01, 0xb9, 0xf8, 0x9b, 0x02, 0x09,
-	0xbe, 0x9d, 0x8e, 0xdf, 0x2d, 0x25, 0x98, 0x1d, 0x50, 0x86, 0x8f, 0xdf, 0x2b, 0x01, 0x0c, 0xdd,
-	0x81, 0xb9, 0x60, 0xd5, 0xf9, 0x6a, 0x42, 0x34, 0xec, 0xa5, 0x89, 0x21, 0x3c, 0xba, 0x47, 0x8d,
-	0x90, 0x71, 0xa8, 0x21, 0x66, 0x0f, 0x37, 0xc4, 0xfd, 0x44, 0x2a, 0x9e, 0x4b, 0x14, 0xff, 0x56,
-	0x20, 0x23, 0xdb, 0xba, 0x6e, 0x79, 0x96, 0xe3, 0xa3, 0x27, 0x90, 0x76, 0x88, 0x3b, 0x9a, 0x12,
-	0xe5, 0xb8, 0x29, 0x59, 0xe3, 0x53, 0xf2, 0xe1, 0x4d, 0xe1, 0xdc, 0x18, 0xeb, 0x3a, 0x75, 0x08,
-	0xc3, 0x4e, 0x8f, 0x0d, 0x0d, 0x70, 0x88, 0x1b, 0xce, 0x8d, 0x03, 0xc8, 0xb1, 0x0e, 0x42, 0x90,
-	0xd9, 0xc3, 0x1e, 0xa1, 0xb6, 0x48, 0x04, 0xf7, 0x30, 0xd9, 0xec, 0x55, 0x79, 0xc1, 0x6c, 0x5d,
-	0xfe, 0xf0, 0xa6, 0x70, 0xf1, 0x28, 0x31, 0x72, 0xf2, 0x23, 0x9f, 0x85, 0x9c, 0x63, 0x1d, 0x84,
-	0x27, 0x11, 0xfa, 0x2f, 0x62, 0xaa, 0x52, 0x7c, 0x0c, 0x0b, 0x2d, 0x31, 0x23, 0xf2, 0x74, 0x55,
-	0x90, 0x33, 0x13, 0x7a, 0x57, 0x8e, 0xf3, 0x9e, 0x10, 0xd6, 0x17, 0x02, 0xd6, 0x98, 0xe5, 0x9f,
-	0xc2, 0x66, 0x96, 0x96, 0xaf, 0x40, 0xf2, 0xfb, 0x3e, 0xf5, 0xfa, 0xce, 0x94, 0x4e, 0x16, 0x37,
-	0x51, 0xa0, 0x45, 0xd7, 0x61, 0x9e, 0xed, 0x7b, 0xd8, 0xdf, 0xa7, 0x5d, 0xfb, 0x7f, 0x2e, 0xad,
-	0x08, 0x80, 0x6e, 0x43, 0x56, 0x74, 0x63, 0x44, 0x89, 0x4f, 0xa5, 0x64, 0x38, 0xaa, 0x19, 0x82,
-	0x44, 0x80, 0xbf, 0x27, 0x21, 0x29, 0x63, 0xd3, 0x4e, 0x59, 0xd3, 0xb1, 0xcd, 0x37, 0x5e, 0xbf,
-	0x87, 0x1f, 0x57, 0xbf, 0xc4, 0xf4, 0xfa, 0x1c, 0xad, 0x45, 0xfc, 0x23, 0x6a, 0x31, 0x96, 0xf7,
-	0xc4, 0xc9, 0xf3, 0x3e, 0x7b, 0xfa, 0xbc, 0x27, 0x4f, 0x90, 0x77, 0xa4, 0xc3, 0x0a, 0x4f, 0x34,
-	0x71, 0x09, 0x23, 0xd1, 0x55, 0x63, 0x8a, 0xf0, 0xd5, 0xb9, 0xa9, 0x16, 0xce, 0x3b, 0xc4, 0xd5,
-	0x03, 0xbc, 0x4c, 0x8f, 0xc1, 0xd1, 0x68, 0x17, 0x96, 0x47, 0x9b, 0xd3, 0x3c, 0x9c, 0x27, 0x38,
-	0x59, 0x9e, 0xce, 0x8d, 0xf8, 0xad, 0xf1, 0x84, 0x7d, 0x05, 0x67, 0x23, 0xc3, 0xd1, 0xf9, 0xd2,
-	0x53, 0xa3, 0x43, 0x23, 0x68, 0x74, 0xc8, 0xc7, 0x10, 0x59, 0x36, 0xc7, 0xfb, 0x6a, 0xe1, 0x14,
-	0x7d, 0x15, 0xc5, 0xf0, 0x30, 0x6a, 0xb0, 0x0d, 0xc8, 0xb5, 0xfb, 0x9e, 0xcb, 0x8f, 0x8b, 0x4d,
-	0x59, 0xd5, 0x8c, 0xb8, 0x45, 0xb2, 0x5c, 0xce, 0x57, 0xdc, 0x37, 0x41, 0x35, 0x2b, 0xb0, 0x26,
-	0x90, 0xa3, 0x65, 0x3b, 0x6a, 0x4a, 0x0f, 0x73, 0xb6, 0xbc, 0x7c, 0x56, 0x39, 0x28, 0x7c, 0xd3,
-	0x09, 0xbb, 0x2f, 0x40, 0xa0, 0xcb, 0x90, 0x8d, 0x9c, 0xf1, 0x32, 0xaa, 0x8b, 0x82, 0xb3, 0x10,
-	0xba, 0xe2, 0xeb, 0xfd, 0xea, 0x0f, 0x0a, 0xc0, 0xd8, 0x2b, 0xea, 0x05, 0x58, 0x6e, 0xd5, 0x9a,
-	0x9a, 0x59, 0xab, 0x37, 0xf5, 0xda, 0x8e, 0xf9, 0x68, 0xa7, 0x51, 0xd7, 0xb6, 0xf5, 0xbb, 0xba,
-	0x56, 0xcd, 0xcd, 0xa0, 0xb3, 0xb0, 0x38, 0xae, 0x7c, 0xa2, 0x35, 0x72, 0x0a, 0x5a, 0x86, 0xb3,
-	0xe3, 0xc2, 0xca, 0x56, 0xa3, 0x59, 0xd1, 0x77, 0x72, 0x31, 0x84, 0x20, 0x3b, 0xae, 0xd8, 0xa9,
-	0xe5, 0xe2, 0xe8, 0x22, 0xa8, 0x87, 0x65, 0xe6, 0xae, 0xde, 0xbc, 0x67, 0xb6, 0xb4, 0x66, 0x2d,
-	0x97, 0xb8, 0xfa, 0x87, 0x02, 0xd9, 0xc3, 0xaf, 0x6d, 0xa8, 0x00, 0x17, 0xea, 0x46, 0xad, 0x5e,
-	0x6b, 0x54, 0x1e, 0x98, 0x8d, 0x66, 0xa5, 0xf9, 0xa8, 0x31, 0x11, 0x53, 0x11, 0xf2, 0x93, 0x80,
-	0xaa, 0x56, 0xaf, 0x35, 0xf4, 0xa6, 0x59, 0xd7, 0x0c, 0xbd, 0x56, 0xcd, 0x29, 0xe8, 0x12, 0xac,
-	0x4d, 0x62, 0x5a, 0xb5, 0xa6, 0xbe, 0xf3, 0x75, 0x08, 0x89, 0xa1, 0x55, 0x38, 0x3f, 0x09, 0xa9,
-	0x57, 0x1a, 0x0d, 0xad, 0x1a, 0x04, 0x3d, 0xa9, 0x33, 0xb4, 0xfb, 0xda, 0x76, 0x53, 0xab, 0xe6,
-	0x12, 0xd3, 0x98, 0x77, 0x2b, 0xfa, 0x03, 0xad, 0x9a, 0x9b, 0xdd, 0xd2, 0x5e, 0xbe, 0xcb, 0x2b,
-	0xaf, 0xde, 0xe5, 0x95, 0x7f, 0xde, 0xe5, 0x95, 0xe7, 0xef, 0xf3, 0x33, 0xaf, 0xde, 0xe7, 0x67,
-	0xfe, 0x7a, 0x9f, 0x9f, 0xf9, 0xf6, 0x5a, 0x87, 0xb0, 0xfd, 0x7e, 0xbb, 0xb4, 0x47, 0x1d, 0xf9,
-	0x31, 0x21, 0xff, 0xdd, 0xf0, 0xed, 0xef, 0xca, 0x07, 0xe2, 0x03, 0x89, 0x0d, 0x7b, 0xd8, 0xe7,
-	0x5f, 0x3f, 0x49, 0x31, 0x01, 0xb7, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x09, 0x89, 0x48,
-	0x3e, 0x0d, 0x00, 0x00,
+	// 1575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4f, 0x6f, 0xdb, 0xca,
+	0x11, 0x37, 0x25, 0x59, 0x96, 0x46, 0x96, 0x4c, 0xaf, 0x9d, 0x67, 0x5a, 0x2f, 0x96, 0x1d, 0xe1,
+	0xe1, 0xc1, 0xcd, 0x7b, 0x96, 0xea, 0xa4, 0x69, 0xd1, 0xa6, 0x40, 0x21, 0x5b, 0x4a, 0xa3, 0xd4,
+	0xb1, 0x54, 0x4a, 0x91, 0x93, 0xa2, 0x00, 0x41, 0x9b, 0x1b, 0x79, 0x51, 0x91, 0xab, 0x92, 0x2b,
+	0xd9, 0xfa, 0x00, 0x3d, 0xf4, 0x96, 0x63, 0x4e, 0x45, 0x8f, 0x3d, 0x15, 0x3d, 0x04, 0xfd, 0x0c,
+	0x39, 0x15, 0x41, 0x2e, 0xed, 0xa5, 0x49, 0x91, 0x1c, 0x0a, 0xe4, 0x53, 0x14, 0xbb, 0x5c, 0x8a,
+	0xb4, 0xac, 0xc0, 0x72, 0x7a, 0xb1, 0xc9, 0x99, 0xdf, 0x6f, 0x76, 0xfe, 0xec, 0xcc, 0x2e, 0x05,
+	0x6b, 0x27, 0xd4, 0xb3, 0xa9, 0x57, 0xee, 0xd2, 0x61, 0x79, 0xb8, 0xcb, 0xff, 0x95, 0xfa, 0x2e,
+	0x65, 0x14, 0x65, 0x7d, 0x45, 0x89, 0x4b, 0x86, 0xbb, 0xf9, 0x82, 0xc4, 0x1d, 0x9b, 0x1e, 0x2e,
+	0x0f, 0x77, 0x8f, 0x31, 0x33, 0x77, 0xcb, 0x27, 0x94, 0x38, 0x3e, 0x3c, 0xbf, 0xda, 0xa5, 0x5d,
+	0x2a, 0x1e, 0xcb, 0xfc, 0x49, 0x4a, 0x37, 0xbb, 0x94, 0x76, 0x7b, 0xb8, 0x2c, 0xde, 0x8e, 0x07,
+	0xcf, 0xcb, 0x8c, 0xd8, 0xd8, 0x63, 0xa6, 0xdd, 0x97, 0x80, 0xf5, 0x49, 0x80, 0xe9, 0x8c, 0xa4,
+	0xaa, 0x30, 0xa9, 0xb2, 0x06, 0xae, 0xc9, 0x08, 0x0d, 0x56, 0x5c, 0xf7, 0x3d, 0x32, 0xfc, 0x45,
+	0xa5, 0xb7, 0xbe, 0x6a, 0xd9, 0xb4, 0x89, 0x43, 0xcb, 0xe2, 0xaf, 0x2f, 0x2a, 0x52, 0x40, 0x47,
+	0x98, 0x74, 0x4f, 0x19, 0xb6, 0x3a, 0x94, 0xe1, 0x46, 0x9f, 0x5b, 0x42, 0xbb, 0x90, 0xa4, 0xe2,
+	0x49, 0x53, 0xb6, 0x94, 0xed, 0xdc, 0x9d, 0xf5, 0xd2, 0x85, 0xa8, 0x4b, 0x21, 0x54, 0x97, 0x40,
+	0xf4, 0x2d, 0x24, 0xcf, 0x84, 0x21, 0x2d, 0xb6, 0xa5, 0x6c, 0xa7, 0xf7, 0x72, 0x6f, 0x5f, 0xed,
+	0x80, 0x64, 0x55, 0xf1, 0x89, 0x2e, 0xb5, 0xc5, 0x3f, 0x2b, 0xb0, 0x50, 0xc5, 0x7d, 0xea, 0x11,
+	0x86, 0x36, 0x21, 0xd3, 0x77, 0x69, 0x9f, 0x7a, 0x66, 0xcf, 0x20, 0x96, 0x58, 0x2b, 0xa1, 0x43,
+	0x20, 0xaa, 0x5b, 0xe8, 0xc7, 0x90, 0xb6, 0x7c, 0x2c, 0x75, 0xa5, 0x5d, 0xed, 0xed, 0xab, 0x9d,
+	0x55, 0x69, 0xb7, 0x62, 0x59, 0x2e, 0xf6, 0xbc, 0x16, 0x73, 0x89, 0xd3, 0xd5, 0x43, 0x28, 0xfa,
+	0x39, 0x24, 0x4d, 0x9b, 0x0e, 0x1c, 0xa6, 0xc5, 0xb7, 0xe2, 0xdb, 0x99, 0xd0, 0x7f, 0x5e, 0xa6,
+	0x92, 0x2c, 0x53, 0x69, 0x9f, 0x12, 0x67, 0x2f, 0xfd, 0xfa, 0xdd, 0xe6, 0xdc, 0x5f, 0xfe, 0xfb,
+	0xb7, 0xdb, 0x8a, 0x2e, 0x39, 0xc5, 0xf7, 0xf3, 0x90, 0x6a, 0x4a, 0x27, 0x50, 0x0e, 0x62, 0x63,
+	0xd7, 0x62, 0xc4, 0x42, 0x3f, 0x84, 0x94, 0x8d, 0x3d, 0xcf, 0xec, 0x62, 0x4f, 0x8b, 0x09, 0xe3,
+	0xab, 0x25, 0xbf, 0x22, 0xa5, 0xa0, 0x22, 0xa5, 0x8a, 0x33, 0xd2, 0xc7, 0x28, 0x74, 0x0f, 0x92,
+	0x1e, 0x33, 0xd9, 0xc0, 0xd3, 0xe2, 0x22, 0x99, 0x1b, 0x13, 0xc9, 0x0c, 0x96, 0x6a, 0x09, 0x90,
+	0x2e, 0xc1, 0xe8, 0x21, 0xa0, 0xe7, 0xc4, 0x31, 0x7b, 0x06, 0x33, 0x7b, 0xbd, 0x91, 0xe1, 0x62,
+	0x6f, 0xd0, 0x63, 0x5a, 0x62, 0x4b, 0xd9, 0xce, 0xdc, 0xc9, 0x4f, 0x98, 0x68, 0x73, 0x88, 0x2e,
+	0x10, 0xba, 0x2a, 0x58, 0x11, 0x09, 0xaa, 0x40, 0xc6, 0x1b, 0x1c, 0xdb, 0x84, 0x19, 0x7c, 0x9b,
+	0x69, 0xf3, 0xd2, 0xc4, 0xa4, 0xd7, 0xed, 0x60, 0x0f, 0xee, 0x25, 0x5e, 0xbc, 0xdf, 0x54, 0x74,
+	0xf0, 0x49, 0x5c, 0x8c, 0x1e, 0x81, 0x2a, 0xb3, 0x6b, 0x60, 0xc7, 0xf2, 0xed, 0x24, 0x67, 0xb4,
+	0x93, 0x93, 0xcc, 0x9a, 0x63, 0x09, 0x5b, 0x75, 0xc8, 0x32, 0xca, 0xcc, 0x9e, 0x21, 0xe5, 0xda,
+	0xc2, 0x35, 0x6a, 0xb4, 0x28, 0xa8, 0xc1, 0x06, 0x3a, 0x80, 0xe5, 0x21, 0x65, 0xc4, 0xe9, 0x1a,
+	0x1e, 0x33, 0x5d, 0x19, 0x5f, 0x6a, 0x46, 0xbf, 0x96, 0x7c, 0x6a, 0x8b, 0x33, 0x85, 0x63, 0x0f,
+	0x41, 0x8a, 0xc2, 0x18, 0xd3, 0x33, 0xda, 0xca, 0xfa, 0xc4, 0x20, 0xc4, 0x3c, 0xdf, 0x24, 0xcc,
+	0xb4, 0x4c, 0x66, 0x6a, 0xc0, 0xb7, 0xad, 0x3e, 0x7e, 0x47, 0xab, 0x30, 0xcf, 0x08, 0xeb, 0x61,
+	0x2d, 0x23, 0x14, 0xfe, 0x0b, 0xd2, 0x60, 0xc1, 0x1b, 0xd8, 0xb6, 0xe9, 0x8e, 0xb4, 0x45, 0x21,
+	0x0f, 0x5e, 0xd1, 0x8f, 0x20, 0xe5, 0x77, 0x04, 0x76, 0xb5, 0xec, 0x15, 0x2d, 0x30, 0x46, 0xa2,
+	0x9b, 0x90, 0xc6, 0xe7, 0x7d, 0x6c, 0x11, 0x86, 0x2d, 0x2d, 0xb7, 0xa5, 0x6c, 0xa7, 0xf4, 0x50,
+	0x50, 0xfc, 0xa7, 0x02, 0x99, 0xe8, 0x0e, 0xf9, 0x0e, 0xd2, 0x23, 0xec, 0x19, 0x27, 0xa2, 0x65,
+	0x94, 0x4b, 0xfd, 0x5b, 0x77, 0x98, 0x9e, 0x1a, 0x61, 0x6f, 0x9f, 0xeb, 0xd1, 0x5d, 0xc8, 0x9a,
+	0xc7, 0x1e, 0x33, 0x89, 0x23, 0x09, 0xb1, 0xa9, 0x84, 0x45, 0x09, 0xf2, 0x49, 0x3f, 0x80, 0x94,
+	0x43, 0x25, 0x3e, 0x3e, 0x15, 0xbf, 0xe0, 0x50, 0x1f, 0x7a, 0x1f, 0x90, 0x43, 0x8d, 0x33, 0xc2,
+	0x4e, 0x8d, 0x21, 0x66, 0x01, 0x29, 0x31, 0x95, 0xb4, 0xe4, 0xd0, 0x23, 0xc2, 0x4e, 0x3b, 0x98,
+	0xf9, 0xe4, 0xe2, 0xcb, 0x18, 0xdc, 0xe8, 0x98, 0x3d, 0x62, 0x99, 0x8c, 0xba, 0x22, 0xc4, 0xa6,
+	0x8b, 0x87, 0x04, 0x9f, 0xa1, 0x43, 0x58, 0x1e, 0x06, 0x0a, 0xc3, 0xf4, 0xd3, 0x26, 0x63, 0xbd,
+	0xf5, 0xf6, 0xd5, 0xce, 0x86, 0xb4, 0x3a, 0x26, 0x5f, 0xcc, 0xac, 0x3a, 0x9c, 0x90, 0xa3, 0x9f,
+	0xc0, 0x12, 0x71, 0x4e, 0xb1, 0xcb, 0x13, 0x6a, 0xf4, 0xe9, 0x19, 0x76, 0x3f, 0x93, 0x88, 0xdc,
+	0x18, 0xd6, 0xe4, 0x28, 0xf4, 0x53, 0x50, 0xe9, 0x10, 0xbb, 0x2e, 0xb1, 0x2c, 0xec, 0x48, 0xe6,
+	0xf4, 0x94, 0x2c, 0x85, 0x38, 0x9f, 0x7a, 0x1f, 0x16, 0xfc, 0x71, 0xeb, 0x69, 0x09, 0xd1, 0x34,
+	0xb7, 0x26, 0x06, 0xc1, 0xe5, 0x59, 0xae, 0x07, 0x8c, 0xe2, 0xdf, 0x15, 0x48, 0x70, 0xf9, 0xd5,
+	0x63, 0xb7, 0x04, 0xf3, 0x43, 0xca, 0xf0, 0xd5, 0x23, 0xd7, 0x87, 0xfd, 0x5f, 0x6e, 0x5d, 0xe8,
+	0x95, 0xf9, 0x8b, 0xbd, 0xf2, 0x28, 0x91, 0x8a, 0xab, 0x89, 0xe2, 0xbf, 0x15, 0xc8, 0xca, 0x8e,
+	0x6f, 0x9a, 0xae, 0x69, 0x7b, 0xe8, 0x19, 0x64, 0x6c, 0xe2, 0x8c, 0x07, 0x88, 0x72, 0xd5, 0x00,
+	0xd9, 0xe0, 0x03, 0xe4, 0xd3, 0xbb, 0xcd, 0x1b, 0x11, 0xd6, 0xf7, 0xd4, 0x26, 0x0c, 0xdb, 0x7d,
+	0x36, 0xd2, 0xc1, 0x26, 0x4e, 0x30, 0x52, 0x6c, 0x40, 0xb6, 0x79, 0x1e, 0x80, 0x8c, 0x3e, 0x76,
+	0x09, 0xb5, 0x44, 0x22, 0xf8, 0x0a, 0x93, 0x73, 0xa0, 0x2a, 0xcf, 0xde, 0xbd, 0x6f, 0x3e, 0xbd,
+	0xdb, 0xbc, 0x79, 0x99, 0x18, 0x2e, 0xf2, 0x92, 0x8f, 0x09, 0xd5, 0x36, 0xcf, 0x83, 0x48, 0x84,
+	0xfe, 0x67, 0x31, 0x4d, 0x29, 0x3e, 0x85, 0xc5, 0x8e, 0x18, 0x1f, 0x32, 0xba, 0x2a, 0xc8, 0x71,
+	0x12, 0xac, 0xae, 0x5c, 0xb5, 0x7a, 0x42, 0x58, 0x5f, 0xf4, 0x59, 0x11, 0xcb, 0x7f, 0x0a, 0xfa,
+	0x5c, 0x5a, 0xfe, 0x16, 0x92, 0xbf, 0x1f, 0x50, 0x77, 0x60, 0x6b, 0xca, 0xf4, 0x43, 0xda, 0xd7,
+	0xa2, 0xef, 0x21, 0xcd, 0x4e, 0x5d, 0xec, 0x9d, 0xd2, 0x9e, 0xf5, 0x99, 0xf3, 0x3c, 0x04, 0xa0,
+	0x7b, 0x90, 0x13, 0x8d, 0x1a, 0x52, 0xe2, 0x53, 0x29, 0x59, 0x8e, 0x6a, 0x07, 0x20, 0xe1, 0xe0,
+	0x1f, 0xd2, 0x90, 0x94, 0xbe, 0xd5, 0xae, 0x59, 0xd3, 0xc8, 0xa1, 0x10, 0xad, 0xdf, 0xe3, 0x2f,
+	0xab, 0x5f, 0x62, 0x7a, 0x7d, 0x2e, 0xd7, 0x22, 0xfe, 0x05, 0xb5, 0x88, 0xe4, 0x3d, 0x31, 0x7b,
+	0xde, 0xe7, 0xaf, 0x9f, 0xf7, 0xe4, 0x0c, 0x79, 0x47, 0x75, 0x58, 0xe7, 0x89, 0x26, 0x0e, 0x61,
+	0x24, 0x3c, 0x85, 0x0d, 0xe1, 0xbe, 0xb6, 0x30, 0xd5, 0xc2, 0x57, 0x36, 0x71, 0xea, 0x3e, 0x5e,
+	0xa6, 0x47, 0xe7, 0x68, 0x74, 0x04, 0x6b, 0xe3, 0x43, 0xc5, 0xb8, 0x98, 0x27, 0x98, 0x2d, 0x4f,
+	0x37, 0xc6, 0xfc, 0x4e, 0x34, 0x61, 0xbf, 0x80, 0x95, 0xd0, 0x70, 0x18, 0x5f, 0x66, 0xaa, 0x77,
+	0x68, 0x0c, 0x0d, 0x83, 0x7c, 0x0a, 0xa1, 0x65, 0x23, 0xba, 0xaf, 0x16, 0xaf, 0xb1, 0xaf, 0x42,
+	0x1f, 0x1e, 0x87, 0x1b, 0x6c, 0x1b, 0xd4, 0xe3, 0x81, 0xeb, 0xf0, 0x70, 0xb1, 0x21, 0xab, 0x9a,
+	0x15, 0x07, 0x6c, 0x8e, 0xcb, 0xf9, 0x88, 0xfb, 0xb5, 0x5f, 0xcd, 0x0a, 0x6c, 0x08, 0xe4, 0x78,
+	0xd8, 0x8e, 0x37, 0xa5, 0x8b, 0x39, 0x5b, 0x9e, 0xcb, 0x79, 0x0e, 0x0a, 0x2e, 0x81, 0xc1, 0xee,
+	0xf3, 0x11, 0xe8, 0x1b, 0xc8, 0x85, 0x8b, 0xf1, 0x32, 0x6a, 0x4b, 0x82, 0xb3, 0x18, 0x2c, 0xc5,
+	0x4f, 0x3e, 0xf4, 0x2b, 0xc8, 0x47, 0x42, 0x34, 0x88, 0x73, 0xe2, 0x62, 0xd3, 0xc3, 0xb2, 0xa4,
+	0xea, 0xd4, 0xa4, 0xad, 0x85, 0x3d, 0x53, 0x97, 0x78, 0xbf, 0xa6, 0x4f, 0x41, 0x8b, 0x1a, 0xb3,
+	0xf0, 0x89, 0x39, 0x0a, 0x8a, 0xba, 0x3c, 0x63, 0x51, 0x43, 0xdb, 0x55, 0x4e, 0x97, 0x45, 0xbd,
+	0x0f, 0x79, 0xde, 0x9a, 0x41, 0x3a, 0x3c, 0x6e, 0xd5, 0x18, 0xdf, 0x6d, 0x90, 0x38, 0x86, 0xd6,
+	0x6c, 0xf3, 0x3c, 0xc8, 0x85, 0xd7, 0xc4, 0x6e, 0x53, 0xaa, 0xd1, 0x6f, 0x21, 0x1f, 0x40, 0x79,
+	0x5c, 0xd8, 0xe8, 0x11, 0x3b, 0xec, 0xef, 0x95, 0xd9, 0x1c, 0x5b, 0x0b, 0x4c, 0xe8, 0x26, 0xc3,
+	0x07, 0xdc, 0x80, 0xef, 0x5a, 0xf1, 0xaf, 0x0a, 0xa0, 0x60, 0xa9, 0x16, 0xbf, 0xf6, 0x7a, 0x1e,
+	0xff, 0xa8, 0x89, 0xde, 0xbd, 0x94, 0x99, 0xef, 0x5e, 0x13, 0xe7, 0x6b, 0xec, 0xd2, 0xf9, 0x3a,
+	0x71, 0x21, 0x8f, 0x5f, 0xff, 0x42, 0x7e, 0xfb, 0x8f, 0x0a, 0x40, 0xe4, 0x83, 0xed, 0x6b, 0x58,
+	0xeb, 0x34, 0xda, 0x35, 0xa3, 0xd1, 0x6c, 0xd7, 0x1b, 0x87, 0xc6, 0x93, 0xc3, 0x56, 0xb3, 0xb6,
+	0x5f, 0x7f, 0x50, 0xaf, 0x55, 0xd5, 0x39, 0xb4, 0x02, 0x4b, 0x51, 0xe5, 0xb3, 0x5a, 0x4b, 0x55,
+	0xd0, 0x1a, 0xac, 0x44, 0x85, 0x95, 0xbd, 0x56, 0xbb, 0x52, 0x3f, 0x54, 0x63, 0x08, 0x41, 0x2e,
+	0xaa, 0x38, 0x6c, 0xa8, 0x71, 0x74, 0x13, 0xb4, 0x8b, 0x32, 0xe3, 0xa8, 0xde, 0x7e, 0x68, 0x74,
+	0x6a, 0xed, 0x86, 0x9a, 0xb8, 0xfd, 0x0f, 0x05, 0x72, 0x17, 0x3f, 0x62, 0xd0, 0x26, 0x7c, 0xdd,
+	0xd4, 0x1b, 0xcd, 0x46, 0xab, 0x72, 0x60, 0xb4, 0xda, 0x95, 0xf6, 0x93, 0xd6, 0x84, 0x4f, 0x45,
+	0x28, 0x4c, 0x02, 0xaa, 0xb5, 0x66, 0xa3, 0x55, 0x6f, 0x1b, 0xcd, 0x9a, 0x5e, 0x6f, 0x54, 0x55,
+	0x05, 0xdd, 0x82, 0x8d, 0x49, 0x4c, 0xa7, 0xd1, 0xae, 0x1f, 0xfe, 0x32, 0x80, 0xc4, 0x50, 0x1e,
+	0xbe, 0x9a, 0x84, 0x34, 0x2b, 0xad, 0x56, 0xad, 0xea, 0x3b, 0x3d, 0xa9, 0xd3, 0x6b, 0x8f, 0x6a,
+	0xfb, 0xed, 0x5a, 0x55, 0x4d, 0x4c, 0x63, 0x3e, 0xa8, 0xd4, 0x0f, 0x6a, 0x55, 0x75, 0x7e, 0xaf,
+	0xf6, 0xfa, 0x43, 0x41, 0x79, 0xf3, 0xa1, 0xa0, 0xfc, 0xe7, 0x43, 0x41, 0x79, 0xf1, 0xb1, 0x30,
+	0xf7, 0xe6, 0x63, 0x61, 0xee, 0x5f, 0x1f, 0x0b, 0x73, 0xbf, 0xf9, 0xae, 0x4b, 0xd8, 0xe9, 0xe0,
+	0xb8, 0x74, 0x42, 0x6d, 0xf9, 0x69, 0x2d, 0xff, 0xed, 0x78, 0xd6, 0xef, 0xca, 0xe7, 0xe2, 0xe7,
+	0x02, 0x36, 0xea, 0x63, 0x8f, 0xff, 0x16, 0x90, 0x14, 0x95, 0xbc, 0xfb, 0xbf, 0x01, 0x00, 0x01,
+	0x09, 0x68, 0x94, 0x4c, 0x10, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1289,6 +1488,64 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorTallyPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTallyPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTallyPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OverriddenPower) > 0 {
+		i -= len(m.OverriddenPower)
+		copy(dAtA[i:], m.OverriddenPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OverriddenPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InheritedPower) > 0 {
+		i -= len(m.InheritedPower)
+		copy(dAtA[i:], m.InheritedPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InheritedPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1489,6 +1746,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposerRateLimitPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ProposerRateLimitPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProposerRateLimitPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxProposalsPerProposer != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxProposalsPerProposer))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MinDepositDecayPeriod != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MinDepositDecayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MinDepositDecayPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.MinDepositIncreaseRatio) > 0 {
+		i -= len(m.MinDepositIncreaseRatio)
+		copy(dAtA[i:], m.MinDepositIncreaseRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositIncreaseRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1541,12 +1838,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1579,22 +1876,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1615,6 +1912,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposerSubmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSubmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSubmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1755,16 +2097,21 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
-func (m *Vote) Size() (n int) {
+func (m *ValidatorTallyPreview) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
 	}
-	l = len(m.Voter)
+	l = len(m.InheritedPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OverriddenPower)
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
@@ -1774,24 +2121,46 @@ func (m *Vote) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
 	return n
 }
 
-func (m *DepositParams) Size() (n int) {
+func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
 	}
 	if m.MaxDepositPeriod != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
@@ -1893,6 +2262,41 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	l = len(m.MinDepositIncreaseRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MinDepositDecayPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MinDepositDecayPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxProposalsPerProposer != 0 {
+		n += 2 + sovGov(uint64(m.MaxProposalsPerProposer))
+	}
+	if m.ProposerRateLimitPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProposerRateLimitPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposerSubmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2800,6 +3204,186 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorTallyPreview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorTallyPreview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorTallyPreview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InheritedPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverriddenPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverriddenPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3744,6 +4328,266 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositIncreaseRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositIncreaseRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositDecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinDepositDecayPeriod == nil {
+				m.MinDepositDecayPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MinDepositDecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalsPerProposer", wireType)
+			}
+			m.MaxProposalsPerProposer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalsPerProposer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerRateLimitPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposerRateLimitPeriod == nil {
+				m.ProposerRateLimitPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ProposerRateLimitPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSubmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSubmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSubmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -28,6 +28,10 @@ var (
 	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositIncreaseRatio   = sdk.ZeroDec()
+	DefaultMinDepositDecayPeriod     = time.Duration(0)
+	DefaultMaxProposalsPerProposer   = uint64(0)
+	DefaultProposerRateLimitPeriod   = DefaultPeriod
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -58,6 +62,7 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 func NewParams(
 	minDeposit, expeditedminDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
 	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	minDepositIncreaseRatio string, minDepositDecayPeriod time.Duration, maxProposalsPerProposer uint64, proposerRateLimitPeriod time.Duration,
 ) Params {
 	return Params{
 		MinDeposit:                 minDeposit,
@@ -73,6 +78,10 @@ func NewParams(
 		BurnProposalDepositPrevote: burnProposalDeposit,
 		BurnVoteQuorum:             burnVoteQuorum,
 		BurnVoteVeto:               burnVoteVeto,
+		MinDepositIncreaseRatio:    minDepositIncreaseRatio,
+		MinDepositDecayPeriod:      &minDepositDecayPeriod,
+		MaxProposalsPerProposer:    maxProposalsPerProposer,
+		ProposerRateLimitPeriod:    &proposerRateLimitPeriod,
 	}
 }
 
@@ -92,9 +101,28 @@ func DefaultParams() Params {
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
+		DefaultMinDepositIncreaseRatio.String(),
+		DefaultMinDepositDecayPeriod,
+		DefaultMaxProposalsPerProposer,
+		DefaultProposerRateLimitPeriod,
 	)
 }
 
+// MinDepositIncrease returns the proportion of the minimum deposit by which it rises for each
+// proposal in voting period, zero if it is not set.
+func (p Params) MinDepositIncrease() (sdk.Dec, error) {
+	if p.MinDepositIncreaseRatio == "" {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return sdk.NewDecFromStr(p.MinDepositIncreaseRatio)
+}
+
+// ProposerRateLimitEnabled returns true if the proposals submitted by a proposer are rate limited.
+func (p Params) ProposerRateLimitEnabled() bool {
+	return p.MaxProposalsPerProposer > 0 && p.ProposerRateLimitPeriod != nil && *p.ProposerRateLimitPeriod > 0
+}
+
 // ValidateBasic performs basic validation on governance parameters.
 func (p Params) ValidateBasic() error {
 	minDeposit := sdk.Coins(p.MinDeposit)
@@ -191,5 +219,24 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("mininum initial deposit ratio of proposal is too large: %s", minInitialDepositRatio)
 	}
 
+	minDepositIncrease, err := p.MinDepositIncrease()
+	if err != nil {
+		return fmt.Errorf("invalid minimum deposit increase ratio: %w", err)
+	}
+	if minDepositIncrease.IsNegative() {
+		return fmt.Errorf("minimum deposit increase ratio must be positive: %s", minDepositIncrease)
+	}
+
+	if p.MinDepositDecayPeriod != nil && *p.MinDepositDecayPeriod < 0 {
+		return fmt.Errorf("minimum deposit decay period must be positive: %s", p.MinDepositDecayPeriod)
+	}
+
+	if p.ProposerRateLimitPeriod != nil && *p.ProposerRateLimitPeriod < 0 {
+		return fmt.Errorf("proposer rate limit period must be positive: %s", p.ProposerRateLimitPeriod)
+	}
+	if p.MaxProposalsPerProposer > 0 && (p.ProposerRateLimitPeriod == nil || *p.ProposerRateLimitPeriod == 0) {
+		return fmt.Errorf("proposer rate limit period must be set with the maximum proposals per proposer: %d", p.MaxProposalsPerProposer)
+	}
+
 	return nil
 }
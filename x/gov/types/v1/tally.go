@@ -27,6 +27,24 @@ func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens math.Int, delegato
 	}
 }
 
+// NewValidatorTallyPreview creates a ValidatorTallyPreview instance from the voting power of a
+// bonded validator and the deductions of its delegators voting independently
+func NewValidatorTallyPreview(val ValidatorGovInfo) ValidatorTallyPreview {
+	inherited, overridden := math.LegacyZeroDec(), math.LegacyZeroDec()
+	if !val.DelegatorShares.IsZero() {
+		// shares * bonded / total shares
+		overridden = val.DelegatorDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		inherited = val.DelegatorShares.Sub(val.DelegatorDeductions).MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+	}
+
+	return ValidatorTallyPreview{
+		ValidatorAddress: val.Address.String(),
+		InheritedPower:   inherited.TruncateInt().String(),
+		OverriddenPower:  overridden.TruncateInt().String(),
+		Options:          val.Vote,
+	}
+}
+
 // NewTallyResult creates a new TallyResult instance
 func NewTallyResult(yes, abstain, no, noWithVeto math.Int) TallyResult {
 	return TallyResult{
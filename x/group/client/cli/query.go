@@ -35,6 +35,8 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryScheduledExecutionCmd(),
+		QueryScheduledExecutionsByGroupPolicyCmd(),
 	)
 
 	return queryCmd
@@ -553,3 +555,75 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryScheduledExecutionCmd creates a CLI command for Query/ScheduledExecution.
+func QueryScheduledExecutionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-execution [proposal-id]",
+		Short: "Query for the scheduled execution of an accepted proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledExecution(cmd.Context(), &group.QueryScheduledExecutionRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryScheduledExecutionsByGroupPolicyCmd creates a CLI command for Query/ScheduledExecutionsByGroupPolicy.
+func QueryScheduledExecutionsByGroupPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-executions-by-group-policy [group-policy-account]",
+		Short: "Query for scheduled executions by account address of group policy with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledExecutionsByGroupPolicy(cmd.Context(), &group.QueryScheduledExecutionsByGroupPolicyRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-executions-by-group-policy")
+
+	return cmd
+}
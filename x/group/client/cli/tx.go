@@ -19,6 +19,10 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagWeightSource       = "weight-source"
+	FlagWeightDenom        = "weight-denom"
+	WeightSourceStaking    = "staking"
+	WeightSourceBalance    = "balance"
 )
 
 // TxCmd returns a root CLI command handler for all x/group transaction commands.
@@ -58,7 +62,9 @@ func MsgCreateGroupCmd() *cobra.Command {
 		Use:   "create-group [admin] [metadata] [members-json-file]",
 		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account.",
 		Long: `Create a group which is an aggregation of member accounts with associated weights and an administrator account.
-Note, the '--from' flag is ignored as it is implied from [admin]. Members accounts can be given through a members JSON file that contains an array of members.`,
+Note, the '--from' flag is ignored as it is implied from [admin]. Members accounts can be given through a members JSON file that contains an array of members.
+If the weight-source flag is set, the members' voting weights are computed from their staked tokens ("staking") or from their balance
+of the weight-denom flag denom ("balance"), and snapshotted at each proposal submission. The members' weights then only define the group membership.`,
 		Example: fmt.Sprintf(`
 %s tx group create-group [admin] [metadata] [members-json-file]
 
@@ -95,10 +101,17 @@ Where members.json contains:
 				return err
 			}
 
+			weightSource, weightDenom, err := getWeightSourceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &group.MsgCreateGroup{
-				Admin:    clientCtx.GetFromAddress().String(),
-				Members:  members,
-				Metadata: args[1],
+				Admin:        clientCtx.GetFromAddress().String(),
+				Members:      members,
+				Metadata:     args[1],
+				WeightSource: weightSource,
+				WeightDenom:  weightDenom,
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
@@ -108,6 +121,7 @@ Where members.json contains:
 		},
 	}
 
+	addWeightSourceFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -268,7 +282,9 @@ func MsgCreateGroupWithPolicyCmd() *cobra.Command {
 		Long: `Create a group with policy which is an aggregation of member accounts with associated weights,
 an administrator account and decision policy. Note, the '--from' flag is ignored as it is implied from [admin].
 Members accounts can be given through a members JSON file that contains an array of members.
-If group-policy-as-admin flag is set to true, the admin of the newly created group and group policy is set with the group policy address itself.`,
+If group-policy-as-admin flag is set to true, the admin of the newly created group and group policy is set with the group policy address itself.
+If the weight-source flag is set, the members' voting weights are computed from their staked tokens ("staking") or from their balance
+of the weight-denom flag denom ("balance"), and snapshotted at each proposal submission.`,
 		Example: fmt.Sprintf(`
 %s tx group create-group-with-policy [admin] [group-metadata] [group-policy-metadata] members.json policy.json
 
@@ -339,6 +355,11 @@ and policy.json contains:
 				return err
 			}
 
+			msg.WeightSource, msg.WeightDenom, err = getWeightSourceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
 			}
@@ -347,6 +368,7 @@ and policy.json contains:
 		},
 	}
 	cmd.Flags().Bool(FlagGroupPolicyAsAdmin, false, "Sets admin of the newly created group and group policy with group policy address itself when true")
+	addWeightSourceFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -574,6 +596,14 @@ Parameters:
 	"title": "My proposal",
 	"summary": "This is a proposal to send 10 stake to cosmos1...",
 	"proposers": ["cosmos1...", "cosmos1..."],
+	// optional execution schedule, to execute the messages automatically,
+	// possibly several times, once the proposal is accepted
+	"execution_schedule": {
+		"start_time": "2023-01-01T00:00:00Z",
+		"interval": "2592000s",
+		"count": "12",
+		"gas_limit": "200000"
+	}
 }
 
 metadata example: 
@@ -610,6 +640,11 @@ metadata example:
 				return err
 			}
 
+			schedule, err := parseExecutionSchedule(clientCtx.Codec, prop)
+			if err != nil {
+				return err
+			}
+
 			execStr, _ := cmd.Flags().GetString(FlagExec)
 
 			msg, err := group.NewMsgSubmitProposal(
@@ -624,6 +659,7 @@ metadata example:
 			if err != nil {
 				return err
 			}
+			msg.ExecutionSchedule = schedule
 
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/spf13/cobra"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -59,6 +61,40 @@ func execFromString(execStr string) group.Exec {
 	return exec
 }
 
+func weightSourceFromString(weightSourceStr string) (group.WeightSource, error) {
+	switch weightSourceStr {
+	case "":
+		return group.WEIGHT_SOURCE_UNSPECIFIED, nil
+	case WeightSourceStaking:
+		return group.WEIGHT_SOURCE_STAKING, nil
+	case WeightSourceBalance:
+		return group.WEIGHT_SOURCE_BALANCE, nil
+	default:
+		return group.WEIGHT_SOURCE_UNSPECIFIED, fmt.Errorf("invalid weight source %q, expected %q or %q", weightSourceStr, WeightSourceStaking, WeightSourceBalance)
+	}
+}
+
+func addWeightSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagWeightSource, "", fmt.Sprintf("Computes the members' voting weights dynamically, from their staked tokens (%q) or balance of the weight denom (%q)", WeightSourceStaking, WeightSourceBalance))
+	cmd.Flags().String(FlagWeightDenom, "", "Denom whose balance defines the members' voting weights, required with the balance weight source")
+}
+
+func getWeightSourceFlags(cmd *cobra.Command) (group.WeightSource, string, error) {
+	weightSourceStr, err := cmd.Flags().GetString(FlagWeightSource)
+	if err != nil {
+		return group.WEIGHT_SOURCE_UNSPECIFIED, "", err
+	}
+	weightSource, err := weightSourceFromString(weightSourceStr)
+	if err != nil {
+		return group.WEIGHT_SOURCE_UNSPECIFIED, "", err
+	}
+	weightDenom, err := cmd.Flags().GetString(FlagWeightDenom)
+	if err != nil {
+		return group.WEIGHT_SOURCE_UNSPECIFIED, "", err
+	}
+	return weightSource, weightDenom, nil
+}
+
 // Proposal defines a Msg-based group proposal for CLI purposes.
 type Proposal struct {
 	GroupPolicyAddress string `json:"group_policy_address"`
@@ -68,6 +104,8 @@ type Proposal struct {
 	Proposers []string          `json:"proposers"`
 	Title     string            `json:"title"`
 	Summary   string            `json:"summary"`
+	// ExecutionSchedule defines an optional proto-JSON-encoded execution schedule.
+	ExecutionSchedule json.RawMessage `json:"execution_schedule,omitempty"`
 }
 
 func getCLIProposal(path string) (Proposal, error) {
@@ -102,3 +140,18 @@ func parseMsgs(cdc codec.Codec, p Proposal) ([]sdk.Msg, error) {
 
 	return msgs, nil
 }
+
+// parseExecutionSchedule returns the execution schedule of the proposal, or
+// nil if the proposal doesn't define one.
+func parseExecutionSchedule(cdc codec.Codec, p Proposal) (*group.ExecutionSchedule, error) {
+	if len(p.ExecutionSchedule) == 0 {
+		return nil, nil
+	}
+
+	var schedule group.ExecutionSchedule
+	if err := cdc.UnmarshalJSON(p.ExecutionSchedule, &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
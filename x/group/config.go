@@ -9,6 +9,14 @@ type Config struct {
 	MaxExecutionPeriod time.Duration
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxScheduledExecutionGas defines the max amount of gas a single
+	// scheduled proposal execution can consume in the EndBlocker. It is also
+	// the default gas limit of schedules that don't define one.
+	MaxScheduledExecutionGas uint64
+	// MaxScheduledExecutionsPerBlock defines the max number of scheduled
+	// proposal executions run in a single EndBlocker. Executions that don't
+	// fit in a block are postponed to the next ones.
+	MaxScheduledExecutionsPerBlock uint64
 }
 
 // DefaultConfig returns the default config for group.
@@ -16,5 +24,8 @@ func DefaultConfig() Config {
 	return Config{
 		MaxExecutionPeriod: 2 * time.Hour * 24 * 7, // Two weeks.
 		MaxMetadataLen:     255,
+
+		MaxScheduledExecutionGas:       1_000_000,
+		MaxScheduledExecutionsPerBlock: 10,
 	}
 }
@@ -528,6 +528,155 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventScheduledExec is an event emitted when the messages of a scheduled
+// proposal are executed.
+type EventScheduledExec struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// execution is the 1-based index of this execution in the schedule.
+	Execution uint64 `protobuf:"varint,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// result is the execution result.
+	Result ProposalExecutorResult `protobuf:"varint,3,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// logs contains error logs in case the execution result is FAILURE.
+	Logs string `protobuf:"bytes,4,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *EventScheduledExec) Reset()         { *m = EventScheduledExec{} }
+func (m *EventScheduledExec) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledExec) ProtoMessage()    {}
+func (*EventScheduledExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventScheduledExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledExec.Merge(m, src)
+}
+func (m *EventScheduledExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledExec proto.InternalMessageInfo
+
+func (m *EventScheduledExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventScheduledExec) GetExecution() uint64 {
+	if m != nil {
+		return m.Execution
+	}
+	return 0
+}
+
+func (m *EventScheduledExec) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *EventScheduledExec) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
+// EventScheduledExecutionEnded is an event emitted when a scheduled execution
+// is removed from state, either because it has completed or because it was
+// cancelled.
+type EventScheduledExecutionEnded struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executions is the number of executions that happened.
+	Executions uint64 `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	// failures is the number of executions that failed.
+	Failures uint64 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	// cancelled is true if the schedule was cancelled before its completion.
+	Cancelled bool `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *EventScheduledExecutionEnded) Reset()         { *m = EventScheduledExecutionEnded{} }
+func (m *EventScheduledExecutionEnded) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledExecutionEnded) ProtoMessage()    {}
+func (*EventScheduledExecutionEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventScheduledExecutionEnded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledExecutionEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledExecutionEnded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledExecutionEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledExecutionEnded.Merge(m, src)
+}
+func (m *EventScheduledExecutionEnded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledExecutionEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledExecutionEnded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledExecutionEnded proto.InternalMessageInfo
+
+func (m *EventScheduledExecutionEnded) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventScheduledExecutionEnded) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *EventScheduledExecutionEnded) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *EventScheduledExecutionEnded) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +688,48 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventScheduledExec)(nil), "cosmos.group.v1.EventScheduledExec")
+	proto.RegisterType((*EventScheduledExecutionEnded)(nil), "cosmos.group.v1.EventScheduledExecutionEnded")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x18, 0xc7, 0xe9, 0x4f, 0x02, 0x52, 0x8c, 0x98, 0xfa, 0x27, 0x03, 0xc9, 0x20, 0xc4, 0x44, 0x0e,
-	0xb2, 0x05, 0x4c, 0xd4, 0x93, 0x44, 0x0c, 0x31, 0x24, 0x1c, 0xc8, 0xf0, 0x4f, 0xe2, 0x05, 0xc7,
-	0xda, 0x8c, 0xc5, 0x41, 0x97, 0xb6, 0x9b, 0x70, 0xf4, 0x1d, 0xf8, 0x52, 0x3c, 0xf8, 0x22, 0x3c,
-	0x12, 0x4f, 0x1e, 0x0d, 0xbc, 0x11, 0xb3, 0xae, 0x03, 0x82, 0x31, 0x23, 0xf9, 0x9d, 0x68, 0xfb,
-	0xfd, 0x7c, 0xbf, 0x3c, 0x4f, 0x9f, 0x15, 0xd6, 0x1d, 0xca, 0x97, 0x94, 0x9b, 0x2e, 0xa3, 0x61,
-	0x60, 0x46, 0x5d, 0x93, 0x44, 0x64, 0x25, 0xb8, 0x11, 0x30, 0x2a, 0x28, 0xaa, 0x24, 0xaa, 0x21,
-	0x55, 0x23, 0xea, 0xd6, 0xaa, 0xc9, 0xc1, 0x4c, 0xca, 0xa6, 0x52, 0xe5, 0xa6, 0xf6, 0xf0, 0x3c,
-	0x49, 0x6c, 0x02, 0xa2, 0xc4, 0x56, 0x07, 0xde, 0x19, 0xc6, 0xc1, 0xaf, 0x19, 0xb1, 0x05, 0x79,
-	0x13, 0x23, 0xa8, 0x0a, 0x6f, 0x4a, 0x76, 0xe6, 0x61, 0x0d, 0x34, 0x41, 0x3b, 0x6f, 0x15, 0xe5,
-	0x7e, 0x84, 0x0f, 0xf8, 0xbb, 0x00, 0x5f, 0x82, 0x8f, 0xe1, 0x83, 0xf3, 0xf4, 0x09, 0xf5, 0x3d,
-	0x67, 0x83, 0x7a, 0xb0, 0x68, 0x63, 0xcc, 0x08, 0xe7, 0xd2, 0x53, 0x1a, 0x68, 0xbf, 0x7e, 0x74,
-	0xee, 0xa9, 0xba, 0x5f, 0x25, 0xca, 0x54, 0x30, 0x6f, 0xe5, 0x5a, 0x29, 0x78, 0x48, 0x3b, 0xf9,
-	0xf3, 0x6b, 0xa4, 0x3d, 0x83, 0x77, 0x65, 0xda, 0x34, 0x9c, 0x2f, 0x3d, 0x31, 0x61, 0x34, 0xa0,
-	0xdc, 0xf6, 0x51, 0x03, 0x96, 0x03, 0xb5, 0x3e, 0x36, 0x04, 0xd3, 0xa3, 0x11, 0x6e, 0xbd, 0x80,
-	0xf7, 0xa5, 0xef, 0x83, 0x27, 0x16, 0x98, 0xd9, 0x5f, 0x2e, 0x77, 0x3e, 0x81, 0x25, 0xe9, 0x7c,
-	0x4f, 0x05, 0xc9, 0xa6, 0xbf, 0x02, 0x85, 0x0f, 0xd7, 0xc4, 0xc9, 0xc4, 0x51, 0x1f, 0x16, 0x18,
-	0xe1, 0xa1, 0x2f, 0xb4, 0xab, 0x26, 0x68, 0xdf, 0xee, 0x3d, 0x36, 0xce, 0x3e, 0x11, 0x23, 0x2d,
-	0x34, 0xce, 0x0b, 0x05, 0x65, 0x96, 0xc4, 0x2d, 0x65, 0x43, 0x08, 0xe6, 0x7d, 0xea, 0x72, 0xed,
-	0x46, 0x7c, 0x81, 0x96, 0x5c, 0xb7, 0x3e, 0xc1, 0x8a, 0x2c, 0x61, 0x4c, 0xec, 0x28, 0x73, 0xda,
-	0xa7, 0x53, 0xb8, 0xba, 0x74, 0x0a, 0xdf, 0x81, 0x1a, 0x43, 0x5a, 0xdd, 0x84, 0x85, 0x2b, 0x82,
-	0xb3, 0xfb, 0x7d, 0x0e, 0x0b, 0x5c, 0xd8, 0x22, 0xe4, 0xaa, 0xdf, 0xc6, 0x7f, 0xfb, 0x9d, 0x4a,
-	0xcc, 0x52, 0x38, 0xea, 0xc3, 0x5b, 0xc2, 0xf6, 0xfd, 0xcd, 0x4c, 0x5d, 0x57, 0xdc, 0x6f, 0xb9,
-	0x57, 0xff, 0xc7, 0xfe, 0x36, 0x86, 0xd4, 0x1d, 0x95, 0xc5, 0x71, 0x33, 0x78, 0xf9, 0x73, 0xa7,
-	0x83, 0xed, 0x4e, 0x07, 0x7f, 0x76, 0x3a, 0xf8, 0xb6, 0xd7, 0x73, 0xdb, 0xbd, 0x9e, 0xfb, 0xbd,
-	0xd7, 0x73, 0x1f, 0x1f, 0xb9, 0x9e, 0x58, 0x84, 0x73, 0xc3, 0xa1, 0x4b, 0xf5, 0x04, 0xd5, 0x4f,
-	0x87, 0xe3, 0xcf, 0xe6, 0x3a, 0x79, 0x81, 0xf3, 0x82, 0x7c, 0x79, 0x4f, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0x1a, 0x1c, 0xb9, 0xe2, 0x03, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0xb6, 0x56, 0x9a, 0x4c, 0x10, 0x45, 0xcb, 0x8f, 0xd2, 0x10, 0xb9, 0x55, 0x84, 0x44,
+	0x0f, 0xc4, 0x56, 0x83, 0x04, 0x9c, 0xa8, 0x28, 0x8a, 0x50, 0xa5, 0x1e, 0x22, 0x87, 0x1f, 0x89,
+	0x4b, 0x70, 0xbc, 0x4b, 0x62, 0xb1, 0xf1, 0x5a, 0xbb, 0xeb, 0xd0, 0x1c, 0x79, 0x03, 0xae, 0xbc,
+	0x01, 0x47, 0x0e, 0x3c, 0x04, 0xc7, 0x8a, 0x13, 0x47, 0x94, 0xbc, 0x08, 0xf2, 0x7a, 0x93, 0x58,
+	0xa9, 0x50, 0x2c, 0xf5, 0x64, 0xcf, 0xcc, 0x37, 0xdf, 0x7e, 0x33, 0xdf, 0x6a, 0xa1, 0x11, 0x70,
+	0x39, 0xe6, 0xd2, 0x1d, 0x0a, 0x9e, 0xc4, 0xee, 0xe4, 0xd8, 0xa5, 0x13, 0x1a, 0x29, 0xe9, 0xc4,
+	0x82, 0x2b, 0x8e, 0xf7, 0xb2, 0xaa, 0xa3, 0xab, 0xce, 0xe4, 0xb8, 0xbe, 0x9f, 0x25, 0xfa, 0xba,
+	0xec, 0x9a, 0xaa, 0x0e, 0xea, 0xf7, 0xd7, 0x99, 0xd4, 0x34, 0xa6, 0xa6, 0xd8, 0x6c, 0xc1, 0xad,
+	0x4e, 0x4a, 0xfc, 0x52, 0x50, 0x5f, 0xd1, 0x57, 0x29, 0x04, 0xef, 0x43, 0x59, 0x63, 0xfb, 0x21,
+	0xa9, 0xa1, 0x43, 0x74, 0x64, 0x79, 0xbb, 0x3a, 0x3e, 0x23, 0x4b, 0xf8, 0x9b, 0x98, 0x14, 0x81,
+	0x9f, 0xc3, 0xbd, 0x75, 0xf6, 0x2e, 0x67, 0x61, 0x30, 0xc5, 0x6d, 0xd8, 0xf5, 0x09, 0x11, 0x54,
+	0x4a, 0xdd, 0x53, 0x39, 0xad, 0xfd, 0xfe, 0xd9, 0xba, 0x63, 0x74, 0xbf, 0xc8, 0x2a, 0x3d, 0x25,
+	0xc2, 0x68, 0xe8, 0x2d, 0x80, 0x4b, 0xb6, 0xdc, 0xe1, 0xd7, 0x60, 0x7b, 0x02, 0xb7, 0x35, 0x5b,
+	0x2f, 0x19, 0x8c, 0x43, 0xd5, 0x15, 0x3c, 0xe6, 0xd2, 0x67, 0xf8, 0x00, 0xaa, 0xb1, 0xf9, 0x5f,
+	0x0d, 0x04, 0x8b, 0xd4, 0x19, 0x69, 0x3e, 0x83, 0xbb, 0xba, 0xef, 0x5d, 0xa8, 0x46, 0x44, 0xf8,
+	0x9f, 0x8b, 0x77, 0x3e, 0x82, 0x8a, 0xee, 0x7c, 0xcb, 0x15, 0xdd, 0x8c, 0xfe, 0x82, 0x0c, 0xbc,
+	0x73, 0x41, 0x83, 0x8d, 0x70, 0x7c, 0x02, 0x25, 0x41, 0x65, 0xc2, 0x54, 0x6d, 0xfb, 0x10, 0x1d,
+	0xdd, 0x6c, 0x3f, 0x74, 0xd6, 0xae, 0x88, 0xb3, 0x10, 0x9a, 0xf2, 0x25, 0x8a, 0x0b, 0x4f, 0xc3,
+	0x3d, 0xd3, 0x86, 0x31, 0x58, 0x8c, 0x0f, 0x65, 0x6d, 0x27, 0x5d, 0xa0, 0xa7, 0xff, 0x9b, 0x1f,
+	0x60, 0x4f, 0x4b, 0x38, 0xa7, 0xfe, 0x64, 0xa3, 0xdb, 0x79, 0x17, 0xb6, 0x8b, 0xba, 0xf0, 0x03,
+	0x19, 0x1b, 0x16, 0xea, 0xba, 0x22, 0x89, 0x28, 0xd9, 0x3c, 0xef, 0x53, 0x28, 0x49, 0xe5, 0xab,
+	0x44, 0x9a, 0x79, 0x0f, 0xfe, 0x3b, 0x6f, 0x4f, 0xc3, 0x3c, 0x03, 0xc7, 0x27, 0x70, 0x43, 0xf9,
+	0x8c, 0x4d, 0xfb, 0x66, 0x5d, 0xe9, 0xbc, 0xd5, 0x76, 0xe3, 0x4a, 0xfb, 0xeb, 0x14, 0x64, 0x76,
+	0x54, 0x55, 0xab, 0xa0, 0xf9, 0x1d, 0x01, 0xce, 0x6e, 0x4e, 0x30, 0xa2, 0x24, 0x61, 0x94, 0x14,
+	0x73, 0xa8, 0x01, 0x15, 0xaa, 0x57, 0x1f, 0xf2, 0x48, 0x8b, 0xb6, 0xbc, 0x55, 0x22, 0xe7, 0xdf,
+	0xce, 0xf5, 0xfc, 0xb3, 0x72, 0xfe, 0x7d, 0x43, 0xd0, 0xb8, 0x2a, 0x55, 0x1f, 0xd8, 0x89, 0x48,
+	0x91, 0x35, 0xdb, 0x00, 0x4b, 0x8d, 0xd2, 0xa8, 0xce, 0x65, 0x70, 0x1d, 0xca, 0x1f, 0xfd, 0x90,
+	0x25, 0x82, 0x66, 0x37, 0xc7, 0xf2, 0x96, 0x71, 0x3a, 0x70, 0xe0, 0x47, 0x01, 0x65, 0x8c, 0x12,
+	0x2d, 0xab, 0xec, 0xad, 0x12, 0xa7, 0xcf, 0x7f, 0xcd, 0x6c, 0x74, 0x39, 0xb3, 0xd1, 0xdf, 0x99,
+	0x8d, 0xbe, 0xce, 0xed, 0xad, 0xcb, 0xb9, 0xbd, 0xf5, 0x67, 0x6e, 0x6f, 0xbd, 0x7f, 0x30, 0x0c,
+	0xd5, 0x28, 0x19, 0x38, 0x01, 0x1f, 0x9b, 0x97, 0xcc, 0x7c, 0x5a, 0x92, 0x7c, 0x72, 0x2f, 0xb2,
+	0x87, 0x6c, 0x50, 0xd2, 0x0f, 0xd8, 0xe3, 0x7f, 0x03, 0x00, 0xf0, 0xe9, 0x1c, 0xc3, 0x29, 0x05,
+	0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,6 +1052,99 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventScheduledExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		i -= len(m.Logs)
+		copy(dAtA[i:], m.Logs)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logs)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Execution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduledExecutionEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledExecutionEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledExecutionEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Failures != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Executions != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1046,6 +1296,49 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventScheduledExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Execution != 0 {
+		n += 1 + sovEvents(uint64(m.Execution))
+	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	l = len(m.Logs)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScheduledExecutionEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovEvents(uint64(m.Executions))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovEvents(uint64(m.Failures))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1906,6 +2199,272 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventScheduledExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			m.Execution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Execution |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduledExecutionEnded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledExecutionEnded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledExecutionEnded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
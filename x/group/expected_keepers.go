@@ -3,6 +3,7 @@ package group
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type AccountKeeper interface {
@@ -21,4 +22,15 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// StakingKeeper defines the expected interface needed to compute the voting
+// weights of members of groups with a staking weight source.
+type StakingKeeper interface {
+	// IterateDelegations iterates through all of the delegations of a delegator.
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool))
+
+	// Validator gets a particular validator by operator address.
+	Validator(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI
 }
@@ -77,6 +77,30 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, se := range s.ScheduledExecutions {
+
+		// check that group policy with scheduled execution address exists
+		if _, exists := groupPolicies[se.GroupPolicyAddress]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group policy account with address %s doesn't correspond to scheduled execution address", se.GroupPolicyAddress))
+		}
+
+		if err := se.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "ScheduledExecution validation failed")
+		}
+	}
+
+	for _, ws := range s.MemberWeightSnapshots {
+
+		if err := ws.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "MemberWeightSnapshot validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[ws.ProposalId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", ws.ProposalId))
+		}
+	}
 	return nil
 }
 
@@ -94,5 +118,11 @@ func (s GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, se := range s.ScheduledExecutions {
+		err := se.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -43,6 +43,11 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// scheduled_executions is the list of scheduled proposal executions.
+	ScheduledExecutions []*ScheduledExecution `protobuf:"bytes,9,rep,name=scheduled_executions,json=scheduledExecutions,proto3" json:"scheduled_executions,omitempty"`
+	// member_weight_snapshots is the list of member voting weights snapshotted
+	// at submission of proposals of groups with a dynamic weight source.
+	MemberWeightSnapshots []*MemberWeightSnapshot `protobuf:"bytes,10,rep,name=member_weight_snapshots,json=memberWeightSnapshots,proto3" json:"member_weight_snapshots,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +139,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledExecutions() []*ScheduledExecution {
+	if m != nil {
+		return m.ScheduledExecutions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMemberWeightSnapshots() []*MemberWeightSnapshot {
+	if m != nil {
+		return m.MemberWeightSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,29 +160,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xe9, 0x8f, 0x3f, 0x3f, 0x58, 0xfe, 0x68, 0x36, 0x31, 0xa9, 0xa0, 0x0d, 0x1a, 0x0f,
-	0x24, 0xc6, 0x36, 0xe0, 0xc1, 0x9b, 0x89, 0x5e, 0x88, 0x07, 0x13, 0x52, 0x12, 0x0f, 0x5e, 0x0c,
-	0xe0, 0x58, 0x1b, 0x29, 0x53, 0x3a, 0x0b, 0x91, 0xb7, 0xf0, 0x09, 0x7c, 0x1e, 0x8f, 0x1c, 0x3d,
-	0x1a, 0x78, 0x11, 0xc3, 0x6c, 0x49, 0x0d, 0x70, 0xda, 0xdd, 0xd9, 0xcf, 0x77, 0x3e, 0x93, 0x8c,
-	0x38, 0x1e, 0x20, 0x05, 0x48, 0x8e, 0x17, 0xe1, 0x24, 0x74, 0xa6, 0x4d, 0xc7, 0x83, 0x11, 0x90,
-	0x4f, 0x76, 0x18, 0xa1, 0x42, 0xb9, 0xa7, 0xbf, 0x6d, 0xfe, 0xb6, 0xa7, 0xcd, 0x6a, 0x6d, 0x93,
-	0x57, 0xb3, 0x10, 0x62, 0xfa, 0xf4, 0x33, 0x2d, 0x4a, 0x6d, 0x9d, 0xef, 0xaa, 0x9e, 0x02, 0x59,
-	0x13, 0x05, 0x06, 0x9f, 0x08, 0xc6, 0xa6, 0x51, 0x37, 0x1a, 0x19, 0x37, 0xcf, 0x85, 0x2e, 0x8c,
-	0x65, 0x4b, 0xe4, 0xf8, 0x4e, 0xe6, 0xbf, 0x7a, 0xba, 0x51, 0x6c, 0x55, 0xed, 0x0d, 0x99, 0xdd,
-	0x5e, 0x5d, 0xee, 0x46, 0x2f, 0xe8, 0xc6, 0xa4, 0xbc, 0x11, 0x65, 0xdd, 0x30, 0x80, 0xa0, 0x0f,
-	0x11, 0x99, 0x69, 0x8e, 0x1e, 0xed, 0x8e, 0xde, 0x33, 0xe4, 0x96, 0xbc, 0xe4, 0x41, 0xb2, 0x21,
-	0xf6, 0x75, 0x8b, 0x10, 0x87, 0xfe, 0x60, 0xc6, 0xa3, 0x65, 0x78, 0xb4, 0x0a, 0xd7, 0x3b, 0x5c,
-	0x5e, 0x0d, 0xd8, 0x16, 0x95, 0x3f, 0xa4, 0x0f, 0x64, 0x66, 0xd9, 0x56, 0xdf, 0x6d, 0xd3, 0x41,
-	0x1e, 0xb7, 0x9c, 0x74, 0xf2, 0x81, 0xe4, 0x89, 0x28, 0x85, 0x11, 0x86, 0x48, 0xbd, 0x21, 0xeb,
-	0x72, 0xac, 0x2b, 0xae, 0x6b, 0x2b, 0xd7, 0x95, 0x28, 0xac, 0x9f, 0x64, 0xfe, 0x67, 0xcd, 0xe1,
-	0x96, 0xa6, 0x13, 0x13, 0x6e, 0xc2, 0xca, 0x73, 0x91, 0x9d, 0xa2, 0x02, 0x32, 0xf3, 0x1c, 0x3a,
-	0xd8, 0x0a, 0x3d, 0xa0, 0x02, 0x57, 0x33, 0xb7, 0xd7, 0x5f, 0x0b, 0xcb, 0x98, 0x2f, 0x2c, 0xe3,
-	0x67, 0x61, 0x19, 0x1f, 0x4b, 0x2b, 0x35, 0x5f, 0x5a, 0xa9, 0xef, 0xa5, 0x95, 0x7a, 0x3c, 0xf3,
-	0x7c, 0xf5, 0x3a, 0xe9, 0xdb, 0x03, 0x0c, 0x9c, 0x78, 0xc5, 0xfa, 0xb8, 0xa0, 0xe7, 0x37, 0xe7,
-	0x5d, 0xef, 0xbb, 0x9f, 0xe3, 0x3d, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x99, 0x5b, 0x30,
-	0xc4, 0x36, 0x02, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0xab, 0xd3, 0x40,
+	0x14, 0xc5, 0x1b, 0x5f, 0x5f, 0x7d, 0x9d, 0xd7, 0xf7, 0x94, 0xd1, 0x87, 0x63, 0xab, 0xa1, 0xfe,
+	0x83, 0x82, 0x98, 0xd0, 0xba, 0x70, 0x27, 0x28, 0x48, 0x71, 0x21, 0x94, 0x04, 0x2a, 0x08, 0x12,
+	0xda, 0xf4, 0x9a, 0x04, 0x9b, 0x4c, 0x9a, 0x3b, 0xa9, 0xed, 0xb7, 0xf0, 0x63, 0xb9, 0xec, 0x46,
+	0x70, 0x29, 0xed, 0x17, 0x91, 0xde, 0x49, 0xa8, 0x34, 0x5d, 0x25, 0x73, 0xe6, 0x77, 0xce, 0xb9,
+	0x30, 0x97, 0x3d, 0xf6, 0x25, 0xc6, 0x12, 0xed, 0x20, 0x93, 0x79, 0x6a, 0x2f, 0xfb, 0x76, 0x00,
+	0x09, 0x60, 0x84, 0x56, 0x9a, 0x49, 0x25, 0xf9, 0x1d, 0x7d, 0x6d, 0xd1, 0xb5, 0xb5, 0xec, 0xb7,
+	0x3b, 0xc7, 0xbc, 0x5a, 0xa7, 0x50, 0xd0, 0x4f, 0x7f, 0xd7, 0x59, 0x6b, 0xa8, 0xfd, 0xae, 0x9a,
+	0x28, 0xe0, 0x1d, 0xd6, 0x24, 0xd0, 0x43, 0x58, 0x08, 0xa3, 0x6b, 0xf4, 0xea, 0xce, 0x05, 0x09,
+	0x2e, 0x2c, 0xf8, 0x80, 0x35, 0xe8, 0x1f, 0xc5, 0xad, 0xee, 0x59, 0xef, 0x72, 0xd0, 0xb6, 0x8e,
+	0xca, 0xac, 0xe1, 0xfe, 0xe7, 0x63, 0xf2, 0x4d, 0x3a, 0x05, 0xc9, 0xdf, 0xb1, 0x2b, 0x1d, 0x18,
+	0x43, 0x3c, 0x85, 0x0c, 0xc5, 0x19, 0x59, 0x1f, 0x9d, 0xb6, 0x7e, 0x22, 0xc8, 0x69, 0x05, 0x87,
+	0x03, 0xf2, 0x1e, 0xbb, 0xab, 0x23, 0x52, 0x39, 0x8f, 0xfc, 0x35, 0x8d, 0x56, 0xa7, 0xd1, 0xae,
+	0x49, 0x1f, 0x91, 0xbc, 0x1f, 0x70, 0xc8, 0xae, 0xff, 0x23, 0x23, 0x40, 0x71, 0x4e, 0x6d, 0xdd,
+	0xd3, 0x6d, 0xda, 0x48, 0xe3, 0x5e, 0x1d, 0x92, 0x22, 0x40, 0xfe, 0x84, 0xb5, 0xd2, 0x4c, 0xa6,
+	0x12, 0x27, 0x73, 0xaa, 0x6b, 0x50, 0xdd, 0x65, 0xa9, 0xed, 0xbb, 0xde, 0xb0, 0x66, 0x79, 0x44,
+	0x71, 0x9b, 0x6a, 0x1e, 0x56, 0x6a, 0x46, 0x05, 0xe1, 0x1c, 0x58, 0xfe, 0x92, 0x9d, 0x2f, 0xa5,
+	0x02, 0x14, 0x17, 0x64, 0xba, 0xa9, 0x98, 0xc6, 0x52, 0x81, 0xa3, 0x19, 0x3e, 0x66, 0xf7, 0xd1,
+	0x0f, 0x61, 0x96, 0xcf, 0x61, 0xe6, 0xc1, 0x0a, 0xfc, 0x5c, 0x45, 0x32, 0x41, 0xd1, 0x24, 0xef,
+	0xb3, 0x8a, 0xd7, 0x2d, 0xe1, 0x0f, 0x25, 0xeb, 0xdc, 0xc3, 0x8a, 0x86, 0xfc, 0x2b, 0x7b, 0xa0,
+	0x1f, 0xc4, 0xfb, 0x01, 0x51, 0x10, 0x2a, 0x0f, 0x93, 0x49, 0x8a, 0xa1, 0x54, 0x28, 0x18, 0x45,
+	0xbf, 0xa8, 0x44, 0xeb, 0xe7, 0xf8, 0x4c, 0xb8, 0x5b, 0xd0, 0xce, 0x4d, 0x7c, 0x42, 0xc5, 0xf7,
+	0x6f, 0x7f, 0x6d, 0x4d, 0x63, 0xb3, 0x35, 0x8d, 0xbf, 0x5b, 0xd3, 0xf8, 0xb9, 0x33, 0x6b, 0x9b,
+	0x9d, 0x59, 0xfb, 0xb3, 0x33, 0x6b, 0x5f, 0x9e, 0x07, 0x91, 0x0a, 0xf3, 0xa9, 0xe5, 0xcb, 0xd8,
+	0x2e, 0x36, 0x53, 0x7f, 0x5e, 0xe1, 0xec, 0xbb, 0xbd, 0xd2, 0x6b, 0x3a, 0x6d, 0xd0, 0x7a, 0xbe,
+	0xfe, 0x37, 0x00, 0x82, 0x08, 0xf2, 0x3b, 0xed, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +209,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MemberWeightSnapshots) > 0 {
+		for iNdEx := len(m.MemberWeightSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberWeightSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ScheduledExecutions) > 0 {
+		for iNdEx := len(m.ScheduledExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +381,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledExecutions) > 0 {
+		for _, e := range m.ScheduledExecutions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberWeightSnapshots) > 0 {
+		for _, e := range m.MemberWeightSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +658,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledExecutions = append(m.ScheduledExecutions, &ScheduledExecution{})
+			if err := m.ScheduledExecutions[len(m.ScheduledExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberWeightSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberWeightSnapshots = append(m.MemberWeightSnapshots, &MemberWeightSnapshot{})
+			if err := m.MemberWeightSnapshots[len(m.MemberWeightSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,14 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.scheduledExecutionTable.Import(ctx.KVStore(k.key), genesisState.ScheduledExecutions, 0); err != nil {
+		panic(errors.Wrap(err, "scheduled executions"))
+	}
+
+	if err := k.memberWeightSnapshotTable.Import(ctx.KVStore(k.key), genesisState.MemberWeightSnapshots, 0); err != nil {
+		panic(errors.Wrap(err, "member weight snapshots"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -86,5 +94,19 @@ func (k Keeper) ExportGenesis(ctx types.Context, _ codec.JSONCodec) *group.Genes
 	}
 	genesisState.Votes = votes
 
+	var scheduledExecutions []*group.ScheduledExecution
+	_, err = k.scheduledExecutionTable.Export(ctx.KVStore(k.key), &scheduledExecutions)
+	if err != nil {
+		panic(errors.Wrap(err, "scheduled executions"))
+	}
+	genesisState.ScheduledExecutions = scheduledExecutions
+
+	var memberWeightSnapshots []*group.MemberWeightSnapshot
+	_, err = k.memberWeightSnapshotTable.Export(ctx.KVStore(k.key), &memberWeightSnapshots)
+	if err != nil {
+		panic(errors.Wrap(err, "member weight snapshots"))
+	}
+	genesisState.MemberWeightSnapshots = memberWeightSnapshots
+
 	return genesisState
 }
@@ -68,7 +68,7 @@ func (s *GenesisTestSuite) SetupTest() {
 	s.cdc = codec.NewProtoCodec(encCfg.InterfaceRegistry)
 	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
 
-	s.keeper = keeper.NewKeeper(key, s.cdc, bApp.MsgServiceRouter(), accountKeeper, group.DefaultConfig())
+	s.keeper = keeper.NewKeeper(key, s.cdc, bApp.MsgServiceRouter(), accountKeeper, nil, nil, group.DefaultConfig())
 }
 
 func (s *GenesisTestSuite) TestInitExportGenesis() {
@@ -380,3 +380,38 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// ScheduledExecution queries the scheduled execution of a proposal.
+func (k Keeper) ScheduledExecution(goCtx context.Context, request *group.QueryScheduledExecutionRequest) (*group.QueryScheduledExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	scheduled, err := k.getScheduledExecution(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryScheduledExecutionResponse{ScheduledExecution: &scheduled}, nil
+}
+
+// ScheduledExecutionsByGroupPolicy queries all scheduled executions of a group policy.
+func (k Keeper) ScheduledExecutionsByGroupPolicy(goCtx context.Context, request *group.QueryScheduledExecutionsByGroupPolicyRequest) (*group.QueryScheduledExecutionsByGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	it, err := k.scheduledExecutionByGroupPolicyIndex.GetPaginated(ctx.KVStore(k.key), addr.Bytes(), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduled []*group.ScheduledExecution
+	pageRes, err := orm.Paginate(it, request.Pagination, &scheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryScheduledExecutionsByGroupPolicyResponse{
+		ScheduledExecutions: scheduled,
+		Pagination:          pageRes,
+	}, nil
+}
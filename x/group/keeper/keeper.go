@@ -43,6 +43,15 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Scheduled Execution Table
+	ScheduledExecutionTablePrefix                byte = 0x50
+	ScheduledExecutionByGroupPolicyIndexPrefix   byte = 0x51
+	ScheduledExecutionsByNextExecutionTimePrefix byte = 0x52
+
+	// Member Weight Snapshot Table
+	MemberWeightSnapshotTablePrefix           byte = 0x60
+	MemberWeightSnapshotByProposalIndexPrefix byte = 0x61
 )
 
 type Keeper struct {
@@ -50,6 +59,12 @@ type Keeper struct {
 
 	accKeeper group.AccountKeeper
 
+	// bankKeeper and stakingKeeper are used to compute the voting weights of
+	// members of groups with a dynamic weight source. They can be nil, in which
+	// case the corresponding weight sources are not supported.
+	bankKeeper    group.BankKeeper
+	stakingKeeper group.StakingKeeper
+
 	// Group Table
 	groupTable        orm.AutoUInt64Table
 	groupByAdminIndex orm.Index
@@ -75,17 +90,28 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Scheduled Execution Table
+	scheduledExecutionTable                orm.PrimaryKeyTable
+	scheduledExecutionByGroupPolicyIndex   orm.Index
+	scheduledExecutionsByNextExecutionTime orm.Index
+
+	// Member Weight Snapshot Table
+	memberWeightSnapshotTable           orm.PrimaryKeyTable
+	memberWeightSnapshotByProposalIndex orm.Index
+
 	router *baseapp.MsgServiceRouter
 
 	config group.Config
 }
 
 // NewKeeper creates a new group keeper.
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.MsgServiceRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.MsgServiceRouter, accKeeper group.AccountKeeper, bankKeeper group.BankKeeper, stakingKeeper group.StakingKeeper, config group.Config) Keeper {
 	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
+		key:           storeKey,
+		router:        router,
+		accKeeper:     accKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -202,12 +228,55 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	}
 	k.voteTable = *voteTable
 
+	// Scheduled Execution Table
+	scheduledExecutionTable, err := orm.NewPrimaryKeyTable([2]byte{ScheduledExecutionTablePrefix}, &group.ScheduledExecution{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.scheduledExecutionByGroupPolicyIndex, err = orm.NewIndex(scheduledExecutionTable, ScheduledExecutionByGroupPolicyIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		addr, err := sdk.AccAddressFromBech32(value.(*group.ScheduledExecution).GroupPolicyAddress)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{addr.Bytes()}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.scheduledExecutionsByNextExecutionTime, err = orm.NewIndex(scheduledExecutionTable, ScheduledExecutionsByNextExecutionTimePrefix, func(value interface{}) ([]interface{}, error) {
+		nextExecutionTime := value.(*group.ScheduledExecution).NextExecutionTime
+		return []interface{}{sdk.FormatTimeBytes(nextExecutionTime)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.scheduledExecutionTable = *scheduledExecutionTable
+
+	// Member Weight Snapshot Table
+	memberWeightSnapshotTable, err := orm.NewPrimaryKeyTable([2]byte{MemberWeightSnapshotTablePrefix}, &group.MemberWeightSnapshot{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.memberWeightSnapshotByProposalIndex, err = orm.NewIndex(memberWeightSnapshotTable, MemberWeightSnapshotByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.MemberWeightSnapshot).ProposalId}, nil
+	}, group.MemberWeightSnapshot{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.memberWeightSnapshotTable = *memberWeightSnapshotTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
 	if config.MaxExecutionPeriod == 0 {
 		config.MaxExecutionPeriod = group.DefaultConfig().MaxExecutionPeriod
 	}
+	if config.MaxScheduledExecutionGas == 0 {
+		config.MaxScheduledExecutionGas = group.DefaultConfig().MaxScheduledExecutionGas
+	}
+	if config.MaxScheduledExecutionsPerBlock == 0 {
+		config.MaxScheduledExecutionsPerBlock = group.DefaultConfig().MaxScheduledExecutionsPerBlock
+	}
 	k.config = config
 
 	return k
@@ -416,6 +485,9 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 			if err := k.pruneVotes(ctx, proposalID); err != nil {
 				return err
 			}
+			if err := k.pruneMemberWeightSnapshots(ctx, proposalID); err != nil {
+				return err
+			}
 			// Emit event for proposal finalized with its result
 			if err := ctx.EventManager().EmitTypedEvent(
 				&group.EventProposalPruned{
@@ -429,6 +501,15 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 				return sdkerrors.Wrap(err, "doTallyAndUpdate")
 			}
 
+			// Accepted proposals with an execution schedule are handed over to
+			// the scheduler.
+			if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutionSchedule != nil {
+				if err := k.scheduleProposal(ctx, proposal, policyInfo); err != nil {
+					return sdkerrors.Wrap(err, "schedule proposal")
+				}
+				continue
+			}
+
 			if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
 				return sdkerrors.Wrap(err, "proposal update")
 			}
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group/module"
 	grouptestutil "github.com/cosmos/cosmos-sdk/x/group/testutil"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var EventProposalPruned = "cosmos.group.v1.EventProposalPruned"
@@ -49,6 +50,7 @@ type TestSuite struct {
 	groupKeeper     keeper.Keeper
 	blockTime       time.Time
 	bankKeeper      *grouptestutil.MockBankKeeper
+	stakingKeeper   *grouptestutil.MockStakingKeeper
 	accountKeeper   *grouptestutil.MockAccountKeeper
 }
 
@@ -67,6 +69,7 @@ func (s *TestSuite) SetupTest() {
 		s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.addrs[i]).Return(authtypes.NewBaseAccountWithAddress(s.addrs[i])).AnyTimes()
 	}
 	s.bankKeeper = grouptestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = grouptestutil.NewMockStakingKeeper(ctrl)
 
 	bApp := baseapp.NewBaseApp(
 		"group",
@@ -78,7 +81,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(bApp.MsgServiceRouter(), s.bankKeeper)
 
 	config := group.DefaultConfig()
-	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, config)
+	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, s.bankKeeper, s.stakingKeeper, config)
 	s.ctx = testCtx.Ctx.WithBlockTime(s.blockTime)
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -3442,3 +3445,304 @@ func eventTypeFound(events []abci.Event, eventType string) bool {
 	}
 	return eventTypeFound
 }
+
+func (s *TestSuite) TestScheduledExecution() {
+	addrs := s.addrs
+	addr2 := addrs[1]
+	proposers := []string{addr2.String()}
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	submitScheduledProposal := func(ctx context.Context, schedule *group.ExecutionSchedule) (uint64, error) {
+		proposalReq := &group.MsgSubmitProposal{
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+			Proposers:          proposers,
+			ExecutionSchedule:  schedule,
+		}
+		s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{msgSend}))
+
+		res, err := s.groupKeeper.SubmitProposal(ctx, proposalReq)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{
+			ProposalId: res.ProposalId,
+			Voter:      addr2.String(),
+			Option:     group.VOTE_OPTION_YES,
+		})
+		s.Require().NoError(err)
+		return res.ProposalId, nil
+	}
+
+	s.Run("gas limit above config", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		_, err := submitScheduledProposal(sdkCtx, &group.ExecutionSchedule{
+			StartTime: s.blockTime,
+			Count:     1,
+			GasLimit:  group.DefaultConfig().MaxScheduledExecutionGas + 1,
+		})
+		s.Require().ErrorContains(err, "execution schedule gas limit")
+	})
+
+	s.Run("executed following the schedule", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID, err := submitScheduledProposal(sdkCtx, &group.ExecutionSchedule{
+			StartTime: s.blockTime,
+			Interval:  time.Hour,
+			Count:     2,
+		})
+		s.Require().NoError(err)
+
+		// MsgExec hands the accepted proposal over to the scheduler.
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+		res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{ProposalId: proposalID, Executor: addr2.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Result)
+
+		_, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().ErrorContains(err, "load proposal: not found")
+
+		scheduledRes, err := s.groupKeeper.ScheduledExecution(sdkCtx, &group.QueryScheduledExecutionRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		// The first execution can't happen before the min execution period.
+		s.Require().Equal(s.blockTime.Add(minExecutionPeriod), scheduledRes.ScheduledExecution.NextExecutionTime)
+
+		// First execution succeeds.
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+		s.Require().NoError(s.groupKeeper.ExecuteScheduledProposals(sdkCtx))
+
+		scheduledRes, err = s.groupKeeper.ScheduledExecution(sdkCtx, &group.QueryScheduledExecutionRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), scheduledRes.ScheduledExecution.Executions)
+		s.Require().Equal(uint64(0), scheduledRes.ScheduledExecution.Failures)
+		s.Require().Equal(s.blockTime.Add(minExecutionPeriod).Add(time.Hour), scheduledRes.ScheduledExecution.NextExecutionTime)
+
+		// Nothing is due until the next execution time.
+		s.Require().NoError(s.groupKeeper.ExecuteScheduledProposals(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour))))
+
+		// Second, and last, execution fails and is recorded.
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, fmt.Errorf("insufficient funds"))
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod).Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.groupKeeper.ExecuteScheduledProposals(sdkCtx))
+		s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), "cosmos.group.v1.EventScheduledExecutionEnded"))
+
+		_, err = s.groupKeeper.ScheduledExecution(sdkCtx, &group.QueryScheduledExecutionRequest{ProposalId: proposalID})
+		s.Require().ErrorContains(err, "not found")
+	})
+
+	s.Run("zero count", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		_, err := submitScheduledProposal(sdkCtx, &group.ExecutionSchedule{
+			StartTime: s.blockTime,
+			Interval:  time.Hour,
+		})
+		s.Require().ErrorContains(err, "execution schedule count")
+	})
+
+	s.Run("recurring until cancelled", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID, err := submitScheduledProposal(sdkCtx, &group.ExecutionSchedule{
+			StartTime: s.blockTime.Add(time.Minute),
+			Interval:  time.Hour,
+			Count:     12,
+		})
+		s.Require().NoError(err)
+
+		// Accepted proposals are scheduled at voting period end too.
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(time.Minute))
+		s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx))
+
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil).Times(3)
+		for i := 0; i < 3; i++ {
+			s.Require().NoError(s.groupKeeper.ExecuteScheduledProposals(sdkCtx.WithBlockTime(s.blockTime.Add(time.Minute + time.Duration(i)*time.Hour))))
+		}
+
+		scheduledRes, err := s.groupKeeper.ScheduledExecutionsByGroupPolicy(sdkCtx, &group.QueryScheduledExecutionsByGroupPolicyRequest{Address: s.groupPolicyAddr.String()})
+		s.Require().NoError(err)
+		s.Require().Len(scheduledRes.ScheduledExecutions, 1)
+		s.Require().Equal(uint64(3), scheduledRes.ScheduledExecutions[0].Executions)
+
+		_, err = s.groupKeeper.CancelScheduledExecution(sdkCtx, &group.MsgCancelScheduledExecution{
+			GroupPolicyAddress: addr2.String(),
+			ProposalId:         proposalID,
+		})
+		s.Require().ErrorContains(err, "doesn't belong to group policy")
+
+		_, err = s.groupKeeper.CancelScheduledExecution(sdkCtx, &group.MsgCancelScheduledExecution{
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+			ProposalId:         proposalID,
+		})
+		s.Require().NoError(err)
+
+		_, err = s.groupKeeper.ScheduledExecution(sdkCtx, &group.QueryScheduledExecutionRequest{ProposalId: proposalID})
+		s.Require().ErrorContains(err, "not found")
+	})
+
+	for name, update := range map[string]func(ctx context.Context) error{
+		"cancelled once the group is modified": func(ctx context.Context) error {
+			_, err := s.groupKeeper.UpdateGroupMetadata(ctx, &group.MsgUpdateGroupMetadata{
+				Admin:    addrs[0].String(),
+				GroupId:  s.groupID,
+				Metadata: "updated",
+			})
+			return err
+		},
+		"cancelled once the group policy is modified": func(ctx context.Context) error {
+			_, err := s.groupKeeper.UpdateGroupPolicyMetadata(ctx, &group.MsgUpdateGroupPolicyMetadata{
+				Admin:              addrs[0].String(),
+				GroupPolicyAddress: s.groupPolicyAddr.String(),
+				Metadata:           "updated",
+			})
+			return err
+		},
+	} {
+		update := update
+		s.Run(name, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			proposalID, err := submitScheduledProposal(sdkCtx, &group.ExecutionSchedule{
+				StartTime: s.blockTime,
+				Interval:  time.Hour,
+				Count:     2,
+			})
+			s.Require().NoError(err)
+
+			sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+			_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{ProposalId: proposalID, Executor: addr2.String()})
+			s.Require().NoError(err)
+
+			s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+			s.Require().NoError(s.groupKeeper.ExecuteScheduledProposals(sdkCtx))
+
+			s.Require().NoError(update(sdkCtx))
+
+			// The due execution is not run, the schedule is cancelled instead.
+			sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod).Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.groupKeeper.ExecuteScheduledProposals(sdkCtx))
+			s.Require().False(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), "cosmos.group.v1.EventScheduledExec"))
+			s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), "cosmos.group.v1.EventScheduledExecutionEnded"))
+
+			_, err = s.groupKeeper.ScheduledExecution(sdkCtx, &group.QueryScheduledExecutionRequest{ProposalId: proposalID})
+			s.Require().ErrorContains(err, "not found")
+		})
+	}
+}
+
+func (s *TestSuite) TestDynamicWeightGroup() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}
+
+	createDynamicGroup := func(ctx context.Context, weightSource group.WeightSource, weightDenom string) string {
+		req := &group.MsgCreateGroupWithPolicy{
+			Admin:        addrs[0].String(),
+			Members:      members,
+			WeightSource: weightSource,
+			WeightDenom:  weightDenom,
+		}
+		s.Require().NoError(req.SetDecisionPolicy(group.NewThresholdDecisionPolicy("60", time.Hour, 0)))
+		s.setNextAccount()
+		res, err := s.groupKeeper.CreateGroupWithPolicy(ctx, req)
+		s.Require().NoError(err)
+		return res.GroupPolicyAddress
+	}
+
+	submitProposal := func(ctx context.Context, policyAddr string) (uint64, error) {
+		req := &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addrs[1].String()},
+		}
+		res, err := s.groupKeeper.SubmitProposal(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+
+	s.Run("balance weights are snapshotted at submission", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		policyAddr := createDynamicGroup(sdkCtx, group.WEIGHT_SOURCE_BALANCE, "gov")
+
+		// Balances are only read once, at proposal submission.
+		s.bankKeeper.EXPECT().GetBalance(gomock.Any(), addrs[1], "gov").Return(sdk.NewInt64Coin("gov", 50)).Times(1)
+		s.bankKeeper.EXPECT().GetBalance(gomock.Any(), addrs[2], "gov").Return(sdk.NewInt64Coin("gov", 30)).Times(1)
+		s.bankKeeper.EXPECT().GetBalance(gomock.Any(), addrs[3], "gov").Return(sdk.NewInt64Coin("gov", 0)).Times(1)
+		proposalID, err := submitProposal(sdkCtx, policyAddr)
+		s.Require().NoError(err)
+
+		proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal("80", proposalRes.Proposal.SnapshotTotalWeight)
+
+		for _, voter := range []sdk.AccAddress{addrs[1], addrs[3]} {
+			_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: group.VOTE_OPTION_YES})
+			s.Require().NoError(err)
+		}
+
+		// addrs[3] had no balance at submission, so its vote doesn't count.
+		tallyRes, err := s.groupKeeper.TallyResult(sdkCtx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal("50", tallyRes.Tally.YesCount)
+
+		_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Option: group.VOTE_OPTION_YES})
+		s.Require().NoError(err)
+
+		// 80 yes weight reaches the 60 threshold: the proposal is accepted, and
+		// the snapshot is pruned along with the votes.
+		s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour + time.Second))))
+		proposalRes, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+		s.Require().Equal("80", proposalRes.Proposal.FinalTallyResult.YesCount)
+
+		genesis := s.groupKeeper.ExportGenesis(sdkCtx, nil)
+		s.Require().Empty(genesis.MemberWeightSnapshots)
+	})
+
+	s.Run("staking weights from bonded validators", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		policyAddr := createDynamicGroup(sdkCtx, group.WEIGHT_SOURCE_STAKING, "")
+
+		valAddr := sdk.ValAddress(addrs[5])
+		validator := stakingtypes.Validator{
+			OperatorAddress: valAddr.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          sdk.NewInt(200),
+			DelegatorShares: sdk.NewDec(100),
+		}
+		s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(validator).AnyTimes()
+		s.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, delegator sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+				if delegator.Equals(addrs[1]) {
+					fn(0, stakingtypes.NewDelegation(delegator, valAddr, sdk.NewDec(40)))
+				}
+			}).Times(len(members))
+
+		proposalID, err := submitProposal(sdkCtx, policyAddr)
+		s.Require().NoError(err)
+
+		proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal("80", proposalRes.Proposal.SnapshotTotalWeight)
+
+		genesis := s.groupKeeper.ExportGenesis(sdkCtx, nil)
+		s.Require().Len(genesis.MemberWeightSnapshots, 1)
+		s.Require().Equal(addrs[1].String(), genesis.MemberWeightSnapshots[0].Address)
+	})
+
+	s.Run("no voting weight", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		policyAddr := createDynamicGroup(sdkCtx, group.WEIGHT_SOURCE_BALANCE, "gov")
+
+		s.bankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), "gov").Return(sdk.NewInt64Coin("gov", 0)).Times(len(members))
+		_, err := submitProposal(sdkCtx, policyAddr)
+		s.Require().ErrorContains(err, "group members have no voting weight")
+	})
+}
@@ -34,6 +34,10 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 		return nil, err
 	}
 
+	if err := k.assertWeightSourceSupported(req.WeightSource); err != nil {
+		return nil, err
+	}
+
 	totalWeight := math.NewDecFromInt64(0)
 	for i := range members.Members {
 		m := members.Members[i]
@@ -56,12 +60,14 @@ func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*
 
 	// Create a new group in the groupTable.
 	groupInfo := &group.GroupInfo{
-		Id:          k.groupTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
-		Admin:       admin,
-		Metadata:    metadata,
-		Version:     1,
-		TotalWeight: totalWeight.String(),
-		CreatedAt:   ctx.BlockTime(),
+		Id:           k.groupTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
+		Admin:        admin,
+		Metadata:     metadata,
+		Version:      1,
+		TotalWeight:  totalWeight.String(),
+		CreatedAt:    ctx.BlockTime(),
+		WeightSource: req.WeightSource,
+		WeightDenom:  req.WeightDenom,
 	}
 	groupID, err := k.groupTable.Create(ctx.KVStore(k.key), groupInfo)
 	if err != nil {
@@ -240,9 +246,11 @@ func (k Keeper) UpdateGroupMetadata(goCtx context.Context, req *group.MsgUpdateG
 
 func (k Keeper) CreateGroupWithPolicy(goCtx context.Context, req *group.MsgCreateGroupWithPolicy) (*group.MsgCreateGroupWithPolicyResponse, error) {
 	groupRes, err := k.CreateGroup(goCtx, &group.MsgCreateGroup{
-		Admin:    req.Admin,
-		Members:  req.Members,
-		Metadata: req.GroupMetadata,
+		Admin:        req.Admin,
+		Members:      req.Members,
+		Metadata:     req.GroupMetadata,
+		WeightSource: req.WeightSource,
+		WeightDenom:  req.WeightDenom,
 	})
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "group response")
@@ -515,6 +523,10 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 		return nil, err
 	}
 
+	if req.ExecutionSchedule != nil && req.ExecutionSchedule.GasLimit > k.config.MaxScheduledExecutionGas {
+		return nil, sdkerrors.Wrapf(errors.ErrMaxLimit, "execution schedule gas limit: %d > %d", req.ExecutionSchedule.GasLimit, k.config.MaxScheduledExecutionGas)
+	}
+
 	m := &group.Proposal{
 		Id:                 k.proposalTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
 		GroupPolicyAddress: req.GroupPolicyAddress,
@@ -529,12 +541,26 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 		FinalTallyResult:   group.DefaultTallyResult(),
 		Title:              req.Title,
 		Summary:            req.Summary,
+		ExecutionSchedule:  req.ExecutionSchedule,
 	}
 
 	if err := m.SetMsgs(msgs); err != nil {
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
 
+	// Snapshot the voting weights of the members of dynamic groups, so that
+	// the proposal is tallied against the weights at submission.
+	if g.WeightSource.IsDynamic() {
+		snapshotTotalWeight, err := k.snapshotMemberWeights(ctx, m.Id, g)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "snapshot member weights")
+		}
+		if snapshotTotalWeight.IsZero() {
+			return nil, sdkerrors.Wrap(errors.ErrInvalid, "group members have no voting weight")
+		}
+		m.SnapshotTotalWeight = snapshotTotalWeight.String()
+	}
+
 	id, err := k.proposalTable.Create(ctx.KVStore(k.key), m)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "create proposal")
@@ -698,7 +724,7 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, electorate.TotalWeight)
+	result, err := policy.Allow(tallyResult, proposalTotalWeight(*p, electorate))
 	if err != nil {
 		return sdkerrors.Wrap(err, "policy allow")
 	}
@@ -709,6 +735,9 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
+		if err := k.pruneMemberWeightSnapshots(ctx, p.Id); err != nil {
+			return err
+		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
@@ -754,6 +783,22 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
+	// Proposals with an execution schedule are not executed here, their
+	// messages are handed over to the scheduler once accepted.
+	if proposal.ExecutionSchedule != nil {
+		if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED {
+			if err := k.scheduleProposal(ctx, proposal, policyInfo); err != nil {
+				return nil, err
+			}
+		} else if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+			return nil, err
+		}
+
+		return &group.MsgExecResponse{
+			Result: proposal.ExecutorResult,
+		}, nil
+	}
+
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
@@ -1029,3 +1074,23 @@ func isProposer(proposal group.Proposal, address string) bool {
 
 	return false
 }
+
+// CancelScheduledExecution implements the MsgServer/CancelScheduledExecution method.
+func (k Keeper) CancelScheduledExecution(goCtx context.Context, req *group.MsgCancelScheduledExecution) (*group.MsgCancelScheduledExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduled, err := k.getScheduledExecution(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled.GroupPolicyAddress != req.GroupPolicyAddress {
+		return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "scheduled execution of proposal %d doesn't belong to group policy %s", req.ProposalId, req.GroupPolicyAddress)
+	}
+
+	if err := k.removeScheduledExecution(ctx, scheduled, true); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCancelScheduledExecutionResponse{}, nil
+}
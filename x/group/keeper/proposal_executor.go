@@ -35,12 +35,18 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router *baseapp.MsgServiceRouter,
 		return nil, err
 	}
 
+	return s.runMsgs(ctx, router, msgs, groupPolicyAcc)
+}
+
+// runMsgs routes the given messages, which must only require the group policy
+// account as signer, to their registered handlers.
+func (s Keeper) runMsgs(ctx sdk.Context, router *baseapp.MsgServiceRouter, msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress) ([]sdk.Result, error) {
 	results := make([]sdk.Result, len(msgs))
 	if err := ensureMsgAuthZ(msgs, groupPolicyAcc); err != nil {
 		return nil, err
 	}
 	for i, msg := range msgs {
-		handler := s.router.Handler(msg)
+		handler := router.Handler(msg)
 		if handler == nil {
 			return nil, errorsmod.Wrapf(errors.ErrInvalid, "no message handler found for %q", sdk.MsgTypeURL(msg))
 		}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+// scheduleProposal hands over the messages of an accepted proposal with an
+// execution schedule to the scheduler, and prunes the proposal. From then on,
+// the messages are executed by the EndBlocker following the schedule.
+func (k Keeper) scheduleProposal(ctx sdk.Context, proposal group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	schedule := *proposal.ExecutionSchedule
+	scheduled := group.ScheduledExecution{
+		ProposalId:         proposal.Id,
+		GroupPolicyAddress: proposal.GroupPolicyAddress,
+		Schedule:           schedule,
+		Messages:           proposal.Messages,
+		NextExecutionTime:  schedule.FirstExecutionTime(proposal.SubmitTime, policy.GetMinExecutionPeriod()),
+		GroupVersion:       proposal.GroupVersion,
+		GroupPolicyVersion: proposal.GroupPolicyVersion,
+	}
+	if err := k.scheduledExecutionTable.Create(ctx.KVStore(k.key), &scheduled); err != nil {
+		return err
+	}
+
+	if err := k.pruneProposal(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(
+		&group.EventProposalPruned{
+			ProposalId:  proposal.Id,
+			Status:      proposal.Status,
+			TallyResult: &proposal.FinalTallyResult,
+		})
+}
+
+// scheduledExecutionsDue returns at most `limit` scheduled executions whose
+// next execution time is before or equal to the current block time, ordered
+// by next execution time.
+func (k Keeper) scheduledExecutionsDue(ctx sdk.Context, limit uint64) (scheduled []group.ScheduledExecution, err error) {
+	it, err := k.scheduledExecutionsByNextExecutionTime.PrefixScan(ctx.KVStore(k.key), nil, nil)
+	if err != nil {
+		return scheduled, err
+	}
+	defer it.Close()
+
+	for uint64(len(scheduled)) < limit {
+		var s group.ScheduledExecution
+		_, err := it.LoadNext(&s)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return scheduled, err
+		}
+		if s.NextExecutionTime.After(ctx.BlockTime()) {
+			break
+		}
+		scheduled = append(scheduled, s)
+	}
+
+	return scheduled, nil
+}
+
+// ExecuteScheduledProposals runs the scheduled executions that are due, up to
+// the MaxScheduledExecutionsPerBlock config. Each execution runs in its own
+// cached context with a gas limit, so that a failing execution doesn't update
+// the store; failures are recorded in the scheduled execution state.
+func (k Keeper) ExecuteScheduledProposals(ctx sdk.Context) error {
+	scheduled, err := k.scheduledExecutionsDue(ctx, k.config.MaxScheduledExecutionsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &s)"
+	for _, s := range scheduled {
+		if err := k.executeScheduled(ctx, &s); err != nil {
+			return sdkerrors.Wrapf(err, "scheduled execution of proposal %d", s.ProposalId)
+		}
+	}
+
+	return nil
+}
+
+// executeScheduled runs one execution of a scheduled proposal, and either
+// moves its next execution time forward or removes it from state if the
+// schedule is completed. The schedule is cancelled without being executed if
+// its group or group policy was modified since the proposal submission.
+func (k Keeper) executeScheduled(ctx sdk.Context, s *group.ScheduledExecution) error {
+	outdated, err := k.isScheduledExecutionOutdated(ctx, *s)
+	if err != nil {
+		return err
+	}
+	if outdated {
+		k.Logger(ctx).Info("scheduled execution cancelled, group or group policy modified", "proposalID", s.ProposalId)
+		return k.removeScheduledExecution(ctx, *s, true)
+	}
+
+	s.Executions++
+
+	result := group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+	var logs string
+	if err := k.doExecuteScheduledMsgs(ctx, *s); err != nil {
+		result = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		logs = fmt.Sprintf("scheduled execution failed on proposal %d, because of error %s", s.ProposalId, err.Error())
+		k.Logger(ctx).Info("scheduled execution failed", "cause", err, "proposalID", s.ProposalId)
+
+		s.Failures++
+		blockTime := ctx.BlockTime()
+		s.LastFailureTime = &blockTime
+		s.LastFailureLogs = logs
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventScheduledExec{
+		ProposalId: s.ProposalId,
+		Execution:  s.Executions,
+		Result:     result,
+		Logs:       logs,
+	}); err != nil {
+		return err
+	}
+
+	// The executed messages may have cancelled the schedule itself.
+	store := ctx.KVStore(k.key)
+	if !k.scheduledExecutionTable.Contains(store, s) {
+		return nil
+	}
+
+	if s.Schedule.IsLastExecution(s.Executions) {
+		return k.removeScheduledExecution(ctx, *s, false)
+	}
+
+	s.NextExecutionTime = s.NextExecutionTime.Add(s.Schedule.Interval)
+	return k.scheduledExecutionTable.Update(store, s)
+}
+
+// isScheduledExecutionOutdated returns true if the group policy of a scheduled
+// execution, or its group, has a different version than the one the proposal
+// was submitted to.
+func (k Keeper) isScheduledExecutionOutdated(ctx sdk.Context, s group.ScheduledExecution) (bool, error) {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, s.GroupPolicyAddress)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "load group policy")
+	}
+	if policyInfo.Version != s.GroupPolicyVersion {
+		return true, nil
+	}
+
+	groupInfo, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "load group")
+	}
+	return groupInfo.Version != s.GroupVersion, nil
+}
+
+// doExecuteScheduledMsgs executes the messages of a scheduled execution in a
+// cached context whose gas meter is limited by the schedule's gas limit. State
+// changes are only written if all messages succeed.
+func (k Keeper) doExecuteScheduledMsgs(ctx sdk.Context, s group.ScheduledExecution) (err error) {
+	groupPolicyAcc, err := sdk.AccAddressFromBech32(s.GroupPolicyAddress)
+	if err != nil {
+		return err
+	}
+
+	msgs, err := s.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	gasLimit := s.Schedule.GasLimit
+	if gasLimit == 0 || gasLimit > k.config.MaxScheduledExecutionGas {
+		gasLimit = k.config.MaxScheduledExecutionGas
+	}
+
+	cacheCtx, flush := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	// Messages are executed in the EndBlocker, so any panic, including out of
+	// gas ones, is recorded as a failed execution instead of halting the chain.
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gas limit: %d", oog.Descriptor, gasLimit)
+				return
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	results, err := k.runMsgs(cacheCtx, k.router, msgs, groupPolicyAcc)
+	if err != nil {
+		return err
+	}
+
+	flush()
+	for _, res := range results {
+		// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
+
+// removeScheduledExecution deletes a scheduled execution from state.
+func (k Keeper) removeScheduledExecution(ctx sdk.Context, s group.ScheduledExecution, cancelled bool) error {
+	if err := k.scheduledExecutionTable.Delete(ctx.KVStore(k.key), &s); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventScheduledExecutionEnded{
+		ProposalId: s.ProposalId,
+		Executions: s.Executions,
+		Failures:   s.Failures,
+		Cancelled:  cancelled,
+	})
+}
+
+// getScheduledExecution gets the scheduled execution of the given proposal id.
+func (k Keeper) getScheduledExecution(ctx sdk.Context, proposalID uint64) (group.ScheduledExecution, error) {
+	var s group.ScheduledExecution
+	if err := k.scheduledExecutionTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.ScheduledExecution{ProposalId: proposalID}), &s); err != nil {
+		return group.ScheduledExecution{}, sdkerrors.Wrap(err, "load scheduled execution")
+	}
+	return s, nil
+}
@@ -54,7 +54,23 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		weight := member.Member.Weight
+
+		// For proposals of groups with a dynamic weight source, the voting
+		// weight is the one snapshotted at proposal submission.
+		if p.SnapshotTotalWeight != "" {
+			snapshot, err := k.getMemberWeightSnapshot(ctx, p.Id, vote.Voter)
+			switch {
+			case sdkerrors.ErrNotFound.Is(err):
+				// The member had no voting weight at proposal submission.
+				continue
+			case err != nil:
+				return group.TallyResult{}, err
+			}
+			weight = snapshot.Weight
+		}
+
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
 	}
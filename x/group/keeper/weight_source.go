@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// assertWeightSourceSupported returns an error if the keeper of the given
+// weight source is not set.
+func (k Keeper) assertWeightSourceSupported(source group.WeightSource) error {
+	switch {
+	case source == group.WEIGHT_SOURCE_STAKING && k.stakingKeeper == nil:
+		return sdkerrors.Wrap(errors.ErrInvalid, "staking weight source is not supported")
+	case source == group.WEIGHT_SOURCE_BALANCE && k.bankKeeper == nil:
+		return sdkerrors.Wrap(errors.ErrInvalid, "balance weight source is not supported")
+	}
+	return nil
+}
+
+// dynamicMemberWeight computes the current voting weight of a member of a
+// group with a dynamic weight source.
+func (k Keeper) dynamicMemberWeight(ctx sdk.Context, g group.GroupInfo, member sdk.AccAddress) (sdkmath.Int, error) {
+	if err := k.assertWeightSourceSupported(g.WeightSource); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	switch g.WeightSource {
+	case group.WEIGHT_SOURCE_STAKING:
+		// Only delegations to bonded validators are taken into account, in the
+		// same way as for the voting power in x/gov.
+		weight := sdkmath.ZeroInt()
+		k.stakingKeeper.IterateDelegations(ctx, member, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+			validator := k.stakingKeeper.Validator(ctx, delegation.GetValidatorAddr())
+			if !validator.IsBonded() {
+				return false
+			}
+			weight = weight.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+			return false
+		})
+		return weight, nil
+	case group.WEIGHT_SOURCE_BALANCE:
+		return k.bankKeeper.GetBalance(ctx, member, g.WeightDenom).Amount, nil
+	default:
+		return sdkmath.Int{}, sdkerrors.Wrapf(errors.ErrInvalid, "weight source %s is not dynamic", g.WeightSource)
+	}
+}
+
+// snapshotMemberWeights stores the current voting weights of the members of
+// a group with a dynamic weight source for the given proposal, and returns
+// their sum. Members with no voting weight are not stored.
+func (k Keeper) snapshotMemberWeights(ctx sdk.Context, proposalID uint64, g group.GroupInfo) (math.Dec, error) {
+	store := ctx.KVStore(k.key)
+	it, err := k.groupMemberByGroupIndex.Get(store, g.Id)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	defer it.Close()
+
+	totalWeight := math.NewDecFromInt64(0)
+	for {
+		var member group.GroupMember
+		_, err := it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return math.Dec{}, err
+		}
+
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot member weight")
+
+		addr, err := sdk.AccAddressFromBech32(member.Member.Address)
+		if err != nil {
+			return math.Dec{}, err
+		}
+		amount, err := k.dynamicMemberWeight(ctx, g, addr)
+		if err != nil {
+			return math.Dec{}, err
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+
+		weight, err := math.NewPositiveDecFromString(amount.String())
+		if err != nil {
+			return math.Dec{}, err
+		}
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return math.Dec{}, err
+		}
+
+		if err := k.memberWeightSnapshotTable.Create(store, &group.MemberWeightSnapshot{
+			ProposalId: proposalID,
+			Address:    member.Member.Address,
+			Weight:     weight.String(),
+		}); err != nil {
+			return math.Dec{}, sdkerrors.Wrap(err, "create member weight snapshot")
+		}
+	}
+
+	return totalWeight, nil
+}
+
+// pruneMemberWeightSnapshots prunes all member weight snapshots of a proposal
+// from state.
+func (k Keeper) pruneMemberWeightSnapshots(ctx sdk.Context, proposalID uint64) error {
+	store := ctx.KVStore(k.key)
+	it, err := k.memberWeightSnapshotByProposalIndex.Get(store, proposalID)
+	if err != nil {
+		return err
+	}
+
+	var snapshots []group.MemberWeightSnapshot
+	for {
+		var snapshot group.MemberWeightSnapshot
+		_, err := it.LoadNext(&snapshot)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			it.Close()
+			return err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	it.Close()
+
+	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &s)"
+	for _, s := range snapshots {
+		if err := k.memberWeightSnapshotTable.Delete(store, &s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getMemberWeightSnapshot gets the voting weight of a member snapshotted at
+// the submission of the given proposal.
+func (k Keeper) getMemberWeightSnapshot(ctx sdk.Context, proposalID uint64, address string) (group.MemberWeightSnapshot, error) {
+	var snapshot group.MemberWeightSnapshot
+	err := k.memberWeightSnapshotTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.MemberWeightSnapshot{
+		ProposalId: proposalID,
+		Address:    address,
+	}), &snapshot)
+	return snapshot, err
+}
+
+// proposalTotalWeight returns the total voting weight used by the decision
+// policy of a proposal: the snapshotted total weight for proposals of groups
+// with a dynamic weight source, and the group total weight otherwise.
+func proposalTotalWeight(p group.Proposal, electorate group.GroupInfo) string {
+	if p.SnapshotTotalWeight != "" {
+		return p.SnapshotTotalWeight
+	}
+	return electorate.TotalWeight
+}
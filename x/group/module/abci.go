@@ -5,8 +5,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, updates proposal's `FinalTallyResult`,
+// prunes expired proposals and runs the scheduled proposal executions.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
 		panic(err)
@@ -15,4 +15,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err := k.PruneProposals(ctx); err != nil {
 		panic(err)
 	}
+
+	if err := k.ExecuteScheduledProposals(ctx); err != nil {
+		panic(err)
+	}
 }
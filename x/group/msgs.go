@@ -39,6 +39,10 @@ func (m MsgCreateGroup) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "admin")
 	}
 
+	if err := ValidateWeightSource(m.WeightSource, m.WeightDenom); err != nil {
+		return err
+	}
+
 	return strictValidateMembers(m.Members)
 }
 
@@ -278,6 +282,9 @@ func (m MsgCreateGroupWithPolicy) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "decision policy")
 	}
+	if err := ValidateWeightSource(m.WeightSource, m.WeightDenom); err != nil {
+		return err
+	}
 
 	return strictValidateMembers(m.Members)
 }
@@ -641,6 +648,19 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "msg %d", i)
 		}
 	}
+
+	if m.ExecutionSchedule != nil {
+		if m.Exec == Exec_EXEC_TRY {
+			return sdkerrors.Wrap(errors.ErrInvalid, "cannot try to execute a proposal with an execution schedule")
+		}
+		if len(msgs) == 0 {
+			return sdkerrors.Wrap(errors.ErrEmpty, "messages of a proposal with an execution schedule")
+		}
+		if err := m.ExecutionSchedule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -821,6 +841,41 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgCancelScheduledExecution{}
+
+// Route Implements Msg
+func (m MsgCancelScheduledExecution) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgCancelScheduledExecution) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgCancelScheduledExecution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCancelScheduledExecution
+func (m MsgCancelScheduledExecution) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.GroupPolicyAddress)
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgCancelScheduledExecution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "proposal id")
+	}
+	return nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
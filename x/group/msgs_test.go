@@ -143,6 +143,50 @@ func TestMsgCreateGroup(t *testing.T) {
 			false,
 			"",
 		},
+		{
+			"invalid weight source",
+			&group.MsgCreateGroup{
+				Admin:        admin.String(),
+				WeightSource: 3,
+			},
+			true,
+			"weight source",
+		},
+		{
+			"weight denom without balance weight source",
+			&group.MsgCreateGroup{
+				Admin:        admin.String(),
+				WeightSource: group.WEIGHT_SOURCE_STAKING,
+				WeightDenom:  "stake",
+			},
+			true,
+			"weight denom can only be set with a balance weight source",
+		},
+		{
+			"balance weight source without weight denom",
+			&group.MsgCreateGroup{
+				Admin:        admin.String(),
+				WeightSource: group.WEIGHT_SOURCE_BALANCE,
+			},
+			true,
+			"weight denom",
+		},
+		{
+			"valid balance weight source",
+			&group.MsgCreateGroup{
+				Admin: admin.String(),
+				Members: []group.MemberRequest{
+					{
+						Address: member1.String(),
+						Weight:  "1",
+					},
+				},
+				WeightSource: group.WEIGHT_SOURCE_BALANCE,
+				WeightDenom:  "stake",
+			},
+			false,
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -973,6 +1017,31 @@ func TestMsgSubmitProposal(t *testing.T) {
 			true,
 			"summary: value is empty",
 		},
+		{
+			"execution schedule without messages",
+			&group.MsgSubmitProposal{
+				GroupPolicyAddress: admin.String(),
+				Proposers:          []string{member1.String(), member2.String()},
+				Title:              "Title",
+				Summary:            "Summary",
+				ExecutionSchedule:  &group.ExecutionSchedule{StartTime: time.Unix(1, 0), Count: 1},
+			},
+			true,
+			"messages of a proposal with an execution schedule: value is empty",
+		},
+		{
+			"execution schedule with exec try",
+			&group.MsgSubmitProposal{
+				GroupPolicyAddress: admin.String(),
+				Proposers:          []string{member1.String(), member2.String()},
+				Title:              "Title",
+				Summary:            "Summary",
+				Exec:               group.Exec_EXEC_TRY,
+				ExecutionSchedule:  &group.ExecutionSchedule{StartTime: time.Unix(1, 0), Count: 1},
+			},
+			true,
+			"cannot try to execute a proposal with an execution schedule",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1486,6 +1486,216 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryScheduledExecutionRequest is the Query/ScheduledExecution request type.
+type QueryScheduledExecutionRequest struct {
+	// proposal_id is the unique ID of the scheduled proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryScheduledExecutionRequest) Reset()         { *m = QueryScheduledExecutionRequest{} }
+func (m *QueryScheduledExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecutionRequest) ProtoMessage()    {}
+func (*QueryScheduledExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryScheduledExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecutionRequest.Merge(m, src)
+}
+func (m *QueryScheduledExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecutionRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledExecutionRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryScheduledExecutionResponse is the Query/ScheduledExecution response type.
+type QueryScheduledExecutionResponse struct {
+	// scheduled_execution is the scheduled execution state.
+	ScheduledExecution *ScheduledExecution `protobuf:"bytes,1,opt,name=scheduled_execution,json=scheduledExecution,proto3" json:"scheduled_execution,omitempty"`
+}
+
+func (m *QueryScheduledExecutionResponse) Reset()         { *m = QueryScheduledExecutionResponse{} }
+func (m *QueryScheduledExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledExecutionResponse) ProtoMessage()    {}
+func (*QueryScheduledExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryScheduledExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecutionResponse.Merge(m, src)
+}
+func (m *QueryScheduledExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledExecutionResponse) GetScheduledExecution() *ScheduledExecution {
+	if m != nil {
+		return m.ScheduledExecution
+	}
+	return nil
+}
+
+// QueryScheduledExecutionsByGroupPolicyRequest is the Query/ScheduledExecutionsByGroupPolicy request type.
+type QueryScheduledExecutionsByGroupPolicyRequest struct {
+	// address is the account address of the group policy.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) Reset() {
+	*m = QueryScheduledExecutionsByGroupPolicyRequest{}
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryScheduledExecutionsByGroupPolicyRequest) ProtoMessage() {}
+func (*QueryScheduledExecutionsByGroupPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyRequest.Merge(m, src)
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryScheduledExecutionsByGroupPolicyResponse is the Query/ScheduledExecutionsByGroupPolicy response type.
+type QueryScheduledExecutionsByGroupPolicyResponse struct {
+	// scheduled_executions are the scheduled executions of the group policy.
+	ScheduledExecutions []*ScheduledExecution `protobuf:"bytes,1,rep,name=scheduled_executions,json=scheduledExecutions,proto3" json:"scheduled_executions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) Reset() {
+	*m = QueryScheduledExecutionsByGroupPolicyResponse{}
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryScheduledExecutionsByGroupPolicyResponse) ProtoMessage() {}
+func (*QueryScheduledExecutionsByGroupPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyResponse.Merge(m, src)
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledExecutionsByGroupPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) GetScheduledExecutions() []*ScheduledExecution {
+	if m != nil {
+		return m.ScheduledExecutions
+	}
+	return nil
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1725,107 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryScheduledExecutionRequest)(nil), "cosmos.group.v1.QueryScheduledExecutionRequest")
+	proto.RegisterType((*QueryScheduledExecutionResponse)(nil), "cosmos.group.v1.QueryScheduledExecutionResponse")
+	proto.RegisterType((*QueryScheduledExecutionsByGroupPolicyRequest)(nil), "cosmos.group.v1.QueryScheduledExecutionsByGroupPolicyRequest")
+	proto.RegisterType((*QueryScheduledExecutionsByGroupPolicyResponse)(nil), "cosmos.group.v1.QueryScheduledExecutionsByGroupPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0xa6, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x2d,
-	0xf9, 0x9d, 0xdd, 0xd8, 0x49, 0x53, 0x04, 0x14, 0x54, 0x4b, 0x10, 0x72, 0x28, 0x4a, 0x4d, 0x85,
-	0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5c, 0xef, 0x26, 0xc2, 0x8a, 0x7c, 0x41,
-	0x82, 0x03, 0xe2, 0x00, 0x2d, 0x42, 0x25, 0xe2, 0xd0, 0x03, 0x12, 0x3d, 0x72, 0x00, 0x21, 0x71,
-	0xeb, 0xad, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x23, 0xb8, 0xa0, 0x9d, 0x79, 0x6b,
-	0xef, 0x6f, 0x6f, 0x84, 0x45, 0x73, 0x69, 0xeb, 0x9d, 0x37, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f, 0x67,
-	0xbf, 0x53, 0xb8, 0xbc, 0xcd, 0xac, 0x2a, 0xb3, 0xd4, 0x72, 0x9d, 0xed, 0xd6, 0xd4, 0xbd, 0xac,
-	0x7a, 0x77, 0x57, 0xaf, 0x37, 0x94, 0x5a, 0x9d, 0xd9, 0x8c, 0x8e, 0x88, 0x41, 0x85, 0x0f, 0x2a,
-	0x7b, 0x59, 0x69, 0xb4, 0xcc, 0xca, 0x8c, 0x8f, 0xa9, 0xce, 0xbf, 0x44, 0x98, 0x34, 0x51, 0x66,
-	0xac, 0x5c, 0xd1, 0x55, 0xad, 0x66, 0xa8, 0x9a, 0x69, 0x32, 0x5b, 0xb3, 0x0d, 0x66, 0x5a, 0x38,
-	0x1a, 0xca, 0x60, 0x37, 0x6a, 0xba, 0x3b, 0x38, 0x8f, 0x83, 0x45, 0xcd, 0xd2, 0x45, 0x6a, 0x75,
-	0x2f, 0x5b, 0xd4, 0x6d, 0x2d, 0xab, 0xd6, 0xb4, 0xb2, 0x61, 0xf2, 0x95, 0x30, 0x76, 0x5c, 0xc4,
-	0x6e, 0x89, 0xfc, 0x88, 0x26, 0x86, 0xce, 0x69, 0x55, 0xc3, 0x64, 0x2a, 0xff, 0x53, 0x3c, 0x92,
-	0x73, 0x70, 0xe1, 0xb6, 0xb3, 0xde, 0xba, 0x93, 0x76, 0xc3, 0xdc, 0x61, 0x05, 0xfd, 0xee, 0xae,
-	0x6e, 0xd9, 0x74, 0x1c, 0x06, 0x39, 0xca, 0x96, 0x51, 0x1a, 0x23, 0x53, 0x64, 0xb6, 0xb7, 0x30,
-	0xc0, 0x7f, 0x6f, 0x94, 0xe4, 0x37, 0xe1, 0x62, 0x70, 0x8e, 0x55, 0x63, 0xa6, 0xa5, 0x53, 0x05,
-	0x7a, 0x0d, 0x73, 0x87, 0xf1, 0x09, 0xc3, 0x39, 0x49, 0x09, 0x08, 0xa3, 0xb4, 0x67, 0xf0, 0x38,
-	0xf9, 0x36, 0x5c, 0x6e, 0xaf, 0xb4, 0xc9, 0x2a, 0xc6, 0x76, 0xc3, 0xcb, 0x90, 0x83, 0x01, 0xad,
-	0x54, 0xaa, 0xeb, 0x96, 0xc5, 0x57, 0x1c, 0xca, 0x8f, 0xfd, 0xfa, 0xe3, 0xd2, 0x28, 0x2e, 0x7a,
-	0x53, 0x8c, 0xbc, 0x6d, 0xd7, 0x0d, 0xb3, 0x5c, 0x70, 0x03, 0xe5, 0x3b, 0x30, 0x11, 0xbd, 0x24,
-	0x22, 0xae, 0xfa, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0xf3, 0x04, 0x68, 0x13, 0xc6, 0xda, 0xab, 0xde,
-	0xd2, 0xab, 0x45, 0xbd, 0x6e, 0x75, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xae, 0xcf, 0xd8, 0x29, 0x9e,
-	0x72, 0xda, 0x4d, 0xe9, 0x14, 0x53, 0x11, 0x7d, 0x84, 0xc5, 0x54, 0x36, 0xb5, 0xb2, 0x8e, 0xcb,
-	0x16, 0x3c, 0x33, 0xe5, 0x6f, 0x09, 0x8c, 0x47, 0xe4, 0xc7, 0x2d, 0xad, 0xc1, 0x40, 0x55, 0x3c,
-	0x1a, 0x23, 0x53, 0xcf, 0xcd, 0x0e, 0xe7, 0x26, 0xa2, 0x77, 0x25, 0xe6, 0x15, 0xdc, 0x60, 0xba,
-	0x1e, 0x41, 0x37, 0xd3, 0x91, 0x4e, 0x24, 0xf5, 0xe1, 0xdd, 0xf7, 0xe1, 0x59, 0xf9, 0xc6, 0xcd,
-	0x52, 0xd5, 0x30, 0x5d, 0x7d, 0x14, 0xe8, 0xd3, 0x9c, 0xdf, 0x1d, 0x6b, 0x28, 0xc2, 0xba, 0x26,
-	0xda, 0x37, 0x04, 0xa4, 0x28, 0x2a, 0x54, 0x2d, 0x07, 0xfd, 0x5c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e,
-	0xc5, 0xc8, 0xee, 0x29, 0xf6, 0x09, 0x81, 0xa9, 0x40, 0x9b, 0x1a, 0xba, 0x95, 0x17, 0x3f, 0xff,
-	0xc7, 0xc6, 0xfa, 0x89, 0xc0, 0xf3, 0x09, 0x1c, 0x28, 0xd5, 0x3a, 0x9c, 0x15, 0x20, 0x35, 0x0c,
-	0x40, 0xc9, 0x3a, 0xbf, 0x3d, 0x67, 0xca, 0xde, 0x75, 0xbb, 0xa7, 0xdf, 0x41, 0x8c, 0x7e, 0x27,
-	0xa2, 0xf1, 0xe2, 0x44, 0xf5, 0xf7, 0xdf, 0xc9, 0x13, 0xf5, 0x3a, 0x8c, 0x72, 0xec, 0xcd, 0x3a,
-	0xab, 0x31, 0x4b, 0xab, 0xb8, 0x3a, 0x4e, 0xc2, 0x70, 0x0d, 0x1f, 0xb5, 0x5b, 0x11, 0xdc, 0x47,
-	0x1b, 0x25, 0xf9, 0x2d, 0xfc, 0x88, 0xb4, 0x27, 0xe2, 0x1e, 0xaf, 0xc1, 0xa0, 0x1b, 0x86, 0x07,
-	0xee, 0x78, 0x68, 0x77, 0xad, 0x49, 0xad, 0x50, 0xf9, 0x21, 0x01, 0xd9, 0xb7, 0xa0, 0xdb, 0x91,
-	0x42, 0x84, 0xff, 0xf0, 0x79, 0xe8, 0x5a, 0x8d, 0xbf, 0x27, 0x70, 0x25, 0x11, 0x11, 0x15, 0xb8,
-	0x0e, 0x43, 0xee, 0xb6, 0xdc, 0x02, 0x27, 0x48, 0xd0, 0x8e, 0xed, 0x5e, 0x55, 0xeb, 0x30, 0xc9,
-	0x41, 0xdf, 0x61, 0xb6, 0x9e, 0x6f, 0xe1, 0x3a, 0xbf, 0xea, 0x69, 0x0b, 0xec, 0xbc, 0x49, 0x7b,
-	0xce, 0x04, 0xce, 0x91, 0xf8, 0x26, 0xf1, 0x30, 0xf9, 0x16, 0xbe, 0x9d, 0x91, 0x39, 0x51, 0x99,
-	0x39, 0xe8, 0x75, 0x82, 0xb1, 0x2f, 0x2e, 0x84, 0x44, 0x71, 0xa2, 0x0b, 0x3c, 0x44, 0xfe, 0x94,
-	0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x63, 0x37, 0x68, 0xd7, 0xaa, 0xfe, 0x15, 0x41, 0x77, 0x11,
-	0x02, 0xc1, 0x4d, 0x2d, 0x08, 0xa1, 0xdc, 0x52, 0xc7, 0xec, 0x4a, 0xc4, 0x74, 0xaf, 0xc4, 0xf7,
-	0x08, 0xda, 0x13, 0xc4, 0xf2, 0x15, 0xb7, 0x55, 0x3b, 0x92, 0xaa, 0x76, 0x5d, 0xd3, 0xea, 0x4b,
-	0xd7, 0x14, 0xf8, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0x82, 0x96, 0x00, 0x2d, 0xd1, 0x09, 0x38, 0x50,
-	0x0e, 0x88, 0xd7, 0x0b, 0x7b, 0xd0, 0x4e, 0x82, 0x5d, 0x79, 0x09, 0x2e, 0x71, 0xb6, 0x3b, 0x5a,
-	0xa5, 0xe2, 0x9c, 0x6d, 0xbb, 0x15, 0x3b, 0xf5, 0xc7, 0xe1, 0x5d, 0xec, 0x4d, 0xdf, 0x5c, 0xdc,
-	0xd4, 0x0d, 0xe8, 0xb3, 0x9d, 0xc7, 0x78, 0x08, 0x84, 0x7d, 0xab, 0x67, 0x52, 0x7e, 0xe8, 0xc9,
-	0x1f, 0x93, 0x3d, 0x8f, 0xfe, 0xfe, 0x61, 0x9e, 0x14, 0xc4, 0x2c, 0xf9, 0x7d, 0xa0, 0x1e, 0xc9,
-	0x5c, 0xa2, 0x6e, 0x55, 0xe4, 0x1e, 0x81, 0xf3, 0xbe, 0xe5, 0x4f, 0x40, 0x25, 0x72, 0xff, 0x9c,
-	0x83, 0x3e, 0x0e, 0x45, 0x3f, 0x27, 0x30, 0xd4, 0x4a, 0x44, 0xa7, 0x43, 0x10, 0x91, 0xd7, 0x3a,
-	0x69, 0xa6, 0x63, 0x9c, 0x48, 0x2a, 0x2b, 0x1f, 0xff, 0xf6, 0xd7, 0xfd, 0x53, 0xb3, 0x74, 0x5a,
-	0x0d, 0x5e, 0x4c, 0xd1, 0x93, 0x9a, 0x3b, 0x4c, 0xdd, 0x77, 0xfd, 0x69, 0x93, 0x7e, 0x47, 0x60,
-	0x24, 0x60, 0x54, 0xe8, 0x62, 0x42, 0xb2, 0xd0, 0x6d, 0x4f, 0x5a, 0x4a, 0x19, 0x8d, 0x80, 0xab,
-	0x1c, 0x50, 0xa1, 0x8b, 0x31, 0x80, 0xdc, 0x56, 0x35, 0x90, 0x13, 0xdf, 0xd6, 0x26, 0x7d, 0x40,
-	0xe0, 0xb4, 0xf7, 0x12, 0x45, 0xe7, 0x12, 0xb2, 0xfa, 0x2f, 0x7a, 0xd2, 0x7c, 0x9a, 0x50, 0xa4,
-	0xcb, 0x72, 0xba, 0x05, 0x3a, 0x17, 0x43, 0x87, 0x77, 0x30, 0xaf, 0x82, 0x07, 0x04, 0xce, 0xf8,
-	0xae, 0x2a, 0x34, 0x29, 0x61, 0xc0, 0xec, 0x4a, 0x0b, 0xa9, 0x62, 0x91, 0x6e, 0x99, 0xd3, 0xcd,
-	0xd3, 0xd9, 0x68, 0x3a, 0x6b, 0xab, 0xd8, 0xd8, 0xe2, 0x9e, 0xd8, 0x51, 0xae, 0x6a, 0x98, 0x4d,
-	0xfa, 0x0b, 0x81, 0xd1, 0xa8, 0x3b, 0x02, 0xcd, 0x76, 0xaa, 0x5a, 0xe8, 0x5e, 0x23, 0xe5, 0x8e,
-	0x33, 0x05, 0x89, 0x5f, 0xe6, 0xc4, 0xd7, 0xe8, 0x4a, 0x52, 0xb5, 0x0d, 0x9d, 0x93, 0x8b, 0x21,
-	0x8f, 0xb2, 0x3f, 0x87, 0xe1, 0x85, 0xc0, 0xe9, 0xe0, 0x7d, 0x3a, 0xe7, 0x8e, 0x33, 0x05, 0xe1,
-	0x5f, 0xe4, 0xf0, 0x39, 0xba, 0x9c, 0x02, 0xde, 0x2f, 0xfb, 0x67, 0x04, 0x06, 0x5d, 0x93, 0x41,
-	0x5f, 0x88, 0x4e, 0x1d, 0x70, 0x43, 0xd2, 0x74, 0xa7, 0x30, 0xa4, 0x52, 0x39, 0xd5, 0x1c, 0x9d,
-	0x09, 0x51, 0xb9, 0xa7, 0xb7, 0xba, 0xef, 0x39, 0xda, 0x9b, 0xf4, 0x31, 0x81, 0x8b, 0xd1, 0x76,
-	0x97, 0xae, 0x24, 0xe7, 0x8c, 0xf4, 0xef, 0xd2, 0xea, 0xf1, 0x26, 0x21, 0xf6, 0x2b, 0x1c, 0x7b,
-	0x8d, 0xae, 0xc6, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x98, 0xc0, 0xf9, 0x08,
-	0x57, 0x4a, 0x97, 0xa3, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0x3d, 0xc6, 0x0c, 0x44, 0x7f, 0x9d, 0xa3,
-	0xbf, 0x46, 0x6f, 0x84, 0xd0, 0x1d, 0x9f, 0xe3, 0x50, 0xb7, 0xf4, 0xe6, 0x5e, 0xcc, 0xaf, 0xbf,
-	0xba, 0xcf, 0x1f, 0x36, 0xe9, 0x23, 0x02, 0x23, 0x01, 0x03, 0x1a, 0x77, 0xd4, 0x46, 0x1b, 0xe6,
-	0xb8, 0xa3, 0x36, 0xc6, 0xd5, 0x26, 0xf4, 0x2f, 0xf7, 0x67, 0x5e, 0xf0, 0x40, 0xcb, 0x7c, 0x4d,
-	0xe0, 0xb4, 0xd7, 0xff, 0xc5, 0x1d, 0xb7, 0x11, 0xc6, 0x35, 0xee, 0xb8, 0x8d, 0xb2, 0x93, 0x09,
-	0xbd, 0xdc, 0x22, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0xfa, 0x9d, 0x16, 0xed, 0x70, 0x82,
-	0xfa, 0xac, 0xa2, 0xb4, 0x98, 0x2e, 0x18, 0xf1, 0x56, 0x38, 0xde, 0x12, 0x5d, 0x48, 0x38, 0x6f,
-	0xc5, 0x17, 0xc1, 0xd3, 0xaa, 0x07, 0x04, 0x86, 0x3d, 0xfe, 0x87, 0xce, 0x46, 0xa7, 0x0c, 0x7b,
-	0x32, 0x69, 0x2e, 0x45, 0x24, 0x92, 0xad, 0x71, 0xb2, 0x65, 0xaa, 0xc4, 0xbf, 0x4d, 0x81, 0x2e,
-	0xe4, 0xd6, 0x8b, 0xda, 0xd0, 0x2f, 0xf6, 0x4a, 0xaf, 0x24, 0x29, 0xe1, 0x12, 0x5d, 0x4d, 0x0e,
-	0x42, 0x98, 0x49, 0x0e, 0x33, 0x4e, 0x2f, 0xc5, 0xc8, 0x94, 0x7f, 0xf5, 0xc9, 0x61, 0x86, 0x3c,
-	0x3d, 0xcc, 0x90, 0x3f, 0x0f, 0x33, 0xe4, 0x8b, 0xa3, 0x4c, 0xcf, 0xd3, 0xa3, 0x4c, 0xcf, 0xef,
-	0x47, 0x99, 0x9e, 0xf7, 0xae, 0x96, 0x0d, 0xfb, 0x83, 0xdd, 0xa2, 0xb2, 0xcd, 0xaa, 0xee, 0x64,
-	0xf1, 0xd7, 0x92, 0x55, 0xfa, 0x50, 0xfd, 0x48, 0x2c, 0x50, 0xec, 0xe7, 0xff, 0xe9, 0xbd, 0xf2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb3, 0x86, 0xf8, 0xcf, 0x17, 0x00, 0x00,
+	// 1446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xa5, 0xbf, 0xf2, 0xd2, 0x1f, 0x62, 0x92, 0xb4, 0xce, 0x36, 0x72, 0xc2, 0xb6,
+	0xa4, 0xf9, 0xb9, 0x1b, 0x3b, 0x69, 0x8b, 0x80, 0xb6, 0x8a, 0xa5, 0x12, 0x7a, 0x28, 0x6a, 0xdd,
+	0xaa, 0x12, 0x08, 0x29, 0xda, 0xc4, 0x5b, 0x77, 0x85, 0xbd, 0xeb, 0x7a, 0xd7, 0xa1, 0x51, 0xe5,
+	0x0b, 0x12, 0x1c, 0x10, 0x07, 0x68, 0x11, 0x2a, 0x11, 0x12, 0x3d, 0x20, 0x51, 0x71, 0xe2, 0x50,
+	0x84, 0xc4, 0xad, 0xb7, 0x1e, 0x2b, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x42, 0xe2, 0xaf, 0x40, 0x3b,
+	0xf3, 0xd6, 0xde, 0x1f, 0xb3, 0x3f, 0x02, 0x16, 0xf8, 0xd2, 0xda, 0x33, 0xef, 0xcd, 0xfb, 0xcc,
+	0xf7, 0xbd, 0x9d, 0x7d, 0xe3, 0xc0, 0xb1, 0x0d, 0xcb, 0xae, 0x5b, 0xb6, 0x5a, 0x6d, 0x5a, 0xad,
+	0x86, 0xba, 0x59, 0x50, 0x6f, 0xb5, 0xf4, 0xe6, 0x96, 0xd2, 0x68, 0x5a, 0x8e, 0x45, 0x0f, 0xf3,
+	0x49, 0x85, 0x4d, 0x2a, 0x9b, 0x05, 0x69, 0xa4, 0x6a, 0x55, 0x2d, 0x36, 0xa7, 0xba, 0x9f, 0xb8,
+	0x99, 0x34, 0x5e, 0xb5, 0xac, 0x6a, 0x4d, 0x57, 0xb5, 0x86, 0xa1, 0x6a, 0xa6, 0x69, 0x39, 0x9a,
+	0x63, 0x58, 0xa6, 0x8d, 0xb3, 0x91, 0x08, 0xce, 0x56, 0x43, 0xf7, 0x26, 0x67, 0x71, 0x72, 0x5d,
+	0xb3, 0x75, 0x1e, 0x5a, 0xdd, 0x2c, 0xac, 0xeb, 0x8e, 0x56, 0x50, 0x1b, 0x5a, 0xd5, 0x30, 0xd9,
+	0x4a, 0x68, 0x3b, 0xc6, 0x6d, 0xd7, 0x78, 0x7c, 0x44, 0xe3, 0x53, 0x2f, 0x6a, 0x75, 0xc3, 0xb4,
+	0x54, 0xf6, 0x2f, 0x1f, 0x92, 0x8b, 0x30, 0x7a, 0xc5, 0x5d, 0x6f, 0xd5, 0x0d, 0x7b, 0xd1, 0xbc,
+	0x61, 0x95, 0xf5, 0x5b, 0x2d, 0xdd, 0x76, 0xe8, 0x18, 0xec, 0x67, 0x28, 0x6b, 0x46, 0x25, 0x47,
+	0x26, 0xc9, 0xf4, 0xee, 0xf2, 0x3e, 0xf6, 0xfd, 0x62, 0x45, 0x7e, 0x13, 0x8e, 0x84, 0x7d, 0xec,
+	0x86, 0x65, 0xda, 0x3a, 0x55, 0x60, 0xb7, 0x61, 0xde, 0xb0, 0x98, 0xc3, 0x50, 0x51, 0x52, 0x42,
+	0xc2, 0x28, 0x5d, 0x0f, 0x66, 0x27, 0x5f, 0x81, 0x63, 0xdd, 0x95, 0x2e, 0x5b, 0x35, 0x63, 0x63,
+	0xcb, 0xcf, 0x50, 0x84, 0x7d, 0x5a, 0xa5, 0xd2, 0xd4, 0x6d, 0x9b, 0xad, 0x38, 0x58, 0xca, 0xfd,
+	0xfc, 0x68, 0x61, 0x04, 0x17, 0x5d, 0xe1, 0x33, 0x57, 0x9d, 0xa6, 0x61, 0x56, 0xcb, 0x9e, 0xa1,
+	0x7c, 0x0d, 0xc6, 0xc5, 0x4b, 0x22, 0xe2, 0x72, 0x00, 0x71, 0x52, 0x8c, 0xe8, 0xf3, 0xe3, 0xa0,
+	0x6d, 0xc8, 0x75, 0x57, 0xbd, 0xa4, 0xd7, 0xd7, 0xf5, 0xa6, 0x9d, 0xae, 0x14, 0x7d, 0x03, 0xa0,
+	0x9b, 0x9f, 0xdc, 0x2e, 0x16, 0x72, 0xca, 0x0b, 0xe9, 0x26, 0x53, 0xe1, 0x75, 0x84, 0xc9, 0x54,
+	0x2e, 0x6b, 0x55, 0x1d, 0x97, 0x2d, 0xfb, 0x3c, 0xe5, 0xaf, 0x08, 0x8c, 0x09, 0xe2, 0xe3, 0x96,
+	0x4e, 0xc3, 0xbe, 0x3a, 0x1f, 0xca, 0x91, 0xc9, 0x17, 0xa6, 0x87, 0x8a, 0xe3, 0xe2, 0x5d, 0x71,
+	0xbf, 0xb2, 0x67, 0x4c, 0x57, 0x05, 0x74, 0x27, 0x53, 0xe9, 0x78, 0xd0, 0x00, 0xde, 0xbd, 0x00,
+	0x9e, 0x5d, 0xda, 0x5a, 0xa9, 0xd4, 0x0d, 0xd3, 0xd3, 0x47, 0x81, 0x3d, 0x9a, 0xfb, 0x3d, 0x35,
+	0x87, 0xdc, 0xac, 0x67, 0xa2, 0x7d, 0x49, 0x40, 0x12, 0x51, 0xa1, 0x6a, 0x45, 0xd8, 0xcb, 0xe4,
+	0xf1, 0x44, 0x4b, 0xaa, 0x56, 0xb4, 0xec, 0x9d, 0x62, 0x1f, 0x12, 0x98, 0x0c, 0x95, 0xa9, 0xa1,
+	0xdb, 0x25, 0xfe, 0xf5, 0x3f, 0x2c, 0xac, 0x1f, 0x08, 0xbc, 0x94, 0xc0, 0x81, 0x52, 0xad, 0xc2,
+	0x21, 0x0e, 0xd2, 0x40, 0x03, 0x94, 0x2c, 0xfd, 0xe9, 0x39, 0x58, 0xf5, 0xaf, 0xdb, 0x3b, 0xfd,
+	0xb6, 0x63, 0xf4, 0xeb, 0x8b, 0xc2, 0x8b, 0x13, 0x35, 0x58, 0x7f, 0xfd, 0x27, 0xea, 0x19, 0x18,
+	0x61, 0xd8, 0x97, 0x9b, 0x56, 0xc3, 0xb2, 0xb5, 0x9a, 0xa7, 0xe3, 0x04, 0x0c, 0x35, 0x70, 0xa8,
+	0x5b, 0x8a, 0xe0, 0x0d, 0x5d, 0xac, 0xc8, 0x6f, 0xc1, 0x68, 0xc8, 0x11, 0xf7, 0x78, 0x0a, 0xf6,
+	0x7b, 0x66, 0x78, 0xe0, 0x8e, 0x45, 0x76, 0xd7, 0x71, 0xea, 0x98, 0xca, 0x0f, 0x08, 0xc8, 0x81,
+	0x05, 0xbd, 0x8a, 0xe4, 0x22, 0xfc, 0x8b, 0xd7, 0x43, 0xcf, 0x72, 0xfc, 0x2d, 0x81, 0xe3, 0x89,
+	0x88, 0xa8, 0xc0, 0x19, 0x18, 0xf4, 0xb6, 0xe5, 0x25, 0x38, 0x41, 0x82, 0xae, 0x6d, 0xef, 0xb2,
+	0xda, 0x84, 0x09, 0x06, 0x7a, 0xdd, 0x72, 0xf4, 0x52, 0x07, 0xd7, 0xfd, 0xd6, 0xcc, 0x9a, 0x60,
+	0xf7, 0x49, 0xda, 0x74, 0x1d, 0x72, 0xbb, 0x52, 0x74, 0xe6, 0x66, 0xf2, 0x25, 0x7c, 0x3a, 0x85,
+	0x31, 0x51, 0x99, 0x19, 0xd8, 0xed, 0x1a, 0x63, 0x5d, 0x8c, 0x46, 0x44, 0x71, 0xad, 0xcb, 0xcc,
+	0x44, 0xfe, 0x88, 0x60, 0x9f, 0xe0, 0x8e, 0xd9, 0xa5, 0x1d, 0x17, 0x68, 0xcf, 0xb2, 0xfe, 0x39,
+	0x81, 0x71, 0x31, 0x08, 0x6e, 0x6a, 0x8e, 0x0b, 0xe5, 0xa5, 0x3a, 0x66, 0x57, 0xdc, 0xa6, 0x77,
+	0x29, 0xbe, 0x4b, 0xb0, 0x3d, 0x41, 0xac, 0x40, 0x72, 0x3b, 0xb9, 0x23, 0x99, 0x72, 0xd7, 0x33,
+	0xad, 0x3e, 0xf3, 0x9a, 0x82, 0x20, 0xd4, 0xff, 0x2a, 0xd4, 0xfd, 0x70, 0x4b, 0x80, 0x2d, 0x51,
+	0x1f, 0x1c, 0x28, 0xdb, 0x04, 0x8e, 0x09, 0xd1, 0xfa, 0xa1, 0x5d, 0x79, 0x15, 0x8e, 0x32, 0xb6,
+	0x6b, 0x5a, 0xad, 0xe6, 0x9e, 0x6d, 0xad, 0x9a, 0x93, 0xf9, 0xe5, 0xf0, 0x36, 0xe4, 0xa2, 0xbe,
+	0xb8, 0xa9, 0xb3, 0xb0, 0xc7, 0x71, 0x87, 0xf1, 0x10, 0x88, 0xf6, 0xad, 0x3e, 0xa7, 0xd2, 0xe0,
+	0x93, 0xdf, 0x26, 0x06, 0x1e, 0xfe, 0xf9, 0xfd, 0x2c, 0x29, 0x73, 0x2f, 0xf9, 0x5d, 0xa0, 0x3e,
+	0xc9, 0x3c, 0xa2, 0x5e, 0x65, 0xe4, 0x2e, 0x81, 0xe1, 0xc0, 0xf2, 0xfd, 0x90, 0x89, 0x15, 0xc8,
+	0x33, 0xa6, 0xab, 0x1b, 0x37, 0xf5, 0x4a, 0xab, 0xa6, 0x57, 0x2e, 0xdc, 0xd6, 0x37, 0x5a, 0xee,
+	0x54, 0xe6, 0x84, 0xbc, 0x0f, 0x13, 0xb1, 0x4b, 0xe0, 0x16, 0xaf, 0xc1, 0xb0, 0xed, 0xcd, 0xae,
+	0xe9, 0xde, 0x34, 0x66, 0xe9, 0x78, 0x64, 0xbf, 0x82, 0x95, 0xa8, 0x1d, 0x19, 0x93, 0xbf, 0x23,
+	0x30, 0x1f, 0x13, 0xb9, 0xff, 0x5e, 0xf0, 0x4f, 0x09, 0x2c, 0x64, 0x84, 0x45, 0xd1, 0xae, 0xc3,
+	0x88, 0x40, 0x34, 0xaf, 0x4a, 0x32, 0xa9, 0x36, 0x1c, 0x55, 0xad, 0x77, 0xb5, 0x53, 0xfc, 0x7a,
+	0x14, 0xf6, 0xb0, 0x2d, 0xd1, 0x4f, 0x08, 0x0c, 0x76, 0x8a, 0x94, 0x4e, 0x45, 0xd0, 0x84, 0x3f,
+	0x09, 0x48, 0x27, 0x53, 0xed, 0x78, 0x50, 0x59, 0xf9, 0xe0, 0x97, 0x3f, 0xee, 0xed, 0x9a, 0xa6,
+	0x53, 0x6a, 0xf8, 0x47, 0x0d, 0xbc, 0xcf, 0x98, 0x37, 0x2c, 0xf5, 0x0e, 0x7e, 0xae, 0xb4, 0xe9,
+	0x37, 0x04, 0x0e, 0x87, 0x9a, 0x5c, 0x3a, 0x9f, 0x10, 0x2c, 0xf2, 0x4b, 0x81, 0xb4, 0x90, 0xd1,
+	0x1a, 0x01, 0x97, 0x19, 0xa0, 0x42, 0xe7, 0x63, 0x00, 0x59, 0x4b, 0xbe, 0x85, 0x9c, 0x58, 0x59,
+	0x6d, 0x7a, 0x9f, 0xc0, 0x01, 0xff, 0x05, 0x9c, 0xce, 0x24, 0x44, 0x0d, 0xfe, 0x48, 0x20, 0xcd,
+	0x66, 0x31, 0x45, 0xba, 0x02, 0xa3, 0x9b, 0xa3, 0x33, 0x31, 0x74, 0x78, 0x7f, 0xf7, 0x2b, 0xb8,
+	0x4d, 0xe0, 0x60, 0xe0, 0x9a, 0x4b, 0x93, 0x02, 0x86, 0x2e, 0x4a, 0xd2, 0x5c, 0x26, 0x5b, 0xa4,
+	0x5b, 0x64, 0x74, 0xb3, 0x74, 0x5a, 0x4c, 0x67, 0xaf, 0xad, 0x6f, 0xad, 0xb1, 0xfb, 0x94, 0xab,
+	0x5c, 0xdd, 0x30, 0xdb, 0xf4, 0x27, 0x02, 0x23, 0xa2, 0xfb, 0x25, 0x2d, 0xa4, 0x65, 0x2d, 0x72,
+	0x27, 0x96, 0x8a, 0x3b, 0x71, 0x41, 0xe2, 0xd7, 0x18, 0xf1, 0x29, 0xba, 0x94, 0x94, 0x6d, 0x43,
+	0x67, 0xe4, 0x7c, 0xca, 0xa7, 0xec, 0x8f, 0x51, 0x78, 0x2e, 0x70, 0x36, 0xf8, 0x80, 0xce, 0xc5,
+	0x9d, 0xb8, 0x20, 0xfc, 0x2b, 0x0c, 0xbe, 0x48, 0x17, 0x33, 0xc0, 0x07, 0x65, 0xff, 0x98, 0xc0,
+	0x7e, 0xaf, 0x41, 0xa5, 0x2f, 0x8b, 0x43, 0x87, 0x3a, 0x69, 0x69, 0x2a, 0xcd, 0x0c, 0xa9, 0x54,
+	0x46, 0x35, 0x43, 0x4f, 0x46, 0xa8, 0xbc, 0x17, 0x8d, 0x7a, 0xc7, 0xf7, 0x16, 0x6a, 0xd3, 0xc7,
+	0x04, 0x8e, 0x88, 0xaf, 0x4a, 0x74, 0x29, 0x39, 0xa6, 0xf0, 0xd5, 0x20, 0x2d, 0xef, 0xcc, 0x09,
+	0xb1, 0x5f, 0x67, 0xd8, 0xa7, 0xe9, 0x72, 0x2c, 0x76, 0xb7, 0x08, 0xf0, 0x10, 0xf0, 0x3d, 0xff,
+	0x8f, 0x09, 0x0c, 0x0b, 0x6e, 0x34, 0x74, 0x51, 0xcc, 0x12, 0x7f, 0xe1, 0x92, 0x0a, 0x3b, 0xf0,
+	0x40, 0xf4, 0x0b, 0x0c, 0xfd, 0x3c, 0x3d, 0x1b, 0x41, 0x77, 0x7b, 0x64, 0x97, 0xba, 0xa3, 0xb7,
+	0x3b, 0xd0, 0x0c, 0xea, 0xaf, 0xde, 0x61, 0x83, 0x6d, 0xfa, 0x90, 0xc0, 0xe1, 0xd0, 0xe5, 0x25,
+	0xee, 0xa8, 0x15, 0x5f, 0xb6, 0xa4, 0x85, 0x8c, 0xd6, 0xa9, 0xf5, 0xeb, 0x12, 0xd9, 0x7e, 0xf0,
+	0x50, 0xc9, 0x7c, 0x41, 0xe0, 0x80, 0xff, 0xee, 0x10, 0x77, 0xdc, 0x0a, 0x2e, 0x3d, 0x71, 0xc7,
+	0xad, 0xe8, 0x2a, 0x92, 0x50, 0xcb, 0x1d, 0x42, 0x54, 0x14, 0x35, 0x7c, 0x40, 0xe0, 0x50, 0xb0,
+	0x4b, 0xa7, 0x29, 0x27, 0x68, 0xe0, 0x9a, 0x21, 0xcd, 0x67, 0x33, 0x46, 0xbc, 0x25, 0x86, 0xb7,
+	0x40, 0xe7, 0x12, 0xce, 0x5b, 0xfe, 0x46, 0xf0, 0x95, 0xea, 0x36, 0x81, 0x21, 0x5f, 0xef, 0x4c,
+	0xa7, 0xc5, 0x21, 0xa3, 0xfd, 0xbc, 0x34, 0x93, 0xc1, 0x12, 0xc9, 0x4e, 0x33, 0xb2, 0x45, 0xaa,
+	0xc4, 0x3f, 0x4d, 0xa1, 0x2a, 0x64, 0x6d, 0x3b, 0x75, 0x60, 0x2f, 0xdf, 0x2b, 0x3d, 0x9e, 0xa4,
+	0x84, 0x47, 0x74, 0x22, 0xd9, 0x08, 0x61, 0x26, 0x18, 0xcc, 0x18, 0x3d, 0x1a, 0x23, 0x13, 0x7d,
+	0x44, 0x80, 0x46, 0x5b, 0x2e, 0xaa, 0x8a, 0x57, 0x8f, 0xed, 0xaf, 0xa5, 0xc5, 0xec, 0x0e, 0xa9,
+	0xef, 0x1f, 0x51, 0xbf, 0x18, 0x7a, 0x0a, 0xfe, 0x22, 0x30, 0x99, 0xd6, 0x82, 0xd2, 0xb3, 0x59,
+	0x99, 0xc4, 0x87, 0xe9, 0xb9, 0x7f, 0xea, 0x8e, 0x1b, 0x5c, 0x65, 0x1b, 0x5c, 0xa1, 0xe7, 0x33,
+	0x6d, 0x30, 0xfe, 0x84, 0x2d, 0x9d, 0x7b, 0xf2, 0x2c, 0x4f, 0x9e, 0x3e, 0xcb, 0x93, 0xdf, 0x9f,
+	0xe5, 0xc9, 0xa7, 0xcf, 0xf3, 0x03, 0x4f, 0x9f, 0xe7, 0x07, 0x7e, 0x7d, 0x9e, 0x1f, 0x78, 0xe7,
+	0x44, 0xd5, 0x70, 0x6e, 0xb6, 0xd6, 0x95, 0x0d, 0xab, 0xee, 0x05, 0xe1, 0xff, 0x2d, 0xd8, 0x95,
+	0xf7, 0xd4, 0xdb, 0x3c, 0xe2, 0xfa, 0x5e, 0xf6, 0x47, 0xad, 0xa5, 0xbf, 0x07, 0x00, 0x8f, 0x45,
+	0x8a, 0xe4, 0xaf, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1874,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ScheduledExecution queries the scheduled execution of an accepted proposal.
+	ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error)
+	// ScheduledExecutionsByGroupPolicy queries the scheduled executions of a group policy.
+	ScheduledExecutionsByGroupPolicy(ctx context.Context, in *QueryScheduledExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsByGroupPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +2014,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error) {
+	out := new(QueryScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ScheduledExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecutionsByGroupPolicy(ctx context.Context, in *QueryScheduledExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsByGroupPolicyResponse, error) {
+	out := new(QueryScheduledExecutionsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ScheduledExecutionsByGroupPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +2068,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ScheduledExecution queries the scheduled execution of an accepted proposal.
+	ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error)
+	// ScheduledExecutionsByGroupPolicy queries the scheduled executions of a group policy.
+	ScheduledExecutionsByGroupPolicy(context.Context, *QueryScheduledExecutionsByGroupPolicyRequest) (*QueryScheduledExecutionsByGroupPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2120,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ScheduledExecution(ctx context.Context, req *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecution not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledExecutionsByGroupPolicy(ctx context.Context, req *QueryScheduledExecutionsByGroupPolicyRequest) (*QueryScheduledExecutionsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecutionsByGroupPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2383,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ScheduledExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecution(ctx, req.(*QueryScheduledExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecutionsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecutionsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ScheduledExecutionsByGroupPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecutionsByGroupPolicy(ctx, req.(*QueryScheduledExecutionsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2479,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ScheduledExecution",
+			Handler:    _Query_ScheduledExecution_Handler,
+		},
+		{
+			MethodName: "ScheduledExecutionsByGroupPolicy",
+			Handler:    _Query_ScheduledExecutionsByGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,66 +3620,220 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryScheduledExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	return n
+
+func (m *QueryScheduledExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScheduledExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryScheduledExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScheduledExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ScheduledExecution != nil {
+		{
+			size, err := m.ScheduledExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledExecutions) > 0 {
+		for iNdEx := len(m.ScheduledExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGroupMembersRequest) Size() (n int) {
@@ -3777,6 +4230,67 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduledExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduledExecution != nil {
+		l = m.ScheduledExecution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledExecutions) > 0 {
+		for _, e := range m.ScheduledExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +7185,399 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduledExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledExecution == nil {
+				m.ScheduledExecution = &ScheduledExecution{}
+			}
+			if err := m.ScheduledExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledExecutionsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsByGroupPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledExecutionsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsByGroupPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledExecutionsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledExecutions = append(m.ScheduledExecutions, &ScheduledExecution{})
+			if err := m.ScheduledExecutions[len(m.ScheduledExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
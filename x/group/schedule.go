@@ -0,0 +1,91 @@
+package group
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+// ValidateBasic does basic validation on an execution schedule.
+func (s ExecutionSchedule) ValidateBasic() error {
+	if s.StartTime.IsZero() {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution schedule start time")
+	}
+	if s.Interval < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "execution schedule interval cannot be negative")
+	}
+	if s.Count == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution schedule count")
+	}
+	if s.Count > 1 && s.Interval == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "execution schedule interval must be positive for recurring executions")
+	}
+	return nil
+}
+
+// IsLastExecution returns true if the given number of executions exhausts the
+// schedule.
+func (s ExecutionSchedule) IsLastExecution(executions uint64) bool {
+	return executions >= s.Count
+}
+
+// FirstExecutionTime returns the time of the first execution of a proposal
+// submitted at `submitTime` under a decision policy with the given min
+// execution period.
+func (s ExecutionSchedule) FirstExecutionTime(submitTime time.Time, minExecutionPeriod time.Duration) time.Time {
+	minExecutionTime := submitTime.Add(minExecutionPeriod)
+	if s.StartTime.Before(minExecutionTime) {
+		return minExecutionTime
+	}
+	return s.StartTime
+}
+
+var _ orm.PrimaryKeyed = &ScheduledExecution{}
+
+// PrimaryKeyFields returns the primary key fields of a scheduled execution.
+func (s ScheduledExecution) PrimaryKeyFields() []interface{} {
+	return []interface{}{s.ProposalId}
+}
+
+var _ orm.Validateable = ScheduledExecution{}
+
+// ValidateBasic does basic validation on a scheduled execution.
+func (s ScheduledExecution) ValidateBasic() error {
+	if s.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "scheduled execution proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "scheduled execution group policy address")
+	}
+	if err := s.Schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	if s.GroupVersion == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "scheduled execution group version")
+	}
+	if s.GroupPolicyVersion == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "scheduled execution group policy version")
+	}
+	if s.Failures > s.Executions {
+		return sdkerrors.Wrap(errors.ErrInvalid, "scheduled execution failures cannot exceed executions")
+	}
+	if s.Schedule.IsLastExecution(s.Executions) {
+		return sdkerrors.Wrap(errors.ErrInvalid, "scheduled execution is already completed")
+	}
+	return nil
+}
+
+// GetMsgs unpacks s.Messages Any's into sdk.Msg's
+func (s *ScheduledExecution) GetMsgs() ([]sdk.Msg, error) {
+	return tx.GetMsgs(s.Messages, "scheduled execution")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s ScheduledExecution) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, s.Messages)
+}
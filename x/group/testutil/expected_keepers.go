@@ -13,6 +13,11 @@ type AccountKeeper interface {
 	group.AccountKeeper
 }
 
+// StakingKeeper extends `StakingKeeper` from expected_keepers.
+type StakingKeeper interface {
+	group.StakingKeeper
+}
+
 // BankKeeper extends `BankKeeper` from expected_keepers and bank `MsgServer` to mock `Send` and
 // to register handlers in MsgServiceRouter
 type BankKeeper interface {
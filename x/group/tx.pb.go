@@ -72,6 +72,12 @@ type MsgCreateGroup struct {
 	Members []MemberRequest `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
 	// metadata is any arbitrary metadata to attached to the group.
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// weight_source defines where the voting weights of the group members come
+	// from. It defaults to the members' static weights.
+	WeightSource WeightSource `protobuf:"varint,4,opt,name=weight_source,json=weightSource,proto3,enum=cosmos.group.v1.WeightSource" json:"weight_source,omitempty"`
+	// weight_denom is the denom whose balance defines the members' voting
+	// weights, required when weight_source is WEIGHT_SOURCE_BALANCE.
+	WeightDenom string `protobuf:"bytes,5,opt,name=weight_denom,json=weightDenom,proto3" json:"weight_denom,omitempty"`
 }
 
 func (m *MsgCreateGroup) Reset()         { *m = MsgCreateGroup{} }
@@ -128,6 +134,20 @@ func (m *MsgCreateGroup) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgCreateGroup) GetWeightSource() WeightSource {
+	if m != nil {
+		return m.WeightSource
+	}
+	return WEIGHT_SOURCE_UNSPECIFIED
+}
+
+func (m *MsgCreateGroup) GetWeightDenom() string {
+	if m != nil {
+		return m.WeightDenom
+	}
+	return ""
+}
+
 // MsgCreateGroupResponse is the Msg/CreateGroup response type.
 type MsgCreateGroupResponse struct {
 	// group_id is the unique ID of the newly created group.
@@ -685,6 +705,12 @@ type MsgCreateGroupWithPolicy struct {
 	GroupPolicyAsAdmin bool `protobuf:"varint,5,opt,name=group_policy_as_admin,json=groupPolicyAsAdmin,proto3" json:"group_policy_as_admin,omitempty"`
 	// decision_policy specifies the group policy's decision policy.
 	DecisionPolicy *types.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
+	// weight_source defines where the voting weights of the group members come
+	// from. It defaults to the members' static weights.
+	WeightSource WeightSource `protobuf:"varint,7,opt,name=weight_source,json=weightSource,proto3,enum=cosmos.group.v1.WeightSource" json:"weight_source,omitempty"`
+	// weight_denom is the denom whose balance defines the members' voting
+	// weights, required when weight_source is WEIGHT_SOURCE_BALANCE.
+	WeightDenom string `protobuf:"bytes,8,opt,name=weight_denom,json=weightDenom,proto3" json:"weight_denom,omitempty"`
 }
 
 func (m *MsgCreateGroupWithPolicy) Reset()         { *m = MsgCreateGroupWithPolicy{} }
@@ -983,6 +1009,10 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	// execution_schedule, if set, makes the proposal messages be executed
+	// automatically, possibly several times, once the proposal is accepted.
+	// It cannot be used together with EXEC_TRY.
+	ExecutionSchedule *ExecutionSchedule `protobuf:"bytes,8,opt,name=execution_schedule,json=executionSchedule,proto3" json:"execution_schedule,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -1469,6 +1499,100 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgCancelScheduledExecution is the Msg/CancelScheduledExecution request type.
+type MsgCancelScheduledExecution struct {
+	// group_policy_address is the account address of the group policy owning
+	// the scheduled execution.
+	GroupPolicyAddress string `protobuf:"bytes,1,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// proposal_id is the unique ID of the proposal whose scheduled execution
+	// is cancelled.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgCancelScheduledExecution) Reset()         { *m = MsgCancelScheduledExecution{} }
+func (m *MsgCancelScheduledExecution) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledExecution) ProtoMessage()    {}
+func (*MsgCancelScheduledExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgCancelScheduledExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledExecution.Merge(m, src)
+}
+func (m *MsgCancelScheduledExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledExecution proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledExecution) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgCancelScheduledExecutionResponse is the Msg/CancelScheduledExecution response type.
+type MsgCancelScheduledExecutionResponse struct {
+}
+
+func (m *MsgCancelScheduledExecutionResponse) Reset()         { *m = MsgCancelScheduledExecutionResponse{} }
+func (m *MsgCancelScheduledExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledExecutionResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledExecutionResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledExecutionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1499,103 +1623,114 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgCancelScheduledExecution)(nil), "cosmos.group.v1.MsgCancelScheduledExecution")
+	proto.RegisterType((*MsgCancelScheduledExecutionResponse)(nil), "cosmos.group.v1.MsgCancelScheduledExecutionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0x6f, 0xdd, 0x64, 0x9b, 0xb4, 0x9b, 0x6d, 0x6b, 0xbb, 0xd3,
-	0xb4, 0x49, 0xad, 0xc6, 0x6e, 0x9c, 0x6f, 0x2b, 0x61, 0x10, 0xa8, 0x49, 0x0d, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x57, 0x64, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x3f, 0x2e, 0xd0, 0x0b, 0x48, 0xf0, 0x07, 0xc0, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6, 0x0d, 0x55,
-	0xe5, 0x52, 0x71, 0xe2, 0x84, 0xa0, 0x15, 0xea, 0x8d, 0x7f, 0x01, 0xb4, 0x33, 0xbb, 0xe3, 0x1d,
-	0xef, 0xae, 0x77, 0x6b, 0x59, 0x70, 0x89, 0xb2, 0xf3, 0x3e, 0xef, 0xd7, 0xe7, 0xbd, 0x79, 0x33,
-	0x63, 0x90, 0x76, 0x2d, 0x6c, 0x5a, 0xb8, 0xac, 0x77, 0xac, 0x6e, 0xbb, 0xbc, 0xbf, 0x56, 0xb6,
-	0x0f, 0x4a, 0xed, 0x8e, 0x65, 0x5b, 0xe2, 0x51, 0x2a, 0x29, 0x11, 0x49, 0x69, 0x7f, 0x4d, 0x9e,
-	0xd7, 0x2d, 0xdd, 0x22, 0xb2, 0xb2, 0xf3, 0x1f, 0x85, 0xc9, 0x8b, 0x14, 0xd6, 0xa0, 0x02, 0x57,
-	0xc7, 0x15, 0xe9, 0x96, 0xa5, 0xef, 0x69, 0x65, 0xf2, 0xb5, 0xd3, 0xbd, 0x5d, 0x56, 0x5b, 0x87,
-	0xae, 0xe8, 0x64, 0xc0, 0xed, 0x61, 0x5b, 0xf3, 0xf4, 0x4e, 0xb8, 0x42, 0x13, 0xeb, 0x8e, 0xc8,
-	0xc4, 0xba, 0x2b, 0x98, 0x53, 0x4d, 0xa3, 0x65, 0x95, 0xc9, 0x5f, 0xba, 0x84, 0x7e, 0x16, 0x20,
-	0x5b, 0xc7, 0xfa, 0x66, 0x47, 0x53, 0x6d, 0xed, 0x35, 0xc7, 0x9a, 0x58, 0x82, 0x71, 0xb5, 0x69,
-	0x1a, 0x2d, 0x49, 0x28, 0x08, 0x2b, 0xd3, 0x1b, 0xd2, 0x2f, 0x0f, 0x56, 0xe7, 0xdd, 0xb8, 0xae,
-	0x36, 0x9b, 0x1d, 0x0d, 0xe3, 0xeb, 0x76, 0xc7, 0x68, 0xe9, 0x0a, 0x85, 0x89, 0x9b, 0x30, 0x69,
-	0x6a, 0xe6, 0x8e, 0xd6, 0xc1, 0x52, 0xaa, 0x90, 0x5e, 0x99, 0xa9, 0xe4, 0x4a, 0x7d, 0xa9, 0x97,
-	0xea, 0x44, 0xae, 0x68, 0x1f, 0x76, 0x35, 0x6c, 0x6f, 0x4c, 0x3f, 0xfc, 0x2d, 0x3f, 0xf6, 0xdd,
-	0xb3, 0xfb, 0x45, 0x41, 0xf1, 0x34, 0x45, 0x19, 0xa6, 0x4c, 0xcd, 0x56, 0x9b, 0xaa, 0xad, 0x4a,
-	0x69, 0xc7, 0xaf, 0xc2, 0xbe, 0xab, 0x2b, 0x9f, 0x3c, 0xbb, 0x5f, 0xa4, 0xce, 0xee, 0x3d, 0xbb,
-	0x5f, 0x74, 0x19, 0x5b, 0xc5, 0xcd, 0x0f, 0xca, 0x7c, 0xe8, 0x68, 0x1d, 0x8e, 0xf3, 0x2b, 0x8a,
-	0x86, 0xdb, 0x56, 0x0b, 0x6b, 0xe2, 0x22, 0x4c, 0x91, 0x68, 0x1a, 0x46, 0x93, 0xe4, 0x95, 0x51,
-	0x26, 0xc9, 0xf7, 0x56, 0x13, 0xfd, 0x29, 0xc0, 0x42, 0x1d, 0xeb, 0x37, 0xdb, 0x4d, 0x4f, 0xab,
-	0xee, 0x06, 0xf5, 0xbc, 0x4c, 0xf8, 0x9d, 0xa4, 0x38, 0x27, 0xe2, 0x36, 0x64, 0x69, 0xaa, 0x8d,
-	0x2e, 0xf1, 0x83, 0xa5, 0xf4, 0xf3, 0x72, 0x75, 0x84, 0x1a, 0xa0, 0x71, 0xe2, 0x6a, 0x99, 0x67,
-	0xa5, 0xc0, 0xb3, 0x12, 0xcc, 0x06, 0xe5, 0xe1, 0x74, 0xa8, 0xc0, 0xe3, 0x08, 0xfd, 0x24, 0xc0,
-	0x31, 0x1e, 0x71, 0x95, 0xa4, 0x35, 0x42, 0x1a, 0x2e, 0xc3, 0x74, 0x4b, 0xbb, 0xdb, 0xa0, 0xe6,
-	0xd2, 0x31, 0xe6, 0xa6, 0x5a, 0xda, 0x5d, 0x12, 0x41, 0x75, 0x95, 0xcf, 0x35, 0x17, 0x99, 0x2b,
-	0x81, 0xa3, 0xd3, 0x70, 0x32, 0x64, 0x99, 0xe5, 0xf9, 0xbd, 0x40, 0xda, 0x84, 0x63, 0x82, 0xb6,
-	0xda, 0x28, 0x53, 0x1d, 0xd4, 0xd1, 0x97, 0xf8, 0x7c, 0xce, 0x0c, 0xa8, 0x1d, 0xd5, 0x40, 0x05,
-	0xc8, 0x85, 0x4b, 0x58, 0x56, 0x5f, 0xa7, 0x60, 0x9e, 0x6f, 0xfe, 0x6d, 0x6b, 0xcf, 0xd8, 0x3d,
-	0xfc, 0x97, 0x72, 0x12, 0x55, 0x38, 0xda, 0xd4, 0x76, 0x0d, 0x6c, 0x58, 0xad, 0x46, 0x9b, 0x78,
-	0x96, 0x32, 0x05, 0x61, 0x65, 0xa6, 0x32, 0x5f, 0xa2, 0x73, 0xac, 0xe4, 0xcd, 0xb1, 0xd2, 0xd5,
-	0xd6, 0xe1, 0x06, 0x7a, 0xf4, 0x60, 0x35, 0xd7, 0xdf, 0xfb, 0xd7, 0x5c, 0x03, 0x34, 0x72, 0x25,
-	0xdb, 0xe4, 0xbe, 0xab, 0x95, 0xcf, 0xbe, 0xc9, 0x8f, 0xf1, 0xd4, 0xe5, 0x23, 0x87, 0x01, 0xd5,
-	0x41, 0x0a, 0x9c, 0x0a, 0x5b, 0x67, 0x83, 0xa1, 0x02, 0x93, 0x2a, 0x65, 0x21, 0x96, 0x1f, 0x0f,
-	0x88, 0x3e, 0x4d, 0xc1, 0x22, 0x5f, 0x0d, 0x6a, 0x74, 0xb8, 0xed, 0xf2, 0x3a, 0xcc, 0x53, 0xbe,
-	0x29, 0x6b, 0x0d, 0x2f, 0x9c, 0x54, 0x8c, 0xba, 0xa8, 0xfb, 0x3d, 0x13, 0xc9, 0xb0, 0xfb, 0x6b,
-	0x9d, 0x27, 0x75, 0x29, 0xb2, 0x1f, 0x7d, 0x79, 0xa2, 0xb3, 0x70, 0x26, 0x52, 0xc8, 0xba, 0xf2,
-	0x87, 0x34, 0x48, 0x3c, 0xff, 0xb7, 0x0c, 0xfb, 0xce, 0x90, 0x9d, 0x39, 0x92, 0x93, 0xe6, 0x1c,
-	0x64, 0x29, 0xdd, 0x7d, 0x9d, 0x7c, 0x44, 0xe7, 0x26, 0x41, 0x05, 0x16, 0xb8, 0xaa, 0x30, 0x74,
-	0x86, 0xa0, 0x8f, 0xf9, 0xc8, 0x67, 0x3a, 0x6b, 0x7d, 0x3a, 0x2a, 0x76, 0x2b, 0x31, 0x5e, 0x10,
-	0x56, 0xa6, 0xf8, 0x82, 0x61, 0xda, 0x2c, 0x21, 0xbb, 0x66, 0x62, 0xc4, 0xbb, 0xe6, 0x4a, 0x70,
-	0xd7, 0x9c, 0x8d, 0xdc, 0x35, 0xbd, 0xea, 0xa0, 0xcf, 0x05, 0x28, 0x44, 0x09, 0x13, 0x9c, 0xab,
-	0xa3, 0xec, 0x6b, 0xf4, 0x63, 0x0a, 0x50, 0x58, 0xb3, 0xf1, 0xa9, 0xff, 0xa7, 0x5b, 0x2f, 0xa4,
-	0x92, 0xe9, 0x11, 0x57, 0xb2, 0x1a, 0xac, 0xe4, 0x72, 0xe4, 0x56, 0xe5, 0x6d, 0xa1, 0x8b, 0x50,
-	0x8c, 0x27, 0x90, 0x6d, 0xdb, 0xbf, 0x04, 0x32, 0x36, 0x03, 0xf0, 0xa1, 0x0f, 0xca, 0x51, 0x32,
-	0x3d, 0xe8, 0x64, 0xbd, 0x92, 0x94, 0x1e, 0x3e, 0x1f, 0x74, 0x1e, 0x96, 0x06, 0xc9, 0x19, 0x31,
-	0x7f, 0xa4, 0x60, 0xae, 0x8e, 0xf5, 0xeb, 0xdd, 0x1d, 0xd3, 0xb0, 0xb7, 0x3b, 0x56, 0xdb, 0xc2,
-	0xea, 0x5e, 0x64, 0x76, 0xc2, 0x10, 0xd9, 0x9d, 0x82, 0xe9, 0x36, 0xb1, 0xeb, 0x8d, 0xb9, 0x69,
-	0xa5, 0xb7, 0x30, 0xf0, 0x04, 0xbe, 0xe4, 0xc8, 0x30, 0x56, 0x75, 0x0d, 0x4b, 0x19, 0x32, 0x1f,
-	0x43, 0x5b, 0x4f, 0x61, 0x28, 0xf1, 0x02, 0x64, 0xb4, 0x03, 0x6d, 0x97, 0xcc, 0xa7, 0x6c, 0x65,
-	0x21, 0x30, 0x4d, 0x6b, 0x07, 0xda, 0xae, 0x42, 0x20, 0xe2, 0x3c, 0x8c, 0xdb, 0x86, 0xbd, 0xa7,
-	0x91, 0xf1, 0x34, 0xad, 0xd0, 0x0f, 0x51, 0x82, 0x49, 0xdc, 0x35, 0x4d, 0xb5, 0x73, 0x28, 0x4d,
-	0x92, 0x75, 0xef, 0xb3, 0xfa, 0x82, 0xd7, 0xab, 0xbd, 0xe0, 0x9d, 0x82, 0x20, 0x5f, 0x41, 0xe8,
-	0xe3, 0x25, 0xc0, 0x26, 0x7a, 0x89, 0x9c, 0xae, 0xfc, 0x22, 0x1b, 0x38, 0x79, 0x98, 0x69, 0xbb,
-	0x6b, 0xbd, 0x99, 0x03, 0xde, 0xd2, 0x56, 0x13, 0x7d, 0x4b, 0x6f, 0xb1, 0xce, 0xac, 0x6a, 0x76,
-	0xd4, 0xbb, 0xac, 0x46, 0x71, 0x8a, 0xfe, 0x9b, 0x40, 0x2a, 0xe1, 0x4d, 0xa0, 0x7a, 0xd9, 0xc9,
-	0xd0, 0xfb, 0xea, 0x3f, 0x3a, 0x59, 0x7e, 0xfd, 0xb1, 0xb8, 0x17, 0xd4, 0xfe, 0x65, 0xd6, 0x64,
-	0x7f, 0x0b, 0x30, 0x59, 0xc7, 0xfa, 0xdb, 0x96, 0x1d, 0x9f, 0xaf, 0xb3, 0x13, 0xf7, 0x2d, 0x5b,
-	0xeb, 0xc4, 0x06, 0x4d, 0x61, 0xe2, 0x3a, 0x4c, 0x58, 0x6d, 0xdb, 0xb0, 0xe8, 0xfd, 0x20, 0x5b,
-	0x39, 0x19, 0xa8, 0xba, 0xe3, 0xf7, 0x2d, 0x02, 0x51, 0x5c, 0x28, 0xd7, 0x76, 0x99, 0xbe, 0xb6,
-	0x4b, 0xde, 0x44, 0xd5, 0x65, 0xb2, 0x3b, 0x49, 0x1c, 0x0e, 0x59, 0x52, 0x18, 0x59, 0x8e, 0x77,
-	0x34, 0x07, 0x47, 0xdd, 0x7f, 0x19, 0x29, 0xf7, 0x28, 0x29, 0x8e, 0xb5, 0x78, 0x52, 0xfe, 0x0f,
-	0x53, 0x8e, 0xc3, 0xae, 0x6d, 0xc5, 0xf3, 0xc2, 0x90, 0xf4, 0xa1, 0x39, 0x81, 0x0d, 0xbd, 0x35,
-	0x20, 0x3e, 0x27, 0x00, 0xa4, 0x90, 0xf8, 0x48, 0x66, 0x5e, 0x63, 0xbe, 0x02, 0x13, 0x1d, 0x0d,
-	0x77, 0xf7, 0x6c, 0xe2, 0x30, 0x5b, 0x59, 0x0e, 0x10, 0xe1, 0xd5, 0xb9, 0xe6, 0xfa, 0x53, 0x08,
-	0x5c, 0x71, 0xd5, 0xd0, 0x17, 0x02, 0x1c, 0xa9, 0x63, 0xfd, 0x0d, 0x4d, 0xdd, 0x77, 0x5f, 0xe2,
-	0x43, 0xdc, 0x4d, 0x07, 0xdc, 0xde, 0xe9, 0x8b, 0xd1, 0xdf, 0xac, 0xb9, 0xb0, 0xfc, 0x7a, 0xfe,
-	0xd1, 0x09, 0xf2, 0x30, 0xee, 0x2d, 0x78, 0xb9, 0x16, 0x8b, 0x90, 0xa9, 0xd1, 0xa1, 0x30, 0x5b,
-	0x7b, 0xa7, 0xb6, 0xd9, 0xb8, 0xf9, 0xe6, 0xf5, 0xed, 0xda, 0xe6, 0xd6, 0xab, 0x5b, 0xb5, 0x6b,
-	0xb3, 0x63, 0xe2, 0xff, 0x60, 0x8a, 0xac, 0xde, 0x50, 0xde, 0x9d, 0x15, 0x2a, 0x8f, 0x66, 0x20,
-	0x5d, 0xc7, 0xba, 0x78, 0x0b, 0x66, 0xfc, 0xbf, 0x32, 0xe4, 0x83, 0x57, 0x37, 0xee, 0xae, 0x21,
-	0x2f, 0xc7, 0x00, 0x18, 0xf1, 0x7b, 0x20, 0x86, 0xbc, 0xdd, 0xcf, 0x87, 0xa9, 0x07, 0x71, 0x72,
-	0x29, 0x19, 0x8e, 0x79, 0xbb, 0x0d, 0xb3, 0x81, 0x07, 0xf2, 0x52, 0x8c, 0x0d, 0x82, 0x92, 0x2f,
-	0x26, 0x41, 0x31, 0x3f, 0x16, 0x1c, 0x0b, 0x7b, 0xa0, 0x2e, 0xc7, 0x86, 0x4b, 0x81, 0x72, 0x39,
-	0x21, 0x90, 0x39, 0x34, 0x60, 0x2e, 0xf8, 0x76, 0x3c, 0x17, 0x53, 0x04, 0x0a, 0x93, 0x57, 0x13,
-	0xc1, 0x98, 0xab, 0x2e, 0x2c, 0x84, 0x3f, 0x08, 0x2e, 0xc4, 0xd8, 0xe9, 0x41, 0xe5, 0xb5, 0xc4,
-	0x50, 0xe6, 0xf6, 0x00, 0x8e, 0x47, 0x3c, 0xd9, 0x8a, 0x31, 0x64, 0xf9, 0xb0, 0x72, 0x25, 0x39,
-	0x96, 0x79, 0xfe, 0x4a, 0x80, 0x7c, 0xdc, 0xdd, 0x75, 0x3d, 0x91, 0x5d, 0x5e, 0x49, 0x7e, 0x71,
-	0x08, 0x25, 0x16, 0xd5, 0xc7, 0x02, 0x2c, 0x46, 0xdf, 0xf0, 0x56, 0x13, 0x99, 0x66, 0xfd, 0x76,
-	0xf9, 0xb9, 0xe0, 0x2c, 0x86, 0xf7, 0x21, 0xdb, 0x77, 0x97, 0x42, 0x61, 0x86, 0x78, 0x8c, 0x5c,
-	0x8c, 0xc7, 0xf8, 0x37, 0x6c, 0xe0, 0x2e, 0x10, 0xba, 0x61, 0xfb, 0x51, 0xe1, 0x1b, 0x36, 0xea,
-	0xd0, 0x16, 0x37, 0x20, 0x43, 0x0e, 0x6c, 0x29, 0x4c, 0xcb, 0x91, 0xc8, 0x85, 0x28, 0x89, 0xdf,
-	0x06, 0x99, 0xab, 0xa1, 0x36, 0x1c, 0x49, 0xb8, 0x0d, 0xee, 0x1c, 0xba, 0x01, 0xe0, 0x3b, 0x42,
-	0x72, 0x61, 0xf8, 0x9e, 0x5c, 0x3e, 0x3f, 0x58, 0xee, 0x59, 0x95, 0xc7, 0x3f, 0x72, 0x5e, 0xd1,
-	0x1b, 0x2f, 0x3f, 0x7c, 0x92, 0x13, 0x1e, 0x3f, 0xc9, 0x09, 0xbf, 0x3f, 0xc9, 0x09, 0x5f, 0x3e,
-	0xcd, 0x8d, 0x3d, 0x7e, 0x9a, 0x1b, 0xfb, 0xf5, 0x69, 0x6e, 0xec, 0xbd, 0x25, 0xdd, 0xb0, 0xef,
-	0x74, 0x77, 0x4a, 0xbb, 0x96, 0xe9, 0xfe, 0x8a, 0x5d, 0xf6, 0x9d, 0x2e, 0x07, 0xf4, 0x7c, 0xd9,
-	0x99, 0x20, 0x17, 0xd1, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x77, 0x54, 0x7d, 0x37,
-	0x17, 0x00, 0x00,
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0xc6, 0x49, 0xb6, 0x49, 0xbb, 0xd9, 0xb6, 0x8e, 0xbb, 0x4d,
+	0x9b, 0xd4, 0x6a, 0xec, 0xc6, 0x69, 0x2b, 0x7d, 0xfd, 0xfd, 0xea, 0x8b, 0x9a, 0xd4, 0xa0, 0x20,
+	0x0c, 0x61, 0xd3, 0x52, 0xe0, 0x62, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xed, 0x35, 0x9e, 0x75, 0x7e,
+	0x5c, 0x10, 0x3f, 0x2e, 0xd0, 0x0b, 0x48, 0x70, 0x07, 0x6e, 0x1c, 0x0b, 0xea, 0x9d, 0x1b, 0xaa,
+	0x7a, 0x2a, 0x9c, 0x38, 0x21, 0xd4, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda, 0x99, 0xdd, 0xc9, 0xfe,
+	0xf4, 0x3a, 0xc6, 0x82, 0x4b, 0xe4, 0x99, 0xf7, 0x79, 0xef, 0xcd, 0xfb, 0xbc, 0x37, 0x6f, 0x66,
+	0x36, 0x20, 0xd4, 0x0c, 0xdc, 0x30, 0x70, 0x41, 0x6b, 0x1b, 0x9d, 0x56, 0x61, 0x6f, 0xb5, 0x60,
+	0x1e, 0xe4, 0x5b, 0x6d, 0xc3, 0x34, 0xf8, 0x29, 0x2a, 0xc9, 0x13, 0x49, 0x7e, 0x6f, 0x55, 0x9c,
+	0xd5, 0x0c, 0xcd, 0x20, 0xb2, 0x82, 0xf5, 0x8b, 0xc2, 0xc4, 0x79, 0x0a, 0xab, 0x52, 0x81, 0xad,
+	0x63, 0x8b, 0x34, 0xc3, 0xd0, 0xea, 0xa8, 0x40, 0x46, 0x3b, 0x9d, 0x7b, 0x05, 0xa5, 0x79, 0x68,
+	0x8b, 0xce, 0x04, 0xdc, 0x1e, 0xb6, 0x90, 0xa3, 0x77, 0xda, 0x16, 0x36, 0xb0, 0x66, 0x89, 0x1a,
+	0x58, 0xb3, 0x05, 0x33, 0x4a, 0x43, 0x6f, 0x1a, 0x05, 0xf2, 0x97, 0x4e, 0x49, 0xdf, 0x27, 0x20,
+	0x5d, 0xc1, 0xda, 0x46, 0x1b, 0x29, 0x26, 0x7a, 0xc9, 0xb2, 0xc6, 0xe7, 0x61, 0x58, 0x51, 0x1b,
+	0x7a, 0x53, 0xe0, 0xb2, 0xdc, 0xf2, 0xf8, 0xba, 0xf0, 0xf3, 0xc3, 0x95, 0x59, 0x7b, 0x5d, 0x37,
+	0x55, 0xb5, 0x8d, 0x30, 0xde, 0x36, 0xdb, 0x7a, 0x53, 0x93, 0x29, 0x8c, 0xdf, 0x80, 0xd1, 0x06,
+	0x6a, 0xec, 0xa0, 0x36, 0x16, 0x12, 0xd9, 0xe4, 0xf2, 0x44, 0x31, 0x93, 0xf7, 0x85, 0x9e, 0xaf,
+	0x10, 0xb9, 0x8c, 0xde, 0xeb, 0x20, 0x6c, 0xae, 0x8f, 0x3f, 0xfa, 0x75, 0x61, 0xe8, 0xdb, 0xe7,
+	0x0f, 0x72, 0x9c, 0xec, 0x68, 0xf2, 0x22, 0x8c, 0x35, 0x90, 0xa9, 0xa8, 0x8a, 0xa9, 0x08, 0x49,
+	0xcb, 0xaf, 0xcc, 0xc6, 0xfc, 0x3a, 0x4c, 0xee, 0x23, 0x5d, 0xdb, 0x35, 0xab, 0xd8, 0xe8, 0xb4,
+	0x6b, 0x48, 0x48, 0x65, 0xb9, 0xe5, 0x74, 0xf1, 0x5c, 0xc0, 0xcd, 0x5d, 0x82, 0xda, 0x26, 0x20,
+	0xf9, 0xc4, 0xbe, 0x6b, 0xc4, 0x9f, 0x07, 0x7b, 0x5c, 0x55, 0x51, 0xd3, 0x68, 0x08, 0xc3, 0xc4,
+	0xc7, 0x04, 0x9d, 0xbb, 0x65, 0x4d, 0x95, 0x96, 0x3f, 0x7a, 0xfe, 0x20, 0x47, 0x63, 0xba, 0xff,
+	0xfc, 0x41, 0xce, 0x4e, 0xcc, 0x0a, 0x56, 0xdf, 0x2d, 0x78, 0x19, 0x92, 0xd6, 0xe0, 0x94, 0x77,
+	0x46, 0x46, 0xb8, 0x65, 0x34, 0x31, 0xe2, 0xe7, 0x61, 0x8c, 0xac, 0xa6, 0xaa, 0xab, 0x84, 0xbe,
+	0x94, 0x3c, 0x4a, 0xc6, 0x9b, 0xaa, 0xf4, 0x3b, 0x07, 0x73, 0x15, 0xac, 0xdd, 0x69, 0xa9, 0x8e,
+	0x56, 0xc5, 0x8e, 0xfd, 0xb8, 0x84, 0xbb, 0x9d, 0x24, 0x3c, 0x4e, 0xf8, 0x2d, 0x48, 0x53, 0x46,
+	0xab, 0x1d, 0xe2, 0x07, 0x0b, 0xc9, 0xe3, 0xa6, 0x64, 0x92, 0x1a, 0xa0, 0xeb, 0xc4, 0xa5, 0x82,
+	0x97, 0x95, 0xac, 0x97, 0x95, 0x60, 0x34, 0xd2, 0x02, 0x9c, 0x0b, 0x15, 0x38, 0x1c, 0x49, 0x3f,
+	0x72, 0x70, 0xd2, 0x8b, 0xb8, 0x49, 0xc2, 0x1a, 0x20, 0x0d, 0xd7, 0x61, 0xbc, 0x89, 0xf6, 0xab,
+	0xd4, 0x5c, 0x32, 0xc6, 0xdc, 0x58, 0x13, 0xed, 0x93, 0x15, 0x94, 0x56, 0xbc, 0xb1, 0x66, 0x22,
+	0x63, 0x25, 0x70, 0xe9, 0x1c, 0x9c, 0x09, 0x99, 0x66, 0x71, 0x7e, 0xc7, 0xc1, 0x29, 0xaf, 0xbc,
+	0xe2, 0x54, 0xf4, 0x00, 0x43, 0xed, 0xb2, 0x71, 0x4a, 0x57, 0xbd, 0xf1, 0x9c, 0xef, 0x92, 0x3b,
+	0xaa, 0x21, 0x65, 0x21, 0x13, 0x2e, 0x61, 0x51, 0x7d, 0x99, 0x80, 0x59, 0x6f, 0xf1, 0x6f, 0x19,
+	0x75, 0xbd, 0x76, 0xf8, 0x0f, 0xc5, 0xc4, 0x2b, 0x30, 0xa5, 0xa2, 0x9a, 0x8e, 0x75, 0xa3, 0x59,
+	0x6d, 0x11, 0xcf, 0xa4, 0x1d, 0x4c, 0x14, 0x67, 0xf3, 0xb4, 0x5d, 0xe6, 0x9d, 0x76, 0x99, 0xbf,
+	0xd9, 0x3c, 0x5c, 0x97, 0x1e, 0x3f, 0x5c, 0xc9, 0xf8, 0x6b, 0xff, 0x96, 0x6d, 0x80, 0xae, 0x5c,
+	0x4e, 0xab, 0x9e, 0x71, 0xa9, 0xf8, 0xc9, 0xd7, 0x0b, 0x43, 0x5e, 0xea, 0x16, 0x22, 0x9b, 0x01,
+	0xd5, 0x91, 0x64, 0x38, 0x1b, 0x36, 0xcf, 0x1a, 0x43, 0x11, 0x46, 0x15, 0xca, 0x42, 0x2c, 0x3f,
+	0x0e, 0x50, 0xfa, 0x38, 0x01, 0xf3, 0xde, 0x6c, 0x50, 0xa3, 0xfd, 0x6d, 0x97, 0x97, 0x61, 0x96,
+	0xf2, 0x4d, 0x59, 0xab, 0x3a, 0xcb, 0x49, 0xc4, 0xa8, 0xf3, 0x9a, 0xdb, 0x33, 0x91, 0xf4, 0xbb,
+	0xbf, 0xd6, 0xbc, 0xa4, 0x2e, 0x46, 0xd6, 0xa3, 0x2b, 0x4e, 0xe9, 0x02, 0x9c, 0x8f, 0x14, 0xb2,
+	0xaa, 0xfc, 0x2a, 0x05, 0x82, 0x97, 0xff, 0xbb, 0xba, 0xb9, 0xdb, 0x67, 0x65, 0x0e, 0xe4, 0x40,
+	0xbb, 0x08, 0x69, 0x4a, 0xb7, 0xaf, 0x92, 0x27, 0x35, 0x4f, 0x27, 0x28, 0xc2, 0x9c, 0x27, 0x2b,
+	0x0c, 0x9d, 0x22, 0xe8, 0x93, 0x2e, 0xf2, 0x99, 0xce, 0xaa, 0x4f, 0x47, 0xc1, 0x76, 0x26, 0xac,
+	0x43, 0x6d, 0xcc, 0x9b, 0x30, 0x4c, 0x8b, 0x25, 0x64, 0xd7, 0x8c, 0x0c, 0x76, 0xd7, 0x04, 0x4f,
+	0xe9, 0xd1, 0xbf, 0x7f, 0x4a, 0x8f, 0x05, 0x4f, 0xe9, 0x1b, 0xc1, 0xcd, 0x79, 0x21, 0x72, 0x73,
+	0x1e, 0x15, 0x81, 0xf4, 0x29, 0x07, 0xd9, 0x28, 0x61, 0x0f, 0xc7, 0xf7, 0x20, 0xb7, 0x8f, 0xf4,
+	0x43, 0x02, 0xa4, 0xb0, 0x9a, 0xf6, 0x32, 0xfc, 0xaf, 0xee, 0xf0, 0x90, 0x82, 0x49, 0x0e, 0xb8,
+	0xcd, 0x96, 0x82, 0x99, 0x5c, 0x8a, 0xec, 0x08, 0x5e, 0x5b, 0xd2, 0x15, 0xc8, 0xc5, 0x13, 0xc8,
+	0xba, 0xc3, 0x1f, 0x1c, 0x9c, 0x0d, 0x83, 0xf7, 0x7d, 0x1e, 0x0f, 0x92, 0xe9, 0x6e, 0x07, 0xf8,
+	0x8d, 0x5e, 0xe9, 0xf1, 0xc6, 0x23, 0x5d, 0x82, 0xc5, 0x6e, 0x72, 0x46, 0xcc, 0xc3, 0x24, 0xcc,
+	0x54, 0xb0, 0xb6, 0xdd, 0xd9, 0x69, 0xe8, 0xe6, 0x56, 0xdb, 0x68, 0x19, 0x58, 0xa9, 0x47, 0x46,
+	0xc7, 0xf5, 0x11, 0xdd, 0x59, 0x18, 0x6f, 0x11, 0xbb, 0x4e, 0x37, 0x1d, 0x97, 0x8f, 0x26, 0xba,
+	0x1e, 0xf4, 0x57, 0x2d, 0x19, 0xc6, 0x8a, 0x86, 0xb0, 0x90, 0xca, 0x26, 0xa3, 0x4a, 0x4f, 0x66,
+	0x28, 0xfe, 0x32, 0xa4, 0xd0, 0x01, 0xaa, 0x91, 0x36, 0x98, 0x2e, 0xce, 0x05, 0x1a, 0x4f, 0xf9,
+	0x00, 0xd5, 0x64, 0x02, 0xe1, 0x67, 0x61, 0xd8, 0xd4, 0xcd, 0x3a, 0x22, 0x5d, 0x70, 0x5c, 0xa6,
+	0x03, 0x5e, 0x80, 0x51, 0xdc, 0x69, 0x34, 0x94, 0xf6, 0x21, 0x69, 0x5e, 0xe3, 0xb2, 0x33, 0xe4,
+	0x5f, 0x07, 0xde, 0xd2, 0xeb, 0x98, 0xd6, 0x7e, 0xc0, 0xb5, 0x5d, 0xa4, 0x76, 0xea, 0x88, 0xb4,
+	0xa7, 0x89, 0xa2, 0x14, 0xea, 0x88, 0x40, 0xb7, 0x6d, 0xa4, 0x3c, 0x83, 0xfc, 0x53, 0xa5, 0xff,
+	0x38, 0xe5, 0x7f, 0xc4, 0x87, 0x95, 0x63, 0xc9, 0x95, 0x63, 0xfa, 0xba, 0x0b, 0x24, 0x48, 0xfa,
+	0x1f, 0xcc, 0x07, 0x26, 0x59, 0x0f, 0x5b, 0x80, 0x89, 0x96, 0x3d, 0x77, 0xd4, 0xc6, 0xc0, 0x99,
+	0xda, 0x54, 0xa5, 0x6f, 0xe8, 0xfd, 0xdb, 0x6a, 0x7f, 0x6a, 0x5b, 0xd9, 0x67, 0x69, 0x8f, 0x53,
+	0x74, 0xdf, 0x61, 0x12, 0x3d, 0xde, 0x61, 0x4a, 0xd7, 0xad, 0x08, 0x9d, 0x91, 0xff, 0xd0, 0x67,
+	0xf1, 0xf9, 0xd7, 0x62, 0x5f, 0xad, 0xfd, 0xd3, 0xac, 0x6e, 0xff, 0xe4, 0x60, 0xb4, 0x82, 0xb5,
+	0x37, 0x0c, 0x33, 0x3e, 0x5e, 0x6b, 0x73, 0xef, 0x19, 0x26, 0x6a, 0xc7, 0x2e, 0x9a, 0xc2, 0xf8,
+	0x35, 0x18, 0x31, 0x5a, 0x56, 0xaa, 0x48, 0x49, 0xa6, 0x8b, 0x67, 0x02, 0xf9, 0xb5, 0xfc, 0xbe,
+	0x46, 0x20, 0xb2, 0x0d, 0xf5, 0x54, 0x72, 0xca, 0x57, 0xc9, 0xbd, 0xd7, 0x65, 0x69, 0x89, 0x6c,
+	0x78, 0xb2, 0x0e, 0x8b, 0x2c, 0x21, 0x8c, 0x2c, 0xcb, 0xbb, 0x34, 0x03, 0x53, 0xf6, 0x4f, 0x46,
+	0xca, 0x7d, 0x4a, 0x8a, 0x65, 0x2d, 0x9e, 0x94, 0x6b, 0x30, 0x46, 0x4b, 0xd2, 0x88, 0xe7, 0x85,
+	0x21, 0xe9, 0x13, 0x79, 0x04, 0xeb, 0x5a, 0xb3, 0xcb, 0xfa, 0xac, 0x05, 0x48, 0x32, 0x4c, 0xd9,
+	0x3f, 0x59, 0x61, 0xbe, 0x00, 0x23, 0x6d, 0x84, 0x3b, 0x75, 0x93, 0x38, 0x4c, 0x17, 0x97, 0x02,
+	0x44, 0x38, 0x79, 0x2e, 0xdb, 0xfe, 0x64, 0x02, 0x97, 0x6d, 0x35, 0xe9, 0x33, 0x0e, 0x26, 0x2b,
+	0x58, 0x7b, 0x05, 0x29, 0x7b, 0xf6, 0xa7, 0x8a, 0x3e, 0x6e, 0xd5, 0x5d, 0xde, 0x1d, 0xf4, 0xad,
+	0xeb, 0x2e, 0xd6, 0x4c, 0x58, 0x7c, 0x47, 0xfe, 0xa5, 0xd3, 0x30, 0xe7, 0x99, 0x60, 0xb9, 0xf8,
+	0x89, 0x23, 0x05, 0xbc, 0xa1, 0x34, 0x6b, 0xa8, 0xee, 0x6c, 0x79, 0x95, 0xf5, 0x85, 0x81, 0xb6,
+	0x58, 0x5f, 0xae, 0x13, 0xfe, 0x5c, 0x97, 0xca, 0x56, 0x58, 0xa1, 0xfe, 0xfc, 0x87, 0x0a, 0x8b,
+	0x31, 0x64, 0xe1, 0xd2, 0x45, 0xb8, 0xd0, 0x25, 0x24, 0x27, 0xf4, 0x5c, 0x0e, 0x52, 0x65, 0xda,
+	0x62, 0xa7, 0xcb, 0x6f, 0x96, 0x37, 0xaa, 0x77, 0x5e, 0xdd, 0xde, 0x2a, 0x6f, 0x6c, 0xbe, 0xb8,
+	0x59, 0xbe, 0x35, 0x3d, 0xc4, 0x9f, 0x80, 0x31, 0x32, 0x7b, 0x5b, 0x7e, 0x6b, 0x9a, 0x2b, 0x3e,
+	0x3e, 0x01, 0xc9, 0x0a, 0xd6, 0xf8, 0xbb, 0x30, 0xe1, 0xfe, 0x02, 0xb5, 0x10, 0xbc, 0x6f, 0x7b,
+	0x6e, 0x6e, 0xe2, 0x52, 0x0c, 0x80, 0xd5, 0x5c, 0x1d, 0xf8, 0x90, 0x0f, 0x2e, 0x97, 0xc2, 0xd4,
+	0x83, 0x38, 0x31, 0xdf, 0x1b, 0x8e, 0x79, 0xbb, 0x07, 0xd3, 0x81, 0xaf, 0x1a, 0x8b, 0x31, 0x36,
+	0x08, 0x4a, 0xbc, 0xd2, 0x0b, 0x8a, 0xf9, 0x31, 0xe0, 0x64, 0xd8, 0x57, 0x85, 0xa5, 0xd8, 0xe5,
+	0x52, 0xa0, 0x58, 0xe8, 0x11, 0xc8, 0x1c, 0xea, 0x30, 0x13, 0x7c, 0xf0, 0x5f, 0x8c, 0x49, 0x02,
+	0x85, 0x89, 0x2b, 0x3d, 0xc1, 0x98, 0xab, 0x0e, 0xcc, 0x85, 0xbf, 0xe2, 0x2e, 0xc7, 0xd8, 0x39,
+	0x82, 0x8a, 0xab, 0x3d, 0x43, 0x99, 0xdb, 0x03, 0x38, 0x15, 0xf1, 0xce, 0xce, 0xc5, 0x90, 0xe5,
+	0xc2, 0x8a, 0xc5, 0xde, 0xb1, 0xcc, 0xf3, 0x17, 0x1c, 0x2c, 0xc4, 0xbd, 0x04, 0xd6, 0x7a, 0xb2,
+	0xeb, 0x55, 0x12, 0xff, 0xdb, 0x87, 0x12, 0x5b, 0xd5, 0x87, 0x1c, 0xcc, 0x47, 0xdf, 0x97, 0x57,
+	0x7a, 0x32, 0xcd, 0xea, 0xed, 0xfa, 0xb1, 0xe0, 0x6c, 0x0d, 0xef, 0x40, 0xda, 0x77, 0x33, 0x95,
+	0xc2, 0x0c, 0x79, 0x31, 0x62, 0x2e, 0x1e, 0xe3, 0xde, 0xb0, 0x81, 0x6b, 0x50, 0xe8, 0x86, 0xf5,
+	0xa3, 0xc4, 0x2b, 0xbd, 0xa0, 0x98, 0x9f, 0x75, 0x48, 0x91, 0xbb, 0x8a, 0x10, 0xa6, 0x65, 0x49,
+	0xc4, 0x6c, 0x94, 0xc4, 0x6d, 0x83, 0xf4, 0xd5, 0x50, 0x1b, 0x96, 0x44, 0xcc, 0x46, 0x49, 0x98,
+	0x8d, 0xdb, 0x00, 0xae, 0xd3, 0x33, 0x13, 0x86, 0x3f, 0x92, 0x8b, 0x97, 0xba, 0xcb, 0x99, 0xd5,
+	0xf7, 0x41, 0x88, 0x3c, 0xe8, 0x42, 0x79, 0x8a, 0x42, 0x8b, 0xd7, 0x8e, 0x83, 0x76, 0xfc, 0x8b,
+	0xc3, 0x1f, 0x58, 0x9f, 0x5e, 0xd6, 0xff, 0xff, 0xe8, 0x69, 0x86, 0x7b, 0xf2, 0x34, 0xc3, 0xfd,
+	0xf6, 0x34, 0xc3, 0x7d, 0xfe, 0x2c, 0x33, 0xf4, 0xe4, 0x59, 0x66, 0xe8, 0x97, 0x67, 0x99, 0xa1,
+	0xb7, 0x17, 0x35, 0xdd, 0xdc, 0xed, 0xec, 0xe4, 0x6b, 0x46, 0xc3, 0xfe, 0x0f, 0x4b, 0xc1, 0x75,
+	0xe8, 0x1d, 0xd0, 0x63, 0x6f, 0x67, 0x84, 0x3c, 0x2b, 0xd6, 0xfe, 0x1a, 0x00, 0xfe, 0x61, 0x86,
+	0x6a, 0xd3, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1638,6 +1773,10 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// CancelScheduledExecution cancels the recurring execution of an accepted
+	// proposal. It must be signed by the group policy account, i.e. it is
+	// meant to be executed through a follow-up proposal.
+	CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -1774,6 +1913,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelScheduledExecution(ctx context.Context, in *MsgCancelScheduledExecution, opts ...grpc.CallOption) (*MsgCancelScheduledExecutionResponse, error) {
+	out := new(MsgCancelScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/CancelScheduledExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1804,6 +1952,10 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// CancelScheduledExecution cancels the recurring execution of an accepted
+	// proposal. It must be signed by the group policy account, i.e. it is
+	// meant to be executed through a follow-up proposal.
+	CancelScheduledExecution(context.Context, *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1852,6 +2004,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) CancelScheduledExecution(ctx context.Context, req *MsgCancelScheduledExecution) (*MsgCancelScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledExecution not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2109,6 +2264,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/CancelScheduledExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledExecution(ctx, req.(*MsgCancelScheduledExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2169,6 +2342,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "CancelScheduledExecution",
+			Handler:    _Msg_CancelScheduledExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -2194,6 +2371,18 @@ func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WeightDenom) > 0 {
+		i -= len(m.WeightDenom)
+		copy(dAtA[i:], m.WeightDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WeightDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WeightSource != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WeightSource))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -2626,6 +2815,18 @@ func (m *MsgCreateGroupWithPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.WeightDenom) > 0 {
+		i -= len(m.WeightDenom)
+		copy(dAtA[i:], m.WeightDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WeightDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.WeightSource != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WeightSource))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.DecisionPolicy != nil {
 		{
 			size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
@@ -2880,6 +3081,18 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionSchedule != nil {
+		{
+			size, err := m.ExecutionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -3221,6 +3434,64 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelScheduledExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3252,6 +3523,13 @@ func (m *MsgCreateGroup) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.WeightSource != 0 {
+		n += 1 + sovTx(uint64(m.WeightSource))
+	}
+	l = len(m.WeightDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3454,6 +3732,13 @@ func (m *MsgCreateGroupWithPolicy) Size() (n int) {
 		l = m.DecisionPolicy.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.WeightSource != 0 {
+		n += 1 + sovTx(uint64(m.WeightSource))
+	}
+	l = len(m.WeightDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3570,6 +3855,10 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExecutionSchedule != nil {
+		l = m.ExecutionSchedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3698,6 +3987,31 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelScheduledExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3831,6 +4145,57 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
+			}
+			m.WeightSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightSource |= WeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5134,6 +5499,57 @@ func (m *MsgCreateGroupWithPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
+			}
+			m.WeightSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightSource |= WeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5894,6 +6310,42 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionSchedule == nil {
+				m.ExecutionSchedule = &ExecutionSchedule{}
+			}
+			if err := m.ExecutionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6677,6 +7129,157 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelScheduledExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
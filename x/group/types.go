@@ -308,6 +308,9 @@ func (g GroupInfo) ValidateBasic() error {
 	if g.Version == 0 {
 		return sdkerrors.Wrap(errors.ErrEmpty, "version")
 	}
+	if err := ValidateWeightSource(g.WeightSource, g.WeightDenom); err != nil {
+		return sdkerrors.Wrap(err, "group")
+	}
 	return nil
 }
 
@@ -411,6 +414,16 @@ func (g Proposal) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrap(err, "proposal FinalTallyResult veto count")
 	}
+	if g.ExecutionSchedule != nil {
+		if err := g.ExecutionSchedule.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal execution schedule")
+		}
+	}
+	if g.SnapshotTotalWeight != "" {
+		if _, err := math.NewPositiveDecFromString(g.SnapshotTotalWeight); err != nil {
+			return sdkerrors.Wrap(err, "proposal snapshot total weight")
+		}
+	}
 	return nil
 }
 
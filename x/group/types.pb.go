@@ -72,6 +72,41 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
 }
 
+// WeightSource defines where the voting weights of a group's members come from.
+type WeightSource int32
+
+const (
+	// WEIGHT_SOURCE_UNSPECIFIED defines static voting weights, set by the group
+	// admin through the members' weights.
+	WEIGHT_SOURCE_UNSPECIFIED WeightSource = 0
+	// WEIGHT_SOURCE_STAKING defines voting weights equal to the amount of tokens
+	// the members have delegated to bonded validators.
+	WEIGHT_SOURCE_STAKING WeightSource = 1
+	// WEIGHT_SOURCE_BALANCE defines voting weights equal to the members' balance
+	// of the group's weight_denom.
+	WEIGHT_SOURCE_BALANCE WeightSource = 2
+)
+
+var WeightSource_name = map[int32]string{
+	0: "WEIGHT_SOURCE_UNSPECIFIED",
+	1: "WEIGHT_SOURCE_STAKING",
+	2: "WEIGHT_SOURCE_BALANCE",
+}
+
+var WeightSource_value = map[string]int32{
+	"WEIGHT_SOURCE_UNSPECIFIED": 0,
+	"WEIGHT_SOURCE_STAKING":     1,
+	"WEIGHT_SOURCE_BALANCE":     2,
+}
+
+func (x WeightSource) String() string {
+	return proto.EnumName(WeightSource_name, int32(x))
+}
+
+func (WeightSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+}
+
 // ProposalStatus defines proposal statuses.
 type ProposalStatus int32
 
@@ -117,7 +152,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +188,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -501,6 +536,14 @@ type GroupInfo struct {
 	TotalWeight string `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3" json:"total_weight,omitempty"`
 	// created_at is a timestamp specifying when a group was created.
 	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// weight_source defines where the voting weights of the group members come
+	// from. For groups with a dynamic weight source, the members' weights only
+	// define the group membership, and the voting weights are snapshotted at
+	// proposal submission.
+	WeightSource WeightSource `protobuf:"varint,7,opt,name=weight_source,json=weightSource,proto3,enum=cosmos.group.v1.WeightSource" json:"weight_source,omitempty"`
+	// weight_denom is the denom whose balance defines the members' voting
+	// weights, only set when weight_source is WEIGHT_SOURCE_BALANCE.
+	WeightDenom string `protobuf:"bytes,8,opt,name=weight_denom,json=weightDenom,proto3" json:"weight_denom,omitempty"`
 }
 
 func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
@@ -578,6 +621,20 @@ func (m *GroupInfo) GetCreatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *GroupInfo) GetWeightSource() WeightSource {
+	if m != nil {
+		return m.WeightSource
+	}
+	return WEIGHT_SOURCE_UNSPECIFIED
+}
+
+func (m *GroupInfo) GetWeightDenom() string {
+	if m != nil {
+		return m.WeightDenom
+	}
+	return ""
+}
+
 // GroupMember represents the relationship between a group and a member.
 type GroupMember struct {
 	// group_id is the unique ID of the group.
@@ -737,6 +794,16 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// execution_schedule, if set, defines the recurring execution schedule of
+	// the proposal messages. Once the proposal is accepted, its messages are
+	// executed automatically in the group EndBlocker following this schedule
+	// instead of being executed once through MsgExec.
+	ExecutionSchedule *ExecutionSchedule `protobuf:"bytes,15,opt,name=execution_schedule,json=executionSchedule,proto3" json:"execution_schedule,omitempty"`
+	// snapshot_total_weight is the sum of the voting weights of the group
+	// members, snapshotted at proposal submission. It is only set for proposals
+	// of groups with a dynamic weight source, and is used instead of the group
+	// total weight by the decision policy.
+	SnapshotTotalWeight string `protobuf:"bytes,16,opt,name=snapshot_total_weight,json=snapshotTotalWeight,proto3" json:"snapshot_total_weight,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -772,6 +839,292 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// MemberWeightSnapshot is the voting weight of a member of a group with a
+// dynamic weight source, snapshotted at proposal submission.
+type MemberWeightSnapshot struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the member's account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the member's voting weight at proposal submission.
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *MemberWeightSnapshot) Reset()         { *m = MemberWeightSnapshot{} }
+func (m *MemberWeightSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MemberWeightSnapshot) ProtoMessage()    {}
+func (*MemberWeightSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *MemberWeightSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberWeightSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberWeightSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberWeightSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberWeightSnapshot.Merge(m, src)
+}
+func (m *MemberWeightSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberWeightSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberWeightSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberWeightSnapshot proto.InternalMessageInfo
+
+func (m *MemberWeightSnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MemberWeightSnapshot) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MemberWeightSnapshot) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// ExecutionSchedule defines when, and how many times, the messages of an
+// accepted proposal are executed.
+type ExecutionSchedule struct {
+	// start_time is the earliest time at which the first execution can happen.
+	// The first execution will never happen before the end of the decision
+	// policy's min_execution_period.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// interval is the duration between two consecutive executions. It must be
+	// positive unless count is 1.
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// count is the total number of executions. It must be positive.
+	Count uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// gas_limit is the maximum amount of gas a single execution can consume.
+	// If not set, the app-specific max_scheduled_execution_gas config,
+	// defined in the keeper, is used. It cannot exceed that config value.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *ExecutionSchedule) Reset()         { *m = ExecutionSchedule{} }
+func (m *ExecutionSchedule) String() string { return proto.CompactTextString(m) }
+func (*ExecutionSchedule) ProtoMessage()    {}
+func (*ExecutionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+}
+func (m *ExecutionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionSchedule.Merge(m, src)
+}
+func (m *ExecutionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionSchedule proto.InternalMessageInfo
+
+func (m *ExecutionSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ExecutionSchedule) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ExecutionSchedule) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ExecutionSchedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// ScheduledExecution is the state of the recurring execution of an accepted
+// proposal with an execution schedule.
+type ScheduledExecution struct {
+	// proposal_id is the unique ID of the accepted proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// group_policy_address is the account address of the group policy executing
+	// the messages.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// schedule is the execution schedule of the proposal.
+	Schedule ExecutionSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+	// messages is the list of `sdk.Msg`s executed at each scheduled time.
+	Messages []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// next_execution_time is the time of the next execution.
+	NextExecutionTime time.Time `protobuf:"bytes,5,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+	// executions is the number of executions that already happened, whether
+	// they succeeded or failed.
+	Executions uint64 `protobuf:"varint,6,opt,name=executions,proto3" json:"executions,omitempty"`
+	// failures is the number of executions that failed.
+	Failures uint64 `protobuf:"varint,7,opt,name=failures,proto3" json:"failures,omitempty"`
+	// last_failure_time is the time of the last failed execution, if any.
+	LastFailureTime *time.Time `protobuf:"bytes,8,opt,name=last_failure_time,json=lastFailureTime,proto3,stdtime" json:"last_failure_time,omitempty"`
+	// last_failure_logs contains the error logs of the last failed execution.
+	LastFailureLogs string `protobuf:"bytes,9,opt,name=last_failure_logs,json=lastFailureLogs,proto3" json:"last_failure_logs,omitempty"`
+	// group_version tracks the version of the group the proposal was submitted
+	// to. The schedule is cancelled if the group is modified.
+	GroupVersion uint64 `protobuf:"varint,10,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
+	// group_policy_version tracks the version of the group policy the proposal
+	// was submitted to. The schedule is cancelled if the group policy is
+	// modified.
+	GroupPolicyVersion uint64 `protobuf:"varint,11,opt,name=group_policy_version,json=groupPolicyVersion,proto3" json:"group_policy_version,omitempty"`
+}
+
+func (m *ScheduledExecution) Reset()         { *m = ScheduledExecution{} }
+func (m *ScheduledExecution) String() string { return proto.CompactTextString(m) }
+func (*ScheduledExecution) ProtoMessage()    {}
+func (*ScheduledExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
+}
+func (m *ScheduledExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledExecution.Merge(m, src)
+}
+func (m *ScheduledExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledExecution proto.InternalMessageInfo
+
+func (m *ScheduledExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *ScheduledExecution) GetSchedule() ExecutionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ExecutionSchedule{}
+}
+
+func (m *ScheduledExecution) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ScheduledExecution) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return time.Time{}
+}
+
+func (m *ScheduledExecution) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetLastFailureTime() *time.Time {
+	if m != nil {
+		return m.LastFailureTime
+	}
+	return nil
+}
+
+func (m *ScheduledExecution) GetLastFailureLogs() string {
+	if m != nil {
+		return m.LastFailureLogs
+	}
+	return ""
+}
+
+func (m *ScheduledExecution) GetGroupVersion() uint64 {
+	if m != nil {
+		return m.GroupVersion
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetGroupPolicyVersion() uint64 {
+	if m != nil {
+		return m.GroupPolicyVersion
+	}
+	return 0
+}
+
 // TallyResult represents the sum of weighted votes for each vote option.
 type TallyResult struct {
 	// yes_count is the weighted sum of yes votes.
@@ -788,7 +1141,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1188,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,6 +1254,7 @@ func (m *Vote) GetSubmitTime() time.Time {
 
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.group.v1.WeightSource", WeightSource_name, WeightSource_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
@@ -912,6 +1266,9 @@ func init() {
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
+	proto.RegisterType((*MemberWeightSnapshot)(nil), "cosmos.group.v1.MemberWeightSnapshot")
+	proto.RegisterType((*ExecutionSchedule)(nil), "cosmos.group.v1.ExecutionSchedule")
+	proto.RegisterType((*ScheduledExecution)(nil), "cosmos.group.v1.ScheduledExecution")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
 }
@@ -919,93 +1276,115 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+	// 1720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0x59,
+	0x1d, 0xcf, 0xd8, 0x4e, 0x6c, 0x7f, 0x9d, 0xd8, 0xce, 0x6b, 0xba, 0x9d, 0x24, 0xad, 0x13, 0xdc,
+	0x0a, 0xaa, 0xa0, 0xda, 0xbb, 0x59, 0x09, 0xa4, 0x1e, 0x10, 0xb6, 0x33, 0x6d, 0x5d, 0x52, 0xdb,
+	0xcc, 0x8c, 0x13, 0xba, 0x97, 0xd1, 0xc4, 0xf3, 0xea, 0x8c, 0xf0, 0xcc, 0x33, 0xf3, 0x9e, 0x93,
+	0xe6, 0x8c, 0x90, 0x56, 0x5c, 0xd8, 0x23, 0x17, 0xa4, 0x95, 0xb8, 0x70, 0xdc, 0xc3, 0x8a, 0x03,
+	0x47, 0xc4, 0xa1, 0xe2, 0x80, 0x56, 0x48, 0x20, 0x4e, 0x80, 0x5a, 0x89, 0xe5, 0x0f, 0xe0, 0x8a,
+	0x84, 0xe6, 0xbd, 0x37, 0xce, 0xd8, 0xce, 0x8f, 0xba, 0xaa, 0xb8, 0x44, 0x79, 0xdf, 0xef, 0xe7,
+	0xfb, 0xfb, 0xc7, 0x7b, 0x63, 0xd8, 0xec, 0x11, 0xea, 0x11, 0x5a, 0xed, 0x07, 0x64, 0x34, 0xac,
+	0x9e, 0x7c, 0x54, 0x65, 0x67, 0x43, 0x4c, 0x2b, 0xc3, 0x80, 0x30, 0x82, 0x0a, 0x82, 0x59, 0xe1,
+	0xcc, 0xca, 0xc9, 0x47, 0x1b, 0x6b, 0x7d, 0xd2, 0x27, 0x9c, 0x57, 0x0d, 0xff, 0x13, 0xb0, 0x8d,
+	0x52, 0x9f, 0x90, 0xfe, 0x00, 0x57, 0xf9, 0xe9, 0x68, 0xf4, 0xa2, 0xea, 0x8c, 0x02, 0x9b, 0xb9,
+	0xc4, 0x97, 0xfc, 0xad, 0x69, 0x3e, 0x73, 0x3d, 0x4c, 0x99, 0xed, 0x0d, 0x25, 0x60, 0x5d, 0xd8,
+	0xb1, 0x84, 0x66, 0x69, 0x54, 0xb2, 0xa6, 0x65, 0x6d, 0xff, 0x4c, 0xb2, 0x56, 0x6d, 0xcf, 0xf5,
+	0x49, 0x95, 0xff, 0x15, 0xa4, 0xf2, 0x6f, 0x15, 0x58, 0x7a, 0x86, 0xbd, 0x23, 0x1c, 0xa0, 0x5d,
+	0x48, 0xdb, 0x8e, 0x13, 0x60, 0x4a, 0x55, 0x65, 0x5b, 0xb9, 0x9f, 0xad, 0xab, 0x7f, 0xfe, 0xf2,
+	0xc1, 0x9a, 0xd4, 0x5d, 0x13, 0x1c, 0x83, 0x05, 0xae, 0xdf, 0xd7, 0x23, 0x20, 0xfa, 0x00, 0x96,
+	0x4e, 0xb1, 0xdb, 0x3f, 0x66, 0x6a, 0x22, 0x14, 0xd1, 0xe5, 0x09, 0x6d, 0x40, 0xc6, 0xc3, 0xcc,
+	0x76, 0x6c, 0x66, 0xab, 0x49, 0xce, 0x19, 0x9f, 0xd1, 0x1e, 0x64, 0x6c, 0xc7, 0xc1, 0x8e, 0x65,
+	0x33, 0x35, 0xb5, 0xad, 0xdc, 0xcf, 0xed, 0x6e, 0x54, 0x84, 0xcf, 0x95, 0xc8, 0xe7, 0x8a, 0x19,
+	0xc5, 0x5b, 0x5f, 0x79, 0xf5, 0xf7, 0xad, 0x85, 0xcf, 0xfe, 0xb1, 0xa5, 0xfc, 0xe6, 0xeb, 0x2f,
+	0x76, 0x14, 0x6e, 0x19, 0x3b, 0x35, 0x56, 0x3e, 0x85, 0x15, 0xe1, 0xb7, 0x8e, 0x7f, 0x32, 0xc2,
+	0x94, 0xfd, 0xbf, 0xdc, 0x2f, 0xff, 0x41, 0x81, 0x5b, 0xe6, 0x71, 0x80, 0xe9, 0x31, 0x19, 0x38,
+	0x7b, 0xb8, 0xe7, 0x52, 0x97, 0xf8, 0x1d, 0x32, 0x70, 0x7b, 0x67, 0xe8, 0x36, 0x64, 0x59, 0xc4,
+	0x12, 0x5e, 0xe8, 0xe7, 0x04, 0xf4, 0x7d, 0x48, 0x9f, 0xba, 0xbe, 0x43, 0x4e, 0x29, 0x37, 0x97,
+	0xdb, 0xfd, 0x66, 0x65, 0xaa, 0x5d, 0x2a, 0x93, 0xfa, 0x0e, 0x05, 0x5a, 0x8f, 0xc4, 0x1e, 0x36,
+	0xff, 0xf8, 0xe5, 0x83, 0xd2, 0xd5, 0x32, 0x3f, 0xff, 0xfa, 0x8b, 0x9d, 0xb2, 0x80, 0x3c, 0xa0,
+	0xce, 0x8f, 0xab, 0x97, 0xb8, 0x5a, 0x7e, 0xa5, 0x80, 0xda, 0xc1, 0x41, 0x0f, 0xfb, 0xcc, 0xee,
+	0xe3, 0xa9, 0x38, 0x4a, 0x00, 0xc3, 0x31, 0x4f, 0x06, 0x12, 0xa3, 0xbc, 0x87, 0x48, 0x9e, 0xbe,
+	0x5d, 0x24, 0x77, 0x63, 0x91, 0x5c, 0xe6, 0x6d, 0xf9, 0xf7, 0x0a, 0xdc, 0xbc, 0xd0, 0x1c, 0x7a,
+	0x06, 0x2b, 0x27, 0x84, 0xb9, 0x7e, 0xdf, 0x1a, 0xe2, 0xc0, 0x25, 0xa2, 0x26, 0xb9, 0xdd, 0xf5,
+	0x99, 0x7e, 0xdb, 0x93, 0xf3, 0x27, 0xda, 0xed, 0x97, 0xe3, 0x76, 0x5b, 0x16, 0xe2, 0x1d, 0x2e,
+	0x8d, 0x3e, 0x81, 0x35, 0xcf, 0xf5, 0x2d, 0xfc, 0x12, 0xf7, 0x46, 0x21, 0x3a, 0xd2, 0x9a, 0x98,
+	0x53, 0x2b, 0xf2, 0x5c, 0x5f, 0x8b, 0x94, 0x08, 0xdd, 0xe5, 0xbf, 0x24, 0x20, 0xfb, 0x38, 0x4c,
+	0x44, 0xd3, 0x7f, 0x41, 0x50, 0x1e, 0x12, 0xae, 0xf0, 0x36, 0xa5, 0x27, 0x5c, 0x07, 0x55, 0x60,
+	0xd1, 0x76, 0x3c, 0xd7, 0x57, 0x13, 0xd7, 0xb4, 0xb6, 0x80, 0x5d, 0x39, 0x7f, 0x2a, 0xa4, 0x4f,
+	0x70, 0x10, 0x26, 0x8b, 0x8f, 0x5f, 0x4a, 0x8f, 0x8e, 0xe8, 0x1b, 0xb0, 0xcc, 0x08, 0xb3, 0x07,
+	0x96, 0x1c, 0x8a, 0x45, 0x2e, 0x99, 0xe3, 0xb4, 0x43, 0x31, 0x19, 0x4f, 0x00, 0x7a, 0x01, 0xb6,
+	0x99, 0x18, 0xdf, 0xa5, 0x79, 0xc7, 0x37, 0x2b, 0x85, 0x6b, 0x0c, 0xd5, 0x61, 0x45, 0x98, 0xb1,
+	0x28, 0x19, 0x05, 0x3d, 0xac, 0xa6, 0xb7, 0x95, 0xfb, 0xf9, 0xdd, 0x3b, 0x33, 0x9d, 0x24, 0x2c,
+	0x1b, 0x1c, 0xa4, 0x2f, 0x9f, 0xc6, 0x4e, 0xa1, 0xc3, 0x52, 0x87, 0x83, 0x7d, 0xe2, 0xa9, 0x19,
+	0xe1, 0xb0, 0xa0, 0xed, 0x85, 0xa4, 0xf2, 0x73, 0xc8, 0xf1, 0xb4, 0xca, 0x25, 0xb7, 0x0e, 0x19,
+	0xae, 0xd8, 0x1a, 0xa7, 0x37, 0xcd, 0xcf, 0x4d, 0x07, 0x55, 0x61, 0xc9, 0xe3, 0x20, 0x59, 0xcf,
+	0x5b, 0x33, 0x9e, 0xc8, 0x85, 0x23, 0x61, 0xe5, 0xff, 0x26, 0xa0, 0xc0, 0x75, 0x8b, 0xa6, 0xe3,
+	0x85, 0x7b, 0x97, 0x2d, 0x14, 0xf7, 0x29, 0x31, 0xe9, 0xd3, 0xb8, 0xee, 0xc9, 0xf9, 0xeb, 0x9e,
+	0xba, 0xbc, 0xee, 0x8b, 0x93, 0x75, 0xb7, 0xa1, 0xe0, 0xc8, 0xf9, 0xb1, 0x86, 0x3c, 0x16, 0x59,
+	0xd9, 0xb5, 0x99, 0xca, 0xd6, 0xfc, 0xb3, 0x7a, 0xf9, 0xfa, 0xd9, 0xd5, 0xf3, 0xce, 0xc4, 0x79,
+	0xaa, 0x6f, 0xd2, 0xef, 0xde, 0x37, 0x0f, 0x33, 0x9f, 0x7e, 0xbe, 0xb5, 0xf0, 0xef, 0xcf, 0xb7,
+	0x94, 0xf2, 0xcf, 0xd2, 0x90, 0xe9, 0x04, 0x64, 0x48, 0xa8, 0x3d, 0x98, 0x99, 0x98, 0xa7, 0xb0,
+	0x26, 0x92, 0x2a, 0x02, 0xb2, 0xa2, 0xaa, 0x5c, 0x37, 0x40, 0xa8, 0x7f, 0x5e, 0x51, 0xc9, 0xb9,
+	0x72, 0x9a, 0xbe, 0x03, 0xd9, 0x21, 0xf7, 0x01, 0x07, 0x54, 0x4d, 0x6d, 0x27, 0xaf, 0x54, 0x7e,
+	0x0e, 0x45, 0x4f, 0x21, 0x47, 0x47, 0x47, 0x9e, 0xcb, 0xac, 0xf0, 0x6e, 0x57, 0x17, 0xe7, 0xcd,
+	0x08, 0x08, 0xe9, 0x90, 0x8f, 0xee, 0xc2, 0x8a, 0x88, 0x35, 0xaa, 0xef, 0x12, 0x4f, 0xc3, 0x32,
+	0x27, 0x1e, 0xc8, 0x22, 0x7f, 0x38, 0x95, 0x90, 0x08, 0x9b, 0xe6, 0xd8, 0x78, 0xd8, 0x91, 0xc4,
+	0x77, 0x61, 0x89, 0x32, 0x9b, 0x8d, 0x28, 0x9f, 0xab, 0xfc, 0xee, 0xd6, 0xcc, 0x40, 0x44, 0xd9,
+	0x37, 0x38, 0x4c, 0x97, 0x70, 0xd4, 0x05, 0xf4, 0xc2, 0xf5, 0xed, 0x81, 0xc5, 0xec, 0xc1, 0xe0,
+	0xcc, 0x0a, 0x30, 0x1d, 0x0d, 0x98, 0x9a, 0xe5, 0x21, 0xde, 0x9e, 0x51, 0x62, 0x86, 0x20, 0x9d,
+	0x63, 0xea, 0xd9, 0x30, 0x48, 0x11, 0x60, 0x91, 0xab, 0x88, 0x31, 0x51, 0x17, 0x56, 0x27, 0xb6,
+	0xb9, 0x85, 0x7d, 0x47, 0x85, 0x79, 0x13, 0x57, 0x88, 0xaf, 0x74, 0xcd, 0x77, 0x50, 0x07, 0x0a,
+	0x62, 0xa3, 0x93, 0x20, 0x72, 0x35, 0xc7, 0xe3, 0xfd, 0xd6, 0xa5, 0xf1, 0x6a, 0x12, 0x2f, 0x1c,
+	0xd3, 0xf3, 0x78, 0xe2, 0x8c, 0x3e, 0x0c, 0xfb, 0x85, 0x52, 0xbb, 0x8f, 0xa9, 0xba, 0xbc, 0x9d,
+	0xbc, 0x6c, 0x90, 0xf4, 0x31, 0x0a, 0xad, 0xc1, 0x22, 0x73, 0xd9, 0x00, 0xab, 0x2b, 0xbc, 0xbd,
+	0xc4, 0x21, 0x9c, 0x58, 0x3a, 0xf2, 0x3c, 0x3b, 0x38, 0x53, 0xf3, 0x9c, 0x1e, 0x1d, 0xd1, 0x0f,
+	0x01, 0x9d, 0xdf, 0x42, 0xb4, 0x77, 0x8c, 0x9d, 0xd1, 0x00, 0xab, 0x05, 0x9e, 0x8b, 0xf2, 0x8c,
+	0xdb, 0xe3, 0xbb, 0xc6, 0x90, 0x48, 0x7d, 0x15, 0x4f, 0x93, 0xd0, 0x2e, 0xdc, 0xa4, 0xbe, 0x3d,
+	0xa4, 0xc7, 0x84, 0x59, 0x13, 0xb7, 0x40, 0x91, 0x9b, 0xbe, 0x11, 0x31, 0xcd, 0xf3, 0xdb, 0xe0,
+	0x61, 0x2a, 0x9c, 0xc5, 0xf2, 0x4f, 0x15, 0x58, 0x13, 0xab, 0x51, 0x90, 0x0d, 0x89, 0x44, 0x5b,
+	0x90, 0x1b, 0xca, 0x8c, 0x9d, 0xef, 0x5b, 0x88, 0x48, 0x4d, 0x27, 0xbe, 0x2d, 0x13, 0xf3, 0xbf,
+	0xd9, 0x92, 0xf1, 0x37, 0x5b, 0xf9, 0xaf, 0x0a, 0xac, 0xce, 0x04, 0x1a, 0xee, 0x1d, 0xca, 0xec,
+	0x40, 0x4e, 0x99, 0x32, 0xf7, 0xde, 0xe1, 0xc2, 0x7c, 0xc8, 0xf6, 0x20, 0xe3, 0xfa, 0x0c, 0x07,
+	0x27, 0xf6, 0x60, 0xee, 0x0b, 0x7f, 0x2c, 0x19, 0x16, 0xba, 0x47, 0x46, 0xbe, 0x70, 0x3e, 0xa5,
+	0x8b, 0x03, 0xda, 0x84, 0x6c, 0xdf, 0xa6, 0xd6, 0xc0, 0xf5, 0x5c, 0x26, 0x2f, 0xe5, 0x4c, 0xdf,
+	0xa6, 0xfb, 0xe1, 0xb9, 0xfc, 0xaf, 0x14, 0xa0, 0x28, 0x1e, 0x67, 0x1c, 0xe1, 0xf5, 0xc9, 0x7d,
+	0x9f, 0x1b, 0xb0, 0x09, 0x99, 0x71, 0x97, 0x25, 0xdf, 0xb6, 0xcb, 0xe2, 0xd3, 0x3c, 0x16, 0x9f,
+	0x18, 0x8e, 0xd4, 0x5b, 0x0d, 0xc7, 0x73, 0xb8, 0xe1, 0xe3, 0x97, 0x2c, 0xf6, 0xee, 0x7a, 0xb7,
+	0x95, 0xb9, 0x1a, 0x6a, 0x19, 0x7b, 0xc9, 0x8b, 0x5a, 0x02, 0x18, 0x6b, 0xa5, 0x72, 0x6d, 0xc6,
+	0x28, 0xe1, 0xe6, 0x7f, 0x61, 0xbb, 0x83, 0x51, 0x80, 0xa9, 0x5c, 0x94, 0xe3, 0x33, 0xda, 0x87,
+	0xd5, 0x81, 0x4d, 0x99, 0x25, 0x09, 0xc2, 0xa9, 0xcc, 0xb5, 0x4e, 0xa5, 0x42, 0x87, 0xf4, 0x42,
+	0x28, 0xfa, 0x48, 0x48, 0x72, 0x4f, 0x76, 0xa6, 0xb4, 0x0d, 0x48, 0x9f, 0xf2, 0x95, 0x99, 0x9d,
+	0xc0, 0xee, 0x93, 0x3e, 0x9d, 0xdd, 0xf7, 0x30, 0xc7, 0xbe, 0xcf, 0x5d, 0xb6, 0xef, 0xcb, 0xbf,
+	0x52, 0x20, 0x17, 0xdf, 0xb7, 0x9b, 0x90, 0x3d, 0xc3, 0xd4, 0x12, 0xfd, 0x2a, 0x3e, 0x02, 0x32,
+	0x67, 0x98, 0x36, 0x78, 0xcb, 0xde, 0x85, 0x15, 0xfb, 0x88, 0x32, 0xdb, 0xf5, 0x25, 0x40, 0x7c,
+	0x41, 0x2d, 0x4b, 0xa2, 0x00, 0xad, 0x43, 0xc6, 0x27, 0xd6, 0x79, 0xc3, 0x67, 0xf5, 0xb4, 0x4f,
+	0x04, 0xeb, 0xdb, 0x80, 0x7c, 0x62, 0x9d, 0xba, 0xec, 0xd8, 0x3a, 0xc1, 0x2c, 0x02, 0x89, 0x37,
+	0x4b, 0xc1, 0x27, 0x87, 0x2e, 0x3b, 0x3e, 0xc0, 0x4c, 0x80, 0xe5, 0x9e, 0xf9, 0x8f, 0x02, 0xa9,
+	0x03, 0xc2, 0xf0, 0xf5, 0xad, 0x5f, 0x81, 0xc5, 0x13, 0xc2, 0x70, 0x70, 0x6d, 0xaf, 0x0b, 0x18,
+	0xfa, 0x18, 0x96, 0xc8, 0x30, 0xac, 0x38, 0xf7, 0x32, 0xbf, 0xbb, 0x39, 0xd3, 0xdc, 0xa1, 0xdd,
+	0x36, 0x87, 0xe8, 0x12, 0x7a, 0xe5, 0x5b, 0xeb, 0x3d, 0xde, 0xee, 0x3b, 0xbf, 0x50, 0x00, 0xce,
+	0xcd, 0xa3, 0x4d, 0xb8, 0x75, 0xd0, 0x36, 0x35, 0xab, 0xdd, 0x31, 0x9b, 0xed, 0x96, 0xd5, 0x6d,
+	0x19, 0x1d, 0xad, 0xd1, 0x7c, 0xd4, 0xd4, 0xf6, 0x8a, 0x0b, 0xe8, 0x06, 0x14, 0xe2, 0xcc, 0xe7,
+	0x9a, 0x51, 0x54, 0xd0, 0x2d, 0xb8, 0x11, 0x27, 0xd6, 0xea, 0x86, 0x59, 0x6b, 0xb6, 0x8a, 0x09,
+	0x84, 0x20, 0x1f, 0x67, 0xb4, 0xda, 0xc5, 0x24, 0xba, 0x0d, 0xea, 0x24, 0xcd, 0x3a, 0x6c, 0x9a,
+	0x4f, 0xac, 0x03, 0xcd, 0x6c, 0x17, 0x53, 0x1b, 0xa9, 0x4f, 0x7f, 0x5d, 0x5a, 0xd8, 0x71, 0x61,
+	0x39, 0xfe, 0x28, 0x47, 0x77, 0x60, 0xfd, 0x50, 0x6b, 0x3e, 0x7e, 0x62, 0x5a, 0x46, 0xbb, 0xab,
+	0x37, 0xb4, 0x29, 0xa7, 0xd6, 0xe1, 0xe6, 0x24, 0xdb, 0x30, 0x6b, 0x3f, 0x68, 0xb6, 0x1e, 0x17,
+	0x95, 0x59, 0x56, 0xbd, 0xb6, 0x5f, 0x6b, 0x35, 0xb4, 0x62, 0x42, 0x9a, 0xfa, 0x93, 0x02, 0xf9,
+	0xc9, 0x57, 0x06, 0xda, 0x82, 0xcd, 0x8e, 0xde, 0xee, 0xb4, 0x8d, 0xda, 0x7e, 0xa8, 0xc9, 0xec,
+	0x1a, 0x53, 0xf6, 0xee, 0xc0, 0xfa, 0x34, 0xc0, 0xe8, 0xd6, 0x9f, 0x35, 0x4d, 0x53, 0xdb, 0x2b,
+	0x2a, 0x61, 0x84, 0xd3, 0xec, 0x5a, 0xa3, 0xa1, 0x75, 0x42, 0x6e, 0xe2, 0x22, 0xae, 0xae, 0x3d,
+	0xd5, 0x1a, 0x21, 0x37, 0x19, 0x26, 0x7f, 0x46, 0xb6, 0xde, 0xd6, 0x43, 0x66, 0xea, 0x22, 0xbb,
+	0x61, 0xee, 0xf6, 0xf4, 0xda, 0x61, 0xab, 0xb8, 0x28, 0x03, 0xfa, 0x9d, 0x02, 0x1f, 0x5c, 0xfc,
+	0x8c, 0x40, 0xf7, 0xe1, 0xde, 0x58, 0x5e, 0xfb, 0x91, 0xd6, 0xe8, 0x9a, 0x6d, 0xdd, 0xd2, 0x35,
+	0xa3, 0xbb, 0x6f, 0x4e, 0x45, 0x78, 0x0f, 0xb6, 0x2f, 0x45, 0xb6, 0xda, 0xa6, 0xa5, 0x77, 0x5b,
+	0x45, 0xe5, 0x4a, 0x94, 0xd1, 0x6d, 0x34, 0x34, 0xc3, 0x28, 0x26, 0xae, 0x44, 0x3d, 0xaa, 0x35,
+	0xf7, 0xbb, 0xba, 0x56, 0x4c, 0x0a, 0xe7, 0xeb, 0xdf, 0x7b, 0xf5, 0xba, 0xa4, 0x7c, 0xf5, 0xba,
+	0xa4, 0xfc, 0xf3, 0x75, 0x49, 0xf9, 0xec, 0x4d, 0x69, 0xe1, 0xab, 0x37, 0xa5, 0x85, 0xbf, 0xbd,
+	0x29, 0x2d, 0x7c, 0x72, 0xaf, 0xef, 0xb2, 0xe3, 0xd1, 0x51, 0xa5, 0x47, 0x3c, 0xf9, 0x73, 0x54,
+	0x35, 0xf6, 0xe9, 0xfe, 0x52, 0xfc, 0x5a, 0x76, 0xb4, 0xc4, 0x3b, 0xff, 0xe3, 0xff, 0x0d, 0x00,
+	0xe9, 0x02, 0xba, 0xf7, 0x44, 0x13, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1289,6 +1668,18 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WeightDenom) > 0 {
+		i -= len(m.WeightDenom)
+		copy(dAtA[i:], m.WeightDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WeightDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.WeightSource != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WeightSource))
+		i--
+		dAtA[i] = 0x38
+	}
 	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
 	if err6 != nil {
 		return 0, err6
@@ -1465,6 +1856,27 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SnapshotTotalWeight) > 0 {
+		i -= len(m.SnapshotTotalWeight)
+		copy(dAtA[i:], m.SnapshotTotalWeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SnapshotTotalWeight)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ExecutionSchedule != nil {
+		{
+			size, err := m.ExecutionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1498,12 +1910,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1531,12 +1943,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1570,7 +1982,7 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+func (m *MemberWeightSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1580,48 +1992,39 @@ func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *MemberWeightSnapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MemberWeightSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NoWithVetoCount) > 0 {
-		i -= len(m.NoWithVetoCount)
-		copy(dAtA[i:], m.NoWithVetoCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NoWithVetoCount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NoCount)))
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.AbstainCount)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.YesCount)))
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
+func (m *ExecutionSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1631,24 +2034,228 @@ func (m *Vote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutionSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ExecutionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupPolicyVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupPolicyVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.GroupVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LastFailureLogs) > 0 {
+		i -= len(m.LastFailureLogs)
+		copy(dAtA[i:], m.LastFailureLogs)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastFailureLogs)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastFailureTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastFailureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastFailureTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTypes(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Failures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Executions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x30
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NoWithVetoCount) > 0 {
+		i -= len(m.NoWithVetoCount)
+		copy(dAtA[i:], m.NoWithVetoCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NoWithVetoCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1804,6 +2411,13 @@ func (m *GroupInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.WeightSource != 0 {
+		n += 1 + sovTypes(uint64(m.WeightSource))
+	}
+	l = len(m.WeightDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1911,6 +2525,99 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ExecutionSchedule != nil {
+		l = m.ExecutionSchedule.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SnapshotTotalWeight)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MemberWeightSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *ScheduledExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Executions != 0 {
+		n += 1 + sovTypes(uint64(m.Executions))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovTypes(uint64(m.Failures))
+	}
+	if m.LastFailureTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastFailureTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LastFailureLogs)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupVersion))
+	}
+	if m.GroupPolicyVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupPolicyVersion))
+	}
 	return n
 }
 
@@ -2843,17 +3550,68 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightSource", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			m.WeightSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightSource |= WeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3639,6 +4397,706 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionSchedule == nil {
+				m.ExecutionSchedule = &ExecutionSchedule{}
+			}
+			if err := m.ExecutionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotTotalWeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberWeightSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberWeightSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberWeightSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailureTime == nil {
+				m.LastFailureTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastFailureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureLogs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFailureLogs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			}
+			m.GroupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyVersion", wireType)
+			}
+			m.GroupPolicyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupPolicyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
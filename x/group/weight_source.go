@@ -0,0 +1,57 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+// IsDynamic returns true if the voting weights of the group members are
+// computed from the chain state instead of being set by the group admin.
+func (w WeightSource) IsDynamic() bool {
+	return w != WEIGHT_SOURCE_UNSPECIFIED
+}
+
+// ValidateWeightSource does basic validation on a group weight source and its
+// denom.
+func ValidateWeightSource(source WeightSource, denom string) error {
+	if _, ok := WeightSource_name[int32(source)]; !ok {
+		return sdkerrors.Wrap(errors.ErrInvalid, "weight source")
+	}
+
+	if source != WEIGHT_SOURCE_BALANCE {
+		if denom != "" {
+			return sdkerrors.Wrap(errors.ErrInvalid, "weight denom can only be set with a balance weight source")
+		}
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrap(err, "weight denom")
+	}
+	return nil
+}
+
+func (s MemberWeightSnapshot) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(s.Address)
+
+	return []interface{}{s.ProposalId, addr.Bytes()}
+}
+
+var _ orm.Validateable = MemberWeightSnapshot{}
+
+// ValidateBasic does basic validation on a member weight snapshot.
+func (s MemberWeightSnapshot) ValidateBasic() error {
+	if s.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "member weight snapshot proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return sdkerrors.Wrap(err, "member weight snapshot address")
+	}
+	if _, err := math.NewPositiveDecFromString(s.Weight); err != nil {
+		return sdkerrors.Wrap(err, "member weight snapshot weight")
+	}
+	return nil
+}
@@ -26,6 +26,9 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		k.HandleValidatorSignatureWithParams(ctx, params, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
 	}
 
+	// Execute the queued slashes whose delay has elapsed
+	k.ExecutePendingSlashes(ctx)
+
 	// If there are still entries for the deprecated MissedBlockBitArray, delete them up until we hit the per block limit
 	k.DeleteDeprecatedValidatorMissedBlockBitArray(ctx, deprecatedBitArrayPruneLimitPerBlock)
 }
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryPendingSlashes(),
+		GetCmdQueryPendingSlash(),
 	)
 
 	return slashingQueryCmd
@@ -139,3 +142,80 @@ $ <appd> query slashing params
 
 	return cmd
 }
+
+// GetCmdQueryPendingSlashes implements the command to query the pending slashes.
+func GetCmdQueryPendingSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-slashes",
+		Short: "Query the slashes awaiting their execution",
+		Long: strings.TrimSpace(`Query the slashes queued during the slash delay, which are executed once it has
+elapsed unless cancelled by governance:
+
+$ <appd> query slashing pending-slashes
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPendingSlashesRequest{Pagination: pageReq}
+			res, err := queryClient.PendingSlashes(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending slashes")
+
+	return cmd
+}
+
+// GetCmdQueryPendingSlash implements the command to query a pending slash.
+func GetCmdQueryPendingSlash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-slash [id]",
+		Short: "Query a pending slash and the delegators affected by it",
+		Long: strings.TrimSpace(`Query a slash awaiting its execution by its ID, along with the delegators whose
+stake is subject to it:
+
+$ <appd> query slashing pending-slash 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPendingSlashRequest{Id: id}
+			res, err := queryClient.PendingSlash(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -40,6 +40,22 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	var nextPendingSlashID uint64 = 1
+	for _, pendingSlash := range data.PendingSlashes {
+		keeper.SetPendingSlash(ctx, pendingSlash)
+		if pendingSlash.Id >= nextPendingSlashID {
+			nextPendingSlashID = pendingSlash.Id + 1
+		}
+	}
+	keeper.setNextPendingSlashID(ctx, nextPendingSlashID)
+
+	// a slash must be executed before the stake subject to it completes its unbonding
+	if data.Params.SlashDelay > 0 {
+		if unbondingTime := stakingKeeper.UnbondingTime(ctx); data.Params.SlashDelay >= unbondingTime {
+			panic(types.ErrInvalidSlashDelay.Wrapf("slash delay %s must be lower than the unbonding time %s", data.Params.SlashDelay, unbondingTime))
+		}
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -72,5 +88,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	keeper.IteratePendingSlashes(ctx, func(pendingSlash types.PendingSlash) bool {
+		genesis.PendingSlashes = append(genesis.PendingSlashes, pendingSlash)
+		return false
+	})
+
+	return genesis
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *KeeperTestSuite) TestExportAndInitGenesis() {
@@ -55,3 +57,44 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
 }
+
+func (s *KeeperTestSuite) TestExportAndInitGenesisPendingSlashes() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.SlashDelay = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	pendingSlash := keeper.QueueSlash(ctx, consAddr, params.SlashFractionDoubleSign, 10, 5, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	genesisState := keeper.ExportGenesis(ctx)
+	require.Equal([]types.PendingSlash{pendingSlash}, genesisState.PendingSlashes)
+
+	require.NoError(keeper.CancelPendingSlash(ctx, pendingSlash.Id))
+	require.Empty(keeper.GetPendingSlashes(ctx))
+
+	s.stakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).Return()
+	s.stakingKeeper.EXPECT().UnbondingTime(ctx).Return(stakingtypes.DefaultUnbondingTime)
+	keeper.InitGenesis(ctx, s.stakingKeeper, genesisState)
+	require.Equal([]types.PendingSlash{pendingSlash}, keeper.GetPendingSlashes(ctx))
+
+	// the pending slash is queued for its execution and new IDs do not collide
+	next := keeper.QueueSlash(ctx, consAddr, params.SlashFractionDoubleSign, 10, 6, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.Equal(pendingSlash.Id+1, next.Id)
+}
+
+func (s *KeeperTestSuite) TestInitGenesisInvalidSlashDelay() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	genesisState := keeper.ExportGenesis(ctx)
+	genesisState.Params.SlashDelay = stakingtypes.DefaultUnbondingTime
+
+	// the slash delay must be lower than the unbonding time
+	s.stakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).Return()
+	s.stakingKeeper.EXPECT().UnbondingTime(ctx).Return(stakingtypes.DefaultUnbondingTime)
+	require.PanicsWithError(
+		fmt.Sprintf("slash delay %s must be lower than the unbonding time %s: invalid slash delay", stakingtypes.DefaultUnbondingTime, stakingtypes.DefaultUnbondingTime),
+		func() { keeper.InitGenesis(ctx, s.stakingKeeper, genesisState) },
+	)
+}
@@ -47,7 +47,16 @@ func (k Keeper) SigningInfo(c context.Context, req *types.QuerySigningInfoReques
 		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
 	}
 
-	return &types.QuerySigningInfoResponse{ValSigningInfo: signingInfo}, nil
+	// only the offences which have not decayed count as prior offences
+	params := k.GetParams(ctx)
+	signingInfo.PruneDowntimeOffences(ctx.BlockHeader().Time, params.DowntimeOffenceDecayWindow)
+	priorOffences := len(signingInfo.DowntimeOffences)
+
+	return &types.QuerySigningInfoResponse{
+		ValSigningInfo:        signingInfo,
+		PriorDowntimeOffences: uint64(priorOffences),
+		NextDowntimePenalty:   params.DowntimePenalty(priorOffences),
+	}, nil
 }
 
 // SigningInfos returns signing-infos of all validators.
@@ -75,3 +84,47 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// PendingSlashes returns the slashes waiting for their execution.
+func (k Keeper) PendingSlashes(c context.Context, req *types.QueryPendingSlashesRequest) (*types.QueryPendingSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var pendingSlashes []types.PendingSlash
+
+	pendingSlashStore := prefix.NewStore(store, types.PendingSlashKeyPrefix)
+	pageRes, err := query.Paginate(pendingSlashStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingSlash types.PendingSlash
+		err := k.cdc.Unmarshal(value, &pendingSlash)
+		if err != nil {
+			return err
+		}
+		pendingSlashes = append(pendingSlashes, pendingSlash)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingSlashesResponse{PendingSlashes: pendingSlashes, Pagination: pageRes}, nil
+}
+
+// PendingSlash returns a pending slash and the delegators affected by it.
+func (k Keeper) PendingSlash(c context.Context, req *types.QueryPendingSlashRequest) (*types.QueryPendingSlashResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	pendingSlash, found := k.GetPendingSlash(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "pending slash %d not found", req.Id)
+	}
+
+	return &types.QueryPendingSlashResponse{
+		PendingSlash:       pendingSlash,
+		AffectedDelegators: k.GetAffectedDelegators(ctx, pendingSlash),
+	}, nil
+}
@@ -89,3 +89,11 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+func (h Hooks) BeforeDenomDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ string) error {
+	return nil
+}
+
+func (h Hooks) AfterDenomDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ string) error {
+	return nil
+}
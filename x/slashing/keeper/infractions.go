@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -122,7 +124,19 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx sdk.Context, params types
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			// Repeat offenders are penalized according to their prior offences which have not decayed yet.
+			signInfo.PruneDowntimeOffences(ctx.BlockHeader().Time, params.DowntimeOffenceDecayWindow)
+			priorOffences := len(signInfo.DowntimeOffences)
+			penalty := params.DowntimePenalty(priorOffences)
+			signInfo.AddDowntimeOffence(ctx.BlockHeader().Time, len(params.RepeatDowntimePenalties)+1)
+
+			// If the slash delay is set, the slash is queued and no coins are burned yet.
+			coinsBurned := math.ZeroInt()
+			if params.SlashDelay > 0 {
+				k.QueueSlash(ctx, consAddr, penalty.SlashFraction, power, distributionHeight, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			} else {
+				coinsBurned = k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, penalty.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			}
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -135,7 +149,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx sdk.Context, params types
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(penalty.JailDuration)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			// We don't set the start height as this will get correctly set
@@ -152,7 +166,8 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx sdk.Context, params types
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"prior_offences", priorOffences,
+				"slashed", penalty.SlashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
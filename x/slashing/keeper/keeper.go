@@ -78,23 +78,27 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.De
 
 // SlashWithInfractionReason attempts to slash a validator. The slash is delegated to the staking
 // module to make the necessary validator changes. It specifies an intraction reason.
+// If the slash delay is set, the slash is queued and executed once the delay has elapsed.
 func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infraction stakingtypes.Infraction) {
-	coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
-
-	reasonAttr := sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueUnspecified)
-	switch infraction {
-	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
-		reasonAttr = sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign)
-	case stakingtypes.Infraction_INFRACTION_DOWNTIME:
-		reasonAttr = sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature)
+	if k.SlashDelay(ctx) > 0 {
+		k.QueueSlash(ctx, consAddr, fraction, power, distributionHeight, infraction)
+		return
 	}
 
+	k.executeSlash(ctx, consAddr, fraction, power, distributionHeight, infraction)
+}
+
+// executeSlash slashes a validator through the staking module and emits the
+// slash event.
+func (k Keeper) executeSlash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infraction stakingtypes.Infraction) {
+	coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, fraction, infraction)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
 			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-			reasonAttr,
+			sdk.NewAttribute(types.AttributeKeyReason, infractionReason(infraction)),
 			sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 		),
 	)
@@ -28,6 +28,12 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// a slash must be executed before the stake subject to it completes its unbonding
+	if unbondingTime := k.sk.UnbondingTime(ctx); req.Params.SlashDelay >= unbondingTime {
+		return nil, types.ErrInvalidSlashDelay.Wrapf("slash delay %s must be lower than the unbonding time %s", req.Params.SlashDelay, unbondingTime)
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -52,3 +58,18 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// CancelPendingSlash implements MsgServer.CancelPendingSlash method.
+// It defines a method to cancel a slash awaiting its execution.
+func (k msgServer) CancelPendingSlash(goCtx context.Context, req *types.MsgCancelPendingSlash) (*types.MsgCancelPendingSlashResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CancelPendingSlash(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPendingSlashResponse{}, nil
+}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -12,6 +14,8 @@ import (
 func (s *KeeperTestSuite) TestUpdateParams() {
 	require := s.Require()
 
+	s.stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(types.DefaultUnbondingTime).AnyTimes()
+
 	minSignedPerWindow, err := sdk.NewDecFromStr("0.60")
 	require.NoError(err)
 
@@ -127,6 +131,37 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "set slash delay not lower than the unbonding time",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					SlashDelay:              types.DefaultUnbondingTime,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "must be lower than the unbonding time",
+		},
+		{
+			name: "set valid slash delay",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					SlashDelay:              7 * 24 * time.Hour,
+				},
+			},
+			expectErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -320,3 +355,62 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCancelPendingSlash() {
+	require := s.Require()
+
+	params := s.slashingKeeper.GetParams(s.ctx)
+	params.SlashDelay = time.Hour
+	require.NoError(s.slashingKeeper.SetParams(s.ctx, params))
+
+	pendingSlash := s.slashingKeeper.QueueSlash(s.ctx, consAddr, sdk.NewDecWithPrec(5, 2), 10, s.ctx.BlockHeight(), types.Infraction_INFRACTION_DOUBLE_SIGN)
+
+	testCases := []struct {
+		name      string
+		request   *slashingtypes.MsgCancelPendingSlash
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			request: &slashingtypes.MsgCancelPendingSlash{
+				Authority: "foo",
+				Id:        pendingSlash.Id,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unknown pending slash",
+			request: &slashingtypes.MsgCancelPendingSlash{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Id:        pendingSlash.Id + 1,
+			},
+			expectErr: true,
+			expErrMsg: "pending slash not found",
+		},
+		{
+			name: "valid request",
+			request: &slashingtypes.MsgCancelPendingSlash{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Id:        pendingSlash.Id,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.CancelPendingSlash(s.ctx, tc.request)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+				_, found := s.slashingKeeper.GetPendingSlash(s.ctx, pendingSlash.Id)
+				require.False(found)
+			}
+		})
+	}
+}
@@ -34,6 +34,11 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return k.GetParams(ctx).SlashFractionDowntime
 }
 
+// SlashDelay - delay after which the slashes are executed
+func (k Keeper) SlashDelay(ctx sdk.Context) (res time.Duration) {
+	return k.GetParams(ctx).SlashDelay
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
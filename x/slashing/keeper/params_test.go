@@ -89,6 +89,51 @@ func (s *KeeperTestSuite) TestParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid negative downtime offence decay window",
+			input: types.Params{
+				SignedBlocksWindow:         int64(750),
+				MinSignedPerWindow:         minSignedPerWindow,
+				DowntimeJailDuration:       time.Duration(10),
+				SlashFractionDoubleSign:    slashFractionDoubleSign,
+				SlashFractionDowntime:      slashFractionDowntime,
+				DowntimeOffenceDecayWindow: -time.Hour,
+			},
+			expectErr: true,
+			expErrMsg: "downtime offence decay window cannot be negative",
+		},
+		{
+			name: "set invalid non escalating repeat downtime penalties",
+			input: types.Params{
+				SignedBlocksWindow:      int64(750),
+				MinSignedPerWindow:      minSignedPerWindow,
+				DowntimeJailDuration:    time.Hour,
+				SlashFractionDoubleSign: slashFractionDoubleSign,
+				SlashFractionDowntime:   slashFractionDowntime,
+				RepeatDowntimePenalties: []types.DowntimePenalty{
+					{JailDuration: 2 * time.Hour, SlashFraction: slashFractionDowntime.MulInt64(2)},
+					{JailDuration: 2 * time.Hour, SlashFraction: slashFractionDowntime},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "repeat downtime penalty 1 cannot be lower than the previous penalty",
+		},
+		{
+			name: "set all valid params with repeat downtime penalties",
+			input: types.Params{
+				SignedBlocksWindow:         int64(750),
+				MinSignedPerWindow:         minSignedPerWindow,
+				DowntimeJailDuration:       time.Hour,
+				SlashFractionDoubleSign:    slashFractionDoubleSign,
+				SlashFractionDowntime:      slashFractionDowntime,
+				DowntimeOffenceDecayWindow: 30 * 24 * time.Hour,
+				RepeatDowntimePenalties: []types.DowntimePenalty{
+					{JailDuration: 2 * time.Hour, SlashFraction: slashFractionDowntime.MulInt64(2)},
+					{JailDuration: 4 * time.Hour, SlashFraction: slashFractionDowntime.MulInt64(4)},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "set all valid params",
 			input: types.Params{
@@ -125,3 +170,22 @@ func (s *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDowntimePenalty() {
+	require := s.Require()
+
+	params := types.DefaultParams()
+	first := params.FirstDowntimePenalty()
+	require.Equal(first, params.DowntimePenalty(0))
+	require.Equal(first, params.DowntimePenalty(3))
+
+	second := types.DowntimePenalty{JailDuration: 2 * time.Hour, SlashFraction: sdk.NewDecWithPrec(2, 2)}
+	third := types.DowntimePenalty{JailDuration: 4 * time.Hour, SlashFraction: sdk.NewDecWithPrec(4, 2)}
+	params.RepeatDowntimePenalties = []types.DowntimePenalty{second, third}
+	require.NoError(params.Validate())
+
+	require.Equal(first, params.DowntimePenalty(0))
+	require.Equal(second, params.DowntimePenalty(1))
+	require.Equal(third, params.DowntimePenalty(2))
+	require.Equal(third, params.DowntimePenalty(5))
+}
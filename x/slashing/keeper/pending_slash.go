@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// QueueSlash records a slash of a validator, executed once the slash delay has
+// elapsed unless it is cancelled by the authority in the meantime.
+func (k Keeper) QueueSlash(
+	ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64,
+	infraction stakingtypes.Infraction,
+) types.PendingSlash {
+	id := k.getNextPendingSlashID(ctx)
+	k.setNextPendingSlashID(ctx, id+1)
+
+	executionTime := ctx.BlockHeader().Time.Add(k.SlashDelay(ctx))
+	pendingSlash := types.NewPendingSlash(
+		id, consAddr, distributionHeight, power, fraction, infraction, ctx.BlockHeight(), executionTime,
+	)
+	k.SetPendingSlash(ctx, pendingSlash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueSlash,
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyReason, infractionReason(infraction)),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, executionTime.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"queued slash",
+		"id", id,
+		"validator", consAddr.String(),
+		"fraction", fraction.String(),
+		"execution_time", executionTime,
+	)
+
+	return pendingSlash
+}
+
+// CancelPendingSlash removes a pending slash, which will never be executed. The
+// validator stays jailed, and tombstoned if it was.
+func (k Keeper) CancelPendingSlash(ctx sdk.Context, id uint64) error {
+	pendingSlash, found := k.GetPendingSlash(ctx, id)
+	if !found {
+		return types.ErrPendingSlashNotFound.Wrapf("id %d", id)
+	}
+
+	k.deletePendingSlash(ctx, pendingSlash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelPendingSlash,
+			sdk.NewAttribute(types.AttributeKeyID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyAddress, pendingSlash.ValidatorAddress),
+		),
+	)
+
+	return nil
+}
+
+// ExecutePendingSlashes executes the pending slashes whose execution time has
+// been reached.
+//
+// The effective slash delay is capped at the unbonding time of x/staking: if the
+// unbonding time was shortened below the slash delay after slashes were queued,
+// these are executed early so that they are still executed before the stake
+// subject to them completes its unbonding.
+func (k Keeper) ExecutePendingSlashes(ctx sdk.Context) {
+	endTime := ctx.BlockHeader().Time
+	if slashDelay := k.SlashDelay(ctx); slashDelay > 0 {
+		if unbondingTime := k.sk.UnbondingTime(ctx); slashDelay > unbondingTime {
+			endTime = endTime.Add(slashDelay - unbondingTime)
+		}
+	}
+
+	var ids []uint64
+	k.iteratePendingSlashQueue(ctx, endTime, func(id uint64) bool {
+		ids = append(ids, id)
+		return false
+	})
+
+	for _, id := range ids {
+		pendingSlash, found := k.GetPendingSlash(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("pending slash %d queued but not found", id))
+		}
+		k.deletePendingSlash(ctx, pendingSlash)
+
+		consAddr := pendingSlash.GetValidatorConsAddr()
+
+		// Defensive: the validator may have been removed, or have completed its
+		// unbonding in the meantime.
+		validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+		if validator == nil || validator.IsUnbonded() {
+			k.Logger(ctx).Info(
+				"ignored pending slash; validator not found or unbonded",
+				"id", id,
+				"validator", consAddr.String(),
+			)
+			continue
+		}
+
+		k.executeSlash(
+			ctx, consAddr, pendingSlash.SlashFraction, pendingSlash.Power,
+			pendingSlash.InfractionHeight, pendingSlash.Infraction,
+		)
+	}
+}
+
+// GetPendingSlash returns a pending slash by its ID.
+func (k Keeper) GetPendingSlash(ctx sdk.Context, id uint64) (pendingSlash types.PendingSlash, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingSlashKey(id))
+	if bz == nil {
+		return pendingSlash, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &pendingSlash)
+	return pendingSlash, true
+}
+
+// SetPendingSlash stores a pending slash and queues it by its execution time.
+func (k Keeper) SetPendingSlash(ctx sdk.Context, pendingSlash types.PendingSlash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingSlashKey(pendingSlash.Id), k.cdc.MustMarshal(&pendingSlash))
+	store.Set(types.PendingSlashQueueKey(pendingSlash.Id, pendingSlash.ExecutionTime), []byte{})
+}
+
+// IteratePendingSlashes iterates over the pending slashes by ID.
+func (k Keeper) IteratePendingSlashes(ctx sdk.Context, cb func(pendingSlash types.PendingSlash) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingSlashKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pendingSlash types.PendingSlash
+		k.cdc.MustUnmarshal(iter.Value(), &pendingSlash)
+		if cb(pendingSlash) {
+			break
+		}
+	}
+}
+
+// GetPendingSlashes returns all the pending slashes.
+func (k Keeper) GetPendingSlashes(ctx sdk.Context) (pendingSlashes []types.PendingSlash) {
+	k.IteratePendingSlashes(ctx, func(pendingSlash types.PendingSlash) bool {
+		pendingSlashes = append(pendingSlashes, pendingSlash)
+		return false
+	})
+
+	return pendingSlashes
+}
+
+// GetAffectedDelegators returns the delegators whose stake is subject to a
+// pending slash: the current delegators of the validator, which share its
+// slashed tokens, and the delegators which started unbonding or redelegating
+// from the validator since the infraction height.
+func (k Keeper) GetAffectedDelegators(ctx sdk.Context, pendingSlash types.PendingSlash) []types.AffectedDelegator {
+	validator := k.sk.ValidatorByConsAddr(ctx, pendingSlash.GetValidatorConsAddr())
+	if validator == nil {
+		return []types.AffectedDelegator{}
+	}
+	valAddr := validator.GetOperator()
+
+	var delegators []types.AffectedDelegator
+	indexes := make(map[string]int)
+	affectedDelegator := func(delAddr string) *types.AffectedDelegator {
+		i, ok := indexes[delAddr]
+		if !ok {
+			i = len(delegators)
+			indexes[delAddr] = i
+			delegators = append(delegators, types.AffectedDelegator{
+				DelegatorAddress: delAddr,
+				Shares:           math.LegacyZeroDec(),
+				Unbonding:        math.ZeroInt(),
+				Redelegated:      math.LegacyZeroDec(),
+			})
+		}
+		return &delegators[i]
+	}
+
+	for _, delegation := range k.sk.GetValidatorDelegations(ctx, valAddr) {
+		ad := affectedDelegator(delegation.DelegatorAddress)
+		ad.Shares = ad.Shares.Add(delegation.Shares)
+	}
+
+	for _, ubd := range k.sk.GetUnbondingDelegationsFromValidator(ctx, valAddr) {
+		for _, entry := range ubd.Entries {
+			if entry.CreationHeight < pendingSlash.InfractionHeight || entry.IsMature(ctx.BlockHeader().Time) {
+				continue
+			}
+			ad := affectedDelegator(ubd.DelegatorAddress)
+			ad.Unbonding = ad.Unbonding.Add(entry.Balance)
+		}
+	}
+
+	for _, red := range k.sk.GetRedelegationsFromSrcValidator(ctx, valAddr) {
+		for _, entry := range red.Entries {
+			if entry.CreationHeight < pendingSlash.InfractionHeight || entry.IsMature(ctx.BlockHeader().Time) {
+				continue
+			}
+			ad := affectedDelegator(red.DelegatorAddress)
+			ad.Redelegated = ad.Redelegated.Add(entry.SharesDst)
+		}
+	}
+
+	return delegators
+}
+
+func (k Keeper) deletePendingSlash(ctx sdk.Context, pendingSlash types.PendingSlash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingSlashKey(pendingSlash.Id))
+	store.Delete(types.PendingSlashQueueKey(pendingSlash.Id, pendingSlash.ExecutionTime))
+}
+
+// iteratePendingSlashQueue iterates over the IDs of the pending slashes whose
+// execution time is at or before the given time, by execution time.
+func (k Keeper) iteratePendingSlashQueue(ctx sdk.Context, endTime time.Time, cb func(id uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.PendingSlashQueueKeyPrefix, sdk.PrefixEndBytes(types.PendingSlashQueueTimePrefix(endTime)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.SplitPendingSlashQueueKey(iter.Key())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getNextPendingSlashID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextPendingSlashIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextPendingSlashID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextPendingSlashIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// infractionReason returns the reason attribute value of an infraction.
+func infractionReason(infraction stakingtypes.Infraction) string {
+	switch infraction {
+	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
+		return types.AttributeValueDoubleSign
+	case stakingtypes.Infraction_INFRACTION_DOWNTIME:
+		return types.AttributeValueMissingSignature
+	default:
+		return types.AttributeValueUnspecified
+	}
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestSlashWithDelay() {
+	require := s.Require()
+	ctx, keeper := s.ctx, s.slashingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SlashDelay = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	fraction := keeper.SlashFractionDoubleSign(ctx)
+	keeper.SlashWithInfractionReason(ctx, consAddr, fraction, 10, ctx.BlockHeight(), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+
+	// the slash is queued instead of executed
+	pendingSlashes := keeper.GetPendingSlashes(ctx)
+	require.Len(pendingSlashes, 1)
+	pendingSlash := pendingSlashes[0]
+	require.Equal(uint64(1), pendingSlash.Id)
+	require.Equal(consAddr, pendingSlash.GetValidatorConsAddr())
+	require.Equal(fraction, pendingSlash.SlashFraction)
+	require.Equal(ctx.BlockTime().Add(time.Hour), pendingSlash.ExecutionTime)
+
+	// nothing is executed before the delay has elapsed
+	s.stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(stakingtypes.DefaultUnbondingTime).Times(3)
+	keeper.ExecutePendingSlashes(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)))
+	require.Len(keeper.GetPendingSlashes(ctx), 1)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	val, err := stakingtypes.NewValidator(sdk.ValAddress(addr), pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Status = stakingtypes.Bonded
+
+	execCtx := ctx.WithBlockTime(pendingSlash.ExecutionTime)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(execCtx, consAddr).Return(val)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(execCtx,
+		consAddr,
+		ctx.BlockHeight(),
+		int64(10),
+		fraction,
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	).Return(sdk.NewInt(100))
+
+	keeper.ExecutePendingSlashes(execCtx)
+	require.Empty(keeper.GetPendingSlashes(ctx))
+
+	// a slash of an unbonded validator is dropped
+	keeper.SlashWithInfractionReason(ctx, consAddr, fraction, 10, ctx.BlockHeight(), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	pendingSlash, found := keeper.GetPendingSlash(ctx, 2)
+	require.True(found)
+
+	val.Status = stakingtypes.Unbonded
+	execCtx = ctx.WithBlockTime(pendingSlash.ExecutionTime)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(execCtx, consAddr).Return(val)
+
+	keeper.ExecutePendingSlashes(execCtx)
+	require.Empty(keeper.GetPendingSlashes(ctx))
+}
+
+func (s *KeeperTestSuite) TestSlashDelayCappedAtUnbondingTime() {
+	require := s.Require()
+	ctx, keeper := s.ctx, s.slashingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SlashDelay = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	fraction := keeper.SlashFractionDoubleSign(ctx)
+	pendingSlash := keeper.QueueSlash(ctx, consAddr, fraction, 10, ctx.BlockHeight(), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.Equal(ctx.BlockTime().Add(time.Hour), pendingSlash.ExecutionTime)
+
+	// the unbonding time is shortened below the slash delay after the slash is queued
+	s.stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(10 * time.Minute).Times(2)
+
+	// nothing is executed before the unbonding time has elapsed
+	keeper.ExecutePendingSlashes(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)))
+	require.Len(keeper.GetPendingSlashes(ctx), 1)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	val, err := stakingtypes.NewValidator(sdk.ValAddress(addr), pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Status = stakingtypes.Bonded
+
+	// the slash is executed once the unbonding time has elapsed, before its execution time
+	execCtx := ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Minute))
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(execCtx, consAddr).Return(val)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(execCtx,
+		consAddr,
+		ctx.BlockHeight(),
+		int64(10),
+		fraction,
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	).Return(sdk.NewInt(100))
+
+	keeper.ExecutePendingSlashes(execCtx)
+	require.Empty(keeper.GetPendingSlashes(ctx))
+}
+
+func (s *KeeperTestSuite) TestGetAffectedDelegators() {
+	require := s.Require()
+	ctx, keeper := s.ctx.WithBlockHeight(10), s.slashingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SlashDelay = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	pendingSlash := keeper.QueueSlash(ctx, consAddr, keeper.SlashFractionDoubleSign(ctx), 10, 5, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	val, err := stakingtypes.NewValidator(valAddr, pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+
+	delAddr1 := sdk.AccAddress([]byte("delegator1__________"))
+	delAddr2 := sdk.AccAddress([]byte("delegator2__________"))
+	dstValAddr := sdk.ValAddress([]byte("validator2__________"))
+	completionTime := ctx.BlockTime().Add(time.Hour)
+
+	ubd := stakingtypes.NewUnbondingDelegation(delAddr1, valAddr, 6, completionTime, sdk.NewInt(20), 1)
+	// unbonded before the infraction, not subject to the slash
+	ubd.AddEntry(4, completionTime, sdk.NewInt(30), 2)
+	red := stakingtypes.NewRedelegation(delAddr2, valAddr, dstValAddr, 7, completionTime, sdk.NewInt(40), sdk.NewDec(40), 3)
+
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(val)
+	s.stakingKeeper.EXPECT().GetValidatorDelegations(ctx, valAddr).Return([]stakingtypes.Delegation{
+		stakingtypes.NewDelegation(delAddr1, valAddr, sdk.NewDec(100)),
+	})
+	s.stakingKeeper.EXPECT().GetUnbondingDelegationsFromValidator(ctx, valAddr).Return([]stakingtypes.UnbondingDelegation{ubd})
+	s.stakingKeeper.EXPECT().GetRedelegationsFromSrcValidator(ctx, valAddr).Return([]stakingtypes.Redelegation{red})
+
+	delegators := keeper.GetAffectedDelegators(ctx, pendingSlash)
+	require.Len(delegators, 2)
+
+	require.Equal(delAddr1.String(), delegators[0].DelegatorAddress)
+	require.Equal(sdk.NewDec(100), delegators[0].Shares)
+	require.Equal(sdk.NewInt(20), delegators[0].Unbonding)
+	require.True(delegators[0].Redelegated.IsZero())
+
+	require.Equal(delAddr2.String(), delegators[1].DelegatorAddress)
+	require.True(delegators[1].Shares.IsZero())
+	require.True(delegators[1].Unbonding.IsZero())
+	require.Equal(sdk.NewDec(40), delegators[1].Redelegated)
+}
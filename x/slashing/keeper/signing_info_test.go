@@ -95,3 +95,27 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBitmap_SmallWindow() {
 		require.Len(missedBlocks, int(params.SignedBlocksWindow)-1)
 	}
 }
+
+func (s *KeeperTestSuite) TestDowntimeOffences() {
+	require := s.Require()
+
+	now := time.Unix(1_000_000, 0).UTC()
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 0, time.Unix(0, 0), false, 0)
+
+	info.AddDowntimeOffence(now.Add(-3*time.Hour), 3)
+	info.AddDowntimeOffence(now.Add(-2*time.Hour), 3)
+	info.AddDowntimeOffence(now.Add(-time.Hour), 3)
+	info.AddDowntimeOffence(now, 3)
+	// only the most recent offences are kept
+	require.Equal([]time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour), now}, info.DowntimeOffences)
+
+	// no offence decays with a zero decay window
+	info.PruneDowntimeOffences(now, 0)
+	require.Len(info.DowntimeOffences, 3)
+
+	info.PruneDowntimeOffences(now, time.Hour)
+	require.Equal([]time.Time{now}, info.DowntimeOffences)
+
+	info.PruneDowntimeOffences(now.Add(time.Hour), time.Hour)
+	require.Empty(info.DowntimeOffences)
+}
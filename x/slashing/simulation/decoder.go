@@ -37,6 +37,18 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.PendingSlashKeyPrefix):
+			var pendingSlashA, pendingSlashB types.PendingSlash
+			cdc.MustUnmarshal(kvA.Value, &pendingSlashA)
+			cdc.MustUnmarshal(kvB.Value, &pendingSlashB)
+			return fmt.Sprintf("%v\n%v", pendingSlashA, pendingSlashB)
+
+		case bytes.Equal(kvA.Key[:1], types.PendingSlashQueueKeyPrefix):
+			return fmt.Sprintf("%d\n%d", types.SplitPendingSlashQueueKey(kvA.Key), types.SplitPendingSlashQueueKey(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.NextPendingSlashIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
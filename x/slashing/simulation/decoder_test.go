@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // nolint:deadcode,unused,varcheck
@@ -31,6 +32,7 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, time.Now().UTC(), false, 0)
 	missed := []byte{1} // we want to display the bytes for simulation diffs
+	pendingSlash := types.NewPendingSlash(1, consAddr1, 5, 10, sdk.NewDecWithPrec(5, 2), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, 6, time.Now().UTC())
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -39,6 +41,9 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitmapKey(consAddr1, 6), Value: missed},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.PendingSlashKey(1), Value: cdc.MustMarshal(&pendingSlash)},
+			{Key: types.PendingSlashQueueKey(1, pendingSlash.ExecutionTime), Value: []byte{}},
+			{Key: types.NextPendingSlashIDKey, Value: sdk.Uint64ToBigEndian(2)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -51,6 +56,9 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v\n", missed, missed), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"PendingSlash", fmt.Sprintf("%v\n%v", pendingSlash, pendingSlash), false},
+		{"PendingSlashQueue", "1\n1", false},
+		{"NextPendingSlashID", "2\n2", false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -5,13 +5,18 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
-	AttributeKeyAddress      = "address"
-	AttributeKeyHeight       = "height"
-	AttributeKeyPower        = "power"
-	AttributeKeyReason       = "reason"
-	AttributeKeyJailed       = "jailed"
-	AttributeKeyMissedBlocks = "missed_blocks"
-	AttributeKeyBurnedCoins  = "burned_coins"
+	EventTypeQueueSlash         = "queue_slash"
+	EventTypeCancelPendingSlash = "cancel_pending_slash"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyHeight        = "height"
+	AttributeKeyPower         = "power"
+	AttributeKeyReason        = "reason"
+	AttributeKeyJailed        = "jailed"
+	AttributeKeyMissedBlocks  = "missed_blocks"
+	AttributeKeyBurnedCoins   = "burned_coins"
+	AttributeKeyID            = "id"
+	AttributeKeyExecutionTime = "execution_time"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
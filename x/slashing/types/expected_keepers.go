@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -56,6 +58,15 @@ type StakingKeeper interface {
 
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx sdk.Context, addr sdk.ConsAddress) bool
+
+	// UnbondingTime returns the unbonding time of the delegations.
+	UnbondingTime(ctx sdk.Context) time.Duration
+
+	// the delegations and unbonding delegations of and redelegations from a
+	// validator, subject to its slashes
+	GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.Delegation
+	GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.UnbondingDelegation
+	GetRedelegationsFromSrcValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.Redelegation
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
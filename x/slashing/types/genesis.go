@@ -12,9 +12,10 @@ func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:         params,
+		SigningInfos:   signingInfos,
+		MissedBlocks:   missedBlocks,
+		PendingSlashes: []PendingSlash{},
 	}
 }
 
@@ -29,9 +30,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:         DefaultParams(),
+		SigningInfos:   []SigningInfo{},
+		MissedBlocks:   []ValidatorMissedBlocks{},
+		PendingSlashes: []PendingSlash{},
 	}
 }
 
@@ -62,5 +64,21 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if data.Params.SlashDelay < 0 {
+		return fmt.Errorf("slash delay cannot be negative, is %s", data.Params.SlashDelay)
+	}
+
+	ids := make(map[uint64]bool, len(data.PendingSlashes))
+	for _, ps := range data.PendingSlashes {
+		if ids[ps.Id] {
+			return fmt.Errorf("duplicate pending slash id %d", ps.Id)
+		}
+		ids[ps.Id] = true
+
+		if err := ps.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -35,6 +35,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// pending_slashes are the slashes awaiting their execution.
+	PendingSlashes []PendingSlash `protobuf:"bytes,4,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +93,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetPendingSlashes() []PendingSlash {
+	if m != nil {
+		return m.PendingSlashes
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,35 +278,36 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x14, 0xce, 0xb9, 0x1b, 0xb0, 0xc2, 0x11, 0x6e, 0xc8, 0x9d, 0x2a, 0x40,
-	0x27, 0xa4, 0x24, 0xba, 0x32, 0x32, 0x91, 0xe5, 0xc4, 0x80, 0x84, 0xd2, 0x13, 0x03, 0x03, 0x91,
-	0xd3, 0xf8, 0x7c, 0xd6, 0x35, 0x76, 0x95, 0xcf, 0x54, 0xe5, 0x0d, 0x18, 0x79, 0x05, 0x36, 0x46,
-	0x90, 0x78, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x42, 0xed, 0xc0, 0x6b, 0x20, 0xec, 0x94, 0x06, 0xd4,
-	0xa8, 0x12, 0x4b, 0x12, 0xfb, 0xfb, 0x7d, 0xff, 0xef, 0x9f, 0x7f, 0x1c, 0xfc, 0x60, 0xa4, 0xa0,
-	0x54, 0x10, 0xc3, 0x98, 0xc2, 0x95, 0x90, 0x3c, 0x9e, 0x9e, 0xe5, 0x4c, 0xd3, 0xb3, 0x98, 0x33,
-	0xc9, 0x40, 0x40, 0x34, 0xa9, 0x94, 0x56, 0xe4, 0xae, 0xc5, 0xa2, 0x35, 0x16, 0xd5, 0xd8, 0x91,
-	0xc7, 0x15, 0x57, 0x86, 0x89, 0x7f, 0x3f, 0x59, 0xfc, 0xe8, 0x61, 0x9b, 0xea, 0x9f, 0x7e, 0xcb,
-	0xdd, 0xb3, 0x5c, 0x66, 0x05, 0xea, 0x19, 0xb6, 0x74, 0x9b, 0x96, 0x42, 0xaa, 0xd8, 0x5c, 0xed,
-	0x56, 0xff, 0x5d, 0x07, 0xef, 0x9f, 0x5b, 0x5b, 0x43, 0x4d, 0x35, 0x23, 0x09, 0xee, 0x4d, 0x68,
-	0x45, 0x4b, 0xf0, 0xd1, 0x09, 0x3a, 0x75, 0x07, 0xc7, 0x51, 0x8b, 0xcd, 0xe8, 0x85, 0xc1, 0x92,
-	0xbd, 0xf9, 0xf7, 0x63, 0xe7, 0xe3, 0xcf, 0x4f, 0x8f, 0x50, 0x5a, 0x77, 0x92, 0x0b, 0x7c, 0x00,
-	0x82, 0x4b, 0x21, 0x79, 0x26, 0xe4, 0xa5, 0x02, 0xbf, 0x73, 0xd2, 0x3d, 0x75, 0x07, 0xf7, 0x5b,
-	0xa5, 0x86, 0x96, 0x7e, 0x26, 0x2f, 0x55, 0x53, 0x6f, 0x1f, 0x36, 0xfb, 0x40, 0x5e, 0xe3, 0x83,
-	0x52, 0x00, 0xb0, 0x22, 0xcb, 0xc7, 0x6a, 0x74, 0x0d, 0x7e, 0xd7, 0xa8, 0x46, 0xad, 0xaa, 0x2f,
-	0xe9, 0x58, 0x14, 0x54, 0xab, 0xea, 0xb9, 0x69, 0x4b, 0x4c, 0xd7, 0x5f, 0xfa, 0x65, 0xa3, 0xd0,
-	0xff, 0x8c, 0xb0, 0xdb, 0x30, 0x42, 0x06, 0xf8, 0x26, 0x2d, 0x8a, 0x8a, 0x81, 0x8d, 0x62, 0x2f,
-	0xf1, 0xbf, 0x7e, 0x09, 0xbd, 0x7a, 0xd8, 0x53, 0x5b, 0x19, 0xea, 0x4a, 0x48, 0x9e, 0xae, 0x41,
-	0x22, 0xf1, 0xe1, 0x74, 0x3d, 0x35, 0x6b, 0x66, 0xe0, 0x77, 0x4c, 0x9a, 0xe1, 0x6e, 0xb3, 0x2d,
-	0x59, 0x78, 0xd3, 0x2d, 0x40, 0xff, 0x03, 0xc2, 0x77, 0xb6, 0xbe, 0xe6, 0x7f, 0xb9, 0xbf, 0xf8,
-	0x37, 0xe1, 0x5d, 0xdf, 0xad, 0x31, 0xb1, 0x3d, 0xd7, 0x27, 0xd8, 0x6d, 0x70, 0xc4, 0xc3, 0x37,
-	0x84, 0x2c, 0xd8, 0xcc, 0xd8, 0xea, 0xa6, 0x76, 0x41, 0x0e, 0x71, 0xcf, 0x36, 0x99, 0xa0, 0x6e,
-	0xa5, 0xf5, 0x2a, 0x39, 0x9f, 0x2f, 0x03, 0xb4, 0x58, 0x06, 0xe8, 0xc7, 0x32, 0x40, 0xef, 0x57,
-	0x81, 0xb3, 0x58, 0x05, 0xce, 0xb7, 0x55, 0xe0, 0xbc, 0x0a, 0xb9, 0xd0, 0x57, 0x6f, 0xf2, 0x68,
-	0xa4, 0xca, 0xfa, 0x94, 0xd7, 0xb7, 0x10, 0x8a, 0xeb, 0x78, 0xb6, 0xf9, 0x4f, 0xf4, 0xdb, 0x09,
-	0x83, 0xbc, 0x67, 0xce, 0xfb, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x26, 0x14, 0xa7, 0x33,
-	0x9d, 0x03, 0x00, 0x00,
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x16, 0x0a, 0x73, 0x37, 0x10, 0x56, 0x19, 0x61, 0x87, 0x6c, 0xaa, 0x18, 0x9a,
+	0x90, 0x9a, 0x68, 0xe5, 0xc8, 0x89, 0x5c, 0x26, 0x0e, 0x48, 0x28, 0x99, 0x90, 0xe0, 0x40, 0xe4,
+	0x34, 0x9e, 0x67, 0xad, 0xb1, 0xa3, 0x3c, 0x53, 0x8d, 0x6f, 0xc1, 0x57, 0xe0, 0xc6, 0x11, 0x24,
+	0x3e, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x08, 0x35, 0x07, 0xbe, 0x06, 0xaa, 0x9d, 0x32, 0x83, 0x16,
+	0x4d, 0xda, 0x25, 0x89, 0xfd, 0x7e, 0xef, 0xff, 0x9e, 0xff, 0xce, 0xc3, 0xbb, 0x53, 0x05, 0x85,
+	0x82, 0x10, 0x66, 0x14, 0x8e, 0x85, 0xe4, 0xe1, 0x7c, 0x3f, 0x63, 0x9a, 0xee, 0x87, 0x9c, 0x49,
+	0x06, 0x02, 0x82, 0xb2, 0x52, 0x5a, 0x91, 0x07, 0x16, 0x0b, 0x56, 0x58, 0xd0, 0x60, 0x5b, 0x43,
+	0xae, 0xb8, 0x32, 0x4c, 0xb8, 0xfc, 0xb2, 0xf8, 0xd6, 0xe3, 0x36, 0xd5, 0xbf, 0xf9, 0x96, 0x7b,
+	0x68, 0xb9, 0xd4, 0x0a, 0x34, 0x35, 0x6c, 0xe8, 0x1e, 0x2d, 0x84, 0x54, 0xa1, 0x79, 0xda, 0xad,
+	0x51, 0xdd, 0xc5, 0xeb, 0x07, 0xb6, 0xad, 0x44, 0x53, 0xcd, 0x48, 0x84, 0xfb, 0x25, 0xad, 0x68,
+	0x01, 0x1e, 0xda, 0x41, 0x7b, 0x83, 0xc9, 0x76, 0xd0, 0xd2, 0x66, 0xf0, 0xca, 0x60, 0xd1, 0xda,
+	0xd9, 0xcf, 0xed, 0xce, 0xe7, 0xdf, 0x5f, 0x9e, 0xa0, 0xb8, 0xc9, 0x24, 0x87, 0x78, 0x03, 0x04,
+	0x97, 0x42, 0xf2, 0x54, 0xc8, 0x23, 0x05, 0x5e, 0x77, 0xa7, 0xb7, 0x37, 0x98, 0x3c, 0x6a, 0x95,
+	0x4a, 0x2c, 0xfd, 0x42, 0x1e, 0x29, 0x57, 0x6f, 0x1d, 0x2e, 0xf6, 0x81, 0xbc, 0xc3, 0x1b, 0x85,
+	0x00, 0x60, 0x79, 0x9a, 0xcd, 0xd4, 0xf4, 0x04, 0xbc, 0x9e, 0x51, 0x0d, 0x5a, 0x55, 0x5f, 0xd3,
+	0x99, 0xc8, 0xa9, 0x56, 0xd5, 0x4b, 0x93, 0x16, 0x99, 0xac, 0x7f, 0xf4, 0x0b, 0x27, 0x40, 0xde,
+	0xe0, 0xbb, 0x25, 0x93, 0xf9, 0xb2, 0x6b, 0x23, 0xc5, 0xc0, 0xbb, 0x61, 0x2a, 0xec, 0xb6, 0x5b,
+	0x60, 0xf9, 0x64, 0xb9, 0xef, 0x0a, 0xdf, 0x29, 0x9d, 0x00, 0x83, 0xd1, 0x57, 0x84, 0x07, 0xce,
+	0x19, 0xc9, 0x04, 0xdf, 0xa2, 0x79, 0x5e, 0x31, 0xb0, 0x2e, 0xaf, 0x45, 0xde, 0xf7, 0x6f, 0xe3,
+	0x61, 0x53, 0xe5, 0xb9, 0x8d, 0x24, 0xba, 0x12, 0x92, 0xc7, 0x2b, 0x90, 0x48, 0xbc, 0x39, 0x5f,
+	0x1d, 0x28, 0x75, 0xed, 0xf5, 0xba, 0xe6, 0xa2, 0xc6, 0x57, 0xfb, 0xd0, 0x62, 0xf3, 0x70, 0x7e,
+	0x09, 0x30, 0xfa, 0x84, 0xf0, 0xfd, 0x4b, 0x1d, 0xbc, 0x56, 0xf7, 0x87, 0xff, 0x5f, 0xde, 0x55,
+	0xbf, 0x84, 0x53, 0xb1, 0xf5, 0xca, 0x46, 0xcf, 0xf0, 0xc0, 0xe1, 0xc8, 0x10, 0xdf, 0x14, 0x32,
+	0x67, 0xa7, 0xa6, 0xad, 0x5e, 0x6c, 0x17, 0x64, 0x13, 0xf7, 0x6d, 0x92, 0x31, 0xea, 0x76, 0xdc,
+	0xac, 0xa2, 0x83, 0xb3, 0x85, 0x8f, 0xce, 0x17, 0x3e, 0xfa, 0xb5, 0xf0, 0xd1, 0xc7, 0xda, 0xef,
+	0x9c, 0xd7, 0x7e, 0xe7, 0x47, 0xed, 0x77, 0xde, 0x8e, 0xb9, 0xd0, 0xc7, 0xef, 0xb3, 0x60, 0xaa,
+	0x8a, 0x66, 0x80, 0x9a, 0xd7, 0x18, 0xf2, 0x93, 0xf0, 0xf4, 0x62, 0x04, 0xf5, 0x87, 0x92, 0x41,
+	0xd6, 0x37, 0xa3, 0xf4, 0xf4, 0xcf, 0x00, 0xea, 0xb9, 0xa7, 0xe0, 0xf8, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +330,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSlashes) > 0 {
+		for iNdEx := len(m.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +538,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingSlashes) > 0 {
+		for _, e := range m.PendingSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -702,6 +732,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSlashes = append(m.PendingSlashes, PendingSlash{})
+			if err := m.PendingSlashes[len(m.PendingSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -46,6 +47,12 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x20<id_Bytes>: PendingSlash
+//
+// - 0x21<executionTime_Bytes><id_Bytes>: []byte{}
+//
+// - 0x22: uint64 (next pending slash ID)
 
 var (
 	ParamsKey                     = []byte{0x00} // Prefix for params key
@@ -54,6 +61,10 @@ var (
 
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x10} // Prefix for missed block bitmap
 
+	PendingSlashKeyPrefix      = []byte{0x20} // Prefix for pending slashes
+	PendingSlashQueueKeyPrefix = []byte{0x21} // Prefix for the queue of pending slashes by execution time
+	NextPendingSlashIDKey      = []byte{0x22} // Key for the next pending slash ID
+
 	IsPruningKey  = []byte{0x09}
 	TrueByteValue = []byte{0x01}
 )
@@ -91,3 +102,27 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// PendingSlashKey returns the key of a pending slash.
+func PendingSlashKey(id uint64) []byte {
+	return append(PendingSlashKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// PendingSlashQueueTimePrefix returns the prefix of the pending slashes queue
+// entries executed at the given time.
+func PendingSlashQueueTimePrefix(executionTime time.Time) []byte {
+	return append(PendingSlashQueueKeyPrefix, sdk.FormatTimeBytes(executionTime)...)
+}
+
+// PendingSlashQueueKey returns the key of a pending slash in the queue of
+// pending slashes by execution time.
+func PendingSlashQueueKey(id uint64, executionTime time.Time) []byte {
+	return append(PendingSlashQueueTimePrefix(executionTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitPendingSlashQueueKey returns the pending slash ID of a key of the queue
+// of pending slashes.
+func SplitPendingSlashQueueKey(key []byte) uint64 {
+	kv.AssertKeyLength(key, 1+len(sdk.FormatTimeBytes(time.Time{}))+8)
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
@@ -14,6 +14,7 @@ const (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCancelPendingSlash{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -74,3 +75,23 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCancelPendingSlash) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCancelPendingSlash message.
+func (msg *MsgCancelPendingSlash) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgCancelPendingSlash) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
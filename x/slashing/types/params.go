@@ -63,6 +63,15 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateSlashDelay(p.SlashDelay); err != nil {
+		return err
+	}
+	if err := validateDowntimeOffenceDecayWindow(p.DowntimeOffenceDecayWindow); err != nil {
+		return err
+	}
+	if err := validateRepeatDowntimePenalties(p.RepeatDowntimePenalties, p.FirstDowntimePenalty()); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -149,6 +158,73 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateSlashDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("slash delay cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeOffenceDecayWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offence decay window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// validateRepeatDowntimePenalties checks that the repeat downtime penalties
+// escalate from the penalty of a first offence.
+func validateRepeatDowntimePenalties(penalties []DowntimePenalty, first DowntimePenalty) error {
+	prev := first
+	for i, penalty := range penalties {
+		if penalty.JailDuration <= 0 {
+			return fmt.Errorf("jail duration of repeat downtime penalty %d must be positive: %s", i, penalty.JailDuration)
+		}
+		if penalty.SlashFraction.IsNil() || penalty.SlashFraction.IsNegative() || penalty.SlashFraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("slash fraction of repeat downtime penalty %d must be between 0 and 1: %s", i, penalty.SlashFraction)
+		}
+		if penalty.JailDuration < prev.JailDuration || penalty.SlashFraction.LT(prev.SlashFraction) {
+			return fmt.Errorf("repeat downtime penalty %d cannot be lower than the previous penalty", i)
+		}
+		prev = penalty
+	}
+
+	return nil
+}
+
+// FirstDowntimePenalty returns the penalty of a first downtime offence.
+func (p Params) FirstDowntimePenalty() DowntimePenalty {
+	return DowntimePenalty{
+		JailDuration:  p.DowntimeJailDuration,
+		SlashFraction: p.SlashFractionDowntime,
+	}
+}
+
+// DowntimePenalty returns the penalty of a downtime offence of a validator
+// with the given number of prior offences.
+func (p Params) DowntimePenalty(priorOffences int) DowntimePenalty {
+	if priorOffences == 0 || len(p.RepeatDowntimePenalties) == 0 {
+		return p.FirstDowntimePenalty()
+	}
+	if priorOffences > len(p.RepeatDowntimePenalties) {
+		priorOffences = len(p.RepeatDowntimePenalties)
+	}
+
+	return p.RepeatDowntimePenalties[priorOffences-1]
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// NewPendingSlash creates a new PendingSlash instance
+//
+//nolint:interfacer
+func NewPendingSlash(
+	id uint64, consAddr sdk.ConsAddress, infractionHeight, power int64, fraction sdk.Dec,
+	infraction stakingtypes.Infraction, height int64, executionTime time.Time,
+) PendingSlash {
+	return PendingSlash{
+		Id:               id,
+		ValidatorAddress: consAddr.String(),
+		InfractionHeight: infractionHeight,
+		Power:            power,
+		SlashFraction:    fraction,
+		Infraction:       infraction,
+		Height:           height,
+		ExecutionTime:    executionTime,
+	}
+}
+
+// GetValidatorConsAddr returns the consensus address of the slashed validator.
+func (ps PendingSlash) GetValidatorConsAddr() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(ps.ValidatorAddress)
+	return addr
+}
+
+// Validate performs a stateless validation of the pending slash.
+func (ps PendingSlash) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(ps.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid validator address of pending slash %d: %w", ps.Id, err)
+	}
+	if ps.Power < 0 {
+		return fmt.Errorf("power of pending slash %d cannot be negative: %d", ps.Id, ps.Power)
+	}
+	if ps.SlashFraction.IsNil() || ps.SlashFraction.IsNegative() || ps.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("slash fraction of pending slash %d must be between 0 and 1: %s", ps.Id, ps.SlashFraction)
+	}
+
+	return nil
+}
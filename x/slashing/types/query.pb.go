@@ -166,6 +166,12 @@ func (m *QuerySigningInfoRequest) GetConsAddress() string {
 type QuerySigningInfoResponse struct {
 	// val_signing_info is the signing info of requested val cons address
 	ValSigningInfo ValidatorSigningInfo `protobuf:"bytes,1,opt,name=val_signing_info,json=valSigningInfo,proto3" json:"val_signing_info"`
+	// prior_downtime_offences is the number of downtime offences of the validator
+	// which have not decayed yet.
+	PriorDowntimeOffences uint64 `protobuf:"varint,2,opt,name=prior_downtime_offences,json=priorDowntimeOffences,proto3" json:"prior_downtime_offences,omitempty"`
+	// next_downtime_penalty is the penalty of the next downtime offence of the
+	// validator.
+	NextDowntimePenalty DowntimePenalty `protobuf:"bytes,3,opt,name=next_downtime_penalty,json=nextDowntimePenalty,proto3" json:"next_downtime_penalty"`
 }
 
 func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
@@ -208,6 +214,20 @@ func (m *QuerySigningInfoResponse) GetValSigningInfo() ValidatorSigningInfo {
 	return ValidatorSigningInfo{}
 }
 
+func (m *QuerySigningInfoResponse) GetPriorDowntimeOffences() uint64 {
+	if m != nil {
+		return m.PriorDowntimeOffences
+	}
+	return 0
+}
+
+func (m *QuerySigningInfoResponse) GetNextDowntimePenalty() DowntimePenalty {
+	if m != nil {
+		return m.NextDowntimePenalty
+	}
+	return DowntimePenalty{}
+}
+
 // QuerySigningInfosRequest is the request type for the Query/SigningInfos RPC
 // method
 type QuerySigningInfosRequest struct {
@@ -309,6 +329,210 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryPendingSlashesRequest is the request type for the Query/PendingSlashes
+// RPC method
+type QueryPendingSlashesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingSlashesRequest) Reset()         { *m = QueryPendingSlashesRequest{} }
+func (m *QueryPendingSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashesRequest) ProtoMessage()    {}
+func (*QueryPendingSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryPendingSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashesRequest.Merge(m, src)
+}
+func (m *QueryPendingSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSlashesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSlashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingSlashesResponse is the response type for the Query/PendingSlashes
+// RPC method
+type QueryPendingSlashesResponse struct {
+	// pending_slashes are the slashes awaiting their execution
+	PendingSlashes []PendingSlash      `protobuf:"bytes,1,rep,name=pending_slashes,json=pendingSlashes,proto3" json:"pending_slashes"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingSlashesResponse) Reset()         { *m = QueryPendingSlashesResponse{} }
+func (m *QueryPendingSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashesResponse) ProtoMessage()    {}
+func (*QueryPendingSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryPendingSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashesResponse.Merge(m, src)
+}
+func (m *QueryPendingSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSlashesResponse) GetPendingSlashes() []PendingSlash {
+	if m != nil {
+		return m.PendingSlashes
+	}
+	return nil
+}
+
+func (m *QueryPendingSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingSlashRequest is the request type for the Query/PendingSlash RPC
+// method
+type QueryPendingSlashRequest struct {
+	// id is the identifier of the pending slash
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPendingSlashRequest) Reset()         { *m = QueryPendingSlashRequest{} }
+func (m *QueryPendingSlashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashRequest) ProtoMessage()    {}
+func (*QueryPendingSlashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *QueryPendingSlashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSlashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSlashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSlashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashRequest.Merge(m, src)
+}
+func (m *QueryPendingSlashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSlashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSlashRequest proto.InternalMessageInfo
+
+func (m *QueryPendingSlashRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryPendingSlashResponse is the response type for the Query/PendingSlash
+// RPC method
+type QueryPendingSlashResponse struct {
+	// pending_slash is the requested pending slash
+	PendingSlash PendingSlash `protobuf:"bytes,1,opt,name=pending_slash,json=pendingSlash,proto3" json:"pending_slash"`
+	// affected_delegators are the delegators whose stake is subject to the slash
+	AffectedDelegators []AffectedDelegator `protobuf:"bytes,2,rep,name=affected_delegators,json=affectedDelegators,proto3" json:"affected_delegators"`
+}
+
+func (m *QueryPendingSlashResponse) Reset()         { *m = QueryPendingSlashResponse{} }
+func (m *QueryPendingSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingSlashResponse) ProtoMessage()    {}
+func (*QueryPendingSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{9}
+}
+func (m *QueryPendingSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingSlashResponse.Merge(m, src)
+}
+func (m *QueryPendingSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingSlashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingSlashResponse proto.InternalMessageInfo
+
+func (m *QueryPendingSlashResponse) GetPendingSlash() PendingSlash {
+	if m != nil {
+		return m.PendingSlash
+	}
+	return PendingSlash{}
+}
+
+func (m *QueryPendingSlashResponse) GetAffectedDelegators() []AffectedDelegator {
+	if m != nil {
+		return m.AffectedDelegators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +540,10 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryPendingSlashesRequest)(nil), "cosmos.slashing.v1beta1.QueryPendingSlashesRequest")
+	proto.RegisterType((*QueryPendingSlashesResponse)(nil), "cosmos.slashing.v1beta1.QueryPendingSlashesResponse")
+	proto.RegisterType((*QueryPendingSlashRequest)(nil), "cosmos.slashing.v1beta1.QueryPendingSlashRequest")
+	proto.RegisterType((*QueryPendingSlashResponse)(nil), "cosmos.slashing.v1beta1.QueryPendingSlashResponse")
 }
 
 func init() {
@@ -323,43 +551,59 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x1a, 0x6a, 0x89, 0xc1, 0xa5, 0xed, 0x00, 0xc2, 0xb8, 0x95, 0xa1, 0x5b, 0x15, 0x2c,
+	0xb7, 0xde, 0x05, 0x53, 0xda, 0x43, 0xd5, 0x03, 0x16, 0x2a, 0xaa, 0x54, 0x29, 0xc4, 0x28, 0x48,
+	0xe4, 0xb2, 0x1a, 0x7b, 0xc7, 0xcb, 0x28, 0xf6, 0xcc, 0xb2, 0xb3, 0x10, 0x10, 0xe2, 0x92, 0x73,
+	0x0e, 0x91, 0xf2, 0x03, 0x72, 0x8a, 0x94, 0x43, 0x22, 0x25, 0x51, 0xfe, 0x40, 0x6e, 0x1c, 0x49,
+	0x72, 0x89, 0x72, 0x88, 0x22, 0x88, 0x94, 0xbf, 0x11, 0xed, 0xcc, 0x2c, 0xde, 0xc5, 0xde, 0x60,
+	0x2b, 0x5c, 0x60, 0x35, 0xef, 0x7d, 0xdf, 0xfb, 0xde, 0x37, 0x6f, 0x1e, 0x80, 0x5f, 0x1a, 0x8c,
+	0xb7, 0x19, 0x37, 0x79, 0x0b, 0xf1, 0x6d, 0x42, 0x1d, 0x73, 0x6f, 0xb1, 0x8e, 0x7d, 0xb4, 0x68,
+	0xee, 0xec, 0x62, 0xef, 0xc0, 0x70, 0x3d, 0xe6, 0x33, 0x38, 0x25, 0x93, 0x8c, 0x30, 0xc9, 0x50,
+	0x49, 0xf9, 0x92, 0x42, 0xd7, 0x11, 0xc7, 0x12, 0x71, 0x8e, 0x77, 0x91, 0x43, 0x28, 0xf2, 0x09,
+	0xa3, 0x92, 0x24, 0x3f, 0xe1, 0x30, 0x87, 0x89, 0x4f, 0x33, 0xf8, 0x52, 0xa7, 0x3f, 0x39, 0x8c,
+	0x39, 0x2d, 0x6c, 0x22, 0x97, 0x98, 0x88, 0x52, 0xe6, 0x0b, 0x08, 0x57, 0xd1, 0xb9, 0x24, 0x75,
+	0xe7, 0x4a, 0x64, 0xde, 0xb4, 0xcc, 0xb3, 0x24, 0xbd, 0x52, 0x2b, 0x43, 0x3f, 0xa0, 0x36, 0xa1,
+	0xcc, 0x14, 0x3f, 0xe5, 0x91, 0x3e, 0x01, 0xe0, 0xf5, 0x40, 0xeb, 0x3a, 0xf2, 0x50, 0x9b, 0xd7,
+	0xf0, 0xce, 0x2e, 0xe6, 0xbe, 0xbe, 0x05, 0xc6, 0x63, 0xa7, 0xdc, 0x65, 0x94, 0x63, 0x58, 0x05,
+	0x19, 0x57, 0x9c, 0xe4, 0xb4, 0x59, 0xad, 0x38, 0x5a, 0x99, 0x31, 0x12, 0xcc, 0x30, 0x24, 0xb0,
+	0x3a, 0x72, 0xfc, 0x7e, 0x26, 0xf5, 0xe8, 0xd3, 0xd3, 0x92, 0x56, 0x53, 0x48, 0x7d, 0x13, 0x4c,
+	0x09, 0xea, 0x0d, 0xe2, 0x50, 0x42, 0x9d, 0xff, 0x68, 0x93, 0xa9, 0xaa, 0xf0, 0x6f, 0x90, 0x6d,
+	0x30, 0xca, 0x2d, 0x64, 0xdb, 0x1e, 0xe6, 0xb2, 0xc8, 0x48, 0x35, 0xf7, 0xfa, 0x45, 0x79, 0x42,
+	0xd5, 0x59, 0x91, 0x91, 0x0d, 0xdf, 0x23, 0xd4, 0xa9, 0x8d, 0x06, 0xd9, 0xea, 0x48, 0x7f, 0x90,
+	0x06, 0xb9, 0x6e, 0x62, 0x25, 0xbc, 0x0e, 0xbe, 0xdf, 0x43, 0x2d, 0x8b, 0xcb, 0x90, 0x45, 0x68,
+	0x93, 0xa9, 0x16, 0xca, 0x89, 0x2d, 0x6c, 0xa2, 0x16, 0xb1, 0x91, 0xcf, 0xbc, 0x08, 0x61, 0xb4,
+	0xa1, 0xb1, 0x3d, 0xd4, 0x8a, 0x84, 0xe0, 0x9f, 0x60, 0xca, 0xf5, 0x08, 0xf3, 0x2c, 0x9b, 0xdd,
+	0xa6, 0x3e, 0x69, 0x63, 0x8b, 0x35, 0x9b, 0x98, 0x36, 0x30, 0xcf, 0xa5, 0x67, 0xb5, 0xe2, 0x70,
+	0x6d, 0x52, 0x84, 0x57, 0x55, 0xf4, 0x9a, 0x0a, 0x42, 0x07, 0x4c, 0x52, 0xbc, 0xef, 0x77, 0x60,
+	0x2e, 0xa6, 0xa8, 0xe5, 0x1f, 0xe4, 0x86, 0x84, 0xc0, 0x62, 0xa2, 0xc0, 0x90, 0x69, 0x5d, 0xe6,
+	0x47, 0xb5, 0x8d, 0x07, 0x8c, 0x17, 0xe2, 0x7a, 0xbd, 0xdb, 0xa0, 0xf0, 0xc2, 0xe1, 0xbf, 0x00,
+	0x74, 0x86, 0x54, 0x59, 0x33, 0x17, 0x56, 0x0e, 0x26, 0xda, 0x90, 0x6f, 0xa0, 0x73, 0xbf, 0x0e,
+	0x56, 0xd8, 0x5a, 0x04, 0xa9, 0x3f, 0xd7, 0xc0, 0x74, 0x8f, 0x22, 0xea, 0x1a, 0xfe, 0x07, 0xc3,
+	0xca, 0xfa, 0xa1, 0xaf, 0xb2, 0x5e, 0xb0, 0xc0, 0xb5, 0x98, 0xe6, 0xb4, 0xd0, 0x3c, 0x7f, 0xa9,
+	0x66, 0x29, 0x25, 0x26, 0xda, 0x06, 0x79, 0x39, 0xed, 0x98, 0xda, 0x84, 0x3a, 0x1b, 0x81, 0x1c,
+	0x7c, 0xe5, 0xd6, 0xbc, 0xd4, 0xc0, 0x8f, 0x3d, 0xcb, 0x28, 0x73, 0xb6, 0xc0, 0x77, 0xae, 0x8c,
+	0x58, 0x5c, 0x86, 0x94, 0x4f, 0xbf, 0x26, 0xbf, 0xb2, 0x08, 0x53, 0x6c, 0x34, 0xdd, 0x58, 0x89,
+	0xab, 0x73, 0xaa, 0xa4, 0x46, 0x28, 0x5a, 0x38, 0xf4, 0x69, 0x0c, 0xa4, 0x89, 0x2d, 0xfc, 0x19,
+	0xae, 0xa5, 0x89, 0xad, 0xbf, 0x0b, 0x47, 0x21, 0x9e, 0xac, 0xba, 0xbd, 0x01, 0xbe, 0x8d, 0x75,
+	0xab, 0x8c, 0x1d, 0xbc, 0xd7, 0x6c, 0xb4, 0x57, 0xd8, 0x04, 0xe3, 0xa8, 0xd9, 0xc4, 0x0d, 0x1f,
+	0xdb, 0x96, 0x8d, 0x5b, 0xd8, 0x09, 0xa6, 0x28, 0x78, 0x80, 0x81, 0x91, 0xa5, 0x44, 0xf2, 0x15,
+	0x85, 0x59, 0x0d, 0x21, 0xd1, 0x0a, 0x10, 0x5d, 0x8c, 0xf2, 0xca, 0xab, 0x0c, 0xf8, 0x46, 0x34,
+	0x07, 0xef, 0x6a, 0x20, 0x23, 0xb7, 0x1d, 0xfc, 0x2d, 0x91, 0xbf, 0x7b, 0xc5, 0xe6, 0x7f, 0xef,
+	0x2f, 0x59, 0xda, 0xa5, 0xcf, 0xdf, 0x79, 0xf3, 0xf1, 0x7e, 0xfa, 0x67, 0x38, 0x63, 0x26, 0xfd,
+	0x15, 0x90, 0xeb, 0x15, 0x3e, 0xd3, 0xc0, 0x68, 0x74, 0x2b, 0x2d, 0x7c, 0xb9, 0x4c, 0xf7, 0x16,
+	0xce, 0x2f, 0x0e, 0x80, 0x50, 0xea, 0xfe, 0x11, 0xea, 0xfe, 0x82, 0xcb, 0x89, 0xea, 0xa2, 0x9b,
+	0x97, 0x9b, 0x87, 0xd1, 0x35, 0x7f, 0x04, 0x1f, 0x6a, 0x20, 0x1b, 0xa1, 0xe5, 0xb0, 0x7f, 0x09,
+	0xe7, 0x76, 0x56, 0x06, 0x81, 0x28, 0xd9, 0x86, 0x90, 0x5d, 0x84, 0x73, 0xfd, 0xc9, 0x86, 0x4f,
+	0x34, 0x30, 0x16, 0x7f, 0xbc, 0x70, 0xe9, 0x92, 0x5b, 0xec, 0xb5, 0x51, 0xf2, 0x7f, 0x0c, 0x06,
+	0x52, 0x6a, 0x17, 0x84, 0xda, 0x12, 0x2c, 0x26, 0x8f, 0x40, 0x7c, 0x7d, 0xc0, 0xc7, 0x1a, 0xc8,
+	0x46, 0xc9, 0x2e, 0xf3, 0xb5, 0xc7, 0xab, 0xce, 0x57, 0x06, 0x81, 0x28, 0xa5, 0xcb, 0x42, 0xa9,
+	0x09, 0xcb, 0xfd, 0x2a, 0x35, 0x0f, 0x89, 0x7d, 0x54, 0x5d, 0x3b, 0x3e, 0x2d, 0x68, 0x27, 0xa7,
+	0x05, 0xed, 0xc3, 0x69, 0x41, 0xbb, 0x77, 0x56, 0x48, 0x9d, 0x9c, 0x15, 0x52, 0x6f, 0xcf, 0x0a,
+	0xa9, 0x9b, 0x65, 0x87, 0xf8, 0xdb, 0xbb, 0x75, 0xa3, 0xc1, 0xda, 0x21, 0xa5, 0xfc, 0x55, 0xe6,
+	0xf6, 0x2d, 0x73, 0xbf, 0xc3, 0xef, 0x1f, 0xb8, 0x98, 0xd7, 0x33, 0xe2, 0x5f, 0x9b, 0xa5, 0xcf,
+	0x03, 0x00, 0x02, 0x78, 0x8d, 0xf4, 0xd0, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +624,11 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// PendingSlashes queries the slashes awaiting their execution.
+	PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error)
+	// PendingSlash queries a slash awaiting its execution, along with the
+	// delegators whose stake is subject to it.
+	PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +666,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) PendingSlashes(ctx context.Context, in *QueryPendingSlashesRequest, opts ...grpc.CallOption) (*QueryPendingSlashesResponse, error) {
+	out := new(QueryPendingSlashesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/PendingSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingSlash(ctx context.Context, in *QueryPendingSlashRequest, opts ...grpc.CallOption) (*QueryPendingSlashResponse, error) {
+	out := new(QueryPendingSlashResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/PendingSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +692,11 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// PendingSlashes queries the slashes awaiting their execution.
+	PendingSlashes(context.Context, *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error)
+	// PendingSlash queries a slash awaiting its execution, along with the
+	// delegators whose stake is subject to it.
+	PendingSlash(context.Context, *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +712,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) PendingSlashes(ctx context.Context, req *QueryPendingSlashesRequest) (*QueryPendingSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlashes not implemented")
+}
+func (*UnimplementedQueryServer) PendingSlash(ctx context.Context, req *QueryPendingSlashRequest) (*QueryPendingSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSlash not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +777,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/PendingSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlashes(ctx, req.(*QueryPendingSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/PendingSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingSlash(ctx, req.(*QueryPendingSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +829,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "PendingSlashes",
+			Handler:    _Query_PendingSlashes_Handler,
+		},
+		{
+			MethodName: "PendingSlash",
+			Handler:    _Query_PendingSlash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -626,6 +948,21 @@ func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.NextDowntimePenalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PriorDowntimeOffences != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PriorDowntimeOffences))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.ValSigningInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -723,69 +1060,233 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPendingSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+func (m *QueryPendingSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingSlashes) > 0 {
+		for iNdEx := len(m.PendingSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSlashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSlashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSlashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AffectedDelegators) > 0 {
+		for iNdEx := len(m.AffectedDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AffectedDelegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.PendingSlash.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PriorDowntimeOffences != 0 {
+		n += 1 + sovQuery(uint64(m.PriorDowntimeOffences))
+	}
+	l = m.NextDowntimePenalty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
@@ -810,6 +1311,67 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingSlashes) > 0 {
+		for _, e := range m.PendingSlashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSlashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPendingSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingSlash.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AffectedDelegators) > 0 {
+		for _, e := range m.AffectedDelegators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1093,6 +1655,58 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorDowntimeOffences", wireType)
+			}
+			m.PriorDowntimeOffences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorDowntimeOffences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDowntimePenalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextDowntimePenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1320,6 +1934,398 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSlashes = append(m.PendingSlashes, PendingSlash{})
+			if err := m.PendingSlashes[len(m.PendingSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSlashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingSlashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingSlashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSlash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffectedDelegators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffectedDelegators = append(m.AffectedDelegators, AffectedDelegator{})
+			if err := m.AffectedDelegators[len(m.AffectedDelegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,9 +32,33 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offences:     %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffences)
+}
+
+// PruneDowntimeOffences forgets the downtime offences which have decayed at the
+// given time. No offence decays with a zero decay window.
+func (i *ValidatorSigningInfo) PruneDowntimeOffences(now time.Time, decayWindow time.Duration) {
+	if decayWindow == 0 {
+		return
+	}
+
+	n := 0
+	for n < len(i.DowntimeOffences) && !i.DowntimeOffences[n].Add(decayWindow).After(now) {
+		n++
+	}
+	i.DowntimeOffences = i.DowntimeOffences[n:]
+}
+
+// AddDowntimeOffence records a downtime offence, keeping at most maxOffences of
+// the most recent ones.
+func (i *ValidatorSigningInfo) AddDowntimeOffence(t time.Time, maxOffences int) {
+	i.DowntimeOffences = append(i.DowntimeOffences, t)
+	if len(i.DowntimeOffences) > maxOffences {
+		i.DowntimeOffences = i.DowntimeOffences[len(i.DowntimeOffences)-maxOffences:]
+	}
 }
 
 // unmarshal a validator signing info from a store value
@@ -4,10 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -37,10 +39,10 @@ type ValidatorSigningInfo struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// Height at which validator was first a candidate OR was unjailed
 	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// Index which is incremented each time the validator was a bonded
-	// in a block and may have signed a precommit or not. This in conjunction with the
-	// `SignedBlocksWindow` param determines the index in the `MissedBlocksBitArray`.
-	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// DEPRECATED: Index which is incremented every time a validator is bonded in a block and
+	// _may_ have signed a pre-commit or not. This in conjunction with the
+	// signed_blocks_window param determines the index in the missed block bitmap.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"` // Deprecated: Do not use.
 	// Timestamp until which the validator is jailed due to liveness downtime.
 	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
 	// Whether or not a validator has been tombstoned (killed out of validator set). It is set
@@ -49,6 +51,9 @@ type ValidatorSigningInfo struct {
 	// A counter kept to avoid unnecessary array reads.
 	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Times of the recent downtime offences of the validator, oldest first. Offences
+	// older than the downtime_offence_decay_window param are forgotten.
+	DowntimeOffences []time.Time `protobuf:"bytes,7,rep,name=downtime_offences,json=downtimeOffences,proto3,stdtime" json:"downtime_offences"`
 }
 
 func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
@@ -97,6 +102,7 @@ func (m *ValidatorSigningInfo) GetStartHeight() int64 {
 	return 0
 }
 
+// Deprecated: Do not use.
 func (m *ValidatorSigningInfo) GetIndexOffset() int64 {
 	if m != nil {
 		return m.IndexOffset
@@ -125,6 +131,13 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetDowntimeOffences() []time.Time {
+	if m != nil {
+		return m.DowntimeOffences
+	}
+	return nil
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -132,6 +145,19 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// slash_delay is the delay after which the slashes are executed, during which
+	// they can be cancelled by the authority. Slashes are executed immediately
+	// when it is zero. It must be shorter than the staking unbonding time.
+	SlashDelay time.Duration `protobuf:"bytes,6,opt,name=slash_delay,json=slashDelay,proto3,stdduration" json:"slash_delay"`
+	// downtime_offence_decay_window is the period after which a downtime offence
+	// no longer counts as a prior offence of the validator. Offences never decay
+	// when it is zero.
+	DowntimeOffenceDecayWindow time.Duration `protobuf:"bytes,7,opt,name=downtime_offence_decay_window,json=downtimeOffenceDecayWindow,proto3,stdduration" json:"downtime_offence_decay_window"`
+	// repeat_downtime_penalties are the escalating penalties of the repeat downtime
+	// offences: the n-th entry applies to a validator with n prior offences, and the
+	// last entry to any further offence. A first offence is penalized with the
+	// downtime_jail_duration and slash_fraction_downtime params.
+	RepeatDowntimePenalties []DowntimePenalty `protobuf:"bytes,8,rep,name=repeat_downtime_penalties,json=repeatDowntimePenalties,proto3" json:"repeat_downtime_penalties"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,9 +207,237 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetSlashDelay() time.Duration {
+	if m != nil {
+		return m.SlashDelay
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeOffenceDecayWindow() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenceDecayWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRepeatDowntimePenalties() []DowntimePenalty {
+	if m != nil {
+		return m.RepeatDowntimePenalties
+	}
+	return nil
+}
+
+// DowntimePenalty defines the penalty of a downtime offence.
+type DowntimePenalty struct {
+	JailDuration  time.Duration                          `protobuf:"bytes,1,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+}
+
+func (m *DowntimePenalty) Reset()         { *m = DowntimePenalty{} }
+func (m *DowntimePenalty) String() string { return proto.CompactTextString(m) }
+func (*DowntimePenalty) ProtoMessage()    {}
+func (*DowntimePenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *DowntimePenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimePenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimePenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimePenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimePenalty.Merge(m, src)
+}
+func (m *DowntimePenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimePenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimePenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimePenalty proto.InternalMessageInfo
+
+func (m *DowntimePenalty) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+// PendingSlash defines a slash of a validator awaiting its execution, which can
+// be cancelled by the authority until then.
+type PendingSlash struct {
+	// id is the unique identifier of the pending slash.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// validator_address is the consensus address of the slashed validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// infraction_height is the height of the stake distribution which committed
+	// the infraction.
+	InfractionHeight int64 `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// power is the validator power at the infraction height.
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+	// slash_fraction is the fraction of stake to slash.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// infraction is the reason of the slash.
+	Infraction types.Infraction `protobuf:"varint,6,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// height is the height at which the slash was queued.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// execution_time is the time after which the slash is executed.
+	ExecutionTime time.Time `protobuf:"bytes,8,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *PendingSlash) Reset()         { *m = PendingSlash{} }
+func (m *PendingSlash) String() string { return proto.CompactTextString(m) }
+func (*PendingSlash) ProtoMessage()    {}
+func (*PendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *PendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSlash.Merge(m, src)
+}
+func (m *PendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSlash proto.InternalMessageInfo
+
+func (m *PendingSlash) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingSlash) GetInfractionHeight() int64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *PendingSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingSlash) GetExecutionTime() time.Time {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return time.Time{}
+}
+
+// AffectedDelegator defines the stake of a delegator subject to a pending slash.
+type AffectedDelegator struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// shares are the delegator shares of the slashed validator.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// unbonding is the balance of the unbonding delegation entries created since
+	// the infraction height.
+	Unbonding cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=unbonding,proto3,customtype=cosmossdk.io/math.Int" json:"unbonding"`
+	// redelegated are the destination shares of the redelegation entries created
+	// since the infraction height.
+	Redelegated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=redelegated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redelegated"`
+}
+
+func (m *AffectedDelegator) Reset()         { *m = AffectedDelegator{} }
+func (m *AffectedDelegator) String() string { return proto.CompactTextString(m) }
+func (*AffectedDelegator) ProtoMessage()    {}
+func (*AffectedDelegator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
+}
+func (m *AffectedDelegator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AffectedDelegator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AffectedDelegator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AffectedDelegator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AffectedDelegator.Merge(m, src)
+}
+func (m *AffectedDelegator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AffectedDelegator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AffectedDelegator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AffectedDelegator proto.InternalMessageInfo
+
+func (m *AffectedDelegator) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*DowntimePenalty)(nil), "cosmos.slashing.v1beta1.DowntimePenalty")
+	proto.RegisterType((*PendingSlash)(nil), "cosmos.slashing.v1beta1.PendingSlash")
+	proto.RegisterType((*AffectedDelegator)(nil), "cosmos.slashing.v1beta1.AffectedDelegator")
 }
 
 func init() {
@@ -191,46 +445,71 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x18, 0xce, 0x01, 0x3f, 0x7e, 0xf4, 0x42, 0x87, 0x5e, 0x43, 0x31, 0x51, 0x65, 0x07, 0x06, 0x14,
-	0x21, 0x61, 0x97, 0xb0, 0xb1, 0x35, 0x45, 0x55, 0xff, 0x49, 0x45, 0xa1, 0x7f, 0xa4, 0x0e, 0xb5,
-	0xce, 0xbe, 0xb3, 0x73, 0xc5, 0xbe, 0x8b, 0x7c, 0xe7, 0x42, 0xf7, 0x4e, 0x9d, 0x18, 0x19, 0x19,
-	0x19, 0x19, 0x3a, 0xf6, 0x03, 0x30, 0xa2, 0x4e, 0x55, 0x07, 0x5a, 0x85, 0x81, 0x7e, 0x8c, 0xca,
-	0x77, 0x76, 0x88, 0x40, 0xea, 0xc0, 0x92, 0xd8, 0xcf, 0xf3, 0xbc, 0xcf, 0x7b, 0xcf, 0xfb, 0x9e,
-	0xe1, 0x72, 0x28, 0x64, 0x2a, 0xa4, 0x27, 0x13, 0x2c, 0xfb, 0x8c, 0xc7, 0xde, 0xc7, 0xb5, 0x80,
-	0x2a, 0xbc, 0x36, 0x02, 0xdc, 0x41, 0x26, 0x94, 0x40, 0xf3, 0x46, 0xe7, 0x8e, 0xe0, 0x52, 0xd7,
-	0x6c, 0xc4, 0x22, 0x16, 0x5a, 0xe3, 0x15, 0x4f, 0x46, 0xde, 0xb4, 0x63, 0x21, 0xe2, 0x84, 0x7a,
-	0xfa, 0x2d, 0xc8, 0x23, 0x8f, 0xe4, 0x19, 0x56, 0x4c, 0xf0, 0x92, 0x77, 0xae, 0xf2, 0x8a, 0xa5,
-	0x54, 0x2a, 0x9c, 0x0e, 0x4a, 0xc1, 0x82, 0xe9, 0xe7, 0x1b, 0xe7, 0xb2, 0xb9, 0xa1, 0xee, 0xe0,
-	0x94, 0x71, 0xe1, 0xe9, 0x5f, 0x03, 0x2d, 0x7d, 0x9b, 0x80, 0x8d, 0x37, 0x38, 0x61, 0x04, 0x2b,
-	0x91, 0x6d, 0xb3, 0x98, 0x33, 0x1e, 0x3f, 0xe5, 0x91, 0x40, 0x1d, 0xf8, 0x3f, 0x26, 0x24, 0xa3,
-	0x52, 0x5a, 0xa0, 0x05, 0xda, 0xb7, 0xba, 0xd6, 0xf7, 0xaf, 0xab, 0x8d, 0xd2, 0xee, 0xa1, 0x61,
-	0xb6, 0x55, 0xc6, 0x78, 0xdc, 0xab, 0x84, 0x68, 0x11, 0xce, 0x4a, 0x85, 0x33, 0xe5, 0xf7, 0x29,
-	0x8b, 0xfb, 0xca, 0x9a, 0x68, 0x81, 0xf6, 0x64, 0xaf, 0xae, 0xb1, 0x27, 0x1a, 0x2a, 0x24, 0x8c,
-	0x13, 0xba, 0xe7, 0x8b, 0x28, 0x92, 0x54, 0x59, 0x93, 0x46, 0xa2, 0xb1, 0x97, 0x1a, 0x42, 0x2f,
-	0xe0, 0xec, 0x07, 0xcc, 0x12, 0x4a, 0xfc, 0x9c, 0x2b, 0x96, 0x58, 0x53, 0x2d, 0xd0, 0xae, 0x77,
-	0x9a, 0xae, 0x09, 0xee, 0x56, 0xc1, 0xdd, 0x57, 0x55, 0xf0, 0xee, 0xed, 0x93, 0x33, 0xa7, 0xb6,
-	0xff, 0xcb, 0x01, 0x47, 0x17, 0xc7, 0x2b, 0xa0, 0x57, 0x37, 0xe5, 0xaf, 0x8b, 0x6a, 0x64, 0x43,
-	0xa8, 0x44, 0x1a, 0x48, 0x25, 0x38, 0x25, 0xd6, 0x7f, 0x2d, 0xd0, 0x9e, 0xe9, 0x8d, 0x21, 0xa8,
-	0x03, 0xe7, 0x52, 0x26, 0x25, 0x25, 0x7e, 0x90, 0x88, 0x70, 0x47, 0xfa, 0xa1, 0xc8, 0xb9, 0xa2,
-	0x99, 0x35, 0xad, 0x4f, 0x76, 0xd7, 0x90, 0x5d, 0xcd, 0x3d, 0x32, 0xd4, 0xc6, 0xcc, 0xc1, 0xa1,
-	0x53, 0xfb, 0x73, 0xe8, 0x80, 0xa5, 0xcf, 0x53, 0x70, 0x7a, 0x0b, 0x67, 0x38, 0x95, 0xe8, 0x01,
-	0x6c, 0x48, 0x16, 0xf3, 0x4b, 0xa3, 0x5d, 0xc6, 0x89, 0xd8, 0xd5, 0xd3, 0x9b, 0xec, 0x21, 0xc3,
-	0x19, 0x9f, 0xb7, 0x9a, 0x41, 0x51, 0xd1, 0x9a, 0xfb, 0x65, 0xd5, 0x80, 0x66, 0x55, 0x49, 0x31,
-	0xb7, 0xd9, 0xee, 0x7a, 0x91, 0xea, 0xe7, 0x99, 0xb3, 0x1c, 0x33, 0xd5, 0xcf, 0x03, 0x37, 0x14,
-	0x69, 0xb9, 0xce, 0xf2, 0x6f, 0x55, 0x92, 0x1d, 0x4f, 0x7d, 0x1a, 0x50, 0xe9, 0x6e, 0xd2, 0xd0,
-	0x64, 0x47, 0x29, 0xe3, 0xdb, 0xda, 0x70, 0x8b, 0x66, 0x65, 0x9f, 0xf7, 0xf0, 0x1e, 0x11, 0xbb,
-	0xbc, 0xb8, 0x28, 0x7e, 0x31, 0x1a, 0xbf, 0xba, 0x52, 0x7a, 0xfa, 0xf5, 0xce, 0xc2, 0xb5, 0xd1,
-	0x6e, 0x96, 0x02, 0x33, 0xd9, 0x83, 0xd1, 0x64, 0x1b, 0x95, 0xcf, 0x33, 0xcc, 0x92, 0x4a, 0x84,
-	0x06, 0xb0, 0xa9, 0x2f, 0xb7, 0x1f, 0x65, 0x38, 0x2c, 0x10, 0x9f, 0x88, 0x3c, 0x48, 0xa8, 0x4e,
-	0xa6, 0xd7, 0x77, 0xc3, 0x30, 0xf3, 0xda, 0xf6, 0x71, 0xe9, 0xba, 0xa9, 0x4d, 0x8b, 0x70, 0x68,
-	0x07, 0xce, 0x5f, 0xeb, 0x68, 0x0e, 0xa6, 0x37, 0x7c, 0xc3, 0x76, 0x73, 0x57, 0xda, 0x19, 0xc7,
-	0x8d, 0xc5, 0x2f, 0x17, 0xc7, 0x2b, 0xf7, 0xc7, 0xca, 0xf6, 0x2e, 0xbf, 0x79, 0xb3, 0xfb, 0xee,
-	0xf3, 0xa3, 0xa1, 0x0d, 0x4e, 0x86, 0x36, 0x38, 0x1d, 0xda, 0xe0, 0xf7, 0xd0, 0x06, 0xfb, 0xe7,
-	0x76, 0xed, 0xf4, 0xdc, 0xae, 0xfd, 0x38, 0xb7, 0x6b, 0xef, 0x56, 0xff, 0x79, 0x88, 0x31, 0x37,
-	0x7d, 0x9e, 0x60, 0x5a, 0xaf, 0x61, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x46, 0x11,
-	0xae, 0x61, 0x04, 0x00, 0x00,
+	// 1012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xbb, 0x6e, 0x1b, 0x47,
+	0x17, 0xd6, 0x92, 0x12, 0x25, 0x0d, 0x29, 0xfd, 0xd2, 0xfc, 0x94, 0xb5, 0x12, 0x12, 0x52, 0x26,
+	0x12, 0x83, 0x70, 0xa0, 0xa5, 0x4d, 0x77, 0xee, 0xc4, 0x30, 0x41, 0x98, 0x9b, 0x89, 0x55, 0xe2,
+	0x20, 0x2e, 0xb2, 0x18, 0xee, 0x0c, 0x97, 0x13, 0xee, 0xce, 0x10, 0x3b, 0x43, 0x4b, 0x7a, 0x85,
+	0x54, 0x2e, 0x55, 0xba, 0x74, 0x13, 0xc0, 0x08, 0x5c, 0xe6, 0x01, 0x5c, 0x1a, 0xae, 0x82, 0x14,
+	0x4e, 0x20, 0x15, 0x0e, 0xf2, 0x14, 0xc1, 0xce, 0xcc, 0x2e, 0x2f, 0xce, 0x8d, 0x8e, 0x1b, 0x89,
+	0x73, 0x2e, 0xdf, 0x39, 0xe7, 0x3b, 0x97, 0x05, 0xd7, 0x7c, 0x2e, 0x22, 0x2e, 0x1a, 0x22, 0x44,
+	0x62, 0x40, 0x59, 0xd0, 0xb8, 0x7f, 0xb3, 0x47, 0x24, 0xba, 0x99, 0x09, 0x9c, 0x51, 0xcc, 0x25,
+	0x87, 0xbb, 0xda, 0xce, 0xc9, 0xc4, 0xc6, 0x6e, 0xbf, 0x1c, 0xf0, 0x80, 0x2b, 0x9b, 0x46, 0xf2,
+	0x4b, 0x9b, 0xef, 0x57, 0x02, 0xce, 0x83, 0x90, 0x34, 0xd4, 0xab, 0x37, 0xee, 0x37, 0xf0, 0x38,
+	0x46, 0x92, 0x72, 0x66, 0xf4, 0xd5, 0x79, 0xbd, 0xa4, 0x11, 0x11, 0x12, 0x45, 0x23, 0x63, 0xb0,
+	0xa7, 0xe3, 0x79, 0x1a, 0xd9, 0x04, 0xd7, 0xaa, 0x6d, 0x14, 0x51, 0xc6, 0x1b, 0xea, 0xaf, 0x11,
+	0xbd, 0x93, 0x56, 0x21, 0xd1, 0x70, 0xa6, 0x08, 0xfd, 0xd6, 0x56, 0xb5, 0xf3, 0x3c, 0x28, 0xdf,
+	0x45, 0x21, 0xc5, 0x48, 0xf2, 0xf8, 0x98, 0x06, 0x8c, 0xb2, 0xa0, 0xc3, 0xfa, 0x1c, 0x36, 0xc1,
+	0x2a, 0xc2, 0x38, 0x26, 0x42, 0xd8, 0xd6, 0x81, 0x55, 0x5f, 0x6f, 0xd9, 0xcf, 0x9f, 0x1c, 0x96,
+	0x4d, 0xd0, 0x23, 0xad, 0x39, 0x96, 0x31, 0x65, 0x81, 0x9b, 0x1a, 0xc2, 0xab, 0xa0, 0x24, 0x24,
+	0x8a, 0xa5, 0x37, 0x20, 0x34, 0x18, 0x48, 0x3b, 0x77, 0x60, 0xd5, 0xf3, 0x6e, 0x51, 0xc9, 0x3e,
+	0x52, 0x22, 0xf8, 0x2e, 0x28, 0x51, 0x86, 0xc9, 0xa9, 0xc7, 0xfb, 0x7d, 0x41, 0xa4, 0x9d, 0x4f,
+	0x4c, 0x5a, 0x39, 0xdb, 0x72, 0x8b, 0x4a, 0x7e, 0x47, 0x89, 0xe1, 0xa7, 0xa0, 0xf4, 0x2d, 0xa2,
+	0x21, 0xc1, 0xde, 0x98, 0x49, 0x1a, 0xda, 0xcb, 0x07, 0x56, 0xbd, 0xd8, 0xdc, 0x77, 0x34, 0x45,
+	0x4e, 0x4a, 0x91, 0xf3, 0x45, 0x4a, 0x51, 0x6b, 0xe3, 0xe9, 0x8b, 0xea, 0xd2, 0x83, 0x5f, 0xaa,
+	0xd6, 0xa3, 0x97, 0x8f, 0xaf, 0x5b, 0x6e, 0x51, 0xbb, 0x7f, 0x99, 0x78, 0xc3, 0x0a, 0x00, 0x92,
+	0x47, 0x3d, 0x21, 0x39, 0x23, 0xd8, 0x5e, 0x39, 0xb0, 0xea, 0x6b, 0xee, 0x94, 0x04, 0x36, 0xc1,
+	0x4e, 0x44, 0x85, 0x20, 0xd8, 0xeb, 0x85, 0xdc, 0x1f, 0x0a, 0xcf, 0xe7, 0x63, 0x26, 0x49, 0x6c,
+	0x17, 0x54, 0x01, 0xff, 0xd7, 0xca, 0x96, 0xd2, 0xbd, 0xaf, 0x55, 0xf0, 0x2e, 0xd8, 0xc6, 0xfc,
+	0x84, 0x25, 0x3d, 0x4a, 0x6a, 0x21, 0xcc, 0x27, 0xc2, 0x5e, 0x3d, 0xc8, 0x2f, 0x96, 0xe6, 0x56,
+	0x8a, 0x71, 0xc7, 0x40, 0xdc, 0x5e, 0x3b, 0x7f, 0x58, 0x5d, 0xfa, 0xed, 0x61, 0xd5, 0xaa, 0x7d,
+	0x5f, 0x00, 0x85, 0x2e, 0x8a, 0x51, 0x24, 0xe0, 0x0d, 0x50, 0x16, 0x34, 0x60, 0x93, 0x04, 0x4f,
+	0x28, 0xc3, 0xfc, 0x44, 0x75, 0x26, 0xef, 0x42, 0xad, 0xd3, 0xf9, 0x7d, 0xa5, 0x34, 0xb0, 0x9f,
+	0x94, 0xc4, 0x3c, 0xe3, 0x35, 0x22, 0x71, 0xea, 0x92, 0xf4, 0xa4, 0xd4, 0xba, 0x95, 0xa4, 0xf1,
+	0xf3, 0x8b, 0xea, 0xb5, 0x80, 0xca, 0xc1, 0xb8, 0xe7, 0xf8, 0x3c, 0x32, 0x03, 0x65, 0xfe, 0x1d,
+	0x0a, 0x3c, 0x6c, 0xc8, 0xb3, 0x11, 0x11, 0x4e, 0x9b, 0xf8, 0x3a, 0x59, 0x18, 0x51, 0x76, 0xac,
+	0x00, 0xbb, 0x24, 0x36, 0x71, 0xbe, 0x01, 0x57, 0x32, 0x1a, 0x12, 0xca, 0xbd, 0x74, 0xa8, 0x55,
+	0x67, 0x8b, 0xcd, 0xbd, 0x57, 0xb8, 0x68, 0x1b, 0x03, 0x4d, 0xc5, 0x79, 0x46, 0x45, 0x39, 0xc5,
+	0xf9, 0x18, 0xd1, 0x30, 0x35, 0x82, 0x23, 0xb0, 0xaf, 0xd6, 0xcb, 0xeb, 0xc7, 0xc8, 0x4f, 0x24,
+	0x1e, 0xe6, 0xe3, 0x5e, 0x48, 0x54, 0x65, 0xf6, 0xf2, 0xeb, 0x17, 0xb3, 0xab, 0x60, 0x3f, 0x34,
+	0xa8, 0x6d, 0x05, 0x9a, 0x14, 0x07, 0x87, 0x60, 0xf7, 0x95, 0x88, 0x3a, 0x31, 0x7b, 0xe5, 0xf5,
+	0xc3, 0xed, 0xcc, 0x85, 0xd3, 0x88, 0xb0, 0x03, 0x8a, 0x3a, 0x18, 0x26, 0x21, 0x3a, 0xb3, 0x0b,
+	0x0b, 0x72, 0x06, 0x94, 0x73, 0x3b, 0xf1, 0x85, 0x43, 0xf0, 0xf6, 0xfc, 0x40, 0x7a, 0x98, 0xf8,
+	0xe8, 0x2c, 0xed, 0xfc, 0xea, 0x82, 0xe0, 0xfb, 0x73, 0xb3, 0xd9, 0x4e, 0xc0, 0x4c, 0xdb, 0x39,
+	0xd8, 0x8b, 0xc9, 0x88, 0x20, 0x99, 0x91, 0xe3, 0x8d, 0x08, 0x43, 0xa1, 0xa4, 0x44, 0xd8, 0x6b,
+	0x6a, 0x0b, 0xea, 0xce, 0x5f, 0x9c, 0x47, 0x27, 0xad, 0xbe, 0xab, 0x3c, 0xce, 0x5a, 0xeb, 0x49,
+	0x5c, 0xd3, 0x15, 0x8d, 0x3a, 0x6b, 0x41, 0x89, 0xb8, 0x7d, 0xf5, 0xbb, 0x97, 0x8f, 0xaf, 0xbf,
+	0x35, 0xc5, 0xef, 0xe9, 0xe4, 0x3c, 0xeb, 0x25, 0xa9, 0xfd, 0x68, 0x81, 0xff, 0xcd, 0x41, 0xc3,
+	0xcf, 0xc0, 0xc6, 0xec, 0x54, 0x5a, 0x0b, 0x92, 0xa0, 0xce, 0x50, 0x36, 0x8d, 0xf7, 0xc0, 0xe6,
+	0xec, 0x6c, 0xfc, 0x97, 0x75, 0xda, 0x98, 0x19, 0x89, 0xda, 0x0f, 0x79, 0x50, 0xea, 0x12, 0x86,
+	0x29, 0x0b, 0x8e, 0x13, 0x05, 0xdc, 0x04, 0x39, 0x8a, 0x55, 0xc2, 0xcb, 0x6e, 0x8e, 0x62, 0xf8,
+	0x01, 0xd8, 0xbe, 0x9f, 0x5e, 0x6a, 0x2f, 0xbd, 0xcd, 0xb9, 0x7f, 0xb8, 0xcd, 0x5b, 0x99, 0x8b,
+	0x91, 0xc3, 0xf7, 0xc0, 0x36, 0x65, 0xd9, 0x6c, 0x9b, 0x4b, 0xad, 0xce, 0xb0, 0xbb, 0x35, 0x51,
+	0x98, 0x73, 0x5d, 0x06, 0x2b, 0x23, 0x7e, 0x42, 0x62, 0xb5, 0x69, 0x79, 0x57, 0x3f, 0xfe, 0x84,
+	0x86, 0x95, 0x37, 0x45, 0x03, 0x6c, 0x01, 0x30, 0xc9, 0x42, 0x2d, 0xc4, 0x66, 0xb3, 0x96, 0x8d,
+	0x92, 0xf9, 0x76, 0xa5, 0x93, 0xd4, 0xc9, 0x2c, 0xdd, 0x29, 0x2f, 0x78, 0x05, 0x14, 0x4c, 0x5d,
+	0xab, 0x2a, 0x6d, 0xf3, 0x82, 0x5d, 0xb0, 0x49, 0x4e, 0x89, 0x3f, 0x56, 0x95, 0xab, 0x8d, 0x5e,
+	0x5b, 0xf4, 0xbb, 0xb2, 0x91, 0x01, 0x24, 0x26, 0xb5, 0xdf, 0x73, 0x60, 0xfb, 0xa8, 0xdf, 0x27,
+	0xbe, 0x24, 0xb8, 0x4d, 0x42, 0x12, 0x24, 0x4c, 0x27, 0x9d, 0xc2, 0xe9, 0xc3, 0xfb, 0xb7, 0x5f,
+	0xd1, 0xad, 0xcc, 0x25, 0xed, 0xd4, 0xd7, 0xa0, 0x20, 0x06, 0x28, 0x26, 0xc2, 0x4c, 0xd9, 0xd1,
+	0x62, 0xf4, 0x3e, 0x7f, 0x72, 0x08, 0x4c, 0xa4, 0x8c, 0x6c, 0x03, 0x08, 0x3f, 0x07, 0xeb, 0x63,
+	0xd6, 0xe3, 0x6a, 0xda, 0x54, 0xf3, 0x4b, 0xad, 0x1b, 0x06, 0x7d, 0x47, 0xfb, 0x08, 0x3c, 0x74,
+	0x28, 0x6f, 0x44, 0x48, 0x0e, 0x9c, 0x0e, 0x93, 0x53, 0x60, 0x1d, 0x26, 0x35, 0xd8, 0x04, 0x02,
+	0xfa, 0xa0, 0x18, 0x13, 0x53, 0x00, 0xc1, 0xf6, 0xf2, 0x9b, 0xca, 0x77, 0x1a, 0xb5, 0xf5, 0xc9,
+	0xa3, 0x8b, 0x8a, 0xf5, 0xf4, 0xa2, 0x62, 0x3d, 0xbb, 0xa8, 0x58, 0xbf, 0x5e, 0x54, 0xac, 0x07,
+	0x97, 0x95, 0xa5, 0x67, 0x97, 0x95, 0xa5, 0x9f, 0x2e, 0x2b, 0x4b, 0xf7, 0x0e, 0xff, 0x36, 0xca,
+	0xd4, 0xb9, 0x50, 0x01, 0x7b, 0x05, 0xd5, 0xeb, 0x5b, 0x7f, 0x0c, 0x00, 0x27, 0x3a, 0xde, 0xaf,
+	0xed, 0x09, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -270,6 +549,14 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if len(this.DowntimeOffences) != len(that1.DowntimeOffences) {
+		return false
+	}
+	for i := range this.DowntimeOffences {
+		if !this.DowntimeOffences[i].Equal(that1.DowntimeOffences[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -306,6 +593,125 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.SlashDelay != that1.SlashDelay {
+		return false
+	}
+	if this.DowntimeOffenceDecayWindow != that1.DowntimeOffenceDecayWindow {
+		return false
+	}
+	if len(this.RepeatDowntimePenalties) != len(that1.RepeatDowntimePenalties) {
+		return false
+	}
+	for i := range this.RepeatDowntimePenalties {
+		if !this.RepeatDowntimePenalties[i].Equal(&that1.RepeatDowntimePenalties[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DowntimePenalty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimePenalty)
+	if !ok {
+		that2, ok := that.(DowntimePenalty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	return true
+}
+func (this *PendingSlash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingSlash)
+	if !ok {
+		that2, ok := that.(PendingSlash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.ExecutionTime.Equal(that1.ExecutionTime) {
+		return false
+	}
+	return true
+}
+func (this *AffectedDelegator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AffectedDelegator)
+	if !ok {
+		that2, ok := that.(AffectedDelegator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if !this.Shares.Equal(that1.Shares) {
+		return false
+	}
+	if !this.Unbonding.Equal(that1.Unbonding) {
+		return false
+	}
+	if !this.Redelegated.Equal(that1.Redelegated) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -328,6 +734,18 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeOffences) > 0 {
+		for iNdEx := len(m.DowntimeOffences) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DowntimeOffences[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DowntimeOffences[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintSlashing(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.MissedBlocksCounter != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
 		i--
@@ -391,6 +809,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RepeatDowntimePenalties) > 0 {
+		for iNdEx := len(m.RepeatDowntimePenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RepeatDowntimePenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeOffenceDecayWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenceDecayWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashDelay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -411,12 +859,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -425,83 +873,980 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintSlashing(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.SignedBlocksWindow != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
-	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
-	if m.Tombstoned {
-		n += 2
-	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DowntimePenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimePenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimePenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AffectedDelegator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AffectedDelegator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AffectedDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Redelegated.Size()
+		i -= size
+		if _, err := m.Redelegated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Unbonding.Size()
+		i -= size
+		if _, err := m.Unbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	if len(m.DowntimeOffences) > 0 {
+		for _, e := range m.DowntimeOffences {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdTime(e)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashDelay)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenceDecayWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.RepeatDowntimePenalties) > 0 {
+		for _, e := range m.RepeatDowntimePenalties {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DowntimePenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *PendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSlashing(uint64(m.Id))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.InfractionHeight))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *AffectedDelegator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.Redelegated.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeOffences = append(m.DowntimeOffences, time.Time{})
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&(m.DowntimeOffences[len(m.DowntimeOffences)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenceDecayWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeOffenceDecayWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatDowntimePenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatDowntimePenalties = append(m.RepeatDowntimePenalties, DowntimePenalty{})
+			if err := m.RepeatDowntimePenalties[len(m.RepeatDowntimePenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimePenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimePenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimePenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *PendingSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -524,15 +1869,34 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -560,13 +1924,13 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
 			}
-			m.StartHeight = 0
+			m.InfractionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -576,16 +1940,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.InfractionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.IndexOffset = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -595,16 +1959,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -614,30 +1978,30 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
 			}
-			var v int
+			m.Infraction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -647,17 +2011,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Infraction |= types.Infraction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -667,11 +2030,44 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -693,7 +2089,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AffectedDelegator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -716,36 +2112,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AffectedDelegator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AffectedDelegator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
-			}
-			m.SignedBlocksWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -755,30 +2132,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -788,28 +2164,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -836,13 +2212,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegated", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -869,7 +2245,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
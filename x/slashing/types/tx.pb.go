@@ -205,46 +205,146 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCancelPendingSlash is the Msg/CancelPendingSlash request type.
+type MsgCancelPendingSlash struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the pending slash to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelPendingSlash) Reset()         { *m = MsgCancelPendingSlash{} }
+func (m *MsgCancelPendingSlash) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingSlash) ProtoMessage()    {}
+func (*MsgCancelPendingSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgCancelPendingSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPendingSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPendingSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPendingSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingSlash.Merge(m, src)
+}
+func (m *MsgCancelPendingSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPendingSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPendingSlash proto.InternalMessageInfo
+
+func (m *MsgCancelPendingSlash) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelPendingSlash) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelPendingSlashResponse defines the response structure for executing a
+// MsgCancelPendingSlash message.
+type MsgCancelPendingSlashResponse struct {
+}
+
+func (m *MsgCancelPendingSlashResponse) Reset()         { *m = MsgCancelPendingSlashResponse{} }
+func (m *MsgCancelPendingSlashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPendingSlashResponse) ProtoMessage()    {}
+func (*MsgCancelPendingSlashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgCancelPendingSlashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPendingSlashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPendingSlashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPendingSlashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPendingSlashResponse.Merge(m, src)
+}
+func (m *MsgCancelPendingSlashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPendingSlashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPendingSlashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPendingSlashResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCancelPendingSlash)(nil), "cosmos.slashing.v1beta1.MsgCancelPendingSlash")
+	proto.RegisterType((*MsgCancelPendingSlashResponse)(nil), "cosmos.slashing.v1beta1.MsgCancelPendingSlashResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x3c, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x68, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0x93, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x45,
-	0xa0, 0xd6, 0x39, 0x42, 0x44, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d, 0xdf, 0xa0, 0x05,
-	0x53, 0xb3, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0x5e, 0xb8, 0x59, 0x20, 0x85, 0x56, 0x26, 0x1d,
-	0x0b, 0xe4, 0x19, 0x66, 0x2c, 0x90, 0x67, 0x6c, 0x7a, 0xbe, 0x41, 0x0b, 0xcd, 0x9e, 0xae, 0xe7,
-	0x1b, 0xb4, 0xa0, 0xa6, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0xc3, 0x9d, 0xa4, 0x24, 0xcc, 0x25, 0x08,
-	0xe7, 0x04, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x2a, 0x1d, 0x67, 0xe4, 0xe2, 0x07, 0x89,
-	0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x99, 0x71, 0x71, 0x26,
-	0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x54, 0x42, 0x9d, 0x2d, 0x81, 0xcb, 0xad, 0x41, 0x08,
-	0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0xe4,
-	0xf5, 0x70, 0x44, 0x98, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x20, 0x3e, 0x84,
-	0xea, 0xb4, 0xb2, 0x00, 0x79, 0x09, 0x61, 0x26, 0xc8, 0x37, 0xaa, 0x48, 0xbe, 0xa9, 0x40, 0xc4,
-	0x26, 0x9a, 0xab, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x60, 0x9e, 0x34, 0xba, 0xc4, 0xc8, 0xc5,
-	0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc1, 0xc5, 0x06, 0x8d, 0x1e, 0x25, 0x9c, 0x4e, 0x83, 0x07, 0x91,
-	0x94, 0x16, 0x61, 0x35, 0x30, 0x1b, 0x84, 0xb2, 0xb8, 0x78, 0x50, 0x82, 0x50, 0x03, 0xaf, 0x5e,
-	0x24, 0x95, 0x52, 0x06, 0xc4, 0xaa, 0x84, 0xd9, 0x25, 0xc5, 0xda, 0x00, 0x0a, 0x31, 0x27, 0xef,
-	0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x9a, 0xa4, 0xf5, 0xb1, 0x07, 0x63,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x0d, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x5d, 0xa0, 0x38, 0x85, 0x03, 0x00, 0x00,
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x33, 0xab, 0x56, 0x76, 0xd4, 0x4a, 0x63, 0xa5, 0xdb, 0x80, 0xc9, 0x12, 0x50, 0x96,
+	0xc0, 0x26, 0xf6, 0x07, 0x45, 0xf6, 0xe6, 0x7a, 0x94, 0x85, 0xb2, 0x45, 0x10, 0x3d, 0x94, 0xd9,
+	0x4d, 0x98, 0x9d, 0xba, 0xc9, 0x84, 0xcc, 0xb4, 0xb4, 0xe0, 0x41, 0x3c, 0x89, 0x27, 0x0f, 0x1e,
+	0x3c, 0x49, 0xf1, 0xd4, 0xe3, 0x1e, 0xfc, 0x1f, 0xec, 0x71, 0xf1, 0xe4, 0xa9, 0xc8, 0xee, 0x61,
+	0xc1, 0xbf, 0x42, 0x26, 0x33, 0xc9, 0xb6, 0xfb, 0xa3, 0xfe, 0xb8, 0x24, 0x99, 0x37, 0x9f, 0xf7,
+	0xfd, 0xbe, 0x97, 0x37, 0x09, 0x2c, 0xb7, 0x29, 0x0b, 0x29, 0xf3, 0x58, 0x17, 0xb1, 0x0e, 0x89,
+	0xb0, 0x77, 0xb0, 0xd6, 0x0a, 0x38, 0x5a, 0xf3, 0xf8, 0xa1, 0x1b, 0x27, 0x94, 0x53, 0x7d, 0x45,
+	0x12, 0x6e, 0x46, 0xb8, 0x8a, 0x30, 0x96, 0x31, 0xc5, 0x34, 0x65, 0x3c, 0xf1, 0x24, 0x71, 0xe3,
+	0xc1, 0x3c, 0xc1, 0x3c, 0x5f, 0x72, 0xab, 0x92, 0xdb, 0x95, 0x02, 0xca, 0x43, 0x6e, 0x29, 0x47,
+	0x2f, 0x64, 0x22, 0x5b, 0xdc, 0xd4, 0xc6, 0x12, 0x0a, 0x49, 0x44, 0xbd, 0xf4, 0x2a, 0x43, 0xf6,
+	0x67, 0x00, 0x8b, 0x0d, 0x86, 0x9f, 0x45, 0x7b, 0x88, 0x74, 0xf5, 0x97, 0x70, 0xf1, 0x00, 0x75,
+	0x89, 0x8f, 0x38, 0x4d, 0x76, 0x91, 0xef, 0x27, 0x25, 0x50, 0x06, 0x95, 0x62, 0x7d, 0xf3, 0xd7,
+	0x99, 0x75, 0x5d, 0xac, 0x03, 0xc6, 0xbe, 0x7f, 0xad, 0x2e, 0x2b, 0xbb, 0xc7, 0x32, 0xb2, 0xc3,
+	0x13, 0x12, 0xe1, 0x2f, 0xa3, 0x9e, 0x93, 0x31, 0x27, 0xa3, 0x9e, 0x03, 0x9a, 0xb7, 0x72, 0x2d,
+	0x01, 0xd6, 0x36, 0xdf, 0x1d, 0x5b, 0xda, 0xa7, 0x63, 0x0b, 0xbc, 0x1d, 0xf5, 0x9c, 0x09, 0x9f,
+	0xf7, 0xa3, 0x9e, 0xa3, 0x54, 0xab, 0xcc, 0x7f, 0xe5, 0xe5, 0x25, 0xd9, 0x77, 0xe0, 0x52, 0xbe,
+	0x68, 0x06, 0x2c, 0xa6, 0x11, 0x0b, 0xec, 0x6f, 0x00, 0xde, 0x16, 0xd1, 0xd8, 0x47, 0x3c, 0xd8,
+	0x46, 0x09, 0x0a, 0x99, 0xbe, 0x05, 0x8b, 0x68, 0x9f, 0x77, 0x68, 0x42, 0xf8, 0x91, 0x2a, 0xbb,
+	0x34, 0xaf, 0xd6, 0xe6, 0x18, 0xd5, 0xeb, 0x70, 0x21, 0x4e, 0x15, 0x4a, 0x85, 0x32, 0xa8, 0xdc,
+	0x58, 0xb7, 0xdc, 0x39, 0x03, 0x73, 0xa5, 0x51, 0xbd, 0x78, 0x7a, 0x66, 0x69, 0xb2, 0x43, 0x95,
+	0x59, 0x7b, 0x24, 0x5a, 0x1a, 0x6b, 0x8a, 0x6e, 0xee, 0x9f, 0xeb, 0xe6, 0x70, 0x3c, 0xcd, 0x89,
+	0xaa, 0xed, 0x55, 0xb8, 0x32, 0x11, 0xca, 0x9b, 0xfc, 0x08, 0xe0, 0xdd, 0x06, 0xc3, 0x4f, 0x50,
+	0xd4, 0x0e, 0xba, 0xdb, 0x41, 0xe4, 0x93, 0x08, 0xef, 0x08, 0x9d, 0xff, 0x6e, 0x75, 0x11, 0x16,
+	0x88, 0x9f, 0xb6, 0x79, 0xb5, 0x59, 0x20, 0x7e, 0x6d, 0x63, 0xba, 0xec, 0xf2, 0xc5, 0x21, 0x4c,
+	0x9b, 0xdb, 0x16, 0xbc, 0x37, 0x73, 0x23, 0xab, 0x7b, 0xbd, 0x5f, 0x80, 0x57, 0x1a, 0x0c, 0xeb,
+	0xcf, 0xe1, 0x82, 0x3a, 0x56, 0xf6, 0xdc, 0x57, 0x9a, 0x8f, 0xd6, 0x70, 0xfe, 0xcc, 0x64, 0x0e,
+	0xfa, 0x1e, 0xbc, 0x79, 0x61, 0xf4, 0x95, 0x4b, 0x73, 0xcf, 0x91, 0xc6, 0xc3, 0xbf, 0x25, 0x73,
+	0xaf, 0xd7, 0x50, 0x9f, 0x31, 0x01, 0xf7, 0x32, 0x9d, 0x69, 0xde, 0xd8, 0xfa, 0x37, 0x3e, 0x73,
+	0x37, 0xae, 0xbd, 0x11, 0xe7, 0xac, 0xfe, 0xf4, 0x64, 0x60, 0x82, 0xd3, 0x81, 0x09, 0xfa, 0x03,
+	0x13, 0xfc, 0x1c, 0x98, 0xe0, 0xc3, 0xd0, 0xd4, 0xfa, 0x43, 0x53, 0xfb, 0x31, 0x34, 0xb5, 0x17,
+	0x55, 0x4c, 0x78, 0x67, 0xbf, 0xe5, 0xb6, 0x69, 0xa8, 0x7e, 0x04, 0xde, 0xec, 0xc3, 0xc7, 0x8f,
+	0xe2, 0x80, 0xb5, 0x16, 0xd2, 0x2f, 0x7f, 0xe3, 0xf7, 0x00, 0xf4, 0xc3, 0xb6, 0x67, 0xbb, 0x04,
+	0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -340,6 +440,54 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCancelPendingSlash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelPendingSlash)
+	if !ok {
+		that2, ok := that.(MsgCancelPendingSlash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelPendingSlashResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelPendingSlashResponse)
+	if !ok {
+		that2, ok := that.(MsgCancelPendingSlashResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -362,6 +510,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CancelPendingSlash defines a governance operation for cancelling a slash
+	// awaiting its execution. The authority defaults to the x/gov module account.
+	CancelPendingSlash(ctx context.Context, in *MsgCancelPendingSlash, opts ...grpc.CallOption) (*MsgCancelPendingSlashResponse, error)
 }
 
 type msgClient struct {
@@ -390,6 +541,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelPendingSlash(ctx context.Context, in *MsgCancelPendingSlash, opts ...grpc.CallOption) (*MsgCancelPendingSlashResponse, error) {
+	out := new(MsgCancelPendingSlashResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/CancelPendingSlash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -401,6 +561,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CancelPendingSlash defines a governance operation for cancelling a slash
+	// awaiting its execution. The authority defaults to the x/gov module account.
+	CancelPendingSlash(context.Context, *MsgCancelPendingSlash) (*MsgCancelPendingSlashResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -413,6 +576,9 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) CancelPendingSlash(ctx context.Context, req *MsgCancelPendingSlash) (*MsgCancelPendingSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingSlash not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -454,6 +620,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelPendingSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPendingSlash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPendingSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/CancelPendingSlash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPendingSlash(ctx, req.(*MsgCancelPendingSlash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -466,6 +650,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CancelPendingSlash",
+			Handler:    _Msg_CancelPendingSlash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -587,6 +775,64 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelPendingSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPendingSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPendingSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPendingSlashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPendingSlashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPendingSlashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -644,6 +890,31 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelPendingSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelPendingSlashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -947,6 +1218,157 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelPendingSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPendingSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPendingSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPendingSlashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPendingSlashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPendingSlashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
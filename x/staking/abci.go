@@ -23,5 +23,11 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k *keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.StepValidatorSetSize(ctx)
+
+	if k.IsEpochEnd(ctx) {
+		k.ApplyQueuedOperations(ctx)
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -39,6 +40,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryValidatorDenomStakes(),
+		GetCmdQueryDenomDelegations(),
+		GetCmdQueryDenomUnbondingDelegations(),
+		GetCmdQueryQueuedOperations(),
+		GetCmdQueryDelegatorQueuedOperations(),
+		GetCmdQueryUnbondingQueue(),
+		GetCmdQueryRedelegationQueue(),
+		GetCmdQueryValidatorSetSize(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +753,438 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryValidatorDenomStakes implements the command to query the
+// additional bond denom stakes of a validator.
+func GetCmdQueryValidatorDenomStakes() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-denom-stakes [validator-addr]",
+		Short: "Query the additional bond denom stakes of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens of each additional bond denom delegated to a validator.
+
+Example:
+$ %s query staking validator-denom-stakes %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorDenomStakesRequest{ValidatorAddr: valAddr.String()}
+			res, err := queryClient.ValidatorDenomStakes(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDenomDelegations implements the command to query the denom delegations of a delegator.
+func GetCmdQueryDenomDelegations() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "denom-delegations [delegator-addr]",
+		Short: "Query all additional bond denom delegations made by one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations of additional bond denoms of an individual delegator on all validators.
+
+Example:
+$ %s query staking denom-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegatorDenomDelegationsRequest{
+				DelegatorAddr: delegatorAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DelegatorDenomDelegations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom delegations")
+
+	return cmd
+}
+
+// GetCmdQueryDenomUnbondingDelegations implements the command to query the denom unbonding delegations of a delegator.
+func GetCmdQueryDenomUnbondingDelegations() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "denom-unbonding-delegations [delegator-addr]",
+		Short: "Query all additional bond denom unbonding-delegations records for one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegations of additional bond denoms of an individual delegator.
+
+Example:
+$ %s query staking denom-unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegatorDenomUnbondingDelegationsRequest{
+				DelegatorAddr: delegatorAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DelegatorDenomUnbondingDelegations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom unbonding delegations")
+
+	return cmd
+}
+
+// GetCmdQueryQueuedOperations implements the command to query the staking
+// operations queued until the end of the epoch.
+func GetCmdQueryQueuedOperations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-operations",
+		Short: "Query the staking operations queued until the end of the epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations, undelegations and redelegations queued until the end of the epoch.
+
+Example:
+$ %s query staking queued-operations
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedOperations(cmd.Context(), &types.QueryQueuedOperationsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued operations")
+
+	return cmd
+}
+
+// GetCmdQueryDelegatorQueuedOperations implements the command to query the
+// staking operations of a delegator queued until the end of the epoch.
+func GetCmdQueryDelegatorQueuedOperations() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegator-queued-operations [delegator-addr]",
+		Short: "Query the staking operations of one delegator queued until the end of the epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations, undelegations and redelegations of an individual delegator queued until the end of the epoch.
+
+Example:
+$ %s query staking delegator-queued-operations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegatorQueuedOperationsRequest{
+				DelegatorAddr: delegatorAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DelegatorQueuedOperations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued operations")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingQueue implements the command to query the unbonding
+// delegation entries maturing in a time window across all delegators.
+func GetCmdQueryUnbondingQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue [start-time] [end-time]",
+		Short: "Query the unbonding delegation entries maturing in a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegation entries of all delegators, of the bond denom and of
+the additional bond denoms, with a completion time within a window, along with their totals per
+validator and denom over the whole window. The times are in the RFC3339 format.
+
+Example:
+$ %s query staking unbonding-queue 2023-01-01T00:00:00Z 2023-01-08T00:00:00Z
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, err := parseTimeWindow(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cmd.Flags().GetString(FlagAddressValidator)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryUnbondingQueueRequest{
+				StartTime:     startTime,
+				EndTime:       endTime,
+				ValidatorAddr: valAddr,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.UnbondingQueue(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidator, "", "Only query the entries unbonding from this validator")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding queue entries")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationQueue implements the command to query the
+// redelegation entries maturing in a time window across all delegators.
+func GetCmdQueryRedelegationQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegation-queue [start-time] [end-time]",
+		Short: "Query the redelegation entries maturing in a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegation entries of all delegators with a completion time within a
+window, along with their totals per source validator over the whole window. The times are in the
+RFC3339 format.
+
+Example:
+$ %s query staking redelegation-queue 2023-01-01T00:00:00Z 2023-01-08T00:00:00Z
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, err := parseTimeWindow(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			valSrcAddr, err := cmd.Flags().GetString(FlagAddressValidatorSrc)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedelegationQueueRequest{
+				StartTime:        startTime,
+				EndTime:          endTime,
+				SrcValidatorAddr: valSrcAddr,
+				Pagination:       pageReq,
+			}
+
+			res, err := queryClient.RedelegationQueue(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidatorSrc, "", "Only query the entries redelegating from this validator")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation queue entries")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSetSize implements the command to query the effective
+// and target sizes of the active validator set.
+func GetCmdQueryValidatorSetSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-set-size",
+		Args:  cobra.NoArgs,
+		Short: "Query the effective size of the active validator set and its target size",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the effective size of the active validator set, the max_validators target it
+ramps toward, and the height at the end of which it next moves toward the target.
+
+Example:
+$ %s query staking validator-set-size
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorSetSize(cmd.Context(), &types.QueryValidatorSetSizeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTimeWindow parses the start and end times of a time window in the
+// RFC3339 format.
+func parseTimeWindow(start, end string) (startTime, endTime time.Time, err error) {
+	startTime, err = time.Parse(time.RFC3339, start)
+	if err != nil {
+		return startTime, endTime, fmt.Errorf("invalid start time: %w", err)
+	}
+
+	endTime, err = time.Parse(time.RFC3339, end)
+	if err != nil {
+		return startTime, endTime, fmt.Errorf("invalid end time: %w", err)
+	}
+
+	if endTime.Before(startTime) {
+		return startTime, endTime, fmt.Errorf("end time %s is before start time %s", end, start)
+	}
+
+	return startTime, endTime, nil
+}
@@ -5,6 +5,8 @@ import (
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -45,7 +47,105 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateDenomStakes(data); err != nil {
+		return err
+	}
+
+	return validateGenesisStateQueuedOperations(data)
+}
+
+// validateGenesisStateQueuedOperations checks that the queued staking
+// operations are valid, have unique ids and are in the bond denom.
+func validateGenesisStateQueuedOperations(data *types.GenesisState) error {
+	ids := make(map[uint64]bool, len(data.QueuedOperations))
+	for _, op := range data.QueuedOperations {
+		if err := op.Validate(); err != nil {
+			return fmt.Errorf("invalid queued operation %d: %w", op.Id, err)
+		}
+		if ids[op.Id] {
+			return fmt.Errorf("duplicate queued operation id %d", op.Id)
+		}
+		ids[op.Id] = true
+
+		if op.Amount.Denom != data.Params.BondDenom {
+			return fmt.Errorf("queued operation %d amount must be in the bond denom %s", op.Id, data.Params.BondDenom)
+		}
+	}
+
+	return nil
+}
+
+// validateGenesisStateDenomStakes checks that the additional bond denom stakes
+// belong to genesis validators, match their weighted tokens, and back the
+// denom delegations and unbonding delegations.
+func validateGenesisStateDenomStakes(data *types.GenesisState) error {
+	weightedTokens := make(map[string]math.Int, len(data.Validators))
+	for _, val := range data.Validators {
+		weightedTokens[val.OperatorAddress] = math.ZeroInt()
+	}
+
+	stakeShares := make(map[string]sdk.Dec, len(data.ValidatorDenomStakes))
+	for _, stake := range data.ValidatorDenomStakes {
+		total, ok := weightedTokens[stake.ValidatorAddress]
+		if !ok {
+			return fmt.Errorf("denom stake of unknown validator %s", stake.ValidatorAddress)
+		}
+		if err := sdk.ValidateDenom(stake.Denom); err != nil {
+			return err
+		}
+		if stake.Tokens.IsNegative() || !stake.Shares.IsPositive() {
+			return fmt.Errorf("invalid %s stake of validator %s", stake.Denom, stake.ValidatorAddress)
+		}
+
+		key := stake.ValidatorAddress + "/" + stake.Denom
+		if _, ok := stakeShares[key]; ok {
+			return fmt.Errorf("duplicate %s stake of validator %s", stake.Denom, stake.ValidatorAddress)
+		}
+		stakeShares[key] = stake.Shares
+
+		weight, _ := data.Params.BondDenomWeight(stake.Denom)
+		weightedTokens[stake.ValidatorAddress] = total.Add(stake.WeightedTokens(weight))
+	}
+
+	for _, val := range data.Validators {
+		if !val.GetWeightedTokens().Equal(weightedTokens[val.OperatorAddress]) {
+			return fmt.Errorf("validator %s weighted tokens %s do not match its denom stakes %s",
+				val.OperatorAddress, val.GetWeightedTokens(), weightedTokens[val.OperatorAddress])
+		}
+	}
+
+	for _, delegation := range data.DenomDelegations {
+		key := delegation.ValidatorAddress + "/" + delegation.Denom
+		shares, ok := stakeShares[key]
+		if !ok {
+			return fmt.Errorf("denom delegation to missing %s stake of validator %s", delegation.Denom, delegation.ValidatorAddress)
+		}
+		if !delegation.Shares.IsPositive() {
+			return fmt.Errorf("denom delegation of %s must have positive shares", delegation.DelegatorAddress)
+		}
+		stakeShares[key] = shares.Sub(delegation.Shares)
+	}
+
+	for key, shares := range stakeShares {
+		if !shares.IsZero() {
+			return fmt.Errorf("denom delegation shares do not sum up to the shares of stake %s", key)
+		}
+	}
+
+	for _, ubd := range data.DenomUnbondingDelegations {
+		if _, ok := weightedTokens[ubd.ValidatorAddress]; !ok {
+			return fmt.Errorf("denom unbonding delegation from unknown validator %s", ubd.ValidatorAddress)
+		}
+		if err := sdk.ValidateDenom(ubd.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func validateGenesisStateValidators(validators []types.Validator) error {
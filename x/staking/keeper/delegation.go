@@ -771,8 +771,9 @@ func (k Keeper) Unbond(
 	// remove the shares and coins from the validator
 	// NOTE that the amount is later (in keeper.Delegation) moved between staking module pools
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
-	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
+	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() && !k.HasValidatorDenomStakes(ctx, validator.GetOperator()) {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
+		// if it still holds additional bond denom stakes, it is removed once the last of them is undelegated
 		k.RemoveValidator(ctx, validator.GetOperator())
 	}
 
@@ -900,8 +901,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 
 			// track undelegation only when remaining or truncated shares are non-zero
+			amt := sdk.NewCoin(bondDenom, entry.Balance)
 			if !entry.Balance.IsZero() {
-				amt := sdk.NewCoin(bondDenom, entry.Balance)
 				if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(
 					ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(amt),
 				); err != nil {
@@ -910,6 +911,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 
 				balances = balances.Add(amt)
 			}
+
+			emitUnbondingEntryMatured(ctx, ubd.DelegatorAddress, ubd.ValidatorAddress, amt, entry.CreationHeight, entry.CompletionTime)
 		}
 	}
 
@@ -1004,9 +1007,12 @@ func (k Keeper) CompleteRedelegation(
 			i--
 			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 
+			amt := sdk.NewCoin(bondDenom, entry.InitialBalance)
 			if !entry.InitialBalance.IsZero() {
-				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
+				balances = balances.Add(amt)
 			}
+
+			emitRedelegationEntryMatured(ctx, red, amt, entry.CreationHeight, entry.CompletionTime)
 		}
 	}
 
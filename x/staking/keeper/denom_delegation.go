@@ -0,0 +1,510 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorDenomStake returns a validator's stake of an additional bond denom.
+func (k Keeper) GetValidatorDenomStake(ctx sdk.Context, valAddr sdk.ValAddress, denom string) (stake types.ValidatorDenomStake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetValidatorDenomStakeKey(valAddr, denom))
+	if value == nil {
+		return stake, false
+	}
+
+	k.cdc.MustUnmarshal(value, &stake)
+	return stake, true
+}
+
+// SetValidatorDenomStake sets a validator's stake of an additional bond denom.
+func (k Keeper) SetValidatorDenomStake(ctx sdk.Context, stake types.ValidatorDenomStake) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorDenomStakeKey(stake.GetValidatorAddr(), stake.Denom), k.cdc.MustMarshal(&stake))
+}
+
+// RemoveValidatorDenomStake removes a validator's stake of an additional bond denom.
+func (k Keeper) RemoveValidatorDenomStake(ctx sdk.Context, stake types.ValidatorDenomStake) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorDenomStakeKey(stake.GetValidatorAddr(), stake.Denom))
+}
+
+// GetValidatorDenomStakes returns all additional bond denom stakes of a validator.
+func (k Keeper) GetValidatorDenomStakes(ctx sdk.Context, valAddr sdk.ValAddress) (stakes []types.ValidatorDenomStake) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorDenomStakesKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.ValidatorDenomStake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
+		stakes = append(stakes, stake)
+	}
+
+	return stakes
+}
+
+// HasValidatorDenomStakes returns whether any additional bond denom is staked
+// to a validator.
+func (k Keeper) HasValidatorDenomStakes(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorDenomStakesKey(valAddr))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// IterateValidatorDenomStakes iterates through the additional bond denom stakes
+// of all validators.
+func (k Keeper) IterateValidatorDenomStakes(ctx sdk.Context, cb func(stake types.ValidatorDenomStake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorDenomStakeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stake types.ValidatorDenomStake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
+		if cb(stake) {
+			break
+		}
+	}
+}
+
+// GetValidatorDenomWeightedTokens returns the voting power contribution of each
+// additional bond denom staked to a validator, as coins of the staked denoms.
+// Their sum equals the validator's weighted tokens.
+func (k Keeper) GetValidatorDenomWeightedTokens(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Coins {
+	params := k.GetParams(ctx)
+	weighted := sdk.NewCoins()
+
+	for _, stake := range k.GetValidatorDenomStakes(ctx, valAddr) {
+		weight, _ := params.BondDenomWeight(stake.Denom)
+		weighted = weighted.Add(sdk.NewCoin(stake.Denom, stake.WeightedTokens(weight)))
+	}
+
+	return weighted
+}
+
+// GetDenomDelegation returns a delegator's bond of an additional bond denom
+// with a validator.
+func (k Keeper) GetDenomDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (delegation types.DenomDelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetDenomDelegationKey(delAddr, valAddr, denom))
+	if value == nil {
+		return delegation, false
+	}
+
+	k.cdc.MustUnmarshal(value, &delegation)
+	return delegation, true
+}
+
+// SetDenomDelegation sets an additional bond denom delegation.
+func (k Keeper) SetDenomDelegation(ctx sdk.Context, delegation types.DenomDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDenomDelegationKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr(), delegation.Denom)
+	store.Set(key, k.cdc.MustMarshal(&delegation))
+}
+
+// RemoveDenomDelegation removes an additional bond denom delegation.
+func (k Keeper) RemoveDenomDelegation(ctx sdk.Context, delegation types.DenomDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomDelegationKey(delegation.GetDelegatorAddr(), delegation.GetValidatorAddr(), delegation.Denom))
+}
+
+// IterateDenomDelegations iterates through all additional bond denom delegations.
+func (k Keeper) IterateDenomDelegations(ctx sdk.Context, cb func(delegation types.DenomDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.DenomDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetDenomUnbondingDelegation returns a delegator's unbonding delegation of an
+// additional bond denom from a validator.
+func (k Keeper) GetDenomUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (ubd types.DenomUnbondingDelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetDenomUBDKey(delAddr, valAddr, denom))
+	if value == nil {
+		return ubd, false
+	}
+
+	k.cdc.MustUnmarshal(value, &ubd)
+	return ubd, true
+}
+
+// GetDenomUnbondingDelegationsFromValidator returns all additional bond denom
+// unbonding delegations from a validator.
+func (k Keeper) GetDenomUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) (ubds []types.DenomUnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDenomUBDsByValIndexKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ubd types.DenomUnbondingDelegation
+		k.cdc.MustUnmarshal(store.Get(types.GetDenomUBDKeyFromValIndexKey(iterator.Key())), &ubd)
+		ubds = append(ubds, ubd)
+	}
+
+	return ubds
+}
+
+// SetDenomUnbondingDelegation sets an additional bond denom unbonding
+// delegation and its validator index.
+func (k Keeper) SetDenomUnbondingDelegation(ctx sdk.Context, ubd types.DenomUnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	delAddr, valAddr := ubd.GetDelegatorAddr(), ubd.GetValidatorAddr()
+
+	store.Set(types.GetDenomUBDKey(delAddr, valAddr, ubd.Denom), k.cdc.MustMarshal(&ubd))
+	store.Set(types.GetDenomUBDByValIndexKey(delAddr, valAddr, ubd.Denom), []byte{})
+}
+
+// RemoveDenomUnbondingDelegation removes an additional bond denom unbonding
+// delegation and its validator index.
+func (k Keeper) RemoveDenomUnbondingDelegation(ctx sdk.Context, ubd types.DenomUnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	delAddr, valAddr := ubd.GetDelegatorAddr(), ubd.GetValidatorAddr()
+
+	store.Delete(types.GetDenomUBDKey(delAddr, valAddr, ubd.Denom))
+	store.Delete(types.GetDenomUBDByValIndexKey(delAddr, valAddr, ubd.Denom))
+}
+
+// IterateDenomUnbondingDelegations iterates through all additional bond denom
+// unbonding delegations.
+func (k Keeper) IterateDenomUnbondingDelegations(ctx sdk.Context, cb func(ubd types.DenomUnbondingDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomUnbondingDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ubd types.DenomUnbondingDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+		if cb(ubd) {
+			break
+		}
+	}
+}
+
+// InsertDenomUBDQueue inserts an additional bond denom unbonding delegation
+// into the denom unbonding queue at the given completion time.
+func (k Keeper) InsertDenomUBDQueue(ctx sdk.Context, ubd types.DenomUnbondingDelegation, completionTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomUnbondingQueueKey(completionTime, ubd.GetDelegatorAddr(), ubd.GetValidatorAddr(), ubd.Denom), []byte{})
+}
+
+// DequeueAllMatureDenomUBDQueue returns and removes all the additional bond
+// denom unbonding delegations with entries maturing at or before currTime.
+func (k Keeper) DequeueAllMatureDenomUBDQueue(ctx sdk.Context, currTime time.Time) (mature []types.DenomUnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.DenomUnbondingQueueKey, sdk.PrefixEndBytes(types.GetDenomUnbondingTimeKey(currTime)))
+	defer iterator.Close()
+
+	seen := make(map[string]bool)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, valAddr, denom := types.ParseDenomUnbondingQueueKey(iterator.Key())
+		keys = append(keys, iterator.Key())
+
+		ubdKey := string(types.GetDenomUBDKey(delAddr, valAddr, denom))
+		if seen[ubdKey] {
+			continue
+		}
+		seen[ubdKey] = true
+		mature = append(mature, types.NewDenomUnbondingDelegation(delAddr, valAddr, denom))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return mature
+}
+
+// updateValidatorWeightedTokens recomputes the weighted tokens of a validator
+// from its additional bond denom stakes and the current weights, updating the
+// validator power index accordingly.
+func (k Keeper) updateValidatorWeightedTokens(ctx sdk.Context, validator types.Validator) types.Validator {
+	weightedTokens := math.ZeroInt()
+	for _, coin := range k.GetValidatorDenomWeightedTokens(ctx, validator.GetOperator()) {
+		weightedTokens = weightedTokens.Add(coin.Amount)
+	}
+
+	if weightedTokens.Equal(validator.GetWeightedTokens()) {
+		return validator
+	}
+
+	k.DeleteValidatorByPowerIndex(ctx, validator)
+	validator.WeightedTokens = weightedTokens
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByPowerIndex(ctx, validator)
+
+	return validator
+}
+
+// RefreshValidatorWeightedTokens recomputes the weighted tokens of every
+// validator holding additional bond denom stakes. It must be called whenever
+// the weighted bond denoms change.
+func (k Keeper) RefreshValidatorWeightedTokens(ctx sdk.Context) error {
+	var valAddrs []sdk.ValAddress
+	seen := make(map[string]bool)
+
+	k.IterateValidatorDenomStakes(ctx, func(stake types.ValidatorDenomStake) bool {
+		if !seen[stake.ValidatorAddress] {
+			seen[stake.ValidatorAddress] = true
+			valAddrs = append(valAddrs, stake.GetValidatorAddr())
+		}
+		return false
+	})
+
+	for _, valAddr := range valAddrs {
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		k.updateValidatorWeightedTokens(ctx, k.mustGetValidator(ctx, valAddr))
+	}
+
+	return nil
+}
+
+// DelegateDenom delegates coins of a whitelisted additional bond denom from a
+// delegator to a validator. The coins are held in the bonded pool, regardless
+// of the validator's status, and add to its voting power by the denom's weight.
+func (k Keeper) DelegateDenom(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount sdk.Coin) (newShares sdk.Dec, err error) {
+	if _, ok := k.GetParams(ctx).BondDenomWeight(amount.Denom); !ok {
+		return math.LegacyZeroDec(), types.ErrBondDenomNotWhitelisted.Wrap(amount.Denom)
+	}
+
+	valAddr := validator.GetOperator()
+
+	stake, found := k.GetValidatorDenomStake(ctx, valAddr, amount.Denom)
+	if !found {
+		stake = types.NewValidatorDenomStake(valAddr, amount.Denom)
+	}
+
+	delegation, found := k.GetDenomDelegation(ctx, delAddr, valAddr, amount.Denom)
+	if !found {
+		delegation = types.NewDenomDelegation(delAddr, valAddr, amount.Denom, math.LegacyZeroDec())
+	}
+
+	if err := k.Hooks().BeforeDenomDelegationSharesModified(ctx, delAddr, valAddr, amount.Denom); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.BondedPoolName, sdk.NewCoins(amount)); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	stake, newShares, err = stake.AddTokensFromDel(amount.Amount)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	k.SetValidatorDenomStake(ctx, stake)
+
+	delegation.Shares = delegation.Shares.Add(newShares)
+	k.SetDenomDelegation(ctx, delegation)
+
+	k.updateValidatorWeightedTokens(ctx, validator)
+
+	if err := k.Hooks().AfterDenomDelegationModified(ctx, delAddr, valAddr, amount.Denom); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return newShares, nil
+}
+
+// UndelegateDenom begins unbonding coins of an additional bond denom from a
+// validator. The coins are moved to the not bonded pool and released to the
+// delegator once the unbonding period has passed.
+func (k Keeper) UndelegateDenom(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (time.Time, error) {
+	delegation, found := k.GetDenomDelegation(ctx, delAddr, valAddr, amount.Denom)
+	if !found {
+		return time.Time{}, types.ErrNoDenomDelegation
+	}
+
+	stake, found := k.GetValidatorDenomStake(ctx, valAddr, amount.Denom)
+	if !found {
+		return time.Time{}, types.ErrNoDenomDelegation
+	}
+
+	shares, err := stake.SharesFromTokens(amount.Amount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// undelegate the whole delegation if the requested amount covers it, so no
+	// dust shares are left behind
+	if shares.GT(delegation.Shares) {
+		if amount.Amount.GT(stake.TokensFromShares(delegation.Shares).TruncateInt()) {
+			return time.Time{}, types.ErrNotEnoughDelegationShares
+		}
+		shares = delegation.Shares
+	}
+
+	ubd, found := k.GetDenomUnbondingDelegation(ctx, delAddr, valAddr, amount.Denom)
+	if !found {
+		ubd = types.NewDenomUnbondingDelegation(delAddr, valAddr, amount.Denom)
+	}
+	if len(ubd.Entries) >= int(k.MaxEntries(ctx)) {
+		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+	}
+
+	if err := k.Hooks().BeforeDenomDelegationSharesModified(ctx, delAddr, valAddr, amount.Denom); err != nil {
+		return time.Time{}, err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		k.RemoveDenomDelegation(ctx, delegation)
+	} else {
+		k.SetDenomDelegation(ctx, delegation)
+	}
+
+	stake, tokens := stake.RemoveDelShares(shares)
+	if stake.Shares.IsZero() {
+		k.RemoveValidatorDenomStake(ctx, stake)
+	} else {
+		k.SetValidatorDenomStake(ctx, stake)
+	}
+
+	validator := k.updateValidatorWeightedTokens(ctx, k.mustGetValidator(ctx, valAddr))
+
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	if tokens.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(amount.Denom, tokens))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.BondedPoolName, types.NotBondedPoolName, coins); err != nil {
+			return time.Time{}, err
+		}
+
+		ubd.AddEntry(ctx.BlockHeight(), completionTime, tokens)
+		k.SetDenomUnbondingDelegation(ctx, ubd)
+		k.InsertDenomUBDQueue(ctx, ubd, completionTime)
+	}
+
+	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() && !k.HasValidatorDenomStakes(ctx, valAddr) {
+		k.RemoveValidator(ctx, valAddr)
+	}
+
+	if err := k.Hooks().AfterDenomDelegationModified(ctx, delAddr, valAddr, amount.Denom); err != nil {
+		return time.Time{}, err
+	}
+
+	return completionTime, nil
+}
+
+// CompleteDenomUnbonding completes the unbonding of all mature entries of an
+// additional bond denom unbonding delegation and returns the released balance.
+func (k Keeper) CompleteDenomUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) (sdk.Coins, error) {
+	ubd, found := k.GetDenomUnbondingDelegation(ctx, delAddr, valAddr, denom)
+	if !found {
+		return nil, types.ErrNoUnbondingDelegation
+	}
+
+	balances := sdk.NewCoins()
+	ctxTime := ctx.BlockHeader().Time
+
+	for i := 0; i < len(ubd.Entries); i++ {
+		entry := ubd.Entries[i]
+		if !entry.IsMature(ctxTime) {
+			continue
+		}
+
+		ubd.RemoveEntry(int64(i))
+		i--
+
+		amt := sdk.NewCoin(denom, entry.Balance)
+		if !entry.Balance.IsZero() {
+			if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(
+				ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(amt),
+			); err != nil {
+				return nil, err
+			}
+
+			balances = balances.Add(amt)
+		}
+
+		emitUnbondingEntryMatured(ctx, ubd.DelegatorAddress, ubd.ValidatorAddress, amt, entry.CreationHeight, entry.CompletionTime)
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveDenomUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetDenomUnbondingDelegation(ctx, ubd)
+	}
+
+	return balances, nil
+}
+
+// slashDenomStakes slashes the additional bond denom stakes of a validator, and
+// the unbonding delegations from it that contributed to the infraction, by
+// slashFactor. The slashed coins are burned and the updated validator returned.
+func (k Keeper) slashDenomStakes(ctx sdk.Context, validator types.Validator, infractionHeight int64, slashFactor sdk.Dec) types.Validator {
+	valAddr := validator.GetOperator()
+	bondedBurned, notBondedBurned := sdk.NewCoins(), sdk.NewCoins()
+
+	for _, stake := range k.GetValidatorDenomStakes(ctx, valAddr) {
+		slashAmount := sdk.MinInt(slashFactor.MulInt(stake.Tokens).TruncateInt(), stake.Tokens)
+		if !slashAmount.IsPositive() {
+			continue
+		}
+
+		stake.Tokens = stake.Tokens.Sub(slashAmount)
+		k.SetValidatorDenomStake(ctx, stake)
+		bondedBurned = bondedBurned.Add(sdk.NewCoin(stake.Denom, slashAmount))
+	}
+
+	if infractionHeight < ctx.BlockHeight() {
+		now := ctx.BlockHeader().Time
+		for _, ubd := range k.GetDenomUnbondingDelegationsFromValidator(ctx, valAddr) {
+			for i, entry := range ubd.Entries {
+				// stake unbonding before the infraction or already released did not contribute
+				if entry.CreationHeight < infractionHeight || entry.IsMature(now) {
+					continue
+				}
+
+				slashAmount := sdk.MinInt(slashFactor.MulInt(entry.InitialBalance).TruncateInt(), entry.Balance)
+				if !slashAmount.IsPositive() {
+					continue
+				}
+
+				entry.Balance = entry.Balance.Sub(slashAmount)
+				ubd.Entries[i] = entry
+				notBondedBurned = notBondedBurned.Add(sdk.NewCoin(ubd.Denom, slashAmount))
+			}
+			k.SetDenomUnbondingDelegation(ctx, ubd)
+		}
+	}
+
+	if !bondedBurned.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.BondedPoolName, bondedBurned); err != nil {
+			panic(err)
+		}
+	}
+	if !notBondedBurned.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, notBondedBurned); err != nil {
+			panic(err)
+		}
+	}
+
+	return k.updateValidatorWeightedTokens(ctx, validator)
+}
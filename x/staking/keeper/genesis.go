@@ -32,6 +32,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	}
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
+	if data.EffectiveMaxValidators > 0 {
+		k.setEffectiveMaxValidators(ctx, data.EffectiveMaxValidators)
+	}
+
 	for _, validator := range data.Validators {
 		k.SetValidator(ctx, validator)
 
@@ -100,9 +104,50 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	var lastQueuedOperationID uint64
+	for _, op := range data.QueuedOperations {
+		k.SetQueuedOperation(ctx, op)
+
+		if op.Id > lastQueuedOperationID {
+			lastQueuedOperationID = op.Id
+		}
+
+		// coins of the queued delegations are escrowed in the not bonded pool
+		if op.OperationType == types.OperationTypeDelegate {
+			notBondedTokens = notBondedTokens.Add(op.Amount.Amount)
+		}
+	}
+	k.setQueuedOperationID(ctx, lastQueuedOperationID)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
+	// additional bond denom stakes are held in the bonded pool regardless of
+	// the validator status, their unbonding entries in the not bonded pool
+	for _, stake := range data.ValidatorDenomStakes {
+		k.SetValidatorDenomStake(ctx, stake)
+		bondedCoins = bondedCoins.Add(sdk.NewCoin(stake.Denom, stake.Tokens))
+	}
+
+	for _, delegation := range data.DenomDelegations {
+		k.SetDenomDelegation(ctx, delegation)
+
+		if !data.Exported {
+			if err := k.Hooks().AfterDenomDelegationModified(ctx, delegation.GetDelegatorAddr(), delegation.GetValidatorAddr(), delegation.Denom); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	for _, ubd := range data.DenomUnbondingDelegations {
+		k.SetDenomUnbondingDelegation(ctx, ubd)
+
+		for _, entry := range ubd.Entries {
+			k.InsertDenomUBDQueue(ctx, ubd, entry.CompletionTime)
+			notBondedCoins = notBondedCoins.Add(sdk.NewCoin(ubd.Denom, entry.Balance))
+		}
+	}
+
 	// check if the unbonded and bonded pools accounts exists
 	bondedPool := k.GetBondedPool(ctx)
 	if bondedPool == nil {
@@ -193,14 +238,42 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	var denomStakes []types.ValidatorDenomStake
+
+	k.IterateValidatorDenomStakes(ctx, func(stake types.ValidatorDenomStake) (stop bool) {
+		denomStakes = append(denomStakes, stake)
+		return false
+	})
+
+	var denomDelegations []types.DenomDelegation
+
+	k.IterateDenomDelegations(ctx, func(delegation types.DenomDelegation) (stop bool) {
+		denomDelegations = append(denomDelegations, delegation)
+		return false
+	})
+
+	var denomUnbondingDelegations []types.DenomUnbondingDelegation
+
+	k.IterateDenomUnbondingDelegations(ctx, func(ubd types.DenomUnbondingDelegation) (stop bool) {
+		denomUnbondingDelegations = append(denomUnbondingDelegations, ubd)
+		return false
+	})
+
+	effectiveMaxValidators, _ := k.getEffectiveMaxValidators(ctx)
+
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		ValidatorDenomStakes:      denomStakes,
+		DenomDelegations:          denomDelegations,
+		DenomUnbondingDelegations: denomUnbondingDelegations,
+		QueuedOperations:          k.GetAllQueuedOperations(ctx),
+		EffectiveMaxValidators:    effectiveMaxValidators,
 	}
 }
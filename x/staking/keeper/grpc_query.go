@@ -464,6 +464,297 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorDenomStakes queries the additional bond denom stakes of a validator
+func (k Querier) ValidatorDenomStakes(c context.Context, req *types.QueryValidatorDenomStakesRequest) (*types.QueryValidatorDenomStakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryValidatorDenomStakesResponse{Stakes: k.GetValidatorDenomStakes(ctx, valAddr)}, nil
+}
+
+// DelegatorDenomDelegations queries the additional bond denom delegations of a delegator
+func (k Querier) DelegatorDenomDelegations(c context.Context, req *types.QueryDelegatorDenomDelegationsRequest) (*types.QueryDelegatorDenomDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var delegations []types.DenomDelegation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDenomDelegationsKey(delAddr))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var delegation types.DenomDelegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorDenomDelegationsResponse{DenomDelegations: delegations, Pagination: pageRes}, nil
+}
+
+// DelegatorDenomUnbondingDelegations queries the additional bond denom unbonding delegations of a delegator
+func (k Querier) DelegatorDenomUnbondingDelegations(c context.Context, req *types.QueryDelegatorDenomUnbondingDelegationsRequest) (*types.QueryDelegatorDenomUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var ubds []types.DenomUnbondingDelegation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDenomUBDsKey(delAddr))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var ubd types.DenomUnbondingDelegation
+		if err := k.cdc.Unmarshal(value, &ubd); err != nil {
+			return err
+		}
+		ubds = append(ubds, ubd)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorDenomUnbondingDelegationsResponse{UnbondingResponses: ubds, Pagination: pageRes}, nil
+}
+
+// QueuedOperations queries the staking operations queued until the end of the epoch
+func (k Querier) QueuedOperations(c context.Context, req *types.QueryQueuedOperationsRequest) (*types.QueryQueuedOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var ops []types.QueuedStakingOperation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedOperationKey)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var op types.QueuedStakingOperation
+		if err := k.cdc.Unmarshal(value, &op); err != nil {
+			return err
+		}
+		ops = append(ops, op)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedOperationsResponse{
+		Operations:     ops,
+		EpochEndHeight: k.NextEpochEndHeight(ctx, ctx.BlockHeight()),
+		Pagination:     pageRes,
+	}, nil
+}
+
+// DelegatorQueuedOperations queries the staking operations of a delegator queued until the end of the epoch
+func (k Querier) DelegatorQueuedOperations(c context.Context, req *types.QueryDelegatorQueuedOperationsRequest) (*types.QueryDelegatorQueuedOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.DelegatorAddr); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var ops []types.QueuedStakingOperation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedOperationKey)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var op types.QueuedStakingOperation
+		if err := k.cdc.Unmarshal(value, &op); err != nil {
+			return false, err
+		}
+		if op.DelegatorAddress != req.DelegatorAddr {
+			return false, nil
+		}
+		if accumulate {
+			ops = append(ops, op)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorQueuedOperationsResponse{
+		Operations:     ops,
+		EpochEndHeight: k.NextEpochEndHeight(ctx, ctx.BlockHeight()),
+		Pagination:     pageRes,
+	}, nil
+}
+
+// UnbondingQueue queries the unbonding delegation entries maturing in a time window across all delegators
+func (k Querier) UnbondingQueue(c context.Context, req *types.QueryUnbondingQueueRequest) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	}
+
+	var valAddr sdk.ValAddress
+	if req.ValidatorAddr != "" {
+		var err error
+		valAddr, err = sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the totals are those of the whole window, whatever the page
+	totals := make([]types.QueueTotal, 0)
+	entries, pageRes, err := paginateQueueEntries(req.Pagination, func(cb func(entry types.UnbondingQueueEntry)) {
+		k.IterateUnbondingQueueEntries(ctx, req.StartTime, req.EndTime, valAddr, func(entry types.UnbondingQueueEntry) bool {
+			totals = types.AddUnbondingQueueTotal(totals, entry)
+			cb(entry)
+			return false
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnbondingQueueResponse{
+		Entries:    entries,
+		Totals:     types.SortQueueTotals(totals),
+		Pagination: pageRes,
+	}, nil
+}
+
+// RedelegationQueue queries the redelegation entries maturing in a time window across all delegators
+func (k Querier) RedelegationQueue(c context.Context, req *types.QueryRedelegationQueueRequest) (*types.QueryRedelegationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.EndTime.Before(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	}
+
+	var valSrcAddr sdk.ValAddress
+	if req.SrcValidatorAddr != "" {
+		var err error
+		valSrcAddr, err = sdk.ValAddressFromBech32(req.SrcValidatorAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the totals are those of the whole window, whatever the page
+	totals := make([]types.QueueTotal, 0)
+	entries, pageRes, err := paginateQueueEntries(req.Pagination, func(cb func(entry types.RedelegationQueueEntry)) {
+		k.IterateRedelegationQueueEntries(ctx, req.StartTime, req.EndTime, valSrcAddr, func(entry types.RedelegationQueueEntry) bool {
+			totals = types.AddRedelegationQueueTotal(totals, entry)
+			cb(entry)
+			return false
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedelegationQueueResponse{
+		Entries:    entries,
+		Totals:     types.SortQueueTotals(totals),
+		Pagination: pageRes,
+	}, nil
+}
+
+// ValidatorSetSize queries the effective size of the active validator set and the target size it ramps toward
+func (k Querier) ValidatorSetSize(c context.Context, _ *types.QueryValidatorSetSizeRequest) (*types.QueryValidatorSetSizeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryValidatorSetSizeResponse{
+		EffectiveMaxValidators: k.EffectiveMaxValidators(ctx),
+		MaxValidators:          k.MaxValidators(ctx),
+		NextStepHeight:         k.NextValidatorSetSizeStepHeight(ctx),
+	}, nil
+}
+
+// paginateQueueEntries returns a page of the queue entries iterated over by
+// iterate. The entries are paginated by offset, the next key encoding the
+// offset of the next page. The whole window is iterated over, so that iterate
+// can accumulate values over all the entries.
+func paginateQueueEntries[T any](pageRequest *query.PageRequest, iterate func(cb func(entry T))) ([]T, *query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	if pageRequest.Reverse {
+		return nil, nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	offset, limit := pageRequest.Offset, pageRequest.Limit
+	if len(pageRequest.Key) > 0 {
+		if offset > 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+		}
+		if len(pageRequest.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		offset = sdk.BigEndianToUint64(pageRequest.Key)
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	entries := make([]T, 0)
+	var count uint64
+	var nextKey []byte
+	iterate(func(entry T) {
+		count++
+		switch {
+		case count <= offset:
+		case count-offset <= limit:
+			entries = append(entries, entry)
+		case nextKey == nil:
+			nextKey = sdk.Uint64ToBigEndian(count - 1)
+		}
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageRequest.CountTotal {
+		pageRes.Total = count
+	}
+
+	return entries, pageRes, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
@@ -72,6 +72,9 @@ func ModuleAccountInvariants(k *Keeper) sdk.Invariant {
 			return false
 		})
 
+		// coins of the queued delegations are escrowed in the not bonded pool
+		notBonded = notBonded.Add(k.GetQueuedDelegationsEscrow(ctx))
+
 		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
 		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
 		broken := !poolBonded.Amount.Equal(bonded) || !poolNotBonded.Amount.Equal(notBonded)
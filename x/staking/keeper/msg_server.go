@@ -220,6 +220,14 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		)
 	}
 
+	// in epoch mode the delegation is applied at the end of the epoch
+	if k.EpochLength(ctx) > 0 {
+		if _, err := k.QueueOperation(ctx, msg); err != nil {
+			return nil, err
+		}
+		return &types.MsgDelegateResponse{}, nil
+	}
+
 	// NOTE: source funds are always unbonded
 	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
 	if err != nil {
@@ -279,6 +287,14 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// in epoch mode the redelegation is applied at the end of the epoch
+	if k.EpochLength(ctx) > 0 {
+		if _, err := k.QueueOperation(ctx, msg); err != nil {
+			return nil, err
+		}
+		return &types.MsgBeginRedelegateResponse{}, nil
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -338,6 +354,14 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
+	// in epoch mode the undelegation is applied at the end of the epoch
+	if k.EpochLength(ctx) > 0 {
+		if _, err := k.QueueOperation(ctx, msg); err != nil {
+			return nil, err
+		}
+		return &types.MsgUndelegateResponse{}, nil
+	}
+
 	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -487,5 +511,79 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, err
 	}
 
+	// the weights of the additional bond denoms may have changed
+	if err := ms.RefreshValidatorWeightedTokens(ctx); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// DelegateDenom defines a method for delegating an additional bond denom to a validator
+func (k msgServer) DelegateDenom(goCtx context.Context, msg *types.MsgDelegateDenom) (*types.MsgDelegateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	newShares, err := k.Keeper.DelegateDenom(ctx, delegatorAddress, validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegateDenom,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+		),
+	})
+
+	return &types.MsgDelegateDenomResponse{}, nil
+}
+
+// UndelegateDenom defines a method for undelegating an additional bond denom from a validator
+func (k msgServer) UndelegateDenom(goCtx context.Context, msg *types.MsgUndelegateDenom) (*types.MsgUndelegateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.Keeper.UndelegateDenom(ctx, delegatorAddress, valAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbondDenom,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgUndelegateDenomResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
@@ -49,6 +49,12 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// EpochLength - Number of blocks staking operations are queued for, zero when
+// they are applied immediately
+func (k Keeper) EpochLength(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).EpochLength
+}
+
 // SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IterateUnbondingQueueEntries iterates over the unbonding delegation entries,
+// of the bond denom and of the additional bond denoms, in the unbonding queues
+// with a completion time within [startTime, endTime], by completion time. If
+// valAddr is not empty, only the entries unbonding from it are iterated over.
+func (k Keeper) IterateUnbondingQueueEntries(
+	ctx sdk.Context, startTime, endTime time.Time, valAddr sdk.ValAddress,
+	cb func(entry types.UnbondingQueueEntry) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+
+	iterator := store.Iterator(types.GetUnbondingDelegationTimeKey(startTime),
+		sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(endTime)))
+	defer iterator.Close()
+
+	denomIterator := store.Iterator(types.GetDenomUnbondingTimeKey(startTime),
+		sdk.PrefixEndBytes(types.GetDenomUnbondingTimeKey(endTime)))
+	defer denomIterator.Close()
+
+	bondDenom := k.BondDenom(ctx)
+	for iterator.Valid() || denomIterator.Valid() {
+		// both queues are merged by completion time, the bond denom first
+		if iterator.Valid() && (!denomIterator.Valid() || bytes.Compare(
+			iterator.Key()[len(types.UnbondingQueueKey):],
+			denomIterator.Key()[len(types.DenomUnbondingQueueKey):len(types.DenomUnbondingQueueKey)+timeLen],
+		) <= 0) {
+			completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.UnbondingQueueKey):])
+			if err != nil {
+				panic(err)
+			}
+
+			timeslice := types.DVPairs{}
+			k.cdc.MustUnmarshal(iterator.Value(), &timeslice)
+			iterator.Next()
+
+			// an unbonding delegation is in the timeslice once per entry
+			seen := make(map[string]bool)
+			for _, dvPair := range timeslice.Pairs {
+				if len(valAddr) > 0 && dvPair.ValidatorAddress != valAddr.String() {
+					continue
+				}
+
+				key := dvPair.DelegatorAddress + "/" + dvPair.ValidatorAddress
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				delAddr := sdk.MustAccAddressFromBech32(dvPair.DelegatorAddress)
+				ubdValAddr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
+				if err != nil {
+					panic(err)
+				}
+
+				ubd, found := k.GetUnbondingDelegation(ctx, delAddr, ubdValAddr)
+				if !found {
+					continue
+				}
+
+				for _, entry := range ubd.Entries {
+					if entry.CompletionTime.Equal(completionTime) &&
+						cb(types.NewUnbondingQueueEntry(ubd.DelegatorAddress, ubd.ValidatorAddress, entry, bondDenom)) {
+						return
+					}
+				}
+			}
+			continue
+		}
+
+		key := denomIterator.Key()
+		denomIterator.Next()
+
+		completionTime, err := sdk.ParseTimeBytes(key[len(types.DenomUnbondingQueueKey) : len(types.DenomUnbondingQueueKey)+timeLen])
+		if err != nil {
+			panic(err)
+		}
+		delAddr, ubdValAddr, denom := types.ParseDenomUnbondingQueueKey(key)
+		if len(valAddr) > 0 && !ubdValAddr.Equals(valAddr) {
+			continue
+		}
+
+		ubd, found := k.GetDenomUnbondingDelegation(ctx, delAddr, ubdValAddr, denom)
+		if !found {
+			continue
+		}
+
+		for _, entry := range ubd.Entries {
+			if entry.CompletionTime.Equal(completionTime) &&
+				cb(types.NewUnbondingQueueEntry(ubd.DelegatorAddress, ubd.ValidatorAddress, entry, denom)) {
+				return
+			}
+		}
+	}
+}
+
+// IterateRedelegationQueueEntries iterates over the redelegation entries in
+// the redelegation queue with a completion time within [startTime, endTime],
+// by completion time. If valSrcAddr is not empty, only the entries
+// redelegating from it are iterated over.
+func (k Keeper) IterateRedelegationQueueEntries(
+	ctx sdk.Context, startTime, endTime time.Time, valSrcAddr sdk.ValAddress,
+	cb func(entry types.RedelegationQueueEntry) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetRedelegationTimeKey(startTime),
+		sdk.InclusiveEndBytes(types.GetRedelegationTimeKey(endTime)))
+	defer iterator.Close()
+
+	bondDenom := k.BondDenom(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Key()[len(types.RedelegationQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+
+		timeslice := types.DVVTriplets{}
+		k.cdc.MustUnmarshal(iterator.Value(), &timeslice)
+
+		// a redelegation is in the timeslice once per entry
+		seen := make(map[string]bool)
+		for _, dvvTriplet := range timeslice.Triplets {
+			if len(valSrcAddr) > 0 && dvvTriplet.ValidatorSrcAddress != valSrcAddr.String() {
+				continue
+			}
+
+			key := dvvTriplet.DelegatorAddress + "/" + dvvTriplet.ValidatorSrcAddress + "/" + dvvTriplet.ValidatorDstAddress
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			delAddr := sdk.MustAccAddressFromBech32(dvvTriplet.DelegatorAddress)
+			srcAddr, err := sdk.ValAddressFromBech32(dvvTriplet.ValidatorSrcAddress)
+			if err != nil {
+				panic(err)
+			}
+			dstAddr, err := sdk.ValAddressFromBech32(dvvTriplet.ValidatorDstAddress)
+			if err != nil {
+				panic(err)
+			}
+
+			red, found := k.GetRedelegation(ctx, delAddr, srcAddr, dstAddr)
+			if !found {
+				continue
+			}
+
+			for _, entry := range red.Entries {
+				if entry.CompletionTime.Equal(completionTime) && cb(types.NewRedelegationQueueEntry(red, entry, bondDenom)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// emitUnbondingEntryMatured emits the event of an unbonding delegation entry
+// that matured.
+func emitUnbondingEntryMatured(ctx sdk.Context, delAddr, valAddr string, amount sdk.Coin, creationHeight int64, completionTime time.Time) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondingEntryMatured,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(creationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+}
+
+// emitRedelegationEntryMatured emits the event of a redelegation entry that
+// matured.
+func emitRedelegationEntryMatured(ctx sdk.Context, red types.Redelegation, amount sdk.Coin, creationHeight int64, completionTime time.Time) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedelegationEntryMatured,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, red.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, red.ValidatorSrcAddress),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, red.ValidatorDstAddress),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(creationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+}
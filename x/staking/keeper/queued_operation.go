@@ -0,0 +1,269 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsEpochEnd returns whether the queued staking operations are applied at the
+// end of the current block.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	epochLength := k.EpochLength(ctx)
+	return epochLength == 0 || uint64(ctx.BlockHeight())%epochLength == 0
+}
+
+// NextEpochEndHeight returns the height at the end of which the operations
+// queued after the given height are applied.
+func (k Keeper) NextEpochEndHeight(ctx sdk.Context, height int64) int64 {
+	epochLength := int64(k.EpochLength(ctx))
+	if epochLength == 0 {
+		return height + 1
+	}
+
+	return (height/epochLength + 1) * epochLength
+}
+
+// incrementQueuedOperationID increments and returns a unique id for a queued
+// staking operation.
+func (k Keeper) incrementQueuedOperationID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.QueuedOperationIDKey)
+	if bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+
+	id++
+	store.Set(types.QueuedOperationIDKey, sdk.Uint64ToBigEndian(id))
+
+	return id
+}
+
+// setQueuedOperationID sets the id of the last queued staking operation.
+func (k Keeper) setQueuedOperationID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueuedOperationIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetQueuedOperation gets a queued staking operation by its id.
+func (k Keeper) GetQueuedOperation(ctx sdk.Context, id uint64) (op types.QueuedStakingOperation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQueuedOperationKey(id))
+	if bz == nil {
+		return op, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &op)
+	return op, true
+}
+
+// SetQueuedOperation sets a queued staking operation.
+func (k Keeper) SetQueuedOperation(ctx sdk.Context, op types.QueuedStakingOperation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&op)
+	store.Set(types.GetQueuedOperationKey(op.Id), bz)
+}
+
+// removeQueuedOperation removes a queued staking operation.
+func (k Keeper) removeQueuedOperation(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedOperationKey(id))
+}
+
+// IterateQueuedOperations iterates over the queued staking operations in the
+// order they are applied.
+func (k Keeper) IterateQueuedOperations(ctx sdk.Context, cb func(op types.QueuedStakingOperation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.QueuedOperationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var op types.QueuedStakingOperation
+		k.cdc.MustUnmarshal(iterator.Value(), &op)
+		if cb(op) {
+			break
+		}
+	}
+}
+
+// GetAllQueuedOperations returns all the queued staking operations.
+func (k Keeper) GetAllQueuedOperations(ctx sdk.Context) (ops []types.QueuedStakingOperation) {
+	k.IterateQueuedOperations(ctx, func(op types.QueuedStakingOperation) bool {
+		ops = append(ops, op)
+		return false
+	})
+
+	return ops
+}
+
+// GetQueuedDelegationsEscrow returns the total amount escrowed in the not
+// bonded pool by the queued delegations.
+func (k Keeper) GetQueuedDelegationsEscrow(ctx sdk.Context) math.Int {
+	escrow := math.ZeroInt()
+	k.IterateQueuedOperations(ctx, func(op types.QueuedStakingOperation) bool {
+		if op.OperationType == types.OperationTypeDelegate {
+			escrow = escrow.Add(op.Amount.Amount)
+		}
+		return false
+	})
+
+	return escrow
+}
+
+// QueueOperation queues a delegation, undelegation or redelegation message
+// until the end of the epoch. The coins of a delegation are escrowed in the not
+// bonded pool until the delegation is applied.
+func (k Keeper) QueueOperation(ctx sdk.Context, msg sdk.Msg) (types.QueuedStakingOperation, error) {
+	op, err := types.NewQueuedStakingOperation(0, ctx.BlockHeight(), msg)
+	if err != nil {
+		return op, err
+	}
+
+	if op.OperationType == types.OperationTypeDelegate {
+		err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, op.GetDelegatorAddr(), types.NotBondedPoolName, sdk.NewCoins(op.Amount))
+		if err != nil {
+			return op, err
+		}
+	}
+
+	op.Id = k.incrementQueuedOperationID(ctx)
+	k.SetQueuedOperation(ctx, op)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueOperation,
+			sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperationType, op.OperationType.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, op.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, op.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, op.Amount.String()),
+		),
+	)
+
+	return op, nil
+}
+
+// ApplyQueuedOperations applies the queued staking operations in the order
+// they were queued. An operation that fails is dropped, and the escrowed coins
+// of a failed delegation are returned to the delegator.
+func (k Keeper) ApplyQueuedOperations(ctx sdk.Context) {
+	for _, op := range k.GetAllQueuedOperations(ctx) {
+		k.removeQueuedOperation(ctx, op.Id)
+
+		cacheCtx, write := ctx.CacheContext()
+		err := k.applyQueuedOperation(cacheCtx, op)
+		if err == nil {
+			write()
+			continue
+		}
+
+		if op.OperationType == types.OperationTypeDelegate {
+			err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, op.GetDelegatorAddr(), sdk.NewCoins(op.Amount))
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQueuedOperationFailed,
+				sdk.NewAttribute(types.AttributeKeyOperationID, strconv.FormatUint(op.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyOperationType, op.OperationType.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, op.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+}
+
+// applyQueuedOperation applies a queued staking operation against the current
+// state, emitting the same events as the message it was queued from.
+func (k Keeper) applyQueuedOperation(ctx sdk.Context, op types.QueuedStakingOperation) error {
+	delAddr, err := sdk.AccAddressFromBech32(op.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(op.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	switch op.OperationType {
+	case types.OperationTypeDelegate:
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return types.ErrNoValidatorFound
+		}
+
+		// the escrowed coins are delegated from the not bonded pool
+		newShares, err := k.Delegate(ctx, delAddr, op.Amount.Amount, types.Unbonded, validator, false)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegate,
+				sdk.NewAttribute(types.AttributeKeyValidator, op.ValidatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, op.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+			),
+		)
+
+	case types.OperationTypeUndelegate:
+		shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, op.Amount.Amount)
+		if err != nil {
+			return err
+		}
+
+		completionTime, err := k.Undelegate(ctx, delAddr, valAddr, shares)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbond,
+				sdk.NewAttribute(types.AttributeKeyValidator, op.ValidatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, op.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			),
+		)
+
+	case types.OperationTypeRedelegate:
+		valDstAddr, err := sdk.ValAddressFromBech32(op.ValidatorDstAddress)
+		if err != nil {
+			return err
+		}
+
+		shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, op.Amount.Amount)
+		if err != nil {
+			return err
+		}
+
+		completionTime, err := k.BeginRedelegation(ctx, delAddr, valAddr, valDstAddr, shares)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRedelegate,
+				sdk.NewAttribute(types.AttributeKeySrcValidator, op.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, op.ValidatorDstAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, op.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			),
+		)
+
+	default:
+		return types.ErrInvalidQueuedOperation.Wrapf("invalid operation type %s", op.OperationType)
+	}
+
+	return nil
+}
@@ -69,6 +69,16 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.Logger(ctx).Error("failed to call before validator modified hook", "error", err)
 	}
 
+	// The voting power at the infraction also counts the validator's weighted
+	// additional bond denom stakes. Those are slashed directly by slashFactor,
+	// so only the bond denom share of the power is slashed from its tokens.
+	if k.HasValidatorDenomStakes(ctx, operatorAddress) {
+		if powerTokens := validator.PowerTokens(); powerTokens.IsPositive() {
+			slashAmount = slashAmountDec.MulInt(validator.Tokens).QuoInt(powerTokens).TruncateInt()
+		}
+		validator = k.slashDenomStakes(ctx, validator, infractionHeight, slashFactor)
+	}
+
 	// Track remaining slash amount for the validator
 	// This will decrease when we slash unbondings and
 	// redelegations, as that stake has since unbonded
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TransferUnbonding transfers to toAddr the ownership of the entries of the
+// unbonding delegation of fromAddr from valAddr, splitting the last one if
+// needed, until wantAmt tokens have changed hands. The transferred entries
+// keep their creation height and completion time, so they remain slashable
+// for the same infractions. Entries on hold are not transferred, and the
+// transfer stops when the unbonding delegation of toAddr reaches the maximum
+// number of entries. It returns the amount of tokens transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := math.ZeroInt()
+	if fromAddr.Equals(toAddr) {
+		return transferred
+	}
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+		if entry.OnHold() || !entry.Balance.IsPositive() {
+			continue
+		}
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		amt := math.MinInt(entry.Balance, wantAmt)
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		transferred = transferred.Add(amt)
+		wantAmt = wantAmt.Sub(amt)
+		modified = true
+
+		if amt.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(amt)
+		entry.InitialBalance = entry.InitialBalance.Sub(amt)
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation transfers to toAddr up to wantShares of the delegation of
+// fromAddr to valAddr. The entries of the redelegations of fromAddr to valAddr
+// backing the transferred shares are transferred along, so that the shares
+// remain slashable for infractions of the source validators; nothing is
+// transferred if toAddr could not receive all of them. It returns the shares
+// transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	transferred := math.LegacyZeroDec()
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred, nil
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	var reds []types.Redelegation
+	maxEntries := int(k.MaxEntries(ctx))
+	exceeded := false
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress != valAddr.String() {
+			return false
+		}
+		reds = append(reds, red)
+
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+		redTo, found := k.GetRedelegation(ctx, toAddr, valSrcAddr, valAddr)
+		exceeded = found && len(redTo.Entries)+len(red.Entries) > maxEntries
+		return exceeded
+	})
+	if exceeded {
+		return transferred, nil
+	}
+
+	transferred = math.LegacyMinDec(wantShares, delFrom.Shares)
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+	if delFrom.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, delFrom); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		if err := k.Hooks().AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	}
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	var err error
+	if found {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	if err := k.Hooks().AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	remaining := transferred
+	for _, red := range reds {
+		if !remaining.IsPositive() {
+			break
+		}
+		remaining = k.transferRedelegationEntries(ctx, red, toAddr, remaining)
+	}
+
+	return transferred, nil
+}
+
+// transferRedelegationEntries transfers to toAddr the entries of the given
+// redelegation, splitting the last one if needed, until wantShares destination
+// shares have changed hands. Entries on hold are not transferred. It returns
+// the destination shares that remain to be transferred.
+func (k Keeper) transferRedelegationEntries(
+	ctx sdk.Context, red types.Redelegation, toAddr sdk.AccAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	modified := false
+	for i := 0; i < len(red.Entries) && wantShares.IsPositive(); i++ {
+		entry := red.Entries[i]
+		if entry.OnHold() || !entry.SharesDst.IsPositive() {
+			continue
+		}
+
+		shares := math.LegacyMinDec(entry.SharesDst, wantShares)
+		balance := entry.InitialBalance
+		if shares.LT(entry.SharesDst) {
+			balance = shares.MulInt(entry.InitialBalance).Quo(entry.SharesDst).TruncateInt()
+		}
+
+		redTo := k.SetRedelegationEntry(ctx, toAddr, valSrcAddr, valDstAddr, entry.CreationHeight, entry.CompletionTime, balance, shares, shares)
+		k.InsertRedelegationQueue(ctx, redTo, entry.CompletionTime)
+
+		wantShares = wantShares.Sub(shares)
+		modified = true
+
+		if shares.Equal(entry.SharesDst) {
+			red.RemoveEntry(int64(i))
+			i--
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+			continue
+		}
+
+		entry.SharesDst = entry.SharesDst.Sub(shares)
+		entry.InitialBalance = entry.InitialBalance.Sub(balance)
+		red.Entries[i] = entry
+	}
+
+	if modified {
+		if len(red.Entries) == 0 {
+			k.RemoveRedelegation(ctx, red)
+		} else {
+			k.SetRedelegation(ctx, red)
+		}
+	}
+
+	return wantShares
+}
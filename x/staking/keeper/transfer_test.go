@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestTransferUnbonding() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	completionTime := ctx.BlockTime().Add(time.Hour)
+
+	keeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 1, completionTime, sdk.NewInt(10))
+	keeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 2, completionTime, sdk.NewInt(20))
+
+	// the first entry is transferred whole, the second one split
+	transferred := keeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(15))
+	require.Equal(sdk.NewInt(15), transferred)
+
+	ubdFrom, found := keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	require.Len(ubdFrom.Entries, 1)
+	require.Equal(int64(2), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(sdk.NewInt(15), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := keeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(found)
+	require.Len(ubdTo.Entries, 2)
+	require.Equal(int64(1), ubdTo.Entries[0].CreationHeight)
+	require.Equal(sdk.NewInt(10), ubdTo.Entries[0].Balance)
+	require.Equal(int64(2), ubdTo.Entries[1].CreationHeight)
+	require.Equal(sdk.NewInt(5), ubdTo.Entries[1].Balance)
+	require.True(ubdTo.Entries[1].CompletionTime.Equal(completionTime))
+	require.Len(keeper.GetUBDQueueTimeSlice(ctx, completionTime), 2)
+
+	// no more than the unbonding tokens can be transferred
+	transferred = keeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(sdk.NewInt(15), transferred)
+	_, found = keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(found)
+	require.Equal(sdk.NewInt(30), keeper.GetDelegatorUnbonding(ctx, delAddrs[1]))
+
+	// nothing is transferred without unbonding delegation
+	transferred = keeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[1], sdk.NewInt(100))
+	require.True(transferred.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTransferDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	completionTime := ctx.BlockTime().Add(time.Hour)
+
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[0], valAddrs[1], math.LegacyNewDec(100)))
+	keeper.SetRedelegationEntry(ctx, delAddrs[0], valAddrs[0], valAddrs[1], 1, completionTime, sdk.NewInt(40), math.LegacyNewDec(40), math.LegacyNewDec(40))
+
+	// the shares are transferred with part of the redelegation entry
+	transferred, err := keeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[1], math.LegacyNewDec(30))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(30), transferred)
+
+	delFrom, found := keeper.GetDelegation(ctx, delAddrs[0], valAddrs[1])
+	require.True(found)
+	require.Equal(math.LegacyNewDec(70), delFrom.Shares)
+	delTo, found := keeper.GetDelegation(ctx, delAddrs[1], valAddrs[1])
+	require.True(found)
+	require.Equal(math.LegacyNewDec(30), delTo.Shares)
+
+	redFrom, found := keeper.GetRedelegation(ctx, delAddrs[0], valAddrs[0], valAddrs[1])
+	require.True(found)
+	require.Len(redFrom.Entries, 1)
+	require.Equal(math.LegacyNewDec(10), redFrom.Entries[0].SharesDst)
+	require.Equal(sdk.NewInt(10), redFrom.Entries[0].InitialBalance)
+	redTo, found := keeper.GetRedelegation(ctx, delAddrs[1], valAddrs[0], valAddrs[1])
+	require.True(found)
+	require.Len(redTo.Entries, 1)
+	require.Equal(math.LegacyNewDec(30), redTo.Entries[0].SharesDst)
+	require.Equal(sdk.NewInt(30), redTo.Entries[0].InitialBalance)
+	require.Equal(int64(1), redTo.Entries[0].CreationHeight)
+
+	// no more than the delegation shares can be transferred
+	transferred, err = keeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[1], math.LegacyNewDec(1000))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(70), transferred)
+	_, found = keeper.GetDelegation(ctx, delAddrs[0], valAddrs[1])
+	require.False(found)
+	_, found = keeper.GetRedelegation(ctx, delAddrs[0], valAddrs[0], valAddrs[1])
+	require.False(found)
+	delTo, found = keeper.GetDelegation(ctx, delAddrs[1], valAddrs[1])
+	require.True(found)
+	require.Equal(math.LegacyNewDec(100), delTo.Shares)
+
+	// nothing is transferred without delegation
+	transferred, err = keeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[0], math.LegacyNewDec(10))
+	require.NoError(err)
+	require.True(transferred.IsZero())
+}
@@ -58,6 +58,24 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
+	// Remove all mature additional bond denom unbonding delegations from the
+	// denom unbonding queue.
+	for _, ubd := range k.DequeueAllMatureDenomUBDQueue(ctx, ctx.BlockHeader().Time) {
+		balances, err := k.CompleteDenomUnbonding(ctx, ubd.GetDelegatorAddr(), ubd.GetValidatorAddr(), ubd.Denom)
+		if err != nil {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteDenomUnbonding,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, ubd.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDelegator, ubd.DelegatorAddress),
+			),
+		)
+	}
+
 	// Remove all mature redelegations from the red queue.
 	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvvTriplet := range matureRedelegations {
@@ -108,8 +126,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // at the previous block height or were removed from the validator set entirely
 // are returned to Tendermint.
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
-	params := k.GetParams(ctx)
-	maxValidators := params.MaxValidators
+	maxValidators := k.EffectiveMaxValidators(ctx)
 	powerReduction := k.PowerReduction(ctx)
 	totalPower := math.ZeroInt()
 	amtFromBondedToNotBonded, amtFromNotBondedToBonded := math.ZeroInt(), math.ZeroInt()
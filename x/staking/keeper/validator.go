@@ -224,7 +224,7 @@ func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators [
 
 // get the current group of bonded validators sorted by power-rank
 func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
-	maxValidators := k.MaxValidators(ctx)
+	maxValidators := k.EffectiveMaxValidators(ctx)
 	validators := make([]types.Validator, maxValidators)
 
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
@@ -313,7 +313,7 @@ func (k Keeper) GetLastValidators(ctx sdk.Context) (validators []types.Validator
 	store := ctx.KVStore(k.storeKey)
 
 	// add the actual validator power sorted store
-	maxValidators := k.MaxValidators(ctx)
+	maxValidators := k.EffectiveMaxValidators(ctx)
 	validators = make([]types.Validator, maxValidators)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.LastValidatorPowerKey)
@@ -462,7 +462,7 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 
 					val = k.UnbondingToUnbonded(ctx, val)
 
-					if val.GetDelegatorShares().IsZero() {
+					if val.GetDelegatorShares().IsZero() && !k.HasValidatorDenomStakes(ctx, val.GetOperator()) {
 						k.RemoveValidator(ctx, val.GetOperator())
 					} else {
 						// remove unbonding ids
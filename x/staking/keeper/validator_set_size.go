@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// EffectiveMaxValidators returns the size of the active validator set. It is
+// MaxValidators, unless the max validators ramp is enabled, in which case it
+// moves toward MaxValidators by MaxValidatorsRampStep validators every
+// MaxValidatorsRampInterval blocks.
+func (k Keeper) EffectiveMaxValidators(ctx sdk.Context) uint32 {
+	params := k.GetParams(ctx)
+	if !params.MaxValidatorsRampEnabled() {
+		return params.MaxValidators
+	}
+
+	if effective, found := k.getEffectiveMaxValidators(ctx); found {
+		return effective
+	}
+
+	return params.MaxValidators
+}
+
+// getEffectiveMaxValidators gets the stored size of the active validator set.
+func (k Keeper) getEffectiveMaxValidators(ctx sdk.Context) (uint32, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EffectiveMaxValidatorsKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return uint32(binary.BigEndian.Uint64(bz)), true
+}
+
+// setEffectiveMaxValidators sets the size of the active validator set.
+func (k Keeper) setEffectiveMaxValidators(ctx sdk.Context, effective uint32) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EffectiveMaxValidatorsKey, sdk.Uint64ToBigEndian(uint64(effective)))
+}
+
+// StepValidatorSetSize moves the size of the active validator set a step
+// toward MaxValidators at the end of a ramp interval. When the ramp is
+// disabled, the size follows MaxValidators, so that enabling the ramp starts
+// it from the current size.
+func (k Keeper) StepValidatorSetSize(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	target := params.MaxValidators
+
+	effective, found := k.getEffectiveMaxValidators(ctx)
+	if !found || !params.MaxValidatorsRampEnabled() {
+		if effective != target {
+			k.setEffectiveMaxValidators(ctx, target)
+		}
+		return
+	}
+
+	if effective == target || uint64(ctx.BlockHeight())%params.MaxValidatorsRampInterval != 0 {
+		return
+	}
+
+	step := params.MaxValidatorsRampStep
+	switch {
+	case effective < target:
+		if target-effective < step {
+			step = target - effective
+		}
+		effective += step
+	default:
+		if effective-target < step {
+			step = effective - target
+		}
+		effective -= step
+	}
+
+	k.setEffectiveMaxValidators(ctx, effective)
+}
+
+// NextValidatorSetSizeStepHeight returns the height at the end of which the
+// size of the active validator set next moves toward MaxValidators, zero if it
+// reached it.
+func (k Keeper) NextValidatorSetSizeStepHeight(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	if k.EffectiveMaxValidators(ctx) == params.MaxValidators {
+		return 0
+	}
+
+	interval := int64(params.MaxValidatorsRampInterval)
+	return (ctx.BlockHeight()/interval + 1) * interval
+}
@@ -37,19 +37,28 @@ func TestMigrateJSON(t *testing.T) {
 	// Make sure about new param MinCommissionRate.
 	expected := `{
 	"delegations": [],
+	"denom_delegations": [],
+	"denom_unbonding_delegations": [],
+	"effective_max_validators": 0,
 	"exported": false,
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"epoch_length": "0",
 		"historical_entries": 10000,
 		"max_entries": 7,
 		"max_validators": 100,
+		"max_validators_ramp_interval": "0",
+		"max_validators_ramp_step": 0,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"unbonding_time": "1814400s",
+		"weighted_bond_denoms": []
 	},
+	"queued_operations": [],
 	"redelegations": [],
 	"unbonding_delegations": [],
+	"validator_denom_stakes": [],
 	"validators": []
 }`
 
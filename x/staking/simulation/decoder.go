@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,6 +23,8 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &powerB)
 
 			return fmt.Sprintf("%v\n%v", powerA, powerB)
+		case bytes.Equal(kvA.Key[:1], types.EffectiveMaxValidatorsKey):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
 		case bytes.Equal(kvA.Key[:1], types.ValidatorsKey):
 			var validatorA, validatorB types.Validator
 
@@ -57,6 +60,34 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorDenomStakeKey):
+			var stakeA, stakeB types.ValidatorDenomStake
+
+			cdc.MustUnmarshal(kvA.Value, &stakeA)
+			cdc.MustUnmarshal(kvB.Value, &stakeB)
+
+			return fmt.Sprintf("%v\n%v", stakeA, stakeB)
+		case bytes.Equal(kvA.Key[:1], types.DenomDelegationKey):
+			var delegationA, delegationB types.DenomDelegation
+
+			cdc.MustUnmarshal(kvA.Value, &delegationA)
+			cdc.MustUnmarshal(kvB.Value, &delegationB)
+
+			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
+		case bytes.Equal(kvA.Key[:1], types.DenomUnbondingDelegationKey):
+			var ubdA, ubdB types.DenomUnbondingDelegation
+
+			cdc.MustUnmarshal(kvA.Value, &ubdA)
+			cdc.MustUnmarshal(kvB.Value, &ubdB)
+
+			return fmt.Sprintf("%v\n%v", ubdA, ubdB)
+		case bytes.Equal(kvA.Key[:1], types.QueuedOperationKey):
+			var opA, opB types.QueuedStakingOperation
+
+			cdc.MustUnmarshal(kvA.Value, &opA)
+			cdc.MustUnmarshal(kvB.Value, &opB)
+
+			return fmt.Sprintf("%v\n%v", opA, opB)
 		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
 			var paramsA, paramsB types.Params
 
@@ -42,15 +42,26 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, math.LegacyOneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, math.OneInt(), 1)
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, math.OneInt(), math.LegacyOneDec(), 0)
+	stake := types.NewValidatorDenomStake(valAddr1, "ustable")
+	denomDel := types.NewDenomDelegation(delAddr1, valAddr1, "ustable", math.LegacyOneDec())
+	denomUBD := types.NewDenomUnbondingDelegation(delAddr1, valAddr1, "ustable")
+	denomUBD.AddEntry(15, bondTime, math.OneInt())
+	queuedOp, err := types.NewQueuedStakingOperation(1, 15, types.NewMsgDelegate(delAddr1, valAddr1, sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt())))
+	require.NoError(t, err)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.LastTotalPowerKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: math.OneInt()})},
+			{Key: types.EffectiveMaxValidatorsKey, Value: sdk.Uint64ToBigEndian(50)},
 			{Key: types.GetValidatorKey(valAddr1), Value: cdc.MustMarshal(&val)},
 			{Key: types.LastValidatorPowerKey, Value: valAddr1.Bytes()},
 			{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&del)},
 			{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&ubd)},
 			{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshal(&red)},
+			{Key: types.GetValidatorDenomStakeKey(valAddr1, "ustable"), Value: cdc.MustMarshal(&stake)},
+			{Key: types.GetDenomDelegationKey(delAddr1, valAddr1, "ustable"), Value: cdc.MustMarshal(&denomDel)},
+			{Key: types.GetDenomUBDKey(delAddr1, valAddr1, "ustable"), Value: cdc.MustMarshal(&denomUBD)},
+			{Key: types.GetQueuedOperationKey(1), Value: cdc.MustMarshal(&queuedOp)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -60,11 +71,16 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"LastTotalPower", fmt.Sprintf("%v\n%v", math.OneInt(), math.OneInt())},
+		{"EffectiveMaxValidators", "50\n50"},
 		{"Validator", fmt.Sprintf("%v\n%v", val, val)},
 		{"LastValidatorPower/ValidatorsByConsAddr/ValidatorsByPowerIndex", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"ValidatorDenomStake", fmt.Sprintf("%v\n%v", stake, stake)},
+		{"DenomDelegation", fmt.Sprintf("%v\n%v", denomDel, denomDel)},
+		{"DenomUnbondingDelegation", fmt.Sprintf("%v\n%v", denomUBD, denomUBD)},
+		{"QueuedStakingOperation", fmt.Sprintf("%v\n%v", queuedOp, queuedOp)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
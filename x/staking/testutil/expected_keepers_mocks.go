@@ -584,6 +584,20 @@ func (mr *MockStakingHooksMockRecorder) AfterDelegationModified(ctx, delAddr, va
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterDelegationModified", reflect.TypeOf((*MockStakingHooks)(nil).AfterDelegationModified), ctx, delAddr, valAddr)
 }
 
+// AfterDenomDelegationModified mocks base method.
+func (m *MockStakingHooks) AfterDenomDelegationModified(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress, denom string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterDenomDelegationModified", ctx, delAddr, valAddr, denom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterDenomDelegationModified indicates an expected call of AfterDenomDelegationModified.
+func (mr *MockStakingHooksMockRecorder) AfterDenomDelegationModified(ctx, delAddr, valAddr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterDenomDelegationModified", reflect.TypeOf((*MockStakingHooks)(nil).AfterDenomDelegationModified), ctx, delAddr, valAddr, denom)
+}
+
 // AfterUnbondingInitiated mocks base method.
 func (m *MockStakingHooks) AfterUnbondingInitiated(ctx types.Context, id uint64) error {
 	m.ctrl.T.Helper()
@@ -696,6 +710,20 @@ func (mr *MockStakingHooksMockRecorder) BeforeDelegationSharesModified(ctx, delA
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeDelegationSharesModified", reflect.TypeOf((*MockStakingHooks)(nil).BeforeDelegationSharesModified), ctx, delAddr, valAddr)
 }
 
+// BeforeDenomDelegationSharesModified mocks base method.
+func (m *MockStakingHooks) BeforeDenomDelegationSharesModified(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress, denom string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeforeDenomDelegationSharesModified", ctx, delAddr, valAddr, denom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeforeDenomDelegationSharesModified indicates an expected call of BeforeDenomDelegationSharesModified.
+func (mr *MockStakingHooksMockRecorder) BeforeDenomDelegationSharesModified(ctx, delAddr, valAddr, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeforeDenomDelegationSharesModified", reflect.TypeOf((*MockStakingHooks)(nil).BeforeDenomDelegationSharesModified), ctx, delAddr, valAddr, denom)
+}
+
 // BeforeValidatorModified mocks base method.
 func (m *MockStakingHooks) BeforeValidatorModified(ctx types.Context, valAddr types.ValAddress) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorDenomStake creates a new, empty stake of an additional bond
+// denom for a validator.
+//
+//nolint:interfacer
+func NewValidatorDenomStake(valAddr sdk.ValAddress, denom string) ValidatorDenomStake {
+	return ValidatorDenomStake{
+		ValidatorAddress: valAddr.String(),
+		Denom:            denom,
+		Tokens:           math.ZeroInt(),
+		Shares:           math.LegacyZeroDec(),
+	}
+}
+
+// GetValidatorAddr returns the validator address of the stake.
+func (s ValidatorDenomStake) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(s.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// SharesFromTokens returns the shares issued for a bond of amt tokens.
+func (s ValidatorDenomStake) SharesFromTokens(amt math.Int) (sdk.Dec, error) {
+	if s.Tokens.IsZero() {
+		if s.Shares.IsPositive() {
+			return math.LegacyZeroDec(), ErrInsufficientShares
+		}
+		return math.LegacyNewDecFromInt(amt), nil
+	}
+
+	return s.Shares.MulInt(amt).QuoInt(s.Tokens), nil
+}
+
+// TokensFromShares calculates the token worth of the provided shares.
+func (s ValidatorDenomStake) TokensFromShares(shares sdk.Dec) math.LegacyDec {
+	return shares.MulInt(s.Tokens).Quo(s.Shares)
+}
+
+// AddTokensFromDel adds tokens to the stake and returns the shares issued.
+func (s ValidatorDenomStake) AddTokensFromDel(amount math.Int) (ValidatorDenomStake, sdk.Dec, error) {
+	issuedShares, err := s.SharesFromTokens(amount)
+	if err != nil {
+		return s, math.LegacyZeroDec(), err
+	}
+
+	s.Tokens = s.Tokens.Add(amount)
+	s.Shares = s.Shares.Add(issuedShares)
+
+	return s, issuedShares, nil
+}
+
+// RemoveDelShares removes shares from the stake and returns the tokens they
+// were worth. If all shares are removed, all remaining tokens are returned.
+func (s ValidatorDenomStake) RemoveDelShares(delShares sdk.Dec) (ValidatorDenomStake, math.Int) {
+	remainingShares := s.Shares.Sub(delShares)
+
+	var issuedTokens math.Int
+	if remainingShares.IsZero() {
+		issuedTokens = s.Tokens
+		s.Tokens = math.ZeroInt()
+	} else {
+		issuedTokens = s.TokensFromShares(delShares).TruncateInt()
+		s.Tokens = s.Tokens.Sub(issuedTokens)
+	}
+
+	s.Shares = remainingShares
+
+	return s, issuedTokens
+}
+
+// WeightedTokens returns the stake's contribution to voting power given the
+// weight of its denom.
+func (s ValidatorDenomStake) WeightedTokens(weight sdk.Dec) math.Int {
+	return weight.MulInt(s.Tokens).TruncateInt()
+}
+
+// NewDenomDelegation creates a new additional bond denom delegation object.
+//
+//nolint:interfacer
+func NewDenomDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, shares sdk.Dec) DenomDelegation {
+	return DenomDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Denom:            denom,
+		Shares:           shares,
+	}
+}
+
+// GetDelegatorAddr returns the delegator address of the delegation.
+func (d DenomDelegation) GetDelegatorAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(d.DelegatorAddress)
+}
+
+// GetValidatorAddr returns the validator address of the delegation.
+func (d DenomDelegation) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewDenomUnbondingDelegation creates a new additional bond denom unbonding
+// delegation object without entries.
+//
+//nolint:interfacer
+func NewDenomUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) DenomUnbondingDelegation {
+	return DenomUnbondingDelegation{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Denom:            denom,
+	}
+}
+
+// GetDelegatorAddr returns the delegator address of the unbonding delegation.
+func (ubd DenomUnbondingDelegation) GetDelegatorAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(ubd.DelegatorAddress)
+}
+
+// GetValidatorAddr returns the validator address of the unbonding delegation.
+func (ubd DenomUnbondingDelegation) GetValidatorAddr() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// AddEntry appends a new entry to the unbonding delegation, merging it into an
+// existing entry with the same creation height and completion time.
+func (ubd *DenomUnbondingDelegation) AddEntry(creationHeight int64, minTime time.Time, balance math.Int) {
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(minTime) {
+			entry.Balance = entry.Balance.Add(balance)
+			entry.InitialBalance = entry.InitialBalance.Add(balance)
+			ubd.Entries[i] = entry
+			return
+		}
+	}
+
+	ubd.Entries = append(ubd.Entries, NewUnbondingDelegationEntry(creationHeight, minTime, balance, 0))
+}
+
+// RemoveEntry removes the entry at index i from the unbonding delegation.
+func (ubd *DenomUnbondingDelegation) RemoveEntry(i int64) {
+	ubd.Entries = append(ubd.Entries[:i], ubd.Entries[i+1:]...)
+}
@@ -52,4 +52,7 @@ var (
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 41, "unbonding operation not found")
 	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 42, "cannot un-hold unbonding operation that is not on hold")
+	ErrBondDenomNotWhitelisted         = sdkerrors.Register(ModuleName, 43, "denom is not a whitelisted weighted bond denom")
+	ErrNoDenomDelegation               = sdkerrors.Register(ModuleName, 44, "no denom delegation for (address, validator, denom) tuple")
+	ErrInvalidQueuedOperation          = sdkerrors.Register(ModuleName, 45, "invalid queued staking operation")
 )
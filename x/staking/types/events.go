@@ -10,6 +10,13 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeDelegateDenom             = "delegate_denom"
+	EventTypeUnbondDenom               = "unbond_denom"
+	EventTypeCompleteDenomUnbonding    = "complete_denom_unbonding"
+	EventTypeQueueOperation            = "queue_staking_operation"
+	EventTypeQueuedOperationFailed     = "queued_staking_operation_failed"
+	EventTypeUnbondingEntryMatured     = "unbonding_entry_matured"
+	EventTypeRedelegationEntryMatured  = "redelegation_entry_matured"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +27,7 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyOperationID       = "operation_id"
+	AttributeKeyOperationType     = "operation_type"
+	AttributeKeyError             = "error"
 )
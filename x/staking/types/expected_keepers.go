@@ -105,6 +105,9 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error
 	AfterUnbondingInitiated(ctx sdk.Context, id uint64) error
+
+	BeforeDenomDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error // Must be called before an additional bond denom delegation's shares are modified
+	AfterDenomDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error        // Must be called after an additional bond denom delegation is created or modified
 }
 
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
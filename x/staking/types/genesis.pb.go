@@ -45,6 +45,18 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// validator_denom_stakes defines the additional bond denom pools of validators at genesis.
+	ValidatorDenomStakes []ValidatorDenomStake `protobuf:"bytes,9,rep,name=validator_denom_stakes,json=validatorDenomStakes,proto3" json:"validator_denom_stakes"`
+	// denom_delegations defines the additional bond denom delegations active at genesis.
+	DenomDelegations []DenomDelegation `protobuf:"bytes,10,rep,name=denom_delegations,json=denomDelegations,proto3" json:"denom_delegations"`
+	// denom_unbonding_delegations defines the additional bond denom unbonding delegations active at genesis.
+	DenomUnbondingDelegations []DenomUnbondingDelegation `protobuf:"bytes,11,rep,name=denom_unbonding_delegations,json=denomUnbondingDelegations,proto3" json:"denom_unbonding_delegations"`
+	// queued_operations defines the staking operations queued until the end of
+	// the epoch.
+	QueuedOperations []QueuedStakingOperation `protobuf:"bytes,12,rep,name=queued_operations,json=queuedOperations,proto3" json:"queued_operations"`
+	// effective_max_validators defines the size of the active validator set
+	// while it ramps toward max_validators. Zero if it is not set.
+	EffectiveMaxValidators uint32 `protobuf:"varint,13,opt,name=effective_max_validators,json=effectiveMaxValidators,proto3" json:"effective_max_validators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +141,41 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetValidatorDenomStakes() []ValidatorDenomStake {
+	if m != nil {
+		return m.ValidatorDenomStakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomDelegations() []DenomDelegation {
+	if m != nil {
+		return m.DenomDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomUnbondingDelegations() []DenomUnbondingDelegation {
+	if m != nil {
+		return m.DenomUnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedOperations() []QueuedStakingOperation {
+	if m != nil {
+		return m.QueuedOperations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEffectiveMaxValidators() uint32 {
+	if m != nil {
+		return m.EffectiveMaxValidators
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +227,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0x6d, 0x4a, 0xdb, 0xe4, 0xd2, 0xa2, 0xf6, 0x48, 0x2b, 0xb7, 0x48, 0x4e, 0xa8, 0x2a,
+	0x88, 0x0a, 0xb5, 0x69, 0xbb, 0x20, 0xb6, 0x46, 0x15, 0x08, 0xa9, 0xa8, 0xc5, 0xa1, 0x0c, 0x48,
+	0xc8, 0xba, 0xf4, 0x2e, 0xae, 0x15, 0xdb, 0x67, 0x7c, 0x97, 0x10, 0xc4, 0x0b, 0x74, 0xe4, 0x11,
+	0x3a, 0x32, 0x32, 0xf0, 0x10, 0x1d, 0x2b, 0x26, 0xc4, 0x50, 0xa1, 0x64, 0x80, 0xc7, 0x40, 0xbe,
+	0x73, 0x5c, 0x47, 0x89, 0xc3, 0x92, 0xc4, 0xfe, 0xfe, 0xff, 0xdf, 0xff, 0xbb, 0x7c, 0xba, 0x0f,
+	0x6c, 0x9e, 0x52, 0xe6, 0x53, 0x66, 0x32, 0x8e, 0xda, 0x6e, 0xe0, 0x98, 0xdd, 0x9d, 0x26, 0xe1,
+	0x68, 0xc7, 0x74, 0x48, 0x40, 0x98, 0xcb, 0x8c, 0x30, 0xa2, 0x9c, 0xc2, 0x55, 0xa9, 0x32, 0x12,
+	0x95, 0x91, 0xa8, 0xd6, 0xcb, 0x0e, 0x75, 0xa8, 0x90, 0x98, 0xf1, 0x2f, 0xa9, 0x5e, 0xcf, 0x63,
+	0x0e, 0xdd, 0x52, 0xb5, 0x26, 0x55, 0xb6, 0xb4, 0x27, 0x01, 0xb2, 0xb4, 0x8c, 0x7c, 0x37, 0xa0,
+	0xa6, 0xf8, 0x94, 0xaf, 0x36, 0xce, 0x8b, 0x60, 0xe1, 0x85, 0xec, 0xa9, 0xc1, 0x11, 0x27, 0x70,
+	0x1f, 0xcc, 0x85, 0x28, 0x42, 0x3e, 0xd3, 0xd4, 0xaa, 0x5a, 0x2b, 0xed, 0xea, 0xc6, 0xe4, 0x1e,
+	0x8d, 0x63, 0xa1, 0xaa, 0x17, 0x2f, 0xaf, 0x2b, 0xca, 0xd7, 0x3f, 0xdf, 0xb6, 0x54, 0x2b, 0x31,
+	0xc2, 0xf7, 0x60, 0xc9, 0x43, 0x8c, 0xdb, 0x9c, 0x72, 0xe4, 0xd9, 0x21, 0xfd, 0x48, 0x22, 0xed,
+	0x56, 0x55, 0xad, 0x2d, 0xd4, 0xf7, 0x62, 0xf1, 0xaf, 0xeb, 0xca, 0x03, 0xc7, 0xe5, 0x67, 0x9d,
+	0xa6, 0x71, 0x4a, 0xfd, 0xa4, 0xc3, 0xe4, 0x6b, 0x9b, 0xe1, 0xb6, 0xc9, 0x3f, 0x85, 0x84, 0x19,
+	0x2f, 0x03, 0x2e, 0xb1, 0x77, 0x62, 0xd8, 0x9b, 0x98, 0x75, 0x1c, 0xa3, 0xa0, 0x0b, 0x56, 0x04,
+	0xbe, 0x8b, 0x3c, 0x17, 0x23, 0x4e, 0x23, 0x19, 0xc1, 0xb4, 0x99, 0xea, 0x4c, 0xad, 0xb4, 0xbb,
+	0x95, 0xd7, 0xf0, 0x21, 0x62, 0xfc, 0xed, 0xd0, 0x23, 0x50, 0xd9, 0xe6, 0xef, 0x7a, 0x63, 0x65,
+	0x06, 0x0f, 0x01, 0x48, 0x53, 0x98, 0x76, 0x5b, 0xf0, 0xef, 0xe7, 0xf1, 0x53, 0x73, 0x16, 0x9b,
+	0xf1, 0xc3, 0x23, 0x50, 0xc2, 0xc4, 0x23, 0x0e, 0xe2, 0x2e, 0x0d, 0x98, 0x36, 0x2b, 0x70, 0x1b,
+	0x79, 0xb8, 0x83, 0x54, 0x9a, 0xe5, 0x65, 0x09, 0xb0, 0x0d, 0x56, 0x3a, 0x41, 0x93, 0x06, 0xd8,
+	0x0d, 0x1c, 0x3b, 0x8b, 0x9e, 0x13, 0xe8, 0x47, 0x79, 0xe8, 0x93, 0xa1, 0x69, 0x72, 0x46, 0xb9,
+	0x33, 0x5e, 0x67, 0xf0, 0x04, 0x2c, 0x46, 0x24, 0x1b, 0x32, 0x2f, 0x42, 0x36, 0xf3, 0x42, 0x2c,
+	0x82, 0x27, 0xd2, 0x47, 0x29, 0x70, 0x1d, 0x14, 0x48, 0x2f, 0xa4, 0x11, 0x27, 0x58, 0x2b, 0x54,
+	0xd5, 0x5a, 0xc1, 0x4a, 0x9f, 0xa1, 0x07, 0x56, 0x6f, 0x86, 0x8c, 0x49, 0x40, 0x7d, 0x3b, 0x4e,
+	0x21, 0x4c, 0x2b, 0x4e, 0x3f, 0x60, 0x3a, 0x8a, 0x83, 0xd8, 0xd4, 0x88, 0x3d, 0x23, 0x07, 0xec,
+	0x8e, 0xd7, 0x19, 0xb4, 0xc1, 0xb2, 0xcc, 0xc8, 0x1e, 0x12, 0x88, 0xa0, 0x87, 0xf9, 0x43, 0x0a,
+	0xa8, 0x3f, 0xf9, 0x5f, 0x5c, 0xc2, 0xa3, 0x35, 0x06, 0x3f, 0x83, 0x7b, 0x32, 0x60, 0xf2, 0xd0,
+	0x4a, 0x22, 0xea, 0xc9, 0xd4, 0xa8, 0xff, 0x4c, 0x6e, 0x0d, 0xe7, 0x88, 0x18, 0x6c, 0x81, 0xe5,
+	0x0f, 0x1d, 0xd2, 0x21, 0xd8, 0xa6, 0x21, 0x89, 0x92, 0xc8, 0x05, 0x11, 0x69, 0xe4, 0x45, 0xbe,
+	0x16, 0x86, 0x86, 0x7c, 0x7b, 0x34, 0xb4, 0x8d, 0x1c, 0x52, 0x32, 0xd3, 0x1a, 0x83, 0x4f, 0x81,
+	0x46, 0x5a, 0x2d, 0x72, 0xca, 0xdd, 0x2e, 0xb1, 0x7d, 0xd4, 0xb3, 0x33, 0x17, 0x68, 0xb1, 0xaa,
+	0xd6, 0x16, 0xad, 0xd5, 0xb4, 0xfe, 0x0a, 0xf5, 0xd2, 0x49, 0xb1, 0x8d, 0x33, 0x00, 0xc7, 0xaf,
+	0x28, 0xdc, 0x05, 0xf3, 0x08, 0xe3, 0x88, 0x30, 0xb9, 0x90, 0x8a, 0x75, 0xed, 0xc7, 0xf7, 0xed,
+	0x72, 0xd2, 0xf0, 0xbe, 0xac, 0x34, 0x78, 0xe4, 0x06, 0x8e, 0x35, 0x14, 0xc2, 0x32, 0x98, 0xbd,
+	0xd9, 0x3a, 0x33, 0x96, 0x7c, 0x78, 0x56, 0x38, 0xbf, 0xa8, 0x28, 0x7f, 0x2f, 0x2a, 0x4a, 0xfd,
+	0xf9, 0x65, 0x5f, 0x57, 0xaf, 0xfa, 0xba, 0xfa, 0xbb, 0xaf, 0xab, 0x5f, 0x06, 0xba, 0x72, 0x35,
+	0xd0, 0x95, 0x9f, 0x03, 0x5d, 0x79, 0xf7, 0x78, 0xea, 0x62, 0xea, 0xa5, 0xab, 0x57, 0xac, 0xa8,
+	0xe6, 0x9c, 0xd8, 0xa1, 0x7b, 0xff, 0x06, 0x00, 0xc2, 0xe3, 0xad, 0xb0, 0xed, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +290,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveMaxValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EffectiveMaxValidators))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.QueuedOperations) > 0 {
+		for iNdEx := len(m.QueuedOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DenomUnbondingDelegations) > 0 {
+		for iNdEx := len(m.DenomUnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DenomDelegations) > 0 {
+		for iNdEx := len(m.DenomDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ValidatorDenomStakes) > 0 {
+		for iNdEx := len(m.ValidatorDenomStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorDenomStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +543,33 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.ValidatorDenomStakes) > 0 {
+		for _, e := range m.ValidatorDenomStakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomDelegations) > 0 {
+		for _, e := range m.DenomDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomUnbondingDelegations) > 0 {
+		for _, e := range m.DenomUnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedOperations) > 0 {
+		for _, e := range m.QueuedOperations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EffectiveMaxValidators != 0 {
+		n += 1 + sovGenesis(uint64(m.EffectiveMaxValidators))
+	}
 	return n
 }
 
@@ -737,6 +880,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDenomStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDenomStakes = append(m.ValidatorDenomStakes, ValidatorDenomStake{})
+			if err := m.ValidatorDenomStakes[len(m.ValidatorDenomStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomDelegations = append(m.DenomDelegations, DenomDelegation{})
+			if err := m.DenomDelegations[len(m.DenomDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomUnbondingDelegations = append(m.DenomUnbondingDelegations, DenomUnbondingDelegation{})
+			if err := m.DenomUnbondingDelegations[len(m.DenomUnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedOperations = append(m.QueuedOperations, QueuedStakingOperation{})
+			if err := m.QueuedOperations[len(m.QueuedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMaxValidators", wireType)
+			}
+			m.EffectiveMaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveMaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -112,3 +112,21 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) e
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) BeforeDenomDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error {
+	for i := range h {
+		if err := h[i].BeforeDenomDelegationSharesModified(ctx, delAddr, valAddr, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiStakingHooks) AfterDenomDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error {
+	for i := range h {
+		if err := h[i].AfterDenomDelegationModified(ctx, delAddr, valAddr, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
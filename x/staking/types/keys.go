@@ -31,9 +31,12 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
+	EffectiveMaxValidatorsKey = []byte{0x13} // key for the size of the active validator set while it ramps toward MaxValidators
+
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorDenomStakeKey    = []byte{0x24} // prefix for each key to a validator's additional bond denom stake
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -46,9 +49,17 @@ var (
 	UnbondingIndexKey = []byte{0x38} // prefix for an index for looking up unbonding operations by their IDs
 	UnbondingTypeKey  = []byte{0x39} // prefix for an index containing the type of unbonding operations
 
-	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
-	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
-	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	DenomDelegationKey                    = []byte{0x3A} // key for an additional bond denom delegation
+	DenomUnbondingDelegationKey           = []byte{0x3B} // key for an additional bond denom unbonding-delegation
+	DenomUnbondingDelegationByValIndexKey = []byte{0x3C} // prefix for each key for an additional bond denom unbonding-delegation, by validator operator
+
+	QueuedOperationIDKey = []byte{0x3D} // key for the counter for the incrementing id for queued staking operations
+
+	UnbondingQueueKey      = []byte{0x41} // prefix for the timestamps in unbonding queue
+	RedelegationQueueKey   = []byte{0x42} // prefix for the timestamps in redelegations queue
+	ValidatorQueueKey      = []byte{0x43} // prefix for the timestamps in validator queue
+	DenomUnbondingQueueKey = []byte{0x44} // prefix for the timestamps in the additional bond denom unbonding queue
+	QueuedOperationKey     = []byte{0x45} // prefix for the staking operations queued until the end of the epoch
 
 	HistoricalInfoKey   = []byte{0x50} // prefix for the historical info
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
@@ -112,7 +123,7 @@ func GetValidatorsByPowerIndexKey(validator Validator, powerReduction math.Int)
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
 	// NOTE the larger values are of higher value
 
-	consensusPower := sdk.TokensToConsensusPower(validator.Tokens, powerReduction)
+	consensusPower := validator.PotentialConsensusPower(powerReduction)
 	consensusPowerBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(consensusPowerBytes, uint64(consensusPower))
 
@@ -377,3 +388,113 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetValidatorDenomStakesKey creates the prefix for all additional bond denom
+// stakes of a validator
+func GetValidatorDenomStakesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorDenomStakeKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorDenomStakeKey creates the key for a validator's stake of an
+// additional bond denom
+// VALUE: staking/ValidatorDenomStake
+func GetValidatorDenomStakeKey(valAddr sdk.ValAddress, denom string) []byte {
+	return append(GetValidatorDenomStakesKey(valAddr), []byte(denom)...)
+}
+
+// GetDenomDelegationsKey creates the prefix for all additional bond denom
+// delegations of a delegator
+func GetDenomDelegationsKey(delAddr sdk.AccAddress) []byte {
+	return append(DenomDelegationKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDenomDelegationKey creates the key for a delegator's bond of an additional
+// bond denom with a validator
+// VALUE: staking/DenomDelegation
+func GetDenomDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
+	return append(GetDenomDelegationsKey(delAddr), denomDelegationKeySuffix(valAddr, denom)...)
+}
+
+// GetDenomUBDsKey creates the prefix for all additional bond denom unbonding
+// delegations of a delegator
+func GetDenomUBDsKey(delAddr sdk.AccAddress) []byte {
+	return append(DenomUnbondingDelegationKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDenomUBDKey creates the key for an additional bond denom unbonding
+// delegation by delegator, validator and denom
+// VALUE: staking/DenomUnbondingDelegation
+func GetDenomUBDKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
+	return append(GetDenomUBDsKey(delAddr), denomDelegationKeySuffix(valAddr, denom)...)
+}
+
+// GetDenomUBDsByValIndexKey creates the prefix keyspace for the indexes of
+// additional bond denom unbonding delegations for a validator
+func GetDenomUBDsByValIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(DenomUnbondingDelegationByValIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDenomUBDByValIndexKey creates the index-key for an additional bond denom
+// unbonding delegation, stored by validator-index
+// VALUE: none (key rearrangement used)
+func GetDenomUBDByValIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
+	key := append(GetDenomUBDsByValIndexKey(valAddr), address.MustLengthPrefix(delAddr)...)
+	return append(key, []byte(denom)...)
+}
+
+// GetDenomUBDKeyFromValIndexKey rearranges the ValIndexKey to get the DenomUBDKey
+func GetDenomUBDKeyFromValIndexKey(indexKey []byte) []byte {
+	kv.AssertKeyAtLeastLength(indexKey, 2)
+	addrs := indexKey[1:] // remove prefix bytes
+
+	valAddrLen := int(addrs[0])
+	kv.AssertKeyAtLeastLength(addrs, 2+valAddrLen)
+	valAddr := addrs[1 : 1+valAddrLen]
+	delAddrLen := int(addrs[1+valAddrLen])
+	kv.AssertKeyAtLeastLength(addrs, 2+valAddrLen+delAddrLen)
+	delAddr := addrs[2+valAddrLen : 2+valAddrLen+delAddrLen]
+
+	return GetDenomUBDKey(delAddr, valAddr, string(addrs[2+valAddrLen+delAddrLen:]))
+}
+
+// GetDenomUnbondingTimeKey creates the prefix for all additional bond denom
+// unbonding delegations maturing at the given time
+func GetDenomUnbondingTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(DenomUnbondingQueueKey, bz...)
+}
+
+// GetDenomUnbondingQueueKey creates the key for an additional bond denom
+// unbonding delegation in the unbonding queue
+// VALUE: none (key rearrangement used)
+func GetDenomUnbondingQueueKey(timestamp time.Time, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
+	key := GetDenomUnbondingTimeKey(timestamp)
+	key = append(key, address.MustLengthPrefix(delAddr)...)
+	return append(key, denomDelegationKeySuffix(valAddr, denom)...)
+}
+
+// ParseDenomUnbondingQueueKey returns the delegator, validator and denom from a
+// key created by GetDenomUnbondingQueueKey.
+func ParseDenomUnbondingQueueKey(key []byte) (sdk.AccAddress, sdk.ValAddress, string) {
+	offset := len(DenomUnbondingQueueKey) + len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, offset+1)
+	delAddrLen := int(key[offset])
+	kv.AssertKeyAtLeastLength(key, offset+2+delAddrLen)
+	delAddr := sdk.AccAddress(key[offset+1 : offset+1+delAddrLen])
+
+	offset += 1 + delAddrLen
+	valAddrLen := int(key[offset])
+	kv.AssertKeyAtLeastLength(key, offset+1+valAddrLen)
+	valAddr := sdk.ValAddress(key[offset+1 : offset+1+valAddrLen])
+
+	return delAddr, valAddr, string(key[offset+1+valAddrLen:])
+}
+
+func denomDelegationKeySuffix(valAddr sdk.ValAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(valAddr), []byte(denom)...)
+}
+
+// GetQueuedOperationKey creates the key for a queued staking operation by its id.
+func GetQueuedOperationKey(id uint64) []byte {
+	return append(QueuedOperationKey, sdk.Uint64ToBigEndian(id)...)
+}
@@ -111,9 +111,35 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateWeightedBondDenoms(p.WeightedBondDenoms, p.BondDenom); err != nil {
+		return err
+	}
+
+	if p.MaxValidatorsRampStep > 0 && p.MaxValidatorsRampInterval == 0 {
+		return fmt.Errorf("max validators ramp interval must be positive when the ramp step is set")
+	}
+
 	return nil
 }
 
+// MaxValidatorsRampEnabled returns whether changes of MaxValidators are ramped
+// over time instead of being applied immediately.
+func (p Params) MaxValidatorsRampEnabled() bool {
+	return p.MaxValidatorsRampStep > 0
+}
+
+// BondDenomWeight returns the weight of an additional bond denom and whether
+// the denom is whitelisted.
+func (p Params) BondDenomWeight(denom string) (sdk.Dec, bool) {
+	for _, wd := range p.WeightedBondDenoms {
+		if wd.Denom == denom {
+			return wd.Weight, true
+		}
+	}
+
+	return math.LegacyZeroDec(), false
+}
+
 func validateUnbondingTime(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -210,3 +236,28 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateWeightedBondDenoms(denoms []WeightedBondDenom, bondDenom string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, wd := range denoms {
+		if err := sdk.ValidateDenom(wd.Denom); err != nil {
+			return err
+		}
+		if wd.Denom == bondDenom {
+			return fmt.Errorf("weighted bond denom cannot be the bond denom: %s", wd.Denom)
+		}
+		if seen[wd.Denom] {
+			return fmt.Errorf("duplicate weighted bond denom: %s", wd.Denom)
+		}
+		seen[wd.Denom] = true
+
+		if wd.Weight.IsNil() || !wd.Weight.IsPositive() {
+			return fmt.Errorf("weight of bond denom %s must be positive: %s", wd.Denom, wd.Weight)
+		}
+		if wd.Weight.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("weight of bond denom %s cannot be greater than 1: %s", wd.Denom, wd.Weight)
+		}
+	}
+
+	return nil
+}
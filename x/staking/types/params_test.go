@@ -35,4 +35,39 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate the max validators ramp
+	params = types.DefaultParams()
+	params.MaxValidatorsRampStep = 1
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorsRampInterval = 100
+	require.NoError(t, params.Validate())
+}
+
+func TestValidateWeightedBondDenoms(t *testing.T) {
+	params := types.DefaultParams()
+	params.WeightedBondDenoms = []types.WeightedBondDenom{{Denom: "ustable", Weight: math.LegacyNewDecWithPrec(5, 1)}}
+	require.NoError(t, params.Validate())
+
+	weight, ok := params.BondDenomWeight("ustable")
+	require.True(t, ok)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), weight)
+	_, ok = params.BondDenomWeight(params.BondDenom)
+	require.False(t, ok)
+
+	// the weight must be in (0, 1]
+	params.WeightedBondDenoms[0].Weight = math.LegacyZeroDec()
+	require.Error(t, params.Validate())
+	params.WeightedBondDenoms[0].Weight = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	// the bond denom and duplicates are not allowed
+	params.WeightedBondDenoms = []types.WeightedBondDenom{{Denom: params.BondDenom, Weight: math.LegacyOneDec()}}
+	require.Error(t, params.Validate())
+	params.WeightedBondDenoms = []types.WeightedBondDenom{
+		{Denom: "ustable", Weight: math.LegacyOneDec()},
+		{Denom: "ustable", Weight: math.LegacyOneDec()},
+	}
+	require.Error(t, params.Validate())
 }
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewUnbondingQueueEntry creates a new unbonding queue entry from an entry of
+// an unbonding delegation.
+func NewUnbondingQueueEntry(delegatorAddr, validatorAddr string, entry UnbondingDelegationEntry, denom string) UnbondingQueueEntry {
+	return UnbondingQueueEntry{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		CreationHeight:   entry.CreationHeight,
+		CompletionTime:   entry.CompletionTime,
+		Balance:          sdk.NewCoin(denom, entry.Balance),
+		OnHold:           entry.OnHold(),
+	}
+}
+
+// NewRedelegationQueueEntry creates a new redelegation queue entry from an
+// entry of a redelegation.
+func NewRedelegationQueueEntry(red Redelegation, entry RedelegationEntry, denom string) RedelegationQueueEntry {
+	return RedelegationQueueEntry{
+		DelegatorAddress:    red.DelegatorAddress,
+		ValidatorSrcAddress: red.ValidatorSrcAddress,
+		ValidatorDstAddress: red.ValidatorDstAddress,
+		CreationHeight:      entry.CreationHeight,
+		CompletionTime:      entry.CompletionTime,
+		InitialBalance:      sdk.NewCoin(denom, entry.InitialBalance),
+		SharesDst:           entry.SharesDst,
+		OnHold:              entry.OnHold(),
+	}
+}
+
+// AddUnbondingQueueTotal adds the balance of an unbonding queue entry to the
+// totals per validator and denom.
+func AddUnbondingQueueTotal(totals []QueueTotal, entry UnbondingQueueEntry) []QueueTotal {
+	return addQueueTotal(totals, entry.ValidatorAddress, entry.Balance)
+}
+
+// AddRedelegationQueueTotal adds the initial balance of a redelegation queue
+// entry to the totals per source validator and denom.
+func AddRedelegationQueueTotal(totals []QueueTotal, entry RedelegationQueueEntry) []QueueTotal {
+	return addQueueTotal(totals, entry.ValidatorSrcAddress, entry.InitialBalance)
+}
+
+func addQueueTotal(totals []QueueTotal, valAddr string, amount sdk.Coin) []QueueTotal {
+	for i, total := range totals {
+		if total.ValidatorAddress == valAddr && total.Amount.Denom == amount.Denom {
+			totals[i].Amount = total.Amount.Add(amount)
+			totals[i].Entries++
+			return totals
+		}
+	}
+
+	return append(totals, QueueTotal{ValidatorAddress: valAddr, Amount: amount, Entries: 1})
+}
+
+// SortQueueTotals sorts queue totals by validator and denom.
+func SortQueueTotals(totals []QueueTotal) []QueueTotal {
+	sort.SliceStable(totals, func(i, j int) bool {
+		if totals[i].ValidatorAddress != totals[j].ValidatorAddress {
+			return totals[i].ValidatorAddress < totals[j].ValidatorAddress
+		}
+		return totals[i].Amount.Denom < totals[j].Amount.Denom
+	})
+
+	return totals
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewQueuedStakingOperation creates a new staking operation queued until the
+// end of the epoch from a delegation, undelegation or redelegation message.
+func NewQueuedStakingOperation(id uint64, creationHeight int64, msg sdk.Msg) (QueuedStakingOperation, error) {
+	op := QueuedStakingOperation{Id: id, CreationHeight: creationHeight}
+
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		op.OperationType = OperationTypeDelegate
+		op.DelegatorAddress = msg.DelegatorAddress
+		op.ValidatorAddress = msg.ValidatorAddress
+		op.Amount = msg.Amount
+	case *MsgUndelegate:
+		op.OperationType = OperationTypeUndelegate
+		op.DelegatorAddress = msg.DelegatorAddress
+		op.ValidatorAddress = msg.ValidatorAddress
+		op.Amount = msg.Amount
+	case *MsgBeginRedelegate:
+		op.OperationType = OperationTypeRedelegate
+		op.DelegatorAddress = msg.DelegatorAddress
+		op.ValidatorAddress = msg.ValidatorSrcAddress
+		op.ValidatorDstAddress = msg.ValidatorDstAddress
+		op.Amount = msg.Amount
+	default:
+		return op, fmt.Errorf("cannot queue staking operation of type %T", msg)
+	}
+
+	return op, nil
+}
+
+// Msg returns the message the operation was queued from.
+func (op QueuedStakingOperation) Msg() (sdk.Msg, error) {
+	switch op.OperationType {
+	case OperationTypeDelegate:
+		return &MsgDelegate{DelegatorAddress: op.DelegatorAddress, ValidatorAddress: op.ValidatorAddress, Amount: op.Amount}, nil
+	case OperationTypeUndelegate:
+		return &MsgUndelegate{DelegatorAddress: op.DelegatorAddress, ValidatorAddress: op.ValidatorAddress, Amount: op.Amount}, nil
+	case OperationTypeRedelegate:
+		return &MsgBeginRedelegate{
+			DelegatorAddress:    op.DelegatorAddress,
+			ValidatorSrcAddress: op.ValidatorAddress,
+			ValidatorDstAddress: op.ValidatorDstAddress,
+			Amount:              op.Amount,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid staking operation type %s", op.OperationType)
+	}
+}
+
+// Validate performs a stateless validation of the queued operation.
+func (op QueuedStakingOperation) Validate() error {
+	msg, err := op.Msg()
+	if err != nil {
+		return err
+	}
+
+	return msg.ValidateBasic()
+}
+
+// GetDelegatorAddr returns the delegator address of the queued operation.
+func (op QueuedStakingOperation) GetDelegatorAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(op.DelegatorAddress)
+}
@@ -78,6 +78,42 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{0}
 }
 
+// StakingOperationType defines the type of a queued staking operation.
+type StakingOperationType int32
+
+const (
+	// UNSPECIFIED defines an invalid operation type.
+	OperationTypeUnspecified StakingOperationType = 0
+	// DELEGATE defines a delegation.
+	OperationTypeDelegate StakingOperationType = 1
+	// UNDELEGATE defines an undelegation.
+	OperationTypeUndelegate StakingOperationType = 2
+	// REDELEGATE defines a redelegation.
+	OperationTypeRedelegate StakingOperationType = 3
+)
+
+var StakingOperationType_name = map[int32]string{
+	0: "STAKING_OPERATION_TYPE_UNSPECIFIED",
+	1: "STAKING_OPERATION_TYPE_DELEGATE",
+	2: "STAKING_OPERATION_TYPE_UNDELEGATE",
+	3: "STAKING_OPERATION_TYPE_REDELEGATE",
+}
+
+var StakingOperationType_value = map[string]int32{
+	"STAKING_OPERATION_TYPE_UNSPECIFIED": 0,
+	"STAKING_OPERATION_TYPE_DELEGATE":    1,
+	"STAKING_OPERATION_TYPE_UNDELEGATE":  2,
+	"STAKING_OPERATION_TYPE_REDELEGATE":  3,
+}
+
+func (x StakingOperationType) String() string {
+	return proto.EnumName(StakingOperationType_name, int32(x))
+}
+
+func (StakingOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+
 // Infraction indicates the infraction a validator commited.
 type Infraction int32
 
@@ -107,7 +143,7 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -374,6 +410,10 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifing an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// weighted_tokens defines the risk-weighted sum of the additional bond denoms
+	// staked to this validator. It contributes to the validator's voting power on
+	// top of tokens.
+	WeightedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=weighted_tokens,json=weightedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"weighted_tokens"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -922,6 +962,21 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// weighted_bond_denoms defines the additional denoms that may be delegated
+	// to validators, along with the risk weight applied to them when computing
+	// voting power.
+	WeightedBondDenoms []WeightedBondDenom `protobuf:"bytes,7,rep,name=weighted_bond_denoms,json=weightedBondDenoms,proto3" json:"weighted_bond_denoms"`
+	// epoch_length defines the number of blocks of an epoch. When positive,
+	// delegations, undelegations and redelegations are queued and applied at the
+	// end of the epoch. Zero applies them immediately.
+	EpochLength uint64 `protobuf:"varint,8,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// max_validators_ramp_step defines the number of validators the size of the
+	// active validator set moves by toward max_validators at each step. Zero
+	// applies changes of max_validators immediately.
+	MaxValidatorsRampStep uint32 `protobuf:"varint,9,opt,name=max_validators_ramp_step,json=maxValidatorsRampStep,proto3" json:"max_validators_ramp_step,omitempty"`
+	// max_validators_ramp_interval defines the number of blocks between two steps
+	// of the active validator set size.
+	MaxValidatorsRampInterval uint64 `protobuf:"varint,10,opt,name=max_validators_ramp_interval,json=maxValidatorsRampInterval,proto3" json:"max_validators_ramp_interval,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -991,6 +1046,317 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetWeightedBondDenoms() []WeightedBondDenom {
+	if m != nil {
+		return m.WeightedBondDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidatorsRampStep() uint32 {
+	if m != nil {
+		return m.MaxValidatorsRampStep
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidatorsRampInterval() uint64 {
+	if m != nil {
+		return m.MaxValidatorsRampInterval
+	}
+	return 0
+}
+
+// WeightedBondDenom defines an additional bondable denom and the weight its
+// tokens carry relative to the bond denom.
+type WeightedBondDenom struct {
+	// denom is the additional bondable coin denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is the fraction of the staked amount that counts towards voting power.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedBondDenom) Reset()         { *m = WeightedBondDenom{} }
+func (m *WeightedBondDenom) String() string { return proto.CompactTextString(m) }
+func (*WeightedBondDenom) ProtoMessage()    {}
+func (*WeightedBondDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *WeightedBondDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedBondDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedBondDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedBondDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedBondDenom.Merge(m, src)
+}
+func (m *WeightedBondDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedBondDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedBondDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedBondDenom proto.InternalMessageInfo
+
+func (m *WeightedBondDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ValidatorDenomStake defines the pool of an additional bond denom staked to a
+// validator. Shares are issued to denom delegators against tokens.
+type ValidatorDenomStake struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// tokens defines the amount of denom staked to the validator.
+	Tokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// shares defines the total shares issued to denom delegators.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *ValidatorDenomStake) Reset()         { *m = ValidatorDenomStake{} }
+func (m *ValidatorDenomStake) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDenomStake) ProtoMessage()    {}
+func (*ValidatorDenomStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *ValidatorDenomStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDenomStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDenomStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDenomStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDenomStake.Merge(m, src)
+}
+func (m *ValidatorDenomStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDenomStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDenomStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDenomStake proto.InternalMessageInfo
+
+// DenomDelegation represents the bond of an additional bond denom owned by a
+// delegator with a validator.
+type DenomDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// shares define the delegation shares of the validator's denom stake.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *DenomDelegation) Reset()         { *m = DenomDelegation{} }
+func (m *DenomDelegation) String() string { return proto.CompactTextString(m) }
+func (*DenomDelegation) ProtoMessage()    {}
+func (*DenomDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
+}
+func (m *DenomDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomDelegation.Merge(m, src)
+}
+func (m *DenomDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomDelegation proto.InternalMessageInfo
+
+// DenomUnbondingDelegation stores all of a delegator's unbonding entries of an
+// additional bond denom from a single validator.
+type DenomUnbondingDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// entries are the unbonding delegation entries.
+	Entries []UnbondingDelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *DenomUnbondingDelegation) Reset()         { *m = DenomUnbondingDelegation{} }
+func (m *DenomUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*DenomUnbondingDelegation) ProtoMessage()    {}
+func (*DenomUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
+}
+func (m *DenomUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomUnbondingDelegation.Merge(m, src)
+}
+func (m *DenomUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomUnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomUnbondingDelegation proto.InternalMessageInfo
+
+// QueuedStakingOperation defines a delegation, undelegation or redelegation
+// queued until the end of the epoch. The coins of a queued delegation are
+// escrowed in the not bonded pool.
+type QueuedStakingOperation struct {
+	// id is the incrementing id of the operation, in which order operations are applied.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// operation_type is the type of the operation.
+	OperationType StakingOperationType `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=cosmos.staking.v1beta1.StakingOperationType" json:"operation_type,omitempty"`
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator, the
+	// source validator of a redelegation.
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// validator_dst_address is the bech32-encoded address of the destination
+	// validator of a redelegation.
+	ValidatorDstAddress string `protobuf:"bytes,5,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// amount is the amount of the operation.
+	Amount types2.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height at which the operation was queued.
+	CreationHeight int64 `protobuf:"varint,7,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+}
+
+func (m *QueuedStakingOperation) Reset()         { *m = QueuedStakingOperation{} }
+func (m *QueuedStakingOperation) String() string { return proto.CompactTextString(m) }
+func (*QueuedStakingOperation) ProtoMessage()    {}
+func (*QueuedStakingOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *QueuedStakingOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedStakingOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedStakingOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedStakingOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedStakingOperation.Merge(m, src)
+}
+func (m *QueuedStakingOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedStakingOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedStakingOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedStakingOperation proto.InternalMessageInfo
+
+func (m *QueuedStakingOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedStakingOperation) GetOperationType() StakingOperationType {
+	if m != nil {
+		return m.OperationType
+	}
+	return OperationTypeUnspecified
+}
+
+func (m *QueuedStakingOperation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueuedStakingOperation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *QueuedStakingOperation) GetValidatorDstAddress() string {
+	if m != nil {
+		return m.ValidatorDstAddress
+	}
+	return ""
+}
+
+func (m *QueuedStakingOperation) GetAmount() types2.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types2.Coin{}
+}
+
+func (m *QueuedStakingOperation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1001,7 +1367,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1422,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,7 +1470,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,7 +1524,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,7 +1563,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1235,6 +1601,7 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.StakingOperationType", StakingOperationType_name, StakingOperationType_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
@@ -1252,6 +1619,11 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*WeightedBondDenom)(nil), "cosmos.staking.v1beta1.WeightedBondDenom")
+	proto.RegisterType((*ValidatorDenomStake)(nil), "cosmos.staking.v1beta1.ValidatorDenomStake")
+	proto.RegisterType((*DenomDelegation)(nil), "cosmos.staking.v1beta1.DenomDelegation")
+	proto.RegisterType((*DenomUnbondingDelegation)(nil), "cosmos.staking.v1beta1.DenomUnbondingDelegation")
+	proto.RegisterType((*QueuedStakingOperation)(nil), "cosmos.staking.v1beta1.QueuedStakingOperation")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1264,126 +1636,151 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1890 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xf7, 0xb3, 0x5d, 0x27, 0xf9, 0x9c, 0xc4, 0xce, 0xec, 0x76, 0xd7, 0xeb, 0x85, 0xd8, 0x75,
-	0x4b, 0x9b, 0xae, 0xba, 0x0e, 0x1b, 0x24, 0x0e, 0xa1, 0x02, 0xad, 0xe3, 0x6c, 0xd7, 0x65, 0x9b,
-	0x58, 0xcf, 0x49, 0x4a, 0x41, 0xe8, 0x69, 0xfc, 0xde, 0xc4, 0x19, 0x62, 0xcf, 0xb3, 0xde, 0x8c,
-	0xb7, 0xb1, 0xc4, 0x01, 0x71, 0x5a, 0xe5, 0x80, 0x2a, 0x71, 0xa0, 0x97, 0x95, 0x56, 0x82, 0x03,
-	0x87, 0x22, 0xf5, 0x50, 0x71, 0xe1, 0x80, 0x38, 0x20, 0x15, 0x2e, 0xac, 0x7a, 0x42, 0x08, 0x05,
-	0xb4, 0x7b, 0x28, 0xe2, 0x84, 0xb8, 0x83, 0xd0, 0xcc, 0x9b, 0xf7, 0xc7, 0x76, 0xb2, 0x9b, 0xac,
-	0x42, 0x55, 0xa9, 0x97, 0xc4, 0x33, 0xf3, 0x7d, 0xbf, 0x37, 0xdf, 0xef, 0xfb, 0x33, 0xf3, 0x0d,
-	0xbc, 0x64, 0xbb, 0xbc, 0xe7, 0xf2, 0x65, 0x2e, 0xf0, 0x3e, 0x65, 0x9d, 0xe5, 0xbb, 0x37, 0xda,
-	0x44, 0xe0, 0x1b, 0xc1, 0xb8, 0xda, 0xf7, 0x5c, 0xe1, 0xa2, 0x4b, 0xbe, 0x54, 0x35, 0x98, 0xd5,
-	0x52, 0xc5, 0x8b, 0x1d, 0xb7, 0xe3, 0x2a, 0x91, 0x65, 0xf9, 0xcb, 0x97, 0x2e, 0x5e, 0xe9, 0xb8,
-	0x6e, 0xa7, 0x4b, 0x96, 0xd5, 0xa8, 0x3d, 0xd8, 0x5d, 0xc6, 0x6c, 0xa8, 0x97, 0x16, 0xc7, 0x97,
-	0x9c, 0x81, 0x87, 0x05, 0x75, 0x99, 0x5e, 0x2f, 0x8d, 0xaf, 0x0b, 0xda, 0x23, 0x5c, 0xe0, 0x5e,
-	0x3f, 0xc0, 0xf6, 0x77, 0x62, 0xf9, 0x1f, 0xd5, 0xdb, 0xd2, 0xd8, 0xda, 0x94, 0x36, 0xe6, 0x24,
-	0xb4, 0xc3, 0x76, 0x69, 0x80, 0xbd, 0x80, 0x7b, 0x94, 0xb9, 0xcb, 0xea, 0xaf, 0x9e, 0xfa, 0x92,
-	0x20, 0xcc, 0x21, 0x5e, 0x8f, 0x32, 0xb1, 0x2c, 0x86, 0x7d, 0xc2, 0xfd, 0xbf, 0x7a, 0xf5, 0x6a,
-	0x6c, 0x15, 0xb7, 0x6d, 0x1a, 0x5f, 0xac, 0xfc, 0xd4, 0x80, 0xf9, 0xdb, 0x94, 0x0b, 0xd7, 0xa3,
-	0x36, 0xee, 0x36, 0xd8, 0xae, 0x8b, 0xbe, 0x01, 0x99, 0x3d, 0x82, 0x1d, 0xe2, 0x15, 0x8c, 0xb2,
-	0xb1, 0x94, 0x5d, 0x29, 0x54, 0x23, 0x80, 0xaa, 0xaf, 0x7b, 0x5b, 0xad, 0xd7, 0x66, 0x3e, 0x3e,
-	0x2a, 0x25, 0x7e, 0xf9, 0xe9, 0x87, 0xd7, 0x0c, 0x53, 0xab, 0xa0, 0x3a, 0x64, 0xee, 0xe2, 0x2e,
-	0x27, 0xa2, 0x90, 0x2c, 0xa7, 0x96, 0xb2, 0x2b, 0x2f, 0x54, 0x8f, 0xe7, 0xbc, 0xba, 0x83, 0xbb,
-	0xd4, 0xc1, 0xc2, 0x1d, 0x45, 0xf1, 0x75, 0x2b, 0x1f, 0x24, 0x21, 0xb7, 0xe6, 0xf6, 0x7a, 0x94,
-	0x73, 0xea, 0x32, 0x13, 0x0b, 0xc2, 0x51, 0x13, 0xd2, 0x1e, 0x16, 0x44, 0x6d, 0x6a, 0xa6, 0xf6,
-	0xba, 0x54, 0xfa, 0xcb, 0x51, 0xe9, 0xe5, 0x0e, 0x15, 0x7b, 0x83, 0x76, 0xd5, 0x76, 0x7b, 0x9a,
-	0x46, 0xfd, 0xef, 0x3a, 0x77, 0xf6, 0xb5, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xd1, 0x75, 0xd0, 0x1b,
-	0xa9, 0x13, 0xdb, 0x54, 0x48, 0xe8, 0x6d, 0x98, 0xee, 0xe1, 0x03, 0x4b, 0xa1, 0x26, 0xcf, 0x01,
-	0x75, 0xaa, 0x87, 0x0f, 0xe4, 0x5e, 0x91, 0x03, 0x39, 0x09, 0x6c, 0xef, 0x61, 0xd6, 0x21, 0x3e,
-	0x7e, 0xea, 0x1c, 0xf0, 0xe7, 0x7a, 0xf8, 0x60, 0x4d, 0x61, 0xca, 0xaf, 0xac, 0x4e, 0xbf, 0xff,
-	0xa0, 0x94, 0xf8, 0xc7, 0x83, 0x92, 0x51, 0xf9, 0xbd, 0x01, 0x10, 0xd1, 0x85, 0x30, 0xe4, 0xed,
-	0x70, 0xa4, 0x3e, 0xcf, 0xb5, 0x2b, 0x5f, 0x39, 0xc9, 0x1b, 0x63, 0x64, 0xd7, 0xe6, 0xe4, 0x46,
-	0x1f, 0x1e, 0x95, 0x0c, 0xdf, 0x2f, 0x39, 0x7b, 0xcc, 0x19, 0x6f, 0x42, 0x76, 0xd0, 0x77, 0xb0,
-	0x20, 0x96, 0x8c, 0x6c, 0xc5, 0x5e, 0x76, 0xa5, 0x58, 0xf5, 0xc3, 0xbe, 0x1a, 0x84, 0x7d, 0x75,
-	0x2b, 0x08, 0x7b, 0x1f, 0xf0, 0xbd, 0xbf, 0x05, 0x80, 0xe0, 0x6b, 0xcb, 0xf5, 0x98, 0x1d, 0x1f,
-	0x18, 0x90, 0xad, 0x13, 0x6e, 0x7b, 0xb4, 0x2f, 0x93, 0x09, 0x15, 0x60, 0xaa, 0xe7, 0x32, 0xba,
-	0xaf, 0x43, 0x71, 0xc6, 0x0c, 0x86, 0xa8, 0x08, 0xd3, 0xd4, 0x21, 0x4c, 0x50, 0x31, 0xf4, 0x5d,
-	0x67, 0x86, 0x63, 0xa9, 0xf5, 0x2e, 0x69, 0x73, 0x1a, 0xb0, 0x6e, 0x06, 0x43, 0xf4, 0x2a, 0xe4,
-	0x39, 0xb1, 0x07, 0x1e, 0x15, 0x43, 0xcb, 0x76, 0x99, 0xc0, 0xb6, 0x28, 0xa4, 0x95, 0x48, 0x2e,
-	0x98, 0x5f, 0xf3, 0xa7, 0x25, 0x88, 0x43, 0x04, 0xa6, 0x5d, 0x5e, 0x78, 0xce, 0x07, 0xd1, 0xc3,
-	0xd8, 0x76, 0x7f, 0x33, 0x05, 0x33, 0x61, 0x18, 0xa3, 0x35, 0xc8, 0xbb, 0x7d, 0xe2, 0xc9, 0xdf,
-	0x16, 0x76, 0x1c, 0x8f, 0x70, 0xae, 0x63, 0xb5, 0xf0, 0xc9, 0x47, 0xd7, 0x2f, 0x6a, 0xe2, 0x6f,
-	0xfa, 0x2b, 0x2d, 0xe1, 0x51, 0xd6, 0x31, 0x73, 0x81, 0x86, 0x9e, 0x46, 0xef, 0x48, 0xd7, 0x31,
-	0x4e, 0x18, 0x1f, 0x70, 0xab, 0x3f, 0x68, 0xef, 0x93, 0xa1, 0x26, 0xf7, 0xe2, 0x04, 0xb9, 0x37,
-	0xd9, 0xb0, 0x56, 0xf8, 0x63, 0x04, 0x6d, 0x7b, 0xc3, 0xbe, 0x70, 0xab, 0xcd, 0x41, 0xfb, 0xdb,
-	0x64, 0x28, 0x5d, 0xa6, 0x71, 0x9a, 0x0a, 0x06, 0x5d, 0x82, 0xcc, 0x0f, 0x30, 0xed, 0x12, 0x47,
-	0xb1, 0x32, 0x6d, 0xea, 0x11, 0x5a, 0x85, 0x0c, 0x17, 0x58, 0x0c, 0xb8, 0xa2, 0x62, 0x7e, 0xa5,
-	0x72, 0x52, 0x8c, 0xd4, 0x5c, 0xe6, 0xb4, 0x94, 0xa4, 0xa9, 0x35, 0xd0, 0x16, 0x64, 0x84, 0xbb,
-	0x4f, 0x98, 0x26, 0xe9, 0x4c, 0xf1, 0xdd, 0x60, 0x22, 0x16, 0xdf, 0x0d, 0x26, 0x4c, 0x8d, 0x85,
-	0x3a, 0x90, 0x77, 0x48, 0x97, 0x74, 0x14, 0x95, 0x7c, 0x0f, 0x7b, 0x84, 0x17, 0x32, 0xe7, 0x90,
-	0x3f, 0xb9, 0x10, 0xb5, 0xa5, 0x40, 0x51, 0x13, 0xb2, 0x4e, 0x14, 0x6e, 0x85, 0x29, 0x45, 0xf4,
-	0x8b, 0x27, 0xd9, 0x1f, 0x8b, 0xcc, 0x78, 0xcd, 0x8a, 0x43, 0xc8, 0x08, 0x1b, 0xb0, 0xb6, 0xcb,
-	0x1c, 0xca, 0x3a, 0xd6, 0x1e, 0xa1, 0x9d, 0x3d, 0x51, 0x98, 0x2e, 0x1b, 0x4b, 0x29, 0x33, 0x17,
-	0xce, 0xdf, 0x56, 0xd3, 0xa8, 0x09, 0xf3, 0x91, 0xa8, 0xca, 0xa2, 0x99, 0xb3, 0x66, 0xd1, 0x5c,
-	0x08, 0x20, 0x45, 0xd0, 0x5b, 0x00, 0x51, 0x9e, 0x16, 0x40, 0xa1, 0x55, 0x9e, 0x9e, 0xf1, 0x71,
-	0x63, 0x62, 0x00, 0xa8, 0x0b, 0x17, 0x7a, 0x94, 0x59, 0x9c, 0x74, 0x77, 0x2d, 0xcd, 0x9c, 0xc4,
-	0xcd, 0x9e, 0x83, 0xa7, 0x17, 0x7a, 0x94, 0xb5, 0x48, 0x77, 0xb7, 0x1e, 0xc2, 0xa2, 0xd7, 0xe1,
-	0x6a, 0x44, 0x87, 0xcb, 0xac, 0x3d, 0xb7, 0xeb, 0x58, 0x1e, 0xd9, 0xb5, 0x6c, 0x77, 0xc0, 0x44,
-	0x61, 0x56, 0x91, 0x78, 0x39, 0x14, 0xd9, 0x64, 0xb7, 0xdd, 0xae, 0x63, 0x92, 0xdd, 0x35, 0xb9,
-	0x8c, 0x5e, 0x84, 0x88, 0x0b, 0x8b, 0x3a, 0xbc, 0x30, 0x57, 0x4e, 0x2d, 0xa5, 0xcd, 0xd9, 0x70,
-	0xb2, 0xe1, 0xf0, 0xd5, 0xd9, 0x7b, 0x0f, 0x4a, 0x09, 0x9d, 0xbd, 0x89, 0x4a, 0x13, 0x66, 0x77,
-	0x70, 0x57, 0x27, 0x1e, 0xe1, 0xe8, 0xeb, 0x30, 0x83, 0x83, 0x41, 0xc1, 0x28, 0xa7, 0x9e, 0x98,
-	0xb8, 0x91, 0xa8, 0x5f, 0x0f, 0x7e, 0xf4, 0xd7, 0xb2, 0x51, 0xf9, 0x85, 0x01, 0x99, 0xfa, 0x4e,
-	0x13, 0x53, 0x0f, 0xad, 0xc3, 0x42, 0x14, 0xc2, 0xa7, 0xad, 0x06, 0x51, 0xd4, 0x07, 0xe5, 0x60,
-	0x1d, 0x16, 0xee, 0x06, 0x05, 0x26, 0x84, 0x49, 0x3e, 0x0d, 0x26, 0x54, 0xd1, 0xf3, 0x63, 0x86,
-	0xbf, 0x09, 0x53, 0xfe, 0x2e, 0x39, 0xfa, 0x16, 0x3c, 0xd7, 0x97, 0x3f, 0x94, 0xbd, 0xd9, 0x95,
-	0xc5, 0x13, 0x43, 0x5f, 0xc9, 0xc7, 0x03, 0xc5, 0xd7, 0xab, 0xfc, 0xc7, 0x00, 0xa8, 0xef, 0xec,
-	0x6c, 0x79, 0xb4, 0xdf, 0x25, 0xe2, 0xbc, 0xcc, 0xbe, 0x03, 0xcf, 0x47, 0x66, 0x73, 0xcf, 0x3e,
-	0xb5, 0xe9, 0x17, 0x42, 0xb5, 0x96, 0x67, 0x1f, 0x8b, 0xe6, 0x70, 0x11, 0xa2, 0xa5, 0x4e, 0x8d,
-	0x56, 0xe7, 0xe2, 0x78, 0x2e, 0xbf, 0x03, 0xd9, 0xc8, 0x7c, 0x8e, 0x1a, 0x30, 0x2d, 0xf4, 0x6f,
-	0x4d, 0x69, 0xe5, 0x64, 0x4a, 0x03, 0xb5, 0x38, 0xad, 0xa1, 0x7a, 0xe5, 0xbf, 0x92, 0xd9, 0x28,
-	0x3d, 0x3e, 0x57, 0x01, 0x25, 0xeb, 0xbe, 0xae, 0xcb, 0xe7, 0x71, 0xaf, 0xd1, 0x58, 0x63, 0xd4,
-	0xde, 0x4b, 0xc2, 0x85, 0xed, 0x20, 0x7d, 0x3f, 0xb7, 0x4c, 0x6c, 0xc3, 0x14, 0x61, 0xc2, 0xa3,
-	0x8a, 0x0a, 0xe9, 0xf0, 0xaf, 0x9e, 0xe4, 0xf0, 0x63, 0x6c, 0x59, 0x67, 0xc2, 0x1b, 0xc6, 0xdd,
-	0x1f, 0x60, 0x8d, 0x51, 0xf1, 0xbb, 0x14, 0x14, 0x4e, 0x52, 0x47, 0xaf, 0x40, 0xce, 0xf6, 0x88,
-	0x9a, 0x08, 0x4e, 0x1c, 0x43, 0x15, 0xcb, 0xf9, 0x60, 0x5a, 0x1f, 0x38, 0x26, 0xc8, 0x6b, 0x9c,
-	0x8c, 0x2e, 0x29, 0xfa, 0x6c, 0xf7, 0xb6, 0xf9, 0x08, 0x41, 0x1d, 0x39, 0x04, 0x72, 0x94, 0x51,
-	0x41, 0x71, 0xd7, 0x6a, 0xe3, 0x2e, 0x66, 0xf6, 0xb3, 0xdc, 0x74, 0x27, 0xcf, 0x87, 0x79, 0x0d,
-	0x5a, 0xf3, 0x31, 0xd1, 0x0e, 0x4c, 0x05, 0xf0, 0xe9, 0x73, 0x80, 0x0f, 0xc0, 0xd0, 0x0b, 0x30,
-	0x1b, 0x3f, 0x36, 0xd4, 0x2d, 0x26, 0x6d, 0x66, 0x63, 0xa7, 0xc6, 0xd3, 0xce, 0xa5, 0xcc, 0x13,
-	0xcf, 0xa5, 0xd8, 0x65, 0xf1, 0xb7, 0x29, 0x58, 0x30, 0x89, 0xf3, 0x05, 0x74, 0xde, 0xf7, 0x00,
-	0xfc, 0x04, 0x97, 0xc5, 0xf7, 0x19, 0xfc, 0x37, 0x59, 0x30, 0x66, 0x7c, 0xbc, 0x3a, 0x17, 0x9f,
-	0xa5, 0x07, 0xff, 0x94, 0x84, 0xd9, 0xb8, 0x07, 0xbf, 0x00, 0xa7, 0x1d, 0xda, 0x88, 0xca, 0x5b,
-	0x5a, 0x95, 0xb7, 0x57, 0x4f, 0x2a, 0x6f, 0x13, 0xb1, 0x7d, 0x8a, 0xba, 0xf6, 0xb3, 0x14, 0x64,
-	0x9a, 0xd8, 0xc3, 0x3d, 0x8e, 0x36, 0x27, 0x6e, 0xc3, 0x7e, 0xc7, 0x7a, 0x65, 0x22, 0xbc, 0xeb,
-	0xfa, 0xa9, 0xc5, 0x8f, 0xee, 0xf7, 0x4f, 0xba, 0x0c, 0x7f, 0x05, 0xe6, 0x65, 0x0f, 0x1e, 0x1a,
-	0xe5, 0xd3, 0x39, 0xa7, 0x9a, 0xe8, 0xb0, 0x69, 0xe3, 0xa8, 0x04, 0x59, 0x29, 0x16, 0xd5, 0x70,
-	0x29, 0x03, 0x3d, 0x7c, 0xb0, 0xee, 0xcf, 0xa0, 0xeb, 0x80, 0xf6, 0xc2, 0xf7, 0x11, 0x2b, 0x22,
-	0x43, 0xca, 0x2d, 0x44, 0x2b, 0x81, 0xf8, 0x97, 0x01, 0xe4, 0x2e, 0x2c, 0x87, 0x30, 0xb7, 0xa7,
-	0x5b, 0xc7, 0x19, 0x39, 0x53, 0x97, 0x13, 0xe8, 0x87, 0xfe, 0x9d, 0x7a, 0xac, 0x3d, 0xd7, 0xdd,
-	0xcd, 0x9d, 0xb3, 0x25, 0xc5, 0xbf, 0x8f, 0x4a, 0xc5, 0x21, 0xee, 0x75, 0x57, 0x2b, 0xc7, 0x40,
-	0x56, 0xd4, 0x1d, 0x7b, 0xb4, 0xad, 0x5f, 0x5d, 0x0a, 0x62, 0xf9, 0xf0, 0xd3, 0x0f, 0xaf, 0x5d,
-	0x8d, 0x61, 0x1e, 0x84, 0x0f, 0x67, 0xbe, 0x3b, 0x2a, 0xbf, 0x32, 0x00, 0x45, 0x07, 0x8d, 0x49,
-	0x78, 0x5f, 0x76, 0x93, 0xb2, 0xc3, 0x88, 0x75, 0x02, 0xc6, 0x93, 0x3b, 0x8c, 0x48, 0x7f, 0xa4,
-	0xc3, 0x88, 0x25, 0xd0, 0x37, 0xa3, 0xb2, 0x9e, 0xd4, 0xde, 0xd6, 0x58, 0x6d, 0xcc, 0x49, 0xac,
-	0x55, 0xa1, 0x23, 0x10, 0x81, 0x52, 0x98, 0x9b, 0x89, 0xca, 0x91, 0x01, 0x57, 0x26, 0x22, 0x30,
-	0xdc, 0xb6, 0x0d, 0xc8, 0x8b, 0x2d, 0x2a, 0x2f, 0x0e, 0xf5, 0xf6, 0x9f, 0x2d, 0xa0, 0x17, 0xbc,
-	0x89, 0x52, 0xfe, 0x7f, 0x3a, 0xa3, 0x56, 0xd3, 0xaa, 0xf8, 0xfc, 0xc1, 0x80, 0x8b, 0xf1, 0x1d,
-	0x85, 0xb6, 0xb5, 0x60, 0x36, 0xbe, 0x17, 0x6d, 0xd5, 0x4b, 0xa7, 0xb1, 0x2a, 0x6e, 0xd0, 0x08,
-	0x88, 0xb4, 0x25, 0x88, 0x74, 0xff, 0x19, 0xef, 0xc6, 0xa9, 0x59, 0x0a, 0x36, 0x76, 0x6c, 0xfa,
-	0xa7, 0x95, 0xb3, 0x7e, 0x92, 0x84, 0x74, 0xd3, 0x75, 0xbb, 0xe8, 0xc7, 0x06, 0x2c, 0x30, 0x57,
-	0x58, 0x32, 0x3f, 0x88, 0x63, 0xe9, 0xa7, 0x04, 0xbf, 0x82, 0xee, 0x9c, 0x8d, 0xbd, 0x7f, 0x1e,
-	0x95, 0x26, 0xa1, 0x46, 0x29, 0xd5, 0x4f, 0x59, 0xcc, 0x15, 0x35, 0x25, 0xb4, 0xe5, 0xbf, 0x36,
-	0xbc, 0x0b, 0x73, 0xa3, 0xdf, 0xf7, 0xcb, 0xae, 0x79, 0xe6, 0xef, 0xcf, 0x3d, 0xf5, 0xdb, 0xb3,
-	0xed, 0xd8, 0x87, 0x57, 0xa7, 0xa5, 0x63, 0xff, 0x25, 0x9d, 0xfb, 0x0e, 0xe4, 0xc3, 0x92, 0xb4,
-	0xad, 0x1e, 0xc6, 0xe4, 0xfd, 0x74, 0xca, 0x7f, 0x23, 0x0b, 0x3a, 0x89, 0x72, 0xfc, 0x19, 0x16,
-	0xb7, 0x6d, 0x5a, 0x1d, 0xd3, 0x19, 0x61, 0x5c, 0xeb, 0x5e, 0xfb, 0xb5, 0x01, 0x10, 0x3d, 0xdc,
-	0xa0, 0xd7, 0xe0, 0x72, 0x6d, 0x73, 0xa3, 0x6e, 0xb5, 0xb6, 0x6e, 0x6e, 0x6d, 0xb7, 0xac, 0xed,
-	0x8d, 0x56, 0x73, 0x7d, 0xad, 0x71, 0xab, 0xb1, 0x5e, 0xcf, 0x27, 0x8a, 0xb9, 0xc3, 0xfb, 0xe5,
-	0xec, 0x36, 0xe3, 0x7d, 0x62, 0xd3, 0x5d, 0x4a, 0x1c, 0xf4, 0x32, 0x5c, 0x1c, 0x95, 0x96, 0xa3,
-	0xf5, 0x7a, 0xde, 0x28, 0xce, 0x1e, 0xde, 0x2f, 0x4f, 0xfb, 0x57, 0x52, 0xe2, 0xa0, 0x25, 0x78,
-	0x7e, 0x52, 0xae, 0xb1, 0xf1, 0x46, 0x3e, 0x59, 0x9c, 0x3b, 0xbc, 0x5f, 0x9e, 0x09, 0xef, 0xae,
-	0xa8, 0x02, 0x28, 0x2e, 0xa9, 0xf1, 0x52, 0x45, 0x38, 0xbc, 0x5f, 0xce, 0xf8, 0x6e, 0x29, 0xa6,
-	0xef, 0xfd, 0x7c, 0x31, 0x71, 0xed, 0xfb, 0x00, 0x0d, 0xb6, 0xeb, 0x61, 0x5b, 0x05, 0x64, 0x11,
-	0x2e, 0x35, 0x36, 0x6e, 0x99, 0x37, 0xd7, 0xb6, 0x1a, 0x9b, 0x1b, 0xa3, 0xdb, 0x1e, 0x5b, 0xab,
-	0x6f, 0x6e, 0xd7, 0xee, 0xac, 0x5b, 0xad, 0xc6, 0x1b, 0x1b, 0x79, 0x03, 0x5d, 0x86, 0x0b, 0x23,
-	0x6b, 0x6f, 0x6f, 0x6c, 0x35, 0xde, 0x5a, 0xcf, 0x27, 0x6b, 0xb7, 0x3e, 0x7e, 0xb4, 0x68, 0x3c,
-	0x7c, 0xb4, 0x68, 0xfc, 0xfd, 0xd1, 0xa2, 0xf1, 0xde, 0xe3, 0xc5, 0xc4, 0xc3, 0xc7, 0x8b, 0x89,
-	0x3f, 0x3f, 0x5e, 0x4c, 0x7c, 0xf7, 0xb5, 0x27, 0x3a, 0x3c, 0xaa, 0x94, 0xca, 0xf5, 0xed, 0x8c,
-	0x3a, 0x97, 0xbe, 0xf6, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x15, 0x6b, 0xa2, 0x81, 0x18,
-	0x00, 0x00,
+	// 2293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x6c, 0x5b, 0x49,
+	0x19, 0xcf, 0xb3, 0x5d, 0x27, 0xf9, 0x9c, 0xc4, 0xce, 0x34, 0x6d, 0x5d, 0x77, 0x89, 0x5d, 0xef,
+	0xb2, 0x9b, 0xad, 0x5a, 0x87, 0x06, 0x09, 0xa4, 0x50, 0xed, 0x2a, 0x8e, 0xdd, 0xd6, 0xbb, 0xdd,
+	0xc4, 0x3c, 0x3b, 0x29, 0x05, 0xa1, 0xa7, 0xf1, 0x7b, 0x13, 0xe7, 0x11, 0xfb, 0x3d, 0xeb, 0xcd,
+	0xb8, 0xad, 0x25, 0x0e, 0x08, 0x09, 0xa9, 0xca, 0x01, 0xad, 0x84, 0x90, 0xf6, 0x40, 0x44, 0x05,
+	0x1c, 0x56, 0x68, 0x91, 0xf6, 0xb0, 0xe2, 0x0a, 0x1c, 0x90, 0x16, 0x2e, 0x54, 0x7b, 0x42, 0x08,
+	0x05, 0xd4, 0x1e, 0x16, 0x71, 0x42, 0xdc, 0x41, 0x68, 0xe6, 0xcd, 0xfb, 0x63, 0xc7, 0x6e, 0x93,
+	0xca, 0x8b, 0x2a, 0xed, 0x25, 0xf1, 0xcc, 0x7c, 0xdf, 0xef, 0xcd, 0xf7, 0xfb, 0xbe, 0xf9, 0xe6,
+	0x9b, 0x19, 0x78, 0x45, 0xb7, 0x69, 0xdb, 0xa6, 0xcb, 0x94, 0xe1, 0x3d, 0xd3, 0x6a, 0x2e, 0xdf,
+	0xbd, 0xda, 0x20, 0x0c, 0x5f, 0xf5, 0xda, 0x85, 0x8e, 0x63, 0x33, 0x1b, 0x9d, 0x75, 0xa5, 0x0a,
+	0x5e, 0xaf, 0x94, 0xca, 0x2c, 0x34, 0xed, 0xa6, 0x2d, 0x44, 0x96, 0xf9, 0x2f, 0x57, 0x3a, 0x73,
+	0xbe, 0x69, 0xdb, 0xcd, 0x16, 0x59, 0x16, 0xad, 0x46, 0x77, 0x67, 0x19, 0x5b, 0x3d, 0x39, 0xb4,
+	0x38, 0x38, 0x64, 0x74, 0x1d, 0xcc, 0x4c, 0xdb, 0x92, 0xe3, 0xd9, 0xc1, 0x71, 0x66, 0xb6, 0x09,
+	0x65, 0xb8, 0xdd, 0xf1, 0xb0, 0xdd, 0x99, 0x68, 0xee, 0x47, 0xe5, 0xb4, 0x24, 0xb6, 0x34, 0xa5,
+	0x81, 0x29, 0xf1, 0xed, 0xd0, 0x6d, 0xd3, 0xc3, 0x9e, 0xc7, 0x6d, 0xd3, 0xb2, 0x97, 0xc5, 0x5f,
+	0xd9, 0xf5, 0x12, 0x23, 0x96, 0x41, 0x9c, 0xb6, 0x69, 0xb1, 0x65, 0xd6, 0xeb, 0x10, 0xea, 0xfe,
+	0x95, 0xa3, 0x17, 0x42, 0xa3, 0xb8, 0xa1, 0x9b, 0xe1, 0xc1, 0xfc, 0x8f, 0x14, 0x98, 0xbb, 0x69,
+	0x52, 0x66, 0x3b, 0xa6, 0x8e, 0x5b, 0x15, 0x6b, 0xc7, 0x46, 0x5f, 0x83, 0xf8, 0x2e, 0xc1, 0x06,
+	0x71, 0xd2, 0x4a, 0x4e, 0x59, 0x4a, 0xac, 0xa4, 0x0b, 0x01, 0x40, 0xc1, 0xd5, 0xbd, 0x29, 0xc6,
+	0x8b, 0xd3, 0x1f, 0x1f, 0x66, 0x27, 0xde, 0xff, 0xf4, 0xc3, 0x4b, 0x8a, 0x2a, 0x55, 0x50, 0x09,
+	0xe2, 0x77, 0x71, 0x8b, 0x12, 0x96, 0x8e, 0xe4, 0xa2, 0x4b, 0x89, 0x95, 0x8b, 0x85, 0xe1, 0x9c,
+	0x17, 0xb6, 0x71, 0xcb, 0x34, 0x30, 0xb3, 0xfb, 0x51, 0x5c, 0xdd, 0xfc, 0x07, 0x11, 0x48, 0xae,
+	0xdb, 0xed, 0xb6, 0x49, 0xa9, 0x69, 0x5b, 0x2a, 0x66, 0x84, 0xa2, 0x2a, 0xc4, 0x1c, 0xcc, 0x88,
+	0x98, 0xd4, 0x74, 0xf1, 0x1a, 0x57, 0xfa, 0xcb, 0x61, 0xf6, 0xd5, 0xa6, 0xc9, 0x76, 0xbb, 0x8d,
+	0x82, 0x6e, 0xb7, 0x25, 0x8d, 0xf2, 0xdf, 0x15, 0x6a, 0xec, 0x49, 0x4b, 0x4b, 0x44, 0xff, 0xe4,
+	0xa3, 0x2b, 0x20, 0x27, 0x52, 0x22, 0xba, 0x2a, 0x90, 0xd0, 0x6d, 0x98, 0x6a, 0xe3, 0xfb, 0x9a,
+	0x40, 0x8d, 0x8c, 0x01, 0x75, 0xb2, 0x8d, 0xef, 0xf3, 0xb9, 0x22, 0x03, 0x92, 0x1c, 0x58, 0xdf,
+	0xc5, 0x56, 0x93, 0xb8, 0xf8, 0xd1, 0x31, 0xe0, 0xcf, 0xb6, 0xf1, 0xfd, 0x75, 0x81, 0xc9, 0xbf,
+	0xb2, 0x3a, 0xf5, 0xde, 0xc3, 0xec, 0xc4, 0x3f, 0x1e, 0x66, 0x95, 0xfc, 0xef, 0x15, 0x80, 0x80,
+	0x2e, 0x84, 0x21, 0xa5, 0xfb, 0x2d, 0xf1, 0x79, 0x2a, 0x5d, 0xf9, 0xda, 0x28, 0x6f, 0x0c, 0x90,
+	0x5d, 0x9c, 0xe5, 0x13, 0x7d, 0x74, 0x98, 0x55, 0x5c, 0xbf, 0x24, 0xf5, 0x01, 0x67, 0xbc, 0x05,
+	0x89, 0x6e, 0xc7, 0xc0, 0x8c, 0x68, 0x3c, 0xb2, 0x05, 0x7b, 0x89, 0x95, 0x4c, 0xc1, 0x0d, 0xfb,
+	0x82, 0x17, 0xf6, 0x85, 0xba, 0x17, 0xf6, 0x2e, 0xe0, 0xbb, 0x7f, 0xf3, 0x00, 0xc1, 0xd5, 0xe6,
+	0xe3, 0x21, 0x3b, 0x3e, 0x50, 0x20, 0x51, 0x22, 0x54, 0x77, 0xcc, 0x0e, 0x5f, 0x4c, 0x28, 0x0d,
+	0x93, 0x6d, 0xdb, 0x32, 0xf7, 0x64, 0x28, 0x4e, 0xab, 0x5e, 0x13, 0x65, 0x60, 0xca, 0x34, 0x88,
+	0xc5, 0x4c, 0xd6, 0x73, 0x5d, 0xa7, 0xfa, 0x6d, 0xae, 0x75, 0x8f, 0x34, 0xa8, 0xe9, 0xb1, 0xae,
+	0x7a, 0x4d, 0xf4, 0x3a, 0xa4, 0x28, 0xd1, 0xbb, 0x8e, 0xc9, 0x7a, 0x9a, 0x6e, 0x5b, 0x0c, 0xeb,
+	0x2c, 0x1d, 0x13, 0x22, 0x49, 0xaf, 0x7f, 0xdd, 0xed, 0xe6, 0x20, 0x06, 0x61, 0xd8, 0x6c, 0xd1,
+	0xf4, 0x29, 0x17, 0x44, 0x36, 0x43, 0xd3, 0xfd, 0xd9, 0x14, 0x4c, 0xfb, 0x61, 0x8c, 0xd6, 0x21,
+	0x65, 0x77, 0x88, 0xc3, 0x7f, 0x6b, 0xd8, 0x30, 0x1c, 0x42, 0xa9, 0x8c, 0xd5, 0xf4, 0x27, 0x1f,
+	0x5d, 0x59, 0x90, 0xc4, 0xaf, 0xb9, 0x23, 0x35, 0xe6, 0x98, 0x56, 0x53, 0x4d, 0x7a, 0x1a, 0xb2,
+	0x1b, 0xdd, 0xe1, 0xae, 0xb3, 0x28, 0xb1, 0x68, 0x97, 0x6a, 0x9d, 0x6e, 0x63, 0x8f, 0xf4, 0x24,
+	0xb9, 0x0b, 0x47, 0xc8, 0x5d, 0xb3, 0x7a, 0xc5, 0xf4, 0x1f, 0x03, 0x68, 0xdd, 0xe9, 0x75, 0x98,
+	0x5d, 0xa8, 0x76, 0x1b, 0x6f, 0x93, 0x9e, 0x9a, 0xf4, 0x71, 0xaa, 0x02, 0x06, 0x9d, 0x85, 0xf8,
+	0x77, 0xb0, 0xd9, 0x22, 0x86, 0x60, 0x65, 0x4a, 0x95, 0x2d, 0xb4, 0x0a, 0x71, 0xca, 0x30, 0xeb,
+	0x52, 0x41, 0xc5, 0xdc, 0x4a, 0x7e, 0x54, 0x8c, 0x14, 0x6d, 0xcb, 0xa8, 0x09, 0x49, 0x55, 0x6a,
+	0xa0, 0x3a, 0xc4, 0x99, 0xbd, 0x47, 0x2c, 0x49, 0xd2, 0x89, 0xe2, 0xbb, 0x62, 0xb1, 0x50, 0x7c,
+	0x57, 0x2c, 0xa6, 0x4a, 0x2c, 0xd4, 0x84, 0x94, 0x41, 0x5a, 0xa4, 0x29, 0xa8, 0xa4, 0xbb, 0xd8,
+	0x21, 0x34, 0x1d, 0x1f, 0xc3, 0xfa, 0x49, 0xfa, 0xa8, 0x35, 0x01, 0x8a, 0xaa, 0x90, 0x30, 0x82,
+	0x70, 0x4b, 0x4f, 0x0a, 0xa2, 0x5f, 0x1e, 0x65, 0x7f, 0x28, 0x32, 0xc3, 0x39, 0x2b, 0x0c, 0xc1,
+	0x23, 0xac, 0x6b, 0x35, 0x6c, 0xcb, 0x30, 0xad, 0xa6, 0xb6, 0x4b, 0xcc, 0xe6, 0x2e, 0x4b, 0x4f,
+	0xe5, 0x94, 0xa5, 0xa8, 0x9a, 0xf4, 0xfb, 0x6f, 0x8a, 0x6e, 0x54, 0x85, 0xb9, 0x40, 0x54, 0xac,
+	0xa2, 0xe9, 0x93, 0xae, 0xa2, 0x59, 0x1f, 0x80, 0x8b, 0xa0, 0x77, 0x00, 0x82, 0x75, 0x9a, 0x06,
+	0x81, 0x96, 0x7f, 0xf6, 0x8a, 0x0f, 0x1b, 0x13, 0x02, 0x40, 0x2d, 0x38, 0xdd, 0x36, 0x2d, 0x8d,
+	0x92, 0xd6, 0x8e, 0x26, 0x99, 0xe3, 0xb8, 0x89, 0x31, 0x78, 0x7a, 0xbe, 0x6d, 0x5a, 0x35, 0xd2,
+	0xda, 0x29, 0xf9, 0xb0, 0xe8, 0x1a, 0x5c, 0x08, 0xe8, 0xb0, 0x2d, 0x6d, 0xd7, 0x6e, 0x19, 0x9a,
+	0x43, 0x76, 0x34, 0xdd, 0xee, 0x5a, 0x2c, 0x3d, 0x23, 0x48, 0x3c, 0xe7, 0x8b, 0x6c, 0x5a, 0x37,
+	0xed, 0x96, 0xa1, 0x92, 0x9d, 0x75, 0x3e, 0x8c, 0x5e, 0x86, 0x80, 0x0b, 0xcd, 0x34, 0x68, 0x7a,
+	0x36, 0x17, 0x5d, 0x8a, 0xa9, 0x33, 0x7e, 0x67, 0xc5, 0xa0, 0x88, 0x40, 0xf2, 0x9e, 0xe0, 0x9e,
+	0x18, 0x9a, 0x0c, 0xdb, 0xb9, 0x31, 0x18, 0x33, 0xe7, 0x81, 0xd6, 0x05, 0xe6, 0xea, 0xcc, 0x83,
+	0x87, 0xd9, 0x09, 0x99, 0x24, 0x26, 0xf2, 0x55, 0x98, 0xd9, 0xc6, 0x2d, 0xb9, 0xbe, 0x09, 0x45,
+	0x5f, 0x81, 0x69, 0xec, 0x35, 0xd2, 0x4a, 0x2e, 0xfa, 0xd4, 0xfc, 0x10, 0x88, 0xba, 0x69, 0xe7,
+	0x7b, 0x7f, 0xcd, 0x29, 0xf9, 0x5f, 0x28, 0x10, 0x2f, 0x6d, 0x57, 0xb1, 0xe9, 0xa0, 0x32, 0xcc,
+	0x07, 0x2b, 0xe5, 0xb8, 0x49, 0x27, 0x58, 0x5c, 0xb2, 0x9f, 0xc3, 0xdc, 0xf5, 0xf2, 0x98, 0x0f,
+	0x13, 0x79, 0x16, 0x8c, 0xaf, 0x22, 0xfb, 0x07, 0x0c, 0x7f, 0x0b, 0x26, 0xdd, 0x59, 0x52, 0xf4,
+	0x26, 0x9c, 0xea, 0xf0, 0x1f, 0xc2, 0xde, 0xc4, 0xca, 0xe2, 0xc8, 0x15, 0x26, 0xe4, 0xc3, 0xf1,
+	0xe8, 0xea, 0xe5, 0xff, 0xa3, 0x00, 0x94, 0xb6, 0xb7, 0xeb, 0x8e, 0xd9, 0x69, 0x11, 0x36, 0x2e,
+	0xb3, 0x6f, 0xc1, 0x99, 0xc0, 0x6c, 0xea, 0xe8, 0xc7, 0x36, 0xfd, 0xb4, 0xaf, 0x56, 0x73, 0xf4,
+	0xa1, 0x68, 0x06, 0x65, 0x3e, 0x5a, 0xf4, 0xd8, 0x68, 0x25, 0xca, 0x86, 0x73, 0xf9, 0x0d, 0x48,
+	0x04, 0xe6, 0x53, 0x54, 0x81, 0x29, 0x26, 0x7f, 0x4b, 0x4a, 0xf3, 0xa3, 0x29, 0xf5, 0xd4, 0xc2,
+	0xb4, 0xfa, 0xea, 0xf9, 0xff, 0x72, 0x66, 0x83, 0x55, 0xf8, 0x42, 0x05, 0x14, 0xdf, 0x5e, 0x64,
+	0xfa, 0x1f, 0x47, 0xf9, 0x24, 0xb1, 0x06, 0xa8, 0x7d, 0x10, 0x81, 0xd3, 0x5b, 0x5e, 0x96, 0x78,
+	0x61, 0x99, 0xd8, 0x82, 0x49, 0x62, 0x31, 0xc7, 0x14, 0x54, 0x70, 0x87, 0x7f, 0x69, 0x94, 0xc3,
+	0x87, 0xd8, 0x52, 0xb6, 0x98, 0xd3, 0x0b, 0xbb, 0xdf, 0xc3, 0x1a, 0xa0, 0xe2, 0x77, 0x51, 0x48,
+	0x8f, 0x52, 0x47, 0xaf, 0x41, 0x52, 0x77, 0x88, 0xe8, 0xf0, 0x36, 0x36, 0x45, 0xe4, 0xe4, 0x39,
+	0xaf, 0x5b, 0xee, 0x6b, 0x2a, 0xf0, 0x6a, 0x91, 0x47, 0x17, 0x17, 0x7d, 0xbe, 0xf2, 0x70, 0x2e,
+	0x40, 0x10, 0x3b, 0x1b, 0x81, 0xa4, 0x69, 0x99, 0xcc, 0xc4, 0x2d, 0xad, 0x81, 0x5b, 0xd8, 0xd2,
+	0x9f, 0xa7, 0xa0, 0x1e, 0x92, 0xb9, 0x25, 0x68, 0xd1, 0xc5, 0x44, 0xdb, 0x30, 0xe9, 0xc1, 0xc7,
+	0xc6, 0x00, 0xef, 0x81, 0xa1, 0x8b, 0x30, 0x13, 0xde, 0x9d, 0x44, 0xb1, 0x14, 0x53, 0x13, 0xa1,
+	0xcd, 0xe9, 0x59, 0xdb, 0x5f, 0xfc, 0xa9, 0xdb, 0x5f, 0xa8, 0x26, 0xfd, 0x4d, 0x14, 0xe6, 0x55,
+	0x62, 0x7c, 0x0e, 0x9d, 0xf7, 0x2d, 0x00, 0x77, 0x81, 0xf3, 0xe4, 0x9b, 0x8e, 0x8d, 0x21, 0x61,
+	0x4c, 0xbb, 0x78, 0x25, 0xca, 0xfe, 0x9f, 0x1e, 0xfc, 0x53, 0x04, 0x66, 0xc2, 0x1e, 0xfc, 0x1c,
+	0xec, 0x76, 0x68, 0x23, 0x48, 0x6f, 0x31, 0x91, 0xde, 0x5e, 0x1f, 0x95, 0xde, 0x8e, 0xc4, 0xf6,
+	0x31, 0xf2, 0xda, 0x4f, 0x4e, 0x41, 0xbc, 0x8a, 0x1d, 0xdc, 0xa6, 0x68, 0xf3, 0x48, 0xd1, 0xed,
+	0x1e, 0x8c, 0xcf, 0x1f, 0x09, 0xef, 0x92, 0xbc, 0xd1, 0x71, 0xa3, 0xfb, 0xbd, 0x51, 0x35, 0xf7,
+	0x17, 0x61, 0x8e, 0x1f, 0xf5, 0x7d, 0xa3, 0x5c, 0x3a, 0x67, 0xc5, 0x59, 0xdd, 0x3f, 0x1b, 0x52,
+	0x94, 0x85, 0x04, 0x17, 0x0b, 0x72, 0x38, 0x97, 0x81, 0x36, 0xbe, 0x5f, 0x76, 0x7b, 0xd0, 0x15,
+	0x40, 0xbb, 0xfe, 0x35, 0x8c, 0x16, 0x90, 0xc1, 0xe5, 0xe6, 0x83, 0x11, 0x4f, 0xfc, 0x0b, 0x00,
+	0x7c, 0x16, 0x9a, 0x41, 0x2c, 0xbb, 0x2d, 0x4f, 0xa8, 0xd3, 0xbc, 0xa7, 0xc4, 0x3b, 0xd0, 0x77,
+	0xdd, 0xd2, 0x7d, 0xe0, 0x16, 0x40, 0x1e, 0xa2, 0x6e, 0x9d, 0x6c, 0x51, 0xfc, 0xfb, 0x30, 0x9b,
+	0xe9, 0xe1, 0x76, 0x6b, 0x35, 0x3f, 0x04, 0x32, 0x2f, 0x4a, 0xf9, 0xfe, 0xdb, 0x03, 0xb4, 0x03,
+	0x0b, 0x7e, 0x9d, 0x1d, 0xcc, 0x92, 0xa6, 0x27, 0x9f, 0xee, 0xda, 0xdb, 0x52, 0xa7, 0xe8, 0x99,
+	0x11, 0x76, 0x2d, 0xba, 0x37, 0x38, 0x4a, 0xf9, 0xa2, 0x24, 0x1d, 0x5b, 0xdf, 0xd5, 0x5a, 0xc4,
+	0x6a, 0xb2, 0x5d, 0x71, 0xd0, 0x8a, 0xa9, 0x09, 0xd1, 0x77, 0x4b, 0x74, 0xa1, 0xaf, 0x42, 0xba,
+	0xdf, 0x3d, 0x9a, 0x83, 0xdb, 0x1d, 0x8d, 0x32, 0xd2, 0x11, 0xc7, 0xad, 0x59, 0xf5, 0x4c, 0x9f,
+	0xa3, 0x54, 0xdc, 0xee, 0xd4, 0x18, 0xe9, 0xa0, 0x37, 0xe1, 0xa5, 0x61, 0x8a, 0xa6, 0xc5, 0x88,
+	0x73, 0x17, 0xb7, 0xc4, 0xe9, 0x2a, 0xa6, 0x9e, 0x3f, 0xa2, 0x5c, 0x91, 0x02, 0xab, 0x4b, 0xde,
+	0x82, 0xde, 0xff, 0xf4, 0xc3, 0x4b, 0x17, 0x42, 0xc4, 0xde, 0xf7, 0x2f, 0x29, 0xdd, 0x98, 0xcc,
+	0xff, 0x40, 0x81, 0xf9, 0x23, 0xb6, 0xa3, 0x05, 0x38, 0xe5, 0x3a, 0xd7, 0xbd, 0xf9, 0x70, 0x1b,
+	0xbc, 0x22, 0x72, 0x89, 0x18, 0xcb, 0x85, 0x95, 0xc4, 0x5a, 0x8d, 0x89, 0xc4, 0xf3, 0x7e, 0x04,
+	0x4e, 0xfb, 0xc6, 0x88, 0x49, 0xd4, 0x18, 0xde, 0x23, 0xc3, 0x4b, 0x18, 0xe5, 0xc4, 0x25, 0x8c,
+	0x6f, 0x50, 0x64, 0xc0, 0x20, 0x79, 0x14, 0x8b, 0x8e, 0xf1, 0x06, 0x21, 0x28, 0x1c, 0x63, 0x63,
+	0x2c, 0x1c, 0xa7, 0x1e, 0x78, 0x19, 0xe5, 0xc7, 0x11, 0x48, 0x0a, 0x86, 0x5e, 0xd8, 0x82, 0xd1,
+	0x67, 0x3b, 0x3a, 0xc0, 0xf6, 0x67, 0xca, 0xcb, 0x4f, 0x23, 0x90, 0x16, 0xbc, 0xbc, 0xf8, 0x15,
+	0xf5, 0x70, 0x82, 0xb6, 0x06, 0x37, 0xa2, 0xf1, 0xd4, 0xd9, 0x01, 0x43, 0xbf, 0x8d, 0xc2, 0xd9,
+	0xaf, 0x77, 0x49, 0x97, 0x18, 0x35, 0x17, 0x70, 0x53, 0xdc, 0x00, 0x72, 0x7e, 0xe6, 0x20, 0x62,
+	0x1a, 0x82, 0x90, 0x98, 0x1a, 0x31, 0x0d, 0x54, 0x83, 0x39, 0xdb, 0x1b, 0xd4, 0xb8, 0x0f, 0x84,
+	0x95, 0x73, 0x2b, 0x97, 0x47, 0x4d, 0x69, 0x10, 0xb1, 0xde, 0xeb, 0x10, 0x75, 0xd6, 0x0e, 0x37,
+	0x87, 0x3b, 0x21, 0x3a, 0x1e, 0x27, 0xc4, 0x4e, 0xec, 0x84, 0x91, 0x55, 0xc4, 0xa9, 0xe7, 0xa9,
+	0x22, 0xae, 0x41, 0x1c, 0xb7, 0xfd, 0x62, 0x8b, 0x6f, 0xea, 0x52, 0x97, 0x3f, 0xa5, 0x84, 0x2e,
+	0xbe, 0xcc, 0xbe, 0x2b, 0x2f, 0xa9, 0x33, 0xac, 0x46, 0x9e, 0x1c, 0x56, 0x23, 0xe7, 0x7f, 0xa5,
+	0x00, 0x0a, 0x9c, 0xae, 0x12, 0xda, 0xb1, 0x2d, 0x2a, 0x6e, 0xdf, 0x42, 0xb7, 0x64, 0xca, 0xd3,
+	0x6f, 0xdf, 0x02, 0xfd, 0xbe, 0xdb, 0xb7, 0x00, 0x00, 0xbd, 0x11, 0x9c, 0x45, 0x22, 0x27, 0xb0,
+	0xc6, 0x53, 0xf2, 0x0b, 0xca, 0x89, 0xfc, 0xa1, 0x02, 0xe7, 0x8f, 0x94, 0x4d, 0xfe, 0xb4, 0x75,
+	0x40, 0x4e, 0x68, 0x50, 0x94, 0x1e, 0x3d, 0x39, 0xfd, 0xe7, 0xab, 0xc2, 0xe6, 0x9d, 0xc1, 0xd1,
+	0xcf, 0xea, 0x60, 0x25, 0x37, 0xae, 0x3f, 0x28, 0xb0, 0x10, 0x9e, 0x91, 0x6f, 0x5b, 0x0d, 0x66,
+	0xc2, 0x73, 0x91, 0x56, 0xbd, 0x72, 0x1c, 0xab, 0xc2, 0x06, 0xf5, 0x81, 0x70, 0x5b, 0xbc, 0x14,
+	0xe1, 0x3e, 0x71, 0x5d, 0x3d, 0x36, 0x4b, 0xde, 0xc4, 0x86, 0xe6, 0x88, 0x98, 0x70, 0xd6, 0x0f,
+	0x23, 0x10, 0xab, 0xda, 0x76, 0x0b, 0x7d, 0x5f, 0x81, 0x79, 0xcb, 0x66, 0xa2, 0x80, 0x0a, 0xee,
+	0x2b, 0xdd, 0x74, 0xb9, 0x7d, 0x32, 0xf6, 0xfe, 0x79, 0x98, 0x3d, 0x0a, 0xd5, 0x4f, 0xa9, 0x7c,
+	0xe6, 0xb1, 0x6c, 0x56, 0x14, 0x42, 0xee, 0x55, 0x26, 0xba, 0x07, 0xb3, 0xfd, 0xdf, 0x77, 0xf3,
+	0xac, 0x7a, 0xe2, 0xef, 0xcf, 0x3e, 0xf3, 0xdb, 0x33, 0x8d, 0xd0, 0x87, 0x57, 0xa7, 0xb8, 0x63,
+	0xff, 0xc5, 0x9d, 0x7b, 0x07, 0x52, 0x7e, 0x51, 0xb2, 0x25, 0x1e, 0x8d, 0x78, 0xf6, 0x99, 0x74,
+	0xdf, 0x8f, 0xbc, 0xeb, 0xaf, 0x5c, 0xf8, 0x89, 0x92, 0xbf, 0x71, 0x16, 0x06, 0x74, 0xfa, 0x18,
+	0x97, 0xba, 0x97, 0x7e, 0xad, 0x00, 0x04, 0x8f, 0x1a, 0xe8, 0x32, 0x9c, 0x2b, 0x6e, 0x6e, 0x94,
+	0xb4, 0x5a, 0x7d, 0xad, 0xbe, 0x55, 0xd3, 0xb6, 0x36, 0x6a, 0xd5, 0xf2, 0x7a, 0xe5, 0x7a, 0xa5,
+	0x5c, 0x4a, 0x4d, 0x64, 0x92, 0xfb, 0x07, 0xb9, 0xc4, 0x96, 0x45, 0x3b, 0x44, 0x37, 0x77, 0x4c,
+	0x62, 0xa0, 0x57, 0x61, 0xa1, 0x5f, 0x9a, 0xb7, 0xca, 0xa5, 0x94, 0x92, 0x99, 0xd9, 0x3f, 0xc8,
+	0x4d, 0xb9, 0xdb, 0x03, 0x31, 0xd0, 0x12, 0x9c, 0x39, 0x2a, 0x57, 0xd9, 0xb8, 0x91, 0x8a, 0x64,
+	0x66, 0xf7, 0x0f, 0x72, 0xd3, 0xfe, 0x3e, 0x82, 0xf2, 0x80, 0xc2, 0x92, 0x12, 0x2f, 0x9a, 0x81,
+	0xfd, 0x83, 0x5c, 0xdc, 0x75, 0x4b, 0x26, 0xf6, 0xe0, 0xe7, 0x8b, 0x13, 0x97, 0x7e, 0x19, 0x81,
+	0x85, 0x61, 0xc9, 0x1e, 0x95, 0x20, 0x5f, 0xab, 0xaf, 0xbd, 0x5d, 0xd9, 0xb8, 0xa1, 0x6d, 0x56,
+	0xcb, 0xea, 0x5a, 0xbd, 0xb2, 0xb9, 0xa1, 0xd5, 0xef, 0x54, 0xcb, 0x03, 0xd6, 0xbc, 0xb4, 0x7f,
+	0x90, 0x4b, 0xf7, 0xa9, 0x86, 0x4d, 0x7b, 0x03, 0xb2, 0x23, 0x50, 0x4a, 0xe5, 0x5b, 0xe5, 0x1b,
+	0x6b, 0xf5, 0x72, 0x4a, 0xc9, 0x9c, 0xdf, 0x3f, 0xc8, 0x9d, 0xe9, 0x83, 0x90, 0x39, 0x8d, 0xa0,
+	0x22, 0x5c, 0x1c, 0x39, 0x0b, 0x1f, 0x21, 0x92, 0xb9, 0xb0, 0x7f, 0x90, 0x3b, 0x37, 0x30, 0x09,
+	0xe3, 0xd9, 0x18, 0x6a, 0xd9, 0xc7, 0x88, 0x0e, 0xc1, 0xf0, 0x17, 0x1d, 0x91, 0x64, 0x7d, 0x1b,
+	0xa0, 0x62, 0xed, 0x38, 0x58, 0x17, 0xab, 0x37, 0x03, 0x67, 0x2b, 0x1b, 0xd7, 0xd5, 0xb5, 0x75,
+	0x01, 0xd8, 0xc7, 0xca, 0xc0, 0x58, 0x69, 0x73, 0xab, 0x78, 0xab, 0xac, 0xd5, 0x2a, 0x37, 0x36,
+	0x52, 0x0a, 0x3a, 0x07, 0xa7, 0xfb, 0xc6, 0x6e, 0x6f, 0xd4, 0x2b, 0xef, 0x94, 0x53, 0x91, 0xe2,
+	0xf5, 0x8f, 0x1f, 0x2f, 0x2a, 0x8f, 0x1e, 0x2f, 0x2a, 0x7f, 0x7f, 0xbc, 0xa8, 0xbc, 0xfb, 0x64,
+	0x71, 0xe2, 0xd1, 0x93, 0xc5, 0x89, 0x3f, 0x3f, 0x59, 0x9c, 0xf8, 0xe6, 0xe5, 0xa7, 0xae, 0x8e,
+	0xe0, 0x18, 0x20, 0xd6, 0x49, 0x23, 0x2e, 0x4e, 0x9e, 0x5f, 0xfe, 0xdf, 0x00, 0x53, 0xd0, 0x58,
+	0x98, 0xca, 0x20, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1392,690 +1789,722 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10916 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x90, 0x1c, 0xc7,
-		0x79, 0xdf, 0xed, 0xe3, 0xf6, 0xf1, 0xed, 0x6b, 0xae, 0xef, 0x00, 0x1c, 0x16, 0xe4, 0xdd, 0x71,
-		0x28, 0x12, 0x0f, 0x92, 0x07, 0x12, 0x24, 0x40, 0xf2, 0x20, 0x8a, 0xde, 0xdd, 0x5b, 0x1c, 0x16,
-		0xb8, 0x97, 0x66, 0xf7, 0xc0, 0x87, 0x63, 0x8d, 0xe7, 0x66, 0xfb, 0xee, 0x86, 0xd8, 0x9d, 0x59,
-		0xcd, 0xcc, 0x02, 0x77, 0x2c, 0x57, 0x8a, 0xb6, 0x62, 0x47, 0x46, 0x1c, 0x47, 0x8e, 0x53, 0x36,
-		0xfd, 0x80, 0x23, 0xf9, 0x29, 0x3b, 0x76, 0xe2, 0x87, 0x22, 0xcb, 0x51, 0xc5, 0xb1, 0x53, 0x71,
-		0x22, 0xbb, 0x52, 0x89, 0xec, 0x4a, 0x25, 0x2e, 0x97, 0xc3, 0xd8, 0x94, 0x2b, 0x52, 0x24, 0x39,
-		0xb6, 0x15, 0xba, 0x92, 0x94, 0xca, 0x4e, 0xaa, 0x5f, 0xf3, 0xd8, 0xc7, 0xed, 0x1e, 0x44, 0x32,
-		0x4a, 0xe9, 0x1f, 0x60, 0xa7, 0xfb, 0xfb, 0x7e, 0xd3, 0xfd, 0xf5, 0xd7, 0x5f, 0x7f, 0xdf, 0xd7,
-		0x3d, 0x7d, 0xf0, 0xc7, 0x65, 0x58, 0xd8, 0xb5, 0xac, 0xdd, 0x16, 0x3e, 0xdf, 0xb1, 0x2d, 0xd7,
-		0xda, 0xee, 0xee, 0x9c, 0x6f, 0x62, 0x47, 0xb7, 0x8d, 0x8e, 0x6b, 0xd9, 0x8b, 0xb4, 0x0c, 0x15,
-		0x18, 0xc5, 0xa2, 0xa0, 0x90, 0xd7, 0x60, 0xea, 0x8a, 0xd1, 0xc2, 0xcb, 0x1e, 0x61, 0x1d, 0xbb,
-		0xe8, 0x19, 0x88, 0xef, 0x18, 0x2d, 0x3c, 0x1b, 0x59, 0x88, 0x9d, 0xc9, 0x5c, 0x78, 0xcf, 0x62,
-		0x0f, 0xd3, 0x62, 0x98, 0x63, 0x93, 0x14, 0x2b, 0x94, 0x43, 0xfe, 0xeb, 0x38, 0x4c, 0x0f, 0xa8,
-		0x45, 0x08, 0xe2, 0xa6, 0xd6, 0x26, 0x88, 0x91, 0x33, 0x69, 0x85, 0xfe, 0x46, 0xb3, 0x90, 0xec,
-		0x68, 0xfa, 0x4d, 0x6d, 0x17, 0xcf, 0x46, 0x69, 0xb1, 0x78, 0x44, 0x73, 0x00, 0x4d, 0xdc, 0xc1,
-		0x66, 0x13, 0x9b, 0xfa, 0xc1, 0x6c, 0x6c, 0x21, 0x76, 0x26, 0xad, 0x04, 0x4a, 0xd0, 0x23, 0x30,
-		0xd5, 0xe9, 0x6e, 0xb7, 0x0c, 0x5d, 0x0d, 0x90, 0xc1, 0x42, 0xec, 0xcc, 0xa4, 0x22, 0xb1, 0x8a,
-		0x65, 0x9f, 0xf8, 0x34, 0x14, 0x6e, 0x63, 0xed, 0x66, 0x90, 0x34, 0x43, 0x49, 0xf3, 0xa4, 0x38,
-		0x40, 0x58, 0x81, 0x6c, 0x1b, 0x3b, 0x8e, 0xb6, 0x8b, 0x55, 0xf7, 0xa0, 0x83, 0x67, 0xe3, 0xb4,
-		0xf7, 0x0b, 0x7d, 0xbd, 0xef, 0xed, 0x79, 0x86, 0x73, 0x35, 0x0e, 0x3a, 0x18, 0x95, 0x20, 0x8d,
-		0xcd, 0x6e, 0x9b, 0x21, 0x4c, 0x0e, 0x91, 0x5f, 0xd5, 0xec, 0xb6, 0x7b, 0x51, 0x52, 0x84, 0x8d,
-		0x43, 0x24, 0x1d, 0x6c, 0xdf, 0x32, 0x74, 0x3c, 0x9b, 0xa0, 0x00, 0xa7, 0xfb, 0x00, 0xea, 0xac,
-		0xbe, 0x17, 0x43, 0xf0, 0xa1, 0x0a, 0xa4, 0xf1, 0xbe, 0x8b, 0x4d, 0xc7, 0xb0, 0xcc, 0xd9, 0x24,
-		0x05, 0x79, 0x68, 0xc0, 0x28, 0xe2, 0x56, 0xb3, 0x17, 0xc2, 0xe7, 0x43, 0x97, 0x20, 0x69, 0x75,
-		0x5c, 0xc3, 0x32, 0x9d, 0xd9, 0xd4, 0x42, 0xe4, 0x4c, 0xe6, 0xc2, 0x7d, 0x03, 0x15, 0x61, 0x83,
-		0xd1, 0x28, 0x82, 0x18, 0xd5, 0x40, 0x72, 0xac, 0xae, 0xad, 0x63, 0x55, 0xb7, 0x9a, 0x58, 0x35,
-		0xcc, 0x1d, 0x6b, 0x36, 0x4d, 0x01, 0xe6, 0xfb, 0x3b, 0x42, 0x09, 0x2b, 0x56, 0x13, 0xd7, 0xcc,
-		0x1d, 0x4b, 0xc9, 0x3b, 0xa1, 0x67, 0x74, 0x1c, 0x12, 0xce, 0x81, 0xe9, 0x6a, 0xfb, 0xb3, 0x59,
-		0xaa, 0x21, 0xfc, 0x89, 0xa8, 0x0e, 0x6e, 0x1a, 0xe4, 0x75, 0xb3, 0x39, 0xa6, 0x3a, 0xfc, 0x51,
-		0xfe, 0xb5, 0x04, 0x14, 0xc6, 0x51, 0xbe, 0xcb, 0x30, 0xb9, 0x43, 0xfa, 0x3f, 0x1b, 0x3d, 0x8a,
-		0x74, 0x18, 0x4f, 0x58, 0xbc, 0x89, 0x7b, 0x14, 0x6f, 0x09, 0x32, 0x26, 0x76, 0x5c, 0xdc, 0x64,
-		0xba, 0x12, 0x1b, 0x53, 0xdb, 0x80, 0x31, 0xf5, 0x2b, 0x5b, 0xfc, 0x9e, 0x94, 0xed, 0x45, 0x28,
-		0x78, 0x4d, 0x52, 0x6d, 0xcd, 0xdc, 0x15, 0x5a, 0x7b, 0x7e, 0x54, 0x4b, 0x16, 0xab, 0x82, 0x4f,
-		0x21, 0x6c, 0x4a, 0x1e, 0x87, 0x9e, 0xd1, 0x32, 0x80, 0x65, 0x62, 0x6b, 0x47, 0x6d, 0x62, 0xbd,
-		0x35, 0x9b, 0x1a, 0x22, 0xa5, 0x0d, 0x42, 0xd2, 0x27, 0x25, 0x8b, 0x95, 0xea, 0x2d, 0xf4, 0xac,
-		0xaf, 0x84, 0xc9, 0x21, 0x3a, 0xb4, 0xc6, 0xa6, 0x5f, 0x9f, 0x1e, 0x6e, 0x41, 0xde, 0xc6, 0x64,
-		0x46, 0xe0, 0x26, 0xef, 0x59, 0x9a, 0x36, 0x62, 0x71, 0x64, 0xcf, 0x14, 0xce, 0xc6, 0x3a, 0x96,
-		0xb3, 0x83, 0x8f, 0xe8, 0x41, 0xf0, 0x0a, 0x54, 0xaa, 0x56, 0x40, 0xed, 0x53, 0x56, 0x14, 0xae,
-		0x6b, 0x6d, 0x5c, 0x7c, 0x15, 0xf2, 0x61, 0xf1, 0xa0, 0x19, 0x98, 0x74, 0x5c, 0xcd, 0x76, 0xa9,
-		0x16, 0x4e, 0x2a, 0xec, 0x01, 0x49, 0x10, 0xc3, 0x66, 0x93, 0xda, 0xbf, 0x49, 0x85, 0xfc, 0x44,
-		0xdf, 0xe4, 0x77, 0x38, 0x46, 0x3b, 0xfc, 0x70, 0xff, 0x88, 0x86, 0x90, 0x7b, 0xfb, 0x5d, 0x7c,
-		0x1a, 0x72, 0xa1, 0x0e, 0x8c, 0xfb, 0x6a, 0xf9, 0xdb, 0xe0, 0xd8, 0x40, 0x68, 0xf4, 0x22, 0xcc,
-		0x74, 0x4d, 0xc3, 0x74, 0xb1, 0xdd, 0xb1, 0x31, 0xd1, 0x58, 0xf6, 0xaa, 0xd9, 0xcf, 0x27, 0x87,
-		0xe8, 0xdc, 0x56, 0x90, 0x9a, 0xa1, 0x28, 0xd3, 0xdd, 0xfe, 0xc2, 0x73, 0xe9, 0xd4, 0x17, 0x92,
-		0xd2, 0x6b, 0xaf, 0xbd, 0xf6, 0x5a, 0x54, 0xfe, 0xcd, 0x04, 0xcc, 0x0c, 0x9a, 0x33, 0x03, 0xa7,
-		0xef, 0x71, 0x48, 0x98, 0xdd, 0xf6, 0x36, 0xb6, 0xa9, 0x90, 0x26, 0x15, 0xfe, 0x84, 0x4a, 0x30,
-		0xd9, 0xd2, 0xb6, 0x71, 0x6b, 0x36, 0xbe, 0x10, 0x39, 0x93, 0xbf, 0xf0, 0xc8, 0x58, 0xb3, 0x72,
-		0x71, 0x95, 0xb0, 0x28, 0x8c, 0x13, 0xbd, 0x0f, 0xe2, 0xdc, 0x78, 0x13, 0x84, 0x73, 0xe3, 0x21,
-		0x90, 0xb9, 0xa4, 0x50, 0x3e, 0x74, 0x0a, 0xd2, 0xe4, 0x7f, 0xa6, 0x1b, 0x09, 0xda, 0xe6, 0x14,
-		0x29, 0x20, 0x7a, 0x81, 0x8a, 0x90, 0xa2, 0xd3, 0xa4, 0x89, 0xc5, 0xa2, 0xe7, 0x3d, 0x13, 0xc5,
-		0x6a, 0xe2, 0x1d, 0xad, 0xdb, 0x72, 0xd5, 0x5b, 0x5a, 0xab, 0x8b, 0xa9, 0xc2, 0xa7, 0x95, 0x2c,
-		0x2f, 0xbc, 0x41, 0xca, 0xd0, 0x3c, 0x64, 0xd8, 0xac, 0x32, 0xcc, 0x26, 0xde, 0xa7, 0x76, 0x75,
-		0x52, 0x61, 0x13, 0xad, 0x46, 0x4a, 0xc8, 0xeb, 0x5f, 0x71, 0x2c, 0x53, 0xa8, 0x26, 0x7d, 0x05,
-		0x29, 0xa0, 0xaf, 0x7f, 0xba, 0xd7, 0xa4, 0xdf, 0x3f, 0xb8, 0x7b, 0x7d, 0x73, 0xe9, 0x34, 0x14,
-		0x28, 0xc5, 0x93, 0x7c, 0xe8, 0xb5, 0xd6, 0xec, 0xd4, 0x42, 0xe4, 0x4c, 0x4a, 0xc9, 0xb3, 0xe2,
-		0x0d, 0x5e, 0x2a, 0x7f, 0x32, 0x0a, 0x71, 0x6a, 0x58, 0x0a, 0x90, 0x69, 0xbc, 0xb4, 0x59, 0x55,
-		0x97, 0x37, 0xb6, 0xca, 0xab, 0x55, 0x29, 0x82, 0xf2, 0x00, 0xb4, 0xe0, 0xca, 0xea, 0x46, 0xa9,
-		0x21, 0x45, 0xbd, 0xe7, 0xda, 0x7a, 0xe3, 0xd2, 0x53, 0x52, 0xcc, 0x63, 0xd8, 0x62, 0x05, 0xf1,
-		0x20, 0xc1, 0x93, 0x17, 0xa4, 0x49, 0x24, 0x41, 0x96, 0x01, 0xd4, 0x5e, 0xac, 0x2e, 0x5f, 0x7a,
-		0x4a, 0x4a, 0x84, 0x4b, 0x9e, 0xbc, 0x20, 0x25, 0x51, 0x0e, 0xd2, 0xb4, 0xa4, 0xbc, 0xb1, 0xb1,
-		0x2a, 0xa5, 0x3c, 0xcc, 0x7a, 0x43, 0xa9, 0xad, 0xaf, 0x48, 0x69, 0x0f, 0x73, 0x45, 0xd9, 0xd8,
-		0xda, 0x94, 0xc0, 0x43, 0x58, 0xab, 0xd6, 0xeb, 0xa5, 0x95, 0xaa, 0x94, 0xf1, 0x28, 0xca, 0x2f,
-		0x35, 0xaa, 0x75, 0x29, 0x1b, 0x6a, 0xd6, 0x93, 0x17, 0xa4, 0x9c, 0xf7, 0x8a, 0xea, 0xfa, 0xd6,
-		0x9a, 0x94, 0x47, 0x53, 0x90, 0x63, 0xaf, 0x10, 0x8d, 0x28, 0xf4, 0x14, 0x5d, 0x7a, 0x4a, 0x92,
-		0xfc, 0x86, 0x30, 0x94, 0xa9, 0x50, 0xc1, 0xa5, 0xa7, 0x24, 0x24, 0x57, 0x60, 0x92, 0xaa, 0x21,
-		0x42, 0x90, 0x5f, 0x2d, 0x95, 0xab, 0xab, 0xea, 0xc6, 0x66, 0xa3, 0xb6, 0xb1, 0x5e, 0x5a, 0x95,
-		0x22, 0x7e, 0x99, 0x52, 0x7d, 0xff, 0x56, 0x4d, 0xa9, 0x2e, 0x4b, 0xd1, 0x60, 0xd9, 0x66, 0xb5,
-		0xd4, 0xa8, 0x2e, 0x4b, 0x31, 0x59, 0x87, 0x99, 0x41, 0x06, 0x75, 0xe0, 0x14, 0x0a, 0xe8, 0x42,
-		0x74, 0x88, 0x2e, 0x50, 0xac, 0x5e, 0x5d, 0x90, 0x3f, 0x17, 0x85, 0xe9, 0x01, 0x8b, 0xca, 0xc0,
-		0x97, 0x3c, 0x0f, 0x93, 0x4c, 0x97, 0xd9, 0x32, 0x7b, 0x76, 0xe0, 0xea, 0x44, 0x35, 0xbb, 0x6f,
-		0xa9, 0xa5, 0x7c, 0x41, 0x27, 0x24, 0x36, 0xc4, 0x09, 0x21, 0x10, 0x7d, 0x0a, 0xfb, 0x2d, 0x7d,
-		0xc6, 0x9f, 0xad, 0x8f, 0x97, 0xc6, 0x59, 0x1f, 0x69, 0xd9, 0xd1, 0x16, 0x81, 0xc9, 0x01, 0x8b,
-		0xc0, 0x65, 0x98, 0xea, 0x03, 0x1a, 0xdb, 0x18, 0x7f, 0x28, 0x02, 0xb3, 0xc3, 0x84, 0x33, 0xc2,
-		0x24, 0x46, 0x43, 0x26, 0xf1, 0x72, 0xaf, 0x04, 0x1f, 0x18, 0x3e, 0x08, 0x7d, 0x63, 0xfd, 0x33,
-		0x11, 0x38, 0x3e, 0xd8, 0xd9, 0x1c, 0xd8, 0x86, 0xf7, 0x41, 0xa2, 0x8d, 0xdd, 0x3d, 0x4b, 0xb8,
-		0x55, 0x0f, 0x0f, 0x58, 0xac, 0x49, 0x75, 0xef, 0x60, 0x73, 0xae, 0xe0, 0x6a, 0x1f, 0x1b, 0xe6,
-		0x31, 0xb2, 0xd6, 0xf4, 0xb5, 0xf4, 0xbb, 0xa3, 0x70, 0x6c, 0x20, 0xf8, 0xc0, 0x86, 0xde, 0x0f,
-		0x60, 0x98, 0x9d, 0xae, 0xcb, 0x5c, 0x27, 0x66, 0x89, 0xd3, 0xb4, 0x84, 0x1a, 0x2f, 0x62, 0x65,
-		0xbb, 0xae, 0x57, 0x1f, 0xa3, 0xf5, 0xc0, 0x8a, 0x28, 0xc1, 0x33, 0x7e, 0x43, 0xe3, 0xb4, 0xa1,
-		0x73, 0x43, 0x7a, 0xda, 0xa7, 0x98, 0x8f, 0x83, 0xa4, 0xb7, 0x0c, 0x6c, 0xba, 0xaa, 0xe3, 0xda,
-		0x58, 0x6b, 0x1b, 0xe6, 0x2e, 0x5d, 0x6a, 0x52, 0x4b, 0x93, 0x3b, 0x5a, 0xcb, 0xc1, 0x4a, 0x81,
-		0x55, 0xd7, 0x45, 0x2d, 0xe1, 0xa0, 0x0a, 0x64, 0x07, 0x38, 0x12, 0x21, 0x0e, 0x56, 0xed, 0x71,
-		0xc8, 0xdf, 0x97, 0x86, 0x4c, 0xc0, 0x35, 0x47, 0x0f, 0x40, 0xf6, 0x15, 0xed, 0x96, 0xa6, 0x8a,
-		0x70, 0x8b, 0x49, 0x22, 0x43, 0xca, 0x36, 0x79, 0xc8, 0xf5, 0x38, 0xcc, 0x50, 0x12, 0xab, 0xeb,
-		0x62, 0x5b, 0xd5, 0x5b, 0x9a, 0xe3, 0x50, 0xa1, 0xa5, 0x28, 0x29, 0x22, 0x75, 0x1b, 0xa4, 0xaa,
-		0x22, 0x6a, 0xd0, 0x45, 0x98, 0xa6, 0x1c, 0xed, 0x6e, 0xcb, 0x35, 0x3a, 0x2d, 0xac, 0x92, 0x00,
-		0xd0, 0xa1, 0x4b, 0x8e, 0xd7, 0xb2, 0x29, 0x42, 0xb1, 0xc6, 0x09, 0x48, 0x8b, 0x1c, 0xb4, 0x0c,
-		0xf7, 0x53, 0xb6, 0x5d, 0x6c, 0x62, 0x5b, 0x73, 0xb1, 0x8a, 0x3f, 0xd8, 0xd5, 0x5a, 0x8e, 0xaa,
-		0x99, 0x4d, 0x75, 0x4f, 0x73, 0xf6, 0x66, 0x67, 0x08, 0x40, 0x39, 0x3a, 0x1b, 0x51, 0x4e, 0x12,
-		0xc2, 0x15, 0x4e, 0x57, 0xa5, 0x64, 0x25, 0xb3, 0x79, 0x55, 0x73, 0xf6, 0xd0, 0x12, 0x1c, 0xa7,
-		0x28, 0x8e, 0x6b, 0x1b, 0xe6, 0xae, 0xaa, 0xef, 0x61, 0xfd, 0xa6, 0xda, 0x75, 0x77, 0x9e, 0x99,
-		0x3d, 0x15, 0x7c, 0x3f, 0x6d, 0x61, 0x9d, 0xd2, 0x54, 0x08, 0xc9, 0x96, 0xbb, 0xf3, 0x0c, 0xaa,
-		0x43, 0x96, 0x0c, 0x46, 0xdb, 0x78, 0x15, 0xab, 0x3b, 0x96, 0x4d, 0xd7, 0xd0, 0xfc, 0x00, 0xd3,
-		0x14, 0x90, 0xe0, 0xe2, 0x06, 0x67, 0x58, 0xb3, 0x9a, 0x78, 0x69, 0xb2, 0xbe, 0x59, 0xad, 0x2e,
-		0x2b, 0x19, 0x81, 0x72, 0xc5, 0xb2, 0x89, 0x42, 0xed, 0x5a, 0x9e, 0x80, 0x33, 0x4c, 0xa1, 0x76,
-		0x2d, 0x21, 0xde, 0x8b, 0x30, 0xad, 0xeb, 0xac, 0xcf, 0x86, 0xae, 0xf2, 0x30, 0xcd, 0x99, 0x95,
-		0x42, 0xc2, 0xd2, 0xf5, 0x15, 0x46, 0xc0, 0x75, 0xdc, 0x41, 0xcf, 0xc2, 0x31, 0x5f, 0x58, 0x41,
-		0xc6, 0xa9, 0xbe, 0x5e, 0xf6, 0xb2, 0x5e, 0x84, 0xe9, 0xce, 0x41, 0x3f, 0x23, 0x0a, 0xbd, 0xb1,
-		0x73, 0xd0, 0xcb, 0xf6, 0x34, 0xcc, 0x74, 0xf6, 0x3a, 0xfd, 0x7c, 0xe7, 0x82, 0x7c, 0xa8, 0xb3,
-		0xd7, 0xe9, 0x65, 0x7c, 0x88, 0xc6, 0xec, 0x36, 0xd6, 0x35, 0x17, 0x37, 0x67, 0x4f, 0x04, 0xc9,
-		0x03, 0x15, 0x68, 0x11, 0x24, 0x5d, 0x57, 0xb1, 0xa9, 0x6d, 0xb7, 0xb0, 0xaa, 0xd9, 0xd8, 0xd4,
-		0x9c, 0xd9, 0x79, 0x4a, 0x1c, 0x77, 0xed, 0x2e, 0x56, 0xf2, 0xba, 0x5e, 0xa5, 0x95, 0x25, 0x5a,
-		0x87, 0xce, 0xc1, 0x94, 0xb5, 0xfd, 0x8a, 0xce, 0x34, 0x52, 0xed, 0xd8, 0x78, 0xc7, 0xd8, 0x9f,
-		0x7d, 0x0f, 0x15, 0x6f, 0x81, 0x54, 0x50, 0x7d, 0xdc, 0xa4, 0xc5, 0xe8, 0x2c, 0x48, 0xba, 0xb3,
-		0xa7, 0xd9, 0x1d, 0x6a, 0x92, 0x9d, 0x8e, 0xa6, 0xe3, 0xd9, 0x87, 0x18, 0x29, 0x2b, 0x5f, 0x17,
-		0xc5, 0x64, 0x46, 0x38, 0xb7, 0x8d, 0x1d, 0x57, 0x20, 0x9e, 0x66, 0x33, 0x82, 0x96, 0x71, 0xb4,
-		0x33, 0x20, 0x11, 0x49, 0x84, 0x5e, 0x7c, 0x86, 0x92, 0xe5, 0x3b, 0x7b, 0x9d, 0xe0, 0x7b, 0x1f,
-		0x84, 0x1c, 0xa1, 0xf4, 0x5f, 0x7a, 0x96, 0x39, 0x6e, 0x9d, 0xbd, 0xc0, 0x1b, 0x9f, 0x82, 0xe3,
-		0x84, 0xa8, 0x8d, 0x5d, 0xad, 0xa9, 0xb9, 0x5a, 0x80, 0xfa, 0x51, 0x4a, 0x4d, 0xc4, 0xbe, 0xc6,
-		0x2b, 0x43, 0xed, 0xb4, 0xbb, 0xdb, 0x07, 0x9e, 0x62, 0x3d, 0xc6, 0xda, 0x49, 0xca, 0x84, 0x6a,
-		0xbd, 0x63, 0xce, 0xb9, 0xbc, 0x04, 0xd9, 0xa0, 0xde, 0xa3, 0x34, 0x30, 0xcd, 0x97, 0x22, 0xc4,
-		0x09, 0xaa, 0x6c, 0x2c, 0x13, 0xf7, 0xe5, 0xe5, 0xaa, 0x14, 0x25, 0x6e, 0xd4, 0x6a, 0xad, 0x51,
-		0x55, 0x95, 0xad, 0xf5, 0x46, 0x6d, 0xad, 0x2a, 0xc5, 0x02, 0x8e, 0xfd, 0xb5, 0x78, 0xea, 0x61,
-		0xe9, 0xb4, 0xfc, 0xe9, 0x18, 0xe4, 0xc3, 0x91, 0x1a, 0x7a, 0x2f, 0x9c, 0x10, 0x09, 0x17, 0x07,
-		0xbb, 0xea, 0x6d, 0xc3, 0xa6, 0x13, 0xb2, 0xad, 0xb1, 0xc5, 0xd1, 0xd3, 0x9f, 0x19, 0x4e, 0x55,
-		0xc7, 0xee, 0x0b, 0x86, 0x4d, 0xa6, 0x5b, 0x5b, 0x73, 0xd1, 0x2a, 0xcc, 0x9b, 0x96, 0xea, 0xb8,
-		0x9a, 0xd9, 0xd4, 0xec, 0xa6, 0xea, 0xa7, 0xba, 0x54, 0x4d, 0xd7, 0xb1, 0xe3, 0x58, 0x6c, 0x21,
-		0xf4, 0x50, 0xee, 0x33, 0xad, 0x3a, 0x27, 0xf6, 0x57, 0x88, 0x12, 0x27, 0xed, 0x51, 0xdf, 0xd8,
-		0x30, 0xf5, 0x3d, 0x05, 0xe9, 0xb6, 0xd6, 0x51, 0xb1, 0xe9, 0xda, 0x07, 0xd4, 0x3f, 0x4f, 0x29,
-		0xa9, 0xb6, 0xd6, 0xa9, 0x92, 0x67, 0x74, 0x03, 0x1e, 0xf6, 0x49, 0xd5, 0x16, 0xde, 0xd5, 0xf4,
-		0x03, 0x95, 0x3a, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xd3, 0x32, 0x74, 0xd7, 0xa1, 0xf6,
-		0x81, 0xd9, 0x38, 0xd9, 0xe7, 0x58, 0xa5, 0x0c, 0xd7, 0x1c, 0xcb, 0xa4, 0x3e, 0x78, 0x45, 0x50,
-		0xbf, 0x2b, 0xe1, 0xd7, 0xb5, 0x78, 0x2a, 0x2e, 0x4d, 0x5e, 0x8b, 0xa7, 0x26, 0xa5, 0xc4, 0xb5,
-		0x78, 0x2a, 0x21, 0x25, 0xaf, 0xc5, 0x53, 0x29, 0x29, 0x7d, 0x2d, 0x9e, 0x4a, 0x4b, 0x20, 0xff,
-		0x6a, 0x0a, 0xb2, 0xc1, 0xc8, 0x80, 0x04, 0x5a, 0x3a, 0x5d, 0x1b, 0x23, 0xd4, 0x7a, 0x3e, 0x78,
-		0x68, 0x1c, 0xb1, 0x58, 0x21, 0x8b, 0xe6, 0x52, 0x82, 0xb9, 0xe1, 0x0a, 0xe3, 0x24, 0x0e, 0x0b,
-		0x51, 0x6b, 0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x9f, 0xd0, 0x0a, 0x24, 0x5e, 0x71, 0x28, 0x76, 0x82,
-		0x62, 0xbf, 0xe7, 0x70, 0xec, 0x6b, 0x75, 0x0a, 0x9e, 0xbe, 0x56, 0x57, 0xd7, 0x37, 0x94, 0xb5,
-		0xd2, 0xaa, 0xc2, 0xd9, 0xd1, 0x49, 0x88, 0xb7, 0xb4, 0x57, 0x0f, 0xc2, 0xcb, 0x2b, 0x2d, 0x42,
-		0x8b, 0x50, 0xe8, 0x9a, 0xb7, 0xb0, 0x6d, 0xec, 0x18, 0x64, 0xa8, 0x08, 0x55, 0x21, 0x48, 0x95,
-		0xf7, 0x6b, 0x57, 0x09, 0xfd, 0x98, 0xea, 0x71, 0x12, 0xe2, 0xb7, 0xb1, 0x76, 0x33, 0xbc, 0x08,
-		0xd2, 0x22, 0x74, 0x06, 0xb2, 0x4d, 0xbc, 0xdd, 0xdd, 0x55, 0x6d, 0xdc, 0xd4, 0x74, 0x37, 0x6c,
-		0xfa, 0x33, 0xb4, 0x4a, 0xa1, 0x35, 0xe8, 0x3a, 0xa4, 0xc9, 0x18, 0x99, 0x74, 0x8c, 0xa7, 0xa8,
-		0x08, 0x1e, 0x3b, 0x5c, 0x04, 0x7c, 0x88, 0x05, 0x93, 0xe2, 0xf3, 0xa3, 0x2b, 0x90, 0x70, 0x35,
-		0x7b, 0x17, 0xbb, 0xd4, 0xf2, 0xe7, 0x07, 0x24, 0x3f, 0x06, 0x20, 0x35, 0x28, 0x07, 0x8d, 0x69,
-		0x39, 0xf7, 0x3b, 0x68, 0x65, 0xce, 0xc3, 0x24, 0x55, 0x0f, 0x04, 0xc0, 0x15, 0x44, 0x9a, 0x40,
-		0x29, 0x88, 0x57, 0x36, 0x14, 0x62, 0x69, 0x24, 0xc8, 0xb2, 0x52, 0x75, 0xb3, 0x56, 0xad, 0x54,
-		0xa5, 0xa8, 0x7c, 0x11, 0x12, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x46, 0x5d, 0x9a, 0xe0, 0x8f, 0x1c,
-		0x23, 0x22, 0x6a, 0xb7, 0xd6, 0xca, 0x55, 0x45, 0x8a, 0xca, 0x5b, 0x50, 0xe8, 0x91, 0x13, 0x3a,
-		0x06, 0x53, 0x4a, 0xb5, 0x51, 0x5d, 0x27, 0x71, 0x96, 0xba, 0xb5, 0x7e, 0x7d, 0x7d, 0xe3, 0x85,
-		0x75, 0x69, 0x22, 0x5c, 0x2c, 0x4c, 0x5a, 0x04, 0xcd, 0x80, 0xe4, 0x17, 0xd7, 0x37, 0xb6, 0x14,
-		0xda, 0x9a, 0xef, 0x89, 0x82, 0xd4, 0x2b, 0x35, 0x74, 0x02, 0xa6, 0x1b, 0x25, 0x65, 0xa5, 0xda,
-		0x50, 0x59, 0xec, 0xe8, 0x41, 0xcf, 0x80, 0x14, 0xac, 0xb8, 0x52, 0xa3, 0xa1, 0xf1, 0x3c, 0x9c,
-		0x0a, 0x96, 0x56, 0x5f, 0x6c, 0x54, 0xd7, 0xeb, 0xf4, 0xe5, 0xa5, 0xf5, 0x15, 0x62, 0x5f, 0x7b,
-		0xf0, 0x44, 0xb4, 0x1a, 0x23, 0x4d, 0x0d, 0xe3, 0x55, 0x57, 0x97, 0xa5, 0x78, 0x6f, 0xf1, 0xc6,
-		0x7a, 0x75, 0xe3, 0x8a, 0x34, 0xd9, 0xfb, 0x76, 0x1a, 0xc1, 0x26, 0x50, 0x11, 0x8e, 0xf7, 0x96,
-		0xaa, 0xd5, 0xf5, 0x86, 0xf2, 0x92, 0x94, 0xec, 0x7d, 0x71, 0xbd, 0xaa, 0xdc, 0xa8, 0x55, 0xaa,
-		0x52, 0x0a, 0x1d, 0x07, 0x14, 0x6e, 0x51, 0xe3, 0xea, 0xc6, 0xb2, 0x94, 0xee, 0xb3, 0x28, 0xb2,
-		0x03, 0xd9, 0x60, 0x18, 0xf9, 0xee, 0xe4, 0x92, 0x5e, 0x8f, 0x42, 0x26, 0x10, 0x16, 0x12, 0x7f,
-		0x5e, 0x6b, 0xb5, 0xac, 0xdb, 0xaa, 0xd6, 0x32, 0x34, 0x87, 0xdb, 0x1b, 0xa0, 0x45, 0x25, 0x52,
-		0x32, 0xee, 0xfc, 0x1e, 0xdf, 0xc2, 0x27, 0xbe, 0x1e, 0x2d, 0xfc, 0xa4, 0x94, 0x90, 0x7f, 0x2c,
-		0x02, 0x52, 0x6f, 0xbc, 0xd7, 0xd3, 0xfd, 0xc8, 0xb0, 0xee, 0xbf, 0x2b, 0x63, 0xf7, 0xa3, 0x11,
-		0xc8, 0x87, 0x83, 0xbc, 0x9e, 0xe6, 0x3d, 0xf0, 0xff, 0xb4, 0x79, 0x7f, 0x14, 0x85, 0x5c, 0x28,
-		0xb4, 0x1b, 0xb7, 0x75, 0x1f, 0x84, 0x29, 0xa3, 0x89, 0xdb, 0x1d, 0xcb, 0xc5, 0xa6, 0x7e, 0xa0,
-		0xb6, 0xf0, 0x2d, 0xdc, 0x9a, 0x95, 0xa9, 0x51, 0x3e, 0x7f, 0x78, 0xf0, 0xb8, 0x58, 0xf3, 0xf9,
-		0x56, 0x09, 0xdb, 0xd2, 0x74, 0x6d, 0xb9, 0xba, 0xb6, 0xb9, 0xd1, 0xa8, 0xae, 0x57, 0x5e, 0x12,
-		0xd6, 0x45, 0x91, 0x8c, 0x1e, 0xb2, 0x77, 0xd0, 0x68, 0x6f, 0x82, 0xd4, 0xdb, 0x28, 0x62, 0x2b,
-		0x06, 0x34, 0x4b, 0x9a, 0x40, 0xd3, 0x50, 0x58, 0xdf, 0x50, 0xeb, 0xb5, 0xe5, 0xaa, 0x5a, 0xbd,
-		0x72, 0xa5, 0x5a, 0x69, 0xd4, 0x59, 0x3a, 0xd0, 0xa3, 0x6e, 0x48, 0xd1, 0xa0, 0x88, 0x7f, 0x38,
-		0x06, 0xd3, 0x03, 0x5a, 0x82, 0x4a, 0x3c, 0x90, 0x67, 0xb9, 0x85, 0xc7, 0xc6, 0x69, 0xfd, 0x22,
-		0x71, 0xa5, 0x37, 0x35, 0xdb, 0xe5, 0x71, 0xff, 0x59, 0x20, 0x52, 0x32, 0x5d, 0xb2, 0xb2, 0xdb,
-		0x3c, 0xcd, 0xca, 0xa2, 0xfb, 0x82, 0x5f, 0xce, 0x32, 0xad, 0x8f, 0x02, 0xea, 0x58, 0x8e, 0xe1,
-		0x1a, 0xb7, 0xb0, 0x6a, 0x98, 0x22, 0x27, 0x4b, 0xa2, 0xfd, 0xb8, 0x22, 0x89, 0x9a, 0x9a, 0xe9,
-		0x7a, 0xd4, 0x26, 0xde, 0xd5, 0x7a, 0xa8, 0x89, 0xe7, 0x11, 0x53, 0x24, 0x51, 0xe3, 0x51, 0x3f,
-		0x00, 0xd9, 0xa6, 0xd5, 0x25, 0x21, 0x10, 0xa3, 0x23, 0xd6, 0x22, 0xa2, 0x64, 0x58, 0x99, 0x47,
-		0xc2, 0x83, 0x5b, 0x3f, 0x19, 0x9c, 0x55, 0x32, 0xac, 0x8c, 0x91, 0x9c, 0x86, 0x82, 0xb6, 0xbb,
-		0x6b, 0x13, 0x70, 0x01, 0xc4, 0xc2, 0xf5, 0xbc, 0x57, 0x4c, 0x09, 0x8b, 0xd7, 0x20, 0x25, 0xe4,
-		0x40, 0x3c, 0x58, 0x22, 0x09, 0xb5, 0xc3, 0x72, 0x50, 0xd1, 0x33, 0x69, 0x25, 0x65, 0x8a, 0xca,
-		0x07, 0x20, 0x6b, 0x38, 0xaa, 0xbf, 0xb7, 0x15, 0x5d, 0x88, 0x9e, 0x49, 0x29, 0x19, 0xc3, 0xf1,
-		0xf6, 0x05, 0xe4, 0x9f, 0x89, 0x42, 0x3e, 0xbc, 0x6b, 0x87, 0x96, 0x21, 0xd5, 0xb2, 0x74, 0x8d,
-		0xaa, 0x16, 0xdb, 0x32, 0x3e, 0x33, 0x62, 0xa3, 0x6f, 0x71, 0x95, 0xd3, 0x2b, 0x1e, 0x67, 0xf1,
-		0xdf, 0x45, 0x20, 0x25, 0x8a, 0xd1, 0x71, 0x88, 0x77, 0x34, 0x77, 0x8f, 0xc2, 0x4d, 0x96, 0xa3,
-		0x52, 0x44, 0xa1, 0xcf, 0xa4, 0xdc, 0xe9, 0x68, 0x26, 0x55, 0x01, 0x5e, 0x4e, 0x9e, 0xc9, 0xb8,
-		0xb6, 0xb0, 0xd6, 0xa4, 0xb9, 0x00, 0xab, 0xdd, 0xc6, 0xa6, 0xeb, 0x88, 0x71, 0xe5, 0xe5, 0x15,
-		0x5e, 0x8c, 0x1e, 0x81, 0x29, 0xd7, 0xd6, 0x8c, 0x56, 0x88, 0x36, 0x4e, 0x69, 0x25, 0x51, 0xe1,
-		0x11, 0x2f, 0xc1, 0x49, 0x81, 0xdb, 0xc4, 0xae, 0xa6, 0xef, 0xe1, 0xa6, 0xcf, 0x94, 0xa0, 0x39,
-		0xbf, 0x13, 0x9c, 0x60, 0x99, 0xd7, 0x0b, 0x5e, 0xf9, 0xb3, 0x51, 0x98, 0x12, 0xd9, 0x8b, 0xa6,
-		0x27, 0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0x37, 0x28, 0xae, 0x7e, 0x55, 0xee, 0xe3, 0x5b, 0x2c,
-		0x79, 0x4c, 0x4a, 0x00, 0xa0, 0xf8, 0xa5, 0x08, 0x80, 0x5f, 0x35, 0x54, 0x6e, 0xf3, 0x90, 0xe1,
-		0x7b, 0xb2, 0x74, 0x63, 0x9f, 0x25, 0xbc, 0x80, 0x15, 0x5d, 0x31, 0x5a, 0x34, 0x2d, 0xb9, 0x8d,
-		0x77, 0x0d, 0x93, 0xef, 0xa7, 0xb0, 0x07, 0x91, 0x96, 0x8c, 0xfb, 0xdb, 0x53, 0x0a, 0xa4, 0x1c,
-		0xdc, 0xd6, 0x4c, 0xd7, 0xd0, 0xf9, 0x0e, 0xc9, 0xa5, 0x23, 0x35, 0x7e, 0xb1, 0xce, 0xb9, 0x15,
-		0x0f, 0x47, 0x3e, 0x03, 0x29, 0x51, 0x4a, 0x1c, 0xbf, 0xf5, 0x8d, 0xf5, 0xaa, 0x34, 0x81, 0x92,
-		0x10, 0xab, 0x57, 0x1b, 0x52, 0x84, 0x84, 0x9d, 0xa5, 0xd5, 0x5a, 0xa9, 0x2e, 0x45, 0xcb, 0x7f,
-		0x13, 0xa6, 0x75, 0xab, 0xdd, 0xfb, 0xc2, 0xb2, 0xd4, 0x93, 0xf2, 0x73, 0xae, 0x46, 0x5e, 0x7e,
-		0x8c, 0x13, 0xed, 0x5a, 0x2d, 0xcd, 0xdc, 0x5d, 0xb4, 0xec, 0x5d, 0xff, 0x58, 0x04, 0x89, 0x0e,
-		0x9c, 0xc0, 0xe1, 0x88, 0xce, 0xf6, 0xff, 0x8a, 0x44, 0x7e, 0x3c, 0x1a, 0x5b, 0xd9, 0x2c, 0xff,
-		0x5c, 0xb4, 0xb8, 0xc2, 0x18, 0x37, 0x45, 0x77, 0x14, 0xbc, 0xd3, 0xc2, 0x3a, 0x69, 0x3c, 0xfc,
-		0xe9, 0x23, 0x30, 0xb3, 0x6b, 0xed, 0x5a, 0x14, 0xe9, 0x3c, 0xf9, 0xc5, 0xcf, 0x55, 0xa4, 0xbd,
-		0xd2, 0xe2, 0xc8, 0x43, 0x18, 0x4b, 0xeb, 0x30, 0xcd, 0x89, 0x55, 0xba, 0x7d, 0xcb, 0x92, 0x0b,
-		0xe8, 0xd0, 0xcc, 0xf6, 0xec, 0x2f, 0xfd, 0x09, 0xf5, 0x4a, 0x94, 0x29, 0xce, 0x4a, 0xea, 0x58,
-		0xfe, 0x61, 0x49, 0x81, 0x63, 0x21, 0x3c, 0x66, 0x23, 0xb0, 0x3d, 0x02, 0xf1, 0xb7, 0x38, 0xe2,
-		0x74, 0x00, 0xb1, 0xce, 0x59, 0x97, 0x2a, 0x90, 0x3b, 0x0a, 0xd6, 0xbf, 0xe6, 0x58, 0x59, 0x1c,
-		0x04, 0x59, 0x81, 0x02, 0x05, 0xd1, 0xbb, 0x8e, 0x6b, 0xb5, 0xa9, 0x01, 0x3e, 0x1c, 0xe6, 0xdf,
-		0xfc, 0x09, 0x9b, 0xb4, 0x79, 0xc2, 0x56, 0xf1, 0xb8, 0x96, 0x96, 0x80, 0xee, 0x58, 0x37, 0xb1,
-		0xde, 0x1a, 0x81, 0xf0, 0x19, 0xde, 0x10, 0x8f, 0x7e, 0xe9, 0x06, 0xcc, 0x90, 0xdf, 0xd4, 0x3e,
-		0x06, 0x5b, 0x32, 0x3a, 0x0d, 0x3e, 0xfb, 0xbb, 0x1f, 0x62, 0x76, 0x61, 0xda, 0x03, 0x08, 0xb4,
-		0x29, 0x30, 0x8a, 0xbb, 0xd8, 0x75, 0xb1, 0xed, 0xa8, 0x5a, 0x6b, 0x50, 0xf3, 0x02, 0x79, 0xc4,
-		0xd9, 0x1f, 0xfa, 0x72, 0x78, 0x14, 0x57, 0x18, 0x67, 0xa9, 0xd5, 0x5a, 0xda, 0x82, 0x13, 0x03,
-		0xb4, 0x62, 0x0c, 0xcc, 0x1f, 0xe6, 0x98, 0x33, 0x7d, 0x9a, 0x41, 0x60, 0x37, 0x41, 0x94, 0x7b,
-		0x63, 0x39, 0x06, 0xe6, 0x8f, 0x70, 0x4c, 0xc4, 0x79, 0xc5, 0x90, 0x12, 0xc4, 0x6b, 0x30, 0x75,
-		0x0b, 0xdb, 0xdb, 0x96, 0xc3, 0x73, 0xb7, 0x63, 0xc0, 0xfd, 0x28, 0x87, 0x2b, 0x70, 0x46, 0x9a,
-		0xcc, 0x25, 0x58, 0xcf, 0x42, 0x6a, 0x47, 0xd3, 0xf1, 0x18, 0x10, 0x77, 0x39, 0x44, 0x92, 0xd0,
-		0x13, 0xd6, 0x12, 0x64, 0x77, 0x2d, 0xbe, 0x44, 0x8e, 0x66, 0xff, 0x31, 0xce, 0x9e, 0x11, 0x3c,
-		0x1c, 0xa2, 0x63, 0x75, 0xba, 0x2d, 0xb2, 0x7e, 0x8e, 0x86, 0xf8, 0x87, 0x02, 0x42, 0xf0, 0x70,
-		0x88, 0x23, 0x88, 0xf5, 0xa3, 0x02, 0xc2, 0x09, 0xc8, 0xf3, 0x79, 0xc8, 0x58, 0x66, 0xeb, 0xc0,
-		0x32, 0xc7, 0x69, 0xc4, 0xc7, 0x38, 0x02, 0x70, 0x16, 0x02, 0x70, 0x19, 0xd2, 0xe3, 0x0e, 0xc4,
-		0x4f, 0x7d, 0x59, 0x4c, 0x0f, 0x31, 0x02, 0x2b, 0x50, 0x10, 0x06, 0xca, 0xb0, 0xcc, 0x31, 0x20,
-		0x7e, 0x9a, 0x43, 0xe4, 0x03, 0x6c, 0xbc, 0x1b, 0x2e, 0x76, 0xdc, 0x5d, 0x3c, 0x0e, 0xc8, 0xcf,
-		0x88, 0x6e, 0x70, 0x16, 0x2e, 0xca, 0x6d, 0x6c, 0xea, 0x7b, 0xe3, 0x21, 0x7c, 0x5c, 0x88, 0x52,
-		0xf0, 0x10, 0x88, 0x0a, 0xe4, 0xda, 0x9a, 0xed, 0xec, 0x69, 0xad, 0xb1, 0x86, 0xe3, 0x67, 0x39,
-		0x46, 0xd6, 0x63, 0xe2, 0x12, 0xe9, 0x9a, 0x47, 0x81, 0xf9, 0x39, 0x21, 0x91, 0x00, 0x1b, 0x9f,
-		0x7a, 0x8e, 0x4b, 0x13, 0xdd, 0x47, 0x41, 0xfb, 0x47, 0x62, 0xea, 0x31, 0xde, 0xb5, 0x20, 0xe2,
-		0x65, 0x48, 0x3b, 0xc6, 0xab, 0x63, 0xc1, 0xfc, 0xbc, 0x18, 0x69, 0xca, 0x40, 0x98, 0x5f, 0x82,
-		0x93, 0x03, 0x97, 0x89, 0x31, 0xc0, 0x7e, 0x81, 0x83, 0x1d, 0x1f, 0xb0, 0x54, 0x70, 0x93, 0x70,
-		0x54, 0xc8, 0x7f, 0x2c, 0x4c, 0x02, 0xee, 0xc1, 0xda, 0x24, 0x41, 0x8b, 0xa3, 0xed, 0x1c, 0x4d,
-		0x6a, 0xff, 0x44, 0x48, 0x8d, 0xf1, 0x86, 0xa4, 0xd6, 0x80, 0xe3, 0x1c, 0xf1, 0x68, 0xe3, 0xfa,
-		0x8b, 0xc2, 0xb0, 0x32, 0xee, 0xad, 0xf0, 0xe8, 0x7e, 0x33, 0x14, 0x3d, 0x71, 0x0a, 0xef, 0xd8,
-		0x51, 0xdb, 0x5a, 0x67, 0x0c, 0xe4, 0x5f, 0xe2, 0xc8, 0xc2, 0xe2, 0x7b, 0xee, 0xb5, 0xb3, 0xa6,
-		0x75, 0x08, 0xf8, 0x8b, 0x30, 0x2b, 0xc0, 0xbb, 0xa6, 0x8d, 0x75, 0x6b, 0xd7, 0x34, 0x5e, 0xc5,
-		0xcd, 0x31, 0xa0, 0x7f, 0xb9, 0x67, 0xa8, 0xb6, 0x02, 0xec, 0x04, 0xb9, 0x06, 0x92, 0xe7, 0xab,
-		0xa8, 0x46, 0xbb, 0x63, 0xd9, 0xee, 0x08, 0xc4, 0x5f, 0x11, 0x23, 0xe5, 0xf1, 0xd5, 0x28, 0xdb,
-		0x52, 0x15, 0xd8, 0xe9, 0x8f, 0x71, 0x55, 0xf2, 0x13, 0x1c, 0x28, 0xe7, 0x73, 0x71, 0xc3, 0xa1,
-		0x5b, 0xed, 0x8e, 0x66, 0x8f, 0x63, 0xff, 0xfe, 0xa9, 0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2,
-		0xd1, 0x91, 0xd5, 0x7e, 0x0c, 0x84, 0x4f, 0x0a, 0xc3, 0x21, 0x78, 0x38, 0x84, 0x70, 0x18, 0xc6,
-		0x80, 0xf8, 0x55, 0x01, 0x21, 0x78, 0x08, 0xc4, 0xfb, 0xfd, 0x85, 0xd6, 0xc6, 0xbb, 0x86, 0xe3,
-		0xda, 0xcc, 0x25, 0x3f, 0x1c, 0xea, 0x53, 0x5f, 0x0e, 0x3b, 0x61, 0x4a, 0x80, 0x95, 0x58, 0x22,
-		0xbe, 0xf5, 0x41, 0x43, 0xb6, 0xd1, 0x0d, 0xfb, 0x35, 0x61, 0x89, 0x02, 0x6c, 0xa4, 0x6d, 0x01,
-		0x0f, 0x91, 0x88, 0x5d, 0x27, 0x81, 0xca, 0x18, 0x70, 0xff, 0xac, 0xa7, 0x71, 0x75, 0xc1, 0x4b,
-		0x30, 0x03, 0xfe, 0x4f, 0xd7, 0xbc, 0x89, 0x0f, 0xc6, 0xd2, 0xce, 0x4f, 0xf7, 0xf8, 0x3f, 0x5b,
-		0x8c, 0x93, 0xd9, 0x90, 0x42, 0x8f, 0x3f, 0x85, 0x46, 0x9d, 0xf5, 0x9b, 0xfd, 0xf6, 0xb7, 0x78,
-		0x7f, 0xc3, 0xee, 0xd4, 0xd2, 0x2a, 0x51, 0xf2, 0xb0, 0xd3, 0x33, 0x1a, 0xec, 0x43, 0x6f, 0x79,
-		0x7a, 0x1e, 0xf2, 0x79, 0x96, 0xae, 0x40, 0x2e, 0xe4, 0xf0, 0x8c, 0x86, 0xfa, 0x5b, 0x1c, 0x2a,
-		0x1b, 0xf4, 0x77, 0x96, 0x2e, 0x42, 0x9c, 0x38, 0x2f, 0xa3, 0xd9, 0xbf, 0x93, 0xb3, 0x53, 0xf2,
-		0xa5, 0xe7, 0x20, 0x25, 0x9c, 0x96, 0xd1, 0xac, 0xdf, 0xc5, 0x59, 0x3d, 0x16, 0xc2, 0x2e, 0x1c,
-		0x96, 0xd1, 0xec, 0x7f, 0x5b, 0xb0, 0x0b, 0x16, 0xc2, 0x3e, 0xbe, 0x08, 0x7f, 0xe3, 0xef, 0xc4,
-		0xf9, 0xa2, 0x23, 0x64, 0x77, 0x19, 0x92, 0xdc, 0x53, 0x19, 0xcd, 0xfd, 0xdd, 0xfc, 0xe5, 0x82,
-		0x63, 0xe9, 0x69, 0x98, 0x1c, 0x53, 0xe0, 0x7f, 0x97, 0xb3, 0x32, 0xfa, 0xa5, 0x0a, 0x64, 0x02,
-		0xde, 0xc9, 0x68, 0xf6, 0xef, 0xe5, 0xec, 0x41, 0x2e, 0xd2, 0x74, 0xee, 0x9d, 0x8c, 0x06, 0xf8,
-		0x7b, 0xa2, 0xe9, 0x9c, 0x83, 0x88, 0x4d, 0x38, 0x26, 0xa3, 0xb9, 0x3f, 0x22, 0xa4, 0x2e, 0x58,
-		0x96, 0x9e, 0x87, 0xb4, 0xb7, 0xd8, 0x8c, 0xe6, 0xff, 0x3e, 0xce, 0xef, 0xf3, 0x10, 0x09, 0x04,
-		0x16, 0xbb, 0xd1, 0x10, 0x7f, 0x5f, 0x48, 0x20, 0xc0, 0x45, 0xa6, 0x51, 0xaf, 0x03, 0x33, 0x1a,
-		0xe9, 0xfb, 0xc5, 0x34, 0xea, 0xf1, 0x5f, 0xc8, 0x68, 0x52, 0x9b, 0x3f, 0x1a, 0xe2, 0x1f, 0x88,
-		0xd1, 0xa4, 0xf4, 0xa4, 0x19, 0xbd, 0x1e, 0xc1, 0x68, 0x8c, 0x1f, 0x14, 0xcd, 0xe8, 0x71, 0x08,
-		0x96, 0x36, 0x01, 0xf5, 0x7b, 0x03, 0xa3, 0xf1, 0x5e, 0xe7, 0x78, 0x53, 0x7d, 0xce, 0xc0, 0xd2,
-		0x0b, 0x70, 0x7c, 0xb0, 0x27, 0x30, 0x1a, 0xf5, 0x87, 0xde, 0xea, 0x89, 0xdd, 0x82, 0x8e, 0xc0,
-		0x52, 0xc3, 0x5f, 0x52, 0x82, 0x5e, 0xc0, 0x68, 0xd8, 0x1f, 0x7e, 0x2b, 0x6c, 0xb8, 0x83, 0x4e,
-		0xc0, 0x52, 0x09, 0xc0, 0x5f, 0x80, 0x47, 0x63, 0xfd, 0x28, 0xc7, 0x0a, 0x30, 0x91, 0xa9, 0xc1,
-		0xd7, 0xdf, 0xd1, 0xfc, 0x77, 0xc5, 0xd4, 0xe0, 0x1c, 0x64, 0x6a, 0x88, 0xa5, 0x77, 0x34, 0xf7,
-		0x8f, 0x89, 0xa9, 0x21, 0x58, 0x88, 0x66, 0x07, 0x56, 0xb7, 0xd1, 0x08, 0x1f, 0x13, 0x9a, 0x1d,
-		0xe0, 0x5a, 0x5a, 0x87, 0xa9, 0xbe, 0x05, 0x71, 0x34, 0xd4, 0x8f, 0x73, 0x28, 0xa9, 0x77, 0x3d,
-		0x0c, 0x2e, 0x5e, 0x7c, 0x31, 0x1c, 0x8d, 0xf6, 0x13, 0x3d, 0x8b, 0x17, 0x5f, 0x0b, 0x97, 0x2e,
-		0x43, 0xca, 0xec, 0xb6, 0x5a, 0x64, 0xf2, 0xa0, 0xc3, 0xcf, 0xe7, 0xce, 0xfe, 0xb7, 0xaf, 0x72,
-		0xe9, 0x08, 0x86, 0xa5, 0x8b, 0x30, 0x89, 0xdb, 0xdb, 0xb8, 0x39, 0x8a, 0xf3, 0x8b, 0x5f, 0x15,
-		0x06, 0x93, 0x50, 0x2f, 0x3d, 0x0f, 0xc0, 0x52, 0x23, 0x74, 0xe3, 0x7c, 0x04, 0xef, 0x97, 0xbe,
-		0xca, 0x0f, 0xc4, 0xf9, 0x2c, 0x3e, 0x00, 0x3b, 0x5e, 0x77, 0x38, 0xc0, 0x97, 0xc3, 0x00, 0x74,
-		0x44, 0x9e, 0x85, 0xe4, 0x2b, 0x8e, 0x65, 0xba, 0xda, 0xee, 0x28, 0xee, 0x3f, 0xe5, 0xdc, 0x82,
-		0x9e, 0x08, 0xac, 0x6d, 0xd9, 0xd8, 0xd5, 0x76, 0x9d, 0x51, 0xbc, 0xff, 0x9d, 0xf3, 0x7a, 0x0c,
-		0x84, 0x59, 0xd7, 0x1c, 0x77, 0x9c, 0x7e, 0xff, 0x99, 0x60, 0x16, 0x0c, 0xa4, 0xd1, 0xe4, 0xf7,
-		0x4d, 0x7c, 0x30, 0x8a, 0xf7, 0xcf, 0x45, 0xa3, 0x39, 0xfd, 0xd2, 0x73, 0x90, 0x26, 0x3f, 0xd9,
-		0x29, 0xd7, 0x11, 0xcc, 0x7f, 0xc1, 0x99, 0x7d, 0x0e, 0xf2, 0x66, 0xc7, 0x6d, 0xba, 0xc6, 0x68,
-		0x61, 0x7f, 0x85, 0x8f, 0xb4, 0xa0, 0x5f, 0x2a, 0x41, 0xc6, 0x71, 0x9b, 0xcd, 0x2e, 0xf7, 0x4f,
-		0x47, 0xb0, 0xff, 0x8f, 0xaf, 0x7a, 0x29, 0x0b, 0x8f, 0x87, 0x8c, 0xf6, 0xed, 0x9b, 0x6e, 0xc7,
-		0xa2, 0xfb, 0x2d, 0xa3, 0x10, 0xde, 0xe2, 0x08, 0x01, 0x96, 0xa5, 0x0a, 0x64, 0x49, 0x5f, 0x6c,
-		0xdc, 0xc1, 0x74, 0x73, 0x6c, 0x04, 0xc4, 0x5f, 0x72, 0x01, 0x84, 0x98, 0xca, 0xdf, 0xfa, 0x99,
-		0x37, 0xe7, 0x22, 0x9f, 0x7d, 0x73, 0x2e, 0xf2, 0x47, 0x6f, 0xce, 0x45, 0x3e, 0xf2, 0xb9, 0xb9,
-		0x89, 0xcf, 0x7e, 0x6e, 0x6e, 0xe2, 0xf7, 0x3f, 0x37, 0x37, 0x31, 0x38, 0x4b, 0x0c, 0x2b, 0xd6,
-		0x8a, 0xc5, 0xf2, 0xc3, 0x2f, 0x3f, 0xb4, 0x6b, 0xb8, 0x7b, 0xdd, 0xed, 0x45, 0xdd, 0x6a, 0x9f,
-		0xd7, 0x2d, 0xa7, 0x6d, 0x39, 0xe7, 0xc3, 0x79, 0x5d, 0xfa, 0x0b, 0xfe, 0x2a, 0x42, 0x62, 0xe6,
-		0x70, 0x3a, 0x57, 0x33, 0x0f, 0x86, 0x7d, 0x4c, 0x77, 0x09, 0x62, 0x25, 0xf3, 0x00, 0x9d, 0x64,
-		0x06, 0x4e, 0xed, 0xda, 0x2d, 0x7e, 0xd4, 0x32, 0x49, 0x9e, 0xb7, 0xec, 0x16, 0x9a, 0xf1, 0xcf,
-		0x43, 0x47, 0xce, 0x64, 0xf9, 0x21, 0xe7, 0xf2, 0xf7, 0x46, 0x8e, 0xd6, 0x93, 0x54, 0xc9, 0x3c,
-		0xa0, 0x1d, 0xd9, 0x8c, 0xbc, 0xfc, 0xe8, 0xc8, 0x3c, 0xf7, 0x4d, 0xd3, 0xba, 0x6d, 0x92, 0x66,
-		0x77, 0xb6, 0x45, 0x8e, 0x7b, 0xae, 0x37, 0xc7, 0xfd, 0x02, 0x6e, 0xb5, 0xae, 0x13, 0xba, 0x06,
-		0x61, 0xd9, 0x4e, 0xb0, 0x53, 0xfd, 0xf0, 0xfd, 0x51, 0x98, 0xeb, 0x4b, 0x67, 0x73, 0x25, 0x18,
-		0x26, 0x84, 0x25, 0x48, 0x2d, 0x0b, 0xdd, 0x9a, 0x85, 0xa4, 0x83, 0x75, 0xcb, 0x6c, 0x3a, 0x54,
-		0x10, 0x31, 0x45, 0x3c, 0x12, 0x41, 0x98, 0x9a, 0x69, 0x39, 0xfc, 0xb0, 0x32, 0x7b, 0x28, 0xff,
-		0xc8, 0x11, 0x05, 0x91, 0x13, 0x6f, 0x12, 0xd2, 0x78, 0x62, 0x4c, 0x69, 0x88, 0x4e, 0x84, 0x32,
-		0xff, 0xe3, 0x4a, 0xe5, 0x07, 0xa3, 0x30, 0xdf, 0x2b, 0x15, 0x32, 0xb3, 0x1c, 0x57, 0x6b, 0x77,
-		0x86, 0x89, 0xe5, 0x32, 0xa4, 0x1b, 0x82, 0xe6, 0xc8, 0x72, 0xb9, 0x7b, 0x44, 0xb9, 0xe4, 0xbd,
-		0x57, 0x09, 0xc1, 0x5c, 0x18, 0x53, 0x30, 0x5e, 0x3f, 0xee, 0x49, 0x32, 0xff, 0x3b, 0x01, 0x27,
-		0xd9, 0x74, 0x52, 0xd9, 0x54, 0x62, 0x0f, 0x5c, 0x26, 0xd9, 0x60, 0xd5, 0xe8, 0x7d, 0x12, 0xf9,
-		0x3a, 0x4c, 0xd7, 0x88, 0xb5, 0x20, 0x51, 0x90, 0xbf, 0xc3, 0x33, 0xf0, 0x3c, 0xf7, 0x42, 0xc8,
-		0xe1, 0xe7, 0xfb, 0x5b, 0xc1, 0x22, 0xf9, 0xdb, 0x23, 0x20, 0xd5, 0x75, 0xad, 0xa5, 0xd9, 0x5f,
-		0x2b, 0x14, 0x7a, 0x1a, 0x80, 0x1d, 0xf7, 0xf0, 0x3e, 0xdc, 0xcb, 0x5f, 0x98, 0x5d, 0x0c, 0x76,
-		0x6e, 0x91, 0xbd, 0x89, 0x9e, 0xa0, 0x4a, 0x53, 0x5a, 0xf2, 0xf3, 0xdc, 0x8b, 0x00, 0x7e, 0x05,
-		0x3a, 0x05, 0x27, 0xea, 0x95, 0xd2, 0x6a, 0x49, 0x11, 0x87, 0x84, 0xea, 0x9b, 0xd5, 0x4a, 0xed,
-		0x4a, 0xad, 0xba, 0x2c, 0x4d, 0xa0, 0xe3, 0x80, 0x82, 0x95, 0xde, 0xa1, 0xa6, 0x63, 0x30, 0x15,
-		0x2c, 0x67, 0x5f, 0xa9, 0x44, 0x89, 0xa7, 0x68, 0xb4, 0x3b, 0x2d, 0x4c, 0x77, 0x1e, 0x55, 0x43,
-		0x48, 0x6d, 0xb4, 0x13, 0xf2, 0xdb, 0xff, 0x91, 0x7d, 0xb9, 0x30, 0xed, 0xb3, 0x7b, 0x32, 0x5f,
-		0x5a, 0x85, 0x29, 0x4d, 0xd7, 0x71, 0x27, 0x04, 0x39, 0xc2, 0x54, 0x13, 0x40, 0xba, 0x97, 0xca,
-		0x39, 0x7d, 0xb4, 0xa7, 0x21, 0xe1, 0xd0, 0xde, 0x8f, 0x82, 0xf8, 0x1d, 0x0e, 0xc1, 0xc9, 0x97,
-		0x4c, 0x98, 0x22, 0x9e, 0x9f, 0x66, 0xe3, 0x40, 0x33, 0x0e, 0xcf, 0x33, 0xfc, 0xf3, 0x5f, 0x79,
-		0x9c, 0xee, 0xac, 0x3e, 0x10, 0x1e, 0x96, 0x01, 0xea, 0xa4, 0x48, 0x1c, 0xdb, 0x6f, 0x28, 0x86,
-		0xbc, 0x78, 0x1f, 0x6f, 0xf0, 0xe1, 0x2f, 0xfb, 0x75, 0xfe, 0xb2, 0xb9, 0x41, 0x3a, 0x10, 0x78,
-		0x53, 0x8e, 0xa3, 0xb2, 0x8a, 0x72, 0x75, 0xd8, 0x9c, 0x7e, 0xf9, 0x91, 0xfe, 0xd5, 0x89, 0xfd,
-		0xf7, 0x18, 0x45, 0xbe, 0x1c, 0x7c, 0x8d, 0x37, 0xf7, 0xfe, 0x20, 0x06, 0x53, 0x5a, 0xdb, 0x30,
-		0xad, 0xf3, 0xf4, 0x5f, 0x3e, 0xe7, 0x26, 0xe9, 0xc3, 0x18, 0x9b, 0x92, 0x97, 0xd8, 0x54, 0x18,
-		0xad, 0x31, 0x7f, 0xf1, 0x3d, 0x3f, 0x3d, 0xe9, 0x4f, 0x97, 0xa5, 0x35, 0x90, 0xc4, 0x21, 0x5e,
-		0x6c, 0xea, 0x56, 0x73, 0xac, 0x2c, 0xc5, 0x57, 0x04, 0x86, 0xc8, 0x6f, 0x55, 0x39, 0xeb, 0xd2,
-		0x7b, 0x21, 0xe5, 0xc1, 0x8c, 0xf2, 0x4c, 0x04, 0x88, 0xc7, 0x41, 0xfc, 0x12, 0x36, 0x33, 0xc7,
-		0xf1, 0x42, 0xdf, 0x12, 0xfc, 0x6c, 0x86, 0xae, 0x93, 0xde, 0xac, 0x40, 0xbe, 0x69, 0x99, 0xae,
-		0x6a, 0xb5, 0x0d, 0x17, 0xb7, 0x3b, 0xee, 0x48, 0xbf, 0xee, 0x2f, 0x19, 0x48, 0x4a, 0xc9, 0x11,
-		0xbe, 0x0d, 0xc1, 0x76, 0x4f, 0x83, 0xeb, 0x34, 0x6f, 0x72, 0x5b, 0xec, 0xee, 0xb3, 0x41, 0xf4,
-		0x06, 0xf7, 0xcf, 0x62, 0x30, 0xc7, 0x89, 0xb7, 0x35, 0x07, 0x9f, 0xbf, 0xf5, 0xc4, 0x36, 0x76,
-		0xb5, 0x27, 0xce, 0xeb, 0x96, 0x21, 0x16, 0xe2, 0x69, 0x6e, 0x6b, 0x49, 0xfd, 0x22, 0xaf, 0x2f,
-		0x0e, 0xdc, 0xad, 0x2e, 0x0e, 0xb7, 0xd1, 0xc5, 0x7e, 0x15, 0x92, 0x5f, 0x86, 0x78, 0xc5, 0x32,
-		0x4c, 0xb2, 0x34, 0x35, 0xb1, 0x69, 0xb5, 0xb9, 0xb5, 0x64, 0x0f, 0xe8, 0x12, 0x24, 0xb4, 0xb6,
-		0xd5, 0x35, 0x5d, 0x66, 0x29, 0xcb, 0x73, 0x9f, 0x79, 0x63, 0x7e, 0xe2, 0x0f, 0xde, 0x98, 0x8f,
-		0xd5, 0x4c, 0xf7, 0xf7, 0x3e, 0xf1, 0x18, 0x70, 0xf4, 0x9a, 0xe9, 0x7e, 0xfc, 0xf3, 0xbf, 0x78,
-		0x2e, 0xa2, 0x70, 0xea, 0xa5, 0xf8, 0x17, 0x3e, 0x3a, 0x1f, 0x91, 0x5f, 0x84, 0xe4, 0x32, 0xd6,
-		0x0f, 0x81, 0x7f, 0xa2, 0x07, 0xfe, 0xa4, 0x80, 0x5f, 0xc6, 0x7a, 0x00, 0x7e, 0x19, 0xeb, 0x3d,
-		0xc8, 0x4f, 0x43, 0xaa, 0x66, 0xba, 0xec, 0x0b, 0xa0, 0x47, 0x20, 0x66, 0x98, 0xec, 0x50, 0x79,
-		0x00, 0xa1, 0xaf, 0x81, 0x0a, 0xa1, 0x22, 0x8c, 0xcb, 0x58, 0xf7, 0x18, 0x9b, 0x58, 0xef, 0x65,
-		0xec, 0x7f, 0x35, 0xa1, 0x2a, 0x2f, 0xff, 0xfe, 0x1f, 0xcf, 0x4d, 0xbc, 0xf6, 0xe6, 0xdc, 0xc4,
-		0xd0, 0xa1, 0x97, 0x47, 0x0f, 0xbd, 0x37, 0xe2, 0x3f, 0x1d, 0x87, 0xfb, 0xe9, 0x87, 0xa1, 0x76,
-		0xdb, 0x30, 0xdd, 0xf3, 0xba, 0x7d, 0xd0, 0x71, 0x2d, 0x32, 0x7f, 0xad, 0x1d, 0x3e, 0xe0, 0x53,
-		0x7e, 0xf5, 0x22, 0xab, 0x1e, 0x3c, 0xdc, 0xf2, 0x0e, 0x4c, 0x6e, 0x12, 0x3e, 0x22, 0x62, 0xd7,
-		0x72, 0xb5, 0x16, 0x77, 0x3a, 0xd8, 0x03, 0x29, 0x65, 0x1f, 0x93, 0x46, 0x59, 0xa9, 0x21, 0xbe,
-		0x23, 0x6d, 0x61, 0x6d, 0x87, 0x7d, 0x93, 0x13, 0xa3, 0xde, 0x6a, 0x8a, 0x14, 0xd0, 0xcf, 0x6f,
-		0x66, 0x60, 0x52, 0xeb, 0xb2, 0x73, 0x33, 0x31, 0xe2, 0xc6, 0xd2, 0x07, 0xf9, 0x3a, 0x24, 0xf9,
-		0xf6, 0x39, 0x92, 0x20, 0x76, 0x13, 0x1f, 0xd0, 0xf7, 0x64, 0x15, 0xf2, 0x13, 0x2d, 0xc2, 0x24,
-		0x6d, 0x3c, 0xff, 0xd8, 0x70, 0x76, 0xb1, 0xaf, 0xf5, 0x8b, 0xb4, 0x91, 0x0a, 0x23, 0x93, 0xaf,
-		0x41, 0x6a, 0xd9, 0x22, 0x5a, 0x18, 0x46, 0x4b, 0x33, 0x34, 0xda, 0xe6, 0x4e, 0x97, 0x6b, 0x85,
-		0xc2, 0x1e, 0xd0, 0x71, 0x48, 0xb0, 0x6f, 0xb4, 0xf8, 0xd9, 0x1f, 0xfe, 0x24, 0x57, 0x20, 0x49,
-		0xb1, 0x37, 0x3a, 0x64, 0xc5, 0xf7, 0x8e, 0xad, 0xa7, 0xf9, 0x17, 0xbb, 0x1c, 0x3e, 0xea, 0x37,
-		0x16, 0x41, 0xbc, 0xa9, 0xb9, 0x1a, 0xef, 0x37, 0xfd, 0x2d, 0xbf, 0x0f, 0x52, 0x1c, 0xc4, 0x41,
-		0x17, 0x20, 0x66, 0x75, 0x1c, 0x7e, 0x7a, 0xa7, 0x38, 0xac, 0x2b, 0x1b, 0x9d, 0x72, 0x9c, 0xe8,
-		0x8c, 0x42, 0x88, 0xcb, 0xeb, 0x43, 0xd5, 0xe2, 0xa9, 0x90, 0x5a, 0xb4, 0xb1, 0xbb, 0xbd, 0xe3,
-		0xfa, 0x3f, 0xd8, 0x70, 0xf6, 0xa9, 0x82, 0xa7, 0x28, 0x77, 0xa3, 0x30, 0x17, 0xa8, 0xbd, 0x85,
-		0x6d, 0xc7, 0xb0, 0x4c, 0xa6, 0x4d, 0x5c, 0x53, 0x50, 0xa0, 0x81, 0xbc, 0x7e, 0x88, 0xaa, 0x3c,
-		0x07, 0xb1, 0x52, 0xa7, 0x83, 0x8a, 0x90, 0xa2, 0xcf, 0xba, 0xc5, 0x74, 0x25, 0xae, 0x78, 0xcf,
-		0xa4, 0xce, 0xb1, 0x76, 0xdc, 0xdb, 0x9a, 0xed, 0x7d, 0xc2, 0x2c, 0x9e, 0xe5, 0x67, 0x21, 0x5d,
-		0xb1, 0x4c, 0x07, 0x9b, 0x4e, 0x97, 0xba, 0xb2, 0xdb, 0x2d, 0x4b, 0xbf, 0xc9, 0x11, 0xd8, 0x03,
-		0x11, 0xb6, 0xd6, 0xe9, 0x50, 0xce, 0xb8, 0x42, 0x7e, 0xb2, 0xf9, 0x5a, 0xde, 0x18, 0x2a, 0x9e,
-		0x8b, 0x47, 0x13, 0x0f, 0xef, 0xa0, 0xef, 0x93, 0x46, 0xe0, 0xbe, 0xfe, 0x89, 0x74, 0x13, 0x1f,
-		0x38, 0x47, 0x9d, 0x47, 0x2f, 0x42, 0x7a, 0x93, 0xde, 0x2e, 0x72, 0x1d, 0x1f, 0xa0, 0x22, 0x24,
-		0x71, 0xf3, 0xc2, 0xc5, 0x8b, 0x4f, 0x3c, 0xcb, 0xb4, 0xfc, 0xea, 0x84, 0x22, 0x0a, 0xd0, 0x1c,
-		0xa4, 0x1d, 0xac, 0x77, 0x2e, 0x5c, 0xbc, 0x74, 0xf3, 0x09, 0xa6, 0x56, 0x57, 0x27, 0x14, 0xbf,
-		0x68, 0x29, 0x45, 0x7a, 0xfc, 0x85, 0x8f, 0xcd, 0x47, 0xca, 0x93, 0x10, 0x73, 0xba, 0xed, 0x77,
-		0x4c, 0x37, 0x7e, 0x60, 0x12, 0x16, 0x02, 0xb5, 0x6c, 0x71, 0xb9, 0xa5, 0xb5, 0x8c, 0xa6, 0xe6,
-		0xdf, 0x09, 0x23, 0x05, 0xfa, 0x4f, 0x29, 0x86, 0xac, 0x1a, 0x87, 0x4a, 0x51, 0xfe, 0xe5, 0x08,
-		0x64, 0x6f, 0x08, 0xe4, 0x3a, 0x76, 0xd1, 0x65, 0x00, 0xef, 0x4d, 0x62, 0xaa, 0x9c, 0x5a, 0xec,
-		0x7d, 0xd7, 0xa2, 0xc7, 0xa3, 0x04, 0xc8, 0xd1, 0xd3, 0x54, 0x01, 0x3b, 0x96, 0xc3, 0x3f, 0x67,
-		0x1d, 0xc1, 0xea, 0x11, 0xa3, 0x47, 0x01, 0x51, 0xab, 0xa6, 0xde, 0xb2, 0x5c, 0xc3, 0xdc, 0x55,
-		0x3b, 0xd6, 0x6d, 0x7e, 0x49, 0x40, 0x4c, 0x91, 0x68, 0xcd, 0x0d, 0x5a, 0xb1, 0x49, 0xca, 0x49,
-		0xa3, 0xd3, 0x1e, 0x0a, 0x89, 0xca, 0xb4, 0x66, 0xd3, 0xc6, 0x8e, 0xc3, 0x0d, 0x97, 0x78, 0x44,
-		0x97, 0x21, 0xd9, 0xe9, 0x6e, 0xab, 0xc2, 0x4a, 0x64, 0x2e, 0xdc, 0x37, 0x68, 0xce, 0x0b, 0xdd,
-		0xe0, 0xb3, 0x3e, 0xd1, 0xe9, 0x6e, 0x13, 0x4d, 0x79, 0x00, 0xb2, 0x03, 0x1a, 0x93, 0xb9, 0xe5,
-		0xb7, 0x83, 0x5e, 0x68, 0xc3, 0x7b, 0xa0, 0x76, 0x6c, 0xc3, 0xb2, 0x0d, 0xf7, 0x80, 0x9e, 0xba,
-		0x8b, 0x29, 0x92, 0xa8, 0xd8, 0xe4, 0xe5, 0xf2, 0x4d, 0x28, 0xd4, 0xa9, 0xb7, 0xee, 0xb7, 0xfc,
-		0xa2, 0xdf, 0xbe, 0xc8, 0xe8, 0xf6, 0x0d, 0x6d, 0x59, 0xb4, 0xaf, 0x65, 0xe5, 0xb5, 0xa1, 0x9a,
-		0xf9, 0xe4, 0xd1, 0x34, 0x33, 0xbc, 0xba, 0x7d, 0xe9, 0x64, 0x68, 0x52, 0x72, 0xaf, 0x27, 0x60,
-		0xb2, 0xc6, 0x55, 0xca, 0x51, 0x81, 0x78, 0xf1, 0xf0, 0x45, 0xb4, 0x38, 0xc2, 0x74, 0x16, 0x47,
-		0x4e, 0x1f, 0xf9, 0x59, 0xc8, 0x6d, 0x6a, 0xb6, 0x5b, 0xc7, 0xee, 0x55, 0xac, 0x35, 0xb1, 0x1d,
-		0x5e, 0x65, 0x73, 0x62, 0x95, 0x45, 0x10, 0xa7, 0x4b, 0x29, 0x5b, 0x65, 0xe8, 0x6f, 0x79, 0x0f,
-		0xe2, 0xf4, 0xd8, 0xad, 0xb7, 0x02, 0x73, 0x0e, 0xb6, 0x02, 0x13, 0xfb, 0x79, 0xe0, 0x62, 0x47,
-		0xe4, 0x8a, 0xe8, 0x03, 0x7a, 0x4a, 0xac, 0xa3, 0xb1, 0xc3, 0xd7, 0x51, 0xae, 0x84, 0x7c, 0x35,
-		0x6d, 0x41, 0xb2, 0x4c, 0xcc, 0x6f, 0x6d, 0xd9, 0x6b, 0x48, 0xc4, 0x6f, 0x08, 0x5a, 0x83, 0x42,
-		0x47, 0xb3, 0x5d, 0xfa, 0x19, 0xde, 0x1e, 0xed, 0x05, 0xd7, 0xf3, 0xf9, 0xfe, 0x59, 0x17, 0xea,
-		0x2c, 0x7f, 0x4b, 0xae, 0x13, 0x2c, 0x94, 0xff, 0x6b, 0x1c, 0x12, 0x5c, 0x18, 0xcf, 0x41, 0x92,
-		0x8b, 0x95, 0x6b, 0xe6, 0xfd, 0x8b, 0xfd, 0x8b, 0xd1, 0xa2, 0xb7, 0x68, 0x70, 0x3c, 0xc1, 0x83,
-		0x1e, 0x86, 0x94, 0xbe, 0xa7, 0x19, 0xa6, 0x6a, 0x34, 0xb9, 0x03, 0x98, 0x79, 0xf3, 0x8d, 0xf9,
-		0x64, 0x85, 0x94, 0xd5, 0x96, 0x95, 0x24, 0xad, 0xac, 0x35, 0xc9, 0xca, 0xbf, 0x87, 0x8d, 0xdd,
-		0x3d, 0x97, 0xcf, 0x2e, 0xfe, 0x84, 0x9e, 0x81, 0x38, 0x51, 0x08, 0xfe, 0x91, 0x76, 0xb1, 0xcf,
-		0x97, 0xf7, 0xf2, 0x24, 0xe5, 0x14, 0x79, 0xf1, 0x47, 0xfe, 0xcb, 0x7c, 0x44, 0xa1, 0x1c, 0xa8,
-		0x02, 0xb9, 0x96, 0xe6, 0xb8, 0x2a, 0x5d, 0xb5, 0xc8, 0xeb, 0x27, 0x29, 0xc4, 0xc9, 0x7e, 0x81,
-		0x70, 0xc1, 0xf2, 0xa6, 0x67, 0x08, 0x17, 0x2b, 0x6a, 0xa2, 0x33, 0x20, 0x51, 0x10, 0xdd, 0x6a,
-		0xb7, 0x0d, 0x97, 0xf9, 0x52, 0x09, 0x2a, 0xf7, 0x3c, 0x29, 0xaf, 0xd0, 0x62, 0xea, 0x51, 0x9d,
-		0x82, 0x34, 0xfd, 0x2c, 0x94, 0x92, 0xb0, 0xb3, 0xde, 0x29, 0x52, 0x40, 0x2b, 0x4f, 0x43, 0xc1,
-		0xb7, 0x8d, 0x8c, 0x24, 0xc5, 0x50, 0xfc, 0x62, 0x4a, 0xf8, 0x38, 0xcc, 0x98, 0x78, 0x9f, 0x9e,
-		0x3e, 0x0f, 0x51, 0xa7, 0x29, 0x35, 0x22, 0x75, 0x37, 0xc2, 0x1c, 0x0f, 0x41, 0x5e, 0x17, 0xc2,
-		0x67, 0xb4, 0x40, 0x69, 0x73, 0x5e, 0x29, 0x25, 0x3b, 0x09, 0x29, 0xad, 0xd3, 0x61, 0x04, 0x19,
-		0x6e, 0x1b, 0x3b, 0x1d, 0x5a, 0x75, 0x0e, 0xa6, 0x68, 0x1f, 0x6d, 0xec, 0x74, 0x5b, 0x2e, 0x07,
-		0xc9, 0x52, 0x9a, 0x02, 0xa9, 0x50, 0x58, 0x39, 0xa5, 0x7d, 0x10, 0x72, 0xf8, 0x96, 0xd1, 0xc4,
-		0xa6, 0x8e, 0x19, 0x5d, 0x8e, 0xd2, 0x65, 0x45, 0x21, 0x25, 0x3a, 0x0b, 0x9e, 0xcd, 0x53, 0x85,
-		0x3d, 0xce, 0x33, 0x3c, 0x51, 0x5e, 0x62, 0xc5, 0xf2, 0x2c, 0xc4, 0x97, 0x35, 0x57, 0x23, 0x4e,
-		0x85, 0xbb, 0xcf, 0x16, 0x99, 0xac, 0x42, 0x7e, 0xca, 0x5f, 0x88, 0x42, 0xfc, 0x86, 0xe5, 0x62,
-		0xf4, 0x64, 0xc0, 0xe1, 0xcb, 0x0f, 0xd2, 0xe7, 0xba, 0xb1, 0x6b, 0xe2, 0xe6, 0x9a, 0xb3, 0x1b,
-		0xb8, 0xc3, 0xc5, 0x57, 0xa7, 0x68, 0x48, 0x9d, 0x66, 0x60, 0xd2, 0xb6, 0xba, 0x66, 0x53, 0x9c,
-		0x92, 0xa6, 0x0f, 0xa8, 0x0a, 0x29, 0x4f, 0x4b, 0xe2, 0xa3, 0xb4, 0xa4, 0x40, 0xb4, 0x84, 0xe8,
-		0x30, 0x2f, 0x50, 0x92, 0xdb, 0x5c, 0x59, 0xca, 0x90, 0xf6, 0x8c, 0x17, 0xd7, 0xb6, 0xf1, 0x14,
-		0xd6, 0x67, 0x23, 0x0b, 0x89, 0x37, 0xf6, 0x9e, 0xf0, 0x98, 0xc6, 0x49, 0x5e, 0x05, 0x97, 0x5e,
-		0x48, 0xad, 0xf8, 0x7d, 0x32, 0x49, 0xda, 0x2f, 0x5f, 0xad, 0xd8, 0x9d, 0x32, 0xf7, 0x41, 0xda,
-		0x31, 0x76, 0x4d, 0xcd, 0xed, 0xda, 0x98, 0x6b, 0x9e, 0x5f, 0x20, 0xff, 0x46, 0x04, 0x12, 0x4c,
-		0x93, 0x03, 0x72, 0x8b, 0x0c, 0x96, 0x5b, 0x74, 0x98, 0xdc, 0x62, 0xf7, 0x2e, 0xb7, 0x12, 0x80,
-		0xd7, 0x18, 0x87, 0x5f, 0xf3, 0x31, 0xc0, 0x5b, 0x60, 0x4d, 0xac, 0x1b, 0xbb, 0x7c, 0xa2, 0x06,
-		0x98, 0xe4, 0xff, 0x1c, 0x21, 0x8e, 0x2b, 0xaf, 0x47, 0x25, 0xc8, 0x89, 0x76, 0xa9, 0x3b, 0x2d,
-		0x6d, 0x97, 0xeb, 0xce, 0xfd, 0x43, 0x1b, 0x77, 0xa5, 0xa5, 0xed, 0x2a, 0x19, 0xde, 0x1e, 0xf2,
-		0x30, 0x78, 0x1c, 0xa2, 0x43, 0xc6, 0x21, 0x34, 0xf0, 0xb1, 0x7b, 0x1b, 0xf8, 0xd0, 0x10, 0xc5,
-		0x7b, 0x87, 0xe8, 0x57, 0xa2, 0x34, 0x78, 0xe9, 0x58, 0x8e, 0xd6, 0x7a, 0x37, 0x66, 0xc4, 0x29,
-		0x48, 0x77, 0xac, 0x96, 0xca, 0x6a, 0xd8, 0xd7, 0x03, 0xa9, 0x8e, 0xd5, 0x52, 0xfa, 0x86, 0x7d,
-		0xf2, 0x6d, 0x9a, 0x2e, 0x89, 0xb7, 0x41, 0x6a, 0xc9, 0x5e, 0xa9, 0xd9, 0x90, 0x65, 0xa2, 0xe0,
-		0x6b, 0xd9, 0xe3, 0x44, 0x06, 0x74, 0x71, 0x8c, 0xf4, 0xaf, 0xbd, 0xac, 0xd9, 0x8c, 0x52, 0xe1,
-		0x74, 0x84, 0x83, 0x99, 0xfe, 0x41, 0x51, 0x6f, 0x50, 0x2d, 0x15, 0x4e, 0x27, 0xff, 0x40, 0x04,
-		0x60, 0x95, 0x48, 0x96, 0xf6, 0x97, 0xac, 0x42, 0x0e, 0x6d, 0x82, 0x1a, 0x7a, 0xf3, 0xdc, 0xb0,
-		0x41, 0xe3, 0xef, 0xcf, 0x3a, 0xc1, 0x76, 0x57, 0x20, 0xe7, 0x2b, 0xa3, 0x83, 0x45, 0x63, 0xe6,
-		0x0e, 0xf1, 0xa8, 0xeb, 0xd8, 0x55, 0xb2, 0xb7, 0x02, 0x4f, 0xf2, 0xbf, 0x8c, 0x40, 0x9a, 0xb6,
-		0x69, 0x0d, 0xbb, 0x5a, 0x68, 0x0c, 0x23, 0xf7, 0x3e, 0x86, 0xf7, 0x03, 0x30, 0x18, 0xc7, 0x78,
-		0x15, 0x73, 0xcd, 0x4a, 0xd3, 0x92, 0xba, 0xf1, 0x2a, 0x46, 0x97, 0x3c, 0x81, 0xc7, 0x0e, 0x17,
-		0xb8, 0xf0, 0xb8, 0xb9, 0xd8, 0x4f, 0x40, 0x92, 0x5e, 0x8b, 0xb7, 0xef, 0x70, 0x27, 0x3a, 0x61,
-		0x76, 0xdb, 0x8d, 0x7d, 0x47, 0x7e, 0x05, 0x92, 0x8d, 0x7d, 0x96, 0x0b, 0x39, 0x05, 0x69, 0xdb,
-		0xb2, 0xf8, 0x9a, 0xcc, 0x7c, 0xa1, 0x14, 0x29, 0xa0, 0x4b, 0x90, 0x88, 0xff, 0xa3, 0x7e, 0xfc,
-		0xef, 0x27, 0x30, 0x62, 0x63, 0x25, 0x30, 0xce, 0xfd, 0xa7, 0x08, 0x64, 0x02, 0xf6, 0x01, 0x3d,
-		0x01, 0xc7, 0xca, 0xab, 0x1b, 0x95, 0xeb, 0x6a, 0x6d, 0x59, 0xbd, 0xb2, 0x5a, 0x5a, 0xf1, 0x3f,
-		0x90, 0x2b, 0x1e, 0xbf, 0x73, 0x77, 0x01, 0x05, 0x68, 0xb7, 0x4c, 0xba, 0x19, 0x83, 0xce, 0xc3,
-		0x4c, 0x98, 0xa5, 0x54, 0xae, 0x57, 0xd7, 0x1b, 0x52, 0xa4, 0x78, 0xec, 0xce, 0xdd, 0x85, 0xa9,
-		0x00, 0x47, 0x69, 0xdb, 0xc1, 0xa6, 0xdb, 0xcf, 0x50, 0xd9, 0x58, 0x5b, 0xab, 0x35, 0xa4, 0x68,
-		0x1f, 0x03, 0x37, 0xd8, 0x67, 0x61, 0x2a, 0xcc, 0xb0, 0x5e, 0x5b, 0x95, 0x62, 0x45, 0x74, 0xe7,
-		0xee, 0x42, 0x3e, 0x40, 0xbd, 0x6e, 0xb4, 0x8a, 0xa9, 0x0f, 0xff, 0xc4, 0xdc, 0xc4, 0xc7, 0x7f,
-		0x72, 0x2e, 0x42, 0x7a, 0x96, 0x0b, 0xd9, 0x08, 0xf4, 0x28, 0x9c, 0xa8, 0xd7, 0x56, 0xd6, 0xab,
-		0xcb, 0xea, 0x5a, 0x7d, 0xa5, 0xe7, 0x9b, 0xe7, 0x62, 0xe1, 0xce, 0xdd, 0x85, 0x0c, 0xef, 0xd2,
-		0x30, 0xea, 0x4d, 0xa5, 0x7a, 0x63, 0xa3, 0x51, 0x95, 0x22, 0x8c, 0x7a, 0xd3, 0xc6, 0xb7, 0x2c,
-		0x97, 0xdd, 0xa8, 0xf9, 0x38, 0x9c, 0x1c, 0x40, 0xed, 0x75, 0x6c, 0xea, 0xce, 0xdd, 0x85, 0xdc,
-		0xa6, 0x8d, 0xd9, 0xfc, 0xa1, 0x1c, 0x8b, 0x30, 0xdb, 0xcf, 0xb1, 0xb1, 0xb9, 0x51, 0x2f, 0xad,
-		0x4a, 0x0b, 0x45, 0xe9, 0xce, 0xdd, 0x85, 0xac, 0x30, 0x86, 0x84, 0xde, 0xef, 0xd9, 0x3b, 0x15,
-		0xed, 0x7c, 0x57, 0x32, 0x94, 0xcb, 0x63, 0x71, 0x44, 0x47, 0xb3, 0xb5, 0xf6, 0x51, 0xc3, 0x9d,
-		0x11, 0xbb, 0xb1, 0xf2, 0x6b, 0x51, 0x28, 0x78, 0xce, 0xf4, 0x26, 0x7d, 0x03, 0x7a, 0x32, 0x98,
-		0x87, 0xc9, 0x0c, 0x5d, 0xc6, 0x18, 0xb5, 0x48, 0xd3, 0xbc, 0x17, 0x52, 0xc2, 0x29, 0xe3, 0xe6,
-		0x62, 0xa1, 0x9f, 0xaf, 0xca, 0x29, 0x38, 0xab, 0xc7, 0x81, 0x9e, 0x87, 0xb4, 0x67, 0x3c, 0xbc,
-		0x5b, 0xa7, 0x86, 0x5b, 0x1b, 0xce, 0xef, 0xf3, 0xa0, 0x67, 0xfd, 0xb0, 0x21, 0x3e, 0x2c, 0x10,
-		0xb9, 0xc1, 0x08, 0x38, 0xb3, 0xa0, 0x97, 0x6b, 0x7c, 0xda, 0xf1, 0xde, 0xd3, 0x1b, 0x3b, 0xf6,
-		0x55, 0x16, 0x49, 0x31, 0xaf, 0x24, 0xd5, 0xd6, 0xf6, 0xcb, 0x34, 0x98, 0x3a, 0x01, 0x49, 0x52,
-		0xb9, 0xcb, 0xbf, 0x09, 0x8f, 0x29, 0x89, 0xb6, 0xb6, 0xbf, 0xa2, 0x39, 0xd7, 0xe2, 0xa9, 0x98,
-		0x14, 0x97, 0x7f, 0x36, 0x02, 0xf9, 0x70, 0x1f, 0xd1, 0x23, 0x80, 0x08, 0x87, 0xb6, 0x8b, 0x55,
-		0x62, 0x62, 0xa8, 0xb0, 0x04, 0x6e, 0xa1, 0xad, 0xed, 0x97, 0x76, 0xf1, 0x7a, 0xb7, 0x4d, 0x1b,
-		0xe0, 0xa0, 0x35, 0x90, 0x04, 0xb1, 0x18, 0x27, 0x2e, 0xcc, 0x93, 0xfd, 0x77, 0x50, 0x72, 0x02,
-		0xb6, 0x50, 0xbd, 0x4e, 0x16, 0xaa, 0x3c, 0xc3, 0xf3, 0x76, 0xd3, 0x43, 0x5d, 0x89, 0x85, 0xbb,
-		0x22, 0x3f, 0x0f, 0x85, 0x1e, 0x79, 0x22, 0x19, 0x72, 0x3c, 0x2b, 0x40, 0x77, 0x2a, 0x99, 0x7f,
-		0x9c, 0x56, 0x32, 0x2c, 0xfa, 0xa7, 0x3b, 0xb7, 0x4b, 0xa9, 0x4f, 0x7d, 0x74, 0x3e, 0x42, 0x13,
-		0xe6, 0x8f, 0x40, 0x2e, 0x24, 0x51, 0x91, 0xa9, 0x8b, 0xf8, 0x99, 0x3a, 0x9f, 0xf8, 0x65, 0xc8,
-		0x12, 0x43, 0x89, 0x9b, 0x9c, 0xf6, 0x61, 0x28, 0x30, 0x43, 0xde, 0x2b, 0x6b, 0xe6, 0x49, 0xad,
-		0x09, 0x81, 0xcb, 0xc2, 0xb5, 0x0a, 0x8b, 0x3d, 0x23, 0xa8, 0x56, 0x34, 0xa7, 0xfc, 0xfe, 0x8f,
-		0xbf, 0x39, 0x17, 0x79, 0x67, 0x26, 0xe2, 0xef, 0xbe, 0x1f, 0x4e, 0x05, 0x2a, 0xb5, 0x6d, 0xdd,
-		0x08, 0x65, 0x1d, 0x0a, 0x01, 0x25, 0x23, 0x95, 0xa3, 0xb2, 0x07, 0x87, 0xe6, 0x30, 0x0e, 0x4f,
-		0x98, 0x15, 0x0f, 0xb7, 0x08, 0xa3, 0x13, 0x1b, 0x83, 0x73, 0x94, 0xff, 0x27, 0x05, 0x49, 0x05,
-		0x7f, 0xb0, 0x8b, 0x1d, 0x17, 0x5d, 0x80, 0x38, 0xd6, 0xf7, 0xac, 0x41, 0x29, 0x21, 0xd2, 0xb9,
-		0x45, 0x4e, 0x57, 0xd5, 0xf7, 0xac, 0xab, 0x13, 0x0a, 0xa5, 0x45, 0x17, 0x61, 0x72, 0xa7, 0xd5,
-		0xe5, 0x79, 0x8a, 0x1e, 0x63, 0x11, 0x64, 0xba, 0x42, 0x88, 0xae, 0x4e, 0x28, 0x8c, 0x9a, 0xbc,
-		0x8a, 0xde, 0xf3, 0x1b, 0x3b, 0xfc, 0x55, 0x35, 0x73, 0x87, 0xbe, 0x8a, 0xd0, 0xa2, 0x32, 0x80,
-		0x61, 0x1a, 0xae, 0x4a, 0x63, 0x78, 0xee, 0x09, 0x3e, 0x30, 0x9c, 0xd3, 0x70, 0x69, 0xd4, 0x7f,
-		0x75, 0x42, 0x49, 0x1b, 0xe2, 0x81, 0x34, 0xf7, 0x83, 0x5d, 0x6c, 0x1f, 0x70, 0x07, 0x70, 0x68,
-		0x73, 0xdf, 0x4f, 0x88, 0x48, 0x73, 0x29, 0x35, 0xaa, 0x42, 0x86, 0x7e, 0xe0, 0xca, 0xe6, 0x2f,
-		0xbf, 0x59, 0x56, 0x1e, 0xc6, 0x5c, 0x26, 0xa4, 0x74, 0x4a, 0x5f, 0x9d, 0x50, 0x60, 0xdb, 0x7b,
-		0x22, 0x46, 0x92, 0xdd, 0x3c, 0xe6, 0xee, 0xf3, 0xfb, 0x34, 0xe7, 0x87, 0x61, 0xd0, 0xeb, 0xc7,
-		0x1a, 0xfb, 0x57, 0x27, 0x94, 0xa4, 0xce, 0x7e, 0x92, 0xfe, 0x37, 0x71, 0xcb, 0xb8, 0x85, 0x6d,
-		0xc2, 0x9f, 0x3e, 0xbc, 0xff, 0xcb, 0x8c, 0x92, 0x22, 0xa4, 0x9b, 0xe2, 0x81, 0x18, 0x5a, 0x6c,
-		0x36, 0x79, 0x37, 0xa0, 0xdf, 0x4e, 0x87, 0xc6, 0xd9, 0x6c, 0x8a, 0x4e, 0xa4, 0x30, 0xff, 0x8d,
-		0x9e, 0xf1, 0x3c, 0xd4, 0x4c, 0xbf, 0x53, 0x18, 0xea, 0x00, 0xcb, 0x55, 0x4c, 0x08, 0x4f, 0x15,
-		0xad, 0x43, 0xbe, 0x65, 0x38, 0xae, 0xea, 0x98, 0x5a, 0xc7, 0xd9, 0xb3, 0x5c, 0x87, 0x06, 0xfd,
-		0x99, 0x0b, 0x0f, 0x0d, 0x43, 0x58, 0x35, 0x1c, 0xb7, 0x2e, 0x88, 0xaf, 0x4e, 0x28, 0xb9, 0x56,
-		0xb0, 0x80, 0xe0, 0x59, 0x3b, 0x3b, 0xd8, 0xf6, 0x00, 0x69, 0x72, 0xe0, 0x10, 0xbc, 0x0d, 0x42,
-		0x2d, 0xf8, 0x09, 0x9e, 0x15, 0x2c, 0x40, 0xdf, 0x0c, 0xd3, 0x2d, 0x4b, 0x6b, 0x7a, 0x70, 0xaa,
-		0xbe, 0xd7, 0x35, 0x6f, 0xd2, 0x4c, 0x42, 0xe6, 0xc2, 0xd9, 0xa1, 0x8d, 0xb4, 0xb4, 0xa6, 0x80,
-		0xa8, 0x10, 0x86, 0xab, 0x13, 0xca, 0x54, 0xab, 0xb7, 0x10, 0x7d, 0x00, 0x66, 0xb4, 0x4e, 0xa7,
-		0x75, 0xd0, 0x8b, 0x5e, 0xa0, 0xe8, 0xe7, 0x86, 0xa1, 0x97, 0x08, 0x4f, 0x2f, 0x3c, 0xd2, 0xfa,
-		0x4a, 0x51, 0x03, 0xa4, 0x8e, 0x8d, 0xe9, 0x37, 0x31, 0x1d, 0xee, 0xaa, 0xd0, 0x1b, 0x83, 0x32,
-		0x17, 0x4e, 0x0f, 0xc3, 0xde, 0x64, 0xf4, 0xc2, 0xb3, 0xb9, 0x3a, 0xa1, 0x14, 0x3a, 0xe1, 0x22,
-		0x86, 0x6a, 0xe9, 0x98, 0x5e, 0x68, 0xc6, 0x51, 0xa7, 0x46, 0xa1, 0x52, 0xfa, 0x30, 0x6a, 0xa8,
-		0xa8, 0x9c, 0xe4, 0x67, 0xda, 0xf8, 0x85, 0x2f, 0xa7, 0x21, 0x13, 0x30, 0x2c, 0x68, 0x16, 0x92,
-		0x7c, 0x8f, 0x5f, 0x9c, 0x85, 0xe3, 0x8f, 0x72, 0x1e, 0xb2, 0x41, 0x63, 0x22, 0x7f, 0x24, 0xe2,
-		0x71, 0xd2, 0x2f, 0xe5, 0x67, 0xc3, 0xa9, 0xc3, 0xb4, 0x9f, 0x15, 0x7c, 0x50, 0xac, 0x22, 0xa2,
-		0x9e, 0xed, 0x26, 0x65, 0x69, 0x21, 0x5f, 0xc4, 0xd0, 0x3c, 0x64, 0x3a, 0x17, 0x3a, 0x1e, 0x49,
-		0x8c, 0x92, 0x40, 0xe7, 0x42, 0x47, 0x10, 0x3c, 0x00, 0x59, 0xd2, 0x53, 0x35, 0xe8, 0x68, 0xa4,
-		0x95, 0x0c, 0x29, 0xe3, 0x24, 0xf2, 0xbf, 0x8d, 0x82, 0xd4, 0x6b, 0x80, 0xbc, 0x9c, 0x62, 0xe4,
-		0xc8, 0x39, 0xc5, 0x93, 0xbd, 0xd9, 0x4c, 0x3f, 0x81, 0xb9, 0x0a, 0x92, 0x9f, 0x87, 0x63, 0x0b,
-		0xc1, 0x70, 0xc7, 0xa9, 0xc7, 0xc3, 0x53, 0x0a, 0x7a, 0x8f, 0xcb, 0x77, 0x25, 0xb4, 0xf7, 0x22,
-		0x2e, 0xb2, 0xef, 0x1d, 0x62, 0xcf, 0x5f, 0xd8, 0xea, 0x34, 0x35, 0x17, 0x8b, 0xbc, 0x48, 0x60,
-		0x1b, 0xe6, 0x61, 0x28, 0x68, 0x9d, 0x8e, 0xea, 0xb8, 0x9a, 0x8b, 0xf9, 0xb2, 0x3e, 0xc9, 0xd2,
-		0x83, 0x5a, 0xa7, 0x53, 0x27, 0xa5, 0x6c, 0x59, 0x7f, 0x08, 0xf2, 0xc4, 0x26, 0x1b, 0x5a, 0x4b,
-		0xe5, 0x59, 0x82, 0x04, 0x5b, 0xfd, 0x79, 0xe9, 0x55, 0x5a, 0x28, 0x37, 0xbd, 0x11, 0xa7, 0xf6,
-		0xd8, 0x0b, 0xb3, 0x22, 0x81, 0x30, 0x0b, 0xf1, 0x1b, 0x0c, 0x98, 0x7c, 0xc4, 0xad, 0x0f, 0x83,
-		0xb3, 0xbb, 0x33, 0x34, 0x24, 0xbb, 0xc5, 0x12, 0x1e, 0x29, 0x85, 0x3d, 0xc8, 0x1f, 0x8a, 0xc2,
-		0x54, 0x9f, 0xe5, 0x1e, 0x98, 0xf6, 0xf6, 0xe3, 0xcb, 0xe8, 0x91, 0xe2, 0xcb, 0xeb, 0xe1, 0xb4,
-		0x6e, 0x60, 0xe5, 0x3b, 0xd5, 0x27, 0x64, 0x66, 0x37, 0x89, 0x42, 0x73, 0x90, 0x40, 0xe6, 0x97,
-		0xaa, 0xf9, 0x16, 0xcc, 0x6c, 0x1f, 0xbc, 0xaa, 0x99, 0xae, 0x61, 0x62, 0xb5, 0x6f, 0xd4, 0xfa,
-		0x97, 0xd2, 0x35, 0xc3, 0xd9, 0xc6, 0x7b, 0xda, 0x2d, 0xc3, 0x12, 0xcd, 0x9a, 0xf6, 0xf8, 0xfd,
-		0xe4, 0xae, 0xac, 0x40, 0x3e, 0xbc, 0xf4, 0xa0, 0x3c, 0x44, 0xdd, 0x7d, 0xde, 0xff, 0xa8, 0xbb,
-		0x8f, 0x1e, 0xe7, 0x79, 0xa0, 0x28, 0xcd, 0x03, 0xf5, 0xbf, 0x88, 0xf3, 0xf9, 0x49, 0x20, 0x59,
-		0xf6, 0x66, 0x83, 0xb7, 0x1c, 0xf5, 0xa2, 0xca, 0x67, 0xa1, 0xd0, 0xb3, 0xde, 0x0c, 0xcb, 0x0a,
-		0xca, 0x05, 0xc8, 0x85, 0x16, 0x17, 0xf9, 0x38, 0xcc, 0x0c, 0x5a, 0x2b, 0xe4, 0x3d, 0xaf, 0x3c,
-		0x64, 0xf3, 0xd1, 0x45, 0x48, 0x79, 0x8b, 0xc5, 0x80, 0x2c, 0x04, 0xed, 0x85, 0x20, 0x56, 0x3c,
-		0xd2, 0x50, 0x32, 0x3b, 0x1a, 0x4a, 0x66, 0xcb, 0xdf, 0x0a, 0xb3, 0xc3, 0x16, 0x82, 0x9e, 0x6e,
-		0xc4, 0x3d, 0x2d, 0x3c, 0x0e, 0x09, 0x7e, 0x6b, 0x61, 0x94, 0x6e, 0xdf, 0xf0, 0x27, 0xa2, 0x9d,
-		0x6c, 0x51, 0x88, 0xb1, 0x5d, 0x1d, 0xfa, 0x20, 0xab, 0x70, 0x72, 0xe8, 0x62, 0x30, 0x7c, 0x23,
-		0x88, 0x01, 0xf1, 0x8d, 0x20, 0x5d, 0x34, 0xc7, 0xa1, 0x7d, 0x15, 0x87, 0x1d, 0xd8, 0x93, 0xfc,
-		0x7a, 0x0c, 0x8e, 0x0f, 0x5e, 0x12, 0xd0, 0x02, 0x64, 0x89, 0xdf, 0xed, 0x86, 0x5d, 0x74, 0x68,
-		0x6b, 0xfb, 0x0d, 0xee, 0x9f, 0xf3, 0x44, 0x7a, 0xd4, 0x4b, 0xa4, 0xa3, 0x2d, 0x98, 0x6a, 0x59,
-		0xba, 0xd6, 0x52, 0x03, 0x1a, 0xcf, 0x95, 0xfd, 0xc1, 0x3e, 0x61, 0x57, 0xd9, 0x5d, 0xe6, 0xcd,
-		0x3e, 0xa5, 0x2f, 0x50, 0x8c, 0x55, 0x4f, 0xf3, 0xd1, 0x32, 0x64, 0xda, 0xbe, 0x22, 0x1f, 0x41,
-		0xd9, 0x83, 0x6c, 0x81, 0x21, 0x99, 0x1c, 0xb8, 0xed, 0x93, 0x38, 0xb2, 0x89, 0x1e, 0xb6, 0x83,
-		0x92, 0x1c, 0xba, 0x83, 0x32, 0x68, 0xbb, 0x22, 0x35, 0x78, 0xbb, 0xe2, 0xc3, 0xc1, 0xa1, 0x09,
-		0x2d, 0xa2, 0xfd, 0x3b, 0x18, 0xa8, 0x0e, 0x33, 0x9c, 0xbf, 0x19, 0x92, 0x7d, 0x74, 0x5c, 0x43,
-		0x83, 0x04, 0xfb, 0x70, 0xb1, 0xc7, 0xee, 0x4d, 0xec, 0xc2, 0x96, 0xc6, 0x03, 0xb6, 0xf4, 0xff,
-		0xb3, 0xa1, 0xf8, 0x0f, 0x69, 0x48, 0x29, 0xd8, 0xe9, 0x90, 0x85, 0x13, 0x95, 0x21, 0x8d, 0xf7,
-		0x75, 0xdc, 0x71, 0xfd, 0x6d, 0xca, 0x41, 0xc1, 0x00, 0xa3, 0xae, 0x0a, 0x4a, 0xe2, 0x89, 0x7b,
-		0x6c, 0xe8, 0x49, 0x1e, 0x6c, 0x0d, 0x8f, 0x9b, 0x38, 0x7b, 0x30, 0xda, 0xba, 0x24, 0xa2, 0xad,
-		0xd8, 0x50, 0xe7, 0x9b, 0x71, 0xf5, 0x84, 0x5b, 0x4f, 0xf2, 0x70, 0x2b, 0x3e, 0xe2, 0x65, 0xa1,
-		0x78, 0xab, 0x12, 0x8a, 0xb7, 0x12, 0x23, 0xba, 0x39, 0x24, 0xe0, 0xba, 0x24, 0x02, 0xae, 0xe4,
-		0x88, 0x16, 0xf7, 0x44, 0x5c, 0x57, 0xc2, 0x11, 0x57, 0x6a, 0x88, 0x01, 0x11, 0xdc, 0x43, 0x43,
-		0xae, 0xe7, 0x02, 0x21, 0x57, 0x7a, 0x68, 0xbc, 0xc3, 0x40, 0x06, 0xc4, 0x5c, 0x95, 0x50, 0xcc,
-		0x05, 0x23, 0x64, 0x30, 0x24, 0xe8, 0xfa, 0xa6, 0x60, 0xd0, 0x95, 0x19, 0x1a, 0xb7, 0xf1, 0xf1,
-		0x1e, 0x14, 0x75, 0x3d, 0xeb, 0x45, 0x5d, 0xd9, 0xa1, 0x61, 0x23, 0xef, 0x43, 0x6f, 0xd8, 0xb5,
-		0xd1, 0x17, 0x76, 0xe5, 0xf8, 0x9f, 0x09, 0x19, 0x06, 0x31, 0x22, 0xee, 0xda, 0xe8, 0x8b, 0xbb,
-		0xf2, 0x23, 0x00, 0x47, 0x04, 0x5e, 0x7f, 0x63, 0x70, 0xe0, 0x35, 0x3c, 0x34, 0xe2, 0xcd, 0x1c,
-		0x2f, 0xf2, 0x52, 0x87, 0x44, 0x5e, 0x2c, 0x3a, 0x7a, 0x64, 0x28, 0xfc, 0xd8, 0xa1, 0xd7, 0xd6,
-		0x80, 0xd0, 0x8b, 0x05, 0x49, 0x67, 0x86, 0x82, 0x8f, 0x11, 0x7b, 0x6d, 0x0d, 0x88, 0xbd, 0xd0,
-		0x48, 0xd8, 0xa3, 0x04, 0x5f, 0x93, 0x52, 0x42, 0x3e, 0x4b, 0x5c, 0xdf, 0x1e, 0x3b, 0x45, 0xfc,
-		0x07, 0x6c, 0xdb, 0x96, 0x2d, 0x4e, 0xd6, 0xd2, 0x07, 0xf9, 0x0c, 0x71, 0xc6, 0x7d, 0x9b, 0x74,
-		0x48, 0xa0, 0x46, 0xfd, 0xb4, 0x80, 0x1d, 0x92, 0x3f, 0x15, 0xf1, 0x79, 0xa9, 0x0f, 0x1b, 0x74,
-		0xe4, 0xd3, 0xdc, 0x91, 0x0f, 0x84, 0x6f, 0xd1, 0x70, 0xf8, 0x36, 0x0f, 0x19, 0xe2, 0x7f, 0xf5,
-		0x44, 0x66, 0x5a, 0xc7, 0x8b, 0xcc, 0xc4, 0x91, 0x02, 0x16, 0xe4, 0xf1, 0x65, 0x85, 0xed, 0xe4,
-		0x14, 0xbc, 0xe3, 0x15, 0x2c, 0xa6, 0x40, 0x8f, 0xc1, 0x74, 0x80, 0xd6, 0xf3, 0xeb, 0x58, 0x98,
-		0x22, 0x79, 0xd4, 0x25, 0xee, 0xe0, 0xfd, 0xab, 0x88, 0x2f, 0x21, 0x3f, 0xa4, 0x1b, 0x14, 0x7d,
-		0x45, 0xde, 0xa6, 0xe8, 0x2b, 0x7a, 0xcf, 0xd1, 0x57, 0xd0, 0x4f, 0x8d, 0x85, 0xfd, 0xd4, 0xff,
-		0x19, 0xf1, 0xc7, 0xc4, 0x8b, 0xa5, 0x74, 0xab, 0x89, 0xb9, 0xe7, 0x48, 0x7f, 0x13, 0xa7, 0xa2,
-		0x65, 0xed, 0x72, 0xff, 0x90, 0xfc, 0x24, 0x54, 0xde, 0xc2, 0x91, 0xe6, 0xeb, 0x82, 0xe7, 0x74,
-		0x4e, 0x06, 0xcf, 0xff, 0xf2, 0x43, 0xb1, 0x09, 0xff, 0x50, 0xac, 0xf7, 0xed, 0x5a, 0x32, 0xf0,
-		0xed, 0x1a, 0x7a, 0x06, 0xd2, 0x34, 0x23, 0xaa, 0x5a, 0x1d, 0xf1, 0x47, 0x65, 0x4e, 0x0d, 0x3f,
-		0x10, 0xeb, 0xd0, 0xa3, 0x7a, 0xec, 0x10, 0xad, 0xef, 0x31, 0xa4, 0x43, 0x1e, 0xc3, 0x7d, 0x90,
-		0x26, 0xad, 0x67, 0x97, 0xa3, 0x03, 0xff, 0xf0, 0x51, 0x14, 0xc8, 0x1f, 0x00, 0xd4, 0xbf, 0x48,
-		0xa0, 0xab, 0x90, 0xc0, 0xb7, 0xe8, 0xa5, 0x7c, 0xec, 0xa0, 0xe1, 0xf1, 0x7e, 0xd7, 0x94, 0x54,
-		0x97, 0x67, 0x89, 0x90, 0xbf, 0xf8, 0xc6, 0xbc, 0xc4, 0xa8, 0x1f, 0xf5, 0xce, 0xf9, 0x2b, 0x9c,
-		0x5f, 0xfe, 0xc3, 0x28, 0x09, 0x60, 0x42, 0x0b, 0xc8, 0x40, 0xd9, 0x0e, 0xda, 0x22, 0x1c, 0x4f,
-		0xde, 0x73, 0x00, 0xbb, 0x9a, 0xa3, 0xde, 0xd6, 0x4c, 0x17, 0x37, 0xb9, 0xd0, 0x03, 0x25, 0xa8,
-		0x08, 0x29, 0xf2, 0xd4, 0x75, 0x70, 0x93, 0x87, 0xd1, 0xde, 0x73, 0xa0, 0x9f, 0xc9, 0xaf, 0xad,
-		0x9f, 0x61, 0x29, 0xa7, 0x7a, 0xa4, 0x1c, 0x08, 0x2e, 0xd2, 0xc1, 0xe0, 0x82, 0x1d, 0x0c, 0xe6,
-		0xe7, 0x13, 0x81, 0xb5, 0x4d, 0x3c, 0xa3, 0x07, 0x21, 0xd7, 0xc6, 0xed, 0x8e, 0x65, 0xb5, 0x54,
-		0x66, 0x6e, 0xd8, 0x5f, 0x41, 0xc8, 0xf2, 0xc2, 0x2a, 0xb5, 0x3a, 0xdf, 0x19, 0xf5, 0xe7, 0x9f,
-		0x1f, 0x44, 0x7e, 0xc3, 0x09, 0x98, 0x5e, 0x1b, 0xdd, 0xeb, 0x22, 0xa0, 0x7a, 0xf0, 0xd4, 0x48,
-		0x97, 0x9a, 0x05, 0xa1, 0xd0, 0xe3, 0xda, 0x0f, 0xff, 0x74, 0x09, 0x2b, 0x76, 0xd0, 0x4b, 0x70,
-		0xa2, 0xc7, 0xb6, 0x79, 0xd0, 0xd1, 0x71, 0x4d, 0xdc, 0xb1, 0xb0, 0x89, 0x13, 0xd0, 0xbe, 0xb0,
-		0x62, 0x5f, 0xe3, 0xac, 0xab, 0x41, 0x3e, 0xec, 0xf1, 0x0c, 0x1c, 0x7e, 0xfa, 0x57, 0x77, 0x5c,
-		0xcd, 0x30, 0xd5, 0x50, 0x3a, 0x28, 0xcb, 0x0a, 0x79, 0x92, 0x69, 0x13, 0x8e, 0x0d, 0xf4, 0x7c,
-		0xd0, 0xd3, 0x90, 0xf6, 0x9d, 0x26, 0x26, 0xd5, 0x43, 0xd2, 0x05, 0x3e, 0xad, 0xfc, 0xeb, 0x11,
-		0x1f, 0x32, 0x9c, 0x80, 0xa8, 0x42, 0x82, 0x1d, 0x7b, 0xe3, 0x87, 0x69, 0x1e, 0x1b, 0xcf, 0x67,
-		0x5a, 0x64, 0x67, 0xe2, 0x14, 0xce, 0x2c, 0x7f, 0x00, 0x12, 0xac, 0x04, 0x65, 0x20, 0xe9, 0xdf,
-		0x9b, 0x0b, 0x90, 0x28, 0x55, 0x2a, 0xd5, 0x4d, 0x71, 0x09, 0x66, 0x79, 0x43, 0x69, 0x48, 0x51,
-		0x52, 0xac, 0x54, 0xaf, 0x55, 0x2b, 0x0d, 0x29, 0x86, 0xa6, 0x20, 0xc7, 0x7e, 0xab, 0x57, 0x36,
-		0x94, 0xb5, 0x52, 0x43, 0x8a, 0x07, 0x8a, 0xea, 0xd5, 0xf5, 0xe5, 0xaa, 0x22, 0x4d, 0xca, 0x4f,
-		0xc0, 0xc9, 0xa1, 0x5e, 0x96, 0x9f, 0x5d, 0x88, 0x04, 0xb2, 0x0b, 0xf2, 0xeb, 0x51, 0x28, 0x0e,
-		0x77, 0x9d, 0xd0, 0xb5, 0x9e, 0x8e, 0x5f, 0x38, 0x82, 0xdf, 0xd5, 0xd3, 0x7b, 0xf4, 0x10, 0xe4,
-		0x6d, 0xbc, 0x83, 0x5d, 0x7d, 0x8f, 0xb9, 0x72, 0x6c, 0xc9, 0xcc, 0x29, 0x39, 0x5e, 0x4a, 0x99,
-		0x1c, 0x46, 0xf6, 0x0a, 0xd6, 0x5d, 0x95, 0xd9, 0x22, 0x87, 0xff, 0x61, 0xd0, 0x1c, 0x2b, 0xad,
-		0xb3, 0x42, 0xf9, 0x5b, 0x8f, 0x24, 0xcb, 0x34, 0x4c, 0x2a, 0xd5, 0x86, 0xf2, 0x92, 0x14, 0x43,
-		0x08, 0xf2, 0xf4, 0xa7, 0x5a, 0x5f, 0x2f, 0x6d, 0xd6, 0xaf, 0x6e, 0x10, 0x59, 0x4e, 0x43, 0x41,
-		0xc8, 0x52, 0x14, 0x4e, 0xca, 0x8f, 0xc0, 0x89, 0x21, 0x7e, 0xdf, 0x80, 0x73, 0x88, 0x1f, 0x8b,
-		0x04, 0xa9, 0xc3, 0x31, 0xff, 0x06, 0x24, 0x1c, 0x57, 0x73, 0xbb, 0x0e, 0x17, 0xe2, 0xd3, 0xe3,
-		0x3a, 0x82, 0x8b, 0xe2, 0x47, 0x9d, 0xb2, 0x2b, 0x1c, 0x46, 0xbe, 0x08, 0xf9, 0x70, 0xcd, 0x70,
-		0x19, 0xf8, 0x4a, 0x14, 0x95, 0x5f, 0x02, 0x08, 0xe4, 0x23, 0xbd, 0x13, 0x5d, 0x91, 0xe0, 0x89,
-		0xae, 0x8b, 0x30, 0x79, 0xcb, 0x62, 0x36, 0x63, 0xf0, 0xc4, 0xb9, 0x61, 0xb9, 0x38, 0x90, 0x7c,
-		0x60, 0xd4, 0xb2, 0x01, 0xa8, 0x3f, 0x27, 0x34, 0xe4, 0x15, 0xcf, 0x85, 0x5f, 0xf1, 0xc0, 0xd0,
-		0xec, 0xd2, 0xe0, 0x57, 0xbd, 0x0a, 0x93, 0xd4, 0xda, 0x0c, 0xfc, 0xc4, 0xe7, 0x5b, 0x00, 0x34,
-		0xd7, 0xb5, 0x8d, 0xed, 0xae, 0xff, 0x82, 0xf9, 0xc1, 0xd6, 0xaa, 0x24, 0xe8, 0xca, 0xf7, 0x71,
-		0xb3, 0x35, 0xe3, 0xb3, 0x06, 0x4c, 0x57, 0x00, 0x50, 0x5e, 0x87, 0x7c, 0x98, 0x77, 0xf0, 0x27,
-		0x4b, 0xfe, 0xa7, 0xff, 0x69, 0xe1, 0x3e, 0x79, 0xce, 0x17, 0xbf, 0x8e, 0x83, 0x3e, 0xc8, 0x77,
-		0x22, 0x90, 0x6a, 0xec, 0x73, 0x3d, 0x3e, 0xe4, 0x50, 0xa5, 0xff, 0xdd, 0x96, 0x97, 0x2c, 0x64,
-		0xf9, 0xd8, 0x98, 0x97, 0xe5, 0xfd, 0x26, 0x6f, 0xa6, 0xc6, 0xc7, 0x8d, 0x76, 0x45, 0xb6, 0x9b,
-		0x5b, 0xa7, 0xcb, 0xe3, 0x7d, 0x23, 0x31, 0x03, 0x93, 0xc1, 0xef, 0x1b, 0xd8, 0x83, 0xdc, 0x0c,
-		0x1c, 0x4b, 0x60, 0xcb, 0x46, 0xf0, 0x63, 0x8a, 0xc8, 0x91, 0x3f, 0xa6, 0xf0, 0xde, 0x12, 0x0d,
-		0xbe, 0xe5, 0x16, 0xa4, 0x84, 0x52, 0xa0, 0xf7, 0x05, 0xcf, 0x9e, 0x88, 0x3d, 0x9a, 0xa1, 0x8b,
-		0x27, 0x87, 0x0f, 0x1c, 0x3d, 0x39, 0x07, 0x53, 0xfc, 0xc8, 0x9d, 0x1f, 0x57, 0xf0, 0xbf, 0x18,
-		0x50, 0x60, 0x15, 0xab, 0x22, 0xa8, 0x90, 0x7f, 0x2a, 0x02, 0x52, 0xaf, 0x56, 0xbe, 0x9b, 0x0d,
-		0x20, 0x46, 0x91, 0x68, 0x7f, 0xe0, 0xca, 0x6e, 0x36, 0xf2, 0x39, 0x52, 0xea, 0x5f, 0xda, 0xfd,
-		0xa1, 0x28, 0x64, 0x02, 0x39, 0x3d, 0xf4, 0x54, 0xe8, 0x08, 0xe8, 0xc2, 0x61, 0xf9, 0xbf, 0xc0,
-		0x19, 0xd0, 0x50, 0xc7, 0xa2, 0x47, 0xef, 0xd8, 0xdb, 0x7f, 0x48, 0x7f, 0xf0, 0xd7, 0x3e, 0x93,
-		0x43, 0xbe, 0xf6, 0xf9, 0x8e, 0x08, 0xa4, 0xbc, 0xa5, 0xfb, 0xa8, 0xd9, 0xfc, 0xe3, 0x90, 0xe0,
-		0xab, 0x13, 0x4b, 0xe7, 0xf3, 0xa7, 0x81, 0xb9, 0xd0, 0x22, 0xa4, 0xc4, 0xdf, 0x79, 0xe2, 0x81,
-		0xa8, 0xf7, 0x7c, 0xee, 0x59, 0xc8, 0x04, 0x36, 0x56, 0x88, 0x9d, 0x58, 0xaf, 0xbe, 0x20, 0x4d,
-		0x14, 0x93, 0x77, 0xee, 0x2e, 0xc4, 0xd6, 0xf1, 0x6d, 0x32, 0xc3, 0x94, 0x6a, 0xe5, 0x6a, 0xb5,
-		0x72, 0x5d, 0x8a, 0x14, 0x33, 0x77, 0xee, 0x2e, 0x24, 0x15, 0x4c, 0xd3, 0x57, 0xe7, 0xae, 0x43,
-		0xa1, 0x67, 0x60, 0xc2, 0xf6, 0x1d, 0x41, 0x7e, 0x79, 0x6b, 0x73, 0xb5, 0x56, 0x29, 0x35, 0xaa,
-		0x2a, 0x3b, 0x4e, 0x87, 0x4e, 0xc0, 0xf4, 0x6a, 0x6d, 0xe5, 0x6a, 0x43, 0xad, 0xac, 0xd6, 0xaa,
-		0xeb, 0x0d, 0xb5, 0xd4, 0x68, 0x94, 0x2a, 0xd7, 0xa5, 0xe8, 0x85, 0xaf, 0x02, 0x14, 0x4a, 0xe5,
-		0x4a, 0x8d, 0xac, 0xcf, 0x06, 0xbf, 0x75, 0xbd, 0x02, 0x71, 0x9a, 0x0a, 0x38, 0xf4, 0xa8, 0x48,
-		0xf1, 0xf0, 0xdc, 0x26, 0xba, 0x02, 0x93, 0x34, 0x4b, 0x80, 0x0e, 0x3f, 0x3b, 0x52, 0x1c, 0x91,
-		0xec, 0x24, 0x8d, 0xa1, 0xd3, 0xe9, 0xd0, 0xc3, 0x24, 0xc5, 0xc3, 0x73, 0x9f, 0x48, 0x81, 0xb4,
-		0x1f, 0x65, 0x8c, 0x3e, 0x5c, 0x51, 0x1c, 0xc3, 0x3a, 0xa2, 0x55, 0x48, 0x8a, 0xc0, 0x70, 0xd4,
-		0x71, 0x8f, 0xe2, 0xc8, 0xe4, 0x24, 0x11, 0x17, 0x0b, 0xe0, 0x0f, 0x3f, 0xbb, 0x52, 0x1c, 0x91,
-		0x69, 0x45, 0x35, 0xef, 0x10, 0xfe, 0x88, 0x23, 0x1c, 0xc5, 0x51, 0xc9, 0x46, 0x22, 0x34, 0x3f,
-		0x35, 0x32, 0xfa, 0x44, 0x4e, 0x71, 0x8c, 0x24, 0x32, 0xda, 0x02, 0x08, 0x84, 0xeb, 0x63, 0x1c,
-		0xb5, 0x29, 0x8e, 0x93, 0x1c, 0x46, 0x1b, 0x90, 0xf2, 0xa2, 0xa7, 0x91, 0x07, 0x5f, 0x8a, 0xa3,
-		0xb3, 0xb4, 0xe8, 0x03, 0x90, 0x0b, 0x47, 0x0d, 0xe3, 0x1d, 0x67, 0x29, 0x8e, 0x99, 0x7e, 0x25,
-		0xf8, 0xe1, 0x10, 0x62, 0xbc, 0xe3, 0x2d, 0xc5, 0x31, 0xb3, 0xb1, 0xe8, 0x15, 0x98, 0xea, 0x77,
-		0xf1, 0xc7, 0x3f, 0xed, 0x52, 0x3c, 0x42, 0x7e, 0x16, 0xb5, 0x01, 0x0d, 0x08, 0x0d, 0x8e, 0x70,
-		0xf8, 0xa5, 0x78, 0x94, 0x74, 0x2d, 0x6a, 0x42, 0xa1, 0xd7, 0xdf, 0x1e, 0xf7, 0x30, 0x4c, 0x71,
-		0xec, 0xd4, 0x2d, 0x7b, 0x4b, 0xd8, 0x4f, 0x1f, 0xf7, 0x70, 0x4c, 0x71, 0xec, 0x4c, 0x6e, 0xb9,
-		0x34, 0xf4, 0x7c, 0xe3, 0xe9, 0x43, 0xcf, 0x37, 0xfa, 0x27, 0x16, 0xbd, 0x33, 0x8d, 0xdf, 0xfe,
-		0x04, 0xbc, 0x87, 0x5f, 0x26, 0xe0, 0xb8, 0xda, 0x4d, 0xc3, 0xdc, 0xf5, 0x6e, 0x87, 0xe0, 0xcf,
-		0xfc, 0x70, 0xe3, 0x71, 0x7e, 0x61, 0x81, 0x28, 0x1d, 0x71, 0x47, 0xc4, 0xd0, 0x7b, 0xaf, 0x46,
-		0x1d, 0x42, 0x1e, 0x7d, 0x74, 0xf1, 0x90, 0xfb, 0x27, 0x46, 0xdc, 0x72, 0x31, 0xe0, 0x7e, 0x8a,
-		0x11, 0x87, 0x30, 0x0f, 0x3b, 0xef, 0x29, 0x7f, 0x7f, 0x04, 0xf2, 0x57, 0x0d, 0xc7, 0xb5, 0x6c,
-		0x43, 0xd7, 0x5a, 0x74, 0xc5, 0xb8, 0x3c, 0xee, 0x47, 0x1e, 0xe5, 0x34, 0x71, 0x46, 0xf8, 0xa5,
-		0x16, 0xfc, 0x60, 0xc8, 0x32, 0x24, 0x6e, 0x69, 0x2d, 0xf6, 0x89, 0x45, 0xf0, 0xfa, 0x99, 0x5e,
-		0x99, 0x07, 0xbc, 0xa4, 0x20, 0x0a, 0xe3, 0x95, 0x7f, 0x9e, 0x1e, 0xe2, 0x6e, 0xb7, 0x0d, 0x87,
-		0xfd, 0x25, 0x76, 0x17, 0x3b, 0x68, 0x13, 0xe2, 0xb6, 0xe6, 0xf2, 0xa0, 0xa6, 0xfc, 0x5e, 0x7e,
-		0x15, 0xc5, 0xc3, 0xa3, 0x2f, 0x94, 0x58, 0xec, 0xbf, 0xad, 0x82, 0x22, 0xa1, 0x17, 0x20, 0xd5,
-		0xd6, 0xf6, 0x55, 0x8a, 0x1a, 0x7d, 0x1b, 0x50, 0x93, 0x6d, 0x6d, 0x9f, 0xb4, 0x95, 0xcc, 0x20,
-		0x02, 0xac, 0xef, 0x69, 0xe6, 0x2e, 0x66, 0xf8, 0xb1, 0xb7, 0x01, 0x3f, 0xd7, 0xd6, 0xf6, 0x2b,
-		0x14, 0x93, 0xbc, 0x65, 0x29, 0xf5, 0xfa, 0x47, 0xe7, 0x27, 0xe8, 0x59, 0xe4, 0xdf, 0x8a, 0xf0,
-		0xf8, 0x95, 0x8a, 0x0b, 0x69, 0x20, 0xe9, 0xde, 0x13, 0x7d, 0xbd, 0xc8, 0xe5, 0x9f, 0x1e, 0x36,
-		0x1a, 0x3d, 0xc2, 0x2e, 0xe7, 0x48, 0x43, 0x3f, 0xfb, 0xc6, 0x7c, 0x84, 0x8d, 0x4b, 0x41, 0xef,
-		0x19, 0x8c, 0x6b, 0x90, 0x61, 0x29, 0x34, 0x95, 0xfa, 0xad, 0xd1, 0x91, 0x7e, 0x6b, 0x4e, 0xf8,
-		0xad, 0x0c, 0x10, 0x18, 0x37, 0xa9, 0x0f, 0xf4, 0xe3, 0xe7, 0x23, 0x90, 0x59, 0x0e, 0x5c, 0x33,
-		0x35, 0x0b, 0xc9, 0xb6, 0x65, 0x1a, 0x37, 0xb1, 0xed, 0x6d, 0xc8, 0xb0, 0x47, 0xe2, 0x5f, 0xb2,
-		0xbf, 0x56, 0xe4, 0x1e, 0x88, 0x2b, 0x18, 0xc4, 0x33, 0xe1, 0xba, 0x8d, 0xb7, 0x1d, 0x43, 0x48,
-		0x5d, 0x11, 0x8f, 0xe8, 0x2c, 0x48, 0x0e, 0xd6, 0xbb, 0xb6, 0xe1, 0x1e, 0xa8, 0xba, 0x65, 0xba,
-		0x9a, 0xee, 0xf2, 0xb4, 0x69, 0x41, 0x94, 0x57, 0x58, 0x31, 0x01, 0x69, 0x62, 0x57, 0x33, 0x5a,
-		0xec, 0xbc, 0x57, 0x5a, 0x11, 0x8f, 0x81, 0xe6, 0x7e, 0x3a, 0x19, 0x8c, 0x0b, 0x2b, 0x20, 0x59,
-		0x1d, 0x6c, 0x87, 0xbe, 0x77, 0x63, 0xba, 0x3a, 0xfb, 0x7b, 0x9f, 0x78, 0x6c, 0x86, 0x0b, 0x9e,
-		0xef, 0xbe, 0xb3, 0x9b, 0x95, 0x95, 0x82, 0xe0, 0x10, 0x1f, 0xc2, 0xbd, 0x14, 0xda, 0x86, 0xe9,
-		0x6e, 0xfb, 0xdf, 0xdb, 0xcf, 0xf4, 0x09, 0xb7, 0x64, 0x1e, 0x94, 0x67, 0x7f, 0xc7, 0x87, 0xf6,
-		0xe3, 0xc6, 0xeb, 0xf8, 0x20, 0xb8, 0x27, 0x43, 0x61, 0x88, 0xfb, 0xfe, 0x8a, 0x66, 0xb4, 0xc4,
-		0x1f, 0x77, 0x53, 0xf8, 0x13, 0x5a, 0xf2, 0x72, 0x30, 0x71, 0x1a, 0x0b, 0xc9, 0xc3, 0x74, 0xa4,
-		0x6c, 0x99, 0xcd, 0x70, 0xba, 0x05, 0x35, 0x20, 0xe1, 0x5a, 0x37, 0xb1, 0xc9, 0x85, 0x74, 0x24,
-		0xfd, 0xee, 0xbf, 0x7c, 0x86, 0x63, 0xa1, 0x5d, 0x90, 0x9a, 0xb8, 0x85, 0x77, 0xd9, 0xd7, 0x5a,
-		0x7b, 0x9a, 0x8d, 0xd9, 0x17, 0x9c, 0x5f, 0xeb, 0xfc, 0x29, 0x78, 0xa8, 0x75, 0x0a, 0x8a, 0x36,
-		0xc3, 0x17, 0x9d, 0x25, 0xf9, 0x96, 0xfc, 0x90, 0xfe, 0x07, 0x34, 0x33, 0x68, 0xb3, 0x42, 0x17,
-		0xa3, 0x9d, 0x05, 0xa9, 0x6b, 0x6e, 0x5b, 0x26, 0xfd, 0x7b, 0x49, 0x3c, 0xba, 0x4a, 0xb1, 0x6d,
-		0x3b, 0xaf, 0x9c, 0x6f, 0xdb, 0x6d, 0x42, 0xde, 0x27, 0xa5, 0xb3, 0x28, 0x7d, 0xd4, 0x59, 0x94,
-		0xf3, 0x00, 0x08, 0x09, 0x5a, 0x03, 0xf0, 0xe7, 0xa9, 0xb7, 0xb5, 0x3f, 0x72, 0xc6, 0x07, 0x3b,
-		0x13, 0x00, 0x40, 0x2d, 0x98, 0x6e, 0x1b, 0xa6, 0xea, 0xe0, 0xd6, 0x8e, 0xca, 0x25, 0x47, 0x70,
-		0x33, 0x6f, 0xc3, 0x48, 0x4f, 0xb5, 0x0d, 0xb3, 0x8e, 0x5b, 0x3b, 0xcb, 0x1e, 0x2c, 0x7a, 0x2f,
-		0x9c, 0xf2, 0xc5, 0x61, 0x99, 0xea, 0x9e, 0xd5, 0x6a, 0xaa, 0x36, 0xde, 0x51, 0x75, 0x7a, 0xf7,
-		0x51, 0x96, 0x0a, 0xf1, 0x84, 0x47, 0xb2, 0x61, 0x5e, 0xb5, 0x5a, 0x4d, 0x05, 0xef, 0x54, 0x48,
-		0x35, 0x7a, 0x10, 0x7c, 0x59, 0xa8, 0x46, 0xd3, 0x99, 0xcd, 0x2d, 0xc4, 0xce, 0xc4, 0x95, 0xac,
-		0x57, 0x58, 0x6b, 0x3a, 0x4b, 0xd9, 0x0f, 0x7f, 0x74, 0x7e, 0x82, 0xcf, 0xde, 0x09, 0x79, 0x93,
-		0xde, 0xd6, 0xc1, 0x27, 0x1e, 0x76, 0xd0, 0x25, 0x48, 0x6b, 0xe2, 0x81, 0x7d, 0x27, 0x72, 0xc8,
-		0xc4, 0xf5, 0x49, 0x99, 0x3d, 0x78, 0xed, 0x0f, 0x17, 0x22, 0xf2, 0x4f, 0x46, 0x20, 0xb1, 0x7c,
-		0x63, 0x53, 0x33, 0x6c, 0x54, 0x85, 0x29, 0x5f, 0x85, 0xc7, 0xb5, 0x06, 0xbe, 0xd6, 0x0b, 0x73,
-		0x50, 0x1d, 0xf6, 0x11, 0xed, 0xa1, 0x30, 0xbd, 0x9f, 0xd7, 0xf6, 0x74, 0xfc, 0x1a, 0x24, 0x59,
-		0x2b, 0x1d, 0xf4, 0x3c, 0x4c, 0x76, 0xc8, 0x0f, 0xbe, 0x19, 0x30, 0x37, 0x54, 0xf5, 0x29, 0x7d,
-		0x50, 0x51, 0x18, 0x9f, 0xfc, 0x57, 0x11, 0x80, 0xe5, 0x1b, 0x37, 0x1a, 0xb6, 0xd1, 0x69, 0x61,
-		0xf7, 0xed, 0xea, 0xf6, 0x2a, 0x1c, 0x0b, 0x7c, 0xae, 0x69, 0xeb, 0x63, 0x77, 0x7d, 0xda, 0xff,
-		0x60, 0xd3, 0xd6, 0x07, 0xa2, 0x35, 0x1d, 0xd7, 0x43, 0x8b, 0x8d, 0x8d, 0xb6, 0xec, 0xb8, 0x83,
-		0x65, 0xf9, 0x22, 0x64, 0xfc, 0xee, 0x3b, 0xa8, 0x06, 0x29, 0x97, 0xff, 0xe6, 0x22, 0x95, 0x87,
-		0x8b, 0x54, 0xb0, 0x05, 0xc5, 0xea, 0xb1, 0xcb, 0x7f, 0x4d, 0x24, 0xeb, 0x4f, 0x8f, 0xaf, 0x2b,
-		0x85, 0x22, 0x76, 0x9f, 0xdb, 0xe5, 0xb7, 0xc3, 0xaf, 0xe1, 0x58, 0x3d, 0xa2, 0xfd, 0x70, 0x14,
-		0xa6, 0xb7, 0xc4, 0xf4, 0xfd, 0xba, 0x95, 0xc4, 0x16, 0x24, 0xb1, 0xe9, 0xda, 0x06, 0x16, 0x3b,
-		0x80, 0x8f, 0x0f, 0x1b, 0xf0, 0x01, 0x7d, 0xa1, 0x7f, 0x34, 0x3b, 0x38, 0xfc, 0x02, 0xab, 0x47,
-		0x14, 0xbf, 0x19, 0x83, 0xd9, 0x61, 0xec, 0xe8, 0x34, 0x14, 0x74, 0x1b, 0xd3, 0x02, 0x35, 0x94,
-		0x25, 0xcf, 0x8b, 0x62, 0xbe, 0xe0, 0x28, 0x40, 0xdc, 0x38, 0xa2, 0x5d, 0x84, 0xf4, 0xde, 0xfc,
-		0xb6, 0xbc, 0x8f, 0x40, 0x97, 0x1c, 0x0c, 0x05, 0x71, 0xec, 0x7d, 0x5b, 0x6b, 0x69, 0xa6, 0x7e,
-		0x2f, 0x9e, 0x6e, 0xff, 0xfa, 0x20, 0xce, 0xd2, 0x97, 0x19, 0x26, 0xba, 0x01, 0x49, 0x01, 0x1f,
-		0x7f, 0x1b, 0xe0, 0x05, 0x18, 0x7a, 0x00, 0xb2, 0xc1, 0x65, 0x83, 0x7a, 0x31, 0x71, 0x25, 0x13,
-		0x58, 0x35, 0x46, 0xad, 0x4b, 0x89, 0x43, 0xd7, 0xa5, 0x80, 0xb3, 0xf8, 0x2f, 0x62, 0x30, 0xa5,
-		0xe0, 0xe6, 0x37, 0xe0, 0xe0, 0x7d, 0x33, 0x00, 0x9b, 0xe0, 0xc4, 0xf8, 0xde, 0xc3, 0xf8, 0xf5,
-		0x1b, 0x8c, 0x34, 0xc3, 0x5b, 0x76, 0xdc, 0x77, 0x73, 0x04, 0xff, 0x7d, 0x14, 0xb2, 0xc1, 0x11,
-		0xfc, 0x06, 0x58, 0xed, 0xd0, 0xba, 0x6f, 0xde, 0xd8, 0x01, 0xf5, 0xb3, 0xc3, 0xcc, 0x5b, 0x9f,
-		0x6e, 0x8f, 0x61, 0xd7, 0x7e, 0x30, 0x06, 0x09, 0x7e, 0x62, 0x6c, 0xa3, 0xcf, 0x1b, 0x8e, 0x8c,
-		0xfa, 0x4c, 0x38, 0x27, 0x3e, 0x13, 0x1e, 0xe8, 0x0c, 0x3f, 0x04, 0x79, 0x12, 0x83, 0x87, 0x8e,
-		0xa1, 0x45, 0xce, 0xe4, 0x68, 0x10, 0xed, 0x1f, 0x9a, 0x46, 0xf3, 0x90, 0x21, 0x64, 0xbe, 0x0d,
-		0x27, 0x34, 0xd0, 0xd6, 0xf6, 0xab, 0xac, 0x04, 0x3d, 0x06, 0x68, 0xcf, 0xcb, 0x8f, 0xa8, 0xbe,
-		0x30, 0x08, 0xdd, 0x94, 0x5f, 0x23, 0xc8, 0xef, 0x07, 0x20, 0xad, 0x50, 0xd9, 0x45, 0x9e, 0xfc,
-		0x76, 0x55, 0x52, 0xb2, 0x4c, 0x2f, 0xf3, 0xfc, 0x36, 0xe6, 0x53, 0xf7, 0x84, 0xe7, 0x3c, 0xba,
-		0x59, 0x3d, 0xda, 0xa4, 0xf8, 0xca, 0x1b, 0xf3, 0xc5, 0x03, 0xad, 0xdd, 0x5a, 0x92, 0x07, 0x40,
-		0xca, 0xd4, 0xc7, 0x0e, 0x87, 0xf5, 0x4b, 0x67, 0x84, 0x2e, 0xdf, 0xf9, 0xfc, 0x2f, 0x9e, 0x3b,
-		0x15, 0xc0, 0xdc, 0xf7, 0x12, 0x67, 0x6c, 0x38, 0xe4, 0x5f, 0x88, 0x00, 0xf2, 0x17, 0x1a, 0xef,
-		0x98, 0xf8, 0x1a, 0x3d, 0x3c, 0x2c, 0x22, 0x81, 0xc8, 0xe1, 0x11, 0x86, 0xcf, 0x1f, 0x8a, 0x30,
-		0x02, 0x13, 0xe8, 0x7d, 0xbe, 0x59, 0x17, 0x1f, 0x85, 0x0f, 0xb8, 0xc2, 0x75, 0xb1, 0x62, 0x19,
-		0x21, 0x08, 0xc1, 0xe4, 0xcd, 0xcd, 0x09, 0xf9, 0x8d, 0x08, 0x9c, 0xec, 0xd3, 0x40, 0xaf, 0xd9,
-		0x3a, 0x20, 0x3b, 0x50, 0x49, 0x47, 0x51, 0x6c, 0xbd, 0xde, 0x9b, 0x42, 0x4f, 0xd9, 0x7d, 0xa6,
-		0xfc, 0x1d, 0x5a, 0xa3, 0xf8, 0x65, 0xae, 0xbf, 0x1d, 0x81, 0x99, 0x60, 0x8b, 0xbc, 0xbe, 0xd5,
-		0x21, 0x1b, 0x6c, 0x0b, 0xef, 0xd5, 0x7b, 0xc6, 0xe9, 0x55, 0xb0, 0x43, 0x21, 0x10, 0xd2, 0x17,
-		0xa1, 0xe9, 0x2c, 0x8d, 0xf7, 0xc4, 0xd8, 0x52, 0xf2, 0xb6, 0x28, 0x06, 0x4d, 0xff, 0x38, 0x1d,
-		0xac, 0xef, 0x8d, 0x42, 0x7c, 0xd3, 0xb2, 0x5a, 0xe8, 0x3b, 0x22, 0x30, 0x65, 0x5a, 0xae, 0x4a,
-		0xe6, 0x07, 0x6e, 0xaa, 0x3c, 0x95, 0xc0, 0x2c, 0xe8, 0x8d, 0xa3, 0x49, 0xef, 0x8b, 0x6f, 0xcc,
-		0xf7, 0x43, 0x0d, 0xba, 0x7d, 0xb7, 0x60, 0x5a, 0x6e, 0x99, 0x12, 0x35, 0x58, 0xb6, 0xe1, 0x36,
-		0xe4, 0xc2, 0xef, 0x67, 0x66, 0x57, 0x39, 0xf2, 0xfb, 0x73, 0x23, 0xdf, 0x9d, 0xdd, 0x0e, 0xbc,
-		0x98, 0xdd, 0x81, 0xf9, 0xe7, 0x64, 0x70, 0x5f, 0x02, 0xe9, 0x46, 0xef, 0xa1, 0xb5, 0x2a, 0x24,
-		0x8f, 0x7a, 0xfe, 0x2d, 0x28, 0x71, 0xce, 0x7b, 0xee, 0x93, 0x11, 0x00, 0x3f, 0x71, 0x83, 0x1e,
-		0x85, 0x13, 0xe5, 0x8d, 0xf5, 0x65, 0xb5, 0xde, 0x28, 0x35, 0xb6, 0xea, 0xe1, 0x1b, 0xd7, 0xc5,
-		0x35, 0x25, 0x4e, 0x07, 0xeb, 0xc6, 0x8e, 0x81, 0x9b, 0xe8, 0x61, 0x98, 0x09, 0x53, 0x93, 0xa7,
-		0xea, 0xb2, 0x14, 0x29, 0x66, 0xef, 0xdc, 0x5d, 0x48, 0x31, 0x97, 0x14, 0x37, 0xd1, 0x19, 0x38,
-		0xd6, 0x4f, 0x57, 0x5b, 0x5f, 0x91, 0xa2, 0xc5, 0xdc, 0x9d, 0xbb, 0x0b, 0x69, 0xcf, 0x77, 0x45,
-		0x32, 0xa0, 0x20, 0x25, 0xc7, 0x8b, 0x15, 0xe1, 0xce, 0xdd, 0x85, 0x04, 0x1b, 0x96, 0x62, 0xfc,
-		0xc3, 0x3f, 0x31, 0x37, 0x71, 0xee, 0x5b, 0x00, 0x6a, 0xe6, 0x8e, 0xad, 0xd1, 0x3f, 0x3b, 0x8c,
-		0x8a, 0x70, 0xbc, 0xb6, 0x7e, 0x45, 0x29, 0x55, 0x1a, 0xb5, 0x8d, 0xf5, 0x9e, 0x8b, 0xe2, 0xc3,
-		0x75, 0xcb, 0x1b, 0x5b, 0xe5, 0xd5, 0xaa, 0x5a, 0xaf, 0xad, 0xac, 0xb3, 0xcd, 0xdf, 0x50, 0xdd,
-		0x0b, 0xeb, 0x8d, 0xda, 0x5a, 0x55, 0x8a, 0x96, 0xaf, 0x0c, 0xdd, 0x7e, 0x78, 0xf4, 0xd0, 0x01,
-		0xf7, 0x2d, 0x65, 0x68, 0x0f, 0xe2, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x67, 0x40, 0xb3,
-		0x7c, 0x9a, 0x00, 0x00,
+		// 11426 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x90, 0x64, 0xd7,
+		0x59, 0xdf, 0xf4, 0x73, 0xba, 0xbf, 0x7e, 0xdd, 0xb9, 0x33, 0xbb, 0xea, 0xed, 0x95, 0x76, 0x47,
+		0x57, 0x96, 0xb4, 0x5a, 0x49, 0xb3, 0xd2, 0x4a, 0xbb, 0x92, 0x5a, 0xb6, 0x45, 0xbf, 0x76, 0xb6,
+		0x57, 0xf3, 0xf2, 0xed, 0x9e, 0xd5, 0x83, 0xe0, 0xcb, 0x9d, 0xee, 0x33, 0x33, 0xad, 0xed, 0xbe,
+		0xb7, 0xdd, 0xf7, 0xf6, 0xee, 0x8c, 0x2a, 0x95, 0x12, 0x38, 0x26, 0x66, 0x43, 0x88, 0x09, 0x09,
+		0x18, 0xf0, 0x82, 0xcd, 0xd3, 0x10, 0x48, 0x00, 0x13, 0x02, 0xa1, 0x42, 0x20, 0x15, 0x12, 0xa0,
+		0x52, 0x89, 0xa1, 0x52, 0x09, 0x45, 0x11, 0x05, 0x6c, 0x2a, 0x38, 0x60, 0xc2, 0x23, 0xa6, 0x92,
+		0x94, 0x0b, 0x92, 0xfa, 0xce, 0xe3, 0x3e, 0xfa, 0x31, 0xb7, 0x67, 0xbd, 0x72, 0x9c, 0xf2, 0x3f,
+		0xbb, 0x7d, 0xce, 0xf9, 0xbe, 0xdf, 0x3d, 0xe7, 0x3b, 0xe7, 0x7c, 0xe7, 0xfb, 0xbe, 0xf3, 0x18,
+		0xf8, 0xbd, 0x2b, 0xb0, 0xbc, 0x67, 0x9a, 0x7b, 0x5d, 0x72, 0xa1, 0x3f, 0x30, 0x6d, 0x73, 0x67,
+		0xb8, 0x7b, 0xa1, 0x4d, 0xac, 0xd6, 0xa0, 0xd3, 0xb7, 0xcd, 0xc1, 0x0a, 0xcd, 0x93, 0x73, 0x8c,
+		0x62, 0x45, 0x50, 0x28, 0xeb, 0xb0, 0x70, 0xa5, 0xd3, 0x25, 0x55, 0x87, 0xb0, 0x41, 0x6c, 0xf9,
+		0x79, 0x88, 0xee, 0x76, 0xba, 0x24, 0x1f, 0x5a, 0x8e, 0x9c, 0x4b, 0x5d, 0x7c, 0xd7, 0xca, 0x08,
+		0xd3, 0x8a, 0x9f, 0x63, 0x0b, 0xb3, 0x55, 0xca, 0xa1, 0xfc, 0x55, 0x14, 0x16, 0x27, 0x94, 0xca,
+		0x32, 0x44, 0x0d, 0xbd, 0x87, 0x88, 0xa1, 0x73, 0x49, 0x95, 0xfe, 0x96, 0xf3, 0x30, 0xdf, 0xd7,
+		0x5b, 0x37, 0xf4, 0x3d, 0x92, 0x0f, 0xd3, 0x6c, 0x91, 0x94, 0xcf, 0x00, 0xb4, 0x49, 0x9f, 0x18,
+		0x6d, 0x62, 0xb4, 0x0e, 0xf3, 0x91, 0xe5, 0xc8, 0xb9, 0xa4, 0xea, 0xc9, 0x91, 0x1f, 0x87, 0x85,
+		0xfe, 0x70, 0xa7, 0xdb, 0x69, 0x69, 0x1e, 0x32, 0x58, 0x8e, 0x9c, 0x8b, 0xa9, 0x12, 0x2b, 0xa8,
+		0xba, 0xc4, 0x8f, 0x42, 0xee, 0x16, 0xd1, 0x6f, 0x78, 0x49, 0x53, 0x94, 0x34, 0x8b, 0xd9, 0x1e,
+		0xc2, 0x0a, 0xa4, 0x7b, 0xc4, 0xb2, 0xf4, 0x3d, 0xa2, 0xd9, 0x87, 0x7d, 0x92, 0x8f, 0xd2, 0xd6,
+		0x2f, 0x8f, 0xb5, 0x7e, 0xb4, 0xe5, 0x29, 0xce, 0xd5, 0x3c, 0xec, 0x13, 0xb9, 0x04, 0x49, 0x62,
+		0x0c, 0x7b, 0x0c, 0x21, 0x36, 0x45, 0x7e, 0x35, 0x63, 0xd8, 0x1b, 0x45, 0x49, 0x20, 0x1b, 0x87,
+		0x98, 0xb7, 0xc8, 0xe0, 0x66, 0xa7, 0x45, 0xf2, 0x71, 0x0a, 0xf0, 0xe8, 0x18, 0x40, 0x83, 0x95,
+		0x8f, 0x62, 0x08, 0x3e, 0xb9, 0x02, 0x49, 0x72, 0x60, 0x13, 0xc3, 0xea, 0x98, 0x46, 0x7e, 0x9e,
+		0x82, 0x3c, 0x3c, 0xa1, 0x17, 0x49, 0xb7, 0x3d, 0x0a, 0xe1, 0xf2, 0xc9, 0x97, 0x61, 0xde, 0xec,
+		0xdb, 0x1d, 0xd3, 0xb0, 0xf2, 0x89, 0xe5, 0xd0, 0xb9, 0xd4, 0xc5, 0xfb, 0x27, 0x0e, 0x84, 0x4d,
+		0x46, 0xa3, 0x0a, 0x62, 0xb9, 0x0e, 0x92, 0x65, 0x0e, 0x07, 0x2d, 0xa2, 0xb5, 0xcc, 0x36, 0xd1,
+		0x3a, 0xc6, 0xae, 0x99, 0x4f, 0x52, 0x80, 0xb3, 0xe3, 0x0d, 0xa1, 0x84, 0x15, 0xb3, 0x4d, 0xea,
+		0xc6, 0xae, 0xa9, 0x66, 0x2d, 0x5f, 0x5a, 0x3e, 0x09, 0x71, 0xeb, 0xd0, 0xb0, 0xf5, 0x83, 0x7c,
+		0x9a, 0x8e, 0x10, 0x9e, 0xc2, 0xa1, 0x43, 0xda, 0x1d, 0xfc, 0x5c, 0x3e, 0xc3, 0x86, 0x0e, 0x4f,
+		0x2a, 0x3f, 0x1f, 0x87, 0xdc, 0x2c, 0x83, 0xef, 0x45, 0x88, 0xed, 0x62, 0xfb, 0xf3, 0xe1, 0xe3,
+		0x48, 0x87, 0xf1, 0xf8, 0xc5, 0x1b, 0xbf, 0x4b, 0xf1, 0x96, 0x20, 0x65, 0x10, 0xcb, 0x26, 0x6d,
+		0x36, 0x56, 0x22, 0x33, 0x8e, 0x36, 0x60, 0x4c, 0xe3, 0x83, 0x2d, 0x7a, 0x57, 0x83, 0xed, 0x55,
+		0xc8, 0x39, 0x55, 0xd2, 0x06, 0xba, 0xb1, 0x27, 0x46, 0xed, 0x85, 0xa0, 0x9a, 0xac, 0xd4, 0x04,
+		0x9f, 0x8a, 0x6c, 0x6a, 0x96, 0xf8, 0xd2, 0x72, 0x15, 0xc0, 0x34, 0x88, 0xb9, 0xab, 0xb5, 0x49,
+		0xab, 0x9b, 0x4f, 0x4c, 0x91, 0xd2, 0x26, 0x92, 0x8c, 0x49, 0xc9, 0x64, 0xb9, 0xad, 0xae, 0xfc,
+		0x82, 0x3b, 0x08, 0xe7, 0xa7, 0x8c, 0xa1, 0x75, 0x36, 0xfd, 0xc6, 0xc6, 0xe1, 0x36, 0x64, 0x07,
+		0x04, 0x67, 0x04, 0x69, 0xf3, 0x96, 0x25, 0x69, 0x25, 0x56, 0x02, 0x5b, 0xa6, 0x72, 0x36, 0xd6,
+		0xb0, 0xcc, 0xc0, 0x9b, 0x94, 0x1f, 0x02, 0x27, 0x43, 0xa3, 0xc3, 0x0a, 0xa8, 0x7e, 0x4a, 0x8b,
+		0xcc, 0x0d, 0xbd, 0x47, 0x0a, 0x6f, 0x42, 0xd6, 0x2f, 0x1e, 0x79, 0x09, 0x62, 0x96, 0xad, 0x0f,
+		0x6c, 0x3a, 0x0a, 0x63, 0x2a, 0x4b, 0xc8, 0x12, 0x44, 0x88, 0xd1, 0xa6, 0xfa, 0x2f, 0xa6, 0xe2,
+		0x4f, 0xf9, 0x6b, 0xdc, 0x06, 0x47, 0x68, 0x83, 0x1f, 0x19, 0xef, 0x51, 0x1f, 0xf2, 0x68, 0xbb,
+		0x0b, 0xcf, 0x41, 0xc6, 0xd7, 0x80, 0x59, 0x3f, 0xad, 0xfc, 0x44, 0x14, 0x4e, 0x4c, 0xc4, 0x96,
+		0x5f, 0x85, 0xa5, 0xa1, 0xd1, 0x31, 0x6c, 0x32, 0xe8, 0x0f, 0x08, 0x0e, 0x59, 0xf6, 0xad, 0xfc,
+		0x1f, 0xcc, 0x4f, 0x19, 0x74, 0xdb, 0x5e, 0x6a, 0x86, 0xa2, 0x2e, 0x0e, 0xc7, 0x33, 0xe5, 0xd7,
+		0x20, 0x85, 0xe3, 0x43, 0x1f, 0xe8, 0x14, 0x90, 0xcd, 0xc6, 0x8b, 0xb3, 0x35, 0x79, 0xa5, 0xea,
+		0x72, 0x96, 0x23, 0x1f, 0x0e, 0x85, 0x55, 0x2f, 0x96, 0xbc, 0x0f, 0xe9, 0x9b, 0x64, 0xd0, 0xd9,
+		0xed, 0xb4, 0x18, 0x36, 0x8a, 0x33, 0x7b, 0xf1, 0xf9, 0x19, 0xb1, 0xaf, 0x7b, 0x58, 0x1b, 0xb6,
+		0x6e, 0x93, 0x22, 0x6c, 0x6f, 0x5c, 0xaf, 0xa9, 0xf5, 0x2b, 0xf5, 0x5a, 0x55, 0xf5, 0x21, 0x17,
+		0x3e, 0x15, 0x82, 0x94, 0xa7, 0x2e, 0xa8, 0xb6, 0x8c, 0x61, 0x6f, 0x87, 0x0c, 0xb8, 0xc4, 0x79,
+		0x4a, 0x3e, 0x0d, 0xc9, 0xdd, 0x61, 0xb7, 0xcb, 0x86, 0x0d, 0x5b, 0xf3, 0x12, 0x98, 0x81, 0x43,
+		0x06, 0xb5, 0x14, 0x57, 0x04, 0x54, 0x4b, 0xe1, 0x6f, 0xf9, 0x21, 0x48, 0x75, 0x2c, 0x6d, 0x40,
+		0xfa, 0x44, 0xb7, 0x49, 0x3b, 0x1f, 0x5d, 0x0e, 0x9d, 0x4b, 0x94, 0xc3, 0xf9, 0x90, 0x0a, 0x1d,
+		0x4b, 0xe5, 0xb9, 0x72, 0x01, 0x12, 0x62, 0xec, 0xe5, 0x63, 0x48, 0xa1, 0x3a, 0x69, 0x56, 0xc6,
+		0xb9, 0xe3, 0xa2, 0x8c, 0xa5, 0x95, 0x67, 0x61, 0x61, 0xac, 0x91, 0x72, 0x0e, 0x52, 0xd5, 0x5a,
+		0x65, 0xad, 0xa4, 0x96, 0x9a, 0xf5, 0xcd, 0x0d, 0x69, 0x4e, 0xce, 0x82, 0xa7, 0xdd, 0x52, 0xe8,
+		0x7c, 0x32, 0xf1, 0xb9, 0x79, 0xe9, 0xad, 0xb7, 0xde, 0x7a, 0x2b, 0xac, 0xfc, 0x72, 0x1c, 0x96,
+		0x26, 0x69, 0xb9, 0x89, 0x0a, 0xd7, 0x95, 0x49, 0xc4, 0x27, 0x93, 0x12, 0xc4, 0xba, 0xfa, 0x0e,
+		0xe9, 0xd2, 0xc6, 0x65, 0x2f, 0x3e, 0x3e, 0x93, 0x1e, 0x5d, 0x59, 0x43, 0x16, 0x95, 0x71, 0xca,
+		0xef, 0xe5, 0x92, 0x8b, 0x51, 0x84, 0xf3, 0xb3, 0x21, 0xa0, 0xf6, 0xe3, 0x52, 0x3e, 0x0d, 0x49,
+		0xfc, 0x9f, 0x75, 0x4b, 0x9c, 0x75, 0x0b, 0x66, 0xd0, 0x6e, 0x29, 0x40, 0x82, 0x2a, 0xb6, 0x36,
+		0x71, 0xba, 0x4c, 0xa4, 0x51, 0x15, 0xb4, 0xc9, 0xae, 0x3e, 0xec, 0xda, 0xda, 0x4d, 0xbd, 0x3b,
+		0x24, 0x54, 0x45, 0x25, 0xd5, 0x34, 0xcf, 0xbc, 0x8e, 0x79, 0xf2, 0x59, 0x48, 0x31, 0x3d, 0xd8,
+		0x31, 0xda, 0xe4, 0x80, 0xae, 0x84, 0x31, 0x95, 0xa9, 0xc6, 0x3a, 0xe6, 0xe0, 0xe7, 0xdf, 0xb0,
+		0x4c, 0x43, 0x28, 0x13, 0xfa, 0x09, 0xcc, 0xa0, 0x9f, 0x7f, 0x6e, 0x74, 0x11, 0x7e, 0x60, 0x72,
+		0xf3, 0xc6, 0xb4, 0xdf, 0xa3, 0x90, 0xa3, 0x14, 0xcf, 0xf0, 0xb9, 0xaa, 0x77, 0xf3, 0x0b, 0x74,
+		0x00, 0x64, 0x59, 0xf6, 0x26, 0xcf, 0x55, 0x7e, 0x36, 0x0c, 0x51, 0xba, 0x14, 0xe4, 0x20, 0xd5,
+		0x7c, 0x6d, 0xab, 0xa6, 0x55, 0x37, 0xb7, 0xcb, 0x6b, 0x35, 0x29, 0x84, 0x5d, 0x4f, 0x33, 0xae,
+		0xac, 0x6d, 0x96, 0x9a, 0x52, 0xd8, 0x49, 0xd7, 0x37, 0x9a, 0x97, 0x9f, 0x95, 0x22, 0x0e, 0xc3,
+		0x36, 0xcb, 0x88, 0x7a, 0x09, 0x9e, 0xb9, 0x28, 0xc5, 0x64, 0x09, 0xd2, 0x0c, 0xa0, 0xfe, 0x6a,
+		0xad, 0x7a, 0xf9, 0x59, 0x29, 0xee, 0xcf, 0x79, 0xe6, 0xa2, 0x34, 0x2f, 0x67, 0x20, 0x49, 0x73,
+		0xca, 0x9b, 0x9b, 0x6b, 0x52, 0xc2, 0xc1, 0x6c, 0x34, 0xd5, 0xfa, 0xc6, 0xaa, 0x94, 0x74, 0x30,
+		0x57, 0xd5, 0xcd, 0xed, 0x2d, 0x09, 0x1c, 0x84, 0xf5, 0x5a, 0xa3, 0x51, 0x5a, 0xad, 0x49, 0x29,
+		0x87, 0xa2, 0xfc, 0x5a, 0xb3, 0xd6, 0x90, 0xd2, 0xbe, 0x6a, 0x3d, 0x73, 0x51, 0xca, 0x38, 0x9f,
+		0xa8, 0x6d, 0x6c, 0xaf, 0x4b, 0x59, 0x79, 0x01, 0x32, 0xec, 0x13, 0xa2, 0x12, 0xb9, 0x91, 0xac,
+		0xcb, 0xcf, 0x4a, 0x92, 0x5b, 0x11, 0x86, 0xb2, 0xe0, 0xcb, 0xb8, 0xfc, 0xac, 0x24, 0x2b, 0x15,
+		0x88, 0xd1, 0x61, 0x28, 0xcb, 0x90, 0x5d, 0x2b, 0x95, 0x6b, 0x6b, 0xda, 0xe6, 0x16, 0x4e, 0x9a,
+		0xd2, 0x9a, 0x14, 0x72, 0xf3, 0xd4, 0xda, 0xfb, 0xb6, 0xeb, 0x6a, 0xad, 0x2a, 0x85, 0xbd, 0x79,
+		0x5b, 0xb5, 0x52, 0xb3, 0x56, 0x95, 0x22, 0x4a, 0x0b, 0x96, 0x26, 0x2d, 0x81, 0x13, 0xa7, 0x90,
+		0x67, 0x2c, 0x84, 0xa7, 0x8c, 0x05, 0x8a, 0x35, 0x3a, 0x16, 0x94, 0xcf, 0x86, 0x61, 0x71, 0x82,
+		0x19, 0x30, 0xf1, 0x23, 0x2f, 0x41, 0x8c, 0x8d, 0x65, 0xa6, 0x8a, 0x1f, 0x9b, 0x68, 0x4f, 0xd0,
+		0x91, 0x3d, 0x66, 0x1c, 0x51, 0x3e, 0xaf, 0xd9, 0x18, 0x99, 0x62, 0x36, 0x22, 0xc4, 0xd8, 0x80,
+		0xfd, 0xba, 0xb1, 0xe5, 0x9a, 0x59, 0x34, 0x97, 0x67, 0xb1, 0x68, 0x68, 0xde, 0xf1, 0x96, 0xed,
+		0xd8, 0x84, 0x65, 0xfb, 0x45, 0x58, 0x18, 0x03, 0x9a, 0x79, 0xf9, 0xfc, 0x60, 0x08, 0xf2, 0xd3,
+		0x84, 0x13, 0xa0, 0x12, 0xc3, 0x3e, 0x95, 0xf8, 0xe2, 0xa8, 0x04, 0x1f, 0x9c, 0xde, 0x09, 0x63,
+		0x7d, 0xfd, 0x23, 0x21, 0x38, 0x39, 0xd9, 0x3d, 0x98, 0x58, 0x87, 0xf7, 0x42, 0xbc, 0x47, 0xec,
+		0x7d, 0x53, 0x18, 0xc2, 0x8f, 0x4c, 0x30, 0xaf, 0xb0, 0x78, 0xb4, 0xb3, 0x39, 0x97, 0xfc, 0xc2,
+		0x68, 0x5d, 0xcf, 0x4e, 0x73, 0x56, 0xc6, 0x6a, 0xfa, 0xcd, 0x61, 0x38, 0x31, 0x11, 0x7c, 0x62,
+		0x45, 0x1f, 0x00, 0xe8, 0x18, 0xfd, 0xa1, 0xcd, 0x8c, 0x5d, 0xa6, 0x89, 0x93, 0x34, 0x87, 0x2a,
+		0x2f, 0xd4, 0xb2, 0x43, 0xdb, 0x29, 0x67, 0x8b, 0x28, 0xb0, 0x2c, 0x4a, 0xf0, 0xbc, 0x5b, 0xd1,
+		0x28, 0xad, 0xe8, 0x99, 0x29, 0x2d, 0x1d, 0x1b, 0x98, 0x4f, 0x81, 0xd4, 0xea, 0x76, 0x88, 0x61,
+		0x6b, 0x96, 0x3d, 0x20, 0x7a, 0xaf, 0x63, 0xec, 0xb1, 0x75, 0xb6, 0x18, 0xdb, 0xd5, 0xbb, 0x16,
+		0x51, 0x73, 0xac, 0xb8, 0x21, 0x4a, 0x91, 0x83, 0x0e, 0xa0, 0x81, 0x87, 0x23, 0xee, 0xe3, 0x60,
+		0xc5, 0x0e, 0x87, 0xf2, 0x6d, 0x49, 0x48, 0x79, 0x9c, 0x29, 0xf9, 0x41, 0x48, 0xbf, 0xa1, 0xdf,
+		0xd4, 0x35, 0xe1, 0x20, 0x33, 0x49, 0xa4, 0x30, 0x6f, 0x8b, 0x65, 0xc9, 0x4f, 0xc1, 0x12, 0x25,
+		0x31, 0x87, 0x36, 0x19, 0x68, 0xad, 0xae, 0x6e, 0x59, 0x54, 0x68, 0x09, 0x4a, 0x2a, 0x63, 0xd9,
+		0x26, 0x16, 0x55, 0x44, 0x89, 0x7c, 0x09, 0x16, 0x29, 0x47, 0x6f, 0xd8, 0xb5, 0x3b, 0xfd, 0x2e,
+		0xd1, 0xd0, 0x65, 0xb7, 0xf2, 0xe0, 0xad, 0xd9, 0x02, 0x52, 0xac, 0x73, 0x02, 0xac, 0x91, 0x25,
+		0x57, 0xe1, 0x01, 0xca, 0xb6, 0x47, 0x0c, 0x32, 0xd0, 0x6d, 0xa2, 0x91, 0x0f, 0x0c, 0xf5, 0xae,
+		0xa5, 0xe9, 0x46, 0x5b, 0xdb, 0xd7, 0xad, 0xfd, 0xfc, 0x92, 0x63, 0x96, 0x9c, 0x42, 0xc2, 0x55,
+		0x4e, 0x57, 0xa3, 0x64, 0x25, 0xa3, 0x7d, 0x55, 0xb7, 0xf6, 0xe5, 0x22, 0x9c, 0xa4, 0x28, 0x96,
+		0x3d, 0xe8, 0x18, 0x7b, 0x5a, 0x6b, 0x9f, 0xb4, 0x6e, 0x68, 0x43, 0x7b, 0xf7, 0xf9, 0xfc, 0x69,
+		0xef, 0xf7, 0x69, 0x0d, 0x1b, 0x94, 0xa6, 0x82, 0x24, 0xdb, 0xf6, 0xee, 0xf3, 0x72, 0x03, 0xd2,
+		0xd8, 0x19, 0xbd, 0xce, 0x9b, 0x44, 0xdb, 0x35, 0x07, 0x74, 0x0d, 0xcd, 0x4e, 0x50, 0x4d, 0x1e,
+		0x09, 0xae, 0x6c, 0x72, 0x86, 0x75, 0xb3, 0x4d, 0x8a, 0xb1, 0xc6, 0x56, 0xad, 0x56, 0x55, 0x53,
+		0x02, 0xe5, 0x8a, 0x39, 0xc0, 0x01, 0xb5, 0x67, 0x3a, 0x02, 0x4e, 0xb1, 0x01, 0xb5, 0x67, 0x0a,
+		0xf1, 0x5e, 0x82, 0xc5, 0x56, 0x8b, 0xb5, 0xb9, 0xd3, 0xd2, 0xb8, 0x63, 0x6d, 0xe5, 0x25, 0x9f,
+		0xb0, 0x5a, 0xad, 0x55, 0x46, 0xc0, 0xc7, 0xb8, 0x25, 0xbf, 0x00, 0x27, 0x5c, 0x61, 0x79, 0x19,
+		0x17, 0xc6, 0x5a, 0x39, 0xca, 0x7a, 0x09, 0x16, 0xfb, 0x87, 0xe3, 0x8c, 0xb2, 0xef, 0x8b, 0xfd,
+		0xc3, 0x51, 0xb6, 0xe7, 0x60, 0xa9, 0xbf, 0xdf, 0x1f, 0xe7, 0x3b, 0xef, 0xe5, 0x93, 0xfb, 0xfb,
+		0xfd, 0x51, 0xc6, 0x87, 0x69, 0x94, 0x65, 0x40, 0x5a, 0xd4, 0x3a, 0xbc, 0xcf, 0x4b, 0xee, 0x29,
+		0x90, 0x57, 0x40, 0x6a, 0xb5, 0x34, 0x62, 0xe8, 0x3b, 0x5d, 0xa2, 0xe9, 0x03, 0x62, 0xe8, 0x56,
+		0xfe, 0x2c, 0x25, 0x8e, 0xda, 0x83, 0x21, 0x51, 0xb3, 0xad, 0x56, 0x8d, 0x16, 0x96, 0x68, 0x99,
+		0x7c, 0x1e, 0x16, 0xcc, 0x9d, 0x37, 0x5a, 0x6c, 0x44, 0x6a, 0xfd, 0x01, 0xd9, 0xed, 0x1c, 0xe4,
+		0xdf, 0x45, 0xc5, 0x9b, 0xc3, 0x02, 0x3a, 0x1e, 0xb7, 0x68, 0xb6, 0xfc, 0x18, 0x48, 0x2d, 0x6b,
+		0x5f, 0x1f, 0xf4, 0xa9, 0x4a, 0xb6, 0xfa, 0x7a, 0x8b, 0xe4, 0x1f, 0x66, 0xa4, 0x2c, 0x7f, 0x43,
+		0x64, 0xe3, 0x8c, 0xb0, 0x6e, 0x75, 0x76, 0x6d, 0x81, 0xf8, 0x28, 0x9b, 0x11, 0x34, 0x8f, 0xa3,
+		0x9d, 0x03, 0x09, 0x25, 0xe1, 0xfb, 0xf0, 0x39, 0x4a, 0x96, 0xed, 0xef, 0xf7, 0xbd, 0xdf, 0x7d,
+		0x08, 0x32, 0xfd, 0x7d, 0xef, 0x47, 0x1f, 0x63, 0x86, 0x5b, 0x7f, 0xdf, 0xf3, 0xc5, 0x67, 0xe1,
+		0x24, 0x12, 0xf5, 0x88, 0xad, 0xb7, 0x75, 0x5b, 0xf7, 0x50, 0x3f, 0x41, 0xa9, 0x51, 0xec, 0xeb,
+		0xbc, 0xd0, 0x57, 0xcf, 0xc1, 0x70, 0xe7, 0xd0, 0x19, 0x58, 0x4f, 0xb2, 0x7a, 0x62, 0x9e, 0x18,
+		0x5a, 0xef, 0x98, 0x37, 0xa5, 0x14, 0x21, 0xed, 0x1d, 0xf7, 0x72, 0x12, 0xd8, 0xc8, 0x97, 0x42,
+		0x68, 0x04, 0x55, 0x36, 0xab, 0x68, 0xbe, 0xbc, 0x5e, 0x93, 0xc2, 0x68, 0x46, 0xad, 0xd5, 0x9b,
+		0x35, 0x4d, 0xdd, 0xde, 0x68, 0xd6, 0xd7, 0x6b, 0x52, 0xc4, 0x63, 0xd8, 0x5f, 0x8b, 0x26, 0x1e,
+		0x91, 0x1e, 0x55, 0x7e, 0x21, 0x02, 0x59, 0xbf, 0x6f, 0x2d, 0xbf, 0x1b, 0xee, 0x13, 0x21, 0x32,
+		0x8b, 0xd8, 0xda, 0xad, 0xce, 0x80, 0x4e, 0xc8, 0x9e, 0xce, 0x16, 0x47, 0x67, 0xfc, 0x2c, 0x71,
+		0xaa, 0x06, 0xb1, 0x5f, 0xe9, 0x0c, 0x70, 0xba, 0xf5, 0x74, 0x5b, 0x5e, 0x83, 0xb3, 0x86, 0xa9,
+		0x59, 0xb6, 0x6e, 0xb4, 0xf5, 0x41, 0x5b, 0x73, 0x83, 0x93, 0x9a, 0xde, 0x6a, 0x11, 0xcb, 0x32,
+		0xd9, 0x42, 0xe8, 0xa0, 0xdc, 0x6f, 0x98, 0x0d, 0x4e, 0xec, 0xae, 0x10, 0x25, 0x4e, 0x3a, 0x32,
+		0x7c, 0x23, 0xd3, 0x86, 0xef, 0x69, 0x48, 0xf6, 0xf4, 0xbe, 0x46, 0x0c, 0x7b, 0x70, 0x48, 0xed,
+		0xf3, 0x84, 0x9a, 0xe8, 0xe9, 0xfd, 0x1a, 0xa6, 0xe5, 0xeb, 0xf0, 0x88, 0x4b, 0xaa, 0x75, 0xc9,
+		0x9e, 0xde, 0x3a, 0xd4, 0xa8, 0x31, 0x4e, 0x03, 0x3d, 0x5a, 0xcb, 0x34, 0x76, 0xbb, 0x9d, 0x96,
+		0x6d, 0xe5, 0x53, 0x8e, 0x8e, 0x53, 0x5c, 0x8e, 0x35, 0xca, 0x70, 0xcd, 0x32, 0x0d, 0x6a, 0x83,
+		0x57, 0x04, 0xf5, 0x3b, 0xd7, 0xc3, 0xfe, 0x5e, 0x8a, 0x4a, 0xb1, 0x6b, 0xd1, 0x44, 0x4c, 0x8a,
+		0x5f, 0x8b, 0x26, 0xe2, 0xd2, 0xfc, 0xb5, 0x68, 0x22, 0x21, 0x25, 0xaf, 0x45, 0x13, 0x49, 0x09,
+		0x94, 0x0f, 0x25, 0x21, 0xed, 0xf5, 0x0c, 0xd0, 0xd1, 0x6a, 0xd1, 0xb5, 0x31, 0x44, 0xb5, 0xe7,
+		0x43, 0x47, 0xfa, 0x11, 0x2b, 0x15, 0x5c, 0x34, 0x8b, 0x71, 0x66, 0x86, 0xab, 0x8c, 0x13, 0x0d,
+		0x16, 0x1c, 0xd6, 0x84, 0x99, 0x3d, 0x09, 0x95, 0xa7, 0xe4, 0x55, 0x88, 0xbf, 0x61, 0x51, 0xec,
+		0x38, 0xc5, 0x7e, 0xd7, 0xd1, 0xd8, 0xd7, 0x1a, 0x14, 0x3c, 0x79, 0xad, 0xa1, 0x6d, 0x6c, 0xaa,
+		0xeb, 0xa5, 0x35, 0x95, 0xb3, 0xcb, 0xa7, 0x20, 0xda, 0xd5, 0xdf, 0x3c, 0xf4, 0x2f, 0xaf, 0x34,
+		0x4b, 0x5e, 0x81, 0xdc, 0xd0, 0x60, 0x5e, 0x37, 0x76, 0x15, 0x52, 0xe5, 0xbc, 0x54, 0x59, 0xb7,
+		0x74, 0x0d, 0xe9, 0x67, 0x1c, 0x1e, 0xa7, 0x20, 0x8a, 0x61, 0x60, 0xff, 0x22, 0x48, 0xb3, 0xe4,
+		0x73, 0x90, 0x6e, 0x93, 0x9d, 0xe1, 0x9e, 0x36, 0x20, 0x6d, 0xbd, 0x65, 0xfb, 0x55, 0x7f, 0x8a,
+		0x16, 0xa9, 0xb4, 0x44, 0x7e, 0x19, 0x92, 0xd8, 0x47, 0x06, 0xed, 0xe3, 0x05, 0x2a, 0x82, 0x27,
+		0x8f, 0x16, 0x01, 0xfb, 0x5f, 0x15, 0x4c, 0xaa, 0xcb, 0x2f, 0x5f, 0x83, 0xb8, 0xad, 0x0f, 0xf6,
+		0x88, 0x4d, 0x35, 0x7f, 0xf6, 0xe2, 0xca, 0x2c, 0x48, 0x4d, 0xca, 0x81, 0x62, 0xa5, 0x63, 0x94,
+		0x23, 0xc8, 0x57, 0x61, 0x9e, 0xfd, 0xb2, 0xf2, 0x8b, 0xcb, 0x91, 0xe3, 0x83, 0xa9, 0x82, 0xfd,
+		0x1d, 0xd4, 0x59, 0x17, 0x20, 0x46, 0x07, 0x9b, 0x0c, 0xc0, 0x87, 0x9b, 0x34, 0x27, 0x27, 0x20,
+		0x5a, 0xd9, 0x54, 0x51, 0x6f, 0x49, 0x90, 0x66, 0xb9, 0xda, 0x56, 0xbd, 0x56, 0xa9, 0x49, 0x61,
+		0xe5, 0x12, 0xc4, 0xd9, 0x08, 0x42, 0x9d, 0xe6, 0x8c, 0x21, 0x69, 0x8e, 0x27, 0x39, 0x46, 0x48,
+		0x94, 0x6e, 0xaf, 0x97, 0x6b, 0xaa, 0x14, 0x56, 0xb6, 0x21, 0x37, 0x22, 0x75, 0xf9, 0x04, 0x2c,
+		0xa8, 0xb5, 0x66, 0x6d, 0x03, 0xbd, 0x36, 0x6d, 0x7b, 0xe3, 0xe5, 0x8d, 0xcd, 0x57, 0x30, 0xe4,
+		0xe1, 0xcb, 0x16, 0x0a, 0x32, 0x24, 0x2f, 0x81, 0xe4, 0x66, 0x37, 0x36, 0xb7, 0x55, 0x5a, 0x9b,
+		0x6f, 0x09, 0x83, 0x34, 0x2a, 0x36, 0xf9, 0x3e, 0x58, 0x6c, 0x96, 0xd4, 0xd5, 0x5a, 0x53, 0x63,
+		0x9e, 0xa8, 0x03, 0xbd, 0x04, 0x92, 0xb7, 0xe0, 0x4a, 0x9d, 0x3a, 0xda, 0x67, 0xe1, 0xb4, 0x37,
+		0xb7, 0xf6, 0x6a, 0xb3, 0xb6, 0xd1, 0xa0, 0x1f, 0x2f, 0x6d, 0xac, 0xa2, 0xb6, 0x1e, 0xc1, 0x13,
+		0xbe, 0x6f, 0x04, 0xab, 0xea, 0xc7, 0xab, 0xad, 0x55, 0xa5, 0xe8, 0x68, 0xf6, 0xe6, 0x46, 0x6d,
+		0xf3, 0x8a, 0x14, 0x1b, 0xfd, 0x3a, 0xf5, 0x87, 0xe3, 0x72, 0x01, 0x4e, 0x8e, 0xe6, 0x6a, 0xb5,
+		0x8d, 0xa6, 0xfa, 0x9a, 0x34, 0x3f, 0xfa, 0xe1, 0x46, 0x4d, 0xbd, 0x5e, 0xaf, 0xd4, 0xa4, 0x84,
+		0x7c, 0x12, 0x64, 0x7f, 0x8d, 0x9a, 0x57, 0x37, 0xab, 0x52, 0x72, 0x4c, 0x3f, 0x29, 0x16, 0xa4,
+		0xbd, 0x4e, 0xe9, 0x97, 0x45, 0x35, 0x2a, 0x1f, 0x0d, 0x43, 0xca, 0xe3, 0x64, 0xa2, 0x77, 0xa0,
+		0x77, 0xbb, 0xe6, 0x2d, 0x4d, 0xef, 0x76, 0x74, 0x8b, 0x6b, 0x2f, 0xa0, 0x59, 0x25, 0xcc, 0x99,
+		0x55, 0x5b, 0xcc, 0xbe, 0x5e, 0xc4, 0xbf, 0x12, 0xd7, 0x8b, 0x98, 0x14, 0x57, 0xbe, 0x37, 0x04,
+		0xd2, 0xa8, 0xf7, 0x38, 0xd2, 0xfc, 0xd0, 0xb4, 0xe6, 0x7f, 0x59, 0xfa, 0xee, 0x63, 0x21, 0xc8,
+		0xfa, 0x5d, 0xc6, 0x91, 0xea, 0x3d, 0xf8, 0xff, 0xb4, 0x7a, 0xbf, 0x1b, 0x86, 0x8c, 0xcf, 0x51,
+		0x9c, 0xb5, 0x76, 0x1f, 0x80, 0x85, 0x4e, 0x9b, 0xf4, 0xfa, 0xa6, 0x8d, 0xbb, 0x8d, 0x5a, 0x97,
+		0xdc, 0x24, 0xdd, 0xbc, 0x42, 0x55, 0xfc, 0x85, 0xa3, 0x5d, 0xd1, 0x95, 0xba, 0xcb, 0xb7, 0x86,
+		0x6c, 0xc5, 0xc5, 0x7a, 0xb5, 0xb6, 0xbe, 0xb5, 0xd9, 0xac, 0x6d, 0x54, 0x5e, 0x13, 0xda, 0x45,
+		0x95, 0x3a, 0x23, 0x64, 0xef, 0xa0, 0xd2, 0xde, 0x02, 0x69, 0xb4, 0x52, 0xa8, 0x2b, 0x26, 0x54,
+		0x4b, 0x9a, 0x93, 0x17, 0x21, 0xb7, 0xb1, 0xa9, 0x35, 0xea, 0xd5, 0x9a, 0x56, 0xbb, 0x72, 0xa5,
+		0x56, 0x69, 0x36, 0x58, 0x70, 0xd1, 0xa1, 0x6e, 0x4a, 0x61, 0xaf, 0x88, 0xbf, 0x3b, 0x02, 0x8b,
+		0x13, 0x6a, 0x22, 0x97, 0x78, 0x58, 0x80, 0x45, 0x2a, 0x9e, 0x9c, 0xa5, 0xf6, 0x2b, 0x68, 0x98,
+		0x6f, 0xe9, 0x03, 0x9b, 0x47, 0x11, 0x1e, 0x03, 0x94, 0x92, 0x61, 0xa3, 0x9d, 0x30, 0xe0, 0x41,
+		0x5b, 0x16, 0x2b, 0xc8, 0xb9, 0xf9, 0x2c, 0x6e, 0xfb, 0x04, 0xc8, 0x7d, 0xd3, 0xea, 0xd8, 0x9d,
+		0x9b, 0xb8, 0x87, 0x29, 0x22, 0xbc, 0x18, 0x3b, 0x88, 0xaa, 0x92, 0x28, 0xa9, 0x1b, 0xb6, 0x43,
+		0x6d, 0x90, 0x3d, 0x7d, 0x84, 0x1a, 0xed, 0x98, 0x88, 0x2a, 0x89, 0x12, 0x87, 0xfa, 0x41, 0x48,
+		0xb7, 0xcd, 0x21, 0x3a, 0x54, 0x8c, 0x0e, 0xb5, 0x45, 0x48, 0x4d, 0xb1, 0x3c, 0x87, 0x84, 0xbb,
+		0xca, 0x6e, 0x68, 0x39, 0xad, 0xa6, 0x58, 0x1e, 0x23, 0x79, 0x14, 0x72, 0xfa, 0xde, 0xde, 0x00,
+		0xc1, 0x05, 0x10, 0x73, 0xfe, 0xb3, 0x4e, 0x36, 0x25, 0x2c, 0x5c, 0x83, 0x84, 0x90, 0x03, 0xda,
+		0xc3, 0x28, 0x09, 0xad, 0xcf, 0x22, 0x5a, 0x61, 0x8c, 0x36, 0x1b, 0xa2, 0xf0, 0x41, 0x48, 0x77,
+		0x2c, 0xcd, 0xdd, 0xdb, 0x0c, 0x2f, 0x87, 0xcf, 0x25, 0xd4, 0x54, 0xc7, 0x72, 0xf6, 0x48, 0x94,
+		0x1f, 0x09, 0x43, 0xd6, 0xbf, 0x6b, 0x2b, 0x57, 0x21, 0xd1, 0x35, 0xf9, 0x26, 0x0b, 0x3b, 0x32,
+		0x70, 0x2e, 0x60, 0xa3, 0x77, 0x65, 0x8d, 0xd3, 0xab, 0x0e, 0x67, 0xe1, 0xdf, 0x85, 0x20, 0x21,
+		0xb2, 0xe5, 0x93, 0x10, 0xed, 0xeb, 0xf6, 0x3e, 0x85, 0x8b, 0x95, 0xc3, 0x52, 0x48, 0xa5, 0x69,
+		0xcc, 0xb7, 0xfa, 0x3a, 0xdb, 0x27, 0xe2, 0xf9, 0x98, 0xc6, 0x7e, 0xed, 0x12, 0xbd, 0x4d, 0x23,
+		0x0b, 0x66, 0xaf, 0x47, 0x0c, 0xdb, 0x12, 0xfd, 0xca, 0xf3, 0x2b, 0x3c, 0x1b, 0x0f, 0x0f, 0xd8,
+		0x03, 0xbd, 0xd3, 0xf5, 0xd1, 0x46, 0x29, 0xad, 0x24, 0x0a, 0x1c, 0xe2, 0x22, 0x9c, 0x12, 0xb8,
+		0x6d, 0x62, 0xeb, 0xad, 0x7d, 0xd2, 0x76, 0x99, 0xe2, 0x34, 0x82, 0x78, 0x1f, 0x27, 0xa8, 0xf2,
+		0x72, 0xc1, 0xab, 0x7c, 0x3a, 0x0c, 0x0b, 0x22, 0x16, 0xd2, 0x76, 0x84, 0xb5, 0x0e, 0xa0, 0x1b,
+		0x86, 0x69, 0x7b, 0xc5, 0x35, 0x3e, 0x94, 0xc7, 0xf8, 0x56, 0x4a, 0x0e, 0x93, 0xea, 0x01, 0x28,
+		0xfc, 0x51, 0x08, 0xc0, 0x2d, 0x9a, 0x2a, 0xb7, 0xb3, 0x90, 0xe2, 0x7b, 0xf2, 0xf4, 0x60, 0x07,
+		0x0b, 0x9f, 0x01, 0xcb, 0xc2, 0xa8, 0x09, 0x06, 0x39, 0x77, 0xc8, 0x5e, 0xc7, 0xe0, 0xbb, 0x33,
+		0x2c, 0x21, 0x82, 0x9c, 0x51, 0x77, 0x7b, 0x52, 0x85, 0x84, 0x45, 0x7a, 0xba, 0x61, 0x77, 0x5a,
+		0x7c, 0xbf, 0xe5, 0xf2, 0xb1, 0x2a, 0xbf, 0xd2, 0xe0, 0xdc, 0xaa, 0x83, 0xa3, 0x9c, 0x83, 0x84,
+		0xc8, 0x45, 0xc3, 0x6f, 0x63, 0x73, 0xa3, 0x26, 0xcd, 0xc9, 0xf3, 0x10, 0x69, 0xd4, 0x9a, 0x52,
+		0x08, 0x9d, 0xd8, 0xd2, 0x5a, 0xbd, 0xd4, 0x90, 0xc2, 0xe5, 0xbf, 0x01, 0x8b, 0x2d, 0xb3, 0x37,
+		0xfa, 0xc1, 0xb2, 0x34, 0x12, 0x40, 0xb4, 0xae, 0x86, 0x5e, 0x7f, 0x92, 0x13, 0xed, 0x99, 0x5d,
+		0xdd, 0xd8, 0x5b, 0x31, 0x07, 0x7b, 0xee, 0xb1, 0x18, 0xf4, 0x35, 0x2c, 0xcf, 0xe1, 0x98, 0xfe,
+		0xce, 0xff, 0x0a, 0x85, 0xbe, 0x3f, 0x1c, 0x59, 0xdd, 0x2a, 0xff, 0x58, 0xb8, 0xb0, 0xca, 0x18,
+		0xb7, 0x44, 0x73, 0x54, 0xb2, 0xdb, 0x25, 0x2d, 0xac, 0x3c, 0xfc, 0xf1, 0xe3, 0xb0, 0xb4, 0x67,
+		0xee, 0x99, 0x14, 0xe9, 0x02, 0xfe, 0x62, 0x95, 0x90, 0x93, 0x4e, 0x6e, 0x21, 0xf0, 0x10, 0x4e,
+		0x71, 0x03, 0x16, 0x39, 0xb1, 0x46, 0xb7, 0xef, 0x59, 0xa8, 0x42, 0x3e, 0x32, 0x4e, 0x9e, 0xff,
+		0xa9, 0xdf, 0xa7, 0x56, 0x89, 0xba, 0xc0, 0x59, 0xb1, 0x8c, 0x45, 0x33, 0x8a, 0x2a, 0x9c, 0xf0,
+		0xe1, 0x31, 0x1d, 0x41, 0x06, 0x01, 0x88, 0xbf, 0xc2, 0x11, 0x17, 0x3d, 0x88, 0x0d, 0xce, 0x5a,
+		0xac, 0x40, 0xe6, 0x38, 0x58, 0xff, 0x9a, 0x63, 0xa5, 0x89, 0x17, 0x64, 0x15, 0x72, 0x14, 0xa4,
+		0x35, 0xb4, 0x6c, 0xb3, 0x47, 0x15, 0xf0, 0xd1, 0x30, 0xff, 0xe6, 0xf7, 0xd9, 0xa4, 0xcd, 0x22,
+		0x5b, 0xc5, 0xe1, 0x2a, 0x16, 0x81, 0x9e, 0x58, 0xc0, 0xdd, 0xdd, 0x00, 0x84, 0x5f, 0xe5, 0x15,
+		0x71, 0xe8, 0x8b, 0xd7, 0x61, 0x09, 0x7f, 0x53, 0xfd, 0xe8, 0xad, 0x49, 0x70, 0x50, 0x3d, 0xff,
+		0x1b, 0x1f, 0x64, 0x7a, 0x61, 0xd1, 0x01, 0xf0, 0xd4, 0xc9, 0xd3, 0x8b, 0x7b, 0xc4, 0xb6, 0xc9,
+		0xc0, 0xd2, 0xf4, 0xee, 0xa4, 0xea, 0x79, 0xa2, 0x92, 0xf9, 0xef, 0xfa, 0xbc, 0xbf, 0x17, 0x57,
+		0x19, 0x67, 0xa9, 0xdb, 0x2d, 0x6e, 0xc3, 0x7d, 0x13, 0x46, 0xc5, 0x0c, 0x98, 0xdf, 0xcd, 0x31,
+		0x97, 0xc6, 0x46, 0x06, 0xc2, 0x6e, 0x81, 0xc8, 0x77, 0xfa, 0x72, 0x06, 0xcc, 0xef, 0xe1, 0x98,
+		0x32, 0xe7, 0x15, 0x5d, 0x8a, 0x88, 0xd7, 0x60, 0xe1, 0x26, 0x19, 0xec, 0x98, 0x16, 0x8f, 0x04,
+		0xcf, 0x00, 0xf7, 0x31, 0x0e, 0x97, 0xe3, 0x8c, 0x34, 0x34, 0x8c, 0x58, 0x2f, 0x40, 0x62, 0x57,
+		0x6f, 0x91, 0x19, 0x20, 0xee, 0x70, 0x88, 0x79, 0xa4, 0x47, 0xd6, 0x12, 0xa4, 0xf7, 0x4c, 0xbe,
+		0x44, 0x06, 0xb3, 0x7f, 0x2f, 0x67, 0x4f, 0x09, 0x1e, 0x0e, 0xd1, 0x37, 0xfb, 0xc3, 0x2e, 0xae,
+		0x9f, 0xc1, 0x10, 0xdf, 0x27, 0x20, 0x04, 0x0f, 0x87, 0x38, 0x86, 0x58, 0x3f, 0x2e, 0x20, 0x2c,
+		0x8f, 0x3c, 0x5f, 0xc2, 0x0d, 0xe2, 0xee, 0xa1, 0x69, 0xcc, 0x52, 0x89, 0x4f, 0x70, 0x04, 0xe0,
+		0x2c, 0x08, 0xf0, 0x22, 0x24, 0x67, 0xed, 0x88, 0x1f, 0xfa, 0xbc, 0x98, 0x1e, 0xa2, 0x07, 0x56,
+		0x21, 0x27, 0x14, 0x14, 0x1e, 0x01, 0x0a, 0x86, 0xf8, 0x61, 0x0e, 0x91, 0xf5, 0xb0, 0xf1, 0x66,
+		0xd8, 0xc4, 0xb2, 0xf7, 0xc8, 0x2c, 0x20, 0x3f, 0x22, 0x9a, 0xc1, 0x59, 0xb8, 0x28, 0x77, 0x88,
+		0xd1, 0xda, 0x9f, 0x0d, 0xe1, 0x93, 0x42, 0x94, 0x82, 0x07, 0x21, 0x2a, 0x90, 0xe9, 0xe9, 0x03,
+		0x6b, 0x5f, 0xef, 0xce, 0xd4, 0x1d, 0x3f, 0xca, 0x31, 0xd2, 0x0e, 0x13, 0x97, 0xc8, 0xd0, 0x38,
+		0x0e, 0xcc, 0x8f, 0x09, 0x89, 0x0c, 0x0d, 0x1f, 0xd0, 0x16, 0x2c, 0x59, 0x36, 0x0d, 0x9b, 0x1f,
+		0x07, 0xed, 0x1f, 0x8a, 0xa9, 0xc7, 0x78, 0xd7, 0xbd, 0x88, 0x2f, 0x42, 0xd2, 0xea, 0xbc, 0x39,
+		0x13, 0xcc, 0x8f, 0x8b, 0x9e, 0xa6, 0x0c, 0xc8, 0xfc, 0x1a, 0x9c, 0x9a, 0xb8, 0x4c, 0xcc, 0x00,
+		0xf6, 0x13, 0x1c, 0xec, 0xe4, 0x84, 0xa5, 0x82, 0xab, 0x84, 0xe3, 0x42, 0xfe, 0x23, 0xa1, 0x12,
+		0xc8, 0x08, 0xd6, 0x16, 0x3a, 0x2d, 0x96, 0xbe, 0x7b, 0x3c, 0xa9, 0xfd, 0x63, 0x21, 0x35, 0xc6,
+		0xeb, 0x93, 0x5a, 0x13, 0x4e, 0x72, 0xc4, 0xe3, 0xf5, 0xeb, 0x4f, 0x0a, 0xc5, 0xca, 0xb8, 0xb7,
+		0xfd, 0xbd, 0xfb, 0xb5, 0x50, 0x70, 0xc4, 0x29, 0xac, 0x63, 0x4b, 0xc3, 0x58, 0x73, 0x30, 0xf2,
+		0x4f, 0x71, 0x64, 0xa1, 0xf1, 0x1d, 0xf3, 0xda, 0x5a, 0xd7, 0xfb, 0x08, 0xfe, 0x2a, 0xe4, 0x05,
+		0xf8, 0xd0, 0x18, 0x90, 0x96, 0xb9, 0x67, 0x74, 0xde, 0x24, 0xed, 0x19, 0xa0, 0x7f, 0x7a, 0xa4,
+		0xab, 0xb6, 0x3d, 0xec, 0x88, 0x5c, 0x07, 0xc9, 0xb1, 0x55, 0xb4, 0x4e, 0xaf, 0x6f, 0x0e, 0xec,
+		0x00, 0xc4, 0x4f, 0x89, 0x9e, 0x72, 0xf8, 0xea, 0x94, 0xad, 0x58, 0x03, 0x76, 0x96, 0x64, 0xd6,
+		0x21, 0xf9, 0x33, 0x1c, 0x28, 0xe3, 0x72, 0x71, 0xc5, 0xd1, 0x32, 0x7b, 0x7d, 0x7d, 0x30, 0x8b,
+		0xfe, 0xfb, 0x27, 0x42, 0x71, 0x70, 0x16, 0xae, 0x38, 0xd0, 0xa2, 0xc3, 0xd5, 0x7e, 0x06, 0x84,
+		0x9f, 0x15, 0x8a, 0x43, 0xf0, 0x70, 0x08, 0x61, 0x30, 0xcc, 0x00, 0xf1, 0x4f, 0x05, 0x84, 0xe0,
+		0x41, 0x88, 0xf7, 0xb9, 0x0b, 0xed, 0x80, 0xec, 0x75, 0x2c, 0x9b, 0x1f, 0x06, 0x3b, 0x1a, 0xea,
+		0xe7, 0x3e, 0xef, 0x37, 0xc2, 0x54, 0x0f, 0x2b, 0x6a, 0x22, 0xbe, 0x91, 0x42, 0x5d, 0xb6, 0xe0,
+		0x8a, 0xfd, 0xbc, 0xd0, 0x44, 0x1e, 0x36, 0xac, 0x9b, 0xc7, 0x42, 0x44, 0xb1, 0xb7, 0xd0, 0x51,
+		0x99, 0x01, 0xee, 0x9f, 0x8d, 0x54, 0xae, 0x21, 0x78, 0x11, 0xd3, 0x63, 0xff, 0x0c, 0x8d, 0x1b,
+		0xe4, 0x70, 0xa6, 0xd1, 0xf9, 0x0b, 0x23, 0xf6, 0xcf, 0x36, 0xe3, 0x64, 0x3a, 0x24, 0x37, 0x62,
+		0x4f, 0xc9, 0x41, 0x67, 0x3d, 0xf3, 0xdf, 0xf0, 0x05, 0xde, 0x5e, 0xbf, 0x39, 0x55, 0x5c, 0x03,
+		0x89, 0xe7, 0xb8, 0x06, 0x6c, 0x20, 0xd8, 0x07, 0xbf, 0xe0, 0x8c, 0x73, 0x9f, 0xcd, 0x53, 0xbc,
+		0x02, 0x19, 0x9f, 0xc1, 0x13, 0x0c, 0xf5, 0x37, 0x39, 0x54, 0xda, 0x6b, 0xef, 0x14, 0x2f, 0x41,
+		0x14, 0x8d, 0x97, 0x60, 0xf6, 0x0f, 0x71, 0x76, 0x4a, 0x5e, 0x7c, 0x0f, 0x24, 0x84, 0xd1, 0x12,
+		0xcc, 0xfa, 0x4d, 0x9c, 0xd5, 0x61, 0x41, 0x76, 0x61, 0xb0, 0x04, 0xb3, 0xff, 0x2d, 0xc1, 0x2e,
+		0x58, 0x90, 0x7d, 0x76, 0x11, 0xfe, 0xd2, 0xdf, 0x8e, 0x32, 0x76, 0xc1, 0x52, 0xc4, 0xb3, 0x2c,
+		0xcc, 0x52, 0x09, 0xe6, 0xfe, 0x66, 0xfe, 0x71, 0xc1, 0x51, 0x7c, 0x0e, 0x62, 0x33, 0x0a, 0xfc,
+		0xef, 0x70, 0x56, 0x46, 0x5f, 0xac, 0x40, 0xca, 0x63, 0x9d, 0x04, 0xb3, 0x7f, 0x2b, 0x67, 0xf7,
+		0x72, 0x61, 0xd5, 0xb9, 0x75, 0x12, 0x0c, 0xf0, 0x77, 0x45, 0xd5, 0x39, 0x07, 0x8a, 0x4d, 0x18,
+		0x26, 0xc1, 0xdc, 0x1f, 0x11, 0x52, 0x17, 0x2c, 0xc5, 0x97, 0x20, 0xe9, 0x2c, 0x36, 0xc1, 0xfc,
+		0xdf, 0xc6, 0xf9, 0x5d, 0x1e, 0x94, 0xc0, 0xd0, 0x38, 0x06, 0xc4, 0xdf, 0x13, 0x12, 0xf0, 0x70,
+		0xe1, 0x34, 0x1a, 0x35, 0x60, 0x82, 0x91, 0xbe, 0x5d, 0x4c, 0xa3, 0x11, 0xfb, 0x05, 0x7b, 0x93,
+		0xea, 0xfc, 0x60, 0x88, 0xbf, 0x2f, 0x7a, 0x93, 0xd2, 0x63, 0x35, 0x46, 0x2d, 0x82, 0x60, 0x8c,
+		0xef, 0x14, 0xd5, 0x18, 0x31, 0x08, 0x8a, 0x5b, 0x20, 0x8f, 0x5b, 0x03, 0xc1, 0x78, 0x1f, 0xe5,
+		0x78, 0x0b, 0x63, 0xc6, 0x40, 0xf1, 0x15, 0x38, 0x39, 0xd9, 0x12, 0x08, 0x46, 0xfd, 0xae, 0x2f,
+		0x8c, 0xf8, 0x6e, 0x5e, 0x43, 0xa0, 0xd8, 0x84, 0xa5, 0x49, 0x56, 0x40, 0x30, 0xec, 0x77, 0x7f,
+		0xc1, 0xaf, 0xb8, 0xbd, 0x46, 0x40, 0xb1, 0x04, 0xe0, 0x2e, 0xc0, 0xc1, 0x58, 0x1f, 0xe3, 0x58,
+		0x1e, 0x26, 0x9c, 0x1a, 0x7c, 0xfd, 0x0d, 0xe6, 0xbf, 0x23, 0xa6, 0x06, 0xe7, 0xc0, 0xa9, 0x21,
+		0x96, 0xde, 0x60, 0xee, 0xef, 0x15, 0x53, 0x43, 0xb0, 0xe0, 0xc8, 0xf6, 0xac, 0x6e, 0xc1, 0x08,
+		0x9f, 0x10, 0x23, 0xdb, 0xc3, 0x55, 0xdc, 0x80, 0x85, 0xb1, 0x05, 0x31, 0x18, 0xea, 0xfb, 0x39,
+		0x94, 0x34, 0xba, 0x1e, 0x7a, 0x17, 0x2f, 0xbe, 0x18, 0x06, 0xa3, 0xfd, 0xc0, 0xc8, 0xe2, 0xc5,
+		0xd7, 0xc2, 0xe2, 0x8b, 0x90, 0x30, 0x86, 0xdd, 0x2e, 0x4e, 0x1e, 0xf9, 0xe8, 0xd3, 0xbe, 0xf9,
+		0xff, 0xf6, 0x45, 0x2e, 0x1d, 0xc1, 0x50, 0xbc, 0x04, 0x31, 0xd2, 0xdb, 0x21, 0xed, 0x20, 0xce,
+		0x3f, 0xfc, 0xa2, 0x50, 0x98, 0x48, 0x5d, 0x7c, 0x09, 0x80, 0x85, 0x46, 0xe8, 0x36, 0x7c, 0x00,
+		0xef, 0x1f, 0x7d, 0x91, 0x1f, 0xaf, 0x73, 0x59, 0x5c, 0x00, 0x76, 0x58, 0xef, 0x68, 0x80, 0xcf,
+		0xfb, 0x01, 0x68, 0x8f, 0xbc, 0x00, 0xf3, 0xb8, 0x91, 0x66, 0xeb, 0x7b, 0x41, 0xdc, 0x7f, 0xcc,
+		0xb9, 0x05, 0x3d, 0x0a, 0xac, 0x67, 0x0e, 0x88, 0xad, 0xef, 0x59, 0x41, 0xbc, 0xff, 0x9d, 0xf3,
+		0x3a, 0x0c, 0xc8, 0xdc, 0xd2, 0x2d, 0x7b, 0x96, 0x76, 0xff, 0x89, 0x60, 0x16, 0x0c, 0x58, 0x69,
+		0xfc, 0x7d, 0x83, 0x1c, 0x06, 0xf1, 0xfe, 0xa9, 0xa8, 0x34, 0xa7, 0x2f, 0xbe, 0x07, 0x92, 0xf8,
+		0x93, 0x9d, 0x99, 0x0d, 0x60, 0xfe, 0x33, 0xce, 0xec, 0x72, 0xe0, 0x97, 0x2d, 0xbb, 0x6d, 0x77,
+		0x82, 0x85, 0xfd, 0xe7, 0xbc, 0xa7, 0x05, 0x7d, 0xb1, 0x04, 0x29, 0xcb, 0x6e, 0xb7, 0x87, 0xdc,
+		0x3e, 0x0d, 0x60, 0xff, 0x1f, 0x5f, 0x74, 0x42, 0x16, 0x0e, 0x0f, 0xf6, 0xf6, 0xad, 0x1b, 0x76,
+		0xdf, 0xa4, 0xfb, 0x2d, 0x41, 0x08, 0x5f, 0xe0, 0x08, 0x1e, 0x96, 0x62, 0x05, 0xd2, 0xd8, 0x16,
+		0x71, 0x17, 0x21, 0x08, 0xe2, 0x2f, 0xb8, 0x00, 0x7c, 0x4c, 0xe5, 0xaf, 0xff, 0xd5, 0xcf, 0x9c,
+		0x09, 0x7d, 0xfa, 0x33, 0x67, 0x42, 0xbf, 0xfb, 0x99, 0x33, 0xa1, 0x8f, 0x7c, 0xf6, 0xcc, 0xdc,
+		0xa7, 0x3f, 0x7b, 0x66, 0xee, 0xb7, 0x3e, 0x7b, 0x66, 0x6e, 0x72, 0x94, 0x18, 0x56, 0xcd, 0x55,
+		0x93, 0xc5, 0x87, 0x5f, 0x7f, 0x78, 0xaf, 0x63, 0xef, 0x0f, 0x77, 0x56, 0x5a, 0x66, 0xef, 0x42,
+		0xcb, 0xb4, 0x7a, 0xa6, 0x75, 0xc1, 0x1f, 0xd7, 0xa5, 0xbf, 0xe0, 0x2f, 0x43, 0x70, 0x8a, 0xc1,
+		0xb8, 0xe1, 0x5c, 0xdd, 0x38, 0x9c, 0x76, 0x99, 0xf2, 0x32, 0x44, 0x4a, 0xc6, 0xa1, 0x7c, 0x8a,
+		0x29, 0x38, 0x6d, 0x38, 0xe8, 0xf2, 0x83, 0x9b, 0xf3, 0x98, 0xde, 0x1e, 0x74, 0x31, 0xcc, 0x2e,
+		0x4e, 0x57, 0xe3, 0x76, 0x0e, 0x4b, 0x94, 0xbf, 0x35, 0x74, 0xbc, 0x96, 0x24, 0x4a, 0xc6, 0x21,
+		0x6d, 0xc8, 0x56, 0xe8, 0xf5, 0x27, 0x02, 0xe3, 0xdc, 0x37, 0x0c, 0xf3, 0x96, 0x81, 0xd5, 0xee,
+		0xef, 0x88, 0x18, 0xf7, 0x99, 0xd1, 0x18, 0xf7, 0x2b, 0xa4, 0xdb, 0x7d, 0x19, 0xe9, 0xf0, 0xf8,
+		0x82, 0xb5, 0x13, 0x67, 0x77, 0x04, 0xe0, 0xdb, 0xc3, 0x70, 0x66, 0x2c, 0x9c, 0xcd, 0x07, 0xc1,
+		0x34, 0x21, 0x14, 0x21, 0x51, 0x15, 0x63, 0x2b, 0x8f, 0x57, 0x19, 0x5b, 0xa6, 0xd1, 0xb6, 0xa8,
+		0x20, 0x22, 0xaa, 0x48, 0xa2, 0x20, 0x0c, 0xdd, 0x30, 0x2d, 0x7e, 0xf4, 0x99, 0x25, 0xca, 0xdf,
+		0x73, 0x4c, 0x41, 0x64, 0xc4, 0x97, 0x84, 0x34, 0x9e, 0x9e, 0x51, 0x1a, 0xa2, 0x11, 0xbe, 0xc8,
+		0xff, 0xac, 0x52, 0xf9, 0xce, 0x30, 0x9c, 0x1d, 0x95, 0x0a, 0xce, 0x2c, 0xcb, 0xd6, 0x7b, 0xfd,
+		0x69, 0x62, 0x79, 0x11, 0x92, 0x4d, 0x41, 0x73, 0x6c, 0xb9, 0xdc, 0x39, 0xa6, 0x5c, 0xb2, 0xce,
+		0xa7, 0x84, 0x60, 0x2e, 0xce, 0x28, 0x18, 0xa7, 0x1d, 0x77, 0x25, 0x99, 0xff, 0x1d, 0x87, 0x53,
+		0x6c, 0x3a, 0x69, 0x6c, 0x2a, 0xb1, 0x04, 0x97, 0x49, 0xda, 0x5b, 0x14, 0xbc, 0x4f, 0xa2, 0xbc,
+		0x0c, 0x8b, 0x75, 0xd4, 0x16, 0xe8, 0x05, 0xb9, 0x3b, 0x3c, 0x13, 0x4f, 0x87, 0x2f, 0xfb, 0x0c,
+		0x7e, 0xbe, 0xbf, 0xe5, 0xcd, 0x52, 0xbe, 0x21, 0x04, 0x52, 0xa3, 0xa5, 0x77, 0xf5, 0xc1, 0x97,
+		0x0a, 0x25, 0x3f, 0x07, 0xc0, 0x8e, 0x7b, 0x38, 0x17, 0x37, 0xb3, 0x17, 0xf3, 0x2b, 0xde, 0xc6,
+		0xad, 0xb0, 0x2f, 0xa1, 0x70, 0xd4, 0x24, 0xa5, 0xc5, 0x9f, 0xe7, 0x5f, 0x05, 0x70, 0x0b, 0xe4,
+		0xd3, 0x70, 0x5f, 0xa3, 0x52, 0x5a, 0x2b, 0xa9, 0xe2, 0x90, 0x50, 0x63, 0xab, 0x56, 0x61, 0xd7,
+		0xac, 0xe6, 0xf0, 0x7c, 0x8d, 0xb7, 0xd0, 0x39, 0xd4, 0x74, 0x02, 0x16, 0xbc, 0xf9, 0xec, 0xce,
+		0x4b, 0x18, 0x2d, 0xc5, 0x4e, 0xaf, 0xdf, 0x25, 0x74, 0xe7, 0x51, 0xeb, 0x08, 0xa9, 0x05, 0x1b,
+		0x21, 0xbf, 0xf6, 0x1f, 0xd9, 0x3d, 0x88, 0x45, 0x97, 0xdd, 0x91, 0x79, 0x71, 0x0d, 0x16, 0xf0,
+		0x64, 0x66, 0xdf, 0x07, 0x19, 0xa0, 0xaa, 0x11, 0x90, 0xee, 0xa5, 0x72, 0x4e, 0x17, 0xed, 0x39,
+		0x88, 0x5b, 0xb4, 0xf5, 0x41, 0x10, 0xbf, 0xce, 0x21, 0x38, 0x79, 0xd1, 0x80, 0x05, 0x76, 0xaf,
+		0x8f, 0x78, 0xaa, 0x71, 0x74, 0x9c, 0xe1, 0x9f, 0x7f, 0xea, 0x29, 0xba, 0xb3, 0xfa, 0xa0, 0xbf,
+		0x5b, 0x26, 0x0c, 0x27, 0x55, 0xe2, 0xd8, 0x6e, 0x45, 0x09, 0x64, 0xc5, 0xf7, 0x78, 0x85, 0x8f,
+		0xfe, 0xd8, 0x2f, 0xf2, 0x8f, 0x9d, 0x99, 0x34, 0x06, 0x3c, 0x5f, 0xca, 0x70, 0x54, 0x56, 0x50,
+		0xae, 0x4d, 0x9b, 0xd3, 0xaf, 0x3f, 0x3e, 0xbe, 0x3a, 0xb1, 0xff, 0x9e, 0xa4, 0xc8, 0x2f, 0x7a,
+		0x3f, 0xe3, 0xcc, 0xbd, 0xdf, 0x8e, 0xc0, 0x02, 0x5e, 0x26, 0x30, 0x2f, 0xd0, 0x7f, 0xf9, 0x9c,
+		0x8b, 0xd1, 0xc4, 0x0c, 0x9b, 0x92, 0x97, 0xd9, 0x54, 0x08, 0x1e, 0x31, 0x7f, 0xf6, 0x2d, 0x3f,
+		0x1c, 0x73, 0xa7, 0x4b, 0x71, 0x1d, 0x24, 0x71, 0x24, 0x98, 0x18, 0x2d, 0xb3, 0x3d, 0x53, 0x94,
+		0xe2, 0xcf, 0x05, 0x86, 0x88, 0x6f, 0xd5, 0x38, 0x6b, 0xf1, 0xdd, 0xb8, 0xd3, 0xc7, 0x61, 0x82,
+		0x2c, 0x13, 0x01, 0xe2, 0x70, 0xa0, 0x5d, 0xc2, 0x66, 0xe6, 0x2c, 0x56, 0xe8, 0x17, 0x04, 0x3f,
+		0x9b, 0xa1, 0x78, 0x42, 0xa2, 0xb8, 0x0a, 0xd9, 0xb6, 0x69, 0xd8, 0x9a, 0xd9, 0xeb, 0xd8, 0xa4,
+		0xd7, 0xb7, 0x03, 0xed, 0xba, 0xbf, 0x60, 0x20, 0x09, 0x35, 0x83, 0x7c, 0x9b, 0x82, 0xed, 0xae,
+		0x3a, 0xd7, 0x6a, 0xdf, 0xe0, 0xba, 0xd8, 0x3e, 0x60, 0x9d, 0xe8, 0x74, 0xee, 0x9f, 0x44, 0xe0,
+		0x0c, 0x27, 0xde, 0xd1, 0x2d, 0x72, 0xe1, 0xe6, 0xd3, 0x3b, 0xc4, 0xd6, 0x9f, 0xbe, 0xd0, 0x32,
+		0x3b, 0x62, 0x21, 0x5e, 0x64, 0xe5, 0x2b, 0x58, 0xbe, 0xc2, 0xcb, 0x0b, 0x13, 0x77, 0xab, 0x0b,
+		0xd3, 0x75, 0x74, 0x61, 0x7c, 0x08, 0x29, 0xaf, 0x43, 0xb4, 0x62, 0x76, 0x0c, 0x5c, 0x9a, 0xda,
+		0xc4, 0x30, 0x7b, 0x5c, 0x5b, 0xb2, 0x84, 0x7c, 0x19, 0xe2, 0x7a, 0xcf, 0x1c, 0x1a, 0x36, 0xd3,
+		0x94, 0xe5, 0x33, 0xbf, 0xfa, 0xf6, 0xd9, 0xb9, 0xdf, 0x7e, 0xfb, 0x6c, 0xa4, 0x6e, 0xd8, 0xbf,
+		0xf9, 0x33, 0x4f, 0x02, 0x47, 0xaf, 0x1b, 0xf6, 0x27, 0xff, 0xe0, 0x27, 0xcf, 0x87, 0x54, 0x4e,
+		0x5d, 0x8c, 0x7e, 0xee, 0xe3, 0x67, 0x43, 0xca, 0xab, 0x30, 0x5f, 0x25, 0xad, 0x23, 0xe0, 0x9f,
+		0x1e, 0x81, 0x3f, 0x25, 0xe0, 0xab, 0xa4, 0xe5, 0x81, 0xaf, 0x92, 0xd6, 0x08, 0xf2, 0x73, 0x90,
+		0xa8, 0x1b, 0x36, 0xbb, 0x4f, 0xf4, 0x38, 0x44, 0x3a, 0x06, 0x3b, 0xa2, 0xee, 0x41, 0x18, 0xab,
+		0xa0, 0x8a, 0x54, 0xc8, 0x58, 0x25, 0x2d, 0x87, 0xb1, 0x4d, 0x5a, 0xf9, 0x50, 0xd0, 0xa7, 0x91,
+		0xaa, 0x5c, 0xfd, 0xad, 0xdf, 0x3b, 0x33, 0xf7, 0xd6, 0x67, 0xce, 0xcc, 0x4d, 0xed, 0x7a, 0x25,
+		0xb8, 0xeb, 0x9d, 0x1e, 0xff, 0xe1, 0x28, 0x3c, 0x40, 0xaf, 0x99, 0x0e, 0x7a, 0x1d, 0xc3, 0xbe,
+		0xd0, 0x1a, 0x1c, 0xf6, 0x6d, 0x13, 0xe7, 0xaf, 0xb9, 0xcb, 0x3b, 0x7c, 0xc1, 0x2d, 0x5e, 0x61,
+		0xc5, 0x93, 0xbb, 0x5b, 0xd9, 0x85, 0xd8, 0x16, 0xf2, 0xa1, 0x88, 0x6d, 0xd3, 0xd6, 0xbb, 0xdc,
+		0xe8, 0x60, 0x09, 0xcc, 0x65, 0x57, 0x53, 0xc3, 0x2c, 0xb7, 0x23, 0x6e, 0xa5, 0x76, 0x89, 0xbe,
+		0xcb, 0x6e, 0xf8, 0x44, 0xa8, 0xb5, 0x9a, 0xc0, 0x0c, 0x7a, 0x99, 0x67, 0x09, 0x62, 0xfa, 0x90,
+		0x9d, 0x9b, 0x89, 0xa0, 0x19, 0x4b, 0x13, 0xca, 0xcb, 0x30, 0xcf, 0xb7, 0xcf, 0xf1, 0xe0, 0xc8,
+		0x0d, 0x72, 0x48, 0xbf, 0x93, 0x56, 0xf1, 0xa7, 0xbc, 0x02, 0x31, 0x5a, 0x79, 0x7e, 0x75, 0x31,
+		0xbf, 0x32, 0x56, 0xfb, 0x15, 0x5a, 0x49, 0x95, 0x91, 0x29, 0xd7, 0x20, 0x51, 0x35, 0x71, 0x14,
+		0xfa, 0xd1, 0x92, 0x0c, 0x8d, 0xd6, 0xb9, 0x3f, 0xe4, 0xa3, 0x42, 0x65, 0x09, 0x3c, 0x9f, 0xce,
+		0x6e, 0x7c, 0xf1, 0xb3, 0x3f, 0x3c, 0xa5, 0x54, 0x60, 0x9e, 0x62, 0x6f, 0xf6, 0x9d, 0x5b, 0xd6,
+		0x21, 0xcf, 0x2d, 0x6b, 0x0e, 0x1f, 0x76, 0x2b, 0x2b, 0x43, 0x14, 0x6f, 0x75, 0xf0, 0x76, 0xd3,
+		0xdf, 0xca, 0x7b, 0x21, 0xc1, 0x41, 0x2c, 0xf9, 0x22, 0x44, 0xcc, 0xbe, 0xc5, 0x4f, 0xef, 0x14,
+		0xa6, 0x35, 0x65, 0xb3, 0x5f, 0x8e, 0xe2, 0x98, 0x51, 0x91, 0xb8, 0xbc, 0x31, 0x75, 0x58, 0x3c,
+		0xeb, 0x1b, 0x16, 0x3d, 0x62, 0xef, 0xec, 0xda, 0xee, 0x0f, 0xd6, 0x9d, 0x63, 0x43, 0xc1, 0x19,
+		0x28, 0x77, 0xc2, 0x70, 0xc6, 0x53, 0x7a, 0x93, 0x0c, 0x30, 0x7e, 0xc4, 0x46, 0x13, 0x1f, 0x29,
+		0xb2, 0xa7, 0x82, 0xbc, 0x7c, 0xca, 0x50, 0x79, 0x0f, 0x44, 0x4a, 0xfd, 0x3e, 0x5e, 0x7a, 0xa6,
+		0xe9, 0x96, 0xc9, 0xc6, 0x4a, 0x54, 0x75, 0xd2, 0x58, 0x66, 0x99, 0xbb, 0xf6, 0x2d, 0x7d, 0xe0,
+		0x5c, 0x88, 0x16, 0x69, 0xe5, 0x05, 0x48, 0x56, 0x4c, 0xc3, 0x22, 0x86, 0x35, 0xa4, 0xa6, 0xec,
+		0x4e, 0xd7, 0x6c, 0xdd, 0xe0, 0x08, 0x2c, 0x81, 0xc2, 0xd6, 0xfb, 0x7d, 0xca, 0x19, 0x55, 0xf1,
+		0x27, 0x9b, 0xaf, 0xe5, 0xcd, 0xa9, 0xe2, 0xb9, 0x74, 0x3c, 0xf1, 0xf0, 0x06, 0xba, 0x36, 0x69,
+		0x08, 0xee, 0x1f, 0x9f, 0x48, 0x37, 0xc8, 0xa1, 0x75, 0xdc, 0x79, 0xf4, 0x2a, 0x24, 0xb7, 0xe8,
+		0xeb, 0x32, 0x2f, 0x93, 0x43, 0xb9, 0x80, 0x4f, 0x90, 0x5c, 0xbc, 0x74, 0xe9, 0xe9, 0x17, 0xd8,
+		0x28, 0xbf, 0x3a, 0xa7, 0x8a, 0x0c, 0xf9, 0x0c, 0x24, 0x2d, 0xd2, 0xea, 0x5f, 0xbc, 0x74, 0xf9,
+		0xc6, 0xd3, 0x6c, 0x58, 0x5d, 0x9d, 0x53, 0xdd, 0xac, 0x62, 0x02, 0x5b, 0xfc, 0xb9, 0x4f, 0x9c,
+		0x0d, 0x95, 0x63, 0x10, 0xb1, 0x86, 0xbd, 0x77, 0x6c, 0x6c, 0x7c, 0x47, 0x0c, 0x96, 0x3d, 0xa5,
+		0x6c, 0x71, 0xb9, 0xa9, 0x77, 0x3b, 0x6d, 0xdd, 0x7d, 0x13, 0x48, 0xf2, 0xb4, 0x9f, 0x52, 0x4c,
+		0x59, 0x35, 0x8e, 0x94, 0xa2, 0xf2, 0xd3, 0x21, 0x48, 0x5f, 0x17, 0xc8, 0xf8, 0x88, 0xd0, 0x8b,
+		0x00, 0xce, 0x97, 0xc4, 0x54, 0x39, 0xbd, 0x32, 0xfa, 0xad, 0x15, 0x87, 0x47, 0xf5, 0x90, 0xcb,
+		0xcf, 0xd1, 0x01, 0xd8, 0x37, 0x2d, 0x7e, 0x39, 0x36, 0x80, 0xd5, 0x21, 0xc6, 0x73, 0x98, 0x54,
+		0xab, 0x69, 0x37, 0x4d, 0x1b, 0x4f, 0x86, 0xf4, 0xcd, 0x5b, 0xfc, 0xc9, 0x81, 0x88, 0x2a, 0xd1,
+		0x92, 0xeb, 0xb4, 0x60, 0x0b, 0xf3, 0xb1, 0xd2, 0x49, 0x07, 0x05, 0xbd, 0x32, 0xbd, 0xdd, 0x1e,
+		0x10, 0xcb, 0xe2, 0x8a, 0x4b, 0x24, 0xf1, 0x46, 0x6e, 0x7f, 0xb8, 0xa3, 0x09, 0x2d, 0x81, 0x77,
+		0x9a, 0x27, 0xcc, 0x79, 0x31, 0x36, 0xf8, 0xac, 0x8f, 0xf7, 0x87, 0x3b, 0x38, 0x52, 0x1e, 0x84,
+		0xf4, 0x84, 0xca, 0xa4, 0x6e, 0xba, 0xf5, 0xa0, 0x0f, 0x1a, 0xf1, 0x16, 0x68, 0xfd, 0x41, 0xc7,
+		0x1c, 0x74, 0xec, 0x43, 0x7a, 0xea, 0x2e, 0xa2, 0x4a, 0xa2, 0x60, 0x8b, 0xe7, 0x2b, 0x37, 0x20,
+		0xd7, 0xa0, 0xd6, 0xba, 0x5b, 0xf3, 0x4b, 0x6e, 0xfd, 0x42, 0xc1, 0xf5, 0x9b, 0x5a, 0xb3, 0xf0,
+		0x58, 0xcd, 0xca, 0xeb, 0x53, 0x47, 0xe6, 0x33, 0xc7, 0x1b, 0x99, 0xfe, 0xd5, 0xed, 0x8f, 0x4e,
+		0xc1, 0xfd, 0xa3, 0x85, 0x3e, 0x95, 0x35, 0xeb, 0xa0, 0x0c, 0x72, 0xc4, 0x0b, 0x47, 0x2f, 0xa2,
+		0x85, 0x00, 0xd5, 0x59, 0x08, 0x9c, 0x3e, 0xca, 0x0b, 0x90, 0xc1, 0xb3, 0xb3, 0x0d, 0x62, 0x5f,
+		0x25, 0x7a, 0x9b, 0x0c, 0xfc, 0xab, 0x6c, 0x46, 0xac, 0xb2, 0x32, 0x44, 0xe9, 0x52, 0xca, 0x56,
+		0x19, 0xfa, 0x5b, 0xd9, 0x87, 0x28, 0xb2, 0xba, 0x2b, 0x30, 0xe7, 0xa0, 0x09, 0xcc, 0xdd, 0x39,
+		0xb4, 0x89, 0xc5, 0x59, 0x58, 0x42, 0x7e, 0x56, 0xac, 0xa3, 0x91, 0xa3, 0xd7, 0x51, 0x3e, 0x08,
+		0xf9, 0x6a, 0xda, 0x85, 0xf9, 0x32, 0xaa, 0xdf, 0x7a, 0xd5, 0xa9, 0x48, 0xc8, 0xad, 0x88, 0xbc,
+		0x0e, 0xb9, 0xbe, 0x3e, 0xb0, 0xe9, 0xa5, 0xbe, 0x7d, 0xda, 0x0a, 0x3e, 0xce, 0xcf, 0x8e, 0xcf,
+		0x3a, 0x5f, 0x63, 0xf9, 0x57, 0x32, 0x7d, 0x6f, 0xa6, 0xf2, 0x5f, 0xa3, 0x10, 0xe7, 0xc2, 0x78,
+		0x0f, 0xcc, 0x73, 0xb1, 0xf2, 0x91, 0xf9, 0xc0, 0xca, 0xf8, 0x62, 0xb4, 0xe2, 0x2c, 0x1a, 0x1c,
+		0x4f, 0xf0, 0xc8, 0x8f, 0x40, 0xa2, 0xb5, 0xaf, 0x77, 0x0c, 0xad, 0xd3, 0xe6, 0x06, 0x60, 0xea,
+		0x33, 0x6f, 0x9f, 0x9d, 0xaf, 0x60, 0x5e, 0xbd, 0xaa, 0xce, 0xd3, 0xc2, 0x7a, 0x1b, 0x57, 0xfe,
+		0x7d, 0xd2, 0xd9, 0xdb, 0xb7, 0xf9, 0xec, 0xe2, 0x29, 0x7c, 0xc9, 0x0c, 0x07, 0x04, 0xbf, 0xf2,
+		0x5d, 0x18, 0xb3, 0xe5, 0x9d, 0x38, 0x49, 0x39, 0x81, 0x1f, 0xfe, 0xc8, 0x7f, 0x39, 0x1b, 0x52,
+		0x29, 0x87, 0x5c, 0x81, 0x4c, 0x57, 0xb7, 0x6c, 0x8d, 0xae, 0x5a, 0xf8, 0xf9, 0x18, 0x85, 0x38,
+		0x35, 0x2e, 0x10, 0x2e, 0x58, 0x5e, 0xf5, 0x14, 0x72, 0xb1, 0xac, 0x36, 0xde, 0x48, 0xa5, 0x20,
+		0x78, 0x64, 0xb8, 0x63, 0x33, 0x5b, 0x2a, 0x4e, 0xe5, 0x9e, 0xc5, 0xfc, 0x0a, 0xcd, 0xa6, 0x16,
+		0xd5, 0x69, 0x48, 0xd2, 0x4b, 0xa6, 0x94, 0x84, 0x9d, 0xf5, 0x4e, 0x60, 0x06, 0x2d, 0x7c, 0x14,
+		0x72, 0xae, 0x6e, 0x64, 0x24, 0x09, 0x86, 0xe2, 0x66, 0x53, 0xc2, 0xa7, 0x60, 0xc9, 0x20, 0x07,
+		0xb6, 0xe6, 0x66, 0x33, 0xea, 0x24, 0xa5, 0x96, 0xb1, 0xec, 0xba, 0x9f, 0xe3, 0x61, 0xc8, 0xb6,
+		0x84, 0xf0, 0x19, 0x2d, 0x50, 0xda, 0x8c, 0x93, 0x4b, 0xc9, 0x4e, 0x41, 0x42, 0xef, 0xf7, 0x19,
+		0x41, 0x8a, 0xeb, 0xc6, 0x7e, 0x9f, 0x16, 0x9d, 0x87, 0x05, 0xda, 0xc6, 0x01, 0xb1, 0x86, 0x5d,
+		0x9b, 0x83, 0xa4, 0x29, 0x4d, 0x0e, 0x0b, 0x54, 0x96, 0x4f, 0x69, 0x1f, 0x82, 0x0c, 0xb9, 0x89,
+		0x07, 0xed, 0x5b, 0x84, 0xd1, 0x65, 0x28, 0x5d, 0x5a, 0x64, 0x52, 0xa2, 0xc7, 0xc0, 0xd1, 0x79,
+		0x9a, 0xd0, 0xc7, 0x59, 0x86, 0x27, 0xf2, 0x4b, 0x2c, 0x5b, 0xc9, 0x43, 0xb4, 0xaa, 0xdb, 0x3a,
+		0x1a, 0x15, 0xf6, 0x01, 0x5b, 0x64, 0xd2, 0x2a, 0xfe, 0x54, 0x3e, 0x17, 0x86, 0xe8, 0x75, 0xd3,
+		0x26, 0xf2, 0x33, 0x1e, 0x83, 0x2f, 0x3b, 0x69, 0x3c, 0x37, 0x3a, 0x7b, 0x06, 0x69, 0xaf, 0x5b,
+		0x7b, 0x9e, 0x17, 0x61, 0xdc, 0xe1, 0x14, 0xf6, 0x0d, 0xa7, 0x25, 0x88, 0x0d, 0xcc, 0xa1, 0xd1,
+		0x16, 0xa7, 0xa4, 0x69, 0x42, 0xae, 0x41, 0xc2, 0x19, 0x25, 0xd1, 0xa0, 0x51, 0x92, 0xc3, 0x51,
+		0x82, 0x63, 0x98, 0x67, 0xa8, 0xf3, 0x3b, 0x7c, 0xb0, 0x94, 0x21, 0xe9, 0x28, 0xaf, 0x7c, 0xec,
+		0x18, 0x03, 0xd6, 0x65, 0xc3, 0x85, 0xc4, 0xe9, 0x7b, 0x47, 0x78, 0x6c, 0xc4, 0x49, 0x4e, 0x01,
+		0x97, 0x9e, 0x6f, 0x58, 0xf1, 0xd7, 0x69, 0xe6, 0x69, 0xbb, 0xdc, 0x61, 0xc5, 0x5e, 0xa8, 0xb9,
+		0x1f, 0x8f, 0x9d, 0xed, 0x19, 0xba, 0x3d, 0x1c, 0x10, 0x3e, 0xf2, 0xdc, 0x0c, 0xe5, 0x97, 0x42,
+		0x10, 0x67, 0x23, 0xd9, 0x23, 0xb7, 0xd0, 0x64, 0xb9, 0x85, 0xa7, 0xc9, 0x2d, 0x72, 0xf7, 0x72,
+		0x2b, 0x01, 0x38, 0x95, 0xb1, 0xf8, 0xa3, 0x21, 0x13, 0xac, 0x05, 0x56, 0xc5, 0x46, 0x67, 0x8f,
+		0x4f, 0x54, 0x0f, 0x93, 0xf2, 0x9f, 0x43, 0x90, 0x74, 0xca, 0xe5, 0x12, 0x64, 0x44, 0xbd, 0xb4,
+		0xdd, 0xae, 0xbe, 0xc7, 0xc7, 0xce, 0x03, 0x53, 0x2b, 0x77, 0xa5, 0xab, 0xef, 0xa9, 0x29, 0x5e,
+		0x1f, 0x4c, 0x4c, 0xee, 0x87, 0xf0, 0x94, 0x7e, 0xf0, 0x75, 0x7c, 0xe4, 0xee, 0x3a, 0xde, 0xd7,
+		0x45, 0xd1, 0xd1, 0x2e, 0xfa, 0x54, 0x98, 0x3a, 0x2f, 0x7d, 0xd3, 0xd2, 0xbb, 0x5f, 0x8e, 0x19,
+		0x71, 0x1a, 0x92, 0x7d, 0xb3, 0xab, 0xb1, 0x12, 0x76, 0x7b, 0x20, 0xd1, 0x37, 0xbb, 0xea, 0x58,
+		0xb7, 0xc7, 0xee, 0xd1, 0x74, 0x89, 0xdf, 0x03, 0xa9, 0xcd, 0x8f, 0x4a, 0x6d, 0x00, 0x69, 0x26,
+		0x0a, 0xbe, 0x96, 0x3d, 0x85, 0x32, 0xc0, 0x5f, 0xf9, 0xd0, 0xf8, 0xda, 0xcb, 0xaa, 0xcd, 0x28,
+		0xd5, 0xf8, 0xbe, 0xc3, 0xc1, 0x54, 0x7f, 0x3e, 0x3c, 0x8d, 0x83, 0x0d, 0x3b, 0x95, 0xd3, 0x29,
+		0xdf, 0x11, 0x02, 0x58, 0x43, 0xc9, 0xd2, 0xf6, 0xe2, 0x2a, 0x64, 0xd1, 0x2a, 0x68, 0xbe, 0x2f,
+		0x9f, 0x99, 0xd6, 0x69, 0xfc, 0xfb, 0x69, 0xcb, 0x5b, 0xef, 0x0a, 0x64, 0xdc, 0xc1, 0x68, 0x11,
+		0x51, 0x99, 0x33, 0x47, 0x58, 0xd4, 0x0d, 0x62, 0xab, 0xe9, 0x9b, 0x9e, 0x94, 0xf2, 0x2f, 0x43,
+		0x90, 0xa4, 0x75, 0xc2, 0x27, 0x0f, 0x7c, 0x7d, 0x18, 0xba, 0xfb, 0x3e, 0x7c, 0x00, 0x80, 0xc1,
+		0xe0, 0x26, 0x3c, 0x1f, 0x59, 0x49, 0x9a, 0x83, 0x5b, 0xeb, 0x18, 0x5b, 0xe2, 0xcd, 0x8e, 0x1c,
+		0x2d, 0x70, 0x61, 0x71, 0x73, 0xb1, 0xdf, 0x07, 0xf3, 0xf4, 0x59, 0xc4, 0x03, 0x8b, 0x1b, 0xd1,
+		0xf8, 0xb2, 0x4e, 0xf3, 0xc0, 0x52, 0xde, 0x80, 0xf9, 0xe6, 0x01, 0x8b, 0x85, 0x9c, 0x86, 0xe4,
+		0xc0, 0x34, 0xf9, 0x9a, 0xcc, 0x6c, 0xa1, 0x04, 0x66, 0xd0, 0x25, 0x48, 0xf8, 0xff, 0x61, 0xd7,
+		0xff, 0x77, 0x03, 0x18, 0x91, 0x99, 0x02, 0x18, 0xe7, 0xff, 0x53, 0x08, 0x52, 0x1e, 0xfd, 0x20,
+		0x3f, 0x0d, 0x27, 0xca, 0x6b, 0x9b, 0x95, 0x97, 0xb5, 0x7a, 0x55, 0xbb, 0xb2, 0x56, 0x5a, 0x75,
+		0x2f, 0xc8, 0x15, 0x4e, 0xde, 0xbe, 0xb3, 0x2c, 0x7b, 0x68, 0xb7, 0x0d, 0xba, 0x19, 0x23, 0x5f,
+		0x80, 0x25, 0x3f, 0x4b, 0xa9, 0xdc, 0xc0, 0xdb, 0x72, 0xa1, 0xc2, 0x89, 0xdb, 0x77, 0x96, 0x17,
+		0x3c, 0x1c, 0xa5, 0x1d, 0x8b, 0x18, 0xf6, 0x38, 0x43, 0x65, 0x73, 0x7d, 0xbd, 0xde, 0x94, 0xc2,
+		0x63, 0x0c, 0x5c, 0x61, 0x3f, 0x06, 0x0b, 0x7e, 0x86, 0x8d, 0xfa, 0x9a, 0x14, 0x29, 0xc8, 0xb7,
+		0xef, 0x2c, 0x67, 0x3d, 0xd4, 0x1b, 0x9d, 0x6e, 0x21, 0xf1, 0xe1, 0x1f, 0x38, 0x33, 0xf7, 0xc9,
+		0x1f, 0x3c, 0x13, 0xc2, 0x96, 0x65, 0x7c, 0x3a, 0x42, 0x7e, 0x02, 0xee, 0x6b, 0xd4, 0x57, 0x37,
+		0x6a, 0x55, 0x6d, 0xbd, 0xb1, 0x3a, 0x72, 0xe7, 0xb9, 0x90, 0xbb, 0x7d, 0x67, 0x39, 0xc5, 0x9b,
+		0x34, 0x8d, 0x7a, 0x4b, 0xad, 0x5d, 0xdf, 0x6c, 0xd6, 0xa4, 0x10, 0xa3, 0xde, 0x1a, 0x90, 0x9b,
+		0xa6, 0xcd, 0x5e, 0x54, 0x7d, 0x0a, 0x4e, 0x4d, 0xa0, 0x76, 0x1a, 0xb6, 0x70, 0xfb, 0xce, 0x72,
+		0x66, 0x6b, 0x40, 0xd8, 0xfc, 0xa1, 0x1c, 0x2b, 0x90, 0x1f, 0xe7, 0xd8, 0xdc, 0xda, 0x6c, 0x94,
+		0xd6, 0xa4, 0xe5, 0x82, 0x74, 0xfb, 0xce, 0x72, 0x5a, 0x28, 0x43, 0xa4, 0x77, 0x5b, 0xf6, 0x4e,
+		0x79, 0x3b, 0xdf, 0x34, 0x0f, 0x0f, 0x8c, 0x16, 0x5e, 0xe8, 0xeb, 0x03, 0xbd, 0x77, 0x5c, 0x77,
+		0x27, 0x60, 0x37, 0x56, 0x79, 0x2b, 0x0c, 0x39, 0xc7, 0x98, 0xde, 0xa2, 0x5f, 0x90, 0x9f, 0xf1,
+		0xc6, 0x61, 0x52, 0x53, 0x97, 0x31, 0x46, 0x2d, 0xc2, 0x34, 0x18, 0x87, 0xe7, 0x46, 0x19, 0x57,
+		0x17, 0xcb, 0xe3, 0x7c, 0x35, 0x4e, 0xc1, 0x59, 0x1d, 0x0e, 0xf9, 0x25, 0x48, 0x3a, 0xca, 0xc3,
+		0x79, 0xc3, 0x6a, 0xba, 0xb6, 0xe1, 0xfc, 0x2e, 0x0f, 0x3e, 0x2b, 0x25, 0xdc, 0x86, 0xe8, 0x34,
+		0x47, 0xe4, 0x3a, 0x23, 0xe0, 0xcc, 0x82, 0x5e, 0xa9, 0xf3, 0x69, 0xc7, 0x5b, 0x4f, 0xdf, 0xff,
+		0x38, 0xd0, 0x98, 0x27, 0xc5, 0xac, 0x92, 0x44, 0x4f, 0x3f, 0x28, 0x63, 0x1a, 0x15, 0x05, 0x16,
+		0xee, 0xf1, 0x3b, 0xe1, 0x11, 0x35, 0xde, 0xd3, 0x0f, 0x56, 0x75, 0xeb, 0x5a, 0x34, 0x11, 0x91,
+		0xa2, 0xca, 0x8f, 0x86, 0x20, 0xeb, 0x6f, 0xa3, 0xfc, 0x38, 0xc8, 0xc8, 0x81, 0xdb, 0x1d, 0xa8,
+		0x62, 0xa8, 0xb0, 0x04, 0x6e, 0xae, 0xa7, 0x1f, 0x94, 0xf6, 0xc8, 0xc6, 0xb0, 0x47, 0x2b, 0x60,
+		0xc9, 0xb8, 0x37, 0xc2, 0x89, 0x45, 0x3f, 0x71, 0x61, 0x9e, 0x1a, 0x7f, 0x83, 0x94, 0x13, 0xb0,
+		0x85, 0xea, 0xa3, 0xb8, 0x50, 0x65, 0x19, 0x9e, 0x28, 0xf1, 0x37, 0x25, 0xe2, 0x6f, 0x8a, 0xf2,
+		0x12, 0xe4, 0x46, 0xe4, 0x29, 0x2b, 0x90, 0xe1, 0x51, 0x01, 0xba, 0x53, 0xc9, 0xec, 0xe3, 0xa4,
+		0x9a, 0x62, 0xde, 0x3f, 0xdd, 0xb9, 0x2d, 0x26, 0x7e, 0x0e, 0x43, 0x51, 0x18, 0x30, 0x7f, 0x1c,
+		0x32, 0x3e, 0x89, 0x8a, 0x48, 0x5d, 0xc8, 0x8d, 0xd4, 0xb9, 0xc4, 0xaf, 0x43, 0x1a, 0x15, 0x25,
+		0x69, 0x73, 0xda, 0x47, 0x20, 0xc7, 0x14, 0xf9, 0xa8, 0xac, 0x99, 0x25, 0xb5, 0x2e, 0x04, 0xae,
+		0x40, 0xc6, 0xa5, 0x73, 0xc5, 0x9e, 0x12, 0x54, 0xab, 0xba, 0x55, 0x7e, 0xdf, 0x27, 0x3f, 0x73,
+		0x26, 0xf4, 0xce, 0x4c, 0xc4, 0xdf, 0x78, 0x1f, 0x9c, 0xf6, 0x14, 0xea, 0x3b, 0xad, 0x8e, 0x2f,
+		0xea, 0x90, 0x73, 0x0b, 0x57, 0xb0, 0x30, 0x28, 0x7a, 0x70, 0x64, 0x0c, 0xe3, 0xe8, 0x80, 0x59,
+		0xe1, 0x68, 0x8d, 0x10, 0x1c, 0xd8, 0x98, 0x1c, 0xa3, 0xfc, 0x3f, 0x09, 0x98, 0x57, 0xc9, 0x07,
+		0x86, 0xc4, 0xb2, 0xe5, 0x8b, 0x10, 0x25, 0xad, 0x7d, 0x73, 0x52, 0x48, 0x08, 0x1b, 0xb7, 0xc2,
+		0xe9, 0x6a, 0xad, 0x7d, 0xf3, 0xea, 0x9c, 0x4a, 0x69, 0xe5, 0x4b, 0x10, 0xdb, 0xed, 0x0e, 0x79,
+		0x9c, 0x62, 0x44, 0x59, 0x78, 0x99, 0xae, 0x20, 0xd1, 0xd5, 0x39, 0x95, 0x51, 0xe3, 0xa7, 0xe8,
+		0x3b, 0xcf, 0x91, 0xa3, 0x3f, 0x85, 0x97, 0x41, 0xf1, 0x53, 0x48, 0x2b, 0x97, 0x01, 0x3a, 0x46,
+		0xc7, 0xd6, 0xa8, 0x0f, 0x9f, 0x8f, 0x8d, 0x6b, 0x09, 0x3f, 0x67, 0xc7, 0xa6, 0x5e, 0x3f, 0x46,
+		0x52, 0x3b, 0x22, 0x81, 0xd5, 0xfd, 0xc0, 0x90, 0x0c, 0x0e, 0xf3, 0xf1, 0xa3, 0xab, 0xfb, 0x3e,
+		0x24, 0xc2, 0xea, 0x52, 0x6a, 0xb9, 0x06, 0x29, 0x7a, 0xc1, 0x95, 0xcd, 0x5f, 0xfe, 0xb2, 0xb0,
+		0x32, 0x8d, 0xb9, 0x8c, 0xa4, 0x74, 0x4a, 0x5f, 0x9d, 0x53, 0x61, 0xc7, 0x49, 0xa1, 0x92, 0x64,
+		0xef, 0x98, 0xd9, 0x07, 0xf9, 0xc4, 0xb8, 0x9a, 0xf2, 0x62, 0xd0, 0xc7, 0xcc, 0x9a, 0x07, 0x18,
+		0x25, 0x6e, 0xb1, 0x9f, 0xd8, 0xfe, 0x36, 0xe9, 0x76, 0xf0, 0x99, 0x38, 0xfb, 0x20, 0x9f, 0x3c,
+		0xba, 0xfd, 0x55, 0x46, 0x49, 0x11, 0x92, 0x6d, 0x91, 0x40, 0x45, 0x4b, 0x8c, 0x36, 0x6f, 0x06,
+		0x8c, 0xeb, 0x69, 0x5f, 0x3f, 0x1b, 0x6d, 0xd1, 0x88, 0x04, 0xe1, 0xbf, 0xe5, 0xe7, 0x1d, 0x0b,
+		0x35, 0x35, 0x6e, 0x14, 0xfa, 0x1a, 0xc0, 0x62, 0x15, 0x73, 0xc2, 0x52, 0x95, 0x37, 0x20, 0xdb,
+		0xed, 0x58, 0xb6, 0x66, 0x19, 0x7a, 0xdf, 0xda, 0x37, 0x6d, 0x8b, 0x3a, 0xfd, 0xf8, 0xc6, 0xf3,
+		0x14, 0x84, 0xb5, 0x8e, 0x65, 0x37, 0x04, 0xf1, 0xd5, 0x39, 0x35, 0xd3, 0xf5, 0x66, 0x20, 0x9e,
+		0xb9, 0xbb, 0x4b, 0x06, 0x0e, 0x60, 0x3e, 0x73, 0x34, 0xde, 0x26, 0x52, 0x0b, 0x7e, 0xc4, 0x33,
+		0xbd, 0x19, 0xf2, 0xd7, 0xc2, 0x62, 0xd7, 0xd4, 0xdb, 0x0e, 0x9c, 0xd6, 0xda, 0x1f, 0x1a, 0x37,
+		0x68, 0x24, 0x01, 0x9f, 0xb5, 0x9c, 0x56, 0x49, 0x53, 0x6f, 0x0b, 0x88, 0x0a, 0x32, 0x5c, 0x9d,
+		0x53, 0x17, 0xba, 0xa3, 0x99, 0xf2, 0xfb, 0x61, 0x49, 0xef, 0xf7, 0xbb, 0x87, 0xa3, 0xe8, 0x39,
+		0x8a, 0x7e, 0x7e, 0x1a, 0x7a, 0x09, 0x79, 0x46, 0xe1, 0x65, 0x7d, 0x2c, 0x57, 0x6e, 0x62, 0x0c,
+		0x84, 0xd0, 0x3b, 0x31, 0x7d, 0x6e, 0xaa, 0xd0, 0xf7, 0x87, 0xf0, 0x31, 0xf8, 0x29, 0xd8, 0x5b,
+		0x8c, 0x5e, 0x58, 0x36, 0x57, 0xe7, 0x30, 0x5c, 0xe2, 0xcb, 0x62, 0xa8, 0x66, 0x8b, 0xd0, 0xe7,
+		0xd1, 0x38, 0xea, 0x42, 0x10, 0x2a, 0xa5, 0xf7, 0xa3, 0xfa, 0xb2, 0xca, 0xf3, 0xfc, 0x4c, 0x1b,
+		0x7f, 0xf0, 0xe5, 0x51, 0x48, 0x79, 0x14, 0x0b, 0x06, 0xd5, 0xf9, 0x1e, 0xbf, 0x38, 0x0b, 0xc7,
+		0x93, 0x4a, 0x16, 0xd2, 0x5e, 0x65, 0xa2, 0x7c, 0x24, 0x04, 0x29, 0x8f, 0x9e, 0x40, 0x4e, 0x6f,
+		0xe8, 0x30, 0xe9, 0x46, 0x05, 0x1f, 0x12, 0xab, 0x88, 0x28, 0x67, 0xbb, 0x49, 0x69, 0x9a, 0xc9,
+		0x17, 0x31, 0xbc, 0xf2, 0xde, 0xbf, 0xd8, 0x77, 0x48, 0x22, 0x94, 0x04, 0xfa, 0x17, 0xfb, 0x82,
+		0xe0, 0x41, 0x48, 0x63, 0x4b, 0x35, 0xaf, 0xa1, 0x91, 0x54, 0x53, 0x98, 0xc7, 0x49, 0x94, 0x7f,
+		0x1b, 0x06, 0x69, 0x54, 0x01, 0x39, 0x31, 0xc5, 0xd0, 0xb1, 0x63, 0x8a, 0xa7, 0x46, 0xa3, 0x99,
+		0x6e, 0x00, 0x73, 0x0d, 0x24, 0x37, 0x0e, 0xc7, 0x16, 0x82, 0xe9, 0x86, 0xd3, 0x88, 0x85, 0xa7,
+		0xe6, 0x5a, 0xfe, 0x0c, 0xf9, 0x8a, 0x6f, 0xef, 0x45, 0xfc, 0x21, 0x83, 0xd1, 0x2e, 0x76, 0xec,
+		0x85, 0xed, 0x7e, 0x5b, 0xb7, 0x89, 0x88, 0x8b, 0xb8, 0x9c, 0xb8, 0xac, 0x63, 0xd8, 0xcf, 0xb2,
+		0x75, 0x9b, 0xf0, 0x65, 0x3d, 0xc6, 0xc2, 0x83, 0x7a, 0xbf, 0x4f, 0x1f, 0x8c, 0x66, 0xcb, 0xfa,
+		0xc3, 0x90, 0x45, 0x9d, 0xdc, 0xd1, 0xbb, 0x1a, 0x8f, 0x12, 0xc4, 0xd9, 0xea, 0xcf, 0x73, 0xaf,
+		0xd2, 0x4c, 0xa5, 0x0d, 0x69, 0xaf, 0x3e, 0x76, 0xdc, 0xac, 0x90, 0xc7, 0xcd, 0x92, 0xf9, 0x0b,
+		0x06, 0x4c, 0x3e, 0xe2, 0xd5, 0x87, 0xc9, 0xd1, 0xdd, 0x25, 0xea, 0x92, 0xdd, 0x64, 0x01, 0x8f,
+		0x84, 0xca, 0x12, 0xca, 0x07, 0xc3, 0xb0, 0x30, 0xa6, 0xb9, 0x27, 0x86, 0xbd, 0x5d, 0xff, 0x32,
+		0x7c, 0x2c, 0xff, 0xf2, 0x65, 0x7f, 0x58, 0xd7, 0xb3, 0xf2, 0x9d, 0x1e, 0x13, 0x32, 0xd3, 0x9b,
+		0x38, 0xa0, 0x39, 0x88, 0x27, 0xf2, 0x4b, 0x87, 0xf9, 0x36, 0x2c, 0xed, 0x1c, 0xbe, 0xa9, 0x1b,
+		0x76, 0xc7, 0x20, 0xda, 0x58, 0xaf, 0x8d, 0x2f, 0xa5, 0xeb, 0x1d, 0x6b, 0x87, 0xec, 0xeb, 0x37,
+		0x3b, 0xa6, 0xa8, 0xd6, 0xa2, 0xc3, 0xef, 0x06, 0x77, 0x15, 0x15, 0xb2, 0xfe, 0xa5, 0x47, 0xce,
+		0x42, 0xd8, 0x3e, 0xe0, 0xed, 0x0f, 0xdb, 0x07, 0xf2, 0x53, 0x3c, 0x0e, 0x14, 0xa6, 0x71, 0xa0,
+		0xf1, 0x0f, 0x71, 0x3e, 0x37, 0x08, 0xa4, 0x28, 0x20, 0x8d, 0x2e, 0x47, 0xa3, 0xa8, 0xca, 0x63,
+		0x90, 0x1b, 0x59, 0x6f, 0xa6, 0x45, 0x05, 0x95, 0x1c, 0x64, 0x38, 0x29, 0x13, 0x87, 0x72, 0x12,
+		0x96, 0x26, 0xad, 0x15, 0xca, 0x3e, 0x2c, 0x4d, 0xd2, 0xf9, 0xf2, 0x25, 0x48, 0x38, 0x8b, 0xc5,
+		0x84, 0x28, 0x04, 0x6d, 0x85, 0x20, 0x56, 0x1d, 0x52, 0x5f, 0x30, 0x3b, 0xec, 0x0b, 0x66, 0x2b,
+		0x5f, 0x0f, 0xf9, 0x69, 0x0b, 0xc1, 0x48, 0x33, 0xa2, 0xce, 0x28, 0x3c, 0x09, 0x71, 0xfe, 0x06,
+		0x62, 0x98, 0x6e, 0xdf, 0xf0, 0x14, 0x8e, 0x4e, 0xb6, 0x28, 0x44, 0x68, 0x36, 0x4b, 0x28, 0x1a,
+		0x9c, 0x9a, 0xba, 0x18, 0x4c, 0xdf, 0x08, 0x62, 0x40, 0x7c, 0x23, 0xa8, 0x25, 0xaa, 0x63, 0xd1,
+		0xb6, 0x8a, 0xc3, 0x0e, 0x2c, 0xa5, 0x7c, 0x34, 0x02, 0x27, 0x27, 0x2f, 0x09, 0xf2, 0x32, 0xa4,
+		0xd1, 0xee, 0xb6, 0xfd, 0x26, 0x3a, 0xf4, 0xf4, 0x83, 0x26, 0xb7, 0xcf, 0x79, 0x20, 0x3d, 0xec,
+		0x04, 0xd2, 0xe5, 0x6d, 0x58, 0xe8, 0x9a, 0x2d, 0xbd, 0xab, 0x79, 0x46, 0x3c, 0x1f, 0xec, 0x0f,
+		0x8d, 0x09, 0xbb, 0xc6, 0x5e, 0x46, 0x6f, 0x8f, 0x0d, 0xfa, 0x1c, 0xc5, 0x58, 0x73, 0x46, 0xbe,
+		0x5c, 0x85, 0x54, 0xcf, 0x1d, 0xc8, 0xc7, 0x18, 0xec, 0x5e, 0x36, 0x4f, 0x97, 0xc4, 0x26, 0x6e,
+		0xfb, 0xc4, 0x8f, 0xad, 0xa2, 0xa7, 0xed, 0xa0, 0xcc, 0x4f, 0xdd, 0x41, 0x99, 0xb4, 0x5d, 0x91,
+		0x98, 0xbc, 0x5d, 0xf1, 0x61, 0x6f, 0xd7, 0xf8, 0x16, 0xd1, 0xf1, 0x1d, 0x0c, 0xb9, 0x01, 0x4b,
+		0x9c, 0xbf, 0xed, 0x93, 0x7d, 0x78, 0x56, 0x45, 0x23, 0x0b, 0xf6, 0xe9, 0x62, 0x8f, 0xdc, 0x9d,
+		0xd8, 0x85, 0x2e, 0x8d, 0x7a, 0x74, 0xe9, 0xff, 0x67, 0x5d, 0xf1, 0x1f, 0x92, 0x90, 0x50, 0x89,
+		0xd5, 0xc7, 0x85, 0x13, 0x43, 0xc9, 0xe4, 0xa0, 0x45, 0xfa, 0xb6, 0xbb, 0x4d, 0x39, 0xc9, 0x19,
+		0x60, 0xd4, 0x35, 0x41, 0x89, 0x96, 0xb8, 0xc3, 0x86, 0x51, 0x75, 0xea, 0x6c, 0x4d, 0xf7, 0x9b,
+		0x38, 0xbb, 0xd7, 0xdb, 0xba, 0x2c, 0xbc, 0xad, 0xc8, 0x54, 0xe3, 0x9b, 0x71, 0x8d, 0xb8, 0x5b,
+		0xcf, 0x70, 0x77, 0x2b, 0x1a, 0xf0, 0x31, 0x9f, 0xbf, 0x55, 0xf1, 0xf9, 0x5b, 0xf1, 0x80, 0x66,
+		0x4e, 0x71, 0xb8, 0x2e, 0x0b, 0x87, 0x6b, 0x3e, 0xa0, 0xc6, 0x23, 0x1e, 0xd7, 0x15, 0xbf, 0xc7,
+		0x95, 0x98, 0xa2, 0x40, 0x04, 0xf7, 0x54, 0x97, 0xeb, 0x3d, 0x1e, 0x97, 0x2b, 0x39, 0xd5, 0xdf,
+		0x61, 0x20, 0x13, 0x7c, 0xae, 0x8a, 0xcf, 0xe7, 0x82, 0x00, 0x19, 0x4c, 0x71, 0xba, 0xbe, 0xc6,
+		0xeb, 0x74, 0xa5, 0xa6, 0xfa, 0x6d, 0xbc, 0xbf, 0x27, 0x79, 0x5d, 0x2f, 0x38, 0x5e, 0x57, 0x7a,
+		0xaa, 0xdb, 0xc8, 0xdb, 0x30, 0xea, 0x76, 0x6d, 0x8e, 0xb9, 0x5d, 0x19, 0xfe, 0x67, 0x62, 0xa6,
+		0x41, 0x04, 0xf8, 0x5d, 0x9b, 0x63, 0x7e, 0x57, 0x36, 0x00, 0x30, 0xc0, 0xf1, 0xfa, 0x6b, 0x93,
+		0x1d, 0xaf, 0xe9, 0xae, 0x11, 0xaf, 0xe6, 0x6c, 0x9e, 0x97, 0x36, 0xc5, 0xf3, 0x62, 0xde, 0xd1,
+		0xe3, 0x53, 0xe1, 0x67, 0x76, 0xbd, 0xb6, 0x27, 0xb8, 0x5e, 0xcc, 0x49, 0x3a, 0x37, 0x15, 0x7c,
+		0x06, 0xdf, 0x6b, 0x7b, 0x82, 0xef, 0x25, 0x07, 0xc2, 0x1e, 0xc7, 0xf9, 0xc2, 0xd7, 0x1d, 0x1f,
+		0x83, 0x05, 0xc1, 0xec, 0xe8, 0x29, 0xb4, 0x1f, 0xc8, 0x60, 0x60, 0x0e, 0xc4, 0xc9, 0x5a, 0x9a,
+		0x50, 0xce, 0x41, 0xda, 0x21, 0x3d, 0xda, 0x51, 0xa3, 0x76, 0x9a, 0x47, 0x0f, 0x29, 0x3f, 0x17,
+		0x82, 0xb4, 0x57, 0xc5, 0xf8, 0x0c, 0xf9, 0x24, 0x37, 0xe4, 0x3d, 0xee, 0x5b, 0xd8, 0xef, 0xbe,
+		0xe1, 0x6b, 0x9c, 0xfd, 0x31, 0xcf, 0x4c, 0xef, 0x3b, 0x9e, 0x99, 0x38, 0x52, 0xc0, 0x9c, 0x3c,
+		0xbe, 0xac, 0xb0, 0x9d, 0x9c, 0x9c, 0x73, 0xbc, 0x82, 0xf9, 0x14, 0xf2, 0x93, 0xb0, 0xe8, 0xa1,
+		0x75, 0xec, 0x3a, 0xe6, 0xa6, 0x48, 0x0e, 0x75, 0x89, 0x1b, 0x78, 0xff, 0x2a, 0x04, 0x0b, 0x63,
+		0x2a, 0x6e, 0xa2, 0xf7, 0x15, 0xba, 0x47, 0xde, 0x57, 0xf8, 0xae, 0xbd, 0x2f, 0xaf, 0x9d, 0x1a,
+		0xf1, 0xdb, 0xa9, 0xff, 0x33, 0x04, 0x19, 0x9f, 0xa6, 0xc5, 0x2e, 0x68, 0x99, 0x6d, 0xc2, 0x2d,
+		0x47, 0xfa, 0x1b, 0x8d, 0x8a, 0xae, 0xb9, 0xc7, 0xed, 0x43, 0xfc, 0x89, 0x54, 0xce, 0xc2, 0x91,
+		0xe4, 0xeb, 0x82, 0x63, 0x74, 0xc6, 0xbc, 0xe7, 0x7f, 0xf9, 0xa1, 0xd8, 0xb8, 0x7b, 0x28, 0xd6,
+		0xb9, 0xbb, 0x36, 0xef, 0xb9, 0xbb, 0x26, 0x3f, 0x0f, 0x49, 0x1a, 0x11, 0xd5, 0xf0, 0x40, 0x6c,
+		0x62, 0xdc, 0x36, 0xf1, 0x1f, 0x88, 0xb5, 0xe8, 0x51, 0x3d, 0xfa, 0xcb, 0x63, 0x31, 0x24, 0x7d,
+		0x16, 0xc3, 0xfd, 0x90, 0xc4, 0xda, 0xb3, 0xa7, 0xd6, 0x81, 0x5f, 0x7c, 0x14, 0x19, 0xca, 0xfb,
+		0x41, 0x1e, 0x5f, 0x24, 0xe4, 0xab, 0x10, 0x27, 0x37, 0xe9, 0xa3, 0x7c, 0xec, 0xa0, 0xe1, 0xc9,
+		0x71, 0xd3, 0x14, 0x8b, 0xcb, 0x79, 0x14, 0xf2, 0x1f, 0xbe, 0x7d, 0x56, 0x62, 0xd4, 0x4f, 0x38,
+		0xe7, 0xfc, 0x55, 0xce, 0xaf, 0xfc, 0x4e, 0x18, 0x72, 0xe2, 0x03, 0xc2, 0x73, 0x9a, 0x24, 0xdb,
+		0x49, 0x5b, 0x84, 0xb3, 0xc9, 0xfb, 0x0c, 0xc0, 0x9e, 0x6e, 0x69, 0xb7, 0x74, 0xc3, 0xe6, 0x7f,
+		0xb1, 0x29, 0xa2, 0x7a, 0x72, 0xf0, 0x80, 0x2d, 0xa6, 0x86, 0x16, 0xff, 0x9b, 0x4d, 0x11, 0xd5,
+		0x49, 0x7b, 0xda, 0x39, 0xff, 0xa5, 0xb5, 0xd3, 0x2f, 0xe5, 0xc4, 0x88, 0x94, 0x3d, 0xce, 0x45,
+		0xd2, 0xeb, 0x5c, 0xb0, 0x83, 0xc1, 0xfc, 0x7c, 0x22, 0xb0, 0xba, 0x89, 0x34, 0x46, 0x65, 0x7a,
+		0xa4, 0xd7, 0x37, 0xcd, 0xae, 0xc6, 0xd4, 0x0d, 0xfb, 0x9b, 0x0a, 0x69, 0x9e, 0x59, 0xa3, 0x5a,
+		0xe7, 0x43, 0x61, 0x58, 0x18, 0x5b, 0x5e, 0xbf, 0xfa, 0x04, 0x4c, 0x9f, 0x8d, 0x1e, 0x35, 0x11,
+		0xe4, 0x86, 0xf7, 0xd4, 0xc8, 0x90, 0xaa, 0x05, 0x31, 0xa0, 0x67, 0xd5, 0x1f, 0xd2, 0x4d, 0x7f,
+		0xb6, 0x25, 0xbf, 0x06, 0xf7, 0x8d, 0xe8, 0x36, 0x07, 0x3a, 0x3c, 0xab, 0x8a, 0x3b, 0xe1, 0x57,
+		0x71, 0x02, 0xda, 0x15, 0x56, 0xe4, 0x4b, 0x9c, 0x75, 0x75, 0xc8, 0x0a, 0x69, 0x70, 0x4f, 0x65,
+		0x52, 0xf7, 0xd3, 0xbf, 0xe1, 0x63, 0x63, 0x00, 0xcd, 0x17, 0x0e, 0x4a, 0xb3, 0x4c, 0x1e, 0x64,
+		0xda, 0x82, 0x13, 0x13, 0x2d, 0x1f, 0xf9, 0x39, 0x48, 0xba, 0x46, 0x13, 0x93, 0xea, 0x11, 0xe1,
+		0x02, 0x97, 0x56, 0xf9, 0xc5, 0x10, 0x9c, 0x98, 0x68, 0xfb, 0xc8, 0x35, 0x88, 0xb3, 0x63, 0x6f,
+		0xfc, 0x30, 0xcd, 0x93, 0xb3, 0xd9, 0x4c, 0x2b, 0xec, 0x4c, 0x9c, 0xca, 0x99, 0x95, 0xf7, 0x43,
+		0x9c, 0xe5, 0xc8, 0x29, 0x98, 0x77, 0xdf, 0xcd, 0x05, 0x88, 0x97, 0x2a, 0x95, 0xda, 0x96, 0x78,
+		0x04, 0xb3, 0xbc, 0xa9, 0xe2, 0x9f, 0xe1, 0x02, 0x88, 0xab, 0xb5, 0x6b, 0xb5, 0x4a, 0x53, 0x8a,
+		0xe0, 0x1f, 0xae, 0x62, 0xbf, 0xb5, 0x2b, 0xf8, 0x0a, 0x7a, 0x53, 0x8a, 0x7a, 0xb2, 0x1a, 0xb5,
+		0x8d, 0x6a, 0x4d, 0x95, 0x62, 0xca, 0xd3, 0x70, 0x4a, 0xd4, 0x63, 0x3c, 0xac, 0xe1, 0x44, 0x17,
+		0x42, 0x9e, 0xe8, 0x02, 0xbe, 0xa0, 0x5d, 0x98, 0x6e, 0x3a, 0xe1, 0x23, 0xf5, 0xbe, 0x86, 0x5f,
+		0x3c, 0x86, 0xdd, 0x35, 0xd2, 0x7a, 0x0c, 0x1e, 0x0e, 0xc8, 0x2e, 0xb1, 0x5b, 0xfb, 0xcc, 0x94,
+		0x63, 0x4b, 0x66, 0x46, 0xcd, 0xf0, 0x5c, 0xca, 0x64, 0x31, 0xb2, 0x37, 0x48, 0xcb, 0xd6, 0x98,
+		0x2e, 0xb2, 0xf8, 0x1f, 0x86, 0xcd, 0xb0, 0xdc, 0x06, 0xcb, 0x54, 0xbe, 0xfe, 0x58, 0xb2, 0x4c,
+		0x42, 0x4c, 0xad, 0xe1, 0x53, 0xe7, 0x11, 0xfc, 0xeb, 0x5c, 0xf4, 0xa7, 0xd6, 0xd8, 0x28, 0x6d,
+		0x35, 0xae, 0x6e, 0xa2, 0x2c, 0x17, 0x21, 0x27, 0x64, 0x29, 0x32, 0x63, 0xca, 0xe3, 0x70, 0xdf,
+		0x14, 0xbb, 0x6f, 0xc2, 0x39, 0xc4, 0x4f, 0x84, 0xbc, 0xd4, 0x7e, 0x9f, 0x7f, 0x13, 0xe2, 0x96,
+		0xad, 0xdb, 0x43, 0x8b, 0x0b, 0xf1, 0xb9, 0x59, 0x0d, 0xc1, 0x15, 0xf1, 0xa3, 0x41, 0xd9, 0x55,
+		0x0e, 0xa3, 0x5c, 0x82, 0xac, 0xbf, 0x64, 0xba, 0x0c, 0xdc, 0x41, 0x14, 0x56, 0x5e, 0x03, 0xf0,
+		0xc4, 0x23, 0x9d, 0x13, 0x5d, 0x21, 0xef, 0x89, 0xae, 0x4b, 0x10, 0xbb, 0x69, 0x32, 0x9d, 0x31,
+		0x79, 0xe2, 0xe0, 0x61, 0x4b, 0x4f, 0xf0, 0x81, 0x51, 0x2b, 0x1d, 0x90, 0xc7, 0x63, 0x42, 0x53,
+		0x3e, 0xf1, 0x1e, 0xff, 0x27, 0x1e, 0x9c, 0x1a, 0x5d, 0x9a, 0xfc, 0xa9, 0x37, 0x21, 0x46, 0xb5,
+		0xcd, 0xc4, 0x2b, 0x3e, 0x5f, 0x07, 0xa0, 0xdb, 0xf6, 0xa0, 0xb3, 0x33, 0x74, 0x3f, 0x70, 0x76,
+		0xb2, 0xb6, 0x2a, 0x09, 0xba, 0xf2, 0xfd, 0x5c, 0x6d, 0x2d, 0xb9, 0xac, 0x1e, 0xd5, 0xe5, 0x01,
+		0x54, 0x36, 0x20, 0xeb, 0xe7, 0x9d, 0x7c, 0x65, 0xc9, 0xbd, 0xfa, 0x9f, 0x14, 0xe6, 0x93, 0x63,
+		0x7c, 0xf1, 0xe7, 0x38, 0x68, 0x42, 0xb9, 0x1d, 0x82, 0x44, 0xf3, 0x80, 0x8f, 0xe3, 0x23, 0x0e,
+		0x55, 0xba, 0xf7, 0xb6, 0x9c, 0x60, 0x21, 0x8b, 0xc7, 0x46, 0x9c, 0x28, 0xef, 0xd7, 0x38, 0x33,
+		0x35, 0x3a, 0xab, 0xb7, 0x2b, 0xa2, 0xdd, 0x5c, 0x3b, 0xbd, 0x38, 0xdb, 0x1d, 0x09, 0x0c, 0xc6,
+		0x7b, 0xee, 0x37, 0xb0, 0x84, 0xd2, 0xf6, 0x1c, 0x4b, 0x60, 0xcb, 0x86, 0xf7, 0x32, 0x45, 0xe8,
+		0xd8, 0x97, 0x29, 0x9c, 0xaf, 0x84, 0xbd, 0x5f, 0xb9, 0x09, 0x09, 0x31, 0x28, 0xe4, 0xf7, 0x7a,
+		0xcf, 0x9e, 0x88, 0x3d, 0x9a, 0xa9, 0x8b, 0x27, 0x87, 0x77, 0x59, 0xd0, 0xf9, 0xe0, 0x47, 0xee,
+		0x5c, 0xbf, 0x82, 0xff, 0xc5, 0x80, 0x1c, 0x2b, 0x58, 0x13, 0x4e, 0x85, 0xf2, 0x43, 0xf8, 0x98,
+		0xfe, 0xc8, 0xa8, 0xfc, 0x72, 0x56, 0x00, 0x95, 0x22, 0x8e, 0x7e, 0xcf, 0x93, 0xdd, 0xac, 0xe7,
+		0x33, 0x98, 0xeb, 0x3e, 0xda, 0xfd, 0xc1, 0x30, 0xa4, 0x3c, 0x31, 0x3d, 0xf9, 0x59, 0xdf, 0x11,
+		0xd0, 0xe5, 0xa3, 0xe2, 0x7f, 0x9e, 0x33, 0xa0, 0xbe, 0x86, 0x85, 0x8f, 0xdf, 0xb0, 0x7b, 0x7f,
+		0x48, 0x7f, 0xf2, 0x6d, 0x9f, 0xd8, 0x94, 0xdb, 0x3e, 0xdf, 0x18, 0x82, 0x84, 0xb3, 0x74, 0x1f,
+		0x37, 0x9a, 0x7f, 0x12, 0xe2, 0x7c, 0x75, 0x62, 0xe1, 0x7c, 0x9e, 0x9a, 0x18, 0x0b, 0x2d, 0x40,
+		0x42, 0xfc, 0xd5, 0x28, 0xee, 0x88, 0x3a, 0xe9, 0xf3, 0x2f, 0x40, 0xca, 0xb3, 0xb1, 0x82, 0x7a,
+		0x62, 0xa3, 0xf6, 0x8a, 0x34, 0x57, 0x98, 0xbf, 0x7d, 0x67, 0x39, 0xb2, 0x41, 0x6e, 0xe1, 0x0c,
+		0x53, 0x6b, 0x95, 0xab, 0xb5, 0xca, 0xcb, 0x52, 0xa8, 0x90, 0xba, 0x7d, 0x67, 0x79, 0x5e, 0x25,
+		0x34, 0x7c, 0x75, 0xfe, 0x65, 0xc8, 0x8d, 0x74, 0x8c, 0x5f, 0xbf, 0xcb, 0x90, 0xad, 0x6e, 0x6f,
+		0xad, 0xd5, 0x2b, 0xa5, 0x66, 0x4d, 0x63, 0xc7, 0xe9, 0xf0, 0x51, 0xfe, 0xb5, 0xfa, 0xea, 0xd5,
+		0xa6, 0x56, 0x59, 0xab, 0xd7, 0x36, 0x9a, 0x5a, 0xa9, 0xd9, 0x2c, 0x55, 0x5e, 0x96, 0xc2, 0x17,
+		0xbf, 0x08, 0x90, 0x2b, 0x95, 0x2b, 0x75, 0x5c, 0x9f, 0xf9, 0x5f, 0x80, 0x95, 0x2b, 0x10, 0xa5,
+		0xa1, 0x80, 0x23, 0x8f, 0x8a, 0x14, 0x8e, 0x8e, 0x6d, 0xca, 0x57, 0x20, 0x46, 0xa3, 0x04, 0xf2,
+		0xd1, 0x67, 0x47, 0x0a, 0x01, 0xc1, 0x4e, 0xac, 0x0c, 0x9d, 0x4e, 0x47, 0x1e, 0x26, 0x29, 0x1c,
+		0x1d, 0xfb, 0x94, 0x55, 0x48, 0xba, 0x5e, 0x46, 0xf0, 0xe1, 0x8a, 0xc2, 0x0c, 0xda, 0x51, 0x5e,
+		0x83, 0x79, 0xe1, 0x18, 0x06, 0x1d, 0xf7, 0x28, 0x04, 0x06, 0x27, 0x51, 0x5c, 0xcc, 0x81, 0x3f,
+		0xfa, 0xec, 0x4a, 0x21, 0x20, 0xd2, 0x2a, 0xd7, 0x9d, 0x43, 0xf8, 0x01, 0x47, 0x38, 0x0a, 0x41,
+		0xc1, 0x46, 0x14, 0x9a, 0x1b, 0x1a, 0x09, 0x3e, 0x91, 0x53, 0x98, 0x21, 0x88, 0x2c, 0x6f, 0x03,
+		0x78, 0xdc, 0xf5, 0x19, 0x8e, 0xda, 0x14, 0x66, 0x09, 0x0e, 0xcb, 0x9b, 0x90, 0x70, 0xbc, 0xa7,
+		0xc0, 0x83, 0x2f, 0x85, 0xe0, 0x28, 0xad, 0xfc, 0x7e, 0xc8, 0xf8, 0xbd, 0x86, 0xd9, 0x8e, 0xb3,
+		0x14, 0x66, 0x0c, 0xbf, 0x22, 0xbe, 0xdf, 0x85, 0x98, 0xed, 0x78, 0x4b, 0x61, 0xc6, 0x68, 0xac,
+		0xfc, 0x06, 0x2c, 0x8c, 0x9b, 0xf8, 0xb3, 0x9f, 0x76, 0x29, 0x1c, 0x23, 0x3e, 0x2b, 0xf7, 0x40,
+		0x9e, 0xe0, 0x1a, 0x1c, 0xe3, 0xf0, 0x4b, 0xe1, 0x38, 0xe1, 0x5a, 0xb9, 0x0d, 0xb9, 0x51, 0x7b,
+		0x7b, 0xd6, 0xc3, 0x30, 0x85, 0x99, 0x43, 0xb7, 0xec, 0x2b, 0x7e, 0x3b, 0x7d, 0xd6, 0xc3, 0x31,
+		0x85, 0x99, 0x23, 0xb9, 0xe5, 0xd2, 0xd4, 0xf3, 0x8d, 0x8f, 0x1e, 0x79, 0xbe, 0xd1, 0x3d, 0xb1,
+		0xe8, 0x9c, 0x69, 0xfc, 0xf5, 0x12, 0xbc, 0x8b, 0x3f, 0x26, 0x60, 0xd9, 0xfa, 0x8d, 0x8e, 0xb1,
+		0xe7, 0xbc, 0x0e, 0xc1, 0xd3, 0xfc, 0x70, 0xe3, 0x49, 0x46, 0xb5, 0x22, 0x72, 0x03, 0xde, 0x88,
+		0x98, 0xfa, 0xee, 0x55, 0xd0, 0x21, 0xe4, 0xe0, 0xa3, 0x8b, 0x47, 0xbc, 0x3f, 0x11, 0xf0, 0xca,
+		0xc5, 0x84, 0xf7, 0x29, 0x02, 0x0e, 0x61, 0x1e, 0x75, 0xde, 0x53, 0xf9, 0xf6, 0x10, 0x64, 0xaf,
+		0x76, 0x2c, 0xdb, 0x1c, 0x74, 0x5a, 0x7a, 0x97, 0xae, 0x18, 0x2f, 0xce, 0x7a, 0xc9, 0xa3, 0x9c,
+		0x44, 0x63, 0x84, 0x3f, 0x6a, 0xc1, 0x58, 0xe4, 0x2a, 0xc4, 0x6f, 0xea, 0x5d, 0x76, 0xc5, 0xc2,
+		0xfb, 0xfc, 0xcc, 0xa8, 0xcc, 0x3d, 0x56, 0x92, 0x17, 0x85, 0xf1, 0x2a, 0x3f, 0x4e, 0x0f, 0x71,
+		0xf7, 0x7a, 0x1d, 0x8b, 0xfd, 0x55, 0x7a, 0x0c, 0xb5, 0x6c, 0x41, 0x74, 0xa0, 0xdb, 0xdc, 0xa9,
+		0x29, 0xbf, 0x9b, 0x3f, 0x45, 0xf1, 0x48, 0xf0, 0x83, 0x12, 0x2b, 0xe3, 0xaf, 0x55, 0x50, 0x24,
+		0xf9, 0x15, 0xc0, 0xc3, 0xc3, 0x1a, 0x45, 0x0d, 0xdf, 0x03, 0x54, 0x3c, 0x49, 0x8d, 0x75, 0xc5,
+		0x19, 0x84, 0xc0, 0xad, 0x7d, 0xdd, 0xd8, 0x23, 0x0c, 0x3f, 0x72, 0x0f, 0xf0, 0x33, 0x3d, 0xfd,
+		0xa0, 0x42, 0x31, 0xf1, 0x2b, 0xc5, 0xc4, 0x47, 0x3f, 0x7e, 0x76, 0x8e, 0x9e, 0x45, 0xfe, 0x95,
+		0x10, 0x80, 0x2b, 0x2e, 0x59, 0xc7, 0x58, 0xbe, 0x48, 0xd1, 0xcf, 0x8b, 0x58, 0xfe, 0xa3, 0xd3,
+		0x7a, 0x63, 0x44, 0xd8, 0xe5, 0x0c, 0x56, 0xf4, 0xd3, 0x6f, 0x9f, 0x0d, 0xb1, 0x7e, 0xc9, 0xb5,
+		0x46, 0x3a, 0xe3, 0x1a, 0xa4, 0x58, 0x08, 0x4d, 0xa3, 0x76, 0x6b, 0x38, 0xd0, 0x6e, 0xcd, 0x08,
+		0xbb, 0x95, 0x01, 0x02, 0xe3, 0xc6, 0x72, 0x4f, 0x3b, 0x7e, 0x3c, 0x04, 0xa9, 0xaa, 0xe7, 0x99,
+		0x29, 0xdc, 0x90, 0x31, 0x8d, 0xce, 0x0d, 0x32, 0x70, 0x36, 0x64, 0x58, 0x12, 0xed, 0x4b, 0xf6,
+		0xd7, 0x8a, 0xec, 0x43, 0xf1, 0x04, 0x83, 0x48, 0x23, 0xd7, 0x2d, 0xb2, 0x63, 0x75, 0x84, 0xd4,
+		0x55, 0x91, 0xc4, 0xdd, 0x71, 0x8b, 0xb4, 0x86, 0x18, 0xab, 0xc5, 0x3f, 0x54, 0x66, 0xe3, 0xdf,
+		0x34, 0x64, 0x61, 0xd3, 0x9c, 0xc8, 0xaf, 0xb0, 0x6c, 0x04, 0x69, 0x13, 0x5b, 0xef, 0x74, 0xd9,
+		0x79, 0xaf, 0xa4, 0x2a, 0x92, 0x9e, 0xea, 0x7e, 0x7f, 0xc2, 0xeb, 0x17, 0x56, 0x40, 0x32, 0xfb,
+		0x64, 0xe0, 0xbb, 0xef, 0xc6, 0xc6, 0x6a, 0xfe, 0x37, 0x7f, 0xe6, 0xc9, 0x25, 0x2e, 0x78, 0xbe,
+		0xfb, 0xce, 0x5e, 0x56, 0x56, 0x73, 0x82, 0x83, 0x67, 0xcb, 0xaf, 0xf9, 0xb6, 0x61, 0x86, 0x3b,
+		0xee, 0x7d, 0xfb, 0xa5, 0x31, 0xe1, 0x96, 0x8c, 0xc3, 0x72, 0xfe, 0xd7, 0x5d, 0x68, 0xd7, 0x6f,
+		0xc4, 0x1b, 0xee, 0x9e, 0x3d, 0x19, 0x0a, 0x83, 0xe6, 0xfb, 0x1b, 0x7a, 0xa7, 0x2b, 0xfe, 0xb8,
+		0x9b, 0xca, 0x53, 0x72, 0xd1, 0x89, 0xc1, 0x44, 0xa9, 0x2f, 0xa4, 0x4c, 0x1b, 0x23, 0x65, 0xd3,
+		0x68, 0xfb, 0xc3, 0x2d, 0x72, 0x13, 0xe2, 0xb6, 0x79, 0x83, 0x18, 0x5c, 0x48, 0xc7, 0x1a, 0xdf,
+		0xe3, 0x8f, 0xcf, 0x70, 0x2c, 0x79, 0x0f, 0xa4, 0x36, 0xe9, 0x92, 0x3d, 0x2a, 0x4a, 0xfc, 0x23,
+		0xb8, 0x84, 0xdd, 0xe0, 0xfc, 0x52, 0xe7, 0x4f, 0xce, 0x41, 0x6d, 0x50, 0x50, 0x79, 0xcb, 0xff,
+		0xd0, 0xd9, 0x3c, 0xdf, 0x92, 0x9f, 0xd2, 0x7e, 0xcf, 0xc8, 0xf4, 0xea, 0x2c, 0x2f, 0x04, 0x8e,
+		0xb0, 0xa1, 0xb1, 0x63, 0x1a, 0xf4, 0xef, 0x25, 0x71, 0xef, 0x2a, 0xc1, 0xb6, 0xed, 0x9c, 0x7c,
+		0xbe, 0x6d, 0xb7, 0x05, 0x59, 0x97, 0x94, 0xce, 0xa2, 0xe4, 0x71, 0x67, 0x51, 0xc6, 0x01, 0x40,
+		0x12, 0xfc, 0xc3, 0x4a, 0xee, 0x3c, 0x75, 0xb6, 0xf6, 0x03, 0x67, 0xbc, 0xb7, 0x31, 0x1e, 0x00,
+		0xb9, 0x0b, 0x8b, 0xbd, 0x8e, 0xa1, 0x59, 0xa4, 0xbb, 0xab, 0x71, 0xc9, 0x21, 0x6e, 0xea, 0x1e,
+		0xf4, 0xf4, 0x42, 0xaf, 0x63, 0x34, 0x48, 0x77, 0xb7, 0xea, 0xc0, 0xca, 0xef, 0x86, 0xd3, 0xae,
+		0x38, 0x4c, 0x43, 0xdb, 0x37, 0xbb, 0x6d, 0x6d, 0x40, 0x76, 0xb5, 0x16, 0x7d, 0xfb, 0x28, 0x4d,
+		0x85, 0x78, 0x9f, 0x43, 0xb2, 0x69, 0x5c, 0x35, 0xbb, 0x6d, 0x95, 0xec, 0x56, 0xb0, 0x18, 0xe3,
+		0xe2, 0x2e, 0x77, 0xa7, 0x8d, 0x47, 0x02, 0x22, 0x78, 0x1e, 0xd6, 0xc9, 0xac, 0xb7, 0x2d, 0x99,
+		0x40, 0xee, 0x16, 0x95, 0x3d, 0x69, 0x6b, 0x7c, 0xd8, 0x66, 0xef, 0x41, 0x63, 0xb2, 0x02, 0xb4,
+		0x49, 0x31, 0x8b, 0xe9, 0x0f, 0x7f, 0xfc, 0xec, 0x1c, 0x57, 0x12, 0x73, 0xca, 0x16, 0x7d, 0x14,
+		0x84, 0xcf, 0x6f, 0x62, 0xc9, 0x97, 0x21, 0xa9, 0x8b, 0x04, 0xbb, 0x8e, 0x72, 0x84, 0x7e, 0x70,
+		0x49, 0x99, 0xda, 0x79, 0xeb, 0x77, 0x96, 0x43, 0xca, 0x0f, 0x86, 0x20, 0x5e, 0xbd, 0xbe, 0xa5,
+		0x77, 0x06, 0x72, 0x0d, 0xdf, 0x85, 0x13, 0x33, 0x65, 0x56, 0xa5, 0xe3, 0x4e, 0x2e, 0x9e, 0x8f,
+		0x30, 0x93, 0xef, 0xea, 0x1e, 0x09, 0x33, 0x7a, 0x8b, 0x77, 0xa4, 0xe1, 0xd7, 0x60, 0x9e, 0xd5,
+		0xd2, 0x92, 0x5f, 0x82, 0x58, 0x1f, 0x7f, 0xe4, 0x43, 0xbe, 0x57, 0xe2, 0xc6, 0x67, 0x18, 0xa5,
+		0xf7, 0x8e, 0x47, 0xc6, 0xa7, 0xfc, 0x65, 0x08, 0xa0, 0x7a, 0xfd, 0x7a, 0x73, 0xd0, 0xe9, 0x77,
+		0x89, 0x7d, 0xaf, 0x9a, 0xbd, 0x06, 0x27, 0xdc, 0x66, 0x5b, 0x83, 0xd6, 0xcc, 0x4d, 0x5f, 0x74,
+		0xd8, 0x1a, 0x83, 0xd6, 0x44, 0xb4, 0xb6, 0x65, 0x3b, 0x68, 0x91, 0x99, 0xd1, 0xaa, 0x96, 0x3d,
+		0x59, 0x96, 0xaf, 0x42, 0xca, 0x6d, 0xbe, 0x25, 0xd7, 0x21, 0x61, 0xf3, 0xdf, 0x5c, 0xa4, 0xca,
+		0x74, 0x91, 0x0a, 0x36, 0xaf, 0x58, 0x1d, 0x76, 0xe5, 0xaf, 0x50, 0xb2, 0xee, 0x2c, 0xfc, 0x8a,
+		0x1a, 0x50, 0xb8, 0xbc, 0x70, 0xf5, 0x7f, 0x2f, 0xcc, 0x27, 0x8e, 0x35, 0x22, 0xda, 0x0f, 0x87,
+		0xf1, 0x4f, 0x2d, 0x72, 0x2d, 0xf1, 0x15, 0x2b, 0x89, 0x6d, 0x98, 0x27, 0x86, 0x3d, 0xe8, 0x10,
+		0xb1, 0xd1, 0xf8, 0xd4, 0xb4, 0x0e, 0x9f, 0xd0, 0x16, 0xfa, 0x97, 0xbe, 0xbd, 0xdd, 0x2f, 0xb0,
+		0x46, 0x44, 0xf1, 0xcb, 0x11, 0xc8, 0x4f, 0x63, 0xc7, 0xa7, 0x12, 0x5a, 0x03, 0x42, 0x33, 0x34,
+		0x5f, 0x30, 0x3e, 0x2b, 0xb2, 0xf9, 0xba, 0xa6, 0x02, 0x5a, 0x8b, 0x38, 0xba, 0x90, 0xf4, 0xee,
+		0xcc, 0xc3, 0xac, 0x8b, 0x40, 0x57, 0x36, 0x02, 0x39, 0x71, 0xba, 0x7e, 0x47, 0xef, 0xea, 0x78,
+		0x25, 0x33, 0x72, 0x2f, 0x34, 0x37, 0x07, 0x2d, 0x33, 0x4c, 0xf9, 0x3a, 0xcc, 0x0b, 0xf8, 0xe8,
+		0x3d, 0x80, 0x17, 0x60, 0x78, 0xcf, 0xc2, 0xbb, 0x3a, 0x51, 0x63, 0x29, 0xaa, 0xa6, 0x9c, 0xbc,
+		0x7a, 0x3b, 0x68, 0xf9, 0x8b, 0x1f, 0xb9, 0xfc, 0x79, 0x6c, 0xd2, 0x7f, 0x11, 0xc1, 0xd3, 0x05,
+		0xed, 0xaf, 0xc2, 0xce, 0xfb, 0x5a, 0x00, 0x36, 0xc1, 0x51, 0xf9, 0xe6, 0xa3, 0xf7, 0x40, 0x61,
+		0x24, 0x19, 0x5e, 0xd5, 0xb2, 0xbf, 0x9c, 0x3d, 0xf8, 0xef, 0xc3, 0x90, 0xf6, 0xf6, 0xe0, 0x57,
+		0xc1, 0x6a, 0x27, 0x6f, 0xb8, 0xea, 0x8d, 0x9d, 0x83, 0x7f, 0x6c, 0x9a, 0x7a, 0x1b, 0x1b, 0xdb,
+		0x33, 0xe8, 0xb5, 0x8f, 0xc5, 0x20, 0xce, 0x0f, 0xa6, 0x6d, 0x8e, 0x19, 0xdd, 0xa1, 0xa0, 0xdb,
+		0xc8, 0x19, 0x71, 0x1b, 0x79, 0xa2, 0xcd, 0xfd, 0x30, 0xe0, 0x1d, 0x65, 0xcd, 0x77, 0xda, 0x0d,
+		0x37, 0x47, 0xd0, 0x57, 0x77, 0xcf, 0x66, 0xe3, 0x81, 0x3f, 0x24, 0x73, 0x75, 0x38, 0xd2, 0xe0,
+		0xb5, 0x83, 0x1a, 0xcb, 0x91, 0x9f, 0x04, 0x79, 0xdf, 0x09, 0xc3, 0x68, 0xae, 0x30, 0x90, 0x6e,
+		0xc1, 0x2d, 0x11, 0xe4, 0xf8, 0x6c, 0x84, 0x69, 0xb4, 0x35, 0xf6, 0x5e, 0x28, 0x7f, 0xc4, 0x15,
+		0x73, 0xaa, 0x98, 0x21, 0xff, 0x75, 0x66, 0xba, 0x8f, 0x44, 0x01, 0xb8, 0x13, 0xb5, 0x76, 0xbc,
+		0x49, 0xf1, 0xe7, 0x6f, 0x9f, 0x2d, 0x1c, 0xea, 0xbd, 0x6e, 0x51, 0x99, 0x00, 0xa9, 0x50, 0x53,
+		0xde, 0x1f, 0x3d, 0x90, 0x77, 0x61, 0xc9, 0xb1, 0xb3, 0xdd, 0x5a, 0x8a, 0xf3, 0x44, 0x53, 0xbb,
+		0xf6, 0x15, 0xce, 0x53, 0x16, 0xcd, 0xf0, 0x76, 0xad, 0x7c, 0x6b, 0xb4, 0xd4, 0xc2, 0x49, 0x49,
+		0xfa, 0x66, 0x6b, 0x5f, 0xeb, 0x12, 0x63, 0xcf, 0x66, 0x2f, 0x42, 0x45, 0xd5, 0x14, 0xcd, 0x5b,
+		0xa3, 0x59, 0xf2, 0x73, 0x90, 0xf7, 0x77, 0x8f, 0x36, 0xd0, 0x7b, 0x78, 0x9d, 0x8b, 0xf4, 0xa9,
+		0xbb, 0x95, 0x51, 0x4f, 0xf8, 0x3a, 0x4a, 0xd5, 0x7b, 0xfd, 0x86, 0x4d, 0xfa, 0xf2, 0x4b, 0x70,
+		0xff, 0x24, 0x46, 0xfa, 0xfa, 0xf2, 0x4d, 0xbd, 0x4b, 0xbd, 0xab, 0xa8, 0x7a, 0x6a, 0x8c, 0xb9,
+		0xce, 0x09, 0x8a, 0xe7, 0xc4, 0x84, 0xbe, 0xfd, 0x07, 0x3f, 0x79, 0xfe, 0xb4, 0x47, 0xb0, 0x07,
+		0x4e, 0x90, 0x92, 0x8d, 0x49, 0xe5, 0x43, 0x21, 0x58, 0x18, 0x6b, 0xfb, 0x94, 0xc7, 0x60, 0x9b,
+		0x10, 0xbf, 0xe5, 0x3e, 0x42, 0xf3, 0x25, 0x5b, 0x44, 0x0c, 0x8b, 0xbf, 0x17, 0xfb, 0xc9, 0x30,
+		0x2c, 0x3a, 0x8d, 0xa1, 0x95, 0x68, 0xd8, 0xfa, 0x0d, 0x32, 0xd9, 0x84, 0x09, 0x1d, 0xdb, 0x84,
+		0x71, 0x1a, 0x14, 0x1e, 0x69, 0x10, 0x77, 0xc5, 0x22, 0xf7, 0x30, 0x82, 0xe0, 0x1a, 0x8e, 0xd1,
+		0x7b, 0x68, 0x38, 0x26, 0x3e, 0x2c, 0x34, 0xca, 0x3f, 0x08, 0x43, 0x8e, 0x4a, 0xe8, 0x2b, 0xd6,
+		0x60, 0x74, 0xa4, 0x1d, 0x19, 0x91, 0xf6, 0x3b, 0x2a, 0x97, 0xef, 0x0b, 0x43, 0x9e, 0xca, 0xe5,
+		0x2b, 0xdf, 0xa2, 0x9e, 0x2c, 0xa0, 0xed, 0xd1, 0x85, 0xe8, 0xde, 0xd8, 0xd9, 0xae, 0x84, 0x7e,
+		0x29, 0x02, 0x27, 0xdf, 0x37, 0x24, 0x43, 0xd2, 0x6e, 0x30, 0xc0, 0x4d, 0x1a, 0x01, 0x44, 0xf9,
+		0x64, 0x21, 0xcc, 0xdf, 0x12, 0x8a, 0xaa, 0xe1, 0x4e, 0x5b, 0x6e, 0x40, 0xd6, 0x14, 0x85, 0x9a,
+		0xe7, 0x9e, 0xe2, 0x13, 0xd3, 0xaa, 0x34, 0x8a, 0x48, 0x0f, 0x2e, 0x64, 0x4c, 0x6f, 0x72, 0x72,
+		0x27, 0x44, 0xee, 0x4d, 0x27, 0x44, 0x8f, 0xdd, 0x09, 0x53, 0xad, 0x88, 0xd8, 0xdd, 0x58, 0x11,
+		0xef, 0x76, 0x5e, 0xca, 0x8e, 0xf3, 0x45, 0x7d, 0xc2, 0x83, 0xe0, 0x2b, 0xf8, 0xd4, 0xb6, 0x6f,
+		0xcf, 0x81, 0xf1, 0x4c, 0xb2, 0x91, 0xe7, 0x27, 0xd9, 0xc8, 0xca, 0x4f, 0x84, 0x40, 0x76, 0x3b,
+		0xdd, 0xb9, 0x42, 0xb5, 0x4e, 0x2f, 0xd6, 0xf0, 0x5c, 0xe7, 0x0e, 0xd5, 0xd4, 0x58, 0xa2, 0xa0,
+		0xf4, 0x56, 0xc5, 0x03, 0x20, 0xbf, 0xd7, 0xf5, 0x45, 0xc2, 0xc7, 0x68, 0x8d, 0x60, 0x72, 0x0c,
+		0xca, 0x39, 0xe5, 0xed, 0x10, 0x1e, 0x7e, 0x1c, 0x31, 0x9b, 0x9c, 0x6a, 0xb7, 0x40, 0x1e, 0x78,
+		0x0a, 0xa9, 0xe9, 0x21, 0x8e, 0x25, 0xdd, 0x9d, 0x15, 0xb6, 0x30, 0x18, 0x2d, 0x7d, 0xa7, 0x1c,
+		0x2b, 0xbe, 0x70, 0xfd, 0x5a, 0x08, 0x6f, 0xc7, 0xb6, 0xc7, 0xbb, 0xa4, 0x01, 0x69, 0x6f, 0x5d,
+		0x78, 0xab, 0xde, 0x35, 0x4b, 0xab, 0xbc, 0x0d, 0xf2, 0x81, 0x60, 0x5b, 0x84, 0x8a, 0x60, 0x5b,
+		0x5c, 0x4f, 0xcf, 0x2c, 0x25, 0x67, 0xfb, 0x7e, 0x92, 0x8e, 0x88, 0xd2, 0xce, 0xfa, 0xd6, 0x30,
+		0x44, 0xb7, 0x4c, 0xb3, 0x2b, 0x7f, 0x63, 0x08, 0x16, 0x0c, 0xd3, 0xa6, 0x06, 0x94, 0x1b, 0xaf,
+		0x64, 0xea, 0xf2, 0xfa, 0xf1, 0xa4, 0xf7, 0x87, 0x6f, 0x9f, 0x1d, 0x87, 0x9a, 0xf4, 0x32, 0x7d,
+		0xce, 0x30, 0xed, 0x32, 0x25, 0x62, 0xa1, 0x4c, 0xf9, 0x16, 0x64, 0xfc, 0xdf, 0x67, 0x7a, 0x56,
+		0x3d, 0xf6, 0xf7, 0x33, 0x81, 0xdf, 0x4e, 0xef, 0x78, 0x3e, 0xcc, 0xde, 0x87, 0xfe, 0x53, 0xec,
+		0xdc, 0xd7, 0x40, 0xba, 0x3e, 0x7a, 0xa0, 0xbb, 0x06, 0xf3, 0xc7, 0x3d, 0x1b, 0xee, 0x95, 0x38,
+		0xe7, 0x3d, 0xff, 0xb3, 0x21, 0x00, 0x77, 0x53, 0x03, 0x1f, 0xe5, 0x2a, 0x6f, 0x6e, 0x54, 0xb5,
+		0x46, 0xb3, 0xd4, 0xdc, 0x6e, 0xf8, 0xff, 0x1a, 0x89, 0x78, 0xc2, 0xcb, 0xea, 0x93, 0x56, 0x67,
+		0xb7, 0x43, 0xda, 0xf2, 0x23, 0xb0, 0xe4, 0xa7, 0xc6, 0x54, 0xad, 0x2a, 0x85, 0x0a, 0xe9, 0xdb,
+		0x77, 0x96, 0x13, 0x6c, 0x79, 0x20, 0xf8, 0x00, 0xea, 0x89, 0x71, 0x3a, 0xfc, 0x4b, 0x26, 0xe1,
+		0x42, 0xe6, 0xf6, 0x9d, 0xe5, 0xa4, 0xb3, 0x8e, 0xc8, 0x0a, 0xc8, 0x5e, 0x4a, 0x8e, 0x17, 0x29,
+		0xc0, 0xed, 0x3b, 0xcb, 0x71, 0xd6, 0x2d, 0x85, 0x28, 0x3e, 0xd4, 0x75, 0xfe, 0xc7, 0xc2, 0xb0,
+		0x34, 0x49, 0xd9, 0xcb, 0x55, 0x50, 0x1a, 0xcd, 0xd2, 0xcb, 0xf5, 0x8d, 0x55, 0x6d, 0x73, 0xab,
+		0xa6, 0x96, 0x9a, 0xf5, 0xcd, 0x8d, 0x09, 0x7f, 0x5b, 0xa5, 0x70, 0xff, 0xed, 0x3b, 0xcb, 0x79,
+		0x1f, 0xab, 0xb7, 0x69, 0xef, 0x85, 0xb3, 0x53, 0x50, 0xaa, 0xb5, 0xb5, 0xda, 0x6a, 0x89, 0xbe,
+		0x52, 0x76, 0xea, 0xf6, 0x9d, 0xe5, 0x13, 0x3e, 0x08, 0xae, 0xd3, 0xf0, 0x32, 0xe9, 0x83, 0x53,
+		0x6b, 0xe1, 0x20, 0x84, 0x0b, 0xa7, 0x6f, 0xdf, 0x59, 0xbe, 0x6f, 0xa4, 0x12, 0xed, 0x60, 0x0c,
+		0xb5, 0xe6, 0x60, 0x44, 0x26, 0x60, 0x38, 0x93, 0x8e, 0x70, 0x61, 0x7d, 0x1d, 0x40, 0xdd, 0xd8,
+		0x1d, 0xe8, 0x2d, 0x3a, 0x7b, 0x0b, 0x70, 0xb2, 0xbe, 0x71, 0x45, 0x2d, 0x55, 0x28, 0xa0, 0x4f,
+		0x2a, 0x23, 0x65, 0xd5, 0xcd, 0xed, 0xf2, 0x5a, 0x4d, 0xc3, 0x77, 0xd4, 0xd8, 0x29, 0x32, 0x5f,
+		0xd9, 0x2b, 0x1b, 0xcd, 0xfa, 0x7a, 0x4d, 0x0a, 0x97, 0xaf, 0x4c, 0x3d, 0xc7, 0xf0, 0xc4, 0x91,
+		0xb3, 0xc3, 0x75, 0x03, 0x7c, 0x87, 0x19, 0xfe, 0xef, 0x00, 0xc5, 0x8b, 0x23, 0x2a, 0xc5, 0xa4,
+		0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2299,6 +2728,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if len(this.WeightedBondDenoms) != len(that1.WeightedBondDenoms) {
+		return false
+	}
+	for i := range this.WeightedBondDenoms {
+		if !this.WeightedBondDenoms[i].Equal(&that1.WeightedBondDenoms[i]) {
+			return false
+		}
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if this.MaxValidatorsRampStep != that1.MaxValidatorsRampStep {
+		return false
+	}
+	if this.MaxValidatorsRampInterval != that1.MaxValidatorsRampInterval {
+		return false
+	}
+	return true
+}
+func (this *WeightedBondDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedBondDenom)
+	if !ok {
+		that2, ok := that.(WeightedBondDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2574,6 +3047,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.WeightedTokens.Size()
+		i -= size
+		if _, err := m.WeightedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
 	if len(m.UnbondingIds) > 0 {
 		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
 		var j4 int
@@ -3192,6 +3675,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxValidatorsRampInterval != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidatorsRampInterval))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxValidatorsRampStep != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidatorsRampStep))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.WeightedBondDenoms) > 0 {
+		for iNdEx := len(m.WeightedBondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedBondDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3235,7 +3747,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *WeightedBondDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3245,19 +3757,297 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WeightedBondDenom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WeightedBondDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorDenomStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDenomStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDenomStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedStakingOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedStakingOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedStakingOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OperationType != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.OperationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -3578,6 +4368,8 @@ func (m *Validator) Size() (n int) {
 		}
 		n += 1 + sovStaking(uint64(l)) + l
 	}
+	l = m.WeightedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3804,18 +4596,151 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.WeightedBondDenoms) > 0 {
+		for _, e := range m.WeightedBondDenoms {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.EpochLength))
+	}
+	if m.MaxValidatorsRampStep != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidatorsRampStep))
+	}
+	if m.MaxValidatorsRampInterval != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidatorsRampInterval))
+	}
 	return n
 }
 
-func (m *DelegationResponse) Size() (n int) {
+func (m *WeightedBondDenom) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorDenomStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *DenomDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *DenomUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedStakingOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	if m.OperationType != 0 {
+		n += 1 + sovStaking(uint64(m.OperationType))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
@@ -4948,6 +5873,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5825,9 +6784,1031 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedBondDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedBondDenoms = append(m.WeightedBondDenoms, WeightedBondDenom{})
+			if err := m.WeightedBondDenoms[len(m.WeightedBondDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsRampStep", wireType)
+			}
+			m.MaxValidatorsRampStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorsRampStep |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsRampInterval", wireType)
+			}
+			m.MaxValidatorsRampInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorsRampInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedBondDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedBondDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedBondDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDenomStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDenomStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDenomStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5837,28 +7818,27 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5886,13 +7866,13 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5920,48 +7900,10 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5983,7 +7925,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *DenomDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6006,17 +7948,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.CreationHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6026,16 +7968,29 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6045,28 +8000,27 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6094,13 +8048,11 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6128,48 +8080,10 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6191,7 +8105,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *DenomUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6214,10 +8128,10 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6254,7 +8168,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6282,11 +8196,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6314,7 +8228,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -6345,7 +8259,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
 			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -6371,7 +8285,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *QueuedStakingOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6394,17 +8308,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedStakingOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedStakingOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6414,30 +8328,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
 			}
-			m.MaxValidators = 0
+			m.OperationType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6447,16 +8347,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				m.OperationType |= StakingOperationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			m.MaxEntries = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6466,16 +8366,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.HistoricalEntries = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6485,14 +8398,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6520,13 +8446,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6536,26 +8462,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -635,6 +635,170 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgDelegateDenom defines a SDK message for delegating coins of an additional
+// bond denom from a delegator to a validator.
+type MsgDelegateDenom struct {
+	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDelegateDenom) Reset()         { *m = MsgDelegateDenom{} }
+func (m *MsgDelegateDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateDenom) ProtoMessage()    {}
+func (*MsgDelegateDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgDelegateDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateDenom.Merge(m, src)
+}
+func (m *MsgDelegateDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateDenom proto.InternalMessageInfo
+
+// MsgDelegateDenomResponse defines the Msg/DelegateDenom response type.
+type MsgDelegateDenomResponse struct {
+}
+
+func (m *MsgDelegateDenomResponse) Reset()         { *m = MsgDelegateDenomResponse{} }
+func (m *MsgDelegateDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateDenomResponse) ProtoMessage()    {}
+func (*MsgDelegateDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgDelegateDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateDenomResponse.Merge(m, src)
+}
+func (m *MsgDelegateDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateDenomResponse proto.InternalMessageInfo
+
+// MsgUndelegateDenom defines a SDK message for undelegating coins of an
+// additional bond denom from a validator.
+type MsgUndelegateDenom struct {
+	DelegatorAddress string      `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string      `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           types1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgUndelegateDenom) Reset()         { *m = MsgUndelegateDenom{} }
+func (m *MsgUndelegateDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateDenom) ProtoMessage()    {}
+func (*MsgUndelegateDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{16}
+}
+func (m *MsgUndelegateDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateDenom.Merge(m, src)
+}
+func (m *MsgUndelegateDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateDenom proto.InternalMessageInfo
+
+// MsgUndelegateDenomResponse defines the Msg/UndelegateDenom response type.
+type MsgUndelegateDenomResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgUndelegateDenomResponse) Reset()         { *m = MsgUndelegateDenomResponse{} }
+func (m *MsgUndelegateDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateDenomResponse) ProtoMessage()    {}
+func (*MsgUndelegateDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{17}
+}
+func (m *MsgUndelegateDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateDenomResponse.Merge(m, src)
+}
+func (m *MsgUndelegateDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateDenomResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateDenomResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -650,81 +814,90 @@ func init() {
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgDelegateDenom)(nil), "cosmos.staking.v1beta1.MsgDelegateDenom")
+	proto.RegisterType((*MsgDelegateDenomResponse)(nil), "cosmos.staking.v1beta1.MsgDelegateDenomResponse")
+	proto.RegisterType((*MsgUndelegateDenom)(nil), "cosmos.staking.v1beta1.MsgUndelegateDenom")
+	proto.RegisterType((*MsgUndelegateDenomResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateDenomResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1100 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x89, 0x21, 0x13, 0x92, 0x34, 0x9b, 0xa4, 0xb5, 0x97, 0x62, 0x57, 0xdb, 0xd0,
-	0x44, 0x81, 0xac, 0x69, 0x40, 0xfc, 0x30, 0x11, 0x22, 0xae, 0x5b, 0x51, 0xc0, 0x52, 0xb4, 0xa1,
-	0x3d, 0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0xec, 0xdd, 0xd9, 0xee, 0x8c, 0xa3, 0xfa, 0x86,
-	0x38, 0x21, 0x2e, 0x54, 0x88, 0x03, 0x17, 0xa4, 0x1e, 0x39, 0xe6, 0xd0, 0x03, 0xff, 0x00, 0x52,
-	0xc5, 0xa9, 0xea, 0x09, 0x71, 0x68, 0x51, 0x72, 0x08, 0xff, 0x01, 0x57, 0xb4, 0xbb, 0xb3, 0x3f,
-	0x6d, 0xaf, 0x37, 0x55, 0x7b, 0x80, 0x4b, 0xbc, 0x79, 0xf3, 0xbd, 0x6f, 0x66, 0xbe, 0x6f, 0xde,
-	0xcc, 0x03, 0x15, 0x05, 0x13, 0x03, 0x93, 0x2a, 0xa1, 0xb0, 0xab, 0x9b, 0x5a, 0xf5, 0xf0, 0x6a,
-	0x1b, 0x51, 0x78, 0xb5, 0x4a, 0xef, 0x4a, 0x96, 0x8d, 0x29, 0xe6, 0xcf, 0x7b, 0x00, 0x89, 0x01,
-	0x24, 0x06, 0x10, 0x4a, 0x1a, 0xc6, 0x5a, 0x0f, 0x55, 0x5d, 0x54, 0xbb, 0x7f, 0x50, 0x85, 0xe6,
-	0xc0, 0x4b, 0x11, 0x2a, 0xc9, 0x21, 0xaa, 0x1b, 0x88, 0x50, 0x68, 0x58, 0x0c, 0xb0, 0xa2, 0x61,
-	0x0d, 0xbb, 0x9f, 0x55, 0xe7, 0x8b, 0x45, 0x4b, 0xde, 0x4c, 0x2d, 0x6f, 0x80, 0x4d, 0xeb, 0x0d,
-	0x95, 0xd9, 0x2a, 0xdb, 0x90, 0xa0, 0x60, 0x89, 0x0a, 0xd6, 0x4d, 0x36, 0xbe, 0x36, 0x66, 0x17,
-	0xfe, 0xa2, 0x3d, 0xd4, 0x05, 0x86, 0x32, 0x88, 0x83, 0x70, 0x7e, 0xd8, 0xc0, 0x12, 0x34, 0x74,
-	0x13, 0x57, 0xdd, 0xbf, 0x5e, 0x48, 0xfc, 0x61, 0x06, 0xf0, 0x4d, 0xa2, 0x5d, 0xb3, 0x11, 0xa4,
-	0xe8, 0x36, 0xec, 0xe9, 0x2a, 0xa4, 0xd8, 0xe6, 0xf7, 0xc0, 0x9c, 0x8a, 0x88, 0x62, 0xeb, 0x16,
-	0xd5, 0xb1, 0x59, 0xe4, 0x2e, 0x71, 0x1b, 0x73, 0xdb, 0x97, 0xa5, 0xd1, 0x1a, 0x49, 0x8d, 0x10,
-	0x5a, 0x9f, 0x7d, 0xf8, 0xa4, 0x92, 0xfb, 0xe5, 0xf4, 0x68, 0x93, 0x93, 0xa3, 0x14, 0xbc, 0x0c,
-	0x80, 0x82, 0x0d, 0x43, 0x27, 0xc4, 0x21, 0xcc, 0xbb, 0x84, 0xeb, 0xe3, 0x08, 0xaf, 0x05, 0x48,
-	0x19, 0x52, 0x44, 0xa2, 0xa4, 0x11, 0x16, 0xbe, 0x07, 0x96, 0x0d, 0xdd, 0x6c, 0x11, 0xd4, 0x3b,
-	0x68, 0xa9, 0xa8, 0x87, 0x34, 0xe8, 0xae, 0x76, 0xea, 0x12, 0xb7, 0x31, 0x5b, 0xdf, 0x71, 0x72,
-	0xfe, 0x7c, 0x52, 0xb9, 0xa2, 0xe9, 0xb4, 0xd3, 0x6f, 0x4b, 0x0a, 0x36, 0x98, 0xd8, 0xec, 0x67,
-	0x8b, 0xa8, 0xdd, 0x2a, 0x1d, 0x58, 0x88, 0x48, 0x37, 0x4d, 0xfa, 0xf8, 0xc1, 0x16, 0x60, 0xab,
-	0xb9, 0x69, 0x52, 0x79, 0xc9, 0xd0, 0xcd, 0x7d, 0xd4, 0x3b, 0x68, 0x04, 0xb4, 0xfc, 0x75, 0xb0,
-	0xc4, 0x26, 0xc1, 0x76, 0x0b, 0xaa, 0xaa, 0x8d, 0x08, 0x29, 0x4e, 0xbb, 0x73, 0x15, 0x1f, 0x3f,
-	0xd8, 0x5a, 0x61, 0xd9, 0xbb, 0xde, 0xc8, 0x3e, 0xb5, 0x75, 0x53, 0x93, 0xcf, 0x05, 0x29, 0x2c,
-	0xee, 0xd0, 0x1c, 0xfa, 0x3a, 0x07, 0x34, 0x33, 0x93, 0x68, 0x82, 0x14, 0x9f, 0xe6, 0x06, 0x28,
-	0x58, 0xfd, 0x76, 0x17, 0x0d, 0x8a, 0x05, 0x57, 0xcb, 0x15, 0xc9, 0x3b, 0x8d, 0x92, 0x7f, 0x1a,
-	0xa5, 0x5d, 0x73, 0x50, 0x2f, 0xfe, 0x1e, 0x32, 0x2a, 0xf6, 0xc0, 0xa2, 0x58, 0xda, 0xeb, 0xb7,
-	0x3f, 0x43, 0x03, 0x99, 0x65, 0xf3, 0x35, 0x30, 0x73, 0x08, 0x7b, 0x7d, 0x54, 0x7c, 0xc9, 0xa5,
-	0x29, 0xf9, 0x96, 0x38, 0x47, 0x30, 0xe2, 0x87, 0x1e, 0x73, 0xd6, 0x4b, 0xa9, 0xdd, 0xfe, 0xf6,
-	0x7e, 0x25, 0xf7, 0xf7, 0xfd, 0x4a, 0xee, 0x9b, 0xd3, 0xa3, 0xcd, 0x61, 0x71, 0xdc, 0xe8, 0xd0,
-	0x5e, 0xbf, 0x3b, 0x3d, 0xda, 0x7c, 0x2d, 0xe2, 0xc0, 0xf0, 0xe9, 0x13, 0x2f, 0x02, 0x61, 0x38,
-	0x2a, 0x23, 0x62, 0x61, 0x93, 0x20, 0xf1, 0xd7, 0x29, 0x70, 0xae, 0x49, 0xb4, 0xeb, 0xaa, 0x4e,
-	0x5f, 0xe4, 0x81, 0x1d, 0xe9, 0x53, 0xfe, 0xcc, 0x3e, 0x41, 0xb0, 0x18, 0x9e, 0xd8, 0x96, 0x0d,
-	0x29, 0x62, 0xe7, 0xf3, 0xfd, 0x8c, 0x67, 0xb3, 0x81, 0x94, 0xc8, 0xd9, 0x6c, 0x20, 0x45, 0x5e,
-	0x50, 0x62, 0xe5, 0xc1, 0x77, 0x46, 0x97, 0xc1, 0xf4, 0x99, 0xa6, 0xc9, 0x52, 0x02, 0xb5, 0x8f,
-	0x62, 0x86, 0x8f, 0xb4, 0xf6, 0xd5, 0xb8, 0xb5, 0x31, 0x97, 0x44, 0x01, 0x14, 0x93, 0xb1, 0xc0,
-	0xd6, 0x1f, 0xf3, 0x60, 0xae, 0x49, 0x34, 0x36, 0x1b, 0x1a, 0x5d, 0x6e, 0xdc, 0xf3, 0x29, 0xb7,
-	0xb3, 0xdb, 0xb8, 0x03, 0x0a, 0xd0, 0xc0, 0x7d, 0x93, 0xba, 0xee, 0x65, 0xad, 0x13, 0x96, 0x53,
-	0xfb, 0x20, 0xbd, 0x50, 0x1c, 0xdd, 0xce, 0xc7, 0x75, 0xf3, 0x65, 0x10, 0x57, 0xc1, 0x72, 0xe4,
-	0xdf, 0x40, 0xad, 0x7f, 0xf2, 0xee, 0xbd, 0x5d, 0x47, 0x9a, 0x6e, 0xca, 0x48, 0x7d, 0xce, 0xa2,
-	0x7d, 0x0e, 0x56, 0x43, 0xd1, 0x88, 0xad, 0x64, 0x16, 0x6e, 0x39, 0x48, 0xdb, 0xb7, 0x95, 0x91,
-	0x6c, 0x2a, 0xa1, 0x01, 0xdb, 0x54, 0x66, 0xb6, 0x06, 0xa1, 0xc3, 0x4e, 0x4c, 0x3f, 0x83, 0x13,
-	0x1f, 0x4f, 0x76, 0x22, 0x71, 0x39, 0x25, 0x24, 0x16, 0x2d, 0xf7, 0x72, 0x4a, 0x44, 0x7d, 0x5f,
-	0x78, 0xd9, 0x2d, 0x77, 0xab, 0x87, 0x9c, 0x7a, 0x69, 0x39, 0x0d, 0x01, 0xbb, 0x8b, 0x84, 0xa1,
-	0xfb, 0xf9, 0x0b, 0xbf, 0x5b, 0xa8, 0xcf, 0x3b, 0xeb, 0xbc, 0xf7, 0xb4, 0xc2, 0x79, 0x6b, 0x5d,
-	0x08, 0x19, 0x1c, 0x8c, 0xf8, 0x53, 0x1e, 0xcc, 0x37, 0x89, 0x76, 0xcb, 0x54, 0xff, 0x8f, 0xb5,
-	0xf1, 0xe1, 0x64, 0x47, 0x8a, 0x71, 0x47, 0x42, 0x21, 0xc4, 0x2e, 0x58, 0x8d, 0x05, 0x5e, 0xa8,
-	0x0f, 0x4f, 0xf3, 0xe0, 0xa2, 0xf3, 0x2e, 0x41, 0x53, 0x41, 0xbd, 0x5b, 0x66, 0x1b, 0x9b, 0xaa,
-	0x6e, 0x6a, 0x93, 0x3a, 0x84, 0xff, 0xa6, 0x2d, 0xfc, 0x3a, 0x58, 0x54, 0x9c, 0x07, 0xd8, 0x91,
-	0xaf, 0x83, 0x74, 0xad, 0xe3, 0xd5, 0xdb, 0x94, 0xbc, 0xe0, 0x87, 0x3f, 0x71, 0xa3, 0xb5, 0x4f,
-	0x27, 0xfb, 0xb7, 0x9e, 0x78, 0xee, 0xc7, 0x09, 0x28, 0x5e, 0x01, 0x6b, 0x69, 0xe3, 0xc1, 0xed,
-	0xf7, 0x1b, 0x07, 0x16, 0x1d, 0xdf, 0x2d, 0x15, 0x52, 0xb4, 0x07, 0x6d, 0x68, 0x10, 0xfe, 0x5d,
-	0x30, 0x0b, 0xfb, 0xb4, 0x83, 0x6d, 0x9d, 0x0e, 0x26, 0x8a, 0x1e, 0x42, 0xf9, 0x5d, 0x50, 0xb0,
-	0x5c, 0x06, 0xd6, 0x94, 0x96, 0xc7, 0x35, 0x0d, 0xde, 0x3c, 0x31, 0xad, 0xbc, 0xc4, 0xda, 0x7b,
-	0xce, 0xd6, 0x43, 0x4a, 0x67, 0xcb, 0x6b, 0x91, 0x2d, 0xdf, 0x0d, 0xfa, 0xf5, 0xc4, 0x9a, 0xc5,
-	0x12, 0xb8, 0x90, 0x08, 0xf9, 0x5b, 0xdc, 0xfe, 0xb9, 0x00, 0xa6, 0x9a, 0x44, 0xe3, 0xef, 0x80,
-	0xc5, 0x64, 0x73, 0xbe, 0x39, 0x6e, 0x85, 0xc3, 0x4d, 0x93, 0xb0, 0x9d, 0x1d, 0x1b, 0xd4, 0x4e,
-	0x17, 0xcc, 0xc7, 0x9b, 0xab, 0x8d, 0x14, 0x92, 0x18, 0x52, 0x78, 0x2b, 0x2b, 0x32, 0x98, 0xec,
-	0x2b, 0xf0, 0x72, 0xf0, 0xe4, 0x5f, 0x4e, 0xc9, 0xf6, 0x41, 0xc2, 0x1b, 0x19, 0x40, 0x01, 0xfb,
-	0x1d, 0xb0, 0x98, 0x7c, 0x22, 0xd3, 0xd4, 0x4b, 0x60, 0x53, 0xd5, 0x1b, 0xf7, 0x02, 0xb4, 0x01,
-	0x88, 0xdc, 0xd4, 0xaf, 0xa7, 0x30, 0x84, 0x30, 0x61, 0x2b, 0x13, 0x2c, 0x98, 0xe3, 0x7b, 0x0e,
-	0x94, 0xc6, 0x5f, 0x43, 0xef, 0xa4, 0x79, 0x3e, 0x2e, 0x4b, 0xd8, 0x79, 0x96, 0xac, 0x60, 0x45,
-	0x1d, 0xf0, 0x4a, 0xac, 0x1a, 0xd7, 0xd3, 0x36, 0x14, 0x01, 0x0a, 0xd5, 0x8c, 0x40, 0x7f, 0x26,
-	0x61, 0xe6, 0x6b, 0xa7, 0xf6, 0xea, 0x37, 0x1e, 0x1e, 0x97, 0xb9, 0x47, 0xc7, 0x65, 0xee, 0xaf,
-	0xe3, 0x32, 0x77, 0xef, 0xa4, 0x9c, 0x7b, 0x74, 0x52, 0xce, 0xfd, 0x71, 0x52, 0xce, 0x7d, 0xf9,
-	0x66, 0x6a, 0xb7, 0x1b, 0x16, 0xa3, 0xdb, 0xf7, 0xb6, 0x0b, 0xee, 0x3b, 0xf0, 0xf6, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa0, 0x87, 0x6e, 0xf3, 0x21, 0x10, 0x00, 0x00,
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0xef, 0x26, 0x29, 0x99, 0x90, 0xa4, 0x71, 0x92, 0x76, 0xe3, 0x96, 0xdd, 0x6a, 0x1b,
+	0x9a, 0x28, 0x10, 0x6f, 0x1b, 0x10, 0x1f, 0x4b, 0x54, 0x91, 0xed, 0xb6, 0xa2, 0x40, 0xa4, 0xc8,
+	0xa1, 0x3d, 0x20, 0xa4, 0xd5, 0xac, 0x3d, 0xf1, 0x5a, 0x59, 0x7b, 0x5c, 0xcf, 0x6c, 0xd4, 0xbd,
+	0x21, 0x4e, 0x88, 0x0b, 0x15, 0xe2, 0xc0, 0x09, 0x55, 0x9c, 0x38, 0xe6, 0xd0, 0x03, 0xff, 0x00,
+	0x52, 0xc5, 0xa9, 0xea, 0x09, 0x71, 0x68, 0x51, 0x72, 0x08, 0xff, 0x01, 0x27, 0x24, 0x64, 0x7b,
+	0x3c, 0xfe, 0xd8, 0x2f, 0xa7, 0x6a, 0x0e, 0xcd, 0xa5, 0xd9, 0xbe, 0xf9, 0xbd, 0xf7, 0x66, 0x7e,
+	0xbf, 0xf7, 0xde, 0x8c, 0x41, 0x51, 0xc5, 0xc4, 0xc4, 0xa4, 0x4c, 0x28, 0xdc, 0x35, 0x2c, 0xbd,
+	0xbc, 0x77, 0xad, 0x81, 0x28, 0xbc, 0x56, 0xa6, 0xf7, 0x65, 0xdb, 0xc1, 0x14, 0x8b, 0xe7, 0x7c,
+	0x80, 0xcc, 0x00, 0x32, 0x03, 0x48, 0x0b, 0x3a, 0xc6, 0x7a, 0x0b, 0x95, 0x3d, 0x54, 0xa3, 0xbd,
+	0x53, 0x86, 0x56, 0xc7, 0x77, 0x91, 0x8a, 0xc9, 0x25, 0x6a, 0x98, 0x88, 0x50, 0x68, 0xda, 0x0c,
+	0x30, 0xa7, 0x63, 0x1d, 0x7b, 0x3f, 0xcb, 0xee, 0x2f, 0x66, 0x5d, 0xf0, 0x33, 0xd5, 0xfd, 0x05,
+	0x96, 0xd6, 0x5f, 0x2a, 0xb0, 0x5d, 0x36, 0x20, 0x41, 0x7c, 0x8b, 0x2a, 0x36, 0x2c, 0xb6, 0xbe,
+	0xd8, 0xe7, 0x14, 0xc1, 0xa6, 0x7d, 0xd4, 0x79, 0x86, 0x32, 0x89, 0x8b, 0x70, 0xff, 0xb0, 0x85,
+	0x19, 0x68, 0x1a, 0x16, 0x2e, 0x7b, 0xff, 0xfa, 0xa6, 0xd2, 0x0f, 0xa3, 0x40, 0xdc, 0x24, 0xfa,
+	0x0d, 0x07, 0x41, 0x8a, 0xee, 0xc2, 0x96, 0xa1, 0x41, 0x8a, 0x1d, 0x71, 0x0b, 0x4c, 0x68, 0x88,
+	0xa8, 0x8e, 0x61, 0x53, 0x03, 0x5b, 0x79, 0xe1, 0x92, 0xb0, 0x3c, 0xb1, 0x76, 0x59, 0xee, 0xcd,
+	0x91, 0x5c, 0x0b, 0xa1, 0xd5, 0xf1, 0xc7, 0xcf, 0x8a, 0x99, 0x5f, 0x8f, 0xf6, 0x57, 0x04, 0x25,
+	0x1a, 0x42, 0x54, 0x00, 0x50, 0xb1, 0x69, 0x1a, 0x84, 0xb8, 0x01, 0xb3, 0x5e, 0xc0, 0xa5, 0x7e,
+	0x01, 0x6f, 0x70, 0xa4, 0x02, 0x29, 0x22, 0xd1, 0xa0, 0x91, 0x28, 0x62, 0x0b, 0xcc, 0x9a, 0x86,
+	0x55, 0x27, 0xa8, 0xb5, 0x53, 0xd7, 0x50, 0x0b, 0xe9, 0xd0, 0xdb, 0x6d, 0xee, 0x92, 0xb0, 0x3c,
+	0x5e, 0x5d, 0x77, 0x7d, 0xfe, 0x7a, 0x56, 0xbc, 0xa2, 0x1b, 0xb4, 0xd9, 0x6e, 0xc8, 0x2a, 0x36,
+	0x19, 0xd9, 0xec, 0xcf, 0x2a, 0xd1, 0x76, 0xcb, 0xb4, 0x63, 0x23, 0x22, 0xdf, 0xb6, 0xe8, 0xd3,
+	0x47, 0xab, 0x80, 0xed, 0xe6, 0xb6, 0x45, 0x95, 0x19, 0xd3, 0xb0, 0xb6, 0x51, 0x6b, 0xa7, 0xc6,
+	0xc3, 0x8a, 0x37, 0xc1, 0x0c, 0x4b, 0x82, 0x9d, 0x3a, 0xd4, 0x34, 0x07, 0x11, 0x92, 0x1f, 0xf1,
+	0x72, 0xe5, 0x9f, 0x3e, 0x5a, 0x9d, 0x63, 0xde, 0x1b, 0xfe, 0xca, 0x36, 0x75, 0x0c, 0x4b, 0x57,
+	0xce, 0x72, 0x17, 0x66, 0x77, 0xc3, 0xec, 0x05, 0x3c, 0xf3, 0x30, 0xa3, 0xc3, 0xc2, 0x70, 0x97,
+	0x20, 0xcc, 0x2d, 0x30, 0x66, 0xb7, 0x1b, 0xbb, 0xa8, 0x93, 0x1f, 0xf3, 0xb8, 0x9c, 0x93, 0xfd,
+	0x6a, 0x94, 0x83, 0x6a, 0x94, 0x37, 0xac, 0x4e, 0x35, 0xff, 0x47, 0x18, 0x51, 0x75, 0x3a, 0x36,
+	0xc5, 0xf2, 0x56, 0xbb, 0xf1, 0x19, 0xea, 0x28, 0xcc, 0x5b, 0xac, 0x80, 0xd1, 0x3d, 0xd8, 0x6a,
+	0xa3, 0xfc, 0x19, 0x2f, 0xcc, 0x42, 0x20, 0x89, 0x5b, 0x82, 0x11, 0x3d, 0x8c, 0x98, 0xb2, 0xbe,
+	0x4b, 0xe5, 0xee, 0xb7, 0x0f, 0x8b, 0x99, 0x7f, 0x1e, 0x16, 0x33, 0xdf, 0x1c, 0xed, 0xaf, 0x74,
+	0x93, 0xe3, 0x59, 0xbb, 0xce, 0xfa, 0xdd, 0xd1, 0xfe, 0xca, 0x1b, 0x11, 0x05, 0xba, 0xab, 0xaf,
+	0x74, 0x11, 0x48, 0xdd, 0x56, 0x05, 0x11, 0x1b, 0x5b, 0x04, 0x95, 0x7e, 0xcb, 0x81, 0xb3, 0x9b,
+	0x44, 0xbf, 0xa9, 0x19, 0xf4, 0x24, 0x0b, 0xb6, 0xa7, 0x4e, 0xd9, 0x63, 0xeb, 0x04, 0xc1, 0x74,
+	0x58, 0xb1, 0x75, 0x07, 0x52, 0xc4, 0xea, 0xf3, 0x83, 0x94, 0xb5, 0x59, 0x43, 0x6a, 0xa4, 0x36,
+	0x6b, 0x48, 0x55, 0xa6, 0xd4, 0x58, 0x7b, 0x88, 0xcd, 0xde, 0x6d, 0x30, 0x72, 0xac, 0x34, 0x69,
+	0x5a, 0xa0, 0x72, 0x3d, 0x26, 0x78, 0x4f, 0x69, 0x2f, 0xc4, 0xa5, 0x8d, 0xa9, 0x54, 0x92, 0x40,
+	0x3e, 0x69, 0xe3, 0xb2, 0xfe, 0x98, 0x05, 0x13, 0x9b, 0x44, 0x67, 0xd9, 0x50, 0xef, 0x76, 0x13,
+	0x5e, 0x4e, 0xbb, 0x1d, 0x5f, 0xc6, 0x75, 0x30, 0x06, 0x4d, 0xdc, 0xb6, 0x68, 0x3e, 0x77, 0x8c,
+	0x3e, 0x61, 0x3e, 0x95, 0x0f, 0x07, 0x37, 0x8a, 0xcb, 0xdb, 0xb9, 0x38, 0x6f, 0x01, 0x0d, 0xa5,
+	0x79, 0x30, 0x1b, 0xf9, 0x2f, 0x67, 0xeb, 0xdf, 0xac, 0x37, 0xb7, 0xab, 0x48, 0x37, 0x2c, 0x05,
+	0x69, 0x2f, 0x99, 0xb4, 0xcf, 0xc1, 0x7c, 0x48, 0x1a, 0x71, 0xd4, 0xd4, 0xc4, 0xcd, 0x72, 0xb7,
+	0x6d, 0x47, 0xed, 0x19, 0x4d, 0x23, 0x94, 0x47, 0xcb, 0xa5, 0x8e, 0x56, 0x23, 0xb4, 0x5b, 0x89,
+	0x91, 0x17, 0x50, 0xe2, 0xe3, 0xe1, 0x4a, 0x24, 0x86, 0x53, 0x82, 0xe2, 0x92, 0x0d, 0xa4, 0x6e,
+	0x6b, 0xa0, 0x8b, 0xa8, 0x78, 0xed, 0x6e, 0xb7, 0x90, 0xdb, 0x2f, 0x75, 0xf7, 0x41, 0xc0, 0x66,
+	0x91, 0xd4, 0x35, 0x9f, 0xbf, 0x08, 0x5e, 0x0b, 0xd5, 0x49, 0x77, 0x9f, 0x0f, 0x9e, 0x17, 0x05,
+	0x7f, 0xaf, 0x53, 0x61, 0x04, 0x17, 0x53, 0xfa, 0x29, 0x0b, 0x26, 0x37, 0x89, 0x7e, 0xc7, 0xd2,
+	0x4e, 0x63, 0x6f, 0x7c, 0x34, 0x5c, 0x91, 0x7c, 0x5c, 0x91, 0x90, 0x88, 0xd2, 0x2e, 0x98, 0x8f,
+	0x19, 0x4e, 0x54, 0x87, 0xe7, 0x59, 0x70, 0xd1, 0xbd, 0x97, 0xa0, 0xa5, 0xa2, 0xd6, 0x1d, 0xab,
+	0x81, 0x2d, 0xcd, 0xb0, 0xf4, 0x61, 0x2f, 0x84, 0x57, 0x53, 0x16, 0x71, 0x09, 0x4c, 0xab, 0xee,
+	0x05, 0xec, 0xd2, 0xd7, 0x44, 0x86, 0xde, 0xf4, 0xfb, 0x2d, 0xa7, 0x4c, 0x05, 0xe6, 0x4f, 0x3c,
+	0x6b, 0xe5, 0xd3, 0xe1, 0xfa, 0x2d, 0x25, 0xae, 0xfb, 0x7e, 0x04, 0x96, 0xae, 0x80, 0xc5, 0x41,
+	0xeb, 0x7c, 0xfa, 0xfd, 0x2e, 0x80, 0x69, 0x57, 0x77, 0x5b, 0x83, 0x14, 0x6d, 0x41, 0x07, 0x9a,
+	0x44, 0x7c, 0x0f, 0x8c, 0xc3, 0x36, 0x6d, 0x62, 0xc7, 0xa0, 0x9d, 0xa1, 0xa4, 0x87, 0x50, 0x71,
+	0x03, 0x8c, 0xd9, 0x5e, 0x04, 0xf6, 0x28, 0x2d, 0xf4, 0x7b, 0x34, 0xf8, 0x79, 0x62, 0x5c, 0xf9,
+	0x8e, 0x95, 0xf7, 0xdd, 0xa3, 0x87, 0x21, 0xdd, 0x23, 0x2f, 0x46, 0x8e, 0x7c, 0x9f, 0xbf, 0xd7,
+	0x13, 0x7b, 0x2e, 0x2d, 0x80, 0xf3, 0x09, 0x13, 0x3f, 0xe2, 0xcf, 0x59, 0xef, 0x95, 0x13, 0x0c,
+	0xfe, 0x1a, 0xb2, 0xb0, 0x79, 0xaa, 0xfa, 0xfe, 0xfa, 0xf0, 0xba, 0xb9, 0xd0, 0xfb, 0x4e, 0xf4,
+	0xb8, 0x60, 0x6f, 0x89, 0x98, 0x8d, 0x93, 0xf7, 0x8b, 0x7f, 0x3b, 0x86, 0x73, 0xe1, 0xf4, 0xd1,
+	0x77, 0xfc, 0x8b, 0x2c, 0xc1, 0x06, 0xbb, 0xc8, 0x12, 0xd6, 0x93, 0x1c, 0xa0, 0x6b, 0xff, 0x9d,
+	0x01, 0xb9, 0x4d, 0xa2, 0x8b, 0xf7, 0xc0, 0x74, 0xf2, 0x83, 0x73, 0xa5, 0x5f, 0xd7, 0x75, 0x7f,
+	0x08, 0x48, 0x6b, 0xe9, 0xb1, 0xfc, 0x38, 0xbb, 0x60, 0x32, 0xfe, 0xc1, 0xb0, 0x3c, 0x20, 0x48,
+	0x0c, 0x29, 0x5d, 0x4d, 0x8b, 0xe4, 0xc9, 0xbe, 0x02, 0xaf, 0xf1, 0x67, 0xec, 0xe5, 0x01, 0xde,
+	0x01, 0x48, 0x7a, 0x2b, 0x05, 0x88, 0x47, 0xbf, 0x07, 0xa6, 0x93, 0xcf, 0xbe, 0x41, 0xec, 0x25,
+	0xb0, 0xd2, 0x5a, 0x7a, 0x2c, 0x4f, 0xd9, 0x00, 0x20, 0xf2, 0xfa, 0x78, 0x73, 0x40, 0x84, 0x10,
+	0x26, 0xad, 0xa6, 0x82, 0xf1, 0x1c, 0xdf, 0x0b, 0x60, 0xa1, 0xff, 0xd5, 0xfa, 0xee, 0x20, 0xcd,
+	0xfb, 0x79, 0x49, 0xeb, 0x2f, 0xe2, 0xc5, 0x77, 0xd4, 0x04, 0xaf, 0xc7, 0x6e, 0x98, 0xa5, 0x41,
+	0x07, 0x8a, 0x00, 0xa5, 0x72, 0x4a, 0x60, 0xb4, 0x3a, 0xe3, 0x83, 0x7e, 0x39, 0x45, 0x41, 0x78,
+	0x48, 0xe9, 0x6a, 0x5a, 0x64, 0xb4, 0x7e, 0x92, 0x83, 0x71, 0x25, 0x95, 0x54, 0x7e, 0xc2, 0xb5,
+	0xf4, 0xd8, 0x20, 0xa5, 0x34, 0xfa, 0xb5, 0x3b, 0x0f, 0xaa, 0xb7, 0x1e, 0x1f, 0x14, 0x84, 0x27,
+	0x07, 0x05, 0xe1, 0xef, 0x83, 0x82, 0xf0, 0xe0, 0xb0, 0x90, 0x79, 0x72, 0x58, 0xc8, 0xfc, 0x79,
+	0x58, 0xc8, 0x7c, 0xf9, 0xf6, 0xc0, 0x2f, 0xd4, 0xf0, 0x02, 0xf5, 0xbe, 0x55, 0x1b, 0x63, 0xde,
+	0xe8, 0x79, 0xe7, 0xff, 0x01, 0x00, 0xe0, 0xae, 0x71, 0xfb, 0xd5, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -761,6 +934,12 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// DelegateDenom defines a method for delegating an additional bond denom
+	// to a validator.
+	DelegateDenom(ctx context.Context, in *MsgDelegateDenom, opts ...grpc.CallOption) (*MsgDelegateDenomResponse, error)
+	// UndelegateDenom defines a method for undelegating an additional bond denom
+	// from a validator.
+	UndelegateDenom(ctx context.Context, in *MsgUndelegateDenom, opts ...grpc.CallOption) (*MsgUndelegateDenomResponse, error)
 }
 
 type msgClient struct {
@@ -834,6 +1013,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) DelegateDenom(ctx context.Context, in *MsgDelegateDenom, opts ...grpc.CallOption) (*MsgDelegateDenomResponse, error) {
+	out := new(MsgDelegateDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/DelegateDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateDenom(ctx context.Context, in *MsgUndelegateDenom, opts ...grpc.CallOption) (*MsgUndelegateDenomResponse, error) {
+	out := new(MsgUndelegateDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/UndelegateDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -858,6 +1055,12 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// DelegateDenom defines a method for delegating an additional bond denom
+	// to a validator.
+	DelegateDenom(context.Context, *MsgDelegateDenom) (*MsgDelegateDenomResponse, error)
+	// UndelegateDenom defines a method for undelegating an additional bond denom
+	// from a validator.
+	UndelegateDenom(context.Context, *MsgUndelegateDenom) (*MsgUndelegateDenomResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -885,6 +1088,12 @@ func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) DelegateDenom(ctx context.Context, req *MsgDelegateDenom) (*MsgDelegateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateDenom not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateDenom(ctx context.Context, req *MsgUndelegateDenom) (*MsgUndelegateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateDenom not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1016,6 +1225,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/DelegateDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateDenom(ctx, req.(*MsgDelegateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/UndelegateDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateDenom(ctx, req.(*MsgUndelegateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1048,6 +1293,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "DelegateDenom",
+			Handler:    _Msg_DelegateDenom_Handler,
+		},
+		{
+			MethodName: "UndelegateDenom",
+			Handler:    _Msg_UndelegateDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1623,93 +1876,241 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDelegateDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDelegateDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEditValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Description.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CommissionRate != nil {
-		l = m.CommissionRate.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MinSelfDelegation != nil {
-		l = m.MinSelfDelegation.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgDelegateDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *MsgUndelegateDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTx(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Description.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinSelfDelegation != nil {
+		l = m.MinSelfDelegation.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.DelegatorAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1851,6 +2252,64 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3497,6 +3956,433 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -60,6 +60,7 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 		Commission:              NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
 		MinSelfDelegation:       math.OneInt(),
 		UnbondingOnHoldRefCount: 0,
+		WeightedTokens:          math.ZeroInt(),
 	}, nil
 }
 
@@ -369,7 +370,24 @@ func (v Validator) ConsensusPower(r math.Int) int64 {
 
 // PotentialConsensusPower returns the potential consensus-engine power.
 func (v Validator) PotentialConsensusPower(r math.Int) int64 {
-	return sdk.TokensToConsensusPower(v.Tokens, r)
+	return sdk.TokensToConsensusPower(v.PowerTokens(), r)
+}
+
+// GetWeightedTokens returns the risk-weighted tokens of the additional bond
+// denoms staked to the validator. Validators stored before weighted bond
+// denoms existed carry no value and are treated as zero.
+func (v Validator) GetWeightedTokens() math.Int {
+	if v.WeightedTokens.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return v.WeightedTokens
+}
+
+// PowerTokens returns the tokens backing the validator's voting power, i.e.
+// its bond denom tokens plus its risk-weighted additional bond denom tokens.
+func (v Validator) PowerTokens() math.Int {
+	return v.Tokens.Add(v.GetWeightedTokens())
 }
 
 // UpdateStatus updates the location of the shares within a validator
@@ -14,8 +14,10 @@ import (
 
 // BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
-// If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
-// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// If it is ready, it will delay it if the validators ready for it do not hold enough of the bonded tokens,
+// or execute it if the handler is installed, and panic/abort otherwise.
+// If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise),
+// and run the pre-upgrade checks if the upgrade height is close enough.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -64,6 +66,11 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			return
 		}
 
+		// Delay the upgrade if not enough validators signalled they are ready for it
+		if k.DelayUpgradeIfNotReady(ctx, plan) {
+			return
+		}
+
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
@@ -92,6 +99,9 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 		ctx.Logger().Error(downgradeMsg)
 		panic(downgradeMsg)
 	}
+
+	// warn ahead of the upgrade height about conditions that would make it fail
+	k.RunPreUpgradeChecks(ctx, plan)
 }
 
 // BuildUpgradeNeededMsg prints the message that notifies that an upgrade is needed.
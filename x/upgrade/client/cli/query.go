@@ -22,6 +22,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetUpgradeReadinessCmd(),
+		GetUpgradeReadinessTallyCmd(),
+		GetParamsCmd(),
 	)
 
 	return cmd
@@ -154,3 +157,98 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetUpgradeReadinessCmd returns the readiness signals sent by validators for an
+// upgrade plan.
+func GetUpgradeReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness [upgrade-name]",
+		Short: "get the readiness signals of validators for an upgrade plan",
+		Long:  "Gets the binary versions validators signalled they are ready with for an upgrade plan.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := types.QueryUpgradeReadinessRequest{Name: args[0], Pagination: pageReq}
+			res, err := queryClient.UpgradeReadiness(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "readiness")
+
+	return cmd
+}
+
+// GetUpgradeReadinessTallyCmd returns the bonded tokens of the validators ready
+// for an upgrade plan against all the bonded tokens.
+func GetUpgradeReadinessTallyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness-tally [upgrade-name]",
+		Short: "get the share of the bonded tokens ready for an upgrade plan",
+		Long: "Gets the bonded tokens of the bonded validators which signalled their readiness for an upgrade plan,\n" +
+			"against all the bonded tokens, and the number of times the plan was delayed for lack of readiness.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryUpgradeReadinessTallyRequest{Name: args[0]}
+			res, err := queryClient.UpgradeReadinessTally(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetParamsCmd returns the upgrade module parameters.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "get the upgrade module parameters",
+		Long:  "Gets the parameters of the upgrade module, which control the delays of plans for lack of readiness.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,14 +1,17 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
@@ -31,6 +34,41 @@ func GetTxCmd() *cobra.Command {
 		Short: "Upgrade transaction subcommands",
 	}
 
+	cmd.AddCommand(
+		NewCmdSignalUpgradeReady(),
+	)
+
+	return cmd
+}
+
+// NewCmdSignalUpgradeReady implements a command handler for a validator operator
+// signalling readiness for the scheduled upgrade plan.
+func NewCmdSignalUpgradeReady() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-ready [plan-name] [version]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Signal that a validator is ready for the scheduled upgrade plan",
+		Long: `Signal that the validator operated by the sender has installed the given binary
+version and is ready for the scheduled upgrade plan. A new signal replaces the previous one.`,
+		Example: fmt.Sprintf("%s tx upgrade signal-ready v2 v2.0.0 --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSignalUpgradeReady{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				PlanName:         args[0],
+				Version:          args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -76,3 +78,65 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(c context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "empty request")
+	}
+
+	if len(req.Name) == 0 {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReadinessSignalsPrefix(req.Name))
+
+	var signals []*types.ReadinessSignal
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var signal types.ReadinessSignal
+		if err := k.cdc.Unmarshal(value, &signal); err != nil {
+			return err
+		}
+		signals = append(signals, &signal)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUpgradeReadinessResponse{Signals: signals, Pagination: pageRes}, nil
+}
+
+// UpgradeReadinessTally implements the Query/UpgradeReadinessTally gRPC method
+func (k Keeper) UpgradeReadinessTally(c context.Context, req *types.QueryUpgradeReadinessTallyRequest) (*types.QueryUpgradeReadinessTallyResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "empty request")
+	}
+
+	if len(req.Name) == 0 {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+
+	if k.stakingKeeper == nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "readiness signalling is not enabled")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	readyTokens, bondedTokens, readyValidators := k.GetReadinessTally(ctx, req.Name)
+	return &types.QueryUpgradeReadinessTallyResponse{
+		ReadyTokens:     readyTokens,
+		BondedTokens:    bondedTokens,
+		ReadyRatio:      readinessRatio(readyTokens, bondedTokens),
+		ReadyValidators: readyValidators,
+		ReadinessDelays: k.GetReadinessDelays(ctx, req.Name),
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
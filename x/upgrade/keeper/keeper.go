@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -27,15 +30,18 @@ import (
 const UpgradeInfoFileName string = "upgrade-info.json"
 
 type Keeper struct {
-	homePath           string                          // root directory of app config
-	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
-	storeKey           storetypes.StoreKey             // key to access x/upgrade store
-	cdc                codec.BinaryCodec               // App-wide binary codec
-	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
-	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
-	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
-	authority          string                          // the address capable of executing and cancelling an upgrade. Usually the gov module account
-	initVersionMap     module.VersionMap               // the module version map at init genesis
+	homePath           string                           // root directory of app config
+	skipUpgradeHeights map[int64]bool                   // map of heights to skip for an upgrade
+	storeKey           storetypes.StoreKey              // key to access x/upgrade store
+	cdc                codec.BinaryCodec                // App-wide binary codec
+	upgradeHandlers    map[string]types.UpgradeHandler  // map of plan name to upgrade handler
+	versionSetter      xp.ProtocolVersionSetter         // implements setting the protocol version field on BaseApp
+	downgradeVerified  bool                             // tells if we've already sanity checked that this binary version isn't being used against an old state.
+	authority          string                           // the address capable of executing and cancelling an upgrade. Usually the gov module account
+	initVersionMap     module.VersionMap                // the module version map at init genesis
+	preUpgradeChecks   map[string]types.PreUpgradeCheck // map of check name to pre-upgrade check
+	preUpgradeWindow   int64                            // number of blocks before the upgrade height in which pre-upgrade checks run
+	stakingKeeper      types.StakingKeeper              // used to verify the validators signalling readiness, if set
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -53,6 +59,8 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
 		authority:          authority,
+		preUpgradeChecks:   map[string]types.PreUpgradeCheck{},
+		preUpgradeWindow:   types.DefaultPreUpgradeCheckWindow,
 	}
 }
 
@@ -66,6 +74,19 @@ func (k *Keeper) GetVersionSetter() xp.ProtocolVersionSetter {
 	return k.versionSetter
 }
 
+// SetStakingKeeper sets the staking keeper used to verify the validators
+// signalling their readiness for an upgrade. Readiness signals are rejected
+// until it is set.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
+// SetPreUpgradeCheckWindow sets the number of blocks before the height of a
+// scheduled upgrade plan in which the pre-upgrade checks run.
+func (k *Keeper) SetPreUpgradeCheckWindow(blocks int64) {
+	k.preUpgradeWindow = blocks
+}
+
 // SetInitVersionMap sets the initial version map.
 // This is only used in app wiring and should not be used in any other context.
 func (k *Keeper) SetInitVersionMap(vm module.VersionMap) {
@@ -85,6 +106,65 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetPreUpgradeCheck sets a PreUpgradeCheck under the given name. The check is
+// run in each of the blocks of the pre-upgrade window of every scheduled
+// upgrade plan.
+func (k Keeper) SetPreUpgradeCheck(name string, check types.PreUpgradeCheck) {
+	k.preUpgradeChecks[name] = check
+}
+
+// RunPreUpgradeChecks runs the registered pre-upgrade checks against the given
+// plan if the current block is in its pre-upgrade window, in the order of
+// their names. Each check runs in a cached context whose writes are
+// discarded, and each failure, including a panic of the check, is logged and
+// emitted as an event. It returns the number of checks that failed.
+func (k Keeper) RunPreUpgradeChecks(ctx sdk.Context, plan types.Plan) int {
+	if len(k.preUpgradeChecks) == 0 || ctx.BlockHeight() >= plan.Height || ctx.BlockHeight() < plan.Height-k.preUpgradeWindow {
+		return 0
+	}
+
+	names := make([]string, 0, len(k.preUpgradeChecks))
+	for name := range k.preUpgradeChecks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	failed := 0
+	for _, name := range names {
+		err := runPreUpgradeCheck(ctx, k.preUpgradeChecks[name], plan)
+		if err == nil {
+			continue
+		}
+
+		failed++
+		k.Logger(ctx).Error("pre-upgrade check failed", "plan", plan.Name, "check", name, "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePreUpgradeCheck,
+				sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+				sdk.NewAttribute(types.AttributeKeyCheck, name),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+
+	return failed
+}
+
+// runPreUpgradeCheck runs a pre-upgrade check against a cached context whose
+// writes are discarded, returning a panic of the check as an error so that it
+// cannot halt the chain.
+func runPreUpgradeCheck(ctx sdk.Context, check types.PreUpgradeCheck, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	return check(cacheCtx, plan)
+}
+
 // setProtocolVersion sets the protocol version to state
 func (k Keeper) setProtocolVersion(ctx sdk.Context, v uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -207,10 +287,14 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 
 	store := ctx.KVStore(k.storeKey)
 
-	// clear any old IBC state stored by previous plan
+	// clear any old IBC state and readiness signals stored by previous plan
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		if oldPlan.Name != plan.Name {
+			k.clearReadinessSignals(ctx, oldPlan.Name)
+			store.Delete(types.ReadinessDelaysKey(oldPlan.Name))
+		}
 	}
 
 	bz := k.cdc.MustMarshal(&plan)
@@ -307,18 +391,200 @@ func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears any schedule upgrade and associated IBC states,
+// readiness signals and delays.
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
-	// clear IBC states everytime upgrade plan is removed
+	store := ctx.KVStore(k.storeKey)
+
+	// clear IBC states, readiness signals and delays everytime upgrade plan is removed
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		k.clearReadinessSignals(ctx, oldPlan.Name)
+		store.Delete(types.ReadinessDelaysKey(oldPlan.Name))
 	}
 
-	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
 }
 
+// SetReadinessSignal saves the readiness signal of a validator for the upgrade
+// plan with the given name, overwriting any previous one.
+func (k Keeper) SetReadinessSignal(ctx sdk.Context, name string, signal types.ReadinessSignal) {
+	valAddr, err := sdk.ValAddressFromBech32(signal.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReadinessSignalKey(name, valAddr), k.cdc.MustMarshal(&signal))
+}
+
+// GetReadinessSignal returns the readiness signal of a validator for the
+// upgrade plan with the given name, if any.
+func (k Keeper) GetReadinessSignal(ctx sdk.Context, name string, valAddr sdk.ValAddress) (signal types.ReadinessSignal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReadinessSignalKey(name, valAddr))
+	if bz == nil {
+		return signal, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &signal)
+	return signal, true
+}
+
+// IterateReadinessSignals iterates over the readiness signals of validators for
+// the upgrade plan with the given name, calling cb on each until it returns
+// true.
+func (k Keeper) IterateReadinessSignals(ctx sdk.Context, name string, cb func(signal types.ReadinessSignal) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReadinessSignalsPrefix(name))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var signal types.ReadinessSignal
+		k.cdc.MustUnmarshal(iter.Value(), &signal)
+		if cb(signal) {
+			break
+		}
+	}
+}
+
+// GetReadinessTally returns the sum of the bonded tokens of the bonded
+// validators which signalled their readiness for the upgrade plan with the
+// given name, the total of the bonded tokens and the number of ready bonded
+// validators. It requires the staking keeper to be set.
+func (k Keeper) GetReadinessTally(ctx sdk.Context, name string) (readyTokens, bondedTokens math.Int, readyValidators uint64) {
+	readyTokens = math.ZeroInt()
+	k.IterateReadinessSignals(ctx, name, func(signal types.ReadinessSignal) bool {
+		valAddr, err := sdk.ValAddressFromBech32(signal.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if found && validator.IsBonded() {
+			readyTokens = readyTokens.Add(validator.GetBondedTokens())
+			readyValidators++
+		}
+		return false
+	})
+
+	return readyTokens, k.stakingKeeper.TotalBondedTokens(ctx), readyValidators
+}
+
+// GetReadinessDelays returns the number of times the height of the upgrade plan
+// with the given name was delayed because the readiness threshold was not
+// reached.
+func (k Keeper) GetReadinessDelays(ctx sdk.Context, name string) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReadinessDelaysKey(name))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(bz)
+}
+
+// setReadinessDelays sets the number of times the height of the upgrade plan
+// with the given name was delayed
+func (k Keeper) setReadinessDelays(ctx sdk.Context, name string, delays uint32) {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, delays)
+	ctx.KVStore(k.storeKey).Set(types.ReadinessDelaysKey(name), bz)
+}
+
+// DelayUpgradeIfNotReady delays the height of the given plan, which is due, by
+// the readiness delay if the bonded validators which signalled their readiness
+// for it do not hold the readiness threshold of the bonded tokens, unless the
+// plan was already delayed the maximum number of times. It returns true if the
+// plan was delayed.
+func (k Keeper) DelayUpgradeIfNotReady(ctx sdk.Context, plan types.Plan) bool {
+	params := k.GetParams(ctx)
+	if !params.DelaysEnabled() || k.stakingKeeper == nil {
+		return false
+	}
+
+	delays := k.GetReadinessDelays(ctx, plan.Name)
+	if delays >= params.MaxReadinessDelays {
+		return false
+	}
+
+	readyTokens, bondedTokens, _ := k.GetReadinessTally(ctx, plan.Name)
+	readiness := readinessRatio(readyTokens, bondedTokens)
+	if readiness.GTE(params.ReadinessThreshold) {
+		return false
+	}
+
+	// the IBC state stored for the previous height is no longer valid
+	k.ClearIBCState(ctx, plan.Height)
+
+	plan.Height = ctx.BlockHeight() + params.ReadinessDelay
+	ctx.KVStore(k.storeKey).Set(types.PlanKey(), k.cdc.MustMarshal(&plan))
+	k.setReadinessDelays(ctx, plan.Name, delays+1)
+
+	k.Logger(ctx).Info("upgrade delayed for lack of readiness", "plan", plan.Name, "height", plan.Height, "readiness", readiness)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgradeDelayed,
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+			sdk.NewAttribute(types.AttributeKeyReadiness, readiness.String()),
+		),
+	)
+
+	return true
+}
+
+// readinessRatio returns the share of the bonded tokens which are ready
+func readinessRatio(readyTokens, bondedTokens math.Int) sdk.Dec {
+	if !bondedTokens.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(readyTokens).QuoInt(bondedTokens)
+}
+
+// GetParams returns the module parameters, or the default ones if they were
+// never set.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey())
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey(), k.cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// clearReadinessSignals deletes the readiness signals of validators for the
+// upgrade plan with the given name
+func (k Keeper) clearReadinessSignals(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReadinessSignalsPrefix(name))
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -1,14 +1,18 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -18,8 +22,10 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetestutil "github.com/cosmos/cosmos-sdk/x/upgrade/testutil"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -29,6 +35,7 @@ type KeeperTestSuite struct {
 	key           *storetypes.KVStoreKey
 	baseApp       *baseapp.BaseApp
 	upgradeKeeper *keeper.Keeper
+	stakingKeeper *upgradetestutil.MockStakingKeeper
 	homeDir       string
 	ctx           sdk.Context
 	msgSrvr       types.MsgServer
@@ -55,6 +62,10 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.upgradeKeeper = keeper.NewKeeper(skipUpgradeHeights, key, s.encCfg.Codec, homeDir, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	s.upgradeKeeper.SetVersionSetter(s.baseApp)
 
+	ctrl := gomock.NewController(s.T())
+	s.stakingKeeper = upgradetestutil.NewMockStakingKeeper(ctrl)
+	s.upgradeKeeper.SetStakingKeeper(s.stakingKeeper)
+
 	vs := s.upgradeKeeper.GetVersionSetter()
 	s.Require().Equal(vs, s.baseApp)
 
@@ -222,6 +233,108 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRunPreUpgradeChecks() {
+	plan := types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 5}
+	s.upgradeKeeper.SetPreUpgradeCheckWindow(5)
+
+	var ran []string
+	s.upgradeKeeper.SetPreUpgradeCheck("b-failing", func(ctx sdk.Context, p types.Plan) error {
+		ran = append(ran, "b-failing")
+		return fmt.Errorf("not ready for %s", p.Name)
+	})
+	s.upgradeKeeper.SetPreUpgradeCheck("c-panicking", func(ctx sdk.Context, p types.Plan) error {
+		ran = append(ran, "c-panicking")
+		ctx.KVStore(s.key).Set([]byte("written"), []byte{1})
+		panic("unexpected state")
+	})
+	s.upgradeKeeper.SetPreUpgradeCheck("a-passing", func(ctx sdk.Context, p types.Plan) error {
+		ran = append(ran, "a-passing")
+		ctx.KVStore(s.key).Set([]byte("written"), []byte{1})
+		return nil
+	})
+
+	// checks do not run before the pre-upgrade window
+	ctx := s.ctx.WithBlockHeight(plan.Height - 6).WithEventManager(sdk.NewEventManager())
+	s.Require().Zero(s.upgradeKeeper.RunPreUpgradeChecks(ctx, plan))
+	s.Require().Empty(ran)
+
+	// checks run in name order, failures and panics are emitted and writes
+	// discarded
+	ctx = s.ctx.WithBlockHeight(plan.Height - 5).WithEventManager(sdk.NewEventManager())
+	s.Require().Equal(2, s.upgradeKeeper.RunPreUpgradeChecks(ctx, plan))
+	s.Require().Equal([]string{"a-passing", "b-failing", "c-panicking"}, ran)
+	s.Require().False(ctx.KVStore(s.key).Has([]byte("written")))
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 2)
+	s.Require().Equal(types.EventTypePreUpgradeCheck, events[0].Type)
+	s.Require().Equal(types.AttributeKeyCheck, events[0].Attributes[1].Key)
+	s.Require().Equal("b-failing", events[0].Attributes[1].Value)
+	s.Require().Equal("not ready for test", events[0].Attributes[2].Value)
+	s.Require().Equal("c-panicking", events[1].Attributes[1].Value)
+	s.Require().Equal("panic: unexpected state", events[1].Attributes[2].Value)
+
+	// checks do not run at the upgrade height
+	ran = nil
+	ctx = s.ctx.WithBlockHeight(plan.Height)
+	s.Require().Zero(s.upgradeKeeper.RunPreUpgradeChecks(ctx, plan))
+	s.Require().Empty(ran)
+}
+
+func (s *KeeperTestSuite) TestDelayUpgradeIfNotReady() {
+	plan := types.Plan{Name: "test", Height: s.ctx.BlockHeight()}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	readyValAddr, unreadyValAddr := sdk.ValAddress("ready"), sdk.ValAddress("unready")
+	readyVal := stakingtypes.Validator{OperatorAddress: readyValAddr.String(), Status: stakingtypes.Bonded, Tokens: math.NewInt(60)}
+	s.stakingKeeper.EXPECT().GetValidator(s.ctx, readyValAddr).Return(readyVal, true).AnyTimes()
+	s.stakingKeeper.EXPECT().TotalBondedTokens(s.ctx).Return(math.NewInt(100)).AnyTimes()
+	s.upgradeKeeper.SetReadinessSignal(s.ctx, plan.Name, types.ReadinessSignal{ValidatorAddress: readyValAddr.String(), Version: "v2"})
+
+	readyTokens, bondedTokens, readyValidators := s.upgradeKeeper.GetReadinessTally(s.ctx, plan.Name)
+	s.Require().Equal(math.NewInt(60), readyTokens)
+	s.Require().Equal(math.NewInt(100), bondedTokens)
+	s.Require().Equal(uint64(1), readyValidators)
+
+	// plans are not delayed with the default params
+	s.Require().False(s.upgradeKeeper.DelayUpgradeIfNotReady(s.ctx, plan))
+
+	// plans are not delayed when the readiness threshold is reached
+	s.Require().NoError(s.upgradeKeeper.SetParams(s.ctx, types.NewParams(sdk.NewDecWithPrec(6, 1), 10, 1)))
+	s.Require().False(s.upgradeKeeper.DelayUpgradeIfNotReady(s.ctx, plan))
+
+	// plans are delayed when the readiness threshold is not reached
+	s.Require().NoError(s.upgradeKeeper.SetParams(s.ctx, types.NewParams(sdk.NewDecWithPrec(67, 2), 10, 1)))
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().True(s.upgradeKeeper.DelayUpgradeIfNotReady(ctx, plan))
+	delayedPlan, found := s.upgradeKeeper.GetUpgradePlan(s.ctx)
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockHeight()+10, delayedPlan.Height)
+	s.Require().Equal(uint32(1), s.upgradeKeeper.GetReadinessDelays(s.ctx, plan.Name))
+	s.Require().Equal(types.EventTypeUpgradeDelayed, ctx.EventManager().Events()[0].Type)
+
+	res, err := s.upgradeKeeper.UpgradeReadinessTally(s.ctx, &types.QueryUpgradeReadinessTallyRequest{Name: plan.Name})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(6, 1), res.ReadyRatio)
+	s.Require().Equal(uint32(1), res.ReadinessDelays)
+
+	// plans are not delayed more than the maximum number of times
+	ctx = s.ctx.WithBlockHeight(delayedPlan.Height)
+	s.Require().False(s.upgradeKeeper.DelayUpgradeIfNotReady(ctx, delayedPlan))
+
+	// unbonded validators are not ready
+	unreadyVal := stakingtypes.Validator{OperatorAddress: unreadyValAddr.String(), Status: stakingtypes.Unbonded, Tokens: math.NewInt(40)}
+	s.stakingKeeper.EXPECT().GetValidator(s.ctx, unreadyValAddr).Return(unreadyVal, true).AnyTimes()
+	s.upgradeKeeper.SetReadinessSignal(s.ctx, plan.Name, types.ReadinessSignal{ValidatorAddress: unreadyValAddr.String(), Version: "v2"})
+	readyTokens, _, readyValidators = s.upgradeKeeper.GetReadinessTally(s.ctx, plan.Name)
+	s.Require().Equal(math.NewInt(60), readyTokens)
+	s.Require().Equal(uint64(1), readyValidators)
+
+	// the delays are cleared along with the plan
+	s.upgradeKeeper.ClearUpgradePlan(s.ctx)
+	s.Require().Zero(s.upgradeKeeper.GetReadinessDelays(s.ctx, plan.Name))
+}
+
 func (s *KeeperTestSuite) TestIsSkipHeight() {
 	var skipOne int64 = 9
 	ok := s.upgradeKeeper.IsSkipHeight(11)
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -49,3 +50,57 @@ func (k msgServer) CancelUpgrade(goCtx context.Context, req *types.MsgCancelUpgr
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SignalUpgradeReady implements the Msg/SignalUpgradeReady Msg service.
+func (k msgServer) SignalUpgradeReady(goCtx context.Context, req *types.MsgSignalUpgradeReady) (*types.MsgSignalUpgradeReadyResponse, error) {
+	if k.stakingKeeper == nil {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "readiness signalling is not enabled")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found || plan.Name != req.PlanName {
+		return nil, errors.Wrapf(errors.ErrInvalidRequest, "upgrade plan %s is not scheduled", req.PlanName)
+	}
+
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	k.SetReadinessSignal(ctx, plan.Name, types.ReadinessSignal{
+		ValidatorAddress: req.ValidatorAddress,
+		Version:          req.Version,
+		Height:           ctx.BlockHeight(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSignalUpgradeReady,
+			sdk.NewAttribute(types.AttributeKeyPlanName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyVersion, req.Version),
+		),
+	)
+
+	return &types.MsgSignalUpgradeReadyResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(gov.ErrInvalidSigner, "expected %s got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -115,3 +119,131 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignalUpgradeReady() {
+	valAddr := sdk.ValAddress(s.addrs[0])
+	unknownValAddr := sdk.ValAddress("unknown")
+	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:   "some name",
+		Info:   "some info",
+		Height: 123450000,
+	})
+	s.Require().NoError(err)
+
+	s.stakingKeeper.EXPECT().GetValidator(s.ctx, valAddr).Return(stakingtypes.Validator{}, true).AnyTimes()
+	s.stakingKeeper.EXPECT().GetValidator(s.ctx, unknownValAddr).Return(stakingtypes.Validator{}, false).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSignalUpgradeReady
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"plan not scheduled",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: valAddr.String(),
+				PlanName:         "other name",
+				Version:          "v2.0.0",
+			},
+			true,
+			"upgrade plan other name is not scheduled",
+		},
+		{
+			"validator not found",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: unknownValAddr.String(),
+				PlanName:         "some name",
+				Version:          "v2.0.0",
+			},
+			true,
+			stakingtypes.ErrNoValidatorFound.Error(),
+		},
+		{
+			"readiness signalled successfully",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: valAddr.String(),
+				PlanName:         "some name",
+				Version:          "v2.0.0",
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SignalUpgradeReady(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				signal, found := s.upgradeKeeper.GetReadinessSignal(s.ctx, tc.req.PlanName, valAddr)
+				s.Require().True(found)
+				s.Require().Equal(tc.req.Version, signal.Version)
+				s.Require().Equal(s.ctx.BlockHeight(), signal.Height)
+			}
+		})
+	}
+
+	res, err := s.upgradeKeeper.UpgradeReadiness(s.ctx, &types.QueryUpgradeReadinessRequest{Name: "some name"})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.ReadinessSignal{{ValidatorAddress: valAddr.String(), Version: "v2.0.0", Height: s.ctx.BlockHeight()}}, res.Signals)
+
+	// the signals are cleared along with the plan
+	s.upgradeKeeper.ClearUpgradePlan(s.ctx)
+	_, found := s.upgradeKeeper.GetReadinessSignal(s.ctx, "some name", valAddr)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govAccAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.NewParams(sdk.NewDecWithPrec(67, 2), 100, 3)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unauthorized authority address",
+			&types.MsgUpdateParams{
+				Authority: s.addrs[0].String(),
+				Params:    params,
+			},
+			true,
+			"expected gov account as only signer for proposal message",
+		},
+		{
+			"invalid params",
+			&types.MsgUpdateParams{
+				Authority: govAccAddr,
+				Params:    types.NewParams(sdk.NewDecWithPrec(67, 2), -1, 3),
+			},
+			true,
+			"readiness delay cannot be negative",
+		},
+		{
+			"params updated successfully",
+			&types.MsgUpdateParams{
+				Authority: govAccAddr,
+				Params:    params,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.UpdateParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(params, s.upgradeKeeper.GetParams(s.ctx))
+			}
+		})
+	}
+}
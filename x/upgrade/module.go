@@ -179,7 +179,8 @@ type UpgradeInputs struct {
 	Key    *store.KVStoreKey
 	Cdc    codec.Codec
 
-	AppOpts servertypes.AppOptions `optional:"true"`
+	AppOpts       servertypes.AppOptions `optional:"true"`
+	StakingKeeper types.StakingKeeper    `optional:"true"`
 }
 
 type UpgradeOutputs struct {
@@ -213,6 +214,9 @@ func ProvideModule(in UpgradeInputs) UpgradeOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(skipUpgradeHeights, in.Key, in.Cdc, homePath, nil, authority.String())
+	if in.StakingKeeper != nil {
+		k.SetStakingKeeper(in.StakingKeeper)
+	}
 	baseappOpt := func(app *baseapp.BaseApp) {
 		k.SetVersionSetter(app)
 	}
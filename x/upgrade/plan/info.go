@@ -47,6 +47,30 @@ func ParseInfo(infoStr string) (*Info, error) {
 	return &planInfo, nil
 }
 
+// ValidateInfoBasic does stateless validation of an info string, without
+// downloading anything. It validates that:
+//   - A JSON object info parses as Info and its Binaries pass ValidateBasic.
+//   - A URL info, pointing to the actual info, contains a checksum query parameter.
+//
+// Any other info, including a blank one, is free-form text and is accepted.
+func ValidateInfoBasic(infoStr string) error {
+	infoStr = strings.TrimSpace(infoStr)
+
+	if strings.HasPrefix(infoStr, "{") {
+		var planInfo Info
+		if err := json.Unmarshal(conv.UnsafeStrToBytes(infoStr), &planInfo); err != nil {
+			return fmt.Errorf("could not parse plan info: %v", err)
+		}
+		return planInfo.Binaries.ValidateBasic()
+	}
+
+	if url, err := neturl.ParseRequestURI(infoStr); err == nil && len(url.Scheme) > 0 && len(url.Host) > 0 {
+		return ValidateIsURLWithChecksum(infoStr)
+	}
+
+	return nil
+}
+
 // ValidateFull does all possible validation of this Info.
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
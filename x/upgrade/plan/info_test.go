@@ -186,6 +186,70 @@ func (s InfoTestSuite) TestInfoValidateFull() {
 	}
 }
 
+func (s InfoTestSuite) TestValidateInfoBasic() {
+	checksum := "?checksum=sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
+	tests := []struct {
+		name    string
+		infoStr string
+		errs    []string
+	}{
+		{
+			name:    "blank string",
+			infoStr: "  ",
+			errs:    nil,
+		},
+		{
+			name:    "free-form text",
+			infoStr: "some text here",
+			errs:    nil,
+		},
+		{
+			name:    "json good",
+			infoStr: `{"binaries":{"linux/amd64":"https://v1.cosmos.network/sdk` + checksum + `"}}`,
+			errs:    nil,
+		},
+		{
+			name:    "json invalid",
+			infoStr: `{"binaries":["foo","bar"]}`,
+			errs:    []string{"could not parse plan info", "cannot unmarshal"},
+		},
+		{
+			name:    "json without binaries",
+			infoStr: `{"foo":"bar"}`,
+			errs:    []string{"no \"binaries\" entries found"},
+		},
+		{
+			name:    "json binary url without checksum",
+			infoStr: `{"binaries":{"linux/amd64":"https://v1.cosmos.network/sdk"}}`,
+			errs:    []string{"invalid url", "linux/amd64", "missing checksum query parameter"},
+		},
+		{
+			name:    "url good",
+			infoStr: "https://v1.cosmos.network/sdk/info.json" + checksum,
+			errs:    nil,
+		},
+		{
+			name:    "url without checksum",
+			infoStr: "https://v1.cosmos.network/sdk/info.json",
+			errs:    []string{"missing checksum query parameter"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			actualErr := ValidateInfoBasic(tc.infoStr)
+			if len(tc.errs) > 0 {
+				require.Error(t, actualErr)
+				for _, expectedErr := range tc.errs {
+					assert.Contains(t, actualErr.Error(), expectedErr)
+				}
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
+
 func (s InfoTestSuite) TestBinaryDownloadURLMapValidateBasic() {
 	addDummyChecksum := func(url string) string {
 		return url + "?checksum=sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
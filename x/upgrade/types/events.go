@@ -0,0 +1,16 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypePreUpgradeCheck    = "pre_upgrade_check"
+	EventTypeSignalUpgradeReady = "signal_upgrade_ready"
+	EventTypeUpgradeDelayed     = "upgrade_delayed"
+
+	AttributeKeyPlanName  = "plan_name"
+	AttributeKeyCheck     = "check"
+	AttributeKeyError     = "error"
+	AttributeKeyValidator = "validator"
+	AttributeKeyVersion   = "version"
+	AttributeKeyHeight    = "height"
+	AttributeKeyReadiness = "readiness"
+)
@@ -24,3 +24,13 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// PreUpgradeCheck specifies the type of function that is called in each of the
+// blocks preceding the height of a scheduled upgrade plan, so that modules can
+// detect conditions that would make the upgrade fail before it is due.
+//
+// A PreUpgradeCheck runs against a cached context whose writes are discarded.
+// The errors it returns are logged and emitted as events, and never halt the
+// chain. As checks run in every block of the pre-upgrade window, they should
+// be cheap.
+type PreUpgradeCheck func(ctx sdk.Context, plan Plan) error
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -11,6 +16,10 @@ const (
 
 	// StoreKey is the prefix under which we store this module's data
 	StoreKey = ModuleName
+
+	// DefaultPreUpgradeCheckWindow is the default number of blocks before the
+	// height of an upgrade plan in which the pre-upgrade checks run
+	DefaultPreUpgradeCheckWindow int64 = 100
 )
 
 const (
@@ -26,6 +35,15 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// ReadinessSignalByte is a prefix to look up the readiness signals of validators for an upgrade plan
+	ReadinessSignalByte = 0x4
+
+	// ParamsByte specifies the Byte under which the module parameters are stored in the store
+	ParamsByte = 0x5
+
+	// ReadinessDelaysByte is a prefix to look up the number of times an upgrade plan was delayed
+	ReadinessDelaysByte = 0x6
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +60,17 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// ParamsKey is the key under which the module parameters are saved
+func ParamsKey() []byte {
+	return []byte{ParamsByte}
+}
+
+// ReadinessDelaysKey is the key under which the number of times the upgrade
+// plan with the given name was delayed for lack of readiness is saved
+func ReadinessDelaysKey(name string) []byte {
+	return append([]byte{ReadinessDelaysByte}, name...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -55,3 +84,19 @@ func UpgradedClientKey(height int64) []byte {
 func UpgradedConsStateKey(height int64) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedConsState))
 }
+
+// ReadinessSignalsPrefix is the prefix of the keys under which the readiness
+// signals of validators for the upgrade plan with the given name are saved
+func ReadinessSignalsPrefix(name string) []byte {
+	key := make([]byte, 5+len(name)) // 5 = ReadinessSignalByte + uint32 len
+	key[0] = ReadinessSignalByte
+	binary.BigEndian.PutUint32(key[1:5], uint32(len(name)))
+	copy(key[5:], name)
+	return key
+}
+
+// ReadinessSignalKey is the key under which the readiness signal of a validator
+// for the upgrade plan with the given name is saved
+func ReadinessSignalKey(name string, valAddr sdk.ValAddress) []byte {
+	return append(ReadinessSignalsPrefix(name), valAddr...)
+}
@@ -1,14 +1,17 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
 )
 
 var (
-	_, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
-	_, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+	_, _, _, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalUpgradeReady{}, &MsgUpdateParams{}
+	_, _, _, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalUpgradeReady{}, &MsgUpdateParams{}
 )
 
 // Route implements the LegacyMsg interface.
@@ -32,6 +35,10 @@ func (m *MsgSoftwareUpgrade) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "plan")
 	}
 
+	if err := plan.ValidateInfoBasic(m.Plan.Info); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("plan info: %s", err)
+	}
+
 	return nil
 }
 
@@ -66,3 +73,67 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// Route implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSignalUpgradeReady) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSignalUpgradeReady) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "validator address")
+	}
+
+	if len(strings.TrimSpace(m.PlanName)) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("plan name cannot be empty")
+	}
+
+	if len(strings.TrimSpace(m.Version)) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("version cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSignalUpgradeReady.
+func (m *MsgSignalUpgradeReady) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	if err := m.Params.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("params: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -41,6 +41,32 @@ func TestMsgSoftwareUpgrade(t *testing.T) {
 			true,
 			"plan",
 		},
+		{
+			"invalid plan info",
+			&types.MsgSoftwareUpgrade{
+				Authority: authority.String(),
+				Plan: types.Plan{
+					Name:   "all-good",
+					Info:   `{"binaries":{"linux/amd64":"https://v1.cosmos.network/sdk"}}`,
+					Height: 123450000,
+				},
+			},
+			true,
+			"plan info: invalid url",
+		},
+		{
+			"all good with plan info",
+			&types.MsgSoftwareUpgrade{
+				Authority: authority.String(),
+				Plan: types.Plan{
+					Name:   "all-good",
+					Info:   `{"binaries":{"linux/amd64":"https://v1.cosmos.network/sdk?checksum=sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"}}`,
+					Height: 123450000,
+				},
+			},
+			false,
+			"",
+		},
 		{
 			"all good",
 			&types.MsgSoftwareUpgrade{
@@ -107,3 +133,135 @@ func TestMsgCancelUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSignalUpgradeReady(t *testing.T) {
+	validator := sdk.ValAddress("validator")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSignalUpgradeReady
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: "validator",
+				PlanName:         "all-good",
+				Version:          "v2.0.0",
+			},
+			true,
+			"validator address: decoding bech32 failed",
+		},
+		{
+			"empty plan name",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: validator.String(),
+				Version:          "v2.0.0",
+			},
+			true,
+			"plan name cannot be empty",
+		},
+		{
+			"empty version",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: validator.String(),
+				PlanName:         "all-good",
+			},
+			true,
+			"version cannot be empty",
+		},
+		{
+			"all good",
+			&types.MsgSignalUpgradeReady{
+				ValidatorAddress: validator.String(),
+				PlanName:         "all-good",
+				Version:          "v2.0.0",
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Type(), sdk.MsgTypeURL(&types.MsgSignalUpgradeReady{}))
+				require.Equal(t, []sdk.AccAddress{sdk.AccAddress(validator)}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid authority address",
+			&types.MsgUpdateParams{
+				Authority: "authority",
+				Params:    types.DefaultParams(),
+			},
+			true,
+			"authority: decoding bech32 failed",
+		},
+		{
+			"readiness threshold above one",
+			&types.MsgUpdateParams{
+				Authority: authority.String(),
+				Params:    types.NewParams(sdk.NewDecWithPrec(11, 1), 100, 1),
+			},
+			true,
+			"readiness threshold must be between 0 and 1",
+		},
+		{
+			"negative readiness delay",
+			&types.MsgUpdateParams{
+				Authority: authority.String(),
+				Params:    types.NewParams(sdk.NewDecWithPrec(5, 1), -1, 1),
+			},
+			true,
+			"readiness delay cannot be negative",
+		},
+		{
+			"delays enabled without readiness delay",
+			&types.MsgUpdateParams{
+				Authority: authority.String(),
+				Params:    types.NewParams(sdk.NewDecWithPrec(5, 1), 0, 1),
+			},
+			true,
+			"readiness delay must be positive",
+		},
+		{
+			"all good",
+			&types.MsgUpdateParams{
+				Authority: authority.String(),
+				Params:    types.NewParams(sdk.NewDecWithPrec(5, 1), 100, 1),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.Type(), sdk.MsgTypeURL(&types.MsgUpdateParams{}))
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(readinessThreshold sdk.Dec, readinessDelay int64, maxReadinessDelays uint32) Params {
+	return Params{
+		ReadinessThreshold: readinessThreshold,
+		ReadinessDelay:     readinessDelay,
+		MaxReadinessDelays: maxReadinessDelays,
+	}
+}
+
+// DefaultParams returns default x/upgrade module parameters, with which plans
+// are never delayed.
+func DefaultParams() Params {
+	return Params{
+		ReadinessThreshold: sdk.ZeroDec(),
+		ReadinessDelay:     0,
+		MaxReadinessDelays: 0,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.ReadinessThreshold.IsNil() || p.ReadinessThreshold.IsNegative() || p.ReadinessThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("readiness threshold must be between 0 and 1: %s", p.ReadinessThreshold)
+	}
+	if p.ReadinessDelay < 0 {
+		return fmt.Errorf("readiness delay cannot be negative: %d", p.ReadinessDelay)
+	}
+	if p.ReadinessThreshold.IsPositive() && p.MaxReadinessDelays > 0 && p.ReadinessDelay == 0 {
+		return fmt.Errorf("readiness delay must be positive when plans can be delayed")
+	}
+
+	return nil
+}
+
+// DelaysEnabled returns true if the height of plans can be delayed when the
+// readiness threshold is not reached.
+func (p Params) DelaysEnabled() bool {
+	return p.ReadinessThreshold.IsPositive() && p.ReadinessDelay > 0 && p.MaxReadinessDelays > 0
+}
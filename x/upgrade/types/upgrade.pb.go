@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -222,11 +223,106 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// ReadinessSignal records that the operator of a validator signalled it is
+// ready for an upgrade plan, running the given binary version.
+type ReadinessSignal struct {
+	// validator_address is the operator address of the signalling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// version is the version of the binary the validator has installed.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the block height at which the signal was last sent.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReadinessSignal) Reset()         { *m = ReadinessSignal{} }
+func (m *ReadinessSignal) String() string { return proto.CompactTextString(m) }
+func (*ReadinessSignal) ProtoMessage()    {}
+func (*ReadinessSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *ReadinessSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessSignal.Merge(m, src)
+}
+func (m *ReadinessSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessSignal proto.InternalMessageInfo
+
+// Params defines the parameters for the x/upgrade module.
+type Params struct {
+	// readiness_threshold is the minimum share of the bonded tokens, held by
+	// validators which signalled their readiness for a plan, required for the
+	// plan to be applied at its height. When it is not reached, the plan height
+	// is delayed by readiness_delay blocks, at most max_readiness_delays times.
+	// Zero disables the delays.
+	ReadinessThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=readiness_threshold,json=readinessThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"readiness_threshold"`
+	// readiness_delay is the number of blocks by which the height of a plan is
+	// delayed when the readiness threshold is not reached.
+	ReadinessDelay int64 `protobuf:"varint,2,opt,name=readiness_delay,json=readinessDelay,proto3" json:"readiness_delay,omitempty"`
+	// max_readiness_delays is the maximum number of times the height of a plan
+	// can be delayed.
+	MaxReadinessDelays uint32 `protobuf:"varint,3,opt,name=max_readiness_delays,json=maxReadinessDelays,proto3" json:"max_readiness_delays,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*ReadinessSignal)(nil), "cosmos.upgrade.v1beta1.ReadinessSignal")
+	proto.RegisterType((*Params)(nil), "cosmos.upgrade.v1beta1.Params")
 }
 
 func init() {
@@ -234,41 +330,52 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0xb5, 0x6e, 0x21, 0x17, 0xa1, 0x0a, 0x13, 0xca, 0x35, 0x2a, 0x76, 0x64, 0x31, 0x44,
-	0x15, 0xb5, 0xd5, 0xb2, 0x85, 0x01, 0x91, 0x0c, 0x48, 0x08, 0x44, 0xe5, 0x52, 0x06, 0x96, 0xe8,
-	0x62, 0x5f, 0x1c, 0x0b, 0xfb, 0xce, 0xb2, 0x2f, 0x81, 0x7c, 0x05, 0xa6, 0x8e, 0x1d, 0x33, 0x32,
-	0xa1, 0x0e, 0x7c, 0x88, 0x88, 0xa9, 0x23, 0x13, 0x85, 0x64, 0x28, 0x3b, 0x5f, 0x00, 0xdd, 0x9d,
-	0x5d, 0x45, 0x10, 0x10, 0x43, 0x97, 0xe8, 0xbd, 0x77, 0xef, 0x97, 0xdf, 0x1f, 0xdf, 0xc1, 0x7b,
-	0x3e, 0xcb, 0x13, 0x96, 0xbb, 0xc3, 0x34, 0xcc, 0x70, 0x40, 0xdc, 0xd1, 0x5e, 0x8f, 0x70, 0xbc,
-	0x57, 0xf6, 0x4e, 0x9a, 0x31, 0xce, 0x8c, 0x4d, 0xb5, 0xe5, 0x94, 0xd3, 0x62, 0xab, 0xbe, 0x15,
-	0x32, 0x16, 0xc6, 0xc4, 0x95, 0x5b, 0xbd, 0x61, 0xdf, 0xc5, 0x74, 0xac, 0x20, 0xf5, 0x5a, 0xc8,
-	0x42, 0x26, 0x4b, 0x57, 0x54, 0xc5, 0xd4, 0xfa, 0x1d, 0xc0, 0xa3, 0x84, 0xe4, 0x1c, 0x27, 0x69,
-	0xb1, 0xb0, 0xa5, 0x98, 0xba, 0x0a, 0x59, 0xd0, 0xaa, 0xa3, 0x9b, 0x38, 0x89, 0x28, 0x73, 0xe5,
-	0xaf, 0x1a, 0xd9, 0x3f, 0x01, 0xd4, 0x0f, 0x62, 0x4c, 0x0d, 0x03, 0xea, 0x14, 0x27, 0x04, 0x81,
-	0x06, 0x68, 0x56, 0x3c, 0x59, 0x1b, 0x8f, 0xa0, 0x2e, 0xfe, 0x1d, 0xad, 0x34, 0x40, 0xb3, 0xba,
-	0x5f, 0x77, 0x14, 0xb5, 0x53, 0x52, 0x3b, 0x2f, 0x4b, 0xea, 0xf6, 0xc6, 0xf4, 0xab, 0xa5, 0x1d,
-	0x9f, 0x5b, 0xe0, 0xc3, 0xc5, 0xe9, 0x0e, 0x40, 0xc0, 0x93, 0x40, 0x63, 0x13, 0xae, 0x0f, 0x48,
-	0x14, 0x0e, 0x38, 0x5a, 0x6d, 0x80, 0xe6, 0xaa, 0x57, 0x74, 0x82, 0x2c, 0xa2, 0x7d, 0x86, 0x74,
-	0x45, 0x26, 0x6a, 0xe3, 0x19, 0xbc, 0x5d, 0x84, 0x13, 0x74, 0xfd, 0x38, 0x22, 0x94, 0x77, 0x73,
-	0x8e, 0x39, 0x41, 0x6b, 0x92, 0xbd, 0xf6, 0x07, 0xfb, 0x63, 0x3a, 0x6e, 0xaf, 0x20, 0xe0, 0xdd,
-	0x2a, 0x61, 0x1d, 0x89, 0x3a, 0x14, 0xa0, 0xd6, 0xf6, 0xc9, 0xc4, 0xd2, 0x7e, 0x4c, 0x2c, 0xf0,
-	0xfe, 0xe2, 0x74, 0x67, 0x43, 0xa5, 0xb0, 0x9b, 0x07, 0x6f, 0x5c, 0x61, 0xd6, 0x3e, 0x07, 0xf0,
-	0xce, 0x21, 0xeb, 0xf3, 0xb7, 0x38, 0x23, 0x47, 0x0a, 0x7d, 0x90, 0xb1, 0x94, 0xe5, 0x38, 0x36,
-	0x6a, 0x70, 0x8d, 0x47, 0x3c, 0x2e, 0x93, 0x50, 0x8d, 0xd1, 0x80, 0xd5, 0x80, 0xe4, 0x7e, 0x16,
-	0xa5, 0x3c, 0x62, 0x54, 0x26, 0x52, 0xf1, 0x16, 0x47, 0xc6, 0x43, 0xa8, 0xa7, 0x31, 0xa6, 0xd2,
-	0x69, 0x75, 0x7f, 0xdb, 0x59, 0xfe, 0xc1, 0x1d, 0xc1, 0xdf, 0xae, 0x88, 0xb8, 0x64, 0x54, 0x9e,
-	0x04, 0xb5, 0x5e, 0x94, 0x72, 0x3f, 0x7f, 0xda, 0xad, 0x17, 0xc8, 0x90, 0x8d, 0x2e, 0x51, 0x1d,
-	0x46, 0x39, 0xa1, 0x5c, 0x98, 0xb1, 0x17, 0xcc, 0xfc, 0xc5, 0x03, 0x02, 0xf6, 0x47, 0x00, 0xef,
-	0x76, 0x30, 0xf5, 0x49, 0x7c, 0xc5, 0x3e, 0x5b, 0x47, 0xff, 0x2f, 0xb5, 0xb9, 0x20, 0xf5, 0x9f,
-	0x62, 0x10, 0xb0, 0x9f, 0xc0, 0x1b, 0xcf, 0x59, 0x30, 0x8c, 0xc9, 0x2b, 0x92, 0xe5, 0x22, 0xcf,
-	0x65, 0x17, 0x12, 0xc1, 0x6b, 0x23, 0x75, 0x2c, 0x95, 0xe9, 0x5e, 0xd9, 0xb6, 0xae, 0x9f, 0x4c,
-	0x2c, 0x20, 0x54, 0xb5, 0x9f, 0x4e, 0xbf, 0x9b, 0xda, 0x74, 0x66, 0x82, 0xb3, 0x99, 0x09, 0xbe,
-	0xcd, 0x4c, 0x70, 0x3c, 0x37, 0xb5, 0xb3, 0xb9, 0xa9, 0x7d, 0x99, 0x9b, 0xda, 0xeb, 0xfb, 0x61,
-	0xc4, 0x07, 0xc3, 0x9e, 0xe3, 0xb3, 0xa4, 0x78, 0x1b, 0xee, 0x82, 0xc8, 0x77, 0x97, 0xaf, 0x98,
-	0x8f, 0x53, 0x92, 0xf7, 0xd6, 0xe5, 0x65, 0x7b, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x32, 0xc0,
-	0xef, 0xa8, 0xe4, 0x03, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x40, 0x41, 0x3b, 0x04, 0x2b, 0x43, 0xc5, 0xa5, 0x42, 0x5b, 0x1b, 0xa3, 0x0d, 0xb1,
+	0xbb, 0x82, 0xb7, 0x7a, 0x30, 0x14, 0x12, 0x13, 0xe3, 0x0f, 0xb2, 0x05, 0x0e, 0x5e, 0x9a, 0x69,
+	0x77, 0xd8, 0x6e, 0xdc, 0x9d, 0xd9, 0xec, 0x4c, 0x2b, 0xfd, 0x17, 0x3c, 0x91, 0x78, 0xe1, 0xc8,
+	0xd1, 0x93, 0xe1, 0xc0, 0x1f, 0x41, 0x3c, 0x11, 0x4e, 0xc6, 0x03, 0x28, 0x1c, 0xf0, 0xee, 0xc9,
+	0x9b, 0xd9, 0x99, 0xdd, 0x52, 0x2b, 0x10, 0x0f, 0x5e, 0x9a, 0xf7, 0xde, 0xbe, 0xef, 0x7d, 0xdf,
+	0xf7, 0xf6, 0x6d, 0xe1, 0xbd, 0x26, 0xe3, 0x1e, 0xe3, 0x46, 0xdb, 0xb7, 0x03, 0x6c, 0x11, 0xa3,
+	0x33, 0xdf, 0x20, 0x02, 0xcf, 0xc7, 0xb9, 0xee, 0x07, 0x4c, 0x30, 0x34, 0xa5, 0xba, 0xf4, 0xb8,
+	0x1a, 0x75, 0x65, 0xa7, 0x6d, 0xc6, 0x6c, 0x97, 0x18, 0xb2, 0xab, 0xd1, 0xde, 0x30, 0x30, 0xed,
+	0x2a, 0x48, 0x36, 0x63, 0x33, 0x9b, 0xc9, 0xd0, 0x08, 0xa3, 0xa8, 0x9a, 0x1f, 0x04, 0x08, 0xc7,
+	0x23, 0x5c, 0x60, 0xcf, 0x8f, 0x1a, 0xa6, 0x15, 0x53, 0x5d, 0x21, 0x23, 0x5a, 0xf5, 0x68, 0x02,
+	0x7b, 0x0e, 0x65, 0x86, 0xfc, 0x55, 0xa5, 0xe2, 0x4f, 0x00, 0x93, 0x2b, 0x2e, 0xa6, 0x08, 0xc1,
+	0x24, 0xc5, 0x1e, 0xd1, 0x40, 0x01, 0x94, 0x52, 0xa6, 0x8c, 0xd1, 0x53, 0x98, 0x0c, 0xa7, 0x6b,
+	0x43, 0x05, 0x50, 0x1a, 0x5b, 0xc8, 0xea, 0x8a, 0x5a, 0x8f, 0xa9, 0xf5, 0xd5, 0x98, 0xba, 0x9a,
+	0xde, 0x3f, 0xca, 0x27, 0xb6, 0x8e, 0xf3, 0xe0, 0xe3, 0xd9, 0xee, 0x1c, 0xd0, 0x80, 0x29, 0x81,
+	0x68, 0x0a, 0x8e, 0xb6, 0x88, 0x63, 0xb7, 0x84, 0x36, 0x5c, 0x00, 0xa5, 0x61, 0x33, 0xca, 0x42,
+	0x32, 0x87, 0x6e, 0x30, 0x2d, 0xa9, 0xc8, 0xc2, 0x18, 0xbd, 0x80, 0xb7, 0xa2, 0xe5, 0x58, 0xf5,
+	0xa6, 0xeb, 0x10, 0x2a, 0xea, 0x5c, 0x60, 0x41, 0xb4, 0x11, 0xc9, 0x9e, 0xf9, 0x8b, 0x7d, 0x91,
+	0x76, 0xab, 0x43, 0x1a, 0x30, 0x27, 0x63, 0xd8, 0x92, 0x44, 0xd5, 0x42, 0x50, 0x65, 0x66, 0x7b,
+	0x27, 0x9f, 0xf8, 0xb1, 0x93, 0x07, 0xef, 0xcf, 0x76, 0xe7, 0xd2, 0x6a, 0x0b, 0x65, 0x6e, 0xbd,
+	0x35, 0x42, 0xb3, 0xc5, 0x63, 0x00, 0x6f, 0xd7, 0xd8, 0x86, 0x78, 0x87, 0x03, 0xb2, 0xa6, 0xd0,
+	0x2b, 0x01, 0xf3, 0x19, 0xc7, 0x2e, 0xca, 0xc0, 0x11, 0xe1, 0x08, 0x37, 0xde, 0x84, 0x4a, 0x50,
+	0x01, 0x8e, 0x59, 0x84, 0x37, 0x03, 0xc7, 0x17, 0x0e, 0xa3, 0x72, 0x23, 0x29, 0xb3, 0xbf, 0x84,
+	0x9e, 0xc0, 0xa4, 0xef, 0x62, 0x2a, 0x9d, 0x8e, 0x2d, 0xcc, 0xe8, 0x17, 0xbf, 0x70, 0x3d, 0xe4,
+	0xaf, 0xa6, 0xc2, 0x75, 0xc9, 0x55, 0x99, 0x12, 0x54, 0x79, 0x1d, 0xcb, 0xfd, 0xbc, 0x57, 0xce,
+	0x46, 0x48, 0x9b, 0x75, 0x7a, 0xa8, 0x25, 0x46, 0x05, 0xa1, 0x22, 0x34, 0x53, 0xec, 0x33, 0x73,
+	0x89, 0x07, 0x0d, 0x14, 0x3f, 0x01, 0x38, 0xbb, 0x84, 0x69, 0x93, 0xb8, 0xff, 0xd9, 0x67, 0x65,
+	0xed, 0xdf, 0xa5, 0x96, 0xfa, 0xa4, 0x5e, 0x29, 0x46, 0x03, 0xc5, 0x67, 0x70, 0xfc, 0x25, 0xb3,
+	0xda, 0x2e, 0x59, 0x27, 0x01, 0x77, 0xd8, 0xc5, 0x07, 0xa9, 0xc1, 0x6b, 0x1d, 0xf5, 0x58, 0x2a,
+	0x4b, 0x9a, 0x71, 0x5a, 0xb9, 0xbe, 0xbd, 0x93, 0x07, 0xa1, 0xaa, 0xe2, 0x07, 0x00, 0xd3, 0x26,
+	0xc1, 0x96, 0x43, 0x09, 0xe7, 0x35, 0xc7, 0xa6, 0xd8, 0x45, 0xaf, 0xe0, 0x44, 0x07, 0xbb, 0x8e,
+	0x85, 0x05, 0x0b, 0xea, 0xd8, 0xb2, 0x02, 0xc2, 0xb9, 0x1a, 0x5c, 0xbd, 0x7b, 0xb8, 0x57, 0x9e,
+	0x8d, 0x6c, 0xac, 0xc7, 0x3d, 0x8b, 0xaa, 0xa5, 0x26, 0x02, 0x87, 0xda, 0xe6, 0xcd, 0xce, 0x40,
+	0x7d, 0x50, 0x47, 0xaa, 0xa7, 0xe3, 0xb2, 0x8b, 0x2f, 0xfe, 0x02, 0x70, 0x74, 0x05, 0x07, 0xd8,
+	0xe3, 0x28, 0x80, 0x93, 0x41, 0xac, 0xaf, 0x2e, 0x5a, 0x01, 0xe1, 0x2d, 0xe6, 0x5a, 0x91, 0x9c,
+	0xc5, 0xf0, 0x32, 0xbe, 0x1e, 0xe5, 0xef, 0xdb, 0x8e, 0x68, 0xb5, 0x1b, 0x7a, 0x93, 0x79, 0xd1,
+	0x37, 0x6c, 0xf4, 0x2d, 0x53, 0x74, 0x7d, 0xc2, 0xf5, 0x65, 0xd2, 0x3c, 0xdc, 0x2b, 0xc3, 0x48,
+	0xfc, 0x32, 0x69, 0xaa, 0x8b, 0x42, 0xbd, 0xe9, 0xab, 0xf1, 0x70, 0xf4, 0x00, 0xa6, 0xcf, 0x39,
+	0x2d, 0xe2, 0xe2, 0xae, 0x14, 0x3e, 0x6c, 0xde, 0xe8, 0x95, 0x97, 0xc3, 0x2a, 0x7a, 0x04, 0x33,
+	0x1e, 0xde, 0xac, 0x0f, 0x34, 0x73, 0xe9, 0x66, 0xdc, 0x44, 0x1e, 0xde, 0x34, 0xff, 0x00, 0xf0,
+	0x4a, 0x21, 0x7c, 0xd3, 0x77, 0xfa, 0xc4, 0x6d, 0xf6, 0xfe, 0x0a, 0x95, 0xe1, 0xea, 0xf3, 0xfd,
+	0xef, 0xb9, 0xc4, 0xfe, 0x49, 0x0e, 0x1c, 0x9c, 0xe4, 0xc0, 0xb7, 0x93, 0x1c, 0xd8, 0x3a, 0xcd,
+	0x25, 0x0e, 0x4e, 0x73, 0x89, 0x2f, 0xa7, 0xb9, 0xc4, 0x9b, 0x87, 0x57, 0x3a, 0x3d, 0x1f, 0x26,
+	0x3d, 0x37, 0x46, 0xe5, 0xe7, 0xff, 0xf8, 0xf7, 0x00, 0x8a, 0xe7, 0xac, 0x8a, 0x76, 0x05, 0x00,
+	0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -572,6 +679,91 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReadinessSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxReadinessDelays != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.MaxReadinessDelays))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadinessDelay != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.ReadinessDelay))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ReadinessThreshold.Size()
+		i -= size
+		if _, err := m.ReadinessThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUpgrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -661,6 +853,43 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *ReadinessSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReadinessThreshold.Size()
+	n += 1 + l + sovUpgrade(uint64(l))
+	if m.ReadinessDelay != 0 {
+		n += 1 + sovUpgrade(uint64(m.ReadinessDelay))
+	}
+	if m.MaxReadinessDelays != 0 {
+		n += 1 + sovUpgrade(uint64(m.MaxReadinessDelays))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1231,6 +1460,261 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReadinessSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadinessThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessDelay", wireType)
+			}
+			m.ReadinessDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadinessDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReadinessDelays", wireType)
+			}
+			m.MaxReadinessDelays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReadinessDelays |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0